@@ -0,0 +1,22 @@
+package apidoc
+
+// AdvertisedFacades holds the facade versions that the API server
+// lists in its Login response, for each kind of login, as a client
+// sees them before negotiating which versions to use. Unlike
+// Info.Facades, they include any facades that couldn't be documented.
+type AdvertisedFacades struct {
+	// Controller holds the facades listed after logging in
+	// to the controller endpoint.
+	Controller []FacadeVersions `json:",omitempty"`
+
+	// Model holds the facades listed after logging in
+	// to a model endpoint.
+	Model []FacadeVersions `json:",omitempty"`
+}
+
+// FacadeVersions holds the versions of a facade, in
+// order, as listed in a Login response.
+type FacadeVersions struct {
+	Name     string
+	Versions []int
+}
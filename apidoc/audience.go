@@ -0,0 +1,103 @@
+package apidoc
+
+import (
+	"encoding/json"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// Audiences that facades can be intended for.
+const (
+	// AudienceClient is for facades used by clients
+	// such as the juju command.
+	AudienceClient = "client"
+
+	// AudienceAgent is for facades used by machine
+	// and unit agents.
+	AudienceAgent = "agent"
+
+	// AudienceController is for facades used by
+	// agents running on controller machines.
+	AudienceController = "controller"
+)
+
+// entityAudiences maps each entity kind that can appear in
+// FacadeInfo.AvailableTo to its audience.
+var entityAudiences = map[string]string{
+	"controller-user":          AudienceClient,
+	"model-user":               AudienceClient,
+	"machine-agent":            AudienceAgent,
+	"unit-agent":               AudienceAgent,
+	"controller-machine-agent": AudienceController,
+}
+
+// allAudiences holds all the audiences in the order
+// in which they are listed.
+var allAudiences = []string{
+	AudienceClient,
+	AudienceAgent,
+	AudienceController,
+}
+
+// AudiencesOf returns the audiences of a facade
+// available to the given kinds of entity.
+func AudiencesOf(availableTo []string) []string {
+	found := make(map[string]bool)
+	for _, kind := range availableTo {
+		if a, ok := entityAudiences[kind]; ok {
+			found[a] = true
+		}
+	}
+	var audiences []string
+	for _, a := range allAudiences {
+		if found[a] {
+			audiences = append(audiences, a)
+		}
+	}
+	return audiences
+}
+
+// HasAudience reports whether the facade is
+// intended for the given audience.
+func (f *FacadeInfo) HasAudience(audience string) bool {
+	for _, a := range f.Audiences {
+		if a == audience {
+			return true
+		}
+	}
+	return false
+}
+
+// addAudiences upgrades a version 1 document by
+// setting the Audiences field of each facade.
+func addAudiences(doc map[string]json.RawMessage) error {
+	data, ok := doc["Facades"]
+	if !ok {
+		return nil
+	}
+	var facades []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &facades); err != nil {
+		return errors.Notef(err, nil, "cannot parse facades")
+	}
+	for _, f := range facades {
+		var availableTo []string
+		if data, ok := f["AvailableTo"]; ok {
+			if err := json.Unmarshal(data, &availableTo); err != nil {
+				return errors.Notef(err, nil, "cannot parse AvailableTo")
+			}
+		}
+		if audiences := AudiencesOf(availableTo); len(audiences) > 0 {
+			data, err := json.Marshal(audiences)
+			if err != nil {
+				return errors.Wrap(err)
+			}
+			f["Audiences"] = data
+		}
+	}
+	data, err := json.Marshal(facades)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	doc["Facades"] = data
+	return nil
+}
@@ -0,0 +1,133 @@
+package apidoc
+
+import (
+	"sort"
+	"strings"
+)
+
+// Canonicalize puts info into a canonical form, so that documents
+// generated from the same API compare equal regardless of the
+// order in which things were found or the version of the
+// tool that generated them.
+//
+// Facades are sorted by name and version, methods by name, and
+// error codes, sentinel errors, operational settings, audit-excluded
+// methods, warnings, factory panics and facade errors into a fixed
+// order. Entries in Fields for the same field are combined, and the
+// entries sorted as described for Fields. Doc comments are
+// normalized as described in NormalizeDoc. The entries in TypeInfo
+// are held in a map and so need no sorting, and the order of struct
+// fields is significant so it is left alone.
+func (info *Info) Canonicalize() {
+	for i := range info.Facades {
+		info.Facades[i].Canonicalize()
+	}
+	sort.SliceStable(info.Facades, func(i, j int) bool {
+		f1, f2 := &info.Facades[i], &info.Facades[j]
+		if f1.Name != f2.Name {
+			return f1.Name < f2.Name
+		}
+		return f1.Version < f2.Version
+	})
+	for i := range info.ErrorCodes {
+		info.ErrorCodes[i].Doc = NormalizeDoc(info.ErrorCodes[i].Doc)
+	}
+	sort.SliceStable(info.ErrorCodes, func(i, j int) bool {
+		return info.ErrorCodes[i].Name < info.ErrorCodes[j].Name
+	})
+	sort.SliceStable(info.Warnings, func(i, j int) bool {
+		return warningLess(&info.Warnings[i], &info.Warnings[j])
+	})
+	sort.SliceStable(info.FactoryPanics, func(i, j int) bool {
+		p1, p2 := &info.FactoryPanics[i], &info.FactoryPanics[j]
+		if p1.Facade != p2.Facade {
+			return p1.Facade < p2.Facade
+		}
+		if p1.Version != p2.Version {
+			return p1.Version < p2.Version
+		}
+		return p1.EntityKind < p2.EntityKind
+	})
+	for i := range info.SentinelErrors {
+		info.SentinelErrors[i].Doc = NormalizeDoc(info.SentinelErrors[i].Doc)
+	}
+	sort.SliceStable(info.SentinelErrors, func(i, j int) bool {
+		return info.SentinelErrors[i].Name < info.SentinelErrors[j].Name
+	})
+	for i := range info.Operational {
+		info.Operational[i].Doc = NormalizeDoc(info.Operational[i].Doc)
+	}
+	sort.SliceStable(info.Operational, func(i, j int) bool {
+		s1, s2 := &info.Operational[i], &info.Operational[j]
+		if s1.Category != s2.Category {
+			return s1.Category < s2.Category
+		}
+		return s1.Name < s2.Name
+	})
+	info.AuditExcluded = uniqueStrings(info.AuditExcluded)
+	sort.SliceStable(info.FacadeErrors, func(i, j int) bool {
+		e1, e2 := &info.FacadeErrors[i], &info.FacadeErrors[j]
+		if e1.Facade != e2.Facade {
+			return e1.Facade < e2.Facade
+		}
+		return e1.Version < e2.Version
+	})
+	info.Fields = combineFields(info.Fields)
+}
+
+// Canonicalize puts f into a canonical form: its methods
+// and AvailableTo entries are sorted and the doc comments of
+// the facade, its methods and its lease parameters are
+// normalized.
+func (f *FacadeInfo) Canonicalize() {
+	f.Doc = NormalizeDoc(f.Doc)
+	for i := range f.Methods {
+		f.Methods[i].Doc = NormalizeDoc(f.Methods[i].Doc)
+	}
+	sort.SliceStable(f.Methods, func(i, j int) bool {
+		return f.Methods[i].Name < f.Methods[j].Name
+	})
+	sort.Strings(f.AvailableTo)
+	for i := range f.Leases {
+		f.Leases[i].Doc = NormalizeDoc(f.Leases[i].Doc)
+	}
+}
+
+// NormalizeDoc returns doc with Windows line endings converted,
+// trailing white space removed from each line, and leading and
+// trailing blank lines removed. A non-empty result always ends
+// in a single newline, as returned by go/ast.CommentGroup.Text.
+func NormalizeDoc(doc string) string {
+	lines := strings.Split(strings.Replace(doc, "\r\n", "\n", -1), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func warningLess(w1, w2 *Warning) bool {
+	switch {
+	case w1.Kind != w2.Kind:
+		return w1.Kind < w2.Kind
+	case w1.Facade != w2.Facade:
+		return w1.Facade < w2.Facade
+	case w1.Version != w2.Version:
+		return w1.Version < w2.Version
+	case w1.Method != w2.Method:
+		return w1.Method < w2.Method
+	case w1.Type != w2.Type:
+		return w1.Type < w2.Type
+	case w1.Field != w2.Field:
+		return w1.Field < w2.Field
+	}
+	return w1.Message < w2.Message
+}
@@ -0,0 +1,99 @@
+package apidoc
+
+import (
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// HasPerItemErrors reports whether the result type t follows the
+// convention of holding a list of results, each with its own Error
+// field, as params.ErrorResults does.
+func (info *Info) HasPerItemErrors(t *jsontypes.Type) bool {
+	t = info.deref(t)
+	if t == nil || t.Kind != jsontypes.Struct {
+		return false
+	}
+	for _, f := range t.Fields {
+		ft := info.deref(f.Type)
+		if ft.Kind != jsontypes.Slice && ft.Kind != jsontypes.Map {
+			continue
+		}
+		if info.hasErrorField(ft.Elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// Invocation returns how a client uses the method m of the facade f,
+// for Method.Invocation. Following the Juju conventions, a method
+// starts a watcher if its result, or each of its results, has a field
+// holding a watcher id, such as NotifyWatcherId, and the methods of a
+// facade with a name ending in Watcher are used to poll a watcher.
+// All other methods are unary.
+func (info *Info) Invocation(f *FacadeInfo, m *Method) string {
+	if strings.HasSuffix(f.Name, "Watcher") {
+		return InvocationWatcher
+	}
+	t := info.deref(m.Result)
+	if t == nil || t.Kind != jsontypes.Struct {
+		return InvocationUnary
+	}
+	if info.hasWatcherIdField(t) {
+		return InvocationWatcher
+	}
+	for _, f := range t.Fields {
+		ft := info.deref(f.Type)
+		if ft != nil && ft.Kind == jsontypes.Slice && info.hasWatcherIdField(ft.Elem) {
+			return InvocationWatcher
+		}
+	}
+	return InvocationUnary
+}
+
+// hasWatcherIdField reports whether t is a struct with a
+// field holding a watcher id, such as StringsWatcherId.
+func (info *Info) hasWatcherIdField(t *jsontypes.Type) bool {
+	t = info.deref(t)
+	if t == nil || t.Kind != jsontypes.Struct {
+		return false
+	}
+	for _, f := range t.Fields {
+		if strings.HasSuffix(strings.ToLower(f.Name), "watcherid") {
+			return true
+		}
+	}
+	return false
+}
+
+// hasErrorField reports whether t is a struct with an Error
+// field holding a type named Error.
+func (info *Info) hasErrorField(t *jsontypes.Type) bool {
+	t = info.deref(t)
+	if t == nil || t.Kind != jsontypes.Struct {
+		return false
+	}
+	for _, f := range t.Fields {
+		if f.Name != "Error" {
+			continue
+		}
+		ft := f.Type
+		if ft.Kind == jsontypes.Ptr {
+			ft = ft.Elem
+		}
+		if ft.Name.Name() == "Error" {
+			return true
+		}
+	}
+	return false
+}
+
+// deref returns the definition of t, following any pointers.
+func (info *Info) deref(t *jsontypes.Type) *jsontypes.Type {
+	t = info.Type(t)
+	for t != nil && t.Kind == jsontypes.Ptr {
+		t = info.Type(t.Elem)
+	}
+	return t
+}
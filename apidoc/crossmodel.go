@@ -0,0 +1,62 @@
+package apidoc
+
+import (
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// CrossModelTag holds the subsystem tag of the facades that
+// implement cross-model relations: offering applications
+// (ApplicationOffers), consuming them from another controller
+// (CrossModelRelations, CrossController) and relaying changes
+// to the other side (RemoteRelations).
+const CrossModelTag = "cross-model"
+
+// CrossModelFacades returns the facades in info that implement
+// cross-model relations, in the order of info.Facades.
+func (info *Info) CrossModelFacades() []FacadeInfo {
+	var fs []FacadeInfo
+	for _, f := range info.Facades {
+		if f.HasTag(CrossModelTag) {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// MacaroonFields returns the struct fields reachable from t that
+// hold macaroons, as Type.Field, in the order in which they're
+// found. A method whose params have any sends macaroons to
+// authorize the call, as the cross-model facades do.
+func (info *Info) MacaroonFields(t *jsontypes.Type) []string {
+	var fields []string
+	info.addMacaroonFields(t, &fields, make(map[jsontypes.TypeName]bool))
+	return fields
+}
+
+func (info *Info) addMacaroonFields(t *jsontypes.Type, fields *[]string, visited map[jsontypes.TypeName]bool) {
+	if t == nil {
+		return
+	}
+	name := t.Name.Name()
+	if t.Name != "" {
+		if visited[t.Name] {
+			return
+		}
+		visited[t.Name] = true
+	}
+	t = info.Type(t)
+	switch t.Kind {
+	case jsontypes.Struct:
+		for _, field := range t.Fields {
+			if strings.Contains(strings.ToLower(field.Name), "macaroon") {
+				*fields = append(*fields, name+"."+field.Name)
+				continue
+			}
+			info.addMacaroonFields(field.Type, fields, visited)
+		}
+	case jsontypes.Ptr, jsontypes.Slice, jsontypes.Array, jsontypes.Map:
+		info.addMacaroonFields(t.Elem, fields, visited)
+	}
+}
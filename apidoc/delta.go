@@ -0,0 +1,78 @@
+package apidoc
+
+import (
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// Delta describes a document that holds only the
+// facades that changed since a baseline document.
+type Delta struct {
+	// Baseline holds the metadata of the baseline
+	// document, if it had any.
+	Baseline *Meta `json:",omitempty"`
+
+	// Index holds the versions of every facade in the
+	// full document, including those left out because
+	// they haven't changed, as returned by FacadeVersions.
+	Index map[string][]int
+
+	// Removed holds the versions of the facades in the
+	// baseline that are no longer present.
+	Removed map[string][]int `json:",omitempty"`
+}
+
+// ChangedSince returns a copy of info holding only the facade
+// versions that are new or different since the baseline document,
+// as filtered by Filter. A facade version is different if its
+// own definition has changed or the definition of any type that
+// its methods use has. The result's Delta field records the
+// facades that were left out and those that have been removed.
+func (info *Info) ChangedSince(baseline *Info) *Info {
+	delta := &Delta{
+		Baseline: baseline.Meta,
+		Index:    info.FacadeVersions(),
+	}
+	for _, f := range baseline.Facades {
+		if info.Facade(f.Name, f.Version) == nil {
+			if delta.Removed == nil {
+				delta.Removed = make(map[string][]int)
+			}
+			delta.Removed[f.Name] = append(delta.Removed[f.Name], f.Version)
+		}
+	}
+	changed := info.Filter(func(f *FacadeInfo, m *Method) bool {
+		if m != nil {
+			return true
+		}
+		return info.facadeChanged(f, baseline)
+	})
+	changed.Delta = delta
+	return changed
+}
+
+// facadeChanged reports whether f or any of the types
+// that it uses differs from its definition in baseline.
+func (info *Info) facadeChanged(f *FacadeInfo, baseline *Info) bool {
+	f0 := baseline.Facade(f.Name, f.Version)
+	if f0 == nil || !sameJSON(withoutSources(*f), withoutSources(*f0)) {
+		return true
+	}
+	if info.TypeInfo == nil {
+		return false
+	}
+	used := make(map[jsontypes.TypeName]bool)
+	for _, m := range f.Methods {
+		info.addUsedTypes(used, m.Param)
+		info.addUsedTypes(used, m.Result)
+	}
+	for name := range used {
+		var t0 *jsontypes.Type
+		if baseline.TypeInfo != nil {
+			t0 = baseline.TypeInfo.Types[name]
+		}
+		if t0 == nil || !sameJSON(info.TypeInfo.Types[name], t0) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,298 @@
+package apidoc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// ChangeKind classifies a change between two documents.
+type ChangeKind string
+
+const (
+	FacadeAdded         ChangeKind = "facade-added"
+	FacadeRemoved       ChangeKind = "facade-removed"
+	AvailabilityChanged ChangeKind = "availability-changed"
+	MethodAdded         ChangeKind = "method-added"
+	MethodRemoved       ChangeKind = "method-removed"
+	ParamChanged        ChangeKind = "param-changed"
+	ResultChanged       ChangeKind = "result-changed"
+	PermissionsChanged  ChangeKind = "permissions-changed"
+	TypeAdded           ChangeKind = "type-added"
+	TypeRemoved         ChangeKind = "type-removed"
+	FieldAdded          ChangeKind = "field-added"
+	FieldRemoved        ChangeKind = "field-removed"
+	FieldTypeChanged    ChangeKind = "field-type-changed"
+	ErrorCodeAdded      ChangeKind = "error-code-added"
+	ErrorCodeRemoved    ChangeKind = "error-code-removed"
+
+	// TypeRevised is used by Info.TypeEvolution to report
+	// a new revision of a type in a TypeFamily.
+	TypeRevised ChangeKind = "type-revised"
+)
+
+// Change describes a single difference between two documents.
+type Change struct {
+	Kind ChangeKind
+
+	// Facade, Version and Method identify the facade
+	// method that changed, if any.
+	Facade  string `json:",omitempty"`
+	Version int    `json:",omitempty"`
+	Method  string `json:",omitempty"`
+
+	// Type and Field identify the type or struct
+	// field that changed, if any.
+	Type  jsontypes.TypeName `json:",omitempty"`
+	Field string             `json:",omitempty"`
+
+	// ErrorCode holds the error code that was added
+	// or removed, if any.
+	ErrorCode string `json:",omitempty"`
+
+	// Old and New hold descriptions of the old
+	// and new values for changes that modify
+	// something rather than adding or removing it.
+	Old string `json:",omitempty"`
+	New string `json:",omitempty"`
+}
+
+// String returns a one-line description of the change.
+func (c Change) String() string {
+	var loc string
+	switch {
+	case c.Facade != "":
+		loc = fmt.Sprintf("%s(%d)", c.Facade, c.Version)
+		if c.Method != "" {
+			loc += "." + c.Method
+		}
+	case c.Type != "":
+		loc = string(c.Type)
+		if c.Field != "" {
+			loc += "." + c.Field
+		}
+	default:
+		loc = c.ErrorCode
+	}
+	s := fmt.Sprintf("%s: %s", loc, c.Kind)
+	if c.Old != "" || c.New != "" {
+		s += fmt.Sprintf(" (%s -> %s)", c.Old, c.New)
+	}
+	return s
+}
+
+// Diff returns the changes needed to get from the old
+// document to the new one, in a deterministic order.
+func Diff(old, new *Info) []Change {
+	var changes []Change
+	changes = append(changes, diffFacades(old, new)...)
+	changes = append(changes, diffTypes(old, new)...)
+	changes = append(changes, diffErrorCodes(old, new)...)
+	return changes
+}
+
+func diffFacades(old, new *Info) []Change {
+	oldFacades, newFacades := facadeMap(old), facadeMap(new)
+	var changes []Change
+	for _, key := range sortedFacadeKeys(oldFacades, newFacades) {
+		f0, ok0 := oldFacades[key]
+		f1, ok1 := newFacades[key]
+		switch {
+		case !ok1:
+			changes = append(changes, Change{Kind: FacadeRemoved, Facade: key.name, Version: key.version})
+			continue
+		case !ok0:
+			changes = append(changes, Change{Kind: FacadeAdded, Facade: key.name, Version: key.version})
+			continue
+		}
+		if a0, a1 := sortedJoin(f0.AvailableTo), sortedJoin(f1.AvailableTo); a0 != a1 {
+			changes = append(changes, Change{
+				Kind:    AvailabilityChanged,
+				Facade:  key.name,
+				Version: key.version,
+				Old:     a0,
+				New:     a1,
+			})
+		}
+		changes = append(changes, diffMethods(key, f0, f1)...)
+	}
+	return changes
+}
+
+func diffMethods(key facadeKey, f0, f1 *FacadeInfo) []Change {
+	names := make(map[string]bool)
+	for _, m := range f0.Methods {
+		names[m.Name] = true
+	}
+	for _, m := range f1.Methods {
+		names[m.Name] = true
+	}
+	var changes []Change
+	for _, name := range sortedNames(names) {
+		m0, m1 := f0.Method(name), f1.Method(name)
+		c := Change{
+			Facade:  key.name,
+			Version: key.version,
+			Method:  name,
+		}
+		switch {
+		case m1 == nil:
+			c.Kind = MethodRemoved
+			changes = append(changes, c)
+			continue
+		case m0 == nil:
+			c.Kind = MethodAdded
+			changes = append(changes, c)
+			continue
+		}
+		if t0, t1 := TypeString(m0.Param), TypeString(m1.Param); t0 != t1 {
+			c.Kind, c.Old, c.New = ParamChanged, t0, t1
+			changes = append(changes, c)
+		}
+		if t0, t1 := TypeString(m0.Result), TypeString(m1.Result); t0 != t1 {
+			c.Kind, c.Old, c.New = ResultChanged, t0, t1
+			changes = append(changes, c)
+		}
+		if p0, p1 := permissionsString(m0.Permissions), permissionsString(m1.Permissions); p0 != p1 {
+			c.Kind, c.Old, c.New = PermissionsChanged, p0, p1
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+func diffTypes(old, new *Info) []Change {
+	oldTypes, newTypes := typeMap(old), typeMap(new)
+	names := make(map[string]bool)
+	for name := range oldTypes {
+		names[string(name)] = true
+	}
+	for name := range newTypes {
+		names[string(name)] = true
+	}
+	var changes []Change
+	for _, name := range sortedNames(names) {
+		tname := jsontypes.TypeName(name)
+		t0, t1 := oldTypes[tname], newTypes[tname]
+		switch {
+		case t1 == nil:
+			changes = append(changes, Change{Kind: TypeRemoved, Type: tname})
+			continue
+		case t0 == nil:
+			changes = append(changes, Change{Kind: TypeAdded, Type: tname})
+			continue
+		}
+		changes = append(changes, diffFields(tname, t0, t1)...)
+	}
+	return changes
+}
+
+// diffFields returns the changes to the fields of t0 needed
+// to get to t1, reported as changes to the type tname.
+func diffFields(tname jsontypes.TypeName, t0, t1 *jsontypes.Type) []Change {
+	var changes []Change
+	for _, f0 := range t0.Fields {
+		f1 := t1.FieldByName(f0.Name)
+		if f1 == nil {
+			changes = append(changes, Change{Kind: FieldRemoved, Type: tname, Field: f0.Name})
+			continue
+		}
+		if s0, s1 := TypeString(f0.Type), TypeString(f1.Type); s0 != s1 {
+			changes = append(changes, Change{
+				Kind:  FieldTypeChanged,
+				Type:  tname,
+				Field: f0.Name,
+				Old:   s0,
+				New:   s1,
+			})
+		}
+	}
+	for _, f1 := range t1.Fields {
+		if t0.FieldByName(f1.Name) == nil {
+			changes = append(changes, Change{Kind: FieldAdded, Type: tname, Field: f1.Name})
+		}
+	}
+	return changes
+}
+
+func diffErrorCodes(old, new *Info) []Change {
+	codes := make(map[string]int)
+	for _, c := range old.ErrorCodes {
+		codes[c.Code] |= 1
+	}
+	for _, c := range new.ErrorCodes {
+		codes[c.Code] |= 2
+	}
+	var changes []Change
+	for _, code := range sortedKeys(codes) {
+		switch codes[code] {
+		case 1:
+			changes = append(changes, Change{Kind: ErrorCodeRemoved, ErrorCode: code})
+		case 2:
+			changes = append(changes, Change{Kind: ErrorCodeAdded, ErrorCode: code})
+		}
+	}
+	return changes
+}
+
+func facadeMap(info *Info) map[facadeKey]*FacadeInfo {
+	m := make(map[facadeKey]*FacadeInfo)
+	for i := range info.Facades {
+		f := &info.Facades[i]
+		m[facadeKey{f.Name, f.Version}] = f
+	}
+	return m
+}
+
+func sortedFacadeKeys(ms ...map[facadeKey]*FacadeInfo) []facadeKey {
+	seen := make(map[facadeKey]bool)
+	var keys []facadeKey
+	for _, m := range ms {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		return keys[i].version < keys[j].version
+	})
+	return keys
+}
+
+func typeMap(info *Info) map[jsontypes.TypeName]*jsontypes.Type {
+	if info.TypeInfo == nil {
+		return nil
+	}
+	return info.TypeInfo.Types
+}
+
+func sortedNames(m map[string]bool) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedJoin(ss []string) string {
+	ss = append([]string(nil), ss...)
+	sort.Strings(ss)
+	return strings.Join(ss, ",")
+}
@@ -0,0 +1,260 @@
+package apidoc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+var diffTests = []struct {
+	about  string
+	old    *Info
+	new    *Info
+	expect []string
+}{{
+	about: "no changes",
+	old: &Info{
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+		}},
+	},
+	new: &Info{
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+		}},
+	},
+}, {
+	about: "facades added and removed",
+	old: &Info{
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+		}},
+	},
+	new: &Info{
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 2,
+		}},
+	},
+	expect: []string{
+		"Client(1): facade-removed",
+		"Client(2): facade-added",
+	},
+}, {
+	about: "availability compared regardless of order",
+	old: &Info{
+		Facades: []FacadeInfo{{
+			Name:        "Client",
+			Version:     1,
+			AvailableTo: []string{"model-user", "controller-user"},
+		}, {
+			Name:        "Pinger",
+			Version:     1,
+			AvailableTo: []string{"model-user", "machine-agent"},
+		}},
+	},
+	new: &Info{
+		Facades: []FacadeInfo{{
+			Name:        "Client",
+			Version:     1,
+			AvailableTo: []string{"controller-user", "model-user"},
+		}, {
+			Name:        "Pinger",
+			Version:     1,
+			AvailableTo: []string{"machine-agent"},
+		}},
+	},
+	expect: []string{
+		"Pinger(1): availability-changed (machine-agent,model-user -> machine-agent)",
+	},
+}, {
+	about: "methods added, removed and changed",
+	old: &Info{
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+			Methods: []Method{{
+				Name: "Close",
+			}, {
+				Name:   "Status",
+				Param:  &jsontypes.Type{Kind: jsontypes.String},
+				Result: &jsontypes.Type{Kind: jsontypes.String},
+			}},
+		}},
+	},
+	new: &Info{
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+			Methods: []Method{{
+				Name: "Open",
+			}, {
+				Name:   "Status",
+				Param:  &jsontypes.Type{Kind: jsontypes.Int},
+				Result: &jsontypes.Type{Kind: jsontypes.Slice, Elem: &jsontypes.Type{Kind: jsontypes.String}},
+			}},
+		}},
+	},
+	expect: []string{
+		"Client(1).Close: method-removed",
+		"Client(1).Open: method-added",
+		"Client(1).Status: param-changed (string -> int)",
+		"Client(1).Status: result-changed (string -> []string)",
+	},
+}, {
+	about: "permissions changed",
+	old: &Info{
+		Facades: []FacadeInfo{{
+			Name:    "ModelManager",
+			Version: 9,
+			Methods: []Method{{
+				Name: "DestroyModels",
+				Permissions: []PermissionRequirement{{
+					EntityKind: PermissionEntityUser,
+					Level:      LevelAdmin,
+					Scope:      ScopeModel,
+					Check:      "api.authorizer.HasPermission(permission.AdminAccess, api.modelTag)",
+				}},
+			}, {
+				Name: "ListModels",
+				Permissions: []PermissionRequirement{{
+					EntityKind: PermissionEntityUser,
+					Level:      LevelSuperuser,
+					Scope:      ScopeController,
+				}, {
+					EntityKind: PermissionEntityUser,
+					Level:      LevelAdmin,
+					Scope:      ScopeModel,
+				}},
+			}},
+		}},
+	},
+	new: &Info{
+		Facades: []FacadeInfo{{
+			Name:    "ModelManager",
+			Version: 9,
+			Methods: []Method{{
+				Name: "DestroyModels",
+				Permissions: []PermissionRequirement{{
+					EntityKind: PermissionEntityUser,
+					Level:      LevelSuperuser,
+					Scope:      ScopeController,
+				}},
+			}, {
+				// Only the order and the checks differ,
+				// which isn't a change.
+				Name: "ListModels",
+				Permissions: []PermissionRequirement{{
+					EntityKind: PermissionEntityUser,
+					Level:      LevelAdmin,
+					Scope:      ScopeModel,
+					Check:      "api.check.ChangeAllowed()",
+				}, {
+					EntityKind: PermissionEntityUser,
+					Level:      LevelSuperuser,
+					Scope:      ScopeController,
+				}},
+			}},
+		}},
+	},
+	expect: []string{
+		"ModelManager(9).DestroyModels: permissions-changed (user:model:admin -> user:controller:superuser)",
+	},
+}, {
+	about: "types and fields changed",
+	old: &Info{
+		TypeInfo: &jsontypes.Info{
+			Types: map[jsontypes.TypeName]*jsontypes.Type{
+				statusParams: {
+					Name: statusParams,
+					Kind: jsontypes.Struct,
+					Fields: []*jsontypes.Field{{
+						Name: "Patterns",
+						Type: &jsontypes.Type{Kind: jsontypes.Slice, Elem: &jsontypes.Type{Kind: jsontypes.String}},
+					}, {
+						Name: "Verbose",
+						Type: &jsontypes.Type{Kind: jsontypes.Bool},
+					}},
+				},
+				entity: {
+					Name: entity,
+					Kind: jsontypes.Struct,
+				},
+			},
+		},
+	},
+	new: &Info{
+		TypeInfo: &jsontypes.Info{
+			Types: map[jsontypes.TypeName]*jsontypes.Type{
+				statusParams: {
+					Name: statusParams,
+					Kind: jsontypes.Struct,
+					Fields: []*jsontypes.Field{{
+						Name: "Patterns",
+						Type: &jsontypes.Type{Kind: jsontypes.String},
+					}, {
+						Name: "IncludeStorage",
+						Type: &jsontypes.Type{Kind: jsontypes.Bool},
+					}},
+				},
+				fullStatusParams: {
+					Name: fullStatusParams,
+					Kind: jsontypes.Struct,
+				},
+			},
+		},
+	},
+	expect: []string{
+		"github.com/juju/juju/rpc/params#Entity: type-removed",
+		"github.com/juju/juju/rpc/params#FullStatusParams: type-added",
+		"github.com/juju/juju/rpc/params#StatusParams.Patterns: field-type-changed ([]string -> string)",
+		"github.com/juju/juju/rpc/params#StatusParams.Verbose: field-removed",
+		"github.com/juju/juju/rpc/params#StatusParams.IncludeStorage: field-added",
+	},
+}, {
+	about: "error codes added and removed",
+	old: &Info{
+		ErrorCodes: []ErrorCode{{
+			Name: "CodeNotFound",
+			Code: "not found",
+		}, {
+			Name: "CodeUnauthorized",
+			Code: "unauthorized access",
+		}},
+	},
+	new: &Info{
+		ErrorCodes: []ErrorCode{{
+			Name: "CodeBadRequest",
+			Code: "bad request",
+		}, {
+			Name: "CodeNotFound",
+			Code: "not found",
+		}},
+	},
+	expect: []string{
+		"bad request: error-code-added",
+		"unauthorized access: error-code-removed",
+	},
+}}
+
+func TestDiff(t *testing.T) {
+	for _, test := range diffTests {
+		t.Run(test.about, func(t *testing.T) {
+			before := mustMarshal(t, []*Info{test.old, test.new})
+			var changes []string
+			for _, c := range Diff(test.old, test.new) {
+				changes = append(changes, c.String())
+			}
+			if !reflect.DeepEqual(changes, test.expect) {
+				t.Errorf("unexpected changes\ngot    %#v\nexpect %#v", changes, test.expect)
+			}
+			if after := mustMarshal(t, []*Info{test.old, test.new}); after != before {
+				t.Errorf("inputs changed\nbefore %s\nafter  %s", before, after)
+			}
+		})
+	}
+}
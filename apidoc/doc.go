@@ -2,13 +2,223 @@
 package apidoc
 
 import (
+	"time"
+
 	"github.com/rogpeppe/apicompat/jsontypes"
 )
 
 // Info holds information on the Juju RPC-based API.
 type Info struct {
-	TypeInfo *jsontypes.Info
-	Facades  []FacadeInfo
+	// SchemaVersion holds the version of the document format.
+	// See CurrentSchemaVersion.
+	SchemaVersion int
+
+	// Meta records how the document was generated.
+	// It is nil for documents generated before it
+	// was added, and for merged documents.
+	Meta *Meta `json:",omitempty"`
+
+	// Delta is set when the document holds only the facades
+	// that have changed since a baseline document.
+	// See ChangedSince.
+	Delta *Delta `json:",omitempty"`
+
+	TypeInfo   *jsontypes.Info
+	Facades    []FacadeInfo
+	ErrorCodes []ErrorCode `json:",omitempty"`
+	Warnings   []Warning   `json:",omitempty"`
+
+	// SentinelErrors holds the package-level error values
+	// that facade methods can return, referred to by the
+	// Errors field of each method.
+	SentinelErrors []SentinelError `json:",omitempty"`
+
+	// Operational holds the settings in the API server source
+	// that govern how connections behave, such as the login
+	// rate limit and the interval between pings.
+	Operational []OperationalSetting `json:",omitempty"`
+
+	// AuditExcluded holds the methods, as Facade.Method, that
+	// the API server leaves out of the audit log by default
+	// because they're read-only. The list applies to every
+	// version of a facade, and may name methods that no
+	// version has. See Method.AuditExcluded.
+	AuditExcluded []string `json:",omitempty"`
+
+	// FactoryPanics records the panics raised by facade
+	// factories when determining who can use each facade.
+	FactoryPanics []FactoryPanic `json:",omitempty"`
+
+	// FacadeErrors records the facades that could not be
+	// documented. They are missing from Facades, so a
+	// document with any is incomplete.
+	FacadeErrors []FacadeError `json:",omitempty"`
+
+	// Advertised holds the facade versions that the API server
+	// lists in its Login response, taken from its facade registry,
+	// so that clients can be checked against a server version
+	// without connecting to it.
+	Advertised *AdvertisedFacades `json:",omitempty"`
+
+	// Negotiation holds the outcome of facade version negotiation
+	// for a range of clients. It is derived from Facades;
+	// see NegotiationTable.
+	Negotiation []FacadeNegotiation `json:",omitempty"`
+
+	// TypeFamilies holds the families of types that have been
+	// revised by adding a version suffix to their names. It is
+	// derived from TypeInfo and Facades; see Info.FindTypeFamilies.
+	TypeFamilies []TypeFamily `json:",omitempty"`
+
+	// Fields holds information on the fields of types in
+	// TypeInfo that isn't held in the types themselves,
+	// such as whether a value must be given and how
+	// values are validated.
+	Fields []FieldInfo `json:",omitempty"`
+}
+
+// Meta holds information on the generation of a document,
+// so that its origin can be traced and the document
+// generated again.
+type Meta struct {
+	// Module holds the path of the juju module
+	// that the document describes.
+	Module string
+
+	// Version holds the version of Module,
+	// as resolved by the go command.
+	Version string
+
+	// Commit holds the hash of the commit
+	// of Version, if known.
+	Commit string `json:",omitempty"`
+
+	// Generated holds the time that the document was
+	// generated, or the time in $SOURCE_DATE_EPOCH
+	// if that was set.
+	Generated time.Time
+
+	// ToolVersion holds the version of jujuapidoc
+	// that generated the document, if known.
+	ToolVersion string `json:",omitempty"`
+
+	// ToolCommit holds the hash of the commit that
+	// jujuapidoc was built from, if known.
+	ToolCommit string `json:",omitempty"`
+
+	// GeneratorHash holds the hash of the doc generator
+	// source embedded in jujuapidoc, which identifies the
+	// generator even when the tool's version doesn't.
+	GeneratorHash string `json:",omitempty"`
+
+	// GoVersion holds the version of Go that
+	// the doc generator was built with.
+	GoVersion string
+}
+
+// FactoryPanic records a panic raised by a facade factory
+// when it was called on behalf of a particular kind of entity.
+// The facade is then assumed to be available to that kind
+// of entity, so its AvailableTo field is guesswork.
+type FactoryPanic struct {
+	Facade     string
+	Version    int
+	EntityKind string
+	Message    string
+}
+
+// FacadeError records an error that stopped a facade
+// from being documented.
+type FacadeError struct {
+	Facade  string
+	Version int
+	Message string
+}
+
+// Warning holds a problem found when generating the documentation.
+type Warning struct {
+	// Kind classifies the problem, for example "round-trip",
+	// "unserializable-field", "platform-conditional" or
+	// "result-order".
+	Kind string
+
+	// Facade, Version and Method identify the facade
+	// method that the problem was found in, if any.
+	Facade  string `json:",omitempty"`
+	Version int    `json:",omitempty"`
+	Method  string `json:",omitempty"`
+
+	// Type holds the type that the problem was found in, if any.
+	Type jsontypes.TypeName `json:",omitempty"`
+
+	// Field holds the field of Type that the problem was
+	// found in, if any.
+	Field string `json:",omitempty"`
+
+	Message string
+}
+
+// ErrorCode holds information on an error code that can
+// be returned in the Code field of an RPC error.
+type ErrorCode struct {
+	Name string
+	Code string
+	Doc  string `json:",omitempty"`
+}
+
+// SentinelError holds information on a package-level error
+// variable, such as ErrPerm, that facade methods can return.
+type SentinelError struct {
+	// Name holds the name of the variable qualified by
+	// the path of its package, as in
+	// "github.com/juju/juju/apiserver/errors.ErrPerm".
+	Name string
+
+	Doc string `json:",omitempty"`
+
+	// Message holds the message of the error, which is
+	// sent as the message of the error on the wire,
+	// if it could be determined.
+	Message string `json:",omitempty"`
+
+	// Code holds the error code that the error is
+	// sent with, if it has one.
+	Code string `json:",omitempty"`
+}
+
+// Categories of OperationalSetting.
+const (
+	// OperationalConnection is for settings that limit
+	// the rate or number of connections.
+	OperationalConnection = "connection"
+
+	// OperationalLogin is for settings that limit
+	// the rate of logins.
+	OperationalLogin = "login"
+
+	// OperationalKeepalive is for settings that govern
+	// the pings that keep a connection alive.
+	OperationalKeepalive = "keepalive"
+)
+
+// OperationalSetting holds a constant in the API server source
+// that governs how connections behave, which client implementers
+// need to know about but which isn't part of any facade.
+type OperationalSetting struct {
+	// Category holds the kind of behavior that
+	// the setting governs; see OperationalConnection
+	// and the constants that follow it.
+	Category string
+
+	// Name holds the name of the constant qualified
+	// by the path of its package.
+	Name string
+
+	// Value holds the value of the constant, with
+	// durations formatted as by time.Duration.String.
+	Value string
+
+	Doc string `json:",omitempty"`
 }
 
 // FacadeInfo holds information on a particular
@@ -19,6 +229,76 @@ type FacadeInfo struct {
 	Doc         string `json:",omitempty"`
 	Methods     []Method
 	AvailableTo []string `json:",omitempty"`
+
+	// Audiences holds the audiences that the facade is
+	// intended for, derived from AvailableTo. See AudiencesOf.
+	Audiences []string `json:",omitempty"`
+
+	// LoginTarget holds the endpoints that the facade can be
+	// used on, if known.
+	LoginTarget *LoginTarget `json:",omitempty"`
+
+	// TestOnly holds whether the facade exists only for testing,
+	// being implemented or registered by test support code or
+	// registered only when a testing feature flag is enabled, so
+	// that production controllers don't serve it. TestOnlyReason
+	// explains why.
+	TestOnly       bool   `json:",omitempty"`
+	TestOnlyReason string `json:",omitempty"`
+
+	// Requires holds the features that the facade depends on,
+	// such as the feature flag that it's registered under.
+	// It's only recorded for secrets facades.
+	Requires []Requirement `json:",omitempty"`
+
+	// Tags holds the subsystems that the facade belongs to,
+	// such as "storage" or "networking", followed by the
+	// audiences that it's intended for, such as "client",
+	// and any tags added by overrides. See FacadeTags and
+	// OverrideTags.
+	Tags []string `json:",omitempty"`
+
+	// Releases holds the Juju releases that have the facade
+	// version, if known. See AddReleaseRanges.
+	Releases *ReleaseRange `json:",omitempty"`
+
+	// Leases holds the durations that govern the leases
+	// granted by a leadership or singular facade, such as
+	// the longest claim allowed, as found in its source.
+	Leases []LeaseParameter `json:",omitempty"`
+
+	// Source holds where the facade's type is declared,
+	// if that's in the juju module.
+	Source *SourcePos `json:",omitempty"`
+
+	// Quickstart holds programs that connect to a controller
+	// and call one of the facade's methods with each client
+	// library. See Info.Quickstart.
+	Quickstart *Quickstart `json:",omitempty"`
+}
+
+// LeaseParameter holds a duration that governs the leases
+// granted by a facade that claims leadership or singular
+// control, such as LeadershipService or Singular.
+type LeaseParameter struct {
+	// Name holds the name of the constant holding the
+	// duration, qualified by the path of its package, or
+	// is empty if the duration is given as a literal or
+	// by a constant from outside Juju, such as time.Minute.
+	Name string `json:",omitempty"`
+
+	// Value holds the duration, formatted
+	// as by time.Duration.String.
+	Value string
+
+	// Method and Check hold the method that compares a
+	// duration against the value and the source of the
+	// comparison, such as "claim.Duration > time.Minute",
+	// or are empty if the method isn't known.
+	Method string `json:",omitempty"`
+	Check  string `json:",omitempty"`
+
+	Doc string `json:",omitempty"`
 }
 
 // Methods holds information on an RPC method implemented
@@ -28,4 +308,191 @@ type Method struct {
 	Doc    string          `json:",omitempty"`
 	Param  *jsontypes.Type `json:",omitempty"`
 	Result *jsontypes.Type `json:",omitempty"`
+
+	// PerItemErrors holds whether the result holds a separate
+	// error for each item, following the params.ErrorResult
+	// convention. If so, a call that succeeds may still have
+	// failed for some or all of its items. See HasPerItemErrors.
+	PerItemErrors bool `json:",omitempty"`
+
+	// Retry holds a heuristic classification of whether
+	// the method is safe to retry, if known.
+	Retry *RetryClass `json:",omitempty"`
+
+	// Superuser holds the check that the method makes for
+	// controller superuser access, if it appears to require it,
+	// so that admins of the model alone cannot call it.
+	Superuser *SuperuserCheck `json:",omitempty"`
+
+	// Permissions holds the access levels that the method
+	// checks its caller for, in the order found. See
+	// PermissionRequirement.
+	Permissions []PermissionRequirement `json:",omitempty"`
+
+	// Examples holds examples of calls to the method, curated
+	// ones first, followed by one with values synthesized from
+	// its schemas. See MethodExample.
+	Examples []MethodExample `json:",omitempty"`
+
+	// Requires holds the features that the method appears to
+	// depend on, such as the type of secret backend or model,
+	// as found in the conditions that its code checks. It's
+	// only recorded for the methods of secrets facades.
+	Requires []Requirement `json:",omitempty"`
+
+	// ResultOrder holds how the results of a bulk method
+	// correspond to its params. It is nil for methods that
+	// don't take a list of items and return a list of results.
+	ResultOrder *ResultOrder `json:",omitempty"`
+
+	// Releases holds the Juju releases that have the
+	// method, if known. See AddReleaseRanges.
+	Releases *ReleaseRange `json:",omitempty"`
+
+	// Errors holds the names of the entries in
+	// Info.SentinelErrors that the method can return,
+	// as found by following its code, in sorted order.
+	Errors []string `json:",omitempty"`
+
+	// Invocation holds how a client uses the method:
+	// InvocationUnary or InvocationWatcher. It is empty
+	// if not known. See Info.Invocation.
+	Invocation string `json:",omitempty"`
+
+	// Usage holds how often the method was called on a
+	// controller, if known. See AddUsage.
+	Usage *MethodUsage `json:",omitempty"`
+
+	// AuditExcluded holds whether calls to the method are left
+	// out of the audit log by default, so that they leave
+	// no audit trail. See Info.AuditExcluded.
+	AuditExcluded bool `json:",omitempty"`
+
+	// Sensitive holds whether the method's payloads may hold
+	// secrets, such as passwords or cloud credentials, and so
+	// shouldn't be logged. SensitiveReason explains why.
+	// See Info.Sensitivity.
+	Sensitive       bool   `json:",omitempty"`
+	SensitiveReason string `json:",omitempty"`
+
+	// Source holds where the method is declared, if that's in
+	// the juju module. A method promoted from an embedded type
+	// is declared with that type, perhaps in another package.
+	Source *SourcePos `json:",omitempty"`
+}
+
+// Values of Method.Invocation.
+const (
+	// InvocationUnary is for a method that's called once
+	// for each result.
+	InvocationUnary = "unary"
+
+	// InvocationWatcher is for a method that starts a
+	// watcher, returning its id, or that is called on a
+	// watcher facade, such as NotifyWatcher.Next, which is
+	// called repeatedly until it returns changes.
+	InvocationWatcher = "watcher"
+)
+
+// ResultOrder describes how the results of a bulk method, one that
+// takes a list of items and returns a list of results, correspond
+// to the items. By convention, the results are in the same order
+// as the items, with one result for each item, but nothing enforces
+// this.
+type ResultOrder struct {
+	// ByPosition holds whether each result is for the
+	// item in the same position in the params.
+	ByPosition bool
+
+	// Confidence holds how confident the classification
+	// is: "high", "medium" or "low".
+	Confidence string
+
+	// Reason explains the classification.
+	Reason string
+}
+
+// RetryClass classifies whether a method is safe to call again,
+// for example after a connection failure, when it's not known
+// whether the first call took effect.
+type RetryClass struct {
+	// Safe holds whether calling the method again has the
+	// same effect as calling it once.
+	Safe bool
+
+	// Confidence holds how confident the classification
+	// is: "high", "medium" or "low".
+	Confidence string
+
+	// Reason explains the classification.
+	Reason string
+}
+
+// SuperuserCheck records how a method checks that the caller
+// has superuser access to the controller. The check is found
+// heuristically by following the method's code.
+type SuperuserCheck struct {
+	// Check holds the source of the call that makes the
+	// check, such as
+	// "api.authorizer.HasPermission(permission.SuperuserAccess, api.controllerTag)".
+	Check string
+}
+
+// FieldInfo holds information on a struct field of a type in
+// Info.TypeInfo that is derived from the code that uses it.
+type FieldInfo struct {
+	// Type holds the type that the field belongs to.
+	Type jsontypes.TypeName
+
+	// Field holds the Go name of the field, as found
+	// in jsontypes.Field.Name.
+	Field string
+
+	// Presence holds whether a value must be given for the
+	// field, "required" or "optional", and PresenceReason
+	// explains why. See Info.FieldPresence.
+	Presence       string `json:",omitempty"`
+	PresenceReason string `json:",omitempty"`
+
+	// TagKinds holds the kinds of entity tag that the field
+	// is parsed as, such as "unit" or "machine", when it is
+	// a string holding a tag. See TagDescription.
+	TagKinds []string `json:",omitempty"`
+
+	// Constraints holds the validation that facade methods
+	// apply to the field's value. A client can check a value
+	// against them before sending it, but the list is found
+	// heuristically and may be incomplete.
+	Constraints []Constraint `json:",omitempty"`
+}
+
+// Constraint describes a check made on the value of a field.
+type Constraint struct {
+	// Kind classifies the check: "name" for a value that must
+	// be a valid entity name, "tag" for one that must be a
+	// valid entity tag, "pattern" for one that must match a
+	// regular expression, or "enum" for one that must be one
+	// of a fixed set of values.
+	Kind string
+
+	// Description describes the check in words,
+	// for example "a valid unit name".
+	Description string
+
+	// Pattern holds the regular expression that the value
+	// must match, in Go syntax, for "pattern" constraints.
+	Pattern string `json:",omitempty"`
+
+	// Values holds the allowed values for "enum" constraints.
+	Values []string `json:",omitempty"`
+
+	// Methods holds the facade methods that make the check.
+	Methods []MethodRef
+}
+
+// MethodRef identifies a method of a particular version of a facade.
+type MethodRef struct {
+	Facade  string
+	Version int
+	Method  string
 }
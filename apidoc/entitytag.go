@@ -0,0 +1,41 @@
+package apidoc
+
+import (
+	"strings"
+)
+
+// tagExamples holds an example tag for each
+// of the common kinds of entity tag.
+var tagExamples = map[string]string{
+	"action":      "action-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	"application": "application-mysql",
+	"cloud":       "cloud-aws",
+	"cloudcred":   "cloudcred-aws_admin_default",
+	"controller":  "controller-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	"filesystem":  "filesystem-0-0",
+	"machine":     "machine-0",
+	"model":       "model-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	"relation":    "relation-wordpress.db#mysql.server",
+	"space":       "space-0",
+	"storage":     "storage-data-0",
+	"unit":        "unit-mysql-0",
+	"user":        "user-admin",
+	"volume":      "volume-0",
+}
+
+// TagDescription returns a description of the values of a field
+// holding an entity tag of one of the given kinds, for example
+// "unit tag, e.g. unit-mysql-0". It returns the empty string
+// if there are no kinds.
+func TagDescription(kinds []string) string {
+	if len(kinds) == 0 {
+		return ""
+	}
+	desc := strings.Join(kinds, " or ") + " tag"
+	for _, kind := range kinds {
+		if example, ok := tagExamples[kind]; ok {
+			return desc + ", e.g. " + example
+		}
+	}
+	return desc
+}
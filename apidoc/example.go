@@ -0,0 +1,115 @@
+package apidoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// defaultAdminVersion holds the version of the Admin facade
+// used to log in when the document doesn't include it.
+const defaultAdminVersion = 3
+
+// Example holds the messages exchanged when calling a method
+// over a new websocket connection to the Juju API server.
+type Example struct {
+	Facade  string
+	Version int
+	Method  string
+
+	// Login holds the login request, which must
+	// be sent first on every connection.
+	Login RequestFrame
+
+	// Request holds the request for the method,
+	// with sample parameters.
+	Request RequestFrame
+
+	// Response holds the shape of the expected response,
+	// with sample values.
+	Response ResponseFrame
+}
+
+// Examples returns an example for every method in info.
+// The login request uses the placeholders $JUJU_USER and
+// $JUJU_PASSWORD for the credentials.
+func (info *Info) Examples() []Example {
+	defs := info.SchemaDefinitions()
+	adminVersion := defaultAdminVersion
+	if vs := info.FacadeVersions()["Admin"]; len(vs) > 0 {
+		adminVersion = vs[len(vs)-1]
+	}
+	var examples []Example
+	for _, f := range info.Facades {
+		for _, m := range f.Methods {
+			e := Example{
+				Facade:  f.Name,
+				Version: f.Version,
+				Method:  m.Name,
+				Login: RequestFrame{
+					RequestId: 1,
+					Type:      "Admin",
+					Version:   adminVersion,
+					Request:   "Login",
+					Params: map[string]interface{}{
+						"auth-tag":    "user-$JUJU_USER",
+						"credentials": "$JUJU_PASSWORD",
+					},
+				},
+				Request: RequestFrame{
+					RequestId: 2,
+					Type:      f.Name,
+					Version:   f.Version,
+					Request:   m.Name,
+				},
+				Response: ResponseFrame{
+					RequestId: 2,
+				},
+			}
+			if m.Param != nil {
+				e.Request.Params = info.Schema(m.Param).Sample(defs)
+			}
+			if m.Result != nil {
+				data, err := json.Marshal(info.Schema(m.Result).Sample(defs))
+				if err == nil {
+					e.Response.Response = data
+				}
+			}
+			examples = append(examples, e)
+		}
+	}
+	return examples
+}
+
+// Script returns a shell script that makes the call in the
+// example using wscat. The credentials, the controller address
+// and the model UUID are taken from environment variables.
+func (e *Example) Script() string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "#!/bin/sh\n")
+	fmt.Fprintf(&buf, "# Call %s(%d).%s on a Juju controller with wscat.\n", e.Facade, e.Version, e.Method)
+	fmt.Fprintf(&buf, "# Set JUJU_CONTROLLER (host:port), JUJU_MODEL_UUID,\n")
+	fmt.Fprintf(&buf, "# JUJU_USER and JUJU_PASSWORD before running it.\n")
+	fmt.Fprintf(&buf, "#\n")
+	fmt.Fprintf(&buf, "# The response should look like this:\n")
+	fmt.Fprintf(&buf, "#\t%s\n", FrameJSON(e.Response))
+	fmt.Fprintf(&buf, "set -e\n")
+	fmt.Fprintf(&buf, "{\n")
+	for _, frame := range []RequestFrame{e.Login, e.Request} {
+		fmt.Fprintf(&buf, "\tcat <<EOF\n%s\nEOF\n", FrameJSON(frame))
+		// Wait for the response before sending the next
+		// frame or closing the connection.
+		fmt.Fprintf(&buf, "\tsleep 2\n")
+	}
+	fmt.Fprintf(&buf, "} | wscat --no-check -c \"wss://$JUJU_CONTROLLER/model/$JUJU_MODEL_UUID/api\"\n")
+	return buf.String()
+}
+
+// FrameJSON returns the JSON encoding of an RPC frame on a single line.
+func FrameJSON(frame interface{}) string {
+	data, err := json.Marshal(frame)
+	if err != nil {
+		return fmt.Sprintf("cannot marshal frame: %v", err)
+	}
+	return string(data)
+}
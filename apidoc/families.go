@@ -0,0 +1,125 @@
+package apidoc
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// TypeFamily holds the revisions of a type that has been changed
+// by declaring a new type with a version suffix on its name, as
+// with params.AddApplicationUnits and params.AddApplicationUnitsV5,
+// so that older facade versions can keep using the old one.
+type TypeFamily struct {
+	// Base holds the name of the type without
+	// any version suffix. There may be no type
+	// with that name.
+	Base jsontypes.TypeName
+
+	// Revisions holds the revisions in order.
+	Revisions []TypeRevision
+}
+
+// TypeRevision holds one revision of a type in a TypeFamily.
+type TypeRevision struct {
+	Type jsontypes.TypeName
+
+	// Revision holds the version suffix of the name,
+	// or zero for the type without a suffix.
+	Revision int
+
+	// UsedBy holds the facade methods whose params or
+	// results refer to the type, directly or indirectly.
+	UsedBy []MethodRef `json:",omitempty"`
+}
+
+// versionSuffixPat matches a type name with a version
+// suffix, such as AddApplicationUnitsV5.
+var versionSuffixPat = regexp.MustCompile(`^(.*[a-z])V([0-9]+)$`)
+
+// FindTypeFamilies returns the type families in info, sorted by
+// base name. A family is formed by the named types in the same
+// package that have the same name apart from a version suffix,
+// as long as there is more than one of them.
+func (info *Info) FindTypeFamilies() []TypeFamily {
+	if info.TypeInfo == nil {
+		return nil
+	}
+	byBase := make(map[jsontypes.TypeName][]TypeRevision)
+	for name := range info.TypeInfo.Types {
+		base, rev := name, 0
+		if m := versionSuffixPat.FindStringSubmatch(name.Name()); m != nil {
+			base = jsontypes.TypeName(name.PkgPath() + "#" + m[1])
+			rev, _ = strconv.Atoi(m[2])
+		}
+		byBase[base] = append(byBase[base], TypeRevision{
+			Type:     name,
+			Revision: rev,
+		})
+	}
+	var families []TypeFamily
+	users := info.typeUsers()
+	for base, revs := range byBase {
+		if len(revs) < 2 {
+			continue
+		}
+		sort.Slice(revs, func(i, j int) bool {
+			return revs[i].Revision < revs[j].Revision
+		})
+		for i := range revs {
+			revs[i].UsedBy = users[revs[i].Type]
+		}
+		families = append(families, TypeFamily{
+			Base:      base,
+			Revisions: revs,
+		})
+	}
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].Base < families[j].Base
+	})
+	return families
+}
+
+// typeUsers returns the methods that refer to each named type,
+// in facade, version and method order.
+func (info *Info) typeUsers() map[jsontypes.TypeName][]MethodRef {
+	users := make(map[jsontypes.TypeName][]MethodRef)
+	for _, f := range info.Facades {
+		for _, m := range f.Methods {
+			used := make(map[jsontypes.TypeName]bool)
+			info.addUsedTypes(used, m.Param)
+			info.addUsedTypes(used, m.Result)
+			for name := range used {
+				users[name] = append(users[name], MethodRef{f.Name, f.Version, m.Name})
+			}
+		}
+	}
+	for name, refs := range users {
+		users[name] = sortMethodRefs(refs)
+	}
+	return users
+}
+
+// TypeEvolution returns the changes between each revision of each
+// type family in info and the one before it. Each revision is
+// reported with a TypeRevised change, followed by the changes to
+// its fields, which refer to the newer revision.
+func (info *Info) TypeEvolution() []Change {
+	families := info.FindTypeFamilies()
+	var changes []Change
+	for _, fam := range families {
+		for i := 1; i < len(fam.Revisions); i++ {
+			old, new := fam.Revisions[i-1].Type, fam.Revisions[i].Type
+			changes = append(changes, Change{
+				Kind: TypeRevised,
+				Type: new,
+				Old:  string(old),
+				New:  string(new),
+			})
+			changes = append(changes, diffFields(new, info.TypeInfo.Types[old], info.TypeInfo.Types[new])...)
+		}
+	}
+	return changes
+}
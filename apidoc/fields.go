@@ -0,0 +1,148 @@
+package apidoc
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// Field returns the information recorded for the given field
+// of the given type, or nil if there is none.
+func (info *Info) Field(t jsontypes.TypeName, field string) *FieldInfo {
+	for i := range info.Fields {
+		f := &info.Fields[i]
+		if f.Type == t && f.Field == field {
+			return f
+		}
+	}
+	return nil
+}
+
+// combineFields returns fs with the entries for each field
+// combined into one, and constraints that differ only in
+// the methods that make them combined likewise. Tag kinds
+// are combined into a sorted list. When entries
+// disagree on presence, Required wins over Optional, as a
+// value that is given is never wrong. The result
+// is sorted by type and field name, the constraints of each
+// field by kind and description, and their methods by facade,
+// version and method name.
+func combineFields(fs []FieldInfo) []FieldInfo {
+	type fieldKey struct {
+		t     jsontypes.TypeName
+		field string
+	}
+	type constraintKey struct {
+		kind, description, pattern, values string
+	}
+	var combined []FieldInfo
+	fieldIndex := make(map[fieldKey]int)
+	constraintIndex := make(map[fieldKey]map[constraintKey]int)
+	for _, f := range fs {
+		fkey := fieldKey{f.Type, f.Field}
+		i, ok := fieldIndex[fkey]
+		if !ok {
+			i = len(combined)
+			fieldIndex[fkey] = i
+			constraintIndex[fkey] = make(map[constraintKey]int)
+			combined = append(combined, FieldInfo{
+				Type:  f.Type,
+				Field: f.Field,
+			})
+		}
+		cf := &combined[i]
+		if presenceLess(cf, &f) {
+			cf.Presence, cf.PresenceReason = f.Presence, f.PresenceReason
+		}
+		cf.TagKinds = append(cf.TagKinds, f.TagKinds...)
+		for _, c := range f.Constraints {
+			ckey := constraintKey{c.Kind, c.Description, c.Pattern, strings.Join(c.Values, "\x00")}
+			j, ok := constraintIndex[fkey][ckey]
+			if !ok {
+				j = len(cf.Constraints)
+				constraintIndex[fkey][ckey] = j
+				c.Methods = nil
+				cf.Constraints = append(cf.Constraints, c)
+			}
+			cc := &cf.Constraints[j]
+			cc.Methods = append(cc.Methods, c.Methods...)
+		}
+	}
+	for i := range combined {
+		combined[i].TagKinds = uniqueStrings(combined[i].TagKinds)
+		cs := combined[i].Constraints
+		for j := range cs {
+			cs[j].Methods = sortMethodRefs(cs[j].Methods)
+		}
+		sort.SliceStable(cs, func(i, j int) bool {
+			if cs[i].Kind != cs[j].Kind {
+				return cs[i].Kind < cs[j].Kind
+			}
+			return cs[i].Description < cs[j].Description
+		})
+	}
+	sort.SliceStable(combined, func(i, j int) bool {
+		f1, f2 := &combined[i], &combined[j]
+		if f1.Type != f2.Type {
+			return f1.Type < f2.Type
+		}
+		return f1.Field < f2.Field
+	})
+	return combined
+}
+
+// presenceLess reports whether the presence of f1 should be
+// replaced by that of f2 when they're combined.
+func presenceLess(f1, f2 *FieldInfo) bool {
+	rank := func(p string) int {
+		switch p {
+		case Required:
+			return 2
+		case Optional:
+			return 1
+		}
+		return 0
+	}
+	if r1, r2 := rank(f1.Presence), rank(f2.Presence); r1 != r2 {
+		return r1 < r2
+	}
+	return f2.Presence != "" && f2.PresenceReason < f1.PresenceReason
+}
+
+// sortMethodRefs returns ms sorted with duplicates removed.
+func sortMethodRefs(ms []MethodRef) []MethodRef {
+	sort.Slice(ms, func(i, j int) bool {
+		m1, m2 := ms[i], ms[j]
+		if m1.Facade != m2.Facade {
+			return m1.Facade < m2.Facade
+		}
+		if m1.Version != m2.Version {
+			return m1.Version < m2.Version
+		}
+		return m1.Method < m2.Method
+	})
+	result := ms[:0]
+	for i, m := range ms {
+		if i == 0 || m != ms[i-1] {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// uniqueStrings returns ss sorted with duplicates
+// removed, or nil if it's empty.
+func uniqueStrings(ss []string) []string {
+	if len(ss) == 0 {
+		return nil
+	}
+	sort.Strings(ss)
+	result := ss[:1]
+	for _, s := range ss[1:] {
+		if s != result[len(result)-1] {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,164 @@
+package apidoc
+
+import (
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// Filter returns a copy of info restricted to the facades and methods
+// for which keep returns true. For each facade, keep is first called
+// with a nil method to decide whether to keep the facade at all, and
+// then with each of its methods in turn.
+//
+// Types that are no longer reachable from the remaining methods are
+// removed from TypeInfo, as are warnings and factory panics that refer
+// to facade versions or types that have been removed; a warning with
+// no Version refers to every version of its facade. Constraints in Fields
+// are kept only for the remaining methods, and sentinel errors only
+// if a remaining method can return them. Error codes are kept,
+// as any method may return any of them, as are operational
+// settings, which apply to every connection, audit-excluded
+// methods, which apply to every facade version, and the facades
+// advertised by the server, which don't depend on what's
+// documented. If info has a negotiation table or type families,
+// they are recomputed from what remains.
+// Facade errors are kept, as there's no facade to pass to keep.
+func (info *Info) Filter(keep func(f *FacadeInfo, m *Method) bool) *Info {
+	filtered := &Info{
+		SchemaVersion: info.SchemaVersion,
+		Meta:          info.Meta,
+		Delta:         info.Delta,
+		ErrorCodes:    info.ErrorCodes,
+		Operational:   info.Operational,
+		Advertised:    info.Advertised,
+		AuditExcluded: info.AuditExcluded,
+		FacadeErrors:  info.FacadeErrors,
+	}
+	kept := make(map[facadeKey]bool)
+	keptNames := make(map[string]bool)
+	keptMethods := make(map[MethodRef]bool)
+	for i := range info.Facades {
+		f := &info.Facades[i]
+		if !keep(f, nil) {
+			continue
+		}
+		f1 := *f
+		f1.Methods = nil
+		for j := range f.Methods {
+			if keep(f, &f.Methods[j]) {
+				f1.Methods = append(f1.Methods, f.Methods[j])
+				keptMethods[MethodRef{f.Name, f.Version, f.Methods[j].Name}] = true
+			}
+		}
+		filtered.Facades = append(filtered.Facades, f1)
+		kept[facadeKey{f.Name, f.Version}] = true
+		keptNames[f.Name] = true
+	}
+	var used map[jsontypes.TypeName]bool
+	if info.TypeInfo != nil {
+		filtered.TypeInfo = jsontypes.NewInfo()
+		used = make(map[jsontypes.TypeName]bool)
+		for _, f := range filtered.Facades {
+			for _, m := range f.Methods {
+				info.addUsedTypes(used, m.Param)
+				info.addUsedTypes(used, m.Result)
+			}
+		}
+		for name := range used {
+			filtered.TypeInfo.Types[name] = info.TypeInfo.Types[name]
+		}
+	}
+	for _, w := range info.Warnings {
+		switch {
+		case w.Facade == "":
+		case w.Version == 0 && !keptNames[w.Facade]:
+			continue
+		case w.Version != 0 && !kept[facadeKey{w.Facade, w.Version}]:
+			continue
+		}
+		if w.Type != "" && used != nil && !used[w.Type] {
+			continue
+		}
+		filtered.Warnings = append(filtered.Warnings, w)
+	}
+	for _, p := range info.FactoryPanics {
+		if kept[facadeKey{p.Facade, p.Version}] {
+			filtered.FactoryPanics = append(filtered.FactoryPanics, p)
+		}
+	}
+	for _, fi := range info.Fields {
+		if used != nil && !used[fi.Type] {
+			continue
+		}
+		fi1 := fi
+		fi1.Constraints = nil
+		for _, c := range fi.Constraints {
+			var methods []MethodRef
+			for _, m := range c.Methods {
+				if keptMethods[m] {
+					methods = append(methods, m)
+				}
+			}
+			if len(methods) > 0 {
+				c.Methods = methods
+				fi1.Constraints = append(fi1.Constraints, c)
+			}
+		}
+		if fi1.Presence != "" || len(fi1.TagKinds) > 0 || len(fi1.Constraints) > 0 {
+			filtered.Fields = append(filtered.Fields, fi1)
+		}
+	}
+	errs := make(map[string]bool)
+	for _, f := range filtered.Facades {
+		for _, m := range f.Methods {
+			for _, name := range m.Errors {
+				errs[name] = true
+			}
+		}
+	}
+	for _, e := range info.SentinelErrors {
+		if errs[e.Name] {
+			filtered.SentinelErrors = append(filtered.SentinelErrors, e)
+		}
+	}
+	if info.Negotiation != nil {
+		filtered.Negotiation = filtered.NegotiationTable()
+	}
+	if info.TypeFamilies != nil {
+		filtered.TypeFamilies = filtered.FindTypeFamilies()
+	}
+	return filtered
+}
+
+// addUsedTypes adds the names of all the types in info.TypeInfo
+// that are reachable from t to used.
+func (info *Info) addUsedTypes(used map[jsontypes.TypeName]bool, t *jsontypes.Type) {
+	if t == nil {
+		return
+	}
+	if t.Name != "" {
+		if used[t.Name] {
+			return
+		}
+		dt := info.TypeInfo.Types[t.Name]
+		if dt == nil {
+			return
+		}
+		used[t.Name] = true
+		t = dt
+	}
+	info.addUsedTypes(used, t.Elem)
+	info.addUsedTypes(used, t.Key)
+	for _, f := range t.Fields {
+		info.addUsedTypes(used, f.Type)
+	}
+}
+
+// Latest returns a copy of info holding only the newest
+// version of each facade, as filtered by Filter.
+func (info *Info) Latest() *Info {
+	versions := info.FacadeVersions()
+	return info.Filter(func(f *FacadeInfo, m *Method) bool {
+		vs := versions[f.Name]
+		return f.Version == vs[len(vs)-1]
+	})
+}
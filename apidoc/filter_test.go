@@ -0,0 +1,193 @@
+package apidoc
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+const (
+	statusParams     jsontypes.TypeName = "github.com/juju/juju/rpc/params#StatusParams"
+	fullStatusParams jsontypes.TypeName = "github.com/juju/juju/rpc/params#FullStatusParams"
+	entity           jsontypes.TypeName = "github.com/juju/juju/rpc/params#Entity"
+	entities         jsontypes.TypeName = "github.com/juju/juju/rpc/params#Entities"
+)
+
+// filterTestInfo returns a new document for the Filter tests,
+// so that each test starts from one that hasn't been filtered.
+func filterTestInfo() *Info {
+	ti := jsontypes.NewInfo()
+	ti.Types[statusParams] = &jsontypes.Type{
+		Name: statusParams,
+		Kind: jsontypes.Struct,
+	}
+	ti.Types[fullStatusParams] = &jsontypes.Type{
+		Name: fullStatusParams,
+		Kind: jsontypes.Struct,
+		Fields: []*jsontypes.Field{{
+			Name: "Entity",
+			Type: &jsontypes.Type{Name: entity},
+		}},
+	}
+	ti.Types[entity] = &jsontypes.Type{
+		Name: entity,
+		Kind: jsontypes.Struct,
+	}
+	return &Info{
+		TypeInfo: ti,
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+			Methods: []Method{{
+				Name:  "Status",
+				Param: &jsontypes.Type{Name: statusParams},
+			}},
+			Tags: []string{"client"},
+		}, {
+			Name:    "Client",
+			Version: 2,
+			Methods: []Method{{
+				Name:   "FullStatus",
+				Param:  &jsontypes.Type{Name: fullStatusParams},
+				Errors: []string{"errors.ErrPerm"},
+			}, {
+				Name: "Status",
+			}},
+			Tags: []string{"client"},
+		}, {
+			Name:    "Pinger",
+			Version: 1,
+			Methods: []Method{{
+				Name: "Ping",
+			}},
+		}},
+		Warnings: []Warning{{
+			Facade:  "Client",
+			Version: 1,
+			Message: "client v1",
+		}, {
+			Facade:  "Client",
+			Message: "client",
+		}, {
+			Facade:  "Pinger",
+			Version: 1,
+			Message: "pinger v1",
+		}, {
+			Type:    statusParams,
+			Message: "status params",
+		}, {
+			Message: "global",
+		}},
+		FactoryPanics: []FactoryPanic{{
+			Facade:  "Client",
+			Version: 1,
+		}, {
+			Facade:  "Client",
+			Version: 2,
+		}},
+		SentinelErrors: []SentinelError{{
+			Name: "errors.ErrPerm",
+		}},
+		ErrorCodes: []ErrorCode{{
+			Name: "CodeNotFound",
+			Code: "not found",
+		}},
+	}
+}
+
+var filterTests = []struct {
+	about           string
+	keep            func(f *FacadeInfo, m *Method) bool
+	expectMethods   []string
+	expectTypes     []jsontypes.TypeName
+	expectWarnings  []string
+	expectPanics    []string
+	expectSentinels []string
+}{{
+	about: "everything kept",
+	keep: func(f *FacadeInfo, m *Method) bool {
+		return true
+	},
+	expectMethods:   []string{"Client(1).Status", "Client(2).FullStatus", "Client(2).Status", "Pinger(1).Ping"},
+	expectTypes:     []jsontypes.TypeName{entity, fullStatusParams, statusParams},
+	expectWarnings:  []string{"client v1", "client", "pinger v1", "status params", "global"},
+	expectPanics:    []string{"Client(1)", "Client(2)"},
+	expectSentinels: []string{"errors.ErrPerm"},
+}, {
+	about: "one facade version kept",
+	keep: func(f *FacadeInfo, m *Method) bool {
+		return f.Name == "Client" && f.Version == 2
+	},
+	expectMethods:   []string{"Client(2).FullStatus", "Client(2).Status"},
+	expectTypes:     []jsontypes.TypeName{entity, fullStatusParams},
+	expectWarnings:  []string{"client", "global"},
+	expectPanics:    []string{"Client(2)"},
+	expectSentinels: []string{"errors.ErrPerm"},
+}, {
+	about: "some methods kept",
+	keep: func(f *FacadeInfo, m *Method) bool {
+		return f.HasTag("client") && (m == nil || m.Name == "Status")
+	},
+	expectMethods:  []string{"Client(1).Status", "Client(2).Status"},
+	expectTypes:    []jsontypes.TypeName{statusParams},
+	expectWarnings: []string{"client v1", "client", "status params", "global"},
+	expectPanics:   []string{"Client(1)", "Client(2)"},
+}, {
+	about: "nothing kept",
+	keep: func(f *FacadeInfo, m *Method) bool {
+		return false
+	},
+	expectWarnings: []string{"global"},
+}}
+
+func TestFilter(t *testing.T) {
+	for _, test := range filterTests {
+		t.Run(test.about, func(t *testing.T) {
+			info := filterTestInfo()
+			before := mustMarshal(t, info)
+			filtered := info.Filter(test.keep)
+			if after := mustMarshal(t, info); after != before {
+				t.Errorf("input changed\nbefore %s\nafter  %s", before, after)
+			}
+			var methods []string
+			for _, f := range filtered.Facades {
+				for _, m := range f.Methods {
+					methods = append(methods, fmt.Sprintf("%s(%d).%s", f.Name, f.Version, m.Name))
+				}
+			}
+			var types []jsontypes.TypeName
+			for name := range filtered.TypeInfo.Types {
+				types = append(types, name)
+			}
+			sort.Slice(types, func(i, j int) bool {
+				return types[i] < types[j]
+			})
+			var warnings []string
+			for _, w := range filtered.Warnings {
+				warnings = append(warnings, w.Message)
+			}
+			var panics []string
+			for _, p := range filtered.FactoryPanics {
+				panics = append(panics, fmt.Sprintf("%s(%d)", p.Facade, p.Version))
+			}
+			var sentinels []string
+			for _, e := range filtered.SentinelErrors {
+				sentinels = append(sentinels, e.Name)
+			}
+			check := func(what string, got, expect interface{}) {
+				if !reflect.DeepEqual(got, expect) {
+					t.Errorf("unexpected %s\ngot    %#v\nexpect %#v", what, got, expect)
+				}
+			}
+			check("methods", methods, test.expectMethods)
+			check("types", types, test.expectTypes)
+			check("warnings", warnings, test.expectWarnings)
+			check("factory panics", panics, test.expectPanics)
+			check("sentinel errors", sentinels, test.expectSentinels)
+			check("error codes", filtered.ErrorCodes, info.ErrorCodes)
+		})
+	}
+}
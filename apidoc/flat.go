@@ -0,0 +1,81 @@
+package apidoc
+
+import (
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// FlatMethod holds a single method of a facade version with
+// everything needed to describe it, so that a list of them
+// can be queried without following references.
+type FlatMethod struct {
+	Facade      string
+	Version     int
+	Method      string
+	Doc         string   `json:",omitempty"`
+	AvailableTo []string `json:",omitempty"`
+	Params      *Schema  `json:",omitempty"`
+	Result      *Schema  `json:",omitempty"`
+}
+
+// Flatten returns a record for every method of every facade
+// in info. The schemas for the params and result have all
+// their references to named types replaced by the schemas
+// of those types, with the title set to the DefinitionName
+// of the type. A reference from a type to itself, directly
+// or indirectly, is left as a schema holding only the title.
+func (info *Info) Flatten() []FlatMethod {
+	defs := info.SchemaDefinitions()
+	var methods []FlatMethod
+	for _, f := range info.Facades {
+		for _, m := range f.Methods {
+			methods = append(methods, FlatMethod{
+				Facade:      f.Name,
+				Version:     f.Version,
+				Method:      m.Name,
+				Doc:         m.Doc,
+				AvailableTo: f.AvailableTo,
+				Params:      info.InlineSchema(defs, m.Param),
+				Result:      info.InlineSchema(defs, m.Result),
+			})
+		}
+	}
+	return methods
+}
+
+// InlineSchema returns the schema for t with all references
+// into defs expanded, as described for Flatten.
+func (info *Info) InlineSchema(defs map[string]*Schema, t *jsontypes.Type) *Schema {
+	return inlineSchema(defs, info.Schema(t), make(map[string]bool))
+}
+
+func inlineSchema(defs map[string]*Schema, s *Schema, expanding map[string]bool) *Schema {
+	if s == nil {
+		return nil
+	}
+	if s.Ref != "" {
+		name := strings.TrimPrefix(s.Ref, "#/definitions/")
+		def := defs[name]
+		if def == nil || expanding[name] {
+			return &Schema{
+				Title: name,
+			}
+		}
+		expanding[name] = true
+		defer delete(expanding, name)
+		s1 := inlineSchema(defs, def, expanding)
+		s1.Title = name
+		return s1
+	}
+	s1 := *s
+	s1.Items = inlineSchema(defs, s.Items, expanding)
+	s1.AdditionalProperties = inlineSchema(defs, s.AdditionalProperties, expanding)
+	if s.Properties != nil {
+		s1.Properties = make(map[string]*Schema)
+		for name, ps := range s.Properties {
+			s1.Properties[name] = inlineSchema(defs, ps, expanding)
+		}
+	}
+	return &s1
+}
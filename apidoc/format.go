@@ -0,0 +1,23 @@
+package apidoc
+
+import (
+	"reflect"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// FormatSchema returns a JSON Schema describing the format of
+// jujuapidoc output, that is, the JSON encoding of Info. It is
+// derived from the Go types in this package, so it cannot drift
+// out of date with them.
+func FormatSchema() *Schema {
+	ti := jsontypes.NewInfo()
+	info := &Info{
+		TypeInfo: ti,
+	}
+	root := info.Schema(ti.Ref(reflect.TypeOf(Info{})))
+	root.Schema = "http://json-schema.org/draft-07/schema#"
+	root.Title = "jujuapidoc output"
+	root.Definitions = info.SchemaDefinitions()
+	return root
+}
@@ -0,0 +1,287 @@
+package apidoc
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// RenderHTML writes info to w as a single HTML page, with a
+// section for each facade in the order given. As sections are
+// identified by facade name alone, info should hold no more
+// than one version of each facade.
+func RenderHTML(w io.Writer, info *Info) error {
+	return RenderHTMLMessages(w, info, nil)
+}
+
+// RenderHTMLMessages is like RenderHTML but takes
+// its text from the given message catalog.
+func RenderHTMLMessages(w io.Writer, info *Info, msgs Messages) error {
+	t, err := template.New("").Funcs(htmlFuncs).Funcs(htmlMessageFuncs(msgs)).Parse(htmlTmpl)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	if err := t.Execute(w, info); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+var htmlTmpl = `
+<html>
+<head>
+<style>
+	body {
+		font-family: Ubuntu Light, sans-serif;
+		padding: 25px;
+	}
+	h2 a {
+		color: black;
+		text-decoration: none;
+	}
+	h2 a:hover {
+		text-decoration: underline;
+	}
+	h2 + p {
+		padding-left: 25px;
+	}
+	tr:nth-child(even) {
+		background-color: #f1f1f1;
+	}
+	td {
+		vertical-align: top;
+		padding: 10px;
+	}
+	.tag {
+		font-size: 60%;
+		background-color: #e0e0e0;
+		padding: 2px 6px;
+		border-radius: 4px;
+	}
+	.per-item-errors, .single-entity, .retry, .releases, .login-target, .requires, .permissions, .watcher, .usage, .audit-excluded, .macaroons {
+		font-style: italic;
+	}
+	.quickstart pre, .example pre {
+		background-color: #f1f1f1;
+		padding: 10px;
+	}
+	.sensitive, .superuser, .test-only {
+		color: #b71c1c;
+		font-weight: bold;
+	}
+</style>
+<title>{{msg "html-title"}}</title>
+</head>
+<body>
+<h1>{{msg "title"}}</h1>
+{{with .TagIndex}}
+	<h2 id="tags">{{msg "tags-heading"}}</h2>
+	<p>{{msg "tags-filter"}} <select id="tag-filter" onchange="filterTags(this.value)">
+		<option value="">{{msg "tags-all"}}</option>
+		{{range .}}<option value="{{.Tag}}">{{.Tag}}</option>{{end}}
+	</select></p>
+	<ul>
+		{{range .}}<li id="tag-{{.Tag}}"><span class="tag">{{.Tag}}</span> {{range $i, $name := .Facades}}{{if $i}}, {{end}}<a href="#{{anchor $name}}">{{$name}}</a>{{end}}</li>{{end}}
+	</ul>
+{{end}}
+{{with .CrossModelFacades}}
+	<h2 id="cross-model-relations">{{msg "cross-model-heading"}}</h2>
+	<p>{{msg "cross-model-intro"}}</p>
+	<ul>
+		{{range crossModelSteps}}<li>{{msg .}}</li>{{end}}
+	</ul>
+	<p>{{msg "cross-model-facades"}}</p>
+	<ul>
+		{{range .}}<li><a href="#{{anchor .Name}}">{{.Name}}</a> v{{.Version}}</li>{{end}}
+	</ul>
+{{end}}
+{{with .MigrationWorkflow}}
+	<h2 id="model-migration">{{msg "migration-heading"}}</h2>
+	<p>{{msg "migration-intro"}}</p>
+	<ol>
+		{{range .}}<li>{{msg .ID}} ({{range $i, $ref := .Methods}}{{if $i}}, {{end}}<a href="#{{methodAnchor $ref.Facade $ref.Version $ref.Method}}">{{$ref.Facade}}.{{$ref.Method}}</a>{{end}})</li>{{end}}
+	</ol>
+{{end}}
+{{range .Facades}}
+<section class="facade" data-tags="{{.Tags | join " "}}">
+	<h2 id="{{anchor .Name}}"><a href="#{{anchor .Name}}">{{.Name}}</a> v{{.Version}} <span style="font-size:80%;font-style: italic">{{.AvailableTo | join " "}}</span>{{range .Tags}} <a class="tag" href="#tag-{{.}}">{{.}}</a>{{end}}</h2>
+	{{$facade := .}}{{$releases := .Releases}}{{with .Releases}}<p class="releases">{{msg "releases" (releaseRange .)}}</p>{{end}}
+	{{if .TestOnly}}<p class="test-only">{{msg "test-only" .TestOnlyReason}}</p>{{end}}
+	{{with .Requires}}<p class="requires">{{requirementsNote .}}</p>{{end}}
+	{{with .LoginTarget}}<p class="login-target">{{loginTargetNote .}}</p>{{end}}
+	{{.Doc | docHTML}}
+	{{with .Leases}}
+		<p>{{msg "leases"}}</p>
+		<ul class="leases">
+			{{range .}}
+				<li>{{.Value}}{{with .Name}} (<code title="{{.}}">{{shortName .}}</code>){{end}}{{if .Method}}: {{msg "lease-checked" .Method}} <code>{{.Check}}</code>{{end}}{{with .Doc}}. {{.}}{{end}}</li>
+			{{end}}
+		</ul>
+	{{end}}
+	{{with .Quickstart}}
+		<details class="quickstart">
+			<summary>{{msg "quickstart" (printf "%s.%s" $facade.Name .Method)}}</summary>
+			{{range .Snippets}}
+				<p>{{.Library}}:</p>
+				<pre><code class="language-{{.Language}}">{{.Code}}</code></pre>
+			{{end}}
+		</details>
+	{{end}}
+	<table>
+		<tr>
+			<th>{{msg "name"}}</th>
+			<th>{{msg "params"}}</th>
+			<th>{{msg "results"}}</th>
+			<th>{{msg "description"}}</th>
+		</tr>
+		{{range .Methods}}
+			<tr id="{{methodAnchor $facade.Name $facade.Version .Name}}">
+				<td>{{.Name}}</td>
+				<td>{{.Param | typeLink}}</td>
+				<td>{{.Result | typeLink}}</td>
+				<td>{{.Doc | docHTML}}{{with .Usage}}
+					<p class="usage">{{usageNote .}}</p>
+				{{end}}{{with macaroonNote ($.MacaroonFields .Param) ($.MacaroonFields .Result)}}
+					<p class="macaroons">{{msg "macaroons" .}}</p>
+				{{end}}{{with .Requires}}
+					<p class="requires">{{requirementsNote .}}</p>
+				{{end}}{{with .Permissions}}
+					<p class="permissions">{{permissionsNote .}}</p>
+				{{end}}{{with .Superuser}}
+					<p class="superuser" title="{{.Check}}">{{msg "superuser"}}</p>
+				{{end}}{{if .Sensitive}}
+					<p class="sensitive" title="{{.SensitiveReason}}">{{msg "sensitive"}}</p>
+				{{end}}{{if .AuditExcluded}}
+					<p class="audit-excluded">{{msg "audit-excluded"}}</p>
+				{{end}}{{if eq .Invocation "watcher"}}
+					<p class="watcher">{{msg "watcher"}}</p>
+				{{end}}{{if .PerItemErrors}}
+					<p class="per-item-errors">{{msg "per-item-errors"}}</p>
+				{{end}}{{with $.SingleEntity .Param .Result}}
+					<p class="single-entity">{{msg "single-entity" .ParamsField .Param.Name .ResultsField .Result.Name}}</p>
+				{{end}}{{with .Retry}}
+					<p class="retry" title="{{.Reason}}">{{if .Safe}}{{msg "retry-safe" .Confidence}}{{else}}{{msg "retry-unsafe" .Confidence}}{{end}}.</p>
+				{{end}}{{if and .Releases (ne (print .Releases) (print $releases))}}
+					<p class="releases">{{msg "releases" (releaseRange .Releases)}}</p>
+				{{end}}{{with .ResultOrder}}
+					<p class="result-order" title="{{.Reason}}">{{if .ByPosition}}{{msg "ordered" .Confidence}}{{else}}{{msg "unordered" .Confidence}}{{end}}.</p>
+				{{end}}{{with .Errors}}
+					<p class="errors">{{msg "errors"}}{{range .}} <a href="#{{sentinelAnchor .}}">{{shortName .}}</a>{{end}}</p>
+				{{end}}{{range .Examples}}
+					<details class="example">
+						<summary>{{msg "example" (exampleTitle .)}}</summary>
+						{{with .Description}}<p>{{.}}</p>{{end}}
+						{{with .Params}}<p>{{msg "example-params"}}</p><pre><code class="language-json">{{exampleJSON .}}</code></pre>{{end}}
+						{{with .Result}}<p>{{msg "example-result"}}</p><pre><code class="language-json">{{exampleJSON .}}</code></pre>{{end}}
+					</details>
+				{{end}}</td>
+			</tr>
+		{{end}}
+	</table>
+</section>
+{{end}}
+{{with .Operational}}
+	<h2 id="operational-behavior">{{msg "operational-heading"}}</h2>
+	<p>{{msg "operational-intro"}}</p>
+	<table>
+		<tr>
+			<th>{{msg "operational-category"}}</th>
+			<th>{{msg "operational-setting"}}</th>
+			<th>{{msg "operational-value"}}</th>
+			<th>{{msg "description"}}</th>
+		</tr>
+		{{range .}}
+			<tr>
+				<td>{{msg (print "operational-" .Category)}}</td>
+				<td title="{{.Name}}">{{shortName .Name}}</td>
+				<td>{{.Value}}</td>
+				<td>{{.Doc | docHTML}}</td>
+			</tr>
+		{{end}}
+	</table>
+{{end}}
+{{with .SentinelErrors}}
+	<h2 id="sentinel-errors">{{msg "sentinel-heading"}}</h2>
+	<table>
+		<tr>
+			<th>{{msg "name"}}</th>
+			<th>{{msg "sentinel-code"}}</th>
+			<th>{{msg "sentinel-message"}}</th>
+			<th>{{msg "description"}}</th>
+		</tr>
+		{{range .}}
+			<tr id="{{sentinelAnchor .Name}}">
+				<td title="{{.Name}}">{{.ShortName}}</td>
+				<td>{{.Code}}</td>
+				<td>{{.Message}}</td>
+				<td>{{.Doc | docHTML}}</td>
+			</tr>
+		{{end}}
+	</table>
+{{end}}
+<script>
+	// filterTags shows only the facades with the given tag,
+	// or all of them if it's empty.
+	function filterTags(tag) {
+		document.querySelectorAll("section.facade").forEach(function(s) {
+			var show = tag === "" || s.dataset.tags.split(" ").indexOf(tag) >= 0;
+			s.style.display = show ? "" : "none";
+		});
+	}
+</script>
+</body>
+</html>
+`
+
+var htmlFuncs = template.FuncMap{
+	"docHTML":        DocHTML,
+	"sentinelAnchor": SentinelAnchor,
+	"shortName":      shortQualifiedName,
+	"anchor":         Anchor,
+	"join": func(sep string, ss []string) string {
+		return strings.Join(ss, sep)
+	},
+	"methodAnchor": MethodAnchor,
+	"exampleJSON":  exampleJSON,
+	"crossModelSteps": func() []string {
+		return crossModelSteps
+	},
+}
+
+// htmlMessageFuncs returns the template functions
+// that produce text from the given message catalog.
+func htmlMessageFuncs(msgs Messages) template.FuncMap {
+	return template.FuncMap{
+		"msg":          msgs.Get,
+		"releaseRange": msgs.releaseRange,
+		"typeLink": func(t *jsontypes.Type) template.HTML {
+			if t == nil {
+				return template.HTML(template.HTMLEscapeString(msgs.Get("none")))
+			}
+			link := fmt.Sprintf(`<a href="%s">%s</a>`, GodocURL(t.Name), template.HTMLEscapeString(t.Name.Name()))
+			return template.HTML(link)
+		},
+		"usageNote": func(u *MethodUsage) string {
+			return usageNote(msgs, u)
+		},
+		"exampleTitle": func(e MethodExample) string {
+			return exampleTitle(msgs, e)
+		},
+		"permissionsNote": func(ps []PermissionRequirement) string {
+			return permissionsNote(msgs, ps)
+		},
+		"requirementsNote": func(rs []Requirement) string {
+			return requirementsNote(msgs, rs)
+		},
+		"loginTargetNote": func(t *LoginTarget) string {
+			return loginTargetNote(msgs, t)
+		},
+		"macaroonNote": func(params, results []string) string {
+			return macaroonNote(msgs, params, results)
+		},
+	}
+}
@@ -0,0 +1,138 @@
+package apidoc
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Intersect returns a document holding the API that all the given
+// documents have in common, such as the documents for several Juju
+// releases, so that a client can target a subset of the API that
+// works with all of them. The first document must not be nil.
+//
+// A facade version is kept if every document has it, and each of its
+// methods is kept if every document has it with params and a result
+// of the same JSON shape, ignoring the names of the types and their
+// docs, as types may move between packages from one release to
+// another. The facade is available only to the entity kinds that it
+// is available to in every document, and loses the tags of the
+// audiences that it's then no longer intended for.
+//
+// The facades, methods and types of the result are taken from
+// the first document and filtered as by Filter. Facade errors are
+// combined from all the documents, as they mean that the result
+// may be missing facades. The result has no Meta or Advertised
+// facades. The given documents are left unchanged.
+func Intersect(infos ...*Info) *Info {
+	type methodKey struct {
+		facade  string
+		version int
+		method  string
+	}
+	facadeCount := make(map[facadeKey]int)
+	availability := make(map[facadeKey]map[string]int)
+	// shapes maps each method to the shape of its params
+	// and result in the first document, and methodCount
+	// counts the documents that have the same shape.
+	shapes := make(map[methodKey]string)
+	methodCount := make(map[methodKey]int)
+	for i, info := range infos {
+		defs := info.SchemaDefinitions()
+		for _, f := range info.Facades {
+			fkey := facadeKey{f.Name, f.Version}
+			facadeCount[fkey]++
+			if availability[fkey] == nil {
+				availability[fkey] = make(map[string]int)
+			}
+			for _, kind := range f.AvailableTo {
+				availability[fkey][kind]++
+			}
+			for _, m := range f.Methods {
+				mkey := methodKey{f.Name, f.Version, m.Name}
+				shape := fmt.Sprintf("%s %s", wireShape(info.InlineSchema(defs, m.Param)), wireShape(info.InlineSchema(defs, m.Result)))
+				if i == 0 {
+					shapes[mkey] = shape
+				}
+				if shapes[mkey] == shape {
+					methodCount[mkey]++
+				}
+			}
+		}
+	}
+	common := infos[0].Filter(func(f *FacadeInfo, m *Method) bool {
+		if m == nil {
+			return facadeCount[facadeKey{f.Name, f.Version}] == len(infos)
+		}
+		return methodCount[methodKey{f.Name, f.Version, m.Name}] == len(infos)
+	})
+	common.Meta = nil
+	common.Advertised = nil
+	for i := range common.Facades {
+		f := &common.Facades[i]
+		counts := availability[facadeKey{f.Name, f.Version}]
+		var availableTo []string
+		for _, kind := range f.AvailableTo {
+			if counts[kind] == len(infos) {
+				availableTo = append(availableTo, kind)
+			}
+		}
+		f.AvailableTo = availableTo
+		f.setAudiences(AudiencesOf(availableTo))
+		// Copy the leases, which Filter shares with the
+		// first document, so that canonicalizing doesn't
+		// change it.
+		f.Leases = append([]LeaseParameter(nil), f.Leases...)
+	}
+	common.ErrorCodes = append([]ErrorCode(nil), common.ErrorCodes...)
+	common.Operational = append([]OperationalSetting(nil), common.Operational...)
+	common.AuditExcluded = append([]string(nil), common.AuditExcluded...)
+	common.FacadeErrors = nil
+	seen := make(map[FacadeError]bool)
+	for _, info := range infos {
+		for _, e := range info.FacadeErrors {
+			if !seen[e] {
+				seen[e] = true
+				common.FacadeErrors = append(common.FacadeErrors, e)
+			}
+		}
+	}
+	common.Canonicalize()
+	return common
+}
+
+// wireShape returns the JSON encoding of s with the
+// titles and descriptions removed, so that it describes
+// only the shape of the values that s allows.
+func wireShape(s *Schema) string {
+	data, err := json.Marshal(stripSchema(s))
+	if err != nil {
+		// Schemas always marshal.
+		panic(err)
+	}
+	return string(data)
+}
+
+// stripSchema returns a copy of s without
+// its titles and descriptions.
+func stripSchema(s *Schema) *Schema {
+	if s == nil {
+		return nil
+	}
+	s1 := *s
+	s1.Title, s1.Description = "", ""
+	s1.Items = stripSchema(s.Items)
+	s1.AdditionalProperties = stripSchema(s.AdditionalProperties)
+	if s.Properties != nil {
+		s1.Properties = make(map[string]*Schema)
+		for name, ps := range s.Properties {
+			s1.Properties[name] = stripSchema(ps)
+		}
+	}
+	if s.Definitions != nil {
+		s1.Definitions = make(map[string]*Schema)
+		for name, ds := range s.Definitions {
+			s1.Definitions[name] = stripSchema(ds)
+		}
+	}
+	return &s1
+}
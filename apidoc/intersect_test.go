@@ -0,0 +1,181 @@
+package apidoc
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+var intersectTests = []struct {
+	about  string
+	infos  []*Info
+	expect []FacadeInfo
+}{{
+	about: "facades missing from a document are dropped",
+	infos: []*Info{{
+		TypeInfo: jsontypes.NewInfo(),
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+		}, {
+			Name:    "Client",
+			Version: 2,
+		}},
+	}, {
+		TypeInfo: jsontypes.NewInfo(),
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 2,
+		}},
+	}},
+	expect: []FacadeInfo{{
+		Name:    "Client",
+		Version: 2,
+	}},
+}, {
+	about: "methods with a different shape are dropped",
+	infos: []*Info{{
+		TypeInfo: jsontypes.NewInfo(),
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+			Methods: []Method{{
+				Name:  "Status",
+				Param: &jsontypes.Type{Kind: jsontypes.String},
+			}, {
+				Name:  "Watch",
+				Param: &jsontypes.Type{Kind: jsontypes.String},
+			}, {
+				Name: "Close",
+			}},
+		}},
+	}, {
+		TypeInfo: jsontypes.NewInfo(),
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+			Methods: []Method{{
+				Name:  "Status",
+				Param: &jsontypes.Type{Kind: jsontypes.String},
+			}, {
+				Name:  "Watch",
+				Param: &jsontypes.Type{Kind: jsontypes.Int},
+			}},
+		}},
+	}},
+	expect: []FacadeInfo{{
+		Name:    "Client",
+		Version: 1,
+		Methods: []Method{{
+			Name:  "Status",
+			Param: &jsontypes.Type{Kind: jsontypes.String},
+		}},
+	}},
+}, {
+	about: "narrowed audiences lose their tags",
+	infos: []*Info{{
+		TypeInfo: jsontypes.NewInfo(),
+		Facades: []FacadeInfo{{
+			Name:        "StorageProvisioner",
+			Version:     4,
+			AvailableTo: []string{"model-user", "machine-agent"},
+			Audiences:   []string{AudienceClient, AudienceAgent},
+			Tags:        []string{"storage", "client", "agent"},
+		}},
+	}, {
+		TypeInfo: jsontypes.NewInfo(),
+		Facades: []FacadeInfo{{
+			Name:        "StorageProvisioner",
+			Version:     4,
+			AvailableTo: []string{"machine-agent"},
+			Audiences:   []string{AudienceAgent},
+			Tags:        []string{"storage", "agent"},
+		}},
+	}},
+	expect: []FacadeInfo{{
+		Name:        "StorageProvisioner",
+		Version:     4,
+		AvailableTo: []string{"machine-agent"},
+		Audiences:   []string{AudienceAgent},
+		Tags:        []string{"storage", "agent"},
+	}},
+}, {
+	about: "shared slices are canonicalized in the result only",
+	infos: []*Info{{
+		TypeInfo: jsontypes.NewInfo(),
+		Facades: []FacadeInfo{{
+			Name:        "LeadershipService",
+			Version:     2,
+			AvailableTo: []string{"unit-agent", "machine-agent"},
+			Leases: []LeaseParameter{{
+				Name:  "duration",
+				Value: "1m0s",
+				Doc:   "\n doc  \n",
+			}},
+		}},
+		ErrorCodes: []ErrorCode{{
+			Name: "CodeNotFound",
+			Code: "not found",
+			Doc:  "\n not found  \n",
+		}, {
+			Name: "CodeBadRequest",
+			Code: "bad request",
+		}},
+		Operational: []OperationalSetting{{
+			Category: OperationalLogin,
+			Name:     "b",
+			Value:    "1",
+			Doc:      "b  ",
+		}, {
+			Category: OperationalConnection,
+			Name:     "a",
+			Value:    "2",
+		}},
+		AuditExcluded: []string{"Pinger.Ping", "Client.FullStatus", "Pinger.Ping"},
+	}, {
+		TypeInfo: jsontypes.NewInfo(),
+		Facades: []FacadeInfo{{
+			Name:        "LeadershipService",
+			Version:     2,
+			AvailableTo: []string{"machine-agent", "unit-agent"},
+		}},
+	}},
+	expect: []FacadeInfo{{
+		Name:        "LeadershipService",
+		Version:     2,
+		AvailableTo: []string{"machine-agent", "unit-agent"},
+		Audiences:   []string{AudienceAgent},
+		Leases: []LeaseParameter{{
+			Name:  "duration",
+			Value: "1m0s",
+			Doc:   " doc\n",
+		}},
+	}},
+}}
+
+func TestIntersect(t *testing.T) {
+	for _, test := range intersectTests {
+		t.Run(test.about, func(t *testing.T) {
+			before := mustMarshal(t, test.infos)
+			common := Intersect(test.infos...)
+			if !reflect.DeepEqual(common.Facades, test.expect) {
+				t.Errorf("unexpected facades\ngot    %s\nexpect %s", mustMarshal(t, common.Facades), mustMarshal(t, test.expect))
+			}
+			if after := mustMarshal(t, test.infos); after != before {
+				t.Errorf("inputs changed\nbefore %s\nafter  %s", before, after)
+			}
+		})
+	}
+}
+
+// mustMarshal returns the JSON encoding of x, which
+// records the order of slices as well as their contents.
+func mustMarshal(t *testing.T, x interface{}) string {
+	data, err := json.Marshal(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,80 @@
+package apidoc
+
+import (
+	"encoding/json"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// upgradeLegacy upgrades a version 0 document. Documents produced by
+// jujuapidoc releases from before TypeInfo was added have no TypeInfo
+// field; instead, the Param and Result fields of each method hold
+// complete type definitions. For those documents, all the named types
+// are moved into TypeInfo and replaced by references, as in the
+// current format.
+func upgradeLegacy(doc map[string]json.RawMessage) error {
+	if _, ok := doc["TypeInfo"]; ok {
+		return nil
+	}
+	var facades []FacadeInfo
+	if data, ok := doc["Facades"]; ok {
+		if err := json.Unmarshal(data, &facades); err != nil {
+			return errors.Notef(err, nil, "cannot parse legacy facades")
+		}
+	}
+	ti := jsontypes.NewInfo()
+	for i := range facades {
+		for j := range facades[i].Methods {
+			m := &facades[i].Methods[j]
+			m.Param = hoistTypes(ti, m.Param)
+			m.Result = hoistTypes(ti, m.Result)
+		}
+	}
+	var err error
+	if doc["Facades"], err = json.Marshal(facades); err != nil {
+		return errors.Wrap(err)
+	}
+	if doc["TypeInfo"], err = json.Marshal(ti); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// hoistTypes adds all the named types defined inline in t to ti,
+// replacing them with references. It returns the replacement for t.
+func hoistTypes(ti *jsontypes.Info, t *jsontypes.Type) *jsontypes.Type {
+	if t == nil {
+		return nil
+	}
+	if t.Name.PkgPath() == "" {
+		hoistElemTypes(ti, t)
+		return t
+	}
+	if _, ok := ti.Types[t.Name]; !ok && t.Kind != "" {
+		// Add the type before its elements
+		// to prevent infinite recursion.
+		ti.Types[t.Name] = t
+		hoistElemTypes(ti, t)
+	}
+	return &jsontypes.Type{
+		Name: t.Name,
+	}
+}
+
+func hoistElemTypes(ti *jsontypes.Info, t *jsontypes.Type) {
+	t.Elem = hoistTypes(ti, t.Elem)
+	t.Key = hoistTypes(ti, t.Key)
+	for _, f := range t.Fields {
+		f.Type = hoistTypes(ti, f.Type)
+	}
+	for i := range t.In {
+		t.In[i] = hoistTypes(ti, t.In[i])
+	}
+	for i := range t.Out {
+		t.Out[i] = hoistTypes(ti, t.Out[i])
+	}
+	for _, m := range t.Methods {
+		m.Type = hoistTypes(ti, m.Type)
+	}
+}
@@ -0,0 +1,116 @@
+package apidoc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// CurrentSchemaVersion holds the version of the document format
+// defined by Info. It should be incremented, and a migration added
+// to migrations, whenever the format changes in a way that older
+// documents would not decode correctly.
+//
+// Documents generated before SchemaVersion was introduced
+// have no SchemaVersion field and are treated as version 0.
+const CurrentSchemaVersion = 4
+
+// migrations holds the functions that upgrade documents
+// between versions: migrations[i] upgrades a document from
+// version i to version i+1. Each is passed the top level
+// fields of the document, which it may change in place.
+var migrations = []func(doc map[string]json.RawMessage) error{
+	// Version 1 added the SchemaVersion field; version 0
+	// also covers documents from before TypeInfo was added.
+	0: upgradeLegacy,
+	// Version 2 added FacadeInfo.Audiences.
+	1: addAudiences,
+	// Version 3 added FacadeInfo.Tags.
+	2: addTags,
+	// Version 4 added the audience tags.
+	3: addAudienceTags,
+}
+
+// ReadFile reads the jujuapidoc output in the named file,
+// upgrading it to the current format if necessary. The file
+// may be gzip-compressed.
+func ReadFile(path string) (*Info, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	data, err = decompress(data)
+	if err != nil {
+		return nil, errors.Notef(err, nil, "cannot load %s", path)
+	}
+	info, err := Parse(data)
+	if err != nil {
+		return nil, errors.Notef(err, nil, "cannot load %s", path)
+	}
+	return info, nil
+}
+
+// Parse parses the given jujuapidoc output, upgrading it
+// to the current format if necessary.
+func Parse(data []byte) (*Info, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	version := 0
+	if v, ok := doc["SchemaVersion"]; ok {
+		if err := json.Unmarshal(v, &version); err != nil {
+			return nil, errors.Notef(err, nil, "invalid SchemaVersion")
+		}
+	}
+	if version > CurrentSchemaVersion {
+		return nil, errors.Newf("document has schema version %d, which is newer than the supported version %d", version, CurrentSchemaVersion)
+	}
+	if version < CurrentSchemaVersion {
+		for v := version; v < CurrentSchemaVersion; v++ {
+			if err := migrations[v](doc); err != nil {
+				return nil, errors.Notef(err, nil, "cannot upgrade document from schema version %d", v)
+			}
+		}
+		var err error
+		if doc["SchemaVersion"], err = json.Marshal(CurrentSchemaVersion); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		if data, err = json.Marshal(doc); err != nil {
+			return nil, errors.Wrap(err)
+		}
+	}
+	var info Info
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &info, nil
+}
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// decompress returns the decompressed contents of data
+// if it's gzip-compressed, or data itself otherwise.
+func decompress(data []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, gzipMagic):
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, errors.Notef(err, nil, "cannot decompress")
+		}
+		return data, nil
+	case bytes.HasPrefix(data, zstdMagic):
+		return nil, errors.Newf("zstd-compressed documents are not supported; decompress with zstd -d first")
+	}
+	return data, nil
+}
@@ -0,0 +1,169 @@
+package apidoc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+var parseTests = []struct {
+	about       string
+	data        string
+	expect      *Info
+	expectError string
+}{{
+	about: "legacy document with inline types",
+	data: `{
+		"Facades": [{
+			"Name": "StorageProvisioner",
+			"Version": 4,
+			"AvailableTo": ["machine-agent"],
+			"Methods": [{
+				"Name": "Volumes",
+				"Param": {
+					"Name": "github.com/juju/juju/rpc/params#Entities",
+					"Kind": "struct",
+					"Fields": [{
+						"Name": "Entities",
+						"Type": {
+							"Kind": "slice",
+							"Elem": {
+								"Name": "github.com/juju/juju/rpc/params#Entity",
+								"Kind": "struct"
+							}
+						}
+					}]
+				}
+			}]
+		}]
+	}`,
+	expect: &Info{
+		SchemaVersion: CurrentSchemaVersion,
+		TypeInfo: &jsontypes.Info{
+			Types: map[jsontypes.TypeName]*jsontypes.Type{
+				entities: {
+					Name: entities,
+					Kind: jsontypes.Struct,
+					Fields: []*jsontypes.Field{{
+						Name: "Entities",
+						Type: &jsontypes.Type{
+							Kind: jsontypes.Slice,
+							Elem: &jsontypes.Type{Name: entity},
+						},
+					}},
+				},
+				entity: {
+					Name: entity,
+					Kind: jsontypes.Struct,
+				},
+			},
+		},
+		Facades: []FacadeInfo{{
+			Name:    "StorageProvisioner",
+			Version: 4,
+			Methods: []Method{{
+				Name:  "Volumes",
+				Param: &jsontypes.Type{Name: entities},
+			}},
+			AvailableTo: []string{"machine-agent"},
+			Audiences:   []string{AudienceAgent},
+			Tags:        []string{"storage", "machines", "agent"},
+		}},
+	},
+}, {
+	about: "version 1 document gets audiences and tags",
+	data: `{
+		"SchemaVersion": 1,
+		"TypeInfo": {"Types": {}},
+		"Facades": [{
+			"Name": "Client",
+			"Version": 1,
+			"AvailableTo": ["model-user", "controller-machine-agent"]
+		}]
+	}`,
+	expect: &Info{
+		SchemaVersion: CurrentSchemaVersion,
+		TypeInfo:      jsontypes.NewInfo(),
+		Facades: []FacadeInfo{{
+			Name:        "Client",
+			Version:     1,
+			AvailableTo: []string{"model-user", "controller-machine-agent"},
+			Audiences:   []string{AudienceClient, AudienceController},
+			Tags:        []string{"client", "controller-agent"},
+		}},
+	},
+}, {
+	about: "version 3 document gets audience tags",
+	data: `{
+		"SchemaVersion": 3,
+		"Facades": [{
+			"Name": "Secrets",
+			"Version": 1,
+			"Audiences": ["client"],
+			"Tags": ["secrets"]
+		}]
+	}`,
+	expect: &Info{
+		SchemaVersion: CurrentSchemaVersion,
+		Facades: []FacadeInfo{{
+			Name:      "Secrets",
+			Version:   1,
+			Audiences: []string{AudienceClient},
+			Tags:      []string{"secrets", "client"},
+		}},
+	},
+}, {
+	about: "current document is left alone",
+	data: fmt.Sprintf(`{
+		"SchemaVersion": %d,
+		"Facades": [{
+			"Name": "Secrets",
+			"Version": 1,
+			"Audiences": ["client"],
+			"Tags": ["secrets"]
+		}]
+	}`, CurrentSchemaVersion),
+	expect: &Info{
+		SchemaVersion: CurrentSchemaVersion,
+		Facades: []FacadeInfo{{
+			Name:      "Secrets",
+			Version:   1,
+			Audiences: []string{AudienceClient},
+			Tags:      []string{"secrets"},
+		}},
+	},
+}, {
+	about:       "newer document",
+	data:        fmt.Sprintf(`{"SchemaVersion": %d}`, CurrentSchemaVersion+1),
+	expectError: fmt.Sprintf(`document has schema version %d, which is newer than the supported version %d`, CurrentSchemaVersion+1, CurrentSchemaVersion),
+}, {
+	about:       "invalid schema version",
+	data:        `{"SchemaVersion": "4"}`,
+	expectError: `invalid SchemaVersion: .*`,
+}, {
+	about:       "invalid facades",
+	data:        `{"SchemaVersion": 1, "Facades": {}}`,
+	expectError: `cannot upgrade document from schema version 1: cannot parse facades: .*`,
+}}
+
+func TestParse(t *testing.T) {
+	for _, test := range parseTests {
+		t.Run(test.about, func(t *testing.T) {
+			info, err := Parse([]byte(test.data))
+			if test.expectError != "" {
+				if err == nil || !regexp.MustCompile("^("+test.expectError+")$").MatchString(err.Error()) {
+					t.Fatalf("unexpected error\ngot    %v\nexpect %s", err, test.expectError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got, expect := mustMarshal(t, info), mustMarshal(t, test.expect); got != expect {
+				t.Errorf("unexpected document\ngot    %s\nexpect %s", got, expect)
+			}
+		})
+	}
+}
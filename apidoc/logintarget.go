@@ -0,0 +1,52 @@
+package apidoc
+
+// LoginTarget records which API server endpoints a facade can be
+// used on. A connection logs in either to the controller endpoint,
+// "/api", or to the endpoint of a model, "/model/<uuid>/api", and the
+// API server refuses calls to facades that aren't served for the
+// kind of login, which is easily mistaken for a permission problem.
+//
+// Agents always log in to a model endpoint, so only the model
+// endpoint matters for the facades that they use.
+type LoginTarget struct {
+	// Controller reports whether the facade can be
+	// used after logging in to the controller endpoint.
+	Controller bool `json:",omitempty"`
+
+	// Model reports whether the facade can be used
+	// after logging in to a model endpoint.
+	Model bool `json:",omitempty"`
+}
+
+// Endpoint paths for the kinds of login.
+const (
+	ControllerEndpoint = "/api"
+	ModelEndpoint      = "/model/<uuid>/api"
+)
+
+// Endpoints returns the paths of the endpoints that the
+// facade can be used on.
+func (t *LoginTarget) Endpoints() []string {
+	var endpoints []string
+	if t.Controller {
+		endpoints = append(endpoints, ControllerEndpoint)
+	}
+	if t.Model {
+		endpoints = append(endpoints, ModelEndpoint)
+	}
+	return endpoints
+}
+
+// loginTargetNote returns a note of the endpoints that
+// a facade with the given login target can be used on.
+func loginTargetNote(msgs Messages, t *LoginTarget) string {
+	switch {
+	case t.Controller && t.Model:
+		return msgs.Get("login-target-any", ControllerEndpoint, ModelEndpoint)
+	case t.Controller:
+		return msgs.Get("login-target-controller", ControllerEndpoint, ModelEndpoint)
+	case t.Model:
+		return msgs.Get("login-target-model", ModelEndpoint, ControllerEndpoint)
+	}
+	return msgs.Get("login-target-none")
+}
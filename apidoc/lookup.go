@@ -0,0 +1,43 @@
+package apidoc
+
+import (
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// Facade returns the facade with the given name and version,
+// or nil if there is none.
+func (info *Info) Facade(name string, version int) *FacadeInfo {
+	for i := range info.Facades {
+		f := &info.Facades[i]
+		if f.Name == name && f.Version == version {
+			return f
+		}
+	}
+	return nil
+}
+
+// Method returns the method with the given name,
+// or nil if there is none.
+func (f *FacadeInfo) Method(name string) *Method {
+	for i := range f.Methods {
+		m := &f.Methods[i]
+		if m.Name == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// Type returns the full definition of t. If t is a reference
+// to a named type, as found in the Param and Result fields
+// of Method, the definition is looked up in info.TypeInfo;
+// otherwise t is returned unchanged.
+func (info *Info) Type(t *jsontypes.Type) *jsontypes.Type {
+	if t == nil || info.TypeInfo == nil {
+		return t
+	}
+	if dt := info.TypeInfo.Types[t.Name]; dt != nil {
+		return dt
+	}
+	return t
+}
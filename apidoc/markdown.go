@@ -0,0 +1,241 @@
+package apidoc
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// RenderMarkdown writes info to w as a Markdown document holding
+// the same information as RenderHTML, with a section for each
+// facade in the order given and a subsection for each method.
+// As with RenderHTML, info should hold no more than one
+// version of each facade.
+func RenderMarkdown(w io.Writer, info *Info) error {
+	return RenderMarkdownMessages(w, info, nil)
+}
+
+// RenderMarkdownMessages is like RenderMarkdown but takes
+// its text from the given message catalog.
+func RenderMarkdownMessages(w io.Writer, info *Info, msgs Messages) error {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "# %s\n", msgs.Get("title"))
+	if cmr := info.CrossModelFacades(); len(cmr) > 0 {
+		fmt.Fprintf(&buf, "\n## %s\n\n", msgs.Get("cross-model-heading"))
+		buf.WriteString(msgs.Get("cross-model-intro") + "\n\n")
+		for _, step := range crossModelSteps {
+			fmt.Fprintf(&buf, "- %s\n", msgs.Get(step))
+		}
+		fmt.Fprintf(&buf, "\n%s\n\n", msgs.Get("cross-model-facades"))
+		for _, f := range cmr {
+			fmt.Fprintf(&buf, "- %s v%d\n", f.Name, f.Version)
+		}
+	}
+	if steps := info.MigrationWorkflow(); len(steps) > 0 {
+		anchors := markdownAnchors(info)
+		fmt.Fprintf(&buf, "\n## %s\n\n", msgs.Get("migration-heading"))
+		buf.WriteString(msgs.Get("migration-intro") + "\n\n")
+		for i, step := range steps {
+			links := make([]string, len(step.Methods))
+			for j, ref := range step.Methods {
+				links[j] = fmt.Sprintf("[%s.%s](#%s)", ref.Facade, ref.Method, anchors[ref])
+			}
+			fmt.Fprintf(&buf, "%d. %s (%s)\n", i+1, msgs.Get(step.ID), strings.Join(links, ", "))
+		}
+	}
+	for _, f := range info.Facades {
+		fmt.Fprintf(&buf, "\n## %s v%d\n\n", f.Name, f.Version)
+		if len(f.AvailableTo) > 0 {
+			fmt.Fprintf(&buf, "*%s*\n\n", msgs.Get("available-to", strings.Join(f.AvailableTo, ", ")))
+		}
+		if f.Releases != nil {
+			fmt.Fprintf(&buf, "*%s*\n\n", msgs.Get("releases", msgs.releaseRange(f.Releases)))
+		}
+		if f.TestOnly {
+			fmt.Fprintf(&buf, "**%s**\n\n", msgs.Get("test-only", f.TestOnlyReason))
+		}
+		if len(f.Requires) > 0 {
+			fmt.Fprintf(&buf, "*%s*\n\n", requirementsNote(msgs, f.Requires))
+		}
+		if f.LoginTarget != nil {
+			fmt.Fprintf(&buf, "*%s*\n\n", loginTargetNote(msgs, f.LoginTarget))
+		}
+		if len(f.Tags) > 0 {
+			fmt.Fprintf(&buf, "%s\n\n", msgs.Get("tags", "`"+strings.Join(f.Tags, "` `")+"`"))
+		}
+		if f.Doc != "" {
+			buf.WriteString(strings.TrimRight(DocMarkdown(f.Doc), "\n"))
+			buf.WriteString("\n\n")
+		}
+		if len(f.Leases) > 0 {
+			fmt.Fprintf(&buf, "%s\n\n", msgs.Get("leases"))
+			for _, p := range f.Leases {
+				fmt.Fprintf(&buf, "- %s", p.Value)
+				if p.Name != "" {
+					fmt.Fprintf(&buf, " (`%s`)", shortQualifiedName(p.Name))
+				}
+				if p.Method != "" {
+					fmt.Fprintf(&buf, ": %s `%s`", msgs.Get("lease-checked", p.Method), p.Check)
+				}
+				if doc := strings.TrimSpace(p.Doc); doc != "" {
+					fmt.Fprintf(&buf, ". %s", strings.Replace(doc, "\n", " ", -1))
+				}
+				buf.WriteString("\n")
+			}
+			buf.WriteString("\n")
+		}
+		if q := f.Quickstart; q != nil {
+			fmt.Fprintf(&buf, "%s\n\n", msgs.Get("quickstart", f.Name+"."+q.Method))
+			for _, snippet := range q.Snippets {
+				fmt.Fprintf(&buf, "%s:\n\n```%s\n%s```\n\n", snippet.Library, snippet.Language, snippet.Code)
+			}
+		}
+		for _, m := range f.Methods {
+			fmt.Fprintf(&buf, "### %s.%s\n\n", f.Name, m.Name)
+			fmt.Fprintf(&buf, "%s: %s  \n%s: %s\n\n", msgs.Get("params"), markdownTypeLink(msgs, m.Param), msgs.Get("results"), markdownTypeLink(msgs, m.Result))
+			if m.Doc != "" {
+				buf.WriteString(strings.TrimRight(DocMarkdown(m.Doc), "\n"))
+				buf.WriteString("\n\n")
+			}
+			if m.Releases != nil && (f.Releases == nil || *m.Releases != *f.Releases) {
+				fmt.Fprintf(&buf, "*%s*\n\n", msgs.Get("releases", msgs.releaseRange(m.Releases)))
+			}
+			if u := m.Usage; u != nil {
+				fmt.Fprintf(&buf, "*%s*\n\n", usageNote(msgs, u))
+			}
+			if params, results := info.MacaroonFields(m.Param), info.MacaroonFields(m.Result); len(params) > 0 || len(results) > 0 {
+				fmt.Fprintf(&buf, "*%s*\n\n", msgs.Get("macaroons", macaroonNote(msgs, params, results)))
+			}
+			if len(m.Requires) > 0 {
+				fmt.Fprintf(&buf, "*%s*\n\n", requirementsNote(msgs, m.Requires))
+			}
+			if len(m.Permissions) > 0 {
+				fmt.Fprintf(&buf, "*%s*\n\n", permissionsNote(msgs, m.Permissions))
+			}
+			if s := m.Superuser; s != nil {
+				fmt.Fprintf(&buf, "**%s** (`%s`)\n\n", msgs.Get("superuser"), s.Check)
+			}
+			if m.Sensitive {
+				fmt.Fprintf(&buf, "**%s**\n\n", msgs.Get("sensitive-why", m.SensitiveReason))
+			}
+			if m.AuditExcluded {
+				fmt.Fprintf(&buf, "*%s*\n\n", msgs.Get("audit-excluded"))
+			}
+			if m.Invocation == InvocationWatcher {
+				fmt.Fprintf(&buf, "*%s*\n\n", msgs.Get("watcher"))
+			}
+			if m.PerItemErrors {
+				fmt.Fprintf(&buf, "*%s*\n\n", msgs.Get("per-item-errors"))
+			}
+			if s := info.SingleEntity(m.Param, m.Result); s != nil {
+				fmt.Fprintf(&buf, "*%s*\n\n", msgs.Get("single-entity", s.ParamsField, s.Param.Name(), s.ResultsField, s.Result.Name()))
+			}
+			if r := m.Retry; r != nil {
+				id := "retry-safe"
+				if !r.Safe {
+					id = "retry-unsafe"
+				}
+				fmt.Fprintf(&buf, "*%s: %s.*\n\n", msgs.Get(id, r.Confidence), r.Reason)
+			}
+			if r := m.ResultOrder; r != nil {
+				id := "ordered"
+				if !r.ByPosition {
+					id = "unordered"
+				}
+				fmt.Fprintf(&buf, "*%s: %s.*\n\n", msgs.Get(id, r.Confidence), r.Reason)
+			}
+			if len(m.Errors) > 0 {
+				names := make([]string, len(m.Errors))
+				for i, name := range m.Errors {
+					names[i] = "`" + shortQualifiedName(name) + "`"
+				}
+				fmt.Fprintf(&buf, "%s %s\n\n", msgs.Get("errors"), strings.Join(names, ", "))
+			}
+			for _, e := range m.Examples {
+				fmt.Fprintf(&buf, "**%s**\n\n", msgs.Get("example", exampleTitle(msgs, e)))
+				if e.Description != "" {
+					fmt.Fprintf(&buf, "%s\n\n", strings.TrimSpace(e.Description))
+				}
+				if len(e.Params) > 0 {
+					fmt.Fprintf(&buf, "%s\n\n```json\n%s\n```\n\n", msgs.Get("example-params"), exampleJSON(e.Params))
+				}
+				if len(e.Result) > 0 {
+					fmt.Fprintf(&buf, "%s\n\n```json\n%s\n```\n\n", msgs.Get("example-result"), exampleJSON(e.Result))
+				}
+			}
+		}
+	}
+	if len(info.Operational) > 0 {
+		fmt.Fprintf(&buf, "\n## %s\n\n", msgs.Get("operational-heading"))
+		buf.WriteString(msgs.Get("operational-intro") + "\n")
+		category := ""
+		for _, s := range info.Operational {
+			if s.Category != category {
+				category = s.Category
+				fmt.Fprintf(&buf, "\n### %s\n\n", msgs.Get("operational-"+category))
+				fmt.Fprintf(&buf, "| %s | %s | %s |\n|---|---|---|\n", msgs.Get("operational-setting"), msgs.Get("operational-value"), msgs.Get("description"))
+			}
+			doc := strings.Replace(strings.TrimSpace(s.Doc), "\n", " ", -1)
+			fmt.Fprintf(&buf, "| `%s` | %s | %s |\n", shortQualifiedName(s.Name), s.Value, doc)
+		}
+	}
+	if len(info.SentinelErrors) > 0 {
+		fmt.Fprintf(&buf, "\n## %s\n", msgs.Get("sentinel-heading"))
+		for _, e := range info.SentinelErrors {
+			fmt.Fprintf(&buf, "\n### %s\n\n", e.ShortName())
+			fmt.Fprintf(&buf, "`%s`\n\n", e.Name)
+			if e.Code != "" {
+				fmt.Fprintf(&buf, "%s: `%s`  \n", msgs.Get("sentinel-code"), e.Code)
+			}
+			if e.Message != "" {
+				fmt.Fprintf(&buf, "%s: `%s`\n\n", msgs.Get("sentinel-message"), e.Message)
+			}
+			if e.Doc != "" {
+				buf.WriteString(strings.TrimRight(DocMarkdown(e.Doc), "\n"))
+				buf.WriteString("\n\n")
+			}
+		}
+	}
+	if _, err := io.WriteString(w, strings.TrimSuffix(buf.String(), "\n")); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// markdownTypeLink returns a Markdown link to the
+// documentation for t, or "n/a" if t is nil.
+func markdownTypeLink(msgs Messages, t *jsontypes.Type) string {
+	if t == nil {
+		return msgs.Get("none")
+	}
+	return fmt.Sprintf("[%s](%s)", t.Name.Name(), GodocURL(t.Name))
+}
+
+// usageNote returns a note of the number of calls recorded
+// in u and the callers that made the most of them.
+func usageNote(msgs Messages, u *MethodUsage) string {
+	if len(u.Callers) == 0 {
+		return msgs.Get("usage", u.Calls)
+	}
+	callers := make([]string, len(u.Callers))
+	for i, c := range u.Callers {
+		callers[i] = fmt.Sprintf("%s (%d)", c.Caller, c.Calls)
+	}
+	return msgs.Get("usage-callers", u.Calls, strings.Join(callers, ", "))
+}
+
+// macaroonNote returns a note of where the params and
+// results of a method hold macaroons.
+func macaroonNote(msgs Messages, params, results []string) string {
+	var notes []string
+	if len(params) > 0 {
+		notes = append(notes, msgs.Get("macaroons-sent", strings.Join(params, ", ")))
+	}
+	if len(results) > 0 {
+		notes = append(notes, msgs.Get("macaroons-reply", strings.Join(results, ", ")))
+	}
+	return strings.Join(notes, "; ")
+}
@@ -0,0 +1,212 @@
+package apidoc
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// EntityKinds holds the kinds of entity that can appear in
+// FacadeInfo.AvailableTo, in the order in which they are
+// listed in a permission matrix.
+var EntityKinds = []string{
+	"controller-machine-agent",
+	"machine-agent",
+	"unit-agent",
+	"controller-user",
+	"model-user",
+}
+
+// Access values held in PermissionRow.Access.
+const (
+	// AccessAllowed is for an entity kind that
+	// the facade is available to.
+	AccessAllowed = "allowed"
+
+	// AccessAssumed is for an entity kind that the facade is
+	// assumed to be available to because its factory panicked
+	// when called on behalf of that kind. See FactoryPanic.
+	AccessAssumed = "assumed"
+
+	// AccessDenied is for an entity kind that
+	// the facade is not available to.
+	AccessDenied = ""
+)
+
+// PermissionRow holds the row of a permission matrix
+// for one method of a facade.
+type PermissionRow struct {
+	Facade  string
+	Version int
+	Method  string
+
+	// Access holds the access of each entity kind in
+	// EntityKinds to the method, in the same order.
+	Access []string
+
+	// Unchecked holds whether the method appears not to
+	// check permissions even though agents can use it.
+	// See the "no-permission-check" warning.
+	Unchecked bool
+
+	// Superuser holds whether the method appears to require
+	// superuser access to the controller. See Method.Superuser.
+	Superuser bool
+}
+
+// PermissionMatrix returns a row for each method of each facade in
+// info, in the order of info.Facades, recording which kinds of
+// entity can call it. Availability is determined for a facade as
+// a whole, so all the methods of a facade have the same access.
+func (info *Info) PermissionMatrix() []PermissionRow {
+	type kindKey struct {
+		facade  string
+		version int
+		kind    string
+	}
+	assumed := make(map[kindKey]bool)
+	for _, p := range info.FactoryPanics {
+		assumed[kindKey{p.Facade, p.Version, p.EntityKind}] = true
+	}
+	unchecked := make(map[MethodRef]bool)
+	for _, w := range info.Warnings {
+		if w.Kind == "no-permission-check" {
+			unchecked[MethodRef{w.Facade, w.Version, w.Method}] = true
+		}
+	}
+	var rows []PermissionRow
+	for _, f := range info.Facades {
+		available := make(map[string]bool)
+		for _, kind := range f.AvailableTo {
+			available[kind] = true
+		}
+		access := make([]string, len(EntityKinds))
+		for i, kind := range EntityKinds {
+			switch {
+			case !available[kind]:
+				access[i] = AccessDenied
+			case assumed[kindKey{f.Name, f.Version, kind}]:
+				access[i] = AccessAssumed
+			default:
+				access[i] = AccessAllowed
+			}
+		}
+		for _, m := range f.Methods {
+			rows = append(rows, PermissionRow{
+				Facade:    f.Name,
+				Version:   f.Version,
+				Method:    m.Name,
+				Access:    access,
+				Unchecked: unchecked[MethodRef{f.Name, f.Version, m.Name}],
+				Superuser: m.Superuser != nil,
+			})
+		}
+	}
+	return rows
+}
+
+// WritePermissionMatrixCSV writes the permission matrix of info
+// to w as CSV, with a header row naming the columns. See
+// PermissionMatrix.
+func WritePermissionMatrixCSV(w io.Writer, info *Info) error {
+	cw := csv.NewWriter(w)
+	header := append([]string{"facade", "version", "method"}, EntityKinds...)
+	header = append(header, "unchecked", "superuser")
+	cw.Write(header)
+	for _, r := range info.PermissionMatrix() {
+		record := append([]string{r.Facade, fmt.Sprint(r.Version), r.Method}, r.Access...)
+		unchecked := ""
+		if r.Unchecked {
+			unchecked = "yes"
+		}
+		superuser := ""
+		if r.Superuser {
+			superuser = "required"
+		}
+		cw.Write(append(record, unchecked, superuser))
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// RenderPermissionMatrixHTML writes the permission matrix of
+// info to w as a single HTML page. See PermissionMatrix.
+func RenderPermissionMatrixHTML(w io.Writer, info *Info) error {
+	return RenderPermissionMatrixHTMLMessages(w, info, nil)
+}
+
+// RenderPermissionMatrixHTMLMessages is like RenderPermissionMatrixHTML
+// but takes its text from the given message catalog.
+func RenderPermissionMatrixHTMLMessages(w io.Writer, info *Info, msgs Messages) error {
+	t, err := template.New("").Funcs(htmlMessageFuncs(msgs)).Parse(matrixTmpl)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	err = t.Execute(w, map[string]interface{}{
+		"Kinds": EntityKinds,
+		"Rows":  info.PermissionMatrix(),
+	})
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+var matrixTmpl = `
+<html>
+<head>
+<style>
+	body {
+		font-family: Ubuntu Light, sans-serif;
+		padding: 25px;
+	}
+	tr:nth-child(even) {
+		background-color: #f1f1f1;
+	}
+	th, td {
+		padding: 4px 10px;
+	}
+	.allowed {
+		background-color: #c8e6c9;
+	}
+	.assumed {
+		background-color: #fff3c4;
+	}
+	.unchecked {
+		color: #b71c1c;
+	}
+</style>
+<title>{{msg "matrix-html-title"}}</title>
+</head>
+<body>
+<h1>{{msg "matrix-title"}}</h1>
+<p>{{msg "matrix-assumed"}}</p>
+<table>
+	<tr>
+		<th>{{msg "matrix-facade"}}</th>
+		<th>{{msg "matrix-version"}}</th>
+		<th>{{msg "matrix-method"}}</th>
+		{{range .Kinds}}<th>{{.}}</th>{{end}}
+		<th>{{msg "matrix-permission-check"}}</th>
+		<th>{{msg "matrix-superuser"}}</th>
+	</tr>
+	{{range .Rows}}
+		<tr>
+			<td>{{.Facade}}</td>
+			<td>{{.Version}}</td>
+			<td>{{.Method}}</td>
+			{{range .Access}}<td class="{{.}}">{{.}}</td>{{end}}
+			<td>{{if .Unchecked}}<span class="unchecked">{{msg "matrix-unchecked"}}</span>{{end}}</td>
+			<td>{{if .Superuser}}{{msg "matrix-required"}}{{end}}</td>
+		</tr>
+	{{end}}
+</table>
+</body>
+</html>
+`
@@ -0,0 +1,169 @@
+package apidoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// Merge combines the given documents into one. This allows
+// documents generated separately, for example by partial
+// regenerations, to be put together.
+//
+// A facade version, type, error code, sentinel error or operational
+// setting may appear in more than one document as long as every
+// definition is the same; otherwise Merge returns an error
+// describing all the conflicts.
+// Warnings, factory panics and audit-excluded methods are combined
+// with duplicates removed, as are the constraints in Fields. Facade
+// errors are combined too, except for those for facades that
+// another document has.
+// If any document has a negotiation table or type families,
+// they are recomputed for the merged document. The result has
+// no Meta or Advertised facades, as it doesn't come from a single
+// generation.
+// The result is in canonical form (see Info.Canonicalize).
+func Merge(infos ...*Info) (*Info, error) {
+	merged := &Info{
+		SchemaVersion: CurrentSchemaVersion,
+		TypeInfo:      jsontypes.NewInfo(),
+	}
+	var conflicts []string
+	facades := make(map[facadeKey]FacadeInfo)
+	codes := make(map[string]ErrorCode)
+	sentinels := make(map[string]SentinelError)
+	operational := make(map[string]OperationalSetting)
+	warnings := make(map[Warning]bool)
+	panics := make(map[FactoryPanic]bool)
+	facadeErrors := make(map[FacadeError]bool)
+	negotiation, families := false, false
+	for _, info := range infos {
+		negotiation = negotiation || info.Negotiation != nil
+		families = families || info.TypeFamilies != nil
+		if info.TypeInfo != nil {
+			for name, t := range info.TypeInfo.Types {
+				if old, ok := merged.TypeInfo.Types[name]; ok {
+					if !sameJSON(old, t) {
+						conflicts = append(conflicts, fmt.Sprintf("type %s has conflicting definitions", name))
+					}
+					continue
+				}
+				merged.TypeInfo.Types[name] = t
+			}
+		}
+		for _, f := range info.Facades {
+			// Copy the slices so that canonicalizing
+			// doesn't change the original document.
+			f.Methods = append([]Method(nil), f.Methods...)
+			f.AvailableTo = append([]string(nil), f.AvailableTo...)
+			f.Leases = append([]LeaseParameter(nil), f.Leases...)
+			f.Canonicalize()
+			key := facadeKey{f.Name, f.Version}
+			if old, ok := facades[key]; ok {
+				// The same facade version can be declared in
+				// different places in different documents.
+				if !sameJSON(withoutSources(old), withoutSources(f)) {
+					conflicts = append(conflicts, fmt.Sprintf("facade %s(%d) has conflicting definitions", f.Name, f.Version))
+				}
+				continue
+			}
+			facades[key] = f
+			merged.Facades = append(merged.Facades, f)
+		}
+		for _, c := range info.ErrorCodes {
+			if old, ok := codes[c.Name]; ok {
+				if old.Code != c.Code {
+					conflicts = append(conflicts, fmt.Sprintf("error code %s has conflicting values %q and %q", c.Name, old.Code, c.Code))
+				}
+				continue
+			}
+			codes[c.Name] = c
+			merged.ErrorCodes = append(merged.ErrorCodes, c)
+		}
+		for _, e := range info.SentinelErrors {
+			if old, ok := sentinels[e.Name]; ok {
+				if old.Code != e.Code || old.Message != e.Message {
+					conflicts = append(conflicts, fmt.Sprintf("sentinel error %s has conflicting definitions", e.Name))
+				}
+				continue
+			}
+			sentinels[e.Name] = e
+			merged.SentinelErrors = append(merged.SentinelErrors, e)
+		}
+		for _, s := range info.Operational {
+			if old, ok := operational[s.Name]; ok {
+				if old.Value != s.Value {
+					conflicts = append(conflicts, fmt.Sprintf("operational setting %s has conflicting values %s and %s", s.Name, old.Value, s.Value))
+				}
+				continue
+			}
+			operational[s.Name] = s
+			merged.Operational = append(merged.Operational, s)
+		}
+		for _, w := range info.Warnings {
+			if !warnings[w] {
+				warnings[w] = true
+				merged.Warnings = append(merged.Warnings, w)
+			}
+		}
+		for _, p := range info.FactoryPanics {
+			if !panics[p] {
+				panics[p] = true
+				merged.FactoryPanics = append(merged.FactoryPanics, p)
+			}
+		}
+		merged.Fields = append(merged.Fields, info.Fields...)
+		merged.AuditExcluded = append(merged.AuditExcluded, info.AuditExcluded...)
+		for _, e := range info.FacadeErrors {
+			if !facadeErrors[e] {
+				facadeErrors[e] = true
+				merged.FacadeErrors = append(merged.FacadeErrors, e)
+			}
+		}
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return nil, errors.Newf("cannot merge documents: %s", strings.Join(conflicts, "; "))
+	}
+	// Drop the errors for facades that were
+	// documented successfully elsewhere.
+	var errs []FacadeError
+	for _, e := range merged.FacadeErrors {
+		if _, ok := facades[facadeKey{e.Facade, e.Version}]; !ok {
+			errs = append(errs, e)
+		}
+	}
+	merged.FacadeErrors = errs
+	if negotiation {
+		merged.Negotiation = merged.NegotiationTable()
+	}
+	if families {
+		merged.TypeFamilies = merged.FindTypeFamilies()
+	}
+	merged.Canonicalize()
+	return merged, nil
+}
+
+type facadeKey struct {
+	name    string
+	version int
+}
+
+// sameJSON reports whether x and y have the same JSON encoding.
+// It is used rather than reflect.DeepEqual because types may hold
+// unexported state that isn't part of the document.
+func sameJSON(x, y interface{}) bool {
+	xdata, err := json.Marshal(x)
+	if err != nil {
+		return false
+	}
+	ydata, err := json.Marshal(y)
+	if err != nil {
+		return false
+	}
+	return string(xdata) == string(ydata)
+}
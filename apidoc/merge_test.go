@@ -0,0 +1,161 @@
+package apidoc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+var mergeTests = []struct {
+	about              string
+	infos              []*Info
+	expectFacades      []FacadeInfo
+	expectFacadeErrors []FacadeError
+	expectError        string
+}{{
+	about: "facades are combined and canonicalized",
+	infos: []*Info{{
+		Facades: []FacadeInfo{{
+			Name:    "Singular",
+			Version: 2,
+			Doc:     "\nSingular claims control.  \n",
+			Methods: []Method{{
+				Name: "Wait",
+			}, {
+				Name: "Claim",
+			}},
+			AvailableTo: []string{"machine-agent", "controller-machine-agent"},
+			Leases: []LeaseParameter{{
+				Value: "1m0s",
+				Doc:   "\n doc  \n",
+			}},
+		}},
+	}, {
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+		}},
+	}},
+	expectFacades: []FacadeInfo{{
+		Name:    "Client",
+		Version: 1,
+	}, {
+		Name:    "Singular",
+		Version: 2,
+		Doc:     "Singular claims control.\n",
+		Methods: []Method{{
+			Name: "Claim",
+		}, {
+			Name: "Wait",
+		}},
+		AvailableTo: []string{"controller-machine-agent", "machine-agent"},
+		Leases: []LeaseParameter{{
+			Value: "1m0s",
+			Doc:   " doc\n",
+		}},
+	}},
+}, {
+	about: "the same facade version in two places is kept once",
+	infos: []*Info{{
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+			Source:  &SourcePos{File: "a.go"},
+		}},
+	}, {
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+			Source:  &SourcePos{File: "b.go"},
+		}},
+	}},
+	expectFacades: []FacadeInfo{{
+		Name:    "Client",
+		Version: 1,
+		Source:  &SourcePos{File: "a.go"},
+	}},
+}, {
+	about: "facade errors are dropped for facades documented elsewhere",
+	infos: []*Info{{
+		FacadeErrors: []FacadeError{{
+			Facade:  "Client",
+			Version: 1,
+			Message: "cannot load",
+		}, {
+			Facade:  "Pinger",
+			Version: 1,
+			Message: "cannot load",
+		}},
+	}, {
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+		}},
+	}},
+	expectFacades: []FacadeInfo{{
+		Name:    "Client",
+		Version: 1,
+	}},
+	expectFacadeErrors: []FacadeError{{
+		Facade:  "Pinger",
+		Version: 1,
+		Message: "cannot load",
+	}},
+}, {
+	about: "conflicts are all reported",
+	infos: []*Info{{
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+			Methods: []Method{{
+				Name: "Status",
+			}},
+		}},
+		ErrorCodes: []ErrorCode{{
+			Name: "CodeNotFound",
+			Code: "not found",
+		}},
+	}, {
+		Facades: []FacadeInfo{{
+			Name:    "Client",
+			Version: 1,
+			Methods: []Method{{
+				Name:  "Status",
+				Param: &jsontypes.Type{Kind: jsontypes.String},
+			}},
+		}},
+		ErrorCodes: []ErrorCode{{
+			Name: "CodeNotFound",
+			Code: "missing",
+		}},
+	}},
+	expectError: `cannot merge documents: error code CodeNotFound has conflicting values "not found" and "missing"; facade Client(1) has conflicting definitions`,
+}}
+
+func TestMerge(t *testing.T) {
+	for _, test := range mergeTests {
+		t.Run(test.about, func(t *testing.T) {
+			before := mustMarshal(t, test.infos)
+			merged, err := Merge(test.infos...)
+			if after := mustMarshal(t, test.infos); after != before {
+				t.Errorf("inputs changed\nbefore %s\nafter  %s", before, after)
+			}
+			if test.expectError != "" {
+				if err == nil || err.Error() != test.expectError {
+					t.Fatalf("unexpected error\ngot    %v\nexpect %s", err, test.expectError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(merged.Facades, test.expectFacades) {
+				t.Errorf("unexpected facades\ngot    %s\nexpect %s", mustMarshal(t, merged.Facades), mustMarshal(t, test.expectFacades))
+			}
+			if !reflect.DeepEqual(merged.FacadeErrors, test.expectFacadeErrors) {
+				t.Errorf("unexpected facade errors\ngot    %s\nexpect %s", mustMarshal(t, merged.FacadeErrors), mustMarshal(t, test.expectFacadeErrors))
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package apidoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// Messages holds a catalog of the human-readable text produced by
+// RenderHTMLMessages, RenderMarkdownMessages and
+// RenderPermissionMatrixHTMLMessages, such as section
+// headings and notes on methods, keyed by message id, so that
+// documentation can be rendered in other languages from the same
+// document. Messages that take arguments are formatted as by
+// fmt.Sprintf, so a translation can reorder them with explicit
+// argument indexes such as %[2]s.
+//
+// Only the text of the renderers is held: facade and method
+// names, doc comments and the reasons given by the generator
+// appear as they are in the document.
+type Messages map[string]string
+
+// DefaultMessages holds the English messages, used for any
+// message missing from a catalog.
+var DefaultMessages = Messages{
+	"title":      "Juju API facades",
+	"html-title": "Juju API docs (autogenerated)",
+	"none":       "n/a",
+
+	"tags-heading": "Tags",
+	"tags-filter":  "Show only the facades tagged",
+	"tags-all":     "(all)",
+
+	"cross-model-heading": "Cross-model relations",
+	"cross-model-intro": "A cross-model relation connects an application offered in one model " +
+		"with an application in another model, which may be on another controller. " +
+		"The facades involved are called from different places:",
+	"cross-model-step-offer": "A user finds an offer with ApplicationOffers on the offering controller, " +
+		"and calls GetConsumeDetails to get the offer's details and a macaroon " +
+		"that grants access to it.",
+	"cross-model-step-relay": "The consuming controller's remote relations worker uses RemoteRelations " +
+		"on its own controller to watch for local changes, and relays them by " +
+		"calling CrossModelRelations on the offering controller.",
+	"cross-model-step-discharge": "Every call to CrossModelRelations carries macaroons for the offer or relation. " +
+		"If one needs to be discharged, the call fails with an error with the code " +
+		"\"discharge required\" holding the macaroon to discharge. The caller " +
+		"discharges it with the third party that it names, usually the offering " +
+		"controller, and makes the call again with the discharged macaroons.",
+	"cross-model-facades": "The facades in the group:",
+
+	"migration-heading": "Model migration",
+	"migration-intro": "A model migration moves a model from one controller to another. " +
+		"It is driven by the migration master worker on the source controller, " +
+		"which calls MigrationMaster on its own controller and MigrationTarget " +
+		"on the target controller, in these steps:",
+	"migration-step-initiate": "A user starts the migration by calling InitiateMigration " +
+		"on the source controller, giving the target controller's details.",
+	"migration-step-watch": "The migration master watches for the migration, and records " +
+		"each phase that it moves it through and its progress, so that clients " +
+		"can follow it.",
+	"migration-step-prechecks": "Both controllers check that the model can be migrated, " +
+		"for example that its machines and agents are healthy and that the " +
+		"target controller is new enough and doesn't already have the model.",
+	"migration-step-export": "The migration master exports the model from the source controller.",
+	"migration-step-import": "The migration master imports the exported model into the target " +
+		"controller, where it stays inactive until it is activated.",
+	"migration-step-validation": "The model's agents are told about the migration, check " +
+		"that they can reach the target controller, and report back; the target " +
+		"controller checks the model's machines against the cloud.",
+	"migration-step-activate": "Once every agent has reported success, the migration master " +
+		"activates the model on the target controller. From here on the migration " +
+		"cannot be aborted.",
+	"migration-step-logtransfer": "The migration master transfers the model's logs, " +
+		"starting after the latest one that the target controller already has.",
+	"migration-step-reap": "The target controller takes over the model's cloud resources, " +
+		"and the migration master removes the model from the source controller.",
+	"migration-step-abort": "If the migration fails before the model is activated, the " +
+		"migration master removes the imported model from the target controller.",
+
+	"available-to":   "Available to: %s",
+	"releases":       "Releases: %s",
+	"releases-open":  "%s and later",
+	"releases-range": "%s to before %s",
+	"tags":           "Tags: %s",
+	"test-only":      "Only for testing: production controllers don't serve this facade (%s).",
+	"leases":         "Lease parameters:",
+	"lease-checked":  "checked by %s as",
+	"quickstart":     "Quickstart: connect and call %s",
+
+	"requirements":               "Depends on: %s.",
+	"requirement-feature-flag":   "the %s feature flag",
+	"requirement-secret-backend": "the %s secret backend",
+	"requirement-model-type":     "the %s model type",
+
+	"login-target-any":        "Served on the controller endpoint (%s) and on model endpoints (%s).",
+	"login-target-controller": "Served only on the controller endpoint (%s): calls on a model endpoint (%s) are refused.",
+	"login-target-model":      "Served only on model endpoints (%s): calls on the controller endpoint (%s) are refused.",
+	"login-target-none":       "Not served on any endpoint.",
+
+	"name":        "Name",
+	"params":      "Params",
+	"results":     "Results",
+	"description": "Description",
+
+	"usage":           "Observed calls: %d.",
+	"usage-callers":   "Observed calls: %d, mostly by %s.",
+	"macaroons":       "Macaroons: %s.",
+	"macaroons-sent":  "sent in %s",
+	"macaroons-reply": "returned in %s",
+	"sensitive":       "Sensitive: payloads may hold secrets and should not be logged.",
+	"sensitive-why":   "Sensitive: payloads may hold secrets and should not be logged (%s).",
+	"audit-excluded":  "Not recorded in the audit log by default, as it is read-only.",
+	"watcher":         "Uses a watcher: changes are polled for by calling Next on the watcher facade.",
+	"superuser":       "Requires superuser access to the controller: admin access to the model is not enough.",
+	"permissions":     "Checks for: %s.",
+	"permission":      "%s access to the %s",
+	"per-item-errors": "Each result holds its own error: a successful call may still have failed for some items.",
+	"single-entity":   "For a single entity, send {\"%[1]s\": [%[2]s]} and read the %[4]s in %[3]s[0].",
+	"retry-safe":      "Safe to retry (%s confidence)",
+	"retry-unsafe":    "Not safe to retry (%s confidence)",
+	"ordered":         "Results are in the same order as the params (%s confidence)",
+	"unordered":       "Results may not be in the same order as the params (%s confidence)",
+	"errors":          "Errors:",
+
+	"example":             "Example: %s",
+	"example-synthesized": "sample values",
+	"example-params":      "Params:",
+	"example-result":      "Result:",
+
+	"operational-heading":    "Operational behavior",
+	"operational-intro":      "Settings in the API server that govern every connection.",
+	"operational-category":   "Category",
+	"operational-setting":    "Setting",
+	"operational-value":      "Value",
+	"operational-connection": "Connections",
+	"operational-login":      "Logins",
+	"operational-keepalive":  "Keepalive",
+
+	"matrix-title":            "Juju API permission matrix",
+	"matrix-html-title":       "Juju API permission matrix (autogenerated)",
+	"matrix-assumed":          "\"assumed\" means that the facade factory panicked when called on behalf of that kind of entity, so the facade is assumed to be available to it.",
+	"matrix-facade":           "Facade",
+	"matrix-version":          "Version",
+	"matrix-method":           "Method",
+	"matrix-permission-check": "Permission check",
+	"matrix-unchecked":        "none found",
+	"matrix-superuser":        "Superuser",
+	"matrix-required":         "required",
+
+	"sentinel-heading": "Sentinel errors",
+	"sentinel-code":    "Code",
+	"sentinel-message": "Message",
+}
+
+// crossModelSteps holds the ids of the messages listing
+// the steps of a cross-model relation, in order.
+var crossModelSteps = []string{
+	"cross-model-step-offer",
+	"cross-model-step-relay",
+	"cross-model-step-discharge",
+}
+
+// ReadMessages reads a message catalog from the named file, which
+// holds a JSON object mapping message ids to messages. It's an error
+// for the file to hold an id that isn't in DefaultMessages, as that's
+// most likely a mistake in the translation.
+func ReadMessages(path string) (Messages, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	var msgs Messages
+	if err := json.Unmarshal(data, &msgs); err != nil {
+		return nil, errors.Notef(err, nil, "cannot parse %s", path)
+	}
+	var unknown []string
+	for id := range msgs {
+		if _, ok := DefaultMessages[id]; !ok {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, errors.Newf("%s has unknown message ids %q", path, unknown)
+	}
+	return msgs, nil
+}
+
+// Get returns the message with the given id formatted with the
+// given arguments. Messages missing from m are taken from
+// DefaultMessages; m may be nil.
+func (m Messages) Get(id string, args ...interface{}) string {
+	msg, ok := m[id]
+	if !ok {
+		msg, ok = DefaultMessages[id]
+		if !ok {
+			// A renderer has asked for a message that
+			// hasn't been added to DefaultMessages.
+			panic(fmt.Sprintf("unknown message id %q", id))
+		}
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// releaseRange returns r described with the messages in m.
+func (m Messages) releaseRange(r *ReleaseRange) string {
+	if r.Removed == "" {
+		return m.Get("releases-open", r.Introduced)
+	}
+	return m.Get("releases-range", r.Introduced, r.Removed)
+}
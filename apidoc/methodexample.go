@@ -0,0 +1,136 @@
+package apidoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/token"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// MethodExample holds an example of the params sent to a method
+// and the result returned, for showing in the documentation.
+type MethodExample struct {
+	Title       string
+	Description string          `json:",omitempty"`
+	Params      json.RawMessage `json:",omitempty"`
+	Result      json.RawMessage `json:",omitempty"`
+
+	// Synthesized holds whether the example was synthesized
+	// from the param and result schemas, in which case it
+	// shows the shape of the payloads rather than a meaningful
+	// call. Other examples are curated; see AddExamples.
+	Synthesized bool `json:",omitempty"`
+}
+
+// SampleExample returns an example for m with sample values
+// synthesized from its param and result schemas, or nil if it
+// has neither. The defs are as returned by SchemaDefinitions.
+func (info *Info) SampleExample(m *Method, defs map[string]*Schema) *MethodExample {
+	if m.Param == nil && m.Result == nil {
+		return nil
+	}
+	e := &MethodExample{
+		Title:       DefaultMessages.Get("example-synthesized"),
+		Synthesized: true,
+	}
+	if m.Param != nil {
+		if data, err := json.Marshal(info.Schema(m.Param).Sample(defs)); err == nil {
+			e.Params = data
+		}
+	}
+	if m.Result != nil {
+		if data, err := json.Marshal(info.Schema(m.Result).Sample(defs)); err == nil {
+			e.Result = data
+		}
+	}
+	return e
+}
+
+// ReadExamples reads curated examples from the JSON file at path,
+// which holds an object mapping from methods, as Facade.Method, to
+// their examples. See AddExamples.
+func ReadExamples(path string) (map[string][]MethodExample, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	var examples map[string][]MethodExample
+	if err := json.Unmarshal(data, &examples); err != nil {
+		return nil, errors.Notef(err, nil, "cannot parse %s", path)
+	}
+	var bad []string
+	for key, es := range examples {
+		parts := strings.Split(key, ".")
+		if len(parts) != 2 || !token.IsIdentifier(parts[0]) || !token.IsIdentifier(parts[1]) {
+			bad = append(bad, key)
+			continue
+		}
+		for _, e := range es {
+			if e.Title == "" || e.Synthesized {
+				bad = append(bad, key)
+				break
+			}
+		}
+	}
+	if len(bad) > 0 {
+		sort.Strings(bad)
+		return nil, errors.Newf("%s has invalid examples for %q: keys must be Facade.Method, and examples need a Title and can't be Synthesized", path, bad)
+	}
+	return examples, nil
+}
+
+// AddExamples adds the given curated examples, keyed by method as
+// Facade.Method, to every version of the methods in info that they
+// are for. They go before any synthesized examples, as they're
+// more useful. It returns an error naming the methods that aren't
+// in info, as they're probably mistyped.
+func (info *Info) AddExamples(examples map[string][]MethodExample) error {
+	used := make(map[string]bool)
+	for i := range info.Facades {
+		f := &info.Facades[i]
+		for j := range f.Methods {
+			m := &f.Methods[j]
+			key := f.Name + "." + m.Name
+			es, ok := examples[key]
+			if !ok {
+				continue
+			}
+			used[key] = true
+			m.Examples = append(append([]MethodExample(nil), es...), m.Examples...)
+		}
+	}
+	var unknown []string
+	for key := range examples {
+		if !used[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return errors.Newf("examples given for unknown methods %q", unknown)
+	}
+	return nil
+}
+
+// exampleTitle returns the title of e, taking the title of
+// a synthesized example from the message catalog.
+func exampleTitle(msgs Messages, e MethodExample) string {
+	if e.Synthesized {
+		return msgs.Get("example-synthesized")
+	}
+	return e.Title
+}
+
+// exampleJSON returns the given example params or
+// result indented for showing in the documentation.
+func exampleJSON(data json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return string(data)
+	}
+	return buf.String()
+}
@@ -0,0 +1,102 @@
+package apidoc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MigrationStep holds a step of a model migration and the
+// facade methods called in it.
+type MigrationStep struct {
+	// ID holds the id of the message describing the step.
+	ID string
+
+	// Methods holds the methods called in the step, in the
+	// newest version of their facade that has them.
+	Methods []MethodRef
+}
+
+// migrationSteps holds the steps of a model migration, in order,
+// with the methods, as Facade.Method, called in each. The
+// MigrationMaster facade is used by the migration master worker
+// on the source controller, which drives the migration; the
+// MigrationTarget facade is provided by the target controller;
+// and the MigrationMinion facade is used by the agents of the
+// model to report on their progress.
+var migrationSteps = []struct {
+	id      string
+	methods []string
+}{
+	{"migration-step-initiate", []string{"Controller.InitiateMigration"}},
+	{"migration-step-watch", []string{"MigrationMaster.Watch", "MigrationMaster.MigrationStatus", "MigrationMaster.SetPhase", "MigrationMaster.SetStatusMessage"}},
+	{"migration-step-prechecks", []string{"MigrationMaster.Prechecks", "MigrationTarget.Prechecks"}},
+	{"migration-step-export", []string{"MigrationMaster.ModelInfo", "MigrationMaster.Export"}},
+	{"migration-step-import", []string{"MigrationTarget.Import"}},
+	{"migration-step-validation", []string{"MigrationMaster.WatchMinionReports", "MigrationMaster.MinionReports", "MigrationMinion.Watch", "MigrationMinion.Report", "MigrationTarget.CheckMachines"}},
+	{"migration-step-activate", []string{"MigrationTarget.Activate"}},
+	{"migration-step-logtransfer", []string{"MigrationTarget.LatestLogTime"}},
+	{"migration-step-reap", []string{"MigrationTarget.AdoptResources", "MigrationMaster.Reap"}},
+	{"migration-step-abort", []string{"MigrationTarget.Abort"}},
+}
+
+// MigrationWorkflow returns the steps of a model migration, in
+// order, with the methods in info that are called in each. Steps
+// with no methods in info are left out, so the result is empty if
+// info holds none of the migration facades.
+func (info *Info) MigrationWorkflow() []MigrationStep {
+	newest := make(map[string]MethodRef)
+	for _, f := range info.Facades {
+		for _, m := range f.Methods {
+			key := f.Name + "." + m.Name
+			if ref, ok := newest[key]; !ok || f.Version > ref.Version {
+				newest[key] = MethodRef{f.Name, f.Version, m.Name}
+			}
+		}
+	}
+	var steps []MigrationStep
+	for _, s := range migrationSteps {
+		step := MigrationStep{
+			ID: s.id,
+		}
+		for _, name := range s.methods {
+			if ref, ok := newest[name]; ok {
+				step.Methods = append(step.Methods, ref)
+			}
+		}
+		if len(step.Methods) > 0 {
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+// markdownAnchors returns the anchors that GitHub gives the method
+// headings written by RenderMarkdown. A heading's anchor is its
+// text in lower case without punctuation, with a numeric suffix
+// for all but the first heading with the same text, as there is
+// for each version of a facade after the first.
+func markdownAnchors(info *Info) map[MethodRef]string {
+	anchors := make(map[MethodRef]string)
+	seen := make(map[string]int)
+	for _, f := range info.Facades {
+		for _, m := range f.Methods {
+			anchor := strings.Map(func(r rune) rune {
+				switch {
+				case 'a' <= r && r <= 'z', '0' <= r && r <= '9', r == '-', r == '_':
+					return r
+				case 'A' <= r && r <= 'Z':
+					return r - 'A' + 'a'
+				}
+				return -1
+			}, f.Name+"."+m.Name)
+			ref := MethodRef{f.Name, f.Version, m.Name}
+			if n := seen[anchor]; n > 0 {
+				anchors[ref] = fmt.Sprintf("%s-%d", anchor, n)
+			} else {
+				anchors[ref] = anchor
+			}
+			seen[anchor]++
+		}
+	}
+	return anchors
+}
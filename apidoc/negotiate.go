@@ -0,0 +1,142 @@
+package apidoc
+
+import (
+	"encoding/json"
+	"sort"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// FacadeNegotiation holds the outcome of facade version
+// negotiation between a server supporting the versions of a
+// facade in the document and clients supporting various
+// ranges of versions.
+type FacadeNegotiation struct {
+	Facade string
+	// ServerVersions holds the versions of the
+	// facade that the server supports, in order.
+	ServerVersions []int
+	Outcomes       []NegotiationOutcome
+}
+
+// NegotiationOutcome records the version chosen by
+// a client that supports all the versions from ClientMin
+// to ClientMax inclusive. Best is zero if there is
+// no version in common.
+type NegotiationOutcome struct {
+	ClientMin int
+	ClientMax int
+	Best      int
+}
+
+// BestVersion returns the facade version that a client
+// supporting the client versions will use with a server
+// supporting the server versions. As in the Juju API client,
+// this is the newest version supported by both, or zero
+// if there is none.
+func BestVersion(client, server []int) int {
+	best := 0
+	for _, v := range server {
+		if v <= best {
+			continue
+		}
+		for _, cv := range client {
+			if cv == v {
+				best = v
+				break
+			}
+		}
+	}
+	return best
+}
+
+// FacadeVersions returns the versions of each facade
+// in info, in order.
+func (info *Info) FacadeVersions() map[string][]int {
+	versions := make(map[string][]int)
+	for _, f := range info.Facades {
+		vs := versions[f.Name]
+		if i := sort.SearchInts(vs, f.Version); i == len(vs) || vs[i] != f.Version {
+			vs = append(vs, 0)
+			copy(vs[i+1:], vs[i:])
+			vs[i] = f.Version
+		}
+		versions[f.Name] = vs
+	}
+	return versions
+}
+
+// NegotiationTable returns the negotiation outcomes for every
+// facade in info, sorted by facade name. The client ranges
+// covered are all those between version 1 and one more than the
+// newest server version, so the table includes clients older
+// and newer than the server as well as those that overlap it.
+func (info *Info) NegotiationTable() []FacadeNegotiation {
+	versions := info.FacadeVersions()
+	names := make([]string, 0, len(versions))
+	for name := range versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	table := make([]FacadeNegotiation, 0, len(names))
+	for _, name := range names {
+		server := versions[name]
+		n := FacadeNegotiation{
+			Facade:         name,
+			ServerVersions: server,
+		}
+		newest := server[len(server)-1]
+		for lo := 1; lo <= newest+1; lo++ {
+			var client []int
+			for hi := lo; hi <= newest+1; hi++ {
+				client = append(client, hi)
+				n.Outcomes = append(n.Outcomes, NegotiationOutcome{
+					ClientMin: lo,
+					ClientMax: hi,
+					Best:      BestVersion(client, server),
+				})
+			}
+		}
+		table = append(table, n)
+	}
+	return table
+}
+
+// ParseClientFacades parses a manifest of the facade versions
+// supported by a client. The manifest is a JSON object mapping
+// facade names to versions. As in the Juju API client, each
+// entry may be a single version or a list of versions.
+func ParseClientFacades(data []byte) (map[string][]int, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, errors.Notef(err, nil, "cannot parse client facades")
+	}
+	facades := make(map[string][]int)
+	for name, v := range raw {
+		var version int
+		if err := json.Unmarshal(v, &version); err == nil {
+			facades[name] = []int{version}
+			continue
+		}
+		var versions []int
+		if err := json.Unmarshal(v, &versions); err != nil {
+			return nil, errors.Newf("invalid versions for facade %q: want a version or a list of versions", name)
+		}
+		facades[name] = versions
+	}
+	return facades, nil
+}
+
+// Negotiate returns the version of each facade that a client
+// supporting the given facade versions will use with a server
+// described by info. Facades with no version in common
+// are omitted.
+func (info *Info) Negotiate(client map[string][]int) map[string]int {
+	chosen := make(map[string]int)
+	for name, server := range info.FacadeVersions() {
+		if best := BestVersion(client[name], server); best > 0 {
+			chosen[name] = best
+		}
+	}
+	return chosen
+}
@@ -0,0 +1,85 @@
+package apidoc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Access levels held in PermissionRequirement.Level.
+// They're the values of juju's permission.Access.
+const (
+	LevelLogin     = "login"
+	LevelAddModel  = "add-model"
+	LevelSuperuser = "superuser"
+	LevelRead      = "read"
+	LevelWrite     = "write"
+	LevelAdmin     = "admin"
+	LevelConsume   = "consume"
+)
+
+// Scopes held in PermissionRequirement.Scope.
+const (
+	ScopeController = "controller"
+	ScopeModel      = "model"
+	ScopeCloud      = "cloud"
+	ScopeOffer      = "offer"
+)
+
+// PermissionEntityUser is the PermissionRequirement.EntityKind
+// of users, the only entities that are granted access levels.
+const PermissionEntityUser = "user"
+
+// PermissionRequirement records an access level that a method
+// checks that its caller has. It's found heuristically in the
+// calls that the method's code makes, and when a method checks
+// for more than one, as many do to let either model admins or
+// controller superusers through, the caller usually needs only
+// one of them.
+type PermissionRequirement struct {
+	// EntityKind holds the kind of the tag of the entity
+	// whose access is checked, such as PermissionEntityUser.
+	EntityKind string
+
+	// Level holds the access level checked for,
+	// such as LevelAdmin.
+	Level string
+
+	// Scope holds what the access is to, such
+	// as ScopeModel.
+	Scope string
+
+	// Check holds the source of the call that makes
+	// the check, such as
+	// "api.authorizer.HasPermission(permission.AdminAccess, api.modelTag)".
+	Check string `json:",omitempty"`
+}
+
+// String returns the requirement without its check,
+// such as "user:model:admin".
+func (r PermissionRequirement) String() string {
+	return r.EntityKind + ":" + r.Scope + ":" + r.Level
+}
+
+// permissionsString returns the requirements without their
+// checks, sorted, for comparing the requirements of methods.
+func permissionsString(ps []PermissionRequirement) string {
+	ss := make([]string, len(ps))
+	for i, p := range ps {
+		ss[i] = p.String()
+	}
+	sort.Strings(ss)
+	return strings.Join(ss, ", ")
+}
+
+// permissionsNote returns a note of the given requirements.
+func permissionsNote(msgs Messages, ps []PermissionRequirement) string {
+	notes := make([]string, len(ps))
+	for i, p := range ps {
+		notes[i] = msgs.Get("permission", p.Level, p.Scope)
+		if p.Check != "" {
+			notes[i] += fmt.Sprintf(" (%s)", p.Check)
+		}
+	}
+	return msgs.Get("permissions", strings.Join(notes, "; "))
+}
@@ -0,0 +1,82 @@
+package apidoc
+
+import (
+	"reflect"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// Values of FieldInfo.Presence.
+const (
+	Required = "required"
+	Optional = "optional"
+)
+
+// FieldPresence returns whether a value must be given for the
+// field f of the type with the given name, either Required or
+// Optional, and the reason why. The presence recorded in
+// info.Fields is used if there is one; otherwise it is decided
+// by StaticPresence.
+func (info *Info) FieldPresence(t jsontypes.TypeName, f *jsontypes.Field) (presence, reason string) {
+	if fi := info.Field(t, f.Name); fi != nil && fi.Presence != "" {
+		return fi.Presence, fi.PresenceReason
+	}
+	return StaticPresence(f)
+}
+
+// StaticPresence returns whether a value must be given for f
+// judging by its declaration alone: a field that is marked
+// omitempty or has a pointer type is optional, and any other
+// field is required, because it is always encoded.
+func StaticPresence(f *jsontypes.Field) (presence, reason string) {
+	if _, omitEmpty := FieldWireName(f); omitEmpty {
+		return Optional, "marked omitempty"
+	}
+	if f.Type != nil && f.Type.Kind == jsontypes.Ptr {
+		return Optional, "pointer field"
+	}
+	return Required, "always encoded"
+}
+
+// MarkPresence sets the presence of every encoded field of
+// every struct in info.TypeInfo that doesn't already have one
+// in info.Fields, as decided by StaticPresence, so that
+// readers of the document don't need to work it out. The
+// fields of embedded structs are marked in their own type.
+func (info *Info) MarkPresence() {
+	if info.TypeInfo == nil {
+		return
+	}
+	marked := make(map[jsontypes.TypeName]map[string]bool)
+	for _, fi := range info.Fields {
+		if fi.Presence == "" {
+			continue
+		}
+		if marked[fi.Type] == nil {
+			marked[fi.Type] = make(map[string]bool)
+		}
+		marked[fi.Type][fi.Field] = true
+	}
+	for name, t := range info.TypeInfo.Types {
+		if t.Kind != jsontypes.Struct {
+			continue
+		}
+		for _, f := range t.Fields {
+			wireName, _ := FieldWireName(f)
+			if wireName == "" || f.Anonymous && reflect.StructTag(f.Tag).Get("json") == "" {
+				continue
+			}
+			if marked[name][f.Name] {
+				continue
+			}
+			presence, reason := StaticPresence(f)
+			info.Fields = append(info.Fields, FieldInfo{
+				Type:           name,
+				Field:          f.Name,
+				Presence:       presence,
+				PresenceReason: reason,
+			})
+		}
+	}
+	info.Fields = combineFields(info.Fields)
+}
@@ -0,0 +1,304 @@
+package apidoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// Quickstart holds short programs that connect to a controller
+// and call a method of a facade, one for each client library.
+type Quickstart struct {
+	// Method holds the name of the method that is called.
+	Method string
+
+	// Snippets holds the programs, one for
+	// each client library.
+	Snippets []Snippet
+}
+
+// Snippet holds a program written with a client library.
+type Snippet struct {
+	// Language holds the language of the program,
+	// as used to highlight code blocks, such as "go".
+	Language string
+
+	// Library holds the name of the client library.
+	Library string
+
+	Code string
+}
+
+// clientLibraries maps each client library that quickstart
+// snippets are written for to the function that writes them
+// with the library's wrapper for the facade.
+var clientLibraries = []struct {
+	language string
+	library  string
+	snippet  func(e *Example, param, result *jsontypes.Type) string
+}{{
+	"go", "github.com/juju/juju/api", goSnippet,
+}, {
+	"python", "python-libjuju", pythonSnippet,
+}}
+
+// Quickstart returns a quickstart for f, calling the first of its
+// methods that isn't a watcher, or nil if it has no methods. The
+// params are samples taken from the schemas in defs, as returned
+// by SchemaDefinitions. Like the examples returned by Examples, the
+// programs take the controller address, the model UUID and the
+// credentials from the environment variables JUJU_CONTROLLER,
+// JUJU_MODEL_UUID, JUJU_USER and JUJU_PASSWORD, and the controller's
+// CA certificate from JUJU_CA_CERT.
+func (info *Info) Quickstart(f *FacadeInfo, defs map[string]*Schema) *Quickstart {
+	if len(f.Methods) == 0 {
+		return nil
+	}
+	m := &f.Methods[0]
+	for i := range f.Methods {
+		if f.Methods[i].Invocation != InvocationWatcher {
+			m = &f.Methods[i]
+			break
+		}
+	}
+	e := &Example{
+		Facade:  f.Name,
+		Version: f.Version,
+		Method:  m.Name,
+	}
+	if m.Param != nil {
+		e.Request.Params = info.Schema(m.Param).Sample(defs)
+	}
+	q := &Quickstart{
+		Method: m.Name,
+	}
+	for _, lib := range clientLibraries {
+		q.Snippets = append(q.Snippets, Snippet{
+			Language: lib.language,
+			Library:  lib.library,
+			Code:     lib.snippet(e, m.Param, m.Result),
+		})
+	}
+	return q
+}
+
+// goSnippet returns a Go program that makes the call in e
+// through a facade caller from the juju api packages.
+func goSnippet(e *Example, param, result *jsontypes.Type) string {
+	imports := map[string]bool{
+		"context":                            true,
+		"fmt":                                true,
+		"log":                                true,
+		"os":                                 true,
+		"github.com/juju/juju/api/base":      true,
+		"github.com/juju/juju/api/connector": true,
+	}
+	args := "nil"
+	if param != nil {
+		args = "args"
+		imports[goTypeImport(param)] = true
+	}
+	response := "nil"
+	if result != nil {
+		response = "&result"
+		imports[goTypeImport(result)] = true
+	}
+	delete(imports, "")
+	var paths []string
+	for p := range imports {
+		paths = append(paths, p)
+	}
+	// The standard library packages come first,
+	// as goimports would have them.
+	sort.Slice(paths, func(i, j int) bool {
+		if si, sj := isStdPackage(paths[i]), isStdPackage(paths[j]); si != sj {
+			return si
+		}
+		return paths[i] < paths[j]
+	})
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "package main\n\nimport (\n")
+	for i, p := range paths {
+		if i > 0 && isStdPackage(paths[i-1]) && !isStdPackage(p) {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "\t%q\n", p)
+	}
+	fmt.Fprintf(&buf, ")\n\n")
+	fmt.Fprintf(&buf, "func main() {\n")
+	fmt.Fprintf(&buf, "\tc, err := connector.NewSimple(connector.SimpleConfig{\n")
+	fmt.Fprintf(&buf, "\t\tControllerAddresses: []string{os.Getenv(\"JUJU_CONTROLLER\")},\n")
+	fmt.Fprintf(&buf, "\t\tModelUUID:           os.Getenv(\"JUJU_MODEL_UUID\"),\n")
+	fmt.Fprintf(&buf, "\t\tCACert:              os.Getenv(\"JUJU_CA_CERT\"),\n")
+	fmt.Fprintf(&buf, "\t\tUsername:            os.Getenv(\"JUJU_USER\"),\n")
+	fmt.Fprintf(&buf, "\t\tPassword:            os.Getenv(\"JUJU_PASSWORD\"),\n")
+	fmt.Fprintf(&buf, "\t})\n")
+	fmt.Fprintf(&buf, "\tif err != nil {\n\t\tlog.Fatal(err)\n\t}\n")
+	fmt.Fprintf(&buf, "\tconn, err := c.Connect()\n")
+	fmt.Fprintf(&buf, "\tif err != nil {\n\t\tlog.Fatal(err)\n\t}\n")
+	fmt.Fprintf(&buf, "\tdefer conn.Close()\n")
+	fmt.Fprintf(&buf, "\tcaller := base.NewFacadeCallerForVersion(conn, %q, %d)\n", e.Facade, e.Version)
+	if param != nil {
+		fmt.Fprintf(&buf, "\t// On the wire, the params look like this:\n")
+		fmt.Fprintf(&buf, "\t//\t%s\n", FrameJSON(e.Request.Params))
+		fmt.Fprintf(&buf, "\tvar args %s\n", goTypeName(param))
+	}
+	if result != nil {
+		fmt.Fprintf(&buf, "\tvar result %s\n", goTypeName(result))
+	}
+	fmt.Fprintf(&buf, "\tif err := caller.FacadeCall(context.Background(), %q, %s, %s); err != nil {\n", e.Method, args, response)
+	fmt.Fprintf(&buf, "\t\tlog.Fatal(err)\n\t}\n")
+	if result != nil {
+		fmt.Fprintf(&buf, "\tfmt.Printf(\"%%+v\\n\", result)\n")
+	}
+	fmt.Fprintf(&buf, "}\n")
+	return buf.String()
+}
+
+// isStdPackage reports whether the package with the given
+// path is in the standard library, which has no dots in the
+// first element of its paths.
+func isStdPackage(path string) bool {
+	return !strings.Contains(strings.Split(path, "/")[0], ".")
+}
+
+// goTypeName returns the name of t as written in a Go
+// program that imports its package, such as "params.Entities".
+func goTypeName(t *jsontypes.Type) string {
+	if t.Name == "" {
+		// Wire types are named, so this shouldn't happen,
+		// but any value can be decoded into an interface.
+		return "interface{}"
+	}
+	return packageName(t.Name.PkgPath()) + "." + t.Name.Name()
+}
+
+// goTypeImport returns the path of the package that declares t,
+// or the empty string if it's not declared in a package.
+func goTypeImport(t *jsontypes.Type) string {
+	if t.Name == "" {
+		return ""
+	}
+	return t.Name.PkgPath()
+}
+
+// pythonSnippet returns a Python program that makes the call in e
+// through the facade class generated by python-libjuju.
+func pythonSnippet(e *Example, param, result *jsontypes.Type) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "import asyncio\nimport os\n\n")
+	fmt.Fprintf(&buf, "from juju.client import client\nfrom juju.model import Model\n\n\n")
+	fmt.Fprintf(&buf, "async def main():\n")
+	fmt.Fprintf(&buf, "    model = Model()\n")
+	fmt.Fprintf(&buf, "    await model.connect(\n")
+	fmt.Fprintf(&buf, "        endpoint=os.environ[\"JUJU_CONTROLLER\"],\n")
+	fmt.Fprintf(&buf, "        uuid=os.environ[\"JUJU_MODEL_UUID\"],\n")
+	fmt.Fprintf(&buf, "        username=os.environ[\"JUJU_USER\"],\n")
+	fmt.Fprintf(&buf, "        password=os.environ[\"JUJU_PASSWORD\"],\n")
+	fmt.Fprintf(&buf, "        cacert=os.environ[\"JUJU_CA_CERT\"],\n")
+	fmt.Fprintf(&buf, "    )\n")
+	fmt.Fprintf(&buf, "    try:\n")
+	fmt.Fprintf(&buf, "        # from_connection uses the newest version of %s that both\n", e.Facade)
+	fmt.Fprintf(&buf, "        # libjuju and the controller support, which may not be %d.\n", e.Version)
+	fmt.Fprintf(&buf, "        facade = client.%sFacade.from_connection(model.connection())\n", e.Facade)
+	fmt.Fprintf(&buf, "        result = await facade.%s(", e.Method)
+	if obj, ok := e.Request.Params.(map[string]interface{}); ok && len(obj) > 0 {
+		names := make([]string, 0, len(obj))
+		for name := range obj {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		buf.WriteString("\n")
+		for _, name := range names {
+			fmt.Fprintf(&buf, "            %s=%s,\n", pythonName(name), pythonLiteral(obj[name]))
+		}
+		buf.WriteString("        ")
+	}
+	fmt.Fprintf(&buf, ")\n")
+	fmt.Fprintf(&buf, "        print(result)\n")
+	fmt.Fprintf(&buf, "    finally:\n")
+	fmt.Fprintf(&buf, "        await model.disconnect()\n\n\n")
+	fmt.Fprintf(&buf, "asyncio.run(main())\n")
+	return buf.String()
+}
+
+// pythonName returns the name of the keyword argument that
+// python-libjuju uses for the field with the given wire name: it's
+// in lower case, with dashes replaced by underscores, and with an
+// underscore added to Python keywords and builtins, so "Type" and
+// "application-offers" become "type_" and "application_offers".
+func pythonName(name string) string {
+	name = strings.ToLower(strings.Replace(name, "-", "_", -1))
+	if pythonReserved[name] {
+		name += "_"
+	}
+	return name
+}
+
+// pythonReserved holds the Python keywords and the names of the
+// Python builtins that are likely to be used as field names.
+var pythonReserved = map[string]bool{
+	"and": true, "as": true, "assert": true, "async": true, "await": true,
+	"break": true, "class": true, "continue": true, "def": true, "del": true,
+	"elif": true, "else": true, "except": true, "finally": true, "for": true,
+	"from": true, "global": true, "if": true, "import": true, "in": true,
+	"is": true, "lambda": true, "nonlocal": true, "not": true, "or": true,
+	"pass": true, "raise": true, "return": true, "try": true, "while": true,
+	"with": true, "yield": true,
+
+	"all": true, "any": true, "bytes": true, "dict": true, "dir": true,
+	"filter": true, "format": true, "hash": true, "help": true, "id": true,
+	"input": true, "len": true, "list": true, "map": true, "max": true,
+	"min": true, "next": true, "object": true, "open": true, "property": true,
+	"range": true, "set": true, "slice": true, "str": true, "type": true,
+	"vars": true, "zip": true,
+}
+
+// pythonLiteral returns v, a value returned by Schema.Sample,
+// as a Python literal.
+func pythonLiteral(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "None"
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	case map[string]interface{}:
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		var buf bytes.Buffer
+		buf.WriteString("{")
+		for i, name := range names {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			fmt.Fprintf(&buf, "%s: %s", pythonLiteral(name), pythonLiteral(v[name]))
+		}
+		buf.WriteString("}")
+		return buf.String()
+	case []interface{}:
+		elems := make([]string, len(v))
+		for i, elem := range v {
+			elems[i] = pythonLiteral(elem)
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	}
+	// Strings and numbers are written the same way in
+	// JSON and Python, as long as the strings are plain,
+	// as sample strings are.
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "None"
+	}
+	return string(data)
+}
@@ -0,0 +1,89 @@
+package apidoc
+
+import (
+	"fmt"
+)
+
+// ReleaseRange holds the range of Juju releases that have a
+// facade version or method, from Introduced up to but not
+// including Removed.
+type ReleaseRange struct {
+	// Introduced holds the first release known to have it.
+	// When that's the oldest release in the history, it may
+	// have been introduced earlier.
+	Introduced string
+
+	// Removed holds the first release after the last one
+	// known to have it, or is empty if the newest release
+	// in the history has it.
+	Removed string `json:",omitempty"`
+}
+
+// String returns a description of the range
+// such as "2.9.0 to before 3.1.0".
+func (r *ReleaseRange) String() string {
+	if r.Removed == "" {
+		return fmt.Sprintf("%s and later", r.Introduced)
+	}
+	return fmt.Sprintf("%s to before %s", r.Introduced, r.Removed)
+}
+
+// Release holds the document generated for a Juju release.
+type Release struct {
+	// Version holds the version of the release, such as "2.9.0".
+	Version string
+
+	// Info holds the document for the release.
+	Info *Info
+}
+
+// AddReleaseRanges sets the Releases field of each facade
+// and method in info from the given history of releases,
+// which must be in release order, oldest first. Facades are
+// matched by name and version, and methods by name within
+// them. Facades and methods that no release has are left
+// with no range.
+//
+// A range doesn't record releases in the middle that lack
+// a facade or method, so one that was removed and later
+// restored is given a range that covers the gap.
+func (info *Info) AddReleaseRanges(history []Release) {
+	type methodKey struct {
+		facade facadeKey
+		method string
+	}
+	facadeRanges := make(map[facadeKey]*ReleaseRange)
+	methodRanges := make(map[methodKey]*ReleaseRange)
+	// extend returns r, or a new range if it's nil,
+	// extended to include release i.
+	extend := func(r *ReleaseRange, i int) *ReleaseRange {
+		if r == nil {
+			r = &ReleaseRange{
+				Introduced: history[i].Version,
+			}
+		}
+		r.Removed = ""
+		if i+1 < len(history) {
+			r.Removed = history[i+1].Version
+		}
+		return r
+	}
+	for i, rel := range history {
+		for _, f := range rel.Info.Facades {
+			fkey := facadeKey{f.Name, f.Version}
+			facadeRanges[fkey] = extend(facadeRanges[fkey], i)
+			for _, m := range f.Methods {
+				mkey := methodKey{fkey, m.Name}
+				methodRanges[mkey] = extend(methodRanges[mkey], i)
+			}
+		}
+	}
+	for i := range info.Facades {
+		f := &info.Facades[i]
+		fkey := facadeKey{f.Name, f.Version}
+		f.Releases = facadeRanges[fkey]
+		for j := range f.Methods {
+			f.Methods[j].Releases = methodRanges[methodKey{fkey, f.Methods[j].Name}]
+		}
+	}
+}
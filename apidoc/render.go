@@ -0,0 +1,141 @@
+package apidoc
+
+import (
+	"bytes"
+	"fmt"
+	"go/doc"
+	"html/template"
+	"strings"
+	"unicode"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// This file holds primitives shared by the renderers of
+// jujuapidoc output, so that they all present documents
+// in the same way.
+
+// DocHTML renders a Go doc comment as HTML, using the same
+// conventions as godoc: paragraphs are separated by blank
+// lines and indented lines are preformatted.
+func DocHTML(text string) template.HTML {
+	var buf bytes.Buffer
+	doc.ToHTML(&buf, text, nil)
+	return template.HTML(buf.String())
+}
+
+// DocMarkdown renders a Go doc comment as Markdown. Indented
+// blocks become fenced code blocks; other text is passed
+// through as paragraphs.
+func DocMarkdown(text string) string {
+	var buf strings.Builder
+	inCode := false
+	for _, line := range strings.Split(NormalizeDoc(text), "\n") {
+		indented := strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+		switch {
+		case indented && !inCode:
+			buf.WriteString("```\n")
+			inCode = true
+		case !indented && line != "" && inCode:
+			buf.WriteString("```\n")
+			inCode = false
+		}
+		if inCode {
+			line = strings.TrimPrefix(line, "\t")
+		}
+		buf.WriteString(line)
+		buf.WriteString("\n")
+	}
+	if inCode {
+		buf.WriteString("```\n")
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// TypeString returns a Go-like representation of t,
+// using the full names of named types. A nil type
+// is represented as "none".
+func TypeString(t *jsontypes.Type) string {
+	if t == nil {
+		return "none"
+	}
+	if t.Name != "" {
+		return string(t.Name)
+	}
+	switch t.Kind {
+	case jsontypes.Ptr:
+		return "*" + TypeString(t.Elem)
+	case jsontypes.Slice:
+		return "[]" + TypeString(t.Elem)
+	case jsontypes.Array:
+		return "[...]" + TypeString(t.Elem)
+	case jsontypes.Map:
+		return "map[" + TypeString(t.Key) + "]" + TypeString(t.Elem)
+	case jsontypes.Chan:
+		return "chan " + TypeString(t.Elem)
+	case jsontypes.Struct:
+		return "struct{...}"
+	case jsontypes.Interface:
+		return "interface{}"
+	case jsontypes.Func:
+		return "func(...)"
+	}
+	return string(t.Kind)
+}
+
+// Signature returns a short Go-like signature for the method,
+// such as "Life(params.Entities) params.LifeResults", using
+// only the unqualified names of named types.
+func (m Method) Signature() string {
+	short := func(t *jsontypes.Type) string {
+		if t != nil && t.Name != "" {
+			return t.Name.Name()
+		}
+		return TypeString(t)
+	}
+	s := m.Name + "("
+	if m.Param != nil {
+		s += short(m.Param)
+	}
+	s += ")"
+	if m.Result != nil {
+		s += " " + short(m.Result)
+	}
+	return s
+}
+
+// GodocURL returns the URL of the documentation
+// for the named type.
+func GodocURL(name jsontypes.TypeName) string {
+	return fmt.Sprintf("https://godoc.org/%s", name)
+}
+
+// Anchor returns an identifier suitable for use as an HTML
+// id or URL fragment, made by joining the given parts with
+// hyphens. Characters other than letters, digits, hyphens
+// and underscores are replaced with hyphens.
+func Anchor(parts ...string) string {
+	return strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_') {
+			return r
+		}
+		return '-'
+	}, strings.Join(parts, "-"))
+}
+
+// FacadeAnchor returns the anchor for the given version of a facade.
+func FacadeAnchor(name string, version int) string {
+	return Anchor(name, fmt.Sprint("v", version))
+}
+
+// SentinelAnchor returns the anchor for the
+// sentinel error with the given name.
+func SentinelAnchor(name string) string {
+	return Anchor("error", name)
+}
+
+// MethodAnchor returns the anchor for a method
+// of the given version of a facade.
+func MethodAnchor(facade string, version int, method string) string {
+	return Anchor(facade, fmt.Sprint("v", version), method)
+}
@@ -0,0 +1,55 @@
+package apidoc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of Requirement.
+const (
+	// RequirementFeatureFlag is for a facade that's registered
+	// only when a feature flag is enabled. Value holds the
+	// flag, as found in the source, such as "feature.Secrets".
+	RequirementFeatureFlag = "feature-flag"
+
+	// RequirementSecretBackend is for code that depends on the
+	// type of secret backend in use. Value holds the backend
+	// type, such as "vault" or "kubernetes".
+	RequirementSecretBackend = "secret-backend"
+
+	// RequirementModelType is for code that depends on the type
+	// of the model. Value holds the model type: "caas" for
+	// models on Kubernetes or "iaas" for models of machines.
+	RequirementModelType = "model-type"
+)
+
+// Requirement records a dependency of a facade or method on a
+// feature of the controller or the model, found heuristically in
+// how the facade is registered or in the conditions that its code
+// checks.
+type Requirement struct {
+	// Kind holds the kind of requirement, such as
+	// RequirementSecretBackend.
+	Kind  string
+	Value string
+
+	// Check holds the source of the condition that the
+	// requirement was found in, such as
+	// "backend.Type() != kubernetes.BackendType". It's empty
+	// for feature flags. A condition may exclude, rather than
+	// require, the feature, so it must be read to tell which.
+	Check string `json:",omitempty"`
+}
+
+// requirementsNote returns a note of the given requirements.
+func requirementsNote(msgs Messages, rs []Requirement) string {
+	notes := make([]string, len(rs))
+	for i, r := range rs {
+		note := msgs.Get("requirement-"+r.Kind, r.Value)
+		if r.Check != "" {
+			note += fmt.Sprintf(" (%s)", r.Check)
+		}
+		notes[i] = note
+	}
+	return msgs.Get("requirements", strings.Join(notes, "; "))
+}
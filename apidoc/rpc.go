@@ -0,0 +1,26 @@
+package apidoc
+
+import (
+	"encoding/json"
+)
+
+// RequestFrame holds a request message as sent to the
+// Juju API server over its websocket connection.
+type RequestFrame struct {
+	RequestId uint64      `json:"request-id"`
+	Type      string      `json:"type"`
+	Version   int         `json:"version"`
+	Id        string      `json:"id,omitempty"`
+	Request   string      `json:"request"`
+	Params    interface{} `json:"params,omitempty"`
+}
+
+// ResponseFrame holds a response message as sent by the
+// Juju API server over its websocket connection.
+type ResponseFrame struct {
+	RequestId uint64                 `json:"request-id"`
+	Error     string                 `json:"error,omitempty"`
+	ErrorCode string                 `json:"error-code,omitempty"`
+	ErrorInfo map[string]interface{} `json:"error-info,omitempty"`
+	Response  json.RawMessage        `json:"response,omitempty"`
+}
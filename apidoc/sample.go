@@ -0,0 +1,147 @@
+package apidoc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// maxSampleDepth holds the maximum nesting depth of
+// a value produced by Sample.
+const maxSampleDepth = 10
+
+// longStringLen holds the length of the long string
+// boundary value produced by Variants.
+const longStringLen = 4096
+
+// Sample returns an example value conforming to s suitable
+// for encoding as JSON. Arrays and maps hold a single element
+// and all object properties are present. Recursive types
+// are cut off with null values.
+func (s *Schema) Sample(defs map[string]*Schema) interface{} {
+	return s.sample(defs, "", make(map[string]bool), 0)
+}
+
+func (s *Schema) sample(defs map[string]*Schema, name string, active map[string]bool, depth int) interface{} {
+	if s == nil || depth > maxSampleDepth {
+		return nil
+	}
+	if s.Ref != "" {
+		if active[s.Ref] {
+			return nil
+		}
+		def := defs[strings.TrimPrefix(s.Ref, "#/definitions/")]
+		active[s.Ref] = true
+		defer delete(active, s.Ref)
+		return def.sample(defs, name, active, depth)
+	}
+	switch s.Type {
+	case "boolean":
+		return true
+	case "integer":
+		return json.Number("1")
+	case "number":
+		return json.Number("1.5")
+	case "string":
+		switch s.Format {
+		case "date-time":
+			return "2006-01-02T15:04:05Z"
+		case "byte":
+			return base64.StdEncoding.EncodeToString([]byte(name))
+		}
+		if name == "" {
+			name = "string"
+		}
+		return name
+	case "array":
+		return []interface{}{s.Items.sample(defs, name, active, depth+1)}
+	case "object":
+		obj := make(map[string]interface{})
+		for pname, ps := range s.Properties {
+			obj[pname] = ps.sample(defs, pname, active, depth+1)
+		}
+		if s.AdditionalProperties != nil {
+			obj["key"] = s.AdditionalProperties.sample(defs, name, active, depth+1)
+		}
+		return obj
+	}
+	return nil
+}
+
+// Variants returns a set of values conforming to s that exercise
+// boundary cases: null, empty and absent values, long strings,
+// extreme numbers and so on. Each variant differs from the value
+// returned by Sample in only one place.
+func (s *Schema) Variants(defs map[string]*Schema) []interface{} {
+	return s.variants(defs, "", make(map[string]bool), 0)
+}
+
+func (s *Schema) variants(defs map[string]*Schema, name string, active map[string]bool, depth int) []interface{} {
+	if s == nil || depth > maxSampleDepth {
+		return nil
+	}
+	if s.Ref != "" {
+		if active[s.Ref] {
+			return nil
+		}
+		def := defs[strings.TrimPrefix(s.Ref, "#/definitions/")]
+		active[s.Ref] = true
+		defer delete(active, s.Ref)
+		return def.variants(defs, name, active, depth)
+	}
+	vs := []interface{}{nil}
+	switch s.Type {
+	case "boolean":
+		vs = append(vs, false)
+	case "integer":
+		vs = append(vs,
+			json.Number("0"),
+			json.Number("-1"),
+			json.Number("9223372036854775807"),
+			json.Number("-9223372036854775808"),
+		)
+	case "number":
+		vs = append(vs,
+			json.Number("0"),
+			json.Number("-1e308"),
+			json.Number("1e308"),
+		)
+	case "string":
+		if s.Format == "" {
+			vs = append(vs, "", strings.Repeat("x", longStringLen), "ü⌘\U0001F600")
+		}
+	case "array":
+		elem := s.Items.sample(defs, name, active, depth+1)
+		vs = append(vs, []interface{}{}, []interface{}{elem, elem})
+		for _, ev := range s.Items.variants(defs, name, active, depth+1) {
+			vs = append(vs, []interface{}{ev})
+		}
+	case "object":
+		vs = append(vs, map[string]interface{}{})
+		base := s.sample(defs, name, active, depth)
+		obj, _ := base.(map[string]interface{})
+		pnames := make([]string, 0, len(s.Properties))
+		for pname := range s.Properties {
+			pnames = append(pnames, pname)
+		}
+		sort.Strings(pnames)
+		for _, pname := range pnames {
+			for _, pv := range s.Properties[pname].variants(defs, pname, active, depth+1) {
+				vs = append(vs, withProperty(obj, pname, pv))
+			}
+		}
+	}
+	return vs
+}
+
+// withProperty returns a copy of obj with the given
+// property set to v.
+func withProperty(obj map[string]interface{}, name string, v interface{}) map[string]interface{} {
+	obj1 := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		obj1[k] = v
+	}
+	obj1[name] = v
+	return obj1
+}
@@ -0,0 +1,409 @@
+package apidoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// Schema holds a JSON Schema describing the wire format of a type.
+// Only the subset of JSON Schema needed to describe Go types
+// encoded with encoding/json is supported.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Definitions          map[string]*Schema `json:"definitions,omitempty"`
+}
+
+// FieldWireName returns the name that encoding/json uses for
+// the given struct field and reports whether the field
+// has the omitempty option. It returns the empty string
+// if the field is not encoded at all.
+func FieldWireName(f *jsontypes.Field) (name string, omitEmpty bool) {
+	tag := reflect.StructTag(f.Tag).Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, omitEmpty
+}
+
+// DefinitionName returns the name used for the given named type in
+// the definitions of a schema returned by Info.Schema. It is the name
+// of the type qualified by the name of its package, for example
+// "params.Entities". Where another type in info.TypeInfo would get
+// the same name, as types from packages with the same name can, each
+// is qualified by as many trailing elements of its package path as
+// it takes to tell them apart, as in "core.network.Address" and
+// "juju.network.Address".
+func (info *Info) DefinitionName(name jsontypes.TypeName) string {
+	if name.PkgPath() == "" {
+		return name.Name()
+	}
+	pkgName := packageName(name.PkgPath())
+	group := []jsontypes.TypeName{name}
+	if info.TypeInfo != nil {
+		for other := range info.TypeInfo.Types {
+			if other != name && other.Name() == name.Name() && other.PkgPath() != "" && packageName(other.PkgPath()) == pkgName {
+				group = append(group, other)
+			}
+		}
+	}
+	if len(group) == 1 {
+		return pkgName + "." + name.Name()
+	}
+	return qualifiedNames(group)[name]
+}
+
+// DefinitionNames returns the DefinitionName of
+// every named type in info.TypeInfo.
+func (info *Info) DefinitionNames() map[jsontypes.TypeName]string {
+	names := make(map[jsontypes.TypeName]string)
+	if info.TypeInfo == nil {
+		return names
+	}
+	groups := make(map[string][]jsontypes.TypeName)
+	for name := range info.TypeInfo.Types {
+		if name.PkgPath() == "" {
+			names[name] = name.Name()
+			continue
+		}
+		short := packageName(name.PkgPath()) + "." + name.Name()
+		groups[short] = append(groups[short], name)
+	}
+	for short, group := range groups {
+		if len(group) == 1 {
+			names[group[0]] = short
+			continue
+		}
+		for name, qualified := range qualifiedNames(group) {
+			names[name] = qualified
+		}
+	}
+	return names
+}
+
+// qualifiedNames returns names for the given types, which would
+// otherwise get the same DefinitionName, each qualified by the
+// fewest trailing elements of its package path that tell them
+// apart.
+func qualifiedNames(group []jsontypes.TypeName) map[jsontypes.TypeName]string {
+	for n := 2; ; n++ {
+		names := make(map[jsontypes.TypeName]string)
+		seen := make(map[string]bool)
+		unique, whole := true, true
+		for _, name := range group {
+			elems := strings.Split(name.PkgPath(), "/")
+			if n < len(elems) {
+				elems = elems[len(elems)-n:]
+				whole = false
+			}
+			qualified := strings.Join(elems, ".") + "." + name.Name()
+			unique = unique && !seen[qualified]
+			seen[qualified] = true
+			names[name] = qualified
+		}
+		if unique || whole {
+			return names
+		}
+	}
+}
+
+// packageName returns the name that the package with the given
+// path is conventionally declared with: the last element of the
+// path without any major version suffix, such as the "/v8" of
+// "github.com/juju/charm/v8" or the ".v2" of
+// "gopkg.in/juju/names.v2".
+func packageName(pkgPath string) string {
+	dir, base := path.Split(pkgPath)
+	if dir != "" && isMajorVersion(base) {
+		base = path.Base(dir)
+	}
+	if strings.HasPrefix(pkgPath, "gopkg.in/") {
+		if i := strings.LastIndex(base, ".v"); i > 0 && isMajorVersion(base[i+1:]) {
+			base = base[:i]
+		}
+	}
+	return base
+}
+
+// isMajorVersion reports whether s is a major
+// version suffix of a package path, such as "v8".
+func isMajorVersion(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	for _, r := range s[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Schema returns a schema for the given type. Named types
+// are referred to with $ref links into the definitions
+// returned by SchemaDefinitions.
+func (info *Info) Schema(t *jsontypes.Type) *Schema {
+	return info.schema(nil, t)
+}
+
+// schema is like Schema, but takes the names of named types
+// from names, as returned by DefinitionNames, when they're in
+// it, which saves working them out for each reference.
+func (info *Info) schema(names map[jsontypes.TypeName]string, t *jsontypes.Type) *Schema {
+	if t == nil {
+		return nil
+	}
+	if t.Name.PkgPath() != "" {
+		name, ok := names[t.Name]
+		if !ok {
+			name = info.DefinitionName(t.Name)
+		}
+		return &Schema{
+			Ref: "#/definitions/" + name,
+		}
+	}
+	return info.typeSchema(names, t)
+}
+
+// SchemaDefinitions returns schemas for all the named
+// types in info.TypeInfo, keyed by DefinitionName.
+func (info *Info) SchemaDefinitions() map[string]*Schema {
+	defs := make(map[string]*Schema)
+	if info.TypeInfo == nil {
+		return defs
+	}
+	names := info.DefinitionNames()
+	for name, t := range info.TypeInfo.Types {
+		defs[names[name]] = info.typeSchema(names, t)
+	}
+	return defs
+}
+
+func (info *Info) typeSchema(names map[jsontypes.TypeName]string, t *jsontypes.Type) *Schema {
+	if t.Kind == "" || t.Kind == jsontypes.Unknown {
+		// A reference to a type that wasn't recorded;
+		// we know nothing about it.
+		return &Schema{}
+	}
+	if t.Methods["MarshalJSON"] != nil || t.Methods["MarshalText"] != nil {
+		switch t.Name {
+		case "time#Time":
+			return &Schema{
+				Type:   "string",
+				Format: "date-time",
+			}
+		}
+		if t.Methods["MarshalText"] != nil {
+			return &Schema{
+				Type: "string",
+			}
+		}
+		// The type has a custom encoding that we can't
+		// determine statically.
+		return &Schema{}
+	}
+	switch t.Kind {
+	case jsontypes.Bool:
+		return &Schema{Type: "boolean"}
+	case jsontypes.Int, jsontypes.Int8, jsontypes.Int16, jsontypes.Int32, jsontypes.Int64,
+		jsontypes.Uint, jsontypes.Uint8, jsontypes.Uint16, jsontypes.Uint32, jsontypes.Uint64, jsontypes.Uintptr:
+		return &Schema{Type: "integer"}
+	case jsontypes.Float32, jsontypes.Float64:
+		return &Schema{Type: "number"}
+	case jsontypes.String:
+		return &Schema{Type: "string"}
+	case jsontypes.Ptr:
+		return info.schema(names, t.Elem)
+	case jsontypes.Array, jsontypes.Slice:
+		if t.Kind == jsontypes.Slice && t.Elem.Kind == jsontypes.Uint8 {
+			// encoding/json encodes byte slices as base64.
+			return &Schema{
+				Type:   "string",
+				Format: "byte",
+			}
+		}
+		return &Schema{
+			Type:  "array",
+			Items: info.schema(names, t.Elem),
+		}
+	case jsontypes.Map:
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: info.schema(names, t.Elem),
+		}
+	case jsontypes.Struct:
+		s := &Schema{
+			Type:       "object",
+			Properties: make(map[string]*Schema),
+		}
+		info.addFieldSchemas(names, s, t)
+		return s
+	case jsontypes.Interface:
+		return &Schema{}
+	}
+	// Channels, functions and the like cannot be encoded.
+	return &Schema{
+		Description: "unencodable " + string(t.Kind),
+	}
+}
+
+func (info *Info) addFieldSchemas(names map[jsontypes.TypeName]string, s *Schema, t *jsontypes.Type) {
+	for _, f := range t.Fields {
+		name, _ := FieldWireName(f)
+		if name == "" {
+			continue
+		}
+		if f.Anonymous && reflect.StructTag(f.Tag).Get("json") == "" {
+			// Fields of embedded structs are promoted
+			// into the outer object.
+			ft := info.Type(f.Type)
+			if ft.Kind == jsontypes.Ptr {
+				ft = info.Type(ft.Elem)
+			}
+			if ft.Kind == jsontypes.Struct {
+				info.addFieldSchemas(names, s, ft)
+				continue
+			}
+		}
+		fs := info.schema(names, f.Type)
+		if fi := info.Field(t.Name, f.Name); fi != nil && len(fi.TagKinds) > 0 && fs.Ref == "" {
+			fs.Description = TagDescription(fi.TagKinds)
+		}
+		s.Properties[name] = fs
+		if presence, _ := info.FieldPresence(t.Name, f); presence == Required {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// CheckJSON checks that data conforms to the schema s,
+// resolving any $ref links in defs. As with encoding/json,
+// null is accepted in place of any value and missing
+// fields are not considered an error, but fields that
+// are not mentioned in the schema are.
+func (s *Schema) CheckJSON(defs map[string]*Schema, data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return errors.Notef(err, nil, "invalid JSON")
+	}
+	return s.check(defs, "", v)
+}
+
+func (s *Schema) check(defs map[string]*Schema, path string, v interface{}) error {
+	if s.Ref != "" {
+		def := defs[strings.TrimPrefix(s.Ref, "#/definitions/")]
+		if def == nil {
+			return errors.Newf("%sunresolved reference %q", pathPrefix(path), s.Ref)
+		}
+		return def.check(defs, path, v)
+	}
+	if v == nil || s.Type == "" {
+		return nil
+	}
+	switch v := v.(type) {
+	case bool:
+		if s.Type == "boolean" {
+			return nil
+		}
+	case json.Number:
+		if s.Type == "number" {
+			return nil
+		}
+		if s.Type == "integer" {
+			if _, err := v.Int64(); err == nil || !strings.ContainsAny(v.String(), ".eE") {
+				return nil
+			}
+		}
+	case string:
+		if s.Type == "string" {
+			return nil
+		}
+	case []interface{}:
+		if s.Type != "array" {
+			break
+		}
+		if s.Items == nil {
+			return nil
+		}
+		for i, elem := range v {
+			if err := s.Items.check(defs, fmt.Sprintf("%s[%d]", path, i), elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		if s.Type != "object" {
+			break
+		}
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fs := s.Properties[name]
+			if fs == nil {
+				fs = s.AdditionalProperties
+			}
+			if fs == nil {
+				return errors.Newf("%sunexpected field %q", pathPrefix(path), name)
+			}
+			if err := fs.check(defs, path+"."+name, v[name]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return errors.Newf("%sgot %s, want %s", pathPrefix(path), jsonKind(v), s.Type)
+}
+
+func pathPrefix(path string) string {
+	if path == "" {
+		return ""
+	}
+	return strings.TrimPrefix(path, ".") + ": "
+}
+
+func jsonKind(v interface{}) string {
+	switch v.(type) {
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return "null"
+}
@@ -0,0 +1,221 @@
+package apidoc
+
+import (
+	"reflect"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// SchemagenFacade holds a facade in the format produced by
+// Juju's schemagen tool, which writes a JSON array of these.
+type SchemagenFacade struct {
+	Name        string
+	Description string
+	Version     int
+	AvailableTo []string `json:",omitempty"`
+	Schema      *SchemagenSchema
+}
+
+// SchemagenSchema holds a JSON Schema as produced by the
+// schema generator that schemagen uses. It differs from
+// Schema in that objects are closed unless they're known
+// to allow any property, and maps are described with
+// pattern properties.
+type SchemagenSchema struct {
+	Ref                  string                      `json:"$ref,omitempty"`
+	Type                 string                      `json:"type,omitempty"`
+	Format               string                      `json:"format,omitempty"`
+	Description          string                      `json:"description,omitempty"`
+	Items                *SchemagenSchema            `json:"items,omitempty"`
+	Properties           map[string]*SchemagenSchema `json:"properties,omitempty"`
+	PatternProperties    map[string]*SchemagenSchema `json:"patternProperties,omitempty"`
+	AdditionalProperties *bool                       `json:"additionalProperties,omitempty"`
+	Required             []string                    `json:"required,omitempty"`
+	Definitions          map[string]*SchemagenSchema `json:"definitions,omitempty"`
+}
+
+// Schemagen returns the facades in info in the format
+// produced by Juju's schemagen tool. Each facade carries
+// its own definitions of the struct types that it uses,
+// named by their unqualified Go names. Where two such types
+// have the same name, they're qualified as by Info.DefinitionName.
+func (info *Info) Schemagen() []SchemagenFacade {
+	facades := make([]SchemagenFacade, 0, len(info.Facades))
+	for i := range info.Facades {
+		facades = append(facades, info.schemagenFacade(&info.Facades[i]))
+	}
+	return facades
+}
+
+func (info *Info) schemagenFacade(f *FacadeInfo) SchemagenFacade {
+	g := &schemagenGen{
+		info:  info,
+		names: make(map[jsontypes.TypeName]string),
+	}
+	if info.TypeInfo != nil {
+		used := make(map[jsontypes.TypeName]bool)
+		for _, m := range f.Methods {
+			info.addUsedTypes(used, m.Param)
+			info.addUsedTypes(used, m.Result)
+		}
+		byName := make(map[string][]jsontypes.TypeName)
+		for name := range used {
+			t := info.TypeInfo.Types[name]
+			if t.Kind == jsontypes.Struct && name != "time#Time" && t.Methods["MarshalJSON"] == nil && t.Methods["MarshalText"] == nil {
+				// Types with a custom encoding are described inline.
+				byName[name.Name()] = append(byName[name.Name()], name)
+			}
+		}
+		for base, names := range byName {
+			for _, name := range names {
+				if len(names) > 1 {
+					g.names[name] = info.DefinitionName(name)
+				} else {
+					g.names[name] = base
+				}
+			}
+		}
+	}
+	methods := make(map[string]*SchemagenSchema)
+	for _, m := range f.Methods {
+		s := &SchemagenSchema{
+			Type:        "object",
+			Properties:  make(map[string]*SchemagenSchema),
+			Description: NormalizeDoc(m.Doc),
+		}
+		if m.Param != nil {
+			s.Properties["Params"] = g.schema(m.Param)
+		}
+		if m.Result != nil {
+			s.Properties["Result"] = g.schema(m.Result)
+		}
+		methods[m.Name] = s
+	}
+	schema := &SchemagenSchema{
+		Type:       "object",
+		Properties: methods,
+	}
+	if len(g.names) > 0 {
+		schema.Definitions = make(map[string]*SchemagenSchema)
+		for name, defName := range g.names {
+			schema.Definitions[defName] = g.typeSchema(info.TypeInfo.Types[name])
+		}
+	}
+	return SchemagenFacade{
+		Name:        f.Name,
+		Description: NormalizeDoc(f.Doc),
+		Version:     f.Version,
+		AvailableTo: f.AvailableTo,
+		Schema:      schema,
+	}
+}
+
+// schemagenGen holds the state used when generating
+// the schema for a single facade.
+type schemagenGen struct {
+	info *Info
+	// names maps each struct type used by the
+	// facade to its definition name.
+	names map[jsontypes.TypeName]string
+}
+
+// schema returns the schema for t, which refers to
+// the definition of t if it's a struct type.
+func (g *schemagenGen) schema(t *jsontypes.Type) *SchemagenSchema {
+	if name, ok := g.names[t.Name]; ok {
+		return &SchemagenSchema{
+			Ref: "#/definitions/" + name,
+		}
+	}
+	return g.typeSchema(g.info.Type(t))
+}
+
+func (g *schemagenGen) typeSchema(t *jsontypes.Type) *SchemagenSchema {
+	if t.Kind == "" || t.Kind == jsontypes.Unknown {
+		return &SchemagenSchema{}
+	}
+	if t.Name == "time#Time" {
+		return &SchemagenSchema{
+			Type:   "string",
+			Format: "date-time",
+		}
+	}
+	if t.Methods["MarshalText"] != nil {
+		return &SchemagenSchema{
+			Type: "string",
+		}
+	}
+	if t.Methods["MarshalJSON"] != nil {
+		return anySchemagenObject()
+	}
+	switch t.Kind {
+	case jsontypes.Bool:
+		return &SchemagenSchema{Type: "boolean"}
+	case jsontypes.Int, jsontypes.Int8, jsontypes.Int16, jsontypes.Int32, jsontypes.Int64,
+		jsontypes.Uint, jsontypes.Uint8, jsontypes.Uint16, jsontypes.Uint32, jsontypes.Uint64, jsontypes.Uintptr:
+		return &SchemagenSchema{Type: "integer"}
+	case jsontypes.Float32, jsontypes.Float64:
+		return &SchemagenSchema{Type: "number"}
+	case jsontypes.String:
+		return &SchemagenSchema{Type: "string"}
+	case jsontypes.Ptr:
+		return g.schema(t.Elem)
+	case jsontypes.Array, jsontypes.Slice:
+		return &SchemagenSchema{
+			Type:  "array",
+			Items: g.schema(t.Elem),
+		}
+	case jsontypes.Map:
+		return &SchemagenSchema{
+			Type: "object",
+			PatternProperties: map[string]*SchemagenSchema{
+				".*": g.schema(t.Elem),
+			},
+		}
+	case jsontypes.Struct:
+		closed := false
+		s := &SchemagenSchema{
+			Type:                 "object",
+			Properties:           make(map[string]*SchemagenSchema),
+			AdditionalProperties: &closed,
+		}
+		g.addFields(s, t)
+		return s
+	}
+	return anySchemagenObject()
+}
+
+func (g *schemagenGen) addFields(s *SchemagenSchema, t *jsontypes.Type) {
+	for _, f := range t.Fields {
+		name, omitEmpty := FieldWireName(f)
+		if name == "" {
+			continue
+		}
+		if f.Anonymous && reflect.StructTag(f.Tag).Get("json") == "" {
+			ft := g.info.Type(f.Type)
+			if ft.Kind == jsontypes.Ptr {
+				ft = g.info.Type(ft.Elem)
+			}
+			if ft.Kind == jsontypes.Struct {
+				g.addFields(s, ft)
+				continue
+			}
+		}
+		s.Properties[name] = g.schema(f.Type)
+		if !omitEmpty {
+			// Unlike Schema, schemagen counts pointer
+			// fields as required too.
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// anySchemagenObject returns the schema that schemagen
+// uses for values that can hold anything.
+func anySchemagenObject() *SchemagenSchema {
+	open := true
+	return &SchemagenSchema{
+		Type:                 "object",
+		AdditionalProperties: &open,
+	}
+}
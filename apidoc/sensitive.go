@@ -0,0 +1,96 @@
+package apidoc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// sensitiveKeywords holds the words that, found in the name of a
+// type or struct field, suggest that it holds a secret. Names are
+// lower-cased, with dashes and underscores removed, before they're
+// checked.
+var sensitiveKeywords = []string{
+	"password",
+	"passphrase",
+	"secret",
+	"credential",
+	"token",
+	"privatekey",
+	"macaroon",
+}
+
+// Sensitivity reports whether the payloads of the method m of the
+// facade f may hold secrets, such as passwords or cloud credentials,
+// for Method.Sensitive, and if so, why. It's a heuristic: a method
+// is sensitive if the facade is for secrets, or if its params or
+// result refer to a type or struct field with a name suggesting a
+// secret, such as Password or CloudCredential.
+func (info *Info) Sensitivity(f *FacadeInfo, m *Method) (bool, string) {
+	if f.HasTag("secrets") {
+		return true, "the facade manages secrets"
+	}
+	if name := sensitiveName(f.Name); name != "" {
+		return true, fmt.Sprintf("the facade name mentions %s", name)
+	}
+	visited := make(map[jsontypes.TypeName]bool)
+	if reason := info.sensitiveType(m.Param, visited); reason != "" {
+		return true, "the params hold " + reason
+	}
+	if reason := info.sensitiveType(m.Result, visited); reason != "" {
+		return true, "the result holds " + reason
+	}
+	return false, ""
+}
+
+// sensitiveType returns a description of the first type or field
+// reachable from t that has a sensitive name, or the empty string
+// if there is none.
+func (info *Info) sensitiveType(t *jsontypes.Type, visited map[jsontypes.TypeName]bool) string {
+	if t == nil {
+		return ""
+	}
+	name := t.Name.Name()
+	if t.Name != "" {
+		if visited[t.Name] {
+			return ""
+		}
+		visited[t.Name] = true
+		if sensitiveName(name) != "" {
+			return fmt.Sprintf("type %s", name)
+		}
+	}
+	t = info.Type(t)
+	switch t.Kind {
+	case jsontypes.Struct:
+		for _, field := range t.Fields {
+			if sensitiveName(field.Name) != "" {
+				if name != "" {
+					return fmt.Sprintf("field %s.%s", name, field.Name)
+				}
+				return fmt.Sprintf("field %s", field.Name)
+			}
+		}
+		for _, field := range t.Fields {
+			if reason := info.sensitiveType(field.Type, visited); reason != "" {
+				return reason
+			}
+		}
+	case jsontypes.Ptr, jsontypes.Slice, jsontypes.Array, jsontypes.Map:
+		return info.sensitiveType(t.Elem, visited)
+	}
+	return ""
+}
+
+// sensitiveName returns the sensitive keyword found in
+// name, or the empty string if there is none.
+func sensitiveName(name string) string {
+	name = strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(name))
+	for _, keyword := range sensitiveKeywords {
+		if strings.Contains(name, keyword) {
+			return keyword
+		}
+	}
+	return ""
+}
@@ -0,0 +1,33 @@
+package apidoc
+
+import (
+	"strings"
+)
+
+// SentinelError returns the entry in info.SentinelErrors
+// with the given name, or nil if there is none.
+func (info *Info) SentinelError(name string) *SentinelError {
+	for i := range info.SentinelErrors {
+		if e := &info.SentinelErrors[i]; e.Name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// ShortName returns the name of the error qualified by
+// the name of its package alone, as in "errors.ErrPerm".
+func (e *SentinelError) ShortName() string {
+	return shortQualifiedName(e.Name)
+}
+
+// shortQualifiedName returns the short form of the given name
+// qualified by a package path, such as a sentinel error name,
+// as returned by SentinelError.ShortName.
+func shortQualifiedName(name string) string {
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return name
+	}
+	return packageName(name[:i]) + name[i:]
+}
@@ -0,0 +1,74 @@
+package apidoc
+
+import (
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// SingleEntity describes how a bulk method, which takes a list of
+// items and returns a result for each, is called for a single item,
+// as most callers do.
+type SingleEntity struct {
+	// ParamsField holds the wire name of the params field
+	// holding the items, such as "entities".
+	ParamsField string
+
+	// Param holds the type of each item, such as params.Entity.
+	Param jsontypes.TypeName
+
+	// ResultsField holds the wire name of the result field
+	// holding the results, such as "results".
+	ResultsField string
+
+	// Result holds the type of each result, such as
+	// params.ErrorResult.
+	Result jsontypes.TypeName
+}
+
+// SingleEntity returns how a bulk method with the given params and
+// result types is called for a single item, or nil if it isn't a
+// bulk method. Following the Juju conventions, a method is a bulk
+// method if its params and its result are both structs with a
+// single field holding a slice of a named type, as with
+// params.Entities and params.ErrorResults; the results are in the
+// same order as the items, so the only result is the one for the
+// only item.
+func (info *Info) SingleEntity(params, result *jsontypes.Type) *SingleEntity {
+	paramsField, param := info.bulkField(params)
+	if paramsField == "" {
+		return nil
+	}
+	resultsField, res := info.bulkField(result)
+	if resultsField == "" {
+		return nil
+	}
+	return &SingleEntity{
+		ParamsField:  paramsField,
+		Param:        param,
+		ResultsField: resultsField,
+		Result:       res,
+	}
+}
+
+// bulkField returns the wire name of the only field of the struct
+// type t and the type of its elements, if it holds a slice of a
+// named type, or pointers to one, or the empty string otherwise.
+func (info *Info) bulkField(t *jsontypes.Type) (string, jsontypes.TypeName) {
+	t = info.deref(t)
+	if t == nil || t.Kind != jsontypes.Struct || len(t.Fields) != 1 {
+		return "", ""
+	}
+	f := t.Fields[0]
+	ft := info.deref(f.Type)
+	if ft == nil || ft.Kind != jsontypes.Slice {
+		return "", ""
+	}
+	elem := ft.Elem
+	for elem != nil && elem.Name == "" && elem.Kind == jsontypes.Ptr {
+		elem = elem.Elem
+	}
+	if elem == nil || elem.Name == "" {
+		return "", ""
+	}
+	name, _ := FieldWireName(f)
+	return name, elem.Name
+}
@@ -0,0 +1,32 @@
+package apidoc
+
+import (
+	"fmt"
+)
+
+// SourcePos holds the position of a declaration in the juju source,
+// so that problems found in the API can be reported against the
+// code, for example by code scanning tools.
+type SourcePos struct {
+	// File holds the path of the file relative to the root
+	// of the juju module, with slash separators.
+	File string
+	Line int
+}
+
+func (p SourcePos) String() string {
+	return fmt.Sprintf("%s:%d", p.File, p.Line)
+}
+
+// withoutSources returns a copy of f with the source positions of
+// the facade and its methods removed, for comparisons that shouldn't
+// depend on where a facade is declared: moving the code that
+// implements a facade doesn't change the API.
+func withoutSources(f FacadeInfo) FacadeInfo {
+	f.Source = nil
+	f.Methods = append([]Method(nil), f.Methods...)
+	for i := range f.Methods {
+		f.Methods[i].Source = nil
+	}
+	return f
+}
@@ -0,0 +1,84 @@
+package apidoc
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// Stats holds statistics on a run of jujuapidoc and its doc
+// generator, for measuring the effect of performance changes.
+// The generator writes the counts, its stages and its caches;
+// jujuapidoc adds its own stages and the outputs it writes.
+type Stats struct {
+	Facades int
+	Methods int
+	Types   int
+
+	// Outputs holds the files written, in order.
+	Outputs []OutputStats `json:",omitempty"`
+
+	// Stages holds the time taken by each stage, in order.
+	Stages []StageStats `json:",omitempty"`
+
+	// Caches holds the use made of each cache.
+	Caches []CacheStats `json:",omitempty"`
+}
+
+// OutputStats records a file written by a run.
+type OutputStats struct {
+	// Name holds the name of the file, or "-" for
+	// standard output.
+	Name  string
+	Bytes int64
+}
+
+// StageStats records the time taken by a stage of a run.
+type StageStats struct {
+	Name     string
+	Duration time.Duration
+}
+
+// CacheStats records the lookups made in a cache.
+type CacheStats struct {
+	Name   string
+	Hits   int
+	Misses int
+}
+
+// HitRate returns the proportion of lookups in c that
+// were hits, or 0 if there were none.
+func (c CacheStats) HitRate() float64 {
+	if c.Hits+c.Misses == 0 {
+		return 0
+	}
+	return float64(c.Hits) / float64(c.Hits+c.Misses)
+}
+
+// AddStage records that the stage with the given
+// name ran from start until now.
+func (s *Stats) AddStage(name string, start time.Time) {
+	s.Stages = append(s.Stages, StageStats{
+		Name:     name,
+		Duration: time.Since(start),
+	})
+}
+
+// WriteSummary writes a human-readable summary of s to w.
+func (s *Stats) WriteSummary(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "facades\t%d\n", s.Facades)
+	fmt.Fprintf(tw, "methods\t%d\n", s.Methods)
+	fmt.Fprintf(tw, "types\t%d\n", s.Types)
+	for _, o := range s.Outputs {
+		fmt.Fprintf(tw, "output %s\t%d bytes\n", o.Name, o.Bytes)
+	}
+	for _, st := range s.Stages {
+		fmt.Fprintf(tw, "stage %s\t%v\n", st.Name, st.Duration.Round(time.Millisecond))
+	}
+	for _, c := range s.Caches {
+		fmt.Fprintf(tw, "cache %s\t%d hits, %d misses (%.1f%%)\n", c.Name, c.Hits, c.Misses, 100*c.HitRate())
+	}
+	return tw.Flush()
+}
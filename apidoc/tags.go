@@ -0,0 +1,299 @@
+package apidoc
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// subsystemKeywords maps each subsystem tag to the keywords that
+// identify it in a facade's name or package path.
+var subsystemKeywords = []struct {
+	tag      string
+	keywords []string
+}{
+	{"storage", []string{"storage", "volume", "filesystem", "disk"}},
+	{"networking", []string{"network", "subnet", "space", "firewall", "proxy", "address"}},
+	{"secrets", []string{"secret"}},
+	{"charms", []string{"charm", "resource"}},
+	{"machines", []string{"machine", "instance", "provisioner", "container"}},
+	{"migration", []string{"migration"}},
+	{CrossModelTag, []string{"crossmodel", "crosscontroller", "offer", "remoterelation"}},
+}
+
+// SubsystemTags returns the subsystem tags for a facade with
+// the given name, implemented in the package with the given
+// path. The package path may be empty if it's not known, in
+// which case only the name is used.
+func SubsystemTags(facadeName, pkgPath string) []string {
+	// Only the part of the path within the apiserver
+	// tree says anything about the facade.
+	if i := strings.Index(pkgPath, "/apiserver/"); i >= 0 {
+		pkgPath = pkgPath[i+len("/apiserver/"):]
+	}
+	text := strings.ToLower(facadeName + " " + pkgPath)
+	var tags []string
+	for _, s := range subsystemKeywords {
+		for _, keyword := range s.keywords {
+			if strings.Contains(text, keyword) {
+				tags = append(tags, s.tag)
+				break
+			}
+		}
+	}
+	return tags
+}
+
+// audienceTags maps each audience to its tag. Controller agents get
+// a tag of their own so that it isn't taken for the controller
+// facades, which are served on the controller endpoint.
+var audienceTags = map[string]string{
+	AudienceClient:     "client",
+	AudienceAgent:      "agent",
+	AudienceController: "controller-agent",
+}
+
+// AudienceTags returns the tags for a facade
+// intended for the given audiences.
+func AudienceTags(audiences []string) []string {
+	var tags []string
+	for _, a := range audiences {
+		if tag, ok := audienceTags[a]; ok {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// FacadeTags returns the tags for a facade with the given name,
+// implemented in the package with the given path and intended for
+// the given audiences: its subsystem tags followed by its audience
+// tags. See SubsystemTags and AudienceTags.
+func FacadeTags(facadeName, pkgPath string, audiences []string) []string {
+	return append(SubsystemTags(facadeName, pkgPath), AudienceTags(audiences)...)
+}
+
+// setAudiences sets the audiences of f, removing the tags of the
+// audiences that it's no longer intended for.
+func (f *FacadeInfo) setAudiences(audiences []string) {
+	f.Audiences = audiences
+	for a, tag := range audienceTags {
+		if !f.HasAudience(a) {
+			f.removeTag(tag)
+		}
+	}
+}
+
+// HasTag reports whether the facade has the given tag.
+func (f *FacadeInfo) HasTag(tag string) bool {
+	for _, t := range f.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// addTags upgrades a version 2 document by setting the Tags
+// field of each facade. Older documents don't record the
+// package of each facade, so the tags are derived from
+// the facade names only.
+func addTags(doc map[string]json.RawMessage) error {
+	data, ok := doc["Facades"]
+	if !ok {
+		return nil
+	}
+	var facades []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &facades); err != nil {
+		return errors.Notef(err, nil, "cannot parse facades")
+	}
+	for _, f := range facades {
+		var name string
+		if err := json.Unmarshal(f["Name"], &name); err != nil {
+			return errors.Notef(err, nil, "cannot parse facade name")
+		}
+		if tags := SubsystemTags(name, ""); len(tags) > 0 {
+			data, err := json.Marshal(tags)
+			if err != nil {
+				return errors.Wrap(err)
+			}
+			f["Tags"] = data
+		}
+	}
+	data, err := json.Marshal(facades)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	doc["Facades"] = data
+	return nil
+}
+
+// addAudienceTags upgrades a version 3 document by adding the
+// audience tags of each facade to its Tags field.
+func addAudienceTags(doc map[string]json.RawMessage) error {
+	data, ok := doc["Facades"]
+	if !ok {
+		return nil
+	}
+	var facades []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &facades); err != nil {
+		return errors.Notef(err, nil, "cannot parse facades")
+	}
+	for _, f := range facades {
+		var audiences, tags []string
+		if data, ok := f["Audiences"]; ok {
+			if err := json.Unmarshal(data, &audiences); err != nil {
+				return errors.Notef(err, nil, "cannot parse Audiences")
+			}
+		}
+		if data, ok := f["Tags"]; ok {
+			if err := json.Unmarshal(data, &tags); err != nil {
+				return errors.Notef(err, nil, "cannot parse Tags")
+			}
+		}
+		if tags = append(tags, AudienceTags(audiences)...); len(tags) > 0 {
+			data, err := json.Marshal(tags)
+			if err != nil {
+				return errors.Wrap(err)
+			}
+			f["Tags"] = data
+		}
+	}
+	data, err := json.Marshal(facades)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	doc["Facades"] = data
+	return nil
+}
+
+// ReadTagOverrides reads overrides of the tags of facades from the
+// JSON file at path, which holds an object mapping from facade names
+// to tags. A tag is added to every version of the facade, unless it
+// starts with "-", in which case the rest of it is removed. See
+// OverrideTags.
+func ReadTagOverrides(path string) (map[string][]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	var overrides map[string][]string
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return nil, errors.Notef(err, nil, "cannot parse %s", path)
+	}
+	var bad []string
+	for name, tags := range overrides {
+		for _, tag := range tags {
+			if !isTag(strings.TrimPrefix(tag, "-")) {
+				bad = append(bad, name+": "+tag)
+			}
+		}
+	}
+	if len(bad) > 0 {
+		sort.Strings(bad)
+		return nil, errors.Newf("%s has invalid tags %q: tags may only hold lower case letters, digits and hyphens", path, bad)
+	}
+	return overrides, nil
+}
+
+// isTag reports whether s can be used as a tag. Tags are used
+// in HTML anchors and class names, so they're kept simple.
+func isTag(s string) bool {
+	if s == "" || s[0] == '-' {
+		return false
+	}
+	for _, r := range s {
+		if !('a' <= r && r <= 'z' || '0' <= r && r <= '9' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// OverrideTags applies the given overrides, as read by
+// ReadTagOverrides, to the tags of the facades in info. It returns
+// an error naming the facades that aren't in info, as they're
+// probably mistyped.
+func (info *Info) OverrideTags(overrides map[string][]string) error {
+	used := make(map[string]bool)
+	for i := range info.Facades {
+		f := &info.Facades[i]
+		tags, ok := overrides[f.Name]
+		if !ok {
+			continue
+		}
+		used[f.Name] = true
+		for _, tag := range tags {
+			if strings.HasPrefix(tag, "-") {
+				f.removeTag(tag[1:])
+			} else if !f.HasTag(tag) {
+				f.Tags = append(f.Tags, tag)
+			}
+		}
+	}
+	var unknown []string
+	for name := range overrides {
+		if !used[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return errors.Newf("tag overrides given for unknown facades %q", unknown)
+	}
+	return nil
+}
+
+// removeTag removes the given tag from f. It makes a new slice
+// rather than filtering in place, as copies of f made by Filter
+// share their tags with the original.
+func (f *FacadeInfo) removeTag(tag string) {
+	var tags []string
+	for _, t := range f.Tags {
+		if t != tag {
+			tags = append(tags, t)
+		}
+	}
+	f.Tags = tags
+}
+
+// TaggedFacades holds the facades with a tag.
+type TaggedFacades struct {
+	Tag string
+
+	// Facades holds the names of the facades
+	// with the tag, each once.
+	Facades []string
+}
+
+// TagIndex returns each of the tags of the facades in info, sorted,
+// with the facades that have it, in the order of info.Facades.
+func (info *Info) TagIndex() []TaggedFacades {
+	facades := make(map[string][]string)
+	seen := make(map[string]map[string]bool)
+	for _, f := range info.Facades {
+		for _, tag := range f.Tags {
+			if seen[tag] == nil {
+				seen[tag] = make(map[string]bool)
+			}
+			if !seen[tag][f.Name] {
+				seen[tag][f.Name] = true
+				facades[tag] = append(facades[tag], f.Name)
+			}
+		}
+	}
+	index := make([]TaggedFacades, 0, len(facades))
+	for tag, names := range facades {
+		index = append(index, TaggedFacades{
+			Tag:     tag,
+			Facades: names,
+		})
+	}
+	sort.Slice(index, func(i, j int) bool {
+		return index[i].Tag < index[j].Tag
+	})
+	return index
+}
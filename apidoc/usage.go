@@ -0,0 +1,164 @@
+package apidoc
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// MethodUsage holds how often a method was seen to be
+// called in a controller's audit log. See AddUsage.
+type MethodUsage struct {
+	// Calls holds the number of calls seen.
+	Calls int
+
+	// Callers holds the entities that made the most calls,
+	// most frequent first.
+	Callers []CallerCount `json:",omitempty"`
+}
+
+// CallerCount holds the number of calls made by one caller.
+type CallerCount struct {
+	// Caller holds the tag of the entity that made the
+	// calls, such as "user-admin", as recorded for its
+	// conversation in the audit log.
+	Caller string
+	Calls  int
+}
+
+// AuditCall holds a single API call recorded in an audit log.
+type AuditCall struct {
+	Facade  string
+	Version int
+	Method  string
+
+	// Caller holds the tag of the entity that made the call,
+	// or is empty if the conversation it was part of isn't
+	// in the log.
+	Caller string
+}
+
+// auditRecord holds a line of a Juju controller audit log,
+// as written by the core/auditlog package. Each line holds
+// one of its fields.
+type auditRecord struct {
+	Conversation *struct {
+		Who            string `json:"who"`
+		ConversationID string `json:"conversation-id"`
+	} `json:"conversation"`
+	Request *struct {
+		ConversationID string `json:"conversation-id"`
+		Facade         string `json:"facade"`
+		Method         string `json:"method"`
+		Version        int    `json:"version"`
+	} `json:"request"`
+}
+
+// ReadAuditLog reads the API calls recorded in a Juju controller
+// audit log, which may be gzip-compressed, as its rotated files are.
+// Each call is attributed to the entity that started the conversation
+// it belongs to. Lines that aren't requests are ignored.
+func ReadAuditLog(r io.Reader) ([]AuditCall, error) {
+	br := bufio.NewReader(r)
+	if magic, _ := br.Peek(len(gzipMagic)); bytes.Equal(magic, gzipMagic) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		defer zr.Close()
+		br = bufio.NewReader(zr)
+	}
+	callers := make(map[string]string)
+	var calls []AuditCall
+	scanner := bufio.NewScanner(br)
+	// Requests hold their arguments, which may be large.
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		var rec auditRecord
+		if err := json.Unmarshal(data, &rec); err != nil {
+			return nil, errors.Notef(err, nil, "cannot parse audit log line %d", line)
+		}
+		switch {
+		case rec.Conversation != nil:
+			callers[rec.Conversation.ConversationID] = rec.Conversation.Who
+		case rec.Request != nil:
+			calls = append(calls, AuditCall{
+				Facade:  rec.Request.Facade,
+				Version: rec.Request.Version,
+				Method:  rec.Request.Method,
+				Caller:  callers[rec.Request.ConversationID],
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Notef(err, nil, "cannot read audit log")
+	}
+	return calls, nil
+}
+
+// AddUsage sets the Usage field of each method in info from the
+// given calls, recording up to maxCallers of the entities that
+// called it most. Methods that weren't called are given a usage
+// with no calls, so that they can be told apart from methods
+// in a document that has no usage recorded.
+//
+// It returns the calls that don't match any method in info, such
+// as those made to facade versions that it doesn't document.
+func (info *Info) AddUsage(calls []AuditCall, maxCallers int) []AuditCall {
+	usage := make(map[MethodRef]map[string]int)
+	for i := range info.Facades {
+		f := &info.Facades[i]
+		for _, m := range f.Methods {
+			usage[MethodRef{f.Name, f.Version, m.Name}] = make(map[string]int)
+		}
+	}
+	var unmatched []AuditCall
+	for _, c := range calls {
+		callers, ok := usage[MethodRef{c.Facade, c.Version, c.Method}]
+		if !ok {
+			unmatched = append(unmatched, c)
+			continue
+		}
+		callers[c.Caller]++
+	}
+	for i := range info.Facades {
+		f := &info.Facades[i]
+		for j := range f.Methods {
+			m := &f.Methods[j]
+			m.Usage = methodUsage(usage[MethodRef{f.Name, f.Version, m.Name}], maxCallers)
+		}
+	}
+	return unmatched
+}
+
+// methodUsage returns the usage of a method
+// from the number of calls made by each caller.
+func methodUsage(callers map[string]int, maxCallers int) *MethodUsage {
+	u := &MethodUsage{}
+	for caller, n := range callers {
+		u.Calls += n
+		if caller != "" {
+			u.Callers = append(u.Callers, CallerCount{caller, n})
+		}
+	}
+	sort.Slice(u.Callers, func(i, j int) bool {
+		c1, c2 := u.Callers[i], u.Callers[j]
+		if c1.Calls != c2.Calls {
+			return c1.Calls > c2.Calls
+		}
+		return c1.Caller < c2.Caller
+	})
+	if len(u.Callers) > maxCallers {
+		u.Callers = u.Callers[:maxCallers]
+	}
+	return u
+}
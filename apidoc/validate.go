@@ -0,0 +1,169 @@
+package apidoc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// Validate checks the referential integrity of info: that facades
+// and methods have names, that facade versions are positive and
+// unique, and that every reference to a named type, whether from a
+// method or from another type, can be resolved in info.TypeInfo.
+// The fields described in info.Fields must also exist, and their
+// constraints must refer to existing facade methods. The sentinel
+// errors that methods refer to must be in info.SentinelErrors, and
+// no facade with a recorded error may be documented. The returned
+// error describes all the problems found.
+func Validate(info *Info) error {
+	var v validator
+	v.checkTypes(info)
+	seen := make(map[facadeKey]bool)
+	for i := range info.Facades {
+		f := &info.Facades[i]
+		key := facadeKey{f.Name, f.Version}
+		if seen[key] {
+			v.addf("facade %s(%d) appears more than once", f.Name, f.Version)
+		}
+		seen[key] = true
+		v.checkFacade(info, f)
+	}
+	for _, e := range info.FacadeErrors {
+		if seen[facadeKey{e.Facade, e.Version}] {
+			v.addf("facade %s(%d) has an error recorded but is documented", e.Facade, e.Version)
+		}
+	}
+	v.checkFields(info)
+	v.checkSentinels(info)
+	return v.err()
+}
+
+// ValidateFacade is like Validate but checks only the given facade
+// against info.TypeInfo. It is useful when the facades are produced
+// one at a time.
+func (info *Info) ValidateFacade(f *FacadeInfo) error {
+	var v validator
+	v.checkFacade(info, f)
+	return v.err()
+}
+
+type validator struct {
+	problems []string
+}
+
+func (v *validator) addf(f string, a ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf(f, a...))
+}
+
+func (v *validator) err() error {
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return errors.Newf("invalid document: %s", strings.Join(v.problems, "; "))
+}
+
+func (v *validator) checkFacade(info *Info, f *FacadeInfo) {
+	if f.Name == "" {
+		v.addf("facade with version %d has no name", f.Version)
+	}
+	if f.Version <= 0 {
+		v.addf("facade %s has non-positive version %d", f.Name, f.Version)
+	}
+	seen := make(map[string]bool)
+	for _, m := range f.Methods {
+		if m.Name == "" {
+			v.addf("facade %s(%d) has a method with no name", f.Name, f.Version)
+			continue
+		}
+		if seen[m.Name] {
+			v.addf("method %s(%d).%s appears more than once", f.Name, f.Version, m.Name)
+		}
+		seen[m.Name] = true
+		v.checkRef(info, m.Param, fmt.Sprintf("params of %s(%d).%s", f.Name, f.Version, m.Name))
+		v.checkRef(info, m.Result, fmt.Sprintf("result of %s(%d).%s", f.Name, f.Version, m.Name))
+	}
+}
+
+func (v *validator) checkTypes(info *Info) {
+	if info.TypeInfo == nil {
+		return
+	}
+	names := make([]string, 0, len(info.TypeInfo.Types))
+	for name := range info.TypeInfo.Types {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		t := info.TypeInfo.Types[jsontypes.TypeName(name)]
+		if t == nil || t.Name != jsontypes.TypeName(name) {
+			v.addf("type entry %s does not define that type", name)
+			continue
+		}
+		v.checkElems(info, t, "type "+name)
+	}
+}
+
+func (v *validator) checkFields(info *Info) {
+	for _, fi := range info.Fields {
+		var t *jsontypes.Type
+		if info.TypeInfo != nil {
+			t = info.TypeInfo.Types[fi.Type]
+		}
+		if t == nil {
+			v.addf("field info for %s.%s refers to unknown type", fi.Type, fi.Field)
+			continue
+		}
+		found := false
+		for _, f := range t.Fields {
+			found = found || f.Name == fi.Field
+		}
+		if !found {
+			v.addf("field info for %s.%s refers to unknown field", fi.Type, fi.Field)
+		}
+		for _, c := range fi.Constraints {
+			for _, m := range c.Methods {
+				if f := info.Facade(m.Facade, m.Version); f == nil || f.Method(m.Method) == nil {
+					v.addf("constraint on %s.%s refers to unknown method %s(%d).%s", fi.Type, fi.Field, m.Facade, m.Version, m.Method)
+				}
+			}
+		}
+	}
+}
+
+func (v *validator) checkSentinels(info *Info) {
+	for _, f := range info.Facades {
+		for _, m := range f.Methods {
+			for _, name := range m.Errors {
+				if info.SentinelError(name) == nil {
+					v.addf("method %s(%d).%s refers to unknown sentinel error %s", f.Name, f.Version, m.Name, name)
+				}
+			}
+		}
+	}
+}
+
+// checkRef checks that any named types referred
+// to by t can be resolved.
+func (v *validator) checkRef(info *Info, t *jsontypes.Type, what string) {
+	if t == nil {
+		return
+	}
+	if t.Name.PkgPath() != "" {
+		if info.TypeInfo == nil || info.TypeInfo.Types[t.Name] == nil {
+			v.addf("%s refers to unknown type %s", what, t.Name)
+		}
+		return
+	}
+	v.checkElems(info, t, what)
+}
+
+func (v *validator) checkElems(info *Info, t *jsontypes.Type, what string) {
+	v.checkRef(info, t.Elem, what)
+	v.checkRef(info, t.Key, what)
+	for _, f := range t.Fields {
+		v.checkRef(info, f.Type, what+" field "+f.Name)
+	}
+}
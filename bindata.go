@@ -1,8 +1,84 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
+// jujugenerateapidoc/access.go
+// jujugenerateapidoc/advertised.go
+// jujugenerateapidoc/audit.go
+// jujugenerateapidoc/cache.go
+// jujugenerateapidoc/checkpoint.go
+// jujugenerateapidoc/completeness.go
+// jujugenerateapidoc/constraints.go
+// jujugenerateapidoc/debugdump.go
+// jujugenerateapidoc/examples.go
+// jujugenerateapidoc/facades.go
 // jujugenerateapidoc/go.mod
 // jujugenerateapidoc/go.sum
+// jujugenerateapidoc/juju2.go
+// jujugenerateapidoc/juju3.go
+// jujugenerateapidoc/juju4.go
+// jujugenerateapidoc/lease.go
+// jujugenerateapidoc/loaderrors.go
+// jujugenerateapidoc/operational.go
+// jujugenerateapidoc/ordering.go
+// jujugenerateapidoc/platform.go
+// jujugenerateapidoc/profile.go
 // jujugenerateapidoc/prog.go
+// jujugenerateapidoc/registration.go
+// jujugenerateapidoc/retry.go
+// jujugenerateapidoc/roundtrip.go
+// jujugenerateapidoc/secrets.go
+// jujugenerateapidoc/security.go
+// jujugenerateapidoc/sentinels.go
+// jujugenerateapidoc/stats.go
+// jujugenerateapidoc/stream.go
+// jujugenerateapidoc/strict.go
+// jujugenerateapidoc/unserializable.go
+// apidoc/advertised.go
+// apidoc/audience.go
+// apidoc/canonical.go
+// apidoc/conventions.go
+// apidoc/crossmodel.go
+// apidoc/delta.go
+// apidoc/diff.go
+// apidoc/doc.go
+// apidoc/entitytag.go
+// apidoc/example.go
+// apidoc/families.go
+// apidoc/fields.go
+// apidoc/filter.go
+// apidoc/flat.go
+// apidoc/format.go
+// apidoc/html.go
+// apidoc/intersect.go
+// apidoc/legacy.go
+// apidoc/load.go
+// apidoc/logintarget.go
+// apidoc/lookup.go
+// apidoc/markdown.go
+// apidoc/matrix.go
+// apidoc/merge.go
+// apidoc/messages.go
+// apidoc/methodexample.go
+// apidoc/migration.go
+// apidoc/negotiate.go
+// apidoc/permission.go
+// apidoc/presence.go
+// apidoc/quickstart.go
+// apidoc/releases.go
+// apidoc/render.go
+// apidoc/requirements.go
+// apidoc/rpc.go
+// apidoc/sample.go
+// apidoc/schema.go
+// apidoc/schemagen.go
+// apidoc/sensitive.go
+// apidoc/sentinel.go
+// apidoc/single.go
+// apidoc/source.go
+// apidoc/stats.go
+// apidoc/tags.go
+// apidoc/usage.go
+// apidoc/validate.go
+// go.mod
 package main
 
 import (
@@ -68,62 +144,1582 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _jujugenerateapidocGoMod = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\xc1\x4e\x04\x21\x10\x44\xcf\xf2\x15\x1c\xf5\x00\x74\x83\x3b\x30\x9f\xc3\x42\x8b\x6c\x66\xa6\x91\x05\xe3\xe7\x9b\xac\xa7\x9d\xc4\x4b\xa5\x52\x49\xbd\xbc\x9d\xf3\xdc\x48\x96\x3a\x3e\xe7\x55\x27\xde\xcd\x6d\xde\xe6\x23\x62\xab\x99\xd3\xa3\x16\x3a\xa8\xc7\x41\x7f\x93\x10\x9d\xbe\x66\xed\x24\x5f\xc5\xcb\xff\x4f\xf9\x0d\x1a\x34\x28\x0b\x18\x10\x1c\xa0\x7d\x77\xd6\x29\x8f\xc1\x27\x08\x2b\xda\x8f\xab\x34\x46\xd6\x23\xd7\x4e\x69\x3c\xa1\x3a\x97\x46\xad\x91\xd9\xeb\xfd\x04\xc2\x80\x16\x57\xe7\x55\x86\x15\x2f\x0b\x5c\x62\xc2\xe5\x04\xe2\x2d\x1e\x45\x73\x2f\xe6\xc7\x0c\xe6\xed\x7e\x76\x01\x00\x8f\x8b\x8a\x10\xd1\x11\x78\x97\xfc\xb3\xcb\x9b\xf8\x0d\x00\x00\xff\xff\xb7\xba\x44\xe9\x19\x01\x00\x00")
+var _jujugenerateapidocAccessGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x59\xdd\x6e\xdd\xb8\x11\xbe\x96\x9e\x62\x72\x2e\xb2\x52\x56\x90\xb7\xe8\x9d\x17\xa7\x40\x9a\x4d\xb1\xdb\x6e\x37\xc6\xc6\xe9\x5e\x18\x46\x41\x4b\x23\x1d\xda\x3a\xa4\x4a\x52\x49\x8c\xd8\xef\x5e\xcc\x90\x94\xa8\xf3\x63\x2c\x02\xc4\x32\x7f\x86\x33\xc3\x6f\xbe\x19\x8e\x47\xd1\x3c\x88\x1e\x61\x2f\xa4\xca\x73\xb9\x1f\xb5\x71\x50\xe4\xd9\xa6\xd7\x17\xc2\xba\x8d\xff\x6a\xb4\xb2\x4e\xa8\xf8\xab\x7b\x1c\xd1\xd2\xb7\x75\x46\xaa\x9e\x3f\x27\x25\x1b\xdd\xe2\x26\xa7\x25\xd2\xed\xa6\xbb\xba\xd1\xfb\x8b\xfb\xe9\x7e\xe2\xff\xc4\x28\x5b\xdd\x5c\xf8\x1f\xb4\xa1\xd7\x83\x50\x7d\xad\x4d\x7f\xf1\xf5\xc2\x69\x3d\xd8\x8b\x5e\x5f\x04\x7d\xec\x26\x2f\xf3\xfc\xe2\x02\xec\x34\xa2\x99\x2c\x9a\xb7\x4d\x83\xd6\xc2\x4e\x0f\xad\x05\xb7\x43\x50\x62\x8f\xa0\x3b\xfe\x1e\xd1\xec\xa5\xb5\x52\x2b\x10\x7e\xdd\x80\x9f\x71\x20\x01\x3b\x1c\x5a\xb8\x7b\x84\x46\x2b\x67\xf4\x30\xa0\x59\x64\xda\x3a\x67\xcb\x8e\x4e\xd9\xc2\xe6\xe3\x7a\x68\xc3\xda\xec\x75\x8b\x83\x1f\xf8\x95\x0e\x38\xd4\xc7\x9e\x55\x88\x76\xb3\x4e\xb4\x58\x38\x10\x06\xa1\x37\x42\x39\x6c\x41\x2b\x2f\xd8\x82\x11\x6e\x87\x86\x56\x28\x1a\x75\x3b\xa4\x7d\x8b\xea\x75\xfe\x59\x98\x13\x5a\x6c\x61\x2f\xc6\x1b\x7f\x1b\xb7\x77\x5a\x0f\xdf\xf2\x6c\xf3\xb6\xdd\x4b\x15\xb4\xbf\x04\x67\x26\xac\xf2\x6c\xf3\x87\x91\x0e\x8f\x46\x7f\x47\xd1\xce\x83\x61\xf4\x99\x4d\x6e\x76\xd8\x3c\x5c\x19\xec\xe4\x57\x4c\xcd\x1d\xe3\x90\xee\x8e\xcd\xef\x26\xd5\x38\xa9\x95\x25\x09\x42\xb5\xb0\x47\xb7\xd3\x6d\xb0\xbd\xd1\x93\x72\x20\xac\x97\x2d\x55\x0f\x9d\x26\xa3\x71\x75\x79\xb4\x18\x1f\x59\x80\x41\x18\x85\xb5\xd8\x56\x60\xa7\x66\x47\x5b\x7f\x16\xf6\x6a\xf6\x71\x0d\x1f\xc8\x6f\x76\x99\x26\x61\x5a\x21\x7d\x08\x47\x32\xd8\xd7\x41\x7e\x95\x48\x9c\xcf\xd2\xda\x3b\x77\x6d\xef\x16\x6e\x6e\xbd\x5b\xbf\x6d\x7e\x16\x76\x53\xc1\xe6\x1d\x2d\xe0\x0f\xa1\xe8\xc7\x7b\x65\x27\x83\xf4\xf5\x3b\xfe\x6f\x92\xfe\xf3\xed\xe4\x76\x1b\xef\x40\x27\xfa\x8f\x8d\x1e\xd1\xd2\x1d\x9d\x80\x8a\x13\x3d\x70\x40\x81\x54\xc9\x6c\x08\x04\x96\xa0\x79\xdc\x92\x94\xb8\x2b\x78\x8a\xb5\xf5\x10\x72\x3b\xdc\x7b\x13\x96\x13\x57\xb8\x08\x76\xe4\xd9\xe6\xdd\x0c\xa8\x6b\xd1\xd3\x85\xf3\x3f\x1f\x9a\x35\x2b\xbb\xac\x20\xcc\xfc\x9b\x00\x97\x2e\x3d\x5a\xcf\x2b\x68\xe9\xbb\x41\x4f\xed\x8b\x4b\x79\x05\x2d\x7d\x3b\x8e\x83\x6c\x04\xe1\xe4\x43\xd7\x45\x5d\xd2\xa5\x3c\x1c\x81\xe8\x21\xe4\x41\xca\x77\x60\xc1\xa0\x9b\x8c\xb2\xa7\x23\x2e\xde\x6c\xb8\x67\xa7\x49\x48\x23\x86\x81\x2e\xa2\x45\xe2\x04\xda\xd7\xcb\xcf\xa8\x82\xf0\x0a\xb4\x89\xe3\x33\x84\xe1\x8b\x74\x3b\x39\x07\x23\x71\x19\x45\xe0\x34\x20\x83\x0b\xa4\xf3\x52\x79\xb3\x92\x03\xc8\x0e\xa4\xb3\x60\xf5\x64\x1a\x84\x46\xa8\xef\x1c\xdc\x21\x74\x7a\x52\x6d\x9d\x93\xdc\x13\xb6\x14\xe3\x43\x0f\x6f\x22\xfb\xd5\x57\xfe\xa3\x82\xd1\xc1\x1b\x46\x47\x7d\xfd\x38\xe2\x6f\x62\x8f\x15\x87\x1a\xf8\xdb\x2c\xe1\x8d\x48\x3d\xf2\x2d\xcf\x5a\x6c\x86\x0a\xe8\xff\xab\x87\xbe\x02\x34\x06\x2e\xb7\xe1\xc4\x9f\xb0\x19\xe8\x24\x92\xeb\xe5\x94\x79\x26\x3b\x5e\xf4\x6a\xcb\xda\x3f\x3d\xf1\xde\xfa\xef\xba\x7d\x84\xad\x1f\xfb\x96\x67\x99\xf7\x35\xfd\x9a\x67\xcf\x79\xd6\x90\xd0\xd7\xe9\xd9\xb4\xc8\x22\xaa\x4b\xd8\x8b\x07\x2c\x08\x78\xe1\x2e\x97\x40\x0d\x11\xb2\x47\xe5\x98\xa6\xca\xca\x0b\xab\x3b\xa9\x5a\xaf\xd8\xac\x38\x7d\x54\xf0\x43\xb5\x88\x7b\x23\xac\xab\xff\x31\xa9\x86\xcc\xf0\xfb\xcb\x3c\x2a\xd6\x04\x98\xcc\x54\xce\x5a\xad\x20\xc2\xc1\xc2\x5c\x73\x2a\x19\x04\xd8\xd0\x1d\xf3\x55\x11\x10\x9a\xf4\x52\x09\x15\xde\x8d\xd0\x6a\xb4\x74\xad\x62\x1c\x51\x18\x70\x1a\x14\x62\xbb\xc8\x22\x21\x5e\x5c\x0d\x6f\xe3\x26\x5a\x62\x09\x2e\x0c\x10\x96\x2c\x66\x98\x25\x28\x63\x88\x85\x6c\x73\x02\x65\x8c\x67\x0b\x07\xf9\x09\x3a\xa3\xf7\x07\x81\x40\x32\x02\xa2\x48\x45\xc1\x38\xad\x98\x8f\x95\x26\x0c\xe3\x10\xb4\xf4\xb1\x02\x72\x8e\x16\xa1\x1e\xbf\xec\xd0\xe0\x25\xc9\x10\xd1\x02\xd6\x44\x0c\x56\x7b\xe6\xb6\xec\xcb\x95\x9c\xc9\x4e\x62\x18\x1e\x61\x40\x67\xfd\x09\x2c\x80\x32\x11\x85\xa9\xd1\x53\xbf\xf3\x5c\x4b\x86\x43\xd1\xac\xf1\x5b\x2e\x2e\xe4\x81\x82\xf0\x1d\x08\x61\x35\x01\xdf\x18\xb7\x4d\x44\xe8\xd3\x13\x34\xf5\xbc\x97\x46\x37\x1b\x3f\xc8\x4a\x9c\x42\x70\xf8\xf5\xf5\x49\xf9\xb4\x9e\x3f\x2e\x53\xb9\x8c\x56\x0f\xb3\xc5\xcb\x6b\x1a\x4a\xfd\x19\xd3\x5d\xc0\x93\xd3\x44\x03\x81\xb1\x49\x46\xa7\x4d\x15\x49\x5f\x9b\x16\xcd\x8a\x23\x8e\x7d\x93\x9c\x59\x94\x70\x73\xfb\x52\x78\x1d\x3a\xe8\xbc\x03\x9a\xda\x2c\xdb\x6c\x88\xa2\xf4\x60\x30\xd8\x68\x13\xd3\xfe\x7c\xd8\xda\x54\xb2\x67\x66\xda\x03\x9a\x8d\xf8\xa9\x73\x62\xb2\xb5\x6c\xeb\xcc\xd4\xb0\xb6\x69\xec\x26\x75\x46\xa0\xd1\x90\xf6\x3a\x69\xac\x8f\x04\xde\x10\x1c\x4b\xb0\x5c\xb0\x7b\x10\x19\x75\x9e\x2d\x72\x3d\x6b\xe6\xbc\xd9\x23\xc3\x9f\xf4\x65\x87\x54\x41\x80\x50\x8f\x2c\x7d\x11\xc7\x4b\xc5\x89\x68\xa9\xf3\xcc\x0f\x12\x11\x79\x89\xa9\x23\x13\x13\x56\x8e\x8a\x5a\xfa\xa4\xce\xdb\xe8\xc0\x88\x95\x99\xa2\x28\x9d\xfb\x58\x25\x52\x4d\xa4\x51\x59\x13\x4e\xa3\x6b\x21\xda\xd1\xd0\x09\x53\x31\x85\xe8\xc9\xd1\x2a\x19\x0a\x19\x5b\xe7\xd9\x4a\xab\x97\x51\x93\x67\x7c\x58\x4c\xdb\x7f\x8a\xc3\x03\x62\x88\xc1\x57\x48\x39\x67\xb5\xb7\x36\xc0\xfe\x8e\xb2\x8c\xbf\x5b\x42\x90\x4f\xc6\x91\x13\x99\x22\xa5\xe2\x4b\x39\xc7\x93\x07\x24\x39\xe7\xf8\xb3\x41\x74\x94\x69\x4e\x65\x5d\x9a\x82\x55\xba\xa1\xb1\xd1\xed\x40\x2a\x57\xc1\x67\x69\x25\x15\xee\x67\x92\x52\x88\xbd\xb0\xea\x86\x84\xdd\x9e\xcc\xab\x61\xec\xea\xa1\xe7\xec\x6e\x7f\x51\x9d\x3e\x0e\x58\x0e\xd6\xb5\xb0\x2d\x97\xe8\x79\x46\x1a\xfe\xa2\xec\x88\x8d\x2b\x66\xe9\x15\x27\x95\x42\x01\xcd\xfe\xa6\x5b\x2c\x81\xd4\x22\xad\x32\xf2\x4d\x05\xfa\x81\xb2\xb7\xaa\x0b\x56\xfe\x9d\x18\x86\xf7\x5f\x47\x53\xe6\x19\x71\xea\x2b\xfd\xc0\x4b\x23\x3f\xf8\x93\x48\x87\x8c\xe8\xfe\xbf\x54\x3d\xf7\xb4\xdf\x08\xd5\x53\x7d\x33\x0c\xf5\x5b\xd3\x5b\xbf\x89\x43\x83\x66\x17\xa2\xe0\x37\x4a\x11\x0c\xe5\xdd\x74\x12\x1d\xe5\x17\x27\x06\x67\x19\x65\x66\xa9\xf8\x44\x3e\x32\xb3\x5f\xa4\x6b\x76\x7e\xb6\x11\x16\x43\xf0\x51\x25\x54\x94\xe4\xac\x39\xb8\x7d\x69\x78\x49\x0b\x49\xf8\x71\x3e\x60\x19\x59\xb6\x9a\xf0\xa5\x77\x4d\xf6\x7f\x64\x6e\x28\xc8\x20\x56\xd0\x9f\xcf\x67\x1e\xbd\xb8\x6e\x52\x2d\x6e\xfd\x99\x31\xd7\xc4\xcb\x09\x02\x28\xdb\xdb\xa4\xd4\x2b\xe8\x76\x78\x23\x9d\x44\xd0\x29\xcb\x68\x7c\x2d\xda\x76\xf1\x14\xcd\x57\xde\xde\x32\x8a\x23\x91\xb2\x0b\x60\xfc\x1b\xd5\xf7\x5f\xe9\xad\x41\x77\xf8\x13\x8f\x9d\xb9\x3a\x7a\x17\xc8\xd6\x63\xfa\x97\x96\x43\x3d\xba\xb6\x9b\x14\x5d\x58\xd4\xa6\x2e\xc8\x25\x65\x80\x8b\xc5\x64\x0f\xdb\x29\x5b\xd8\x12\xc2\x56\xd3\x1f\x71\xc0\xc6\x69\x43\x7e\x5c\xad\xaa\x3f\xe2\x90\x67\x59\x8b\x9d\x98\x06\x77\x79\x06\x57\x2a\x62\xf2\x28\x1c\xea\x4f\x16\xed\x8d\x6c\x6f\xeb\x22\xd4\xc1\x54\x00\x26\x50\x7d\x7a\x82\x4e\xd5\x57\x0f\xbd\x47\x03\x85\xce\xd3\x13\xbc\x0a\x9d\x89\x9a\xde\x89\xfc\x98\x2b\xe2\xaa\xfa\x4a\xb8\x5d\x51\x56\x5c\x66\x5d\x3d\xf4\xfe\x6d\x5b\x9e\x73\x1c\xb9\x05\x91\x2a\x4e\x7f\x21\x88\x69\x59\x4d\x6c\x42\x73\x81\x39\x3c\xb1\xd0\x49\xda\x16\x65\xd0\x32\x29\xae\xcf\x9c\x21\x3b\xe8\xc8\xf2\xe8\x85\xe5\xcc\xba\x58\xf1\x4b\xf9\x23\xc4\xe8\x4c\x6b\xe6\x44\xaf\x20\x87\xf1\xf1\xfd\x5f\x66\xaa\x2a\xc3\x49\xab\xb3\x9f\xcb\x98\xed\xdb\x94\xba\x29\x0d\x51\x0a\x4c\x6a\xbc\xe5\xa1\x94\xb2\x7a\x45\x6c\x3b\xa9\x81\x52\x22\xad\xb0\xf4\x2e\x49\xb2\x0d\xec\x84\x05\x31\x18\x14\xed\x23\xdc\x51\x62\x79\xb9\xbe\x21\xf0\x9f\x79\x06\x91\x85\xb0\x62\xab\x10\x18\xf1\x75\xf4\x8e\xfa\x38\x91\x79\x79\xa6\xfe\x8f\x18\x8a\xb2\xfe\x17\xf1\x7d\x09\xaf\xb6\x10\x9b\x58\xb5\x0f\xf4\x43\x86\xe5\x67\xd2\x4b\xd9\x8e\xd6\xbf\x57\x4e\xba\x47\x92\x79\x79\xbc\xd6\x4f\x7e\xf2\x85\x62\xc6\xb4\x17\x5e\xc1\x07\x47\x93\x66\x89\x8e\xf4\x0a\xca\xf8\x6d\x1c\x96\x7b\xb7\xf0\xc8\x72\xc1\xe1\xad\x24\x3b\x30\xf5\xaf\x91\x38\x63\x95\x4b\x69\xfb\xc6\xdc\x1e\xda\xb4\x4c\x44\x52\x32\x35\x7b\xfb\x05\xe2\x5b\xd7\x83\xb0\xe5\x47\x8e\x6a\x8b\xf5\x78\x05\x66\x46\xcf\xa2\xed\xaa\x14\x7e\xa9\x5f\x41\x6f\x2b\x66\x37\x7a\xb6\x90\x10\xc2\x8f\x7b\x5c\xd6\x73\x57\x84\xeb\x3a\xf7\x5d\xac\x1e\x6a\xf8\x63\x87\x6a\x9e\xde\x85\x3e\x0f\x3d\x63\x6d\x7d\x2d\x7a\x92\x23\x95\x43\xd3\x89\xc6\x4b\xab\x12\x45\xa4\x85\x7e\x42\x92\xe3\xdf\x4a\xcb\x1b\x7d\x6e\x1b\x91\x80\x8d\x18\xa5\x27\xf2\x6b\xd1\x6f\x7c\x05\xd6\x09\x39\x50\x9b\x8a\x63\x63\x7e\x68\x85\x37\x93\xb3\x38\x74\x01\xd3\x07\x17\xf7\xe7\x90\x5c\x86\xaa\x94\x2e\x8f\x68\xda\xcb\x0d\x95\xea\x9f\xc8\xb9\x94\x48\x4f\x65\x5c\x46\x0e\x65\xdb\x1f\x61\x58\x11\x50\x48\xbb\x10\xf3\x57\xbe\xce\xbb\xc4\x13\x8e\x05\xae\x88\x98\x28\xf9\x43\x57\x84\xf4\x2d\x3b\x18\x23\x5d\xb9\x99\x9b\xaf\x34\xfb\x3f\xa1\x29\x07\x5b\x18\xeb\xf7\x03\xee\x8b\xc8\x41\xb2\xe3\xce\x43\x7b\xbc\x9d\xd4\x69\x93\xcd\xfa\xee\x9e\x16\xf0\xea\xfa\xc3\xdd\x7d\x11\x0b\x07\x7d\x77\x1f\x28\x3f\xd8\xf5\xfa\x75\x70\x58\xca\xf8\xf3\xaa\x99\xf2\x8f\x3a\xd3\x24\xda\x6e\x62\xfe\x95\x9d\xc7\xca\xac\x59\x6c\xa4\xdd\x90\xa8\x90\xec\x17\xf5\x62\xa8\xf9\x4d\x4b\xc9\x10\xf3\xb4\x35\xdc\x25\x89\x8a\x5d\xeb\x5f\xf5\x17\x34\xc5\x51\xe0\x91\x4b\xcb\xa5\xbc\x62\x69\xcb\x65\xcf\x0d\xc8\x33\xfd\xb9\x55\xc7\xcc\xf7\xe1\x56\x43\xbe\xdf\xb6\x1a\xe2\xbe\xda\xb3\x37\x42\x76\xb3\x82\x24\x54\x48\x65\x0b\x6b\x9a\xa0\x46\xf4\xcc\xa1\xa1\xc1\xc4\xe7\x3c\xa9\xcf\x5e\xaa\x94\xb8\x44\x0a\x42\x52\x4d\x58\xdf\xb0\x75\xae\x06\xa9\x3f\x69\xa7\xfd\xdc\xa5\x3e\xbd\x95\x8d\x48\x1f\xb2\xa7\xfd\x13\x48\x6a\x55\x82\x81\x41\xfa\x3b\xc7\xf2\xec\x4b\x1b\x7e\xf4\xe8\xf0\xef\x55\xa2\x03\x7a\x53\x25\x7d\x42\xc2\xcb\x61\x62\x4c\x53\x22\xf5\x77\xbe\x4b\x1e\xc3\x35\x7c\x44\x5c\xf7\x94\x03\x55\xac\x6b\xc2\x55\x4b\x2f\x56\xeb\x21\x50\x92\xea\x35\x58\xda\x89\xc1\x52\xfe\xe6\x67\xe0\x27\x85\x5f\xc9\x1a\x6c\x67\x13\x92\x46\x38\x1f\xfd\x4e\x28\x6a\xf0\x57\xa1\xf3\xce\xfd\x17\x9f\xef\x6e\x6e\xcd\xa4\xb0\x08\x9d\x40\x73\xf3\x03\x65\x8a\xf0\xa7\x9c\xfa\x5a\x7f\x1a\x47\x34\x05\x0d\x97\x79\x46\x8b\x20\xc2\xb9\x30\xe5\xcc\x4d\xfe\x4f\x01\x09\x3d\xad\x5a\xe8\xdf\xf2\x15\xc8\x96\xf0\x24\x79\x71\x73\xc0\xd9\x51\x69\xb4\xdc\xae\xb7\xf9\xb0\xf9\xc2\x18\x5b\x65\x9d\xf4\x36\xe6\x94\xbf\x74\xc7\x62\x6f\x6d\x11\xb1\xb4\xc8\x88\xdd\x11\x0c\x76\x68\xe8\x0f\x02\x8b\x13\x0f\x3a\x0a\x5c\xf7\xa4\xcd\xde\x65\x0f\x28\xad\x30\xdc\xf0\x81\x96\xe7\x12\x02\xf2\x3b\xcd\x27\x82\xc0\x83\x84\x7f\x17\xd3\xc1\x41\xd5\x1e\xe2\x0d\xc9\xdd\x98\xd4\xea\x27\x4a\x75\xae\xd4\x31\x7f\xa1\x4c\xf7\x2b\x7c\x8d\x9e\x94\xe8\x07\x8d\x22\x7d\x77\x1f\x19\x71\x7c\xb9\x36\x67\xc5\xcb\x3c\xa9\xcd\x17\xa6\x4e\x9e\x79\x07\xf2\x2f\x2e\xe0\x7a\x87\xf3\x3d\xec\xf5\xe7\x98\xa3\x93\x5b\x72\x1a\x1a\x6d\xf0\x62\x19\x62\xf4\x4b\x05\xff\x9c\xee\x27\xf8\x6b\x9d\x67\xa3\x70\x3b\x52\xf2\x90\xf7\x59\x1f\x9e\x7c\xb5\x5d\x57\xfd\xdf\x6f\x16\x71\x1b\x78\xfd\xfa\xdc\xaa\x83\x93\x37\xa7\xac\x08\xbf\xea\xbb\xfb\xfc\x39\xff\xff\x00\xe2\xe3\x3b\x55\x57\x1d\x00\x00")
+
+func jujugenerateapidocAccessGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocAccessGo,
+		"jujugenerateapidoc/access.go",
+	)
+}
+
+func jujugenerateapidocAccessGo() (*asset, error) {
+	bytes, err := jujugenerateapidocAccessGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/access.go", size: 7511, mode: os.FileMode(436), modTime: time.Unix(1792000111, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocAdvertisedGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x93\x41\x6b\xdc\x3e\x10\xc5\xcf\xd2\xa7\x18\xf6\xf0\xff\xdb\xc5\x78\x7b\x5e\xc8\x21\xb4\x14\x02\x69\x29\x14\x7a\x31\xa6\x4c\xad\xb1\x57\x89\x2c\x19\x8d\xec\x52\xc2\x7e\xf7\x22\xc9\x59\x6f\xb3\x09\xdd\xc3\x1a\xcd\xd3\xfc\xde\xe8\x21\x4d\xd8\x3d\xe2\x40\x30\xa2\xb6\x52\xea\x71\x72\x3e\x40\x21\xc5\x8e\x9d\x0f\x3b\x29\xc5\x6e\xd0\xe1\x38\xff\xac\x3b\x37\xee\x1f\xe6\x87\x39\xff\xe1\xa4\x99\xfc\x42\x7e\xf7\xcf\x1d\xfb\x1e\x3b\x54\xf4\x26\x0b\x27\xad\x5c\xb7\xcf\x9f\x9d\x2c\xa5\xdc\xef\x01\xd5\x42\x3e\x68\x26\xf5\x29\x75\x33\x78\x0a\xb3\xb7\x0c\xe1\x48\x90\x89\xb0\x90\x67\xed\x52\x0d\x43\x12\x6e\xbf\xde\x41\x9e\x2b\x42\x8c\xe6\xc0\xa0\x2d\xe8\xc0\x70\xef\x06\x6d\xc1\x13\x4f\xce\x32\x55\x30\xe8\x85\x2c\xa0\x31\xa9\xd1\xd3\xa0\x39\x90\x27\xb5\xc2\xb9\x8e\x84\x7b\xfd\x48\x2f\xc0\x15\xfc\x3a\xea\xee\xb8\xc2\x9d\x35\xbf\x2f\x46\xba\x18\xc5\x44\xbf\xc8\x30\x14\x38\x31\x3a\xa3\xc9\x06\x98\xa3\xbb\x0e\x60\x08\x17\x62\x70\x73\xb8\x06\xa0\x27\xfb\x7f\xc8\x8e\x2a\x42\x9c\x05\xc2\xee\x08\x64\xd5\xe4\xb4\x0d\xb5\xec\x67\xdb\x5d\xc7\x54\x28\x86\xa6\xcd\xac\xfa\x23\x05\xd4\x86\x4b\x78\x97\xc3\xad\x6f\xaf\x52\x7d\x92\xe2\x9c\xe2\xe1\x06\x46\x7c\xa4\x62\xc4\xa9\xe1\xe0\xb5\x1d\xda\xa6\xd5\x36\x94\x52\xf4\xce\xc3\x8f\x0a\x14\x1c\x6e\xc0\xa3\x1d\x08\x14\xc3\x93\x14\xe7\xe6\x46\xd5\x5f\x70\xa4\x16\x6e\x00\xa7\x89\xac\x2a\x5e\x2a\x15\xa8\xfa\x7b\xae\x95\x52\x9c\xa4\xb0\x38\xd2\x66\xda\xb4\xd9\xb2\x82\xf7\x15\x18\xb2\xe7\xfe\x72\xb5\x8f\xdb\x37\xfb\x67\x35\x0d\x11\x25\xde\x9c\xd3\xb2\x82\xf8\xc9\x4e\xf1\x2a\xd7\xdf\x12\x9e\x8b\x58\xe6\x52\x8a\x05\x3d\x20\xbc\x15\xcc\xf9\xc8\x7f\xdb\xc6\xd5\x7a\xf0\x34\xfa\xf3\x18\x4d\x14\x5a\x29\xb2\xd5\x9d\x0d\x5c\x2c\xd1\x44\xf4\x71\xd7\x6a\x92\x6f\xf2\x9a\x01\x47\x88\x88\x99\x1d\x20\xfe\x22\xa0\x8a\xa5\x67\xfd\x00\x0b\xc7\xc2\x49\x0a\xa1\x7b\x38\x3f\xa7\xfa\x8e\x3f\x38\x1b\xbc\x33\x86\x7c\x46\xa6\x33\x95\x69\x2c\x81\xf5\xa6\x6e\x91\x5c\x56\x2b\xe8\xcb\xd7\xb9\x9f\x9d\x22\xf3\x1a\x32\x09\x97\xb4\x54\xd8\x40\x27\x29\xf2\xf3\x84\xff\x50\x9e\xe4\x9f\x01\x00\x70\x6d\xce\xea\x54\x04\x00\x00")
+
+func jujugenerateapidocAdvertisedGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocAdvertisedGo,
+		"jujugenerateapidoc/advertised.go",
+	)
+}
+
+func jujugenerateapidocAdvertisedGo() (*asset, error) {
+	bytes, err := jujugenerateapidocAdvertisedGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/advertised.go", size: 1108, mode: os.FileMode(436), modTime: time.Unix(1791999565, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocAuditGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x55\x4d\x8f\xdb\x36\x10\x3d\x4b\xbf\x62\x56\x87\x54\x0a\x14\x39\xed\x31\x85\x0b\x2c\xd2\x6d\x60\xb4\x4d\x83\xee\x62\x2f\x86\x51\x70\xc5\x91\x4c\x88\x22\x05\x92\xf2\xda\xcd\xfa\xbf\x17\xc3\x0f\xdb\xfb\xd1\x93\x65\xf2\xcd\x9b\x37\xc3\x37\xe4\xc4\xda\x81\xf5\x08\x23\x13\x2a\xcf\xc5\x38\x69\xe3\xa0\xcc\xb3\xa2\xd7\x0b\x66\x5d\x11\xbe\x5a\xad\xac\x63\x2a\xfd\x75\x7a\x40\x45\xdf\x56\x1b\xbf\x66\x9d\x11\xaa\xb7\x45\xee\xf7\x25\x53\x7d\xa3\x4d\xbf\xd8\x2f\x9c\xd6\xd2\x2e\x7a\xbd\x88\x79\x6c\x91\x57\x79\xbe\x58\x00\x9b\xb9\x70\x37\xfb\x56\xce\x1c\xf9\x9f\xe8\xb6\x9a\x5b\x30\xe8\x66\xa3\x2c\xb8\x2d\xc2\x18\xd6\x6a\x60\x16\x7e\x63\x2d\xe3\xd8\x04\x58\x0d\x6e\xcb\x1c\x71\x10\xec\xfa\xdb\x0a\x2c\x9a\x1d\x1a\x90\xc8\x76\x68\x41\xcf\x0e\x74\xe7\x39\x7c\x12\x90\xba\x87\x87\x03\x70\xec\xd8\x2c\x5d\x03\x77\x5b\x3c\xfc\x60\x30\x31\x04\xed\x90\x4a\xb4\x20\x94\x0f\x16\x4a\x38\xc1\xa4\xf8\x17\x8d\x4d\x84\xb1\x8a\x0f\x12\x77\x28\x89\x60\xc7\x8c\x60\x0f\x12\x4f\x51\x17\x7a\x22\x18\x1e\x85\xdb\x82\x62\x23\x5a\x18\x51\x39\xa1\x95\x50\x3d\x05\x17\x06\x19\x07\xad\xe4\xa1\xa8\xc1\xce\xed\x96\x6a\xa5\xb5\xbf\x94\x3c\x7c\x66\x52\x86\xea\x29\x71\x14\x0f\xc2\x82\xd3\x14\x8b\xa1\x75\x3e\x27\x85\x7c\x20\x9a\xd4\xb4\x26\xef\x66\xd5\xbe\xd9\xe3\x72\x1a\x7a\x78\x1f\xa5\xd9\xe6\x5b\xf8\xa8\x60\x64\xd3\x3a\x74\x62\xf3\xa0\xb5\x84\xef\x79\x16\x53\x70\xf8\xb4\x84\x91\x0d\x58\xbe\xc0\x54\x79\x26\x3a\x98\x86\xbe\xb9\x3b\x4c\x68\x57\xaa\xd3\xb0\x5c\x82\x12\x3e\x3a\x0b\x87\x99\x84\xf2\x3c\x3b\xe6\x59\xa7\x0d\xfc\x53\x43\x47\x94\x86\xa9\x1e\x7d\xf8\xed\x41\x39\xb6\xf7\x41\x11\xc0\xb1\x95\x67\x4c\xd7\xfc\x8a\xad\xb4\x1e\x90\xf5\xb4\x57\x83\x1e\x68\x9f\xbe\x9b\xf2\x3d\xb3\xae\xf9\x82\x8a\x50\x15\x61\x44\x07\x57\x7a\x80\xa7\x27\xf0\xe8\xe6\x4e\x0f\x70\xb5\x04\x6f\xdb\xe6\xfe\xfa\xef\xc0\x94\xb5\x5a\x39\xa1\x66\xa4\x3f\xc7\x3c\x3b\x65\xb7\x13\xb6\xe7\xec\x81\xe2\x76\xc2\x36\x2a\xc8\x76\x09\x40\xbf\x31\xfd\x3d\x93\x33\x12\xc8\x0b\xf0\x85\x8a\x1a\x04\x3f\xf3\xf8\xa8\xe6\xab\xf7\x41\xe0\xa1\xf6\x09\xf8\x65\x09\x12\x55\x19\xb6\x3d\x8d\xad\x48\xfa\x55\x1c\xaa\xe6\xb3\x56\x8e\x09\x65\xcb\xb4\x70\xa7\xff\xd0\x8f\x68\x4a\xc1\x3d\x5d\x55\x07\x27\x91\x03\x8a\x2a\x6a\x7c\x5e\x5d\xac\x2f\xcb\x18\xe7\xd7\x97\xae\x20\x3b\xd4\x70\x99\x7b\x2d\x36\xf5\xe9\xd0\x42\x31\xc7\xd4\xa0\xa3\x3f\xc4\x97\x07\x7b\x0c\x03\xfd\x82\x99\x16\xc8\xac\x27\x1c\x30\x29\xdf\x1c\x38\x8a\x8e\xf3\xd5\x69\x33\x3e\x9f\x76\xe8\xf4\xac\x38\x4d\x17\x26\x57\xbf\x51\xc2\x6b\x47\xd7\x80\x40\xb6\xb8\xd9\x4f\xe6\x5c\xcf\x4b\x9b\xfb\x6e\x11\x6c\xa5\xa8\x05\xae\xc4\x1a\x28\x49\xa9\x7c\xf0\x57\xcd\xb1\x82\x34\x0f\x19\x7a\xae\xe0\x3c\xd5\x94\x89\xbe\xca\x4f\x8e\xf3\xbd\x8f\xfd\x71\xc6\xf7\x9e\x9a\xe7\x76\x14\xf2\x6c\x52\xc2\xcc\xac\x89\x72\x13\xe2\xdd\x2e\x9c\x40\x9a\xa1\xa7\xa7\xd3\x52\xf3\xbb\x50\xbc\xac\xc8\xc3\xa9\x6b\xcd\xad\xaf\xe2\xff\x32\x5a\xf8\xf4\x0a\x7b\xcf\x64\x99\x18\xa3\xe6\x89\x19\xe7\xa1\xc9\x5a\xb7\x93\x14\xae\xb4\x35\x14\x4d\x51\xfd\xec\x8d\xe9\x31\x15\xa9\xfa\x09\xde\xbd\x03\x61\x57\x1c\x95\x0b\xcb\xeb\x8f\x9b\xea\xf5\xe2\x8f\x9b\xe8\xc2\xd4\xf6\xb5\xdd\xc0\xf2\x52\x5e\x14\xdc\x31\x69\x31\xcf\x8e\x55\xf4\x50\xa4\x01\x83\xf4\x0c\x59\x78\xdc\xa2\xdb\xa2\x01\x4b\xf7\x1e\x53\x80\x7b\x5a\x47\x0e\x5f\x34\x08\x52\x21\x3a\x81\xa6\x26\x03\x31\x0b\x9d\xf7\x0d\x30\xc5\xe3\x3d\x18\x2f\x5d\x66\x92\x75\x92\x4c\x1b\x3d\x78\x3e\xdb\x28\xc8\x52\x8b\x8b\x82\x4a\x0a\x97\xc5\x2a\x44\xf8\x3c\xa5\xf5\xb5\xd2\xb1\xaf\xec\x4d\x94\x52\xda\x24\x9e\xde\x42\xe4\x34\x90\xcf\x1f\xb2\x01\x0f\xfe\xfd\x18\xc9\xc4\x01\x04\xda\x70\x34\x51\xd4\x45\x5c\x39\xbe\x36\xe8\x7a\x13\xe7\xe5\x7b\x9e\x85\x7a\xd2\x75\x9c\x76\x6a\xf8\x58\xfb\x93\x1a\xab\x2a\x5c\xb1\x84\x3b\x5f\x3c\x23\x15\x18\x63\x97\xc0\xa6\x09\x15\x2f\xfd\xdf\xda\x77\xa8\xf2\x53\x4d\x32\xa2\x51\x6c\xd8\xad\x4e\x6d\x51\x6c\x44\x9b\x1f\xf3\xff\x06\x00\xde\xfc\x34\xa0\x2d\x08\x00\x00")
+
+func jujugenerateapidocAuditGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocAuditGo,
+		"jujugenerateapidoc/audit.go",
+	)
+}
+
+func jujugenerateapidocAuditGo() (*asset, error) {
+	bytes, err := jujugenerateapidocAuditGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/audit.go", size: 2093, mode: os.FileMode(436), modTime: time.Unix(1791997693, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocCacheGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\xc1\x6e\xdc\x3c\x0e\x3e\x5b\x4f\xc1\x3f\x87\xd6\xd3\x7a\x35\xf7\x14\x39\x6d\x5b\x6c\x81\x76\x1b\xa0\xdd\xbd\x04\xc1\x42\x23\xd3\xb6\x62\x5b\x32\x24\x79\x1a\x6f\x30\xef\xfe\x83\x92\x3c\x63\x67\x92\x34\x40\x2f\x33\x96\x44\x8a\xe4\xc7\x8f\x94\x34\x08\xd9\x8a\x1a\xa1\x17\x4a\x33\xa6\xfa\xc1\x58\x0f\x39\xcb\x2e\x6a\xb3\x15\xce\x5f\xc4\x2f\x6f\x5a\xd4\xf3\xf7\x34\xa0\xa3\x6f\x8b\x55\x87\x32\x88\xb8\x49\xcb\x0b\x46\xeb\xca\x37\xe3\x8e\x4b\xd3\x6f\xef\xc6\xbb\x31\xfc\x88\x41\x95\x46\x6e\xe3\x1f\x49\xd7\xa6\x13\xba\xe6\xc6\xd6\xdb\xfb\xad\x37\xa6\x73\xdb\xda\x6c\x93\x27\xee\x82\x6d\x18\xdb\x6e\x21\x8d\xbf\xe8\x12\xef\x41\xd1\x2f\x3a\xf0\x0d\x42\x67\x44\x89\xe5\xbc\xee\xc0\x19\xf0\x8d\xf0\xc7\x09\x52\x16\xba\x04\x67\x46\x2b\x11\x2a\xd5\xa1\x83\xd2\xe8\xb7\x1e\x34\x62\x09\xde\xc0\x0e\xa1\x32\xa3\x2e\x61\x37\x81\xb7\x62\x8f\xd6\x29\x5d\x93\x22\x19\xf8\xd5\x98\x0e\xa1\xc4\x01\x75\x89\x5a\x4e\x50\x5b\x31\x34\x80\x42\x36\xe0\x55\x8f\x9c\xf9\x69\xc0\xd9\x5e\x74\xd0\x79\x3b\x4a\x0f\x0f\x2c\x3b\xb9\xee\xa0\x31\x5d\xe9\x40\x74\xdd\x53\x8e\x17\xd0\xe2\x84\xc1\x87\x84\xfb\x20\x7c\xc3\x59\x76\x54\xef\xc5\x70\xe3\xbc\x55\xba\xbe\x7d\x37\x4f\xf2\xeb\xf8\x11\x0c\xc5\xd8\x9e\xb4\xe2\x26\xed\xc5\x3d\x78\x8b\x2b\x4b\xa4\x01\x5a\x50\x10\x19\x7d\xaf\x8c\x44\x94\xcb\xcf\xaa\x43\x76\x60\x31\xca\xc5\xdc\x22\x48\x52\x85\x77\xc2\x79\x1e\x84\xb3\xa1\xad\x01\xce\x7d\x3c\x30\xb6\x17\x96\xf8\x14\xb6\xf9\xae\x25\x02\x71\x85\xd3\x57\x9a\x04\x80\x93\x6a\x00\x33\x11\x20\xac\xa6\x9d\x1c\x58\xf4\xa3\xd5\x0e\x84\x8e\x64\x00\x53\x01\x59\xa5\x4c\x53\xe8\xca\xbb\x53\xca\x14\x3a\x4e\xd9\xfc\xd9\xa4\x00\x40\x39\xd8\x8d\xaa\xf3\x60\x34\x54\xca\x3a\x0f\xa3\xc3\x0f\x20\x1c\x18\xdd\x4d\x60\x34\x82\x35\xe6\x48\x22\x52\x56\x0e\x70\x8f\x36\x01\x5a\x40\x27\x3c\x5a\x90\xa2\xeb\x66\x6f\x42\x56\x9d\xe8\x93\x11\xce\xaa\x51\xcb\xb5\xdf\x39\xf9\x78\x06\xcc\x66\x1d\x30\x3c\x2c\x00\xe2\x1f\x4d\x4e\xfb\xe4\x1b\x9a\x4e\x18\x5d\xc1\x9b\xa5\x02\x2d\x1c\x69\x72\x09\xbd\x68\x31\x7f\x89\x2c\x9b\x82\x14\x28\x69\xee\x92\xe0\x7e\xac\xb0\x48\x72\x10\x3d\xb0\xd3\xf6\xfc\xbf\xca\x29\x9f\xdf\x9c\xef\xfa\x30\xb4\xf5\xa1\x80\xe0\xec\x33\x61\xee\x8c\xe9\x42\x18\x31\x0e\x3e\x4b\xdc\x0c\x6d\xcd\xaf\xdb\xfa\x5a\xf8\xe6\x16\xae\x28\x93\x24\x54\x19\x0b\xff\x2b\xa0\x82\xcb\x2b\xb0\x42\xd7\x48\x0b\xfc\x47\xa4\x72\xd8\x26\x53\x15\x78\xd3\x12\xeb\x48\x88\x96\x3f\x3b\x8c\x34\xcc\x2b\x7e\x6d\x5c\xbe\xd9\x7c\x38\x8a\xfc\x75\x05\x5a\x25\x0f\x12\x96\x3c\xc0\x70\x93\x24\xf8\xbf\x45\x8f\xf9\x86\x7c\x58\x80\x90\xe4\x43\x85\x5c\x42\x55\xa4\xe1\xd0\xd6\x97\x40\x2e\xa5\x89\x03\x3b\xfe\x86\x9f\x99\x15\x76\x44\x02\xb1\x20\xdb\x1b\x96\x1d\x36\x6c\x5e\x0a\x36\x16\x65\x31\x58\x53\xff\xa4\x86\x0a\x04\x42\x1a\xfc\x53\xc8\x06\x1f\x34\xfe\xfa\x6a\x4c\x3b\x0e\x61\x98\x5f\x90\x28\x50\x49\xba\x8b\xcd\x81\x65\x3d\xfa\xc6\x94\x1f\x8d\x74\x70\x05\xa5\x91\x4f\x2b\x45\x29\x5a\x8f\x5a\x25\xca\xce\x11\x03\xc8\x1e\x0d\x9e\x56\xa3\x15\x61\x85\x57\x46\x07\xbd\x58\x90\xdd\x49\x04\x7a\xec\x8d\xfa\x7f\xea\xc8\x16\xdd\xd8\x79\x47\x25\x19\x65\x1c\x35\xb5\x16\xa7\x50\x84\x5f\x3c\x55\x9f\x13\x15\x35\x5d\x0b\xd2\x68\x39\x5a\x8b\x3a\x94\x60\xea\xa5\xcb\xad\x57\xad\x94\x5a\x55\xe8\x57\xd1\x92\x0c\xc6\x95\x0e\x83\x7f\x38\x2f\xc8\xea\xe8\x87\xd1\x73\x96\x91\x18\xf5\x28\x6a\xe5\x2c\xeb\xc7\xd8\x6a\xbe\x8d\x1e\xef\x59\xd6\x13\xeb\x87\x1b\xa5\x3d\xda\x4a\x48\x7c\x38\xdc\x2e\xbe\x83\xad\x86\x7a\x08\xf5\x93\x5e\x39\x87\x0e\xa4\x19\xb5\x4f\x3d\x95\xdc\x73\x45\x08\x20\x9a\xe5\x2c\x23\xf9\x62\x16\x56\xda\x53\x5e\xa9\x18\xe0\x11\x9c\x0b\xbf\x36\xf0\x6e\x19\xea\xc3\x91\x18\x6f\x16\xd3\xc4\x3e\xd2\xb9\x0c\x81\x13\xd7\xfa\x75\xd5\x3e\x13\x04\x95\xee\x81\x9c\xd8\x6e\xa1\x46\x7f\x6c\x99\x47\xe0\xca\x94\xa9\x10\x46\x8b\x53\x11\xba\x59\x3a\xf9\xa2\x03\x74\x3a\x4a\xd3\x0f\xa3\x47\x50\x1e\xa8\xda\x1a\xb4\x48\x19\xd4\x46\x63\x6a\x71\xb9\x5c\xc5\xb1\x21\x73\x79\x8b\x13\x2c\xbc\x29\x12\x6a\x90\x9a\xd9\xd2\xd1\xe5\x80\x12\x2d\x79\x3f\xf2\xaf\x46\xb6\xf9\x86\x65\xfb\x02\x4c\x4b\xc5\x2d\x79\x7f\xd3\xe2\x74\xcb\xa8\xe8\x4d\x4b\x82\x99\xe4\x84\xfa\xfb\xf7\x2c\x3b\x00\x76\x0e\xd3\x64\x4c\x42\x98\x4e\xbb\xfd\x47\x77\x69\x3f\x55\xc1\x5f\x49\x7b\x0f\x57\xc9\x2b\x32\xb4\x36\x9b\xcd\xe6\xe0\x0a\xf6\x2c\x7b\xbc\xcb\xe1\x98\xaa\x7d\x82\x38\xd0\x60\x05\xf2\xe8\xe8\x22\x55\x22\x15\x82\x7c\x0e\xaa\xa0\x96\x6f\x20\xde\x87\x78\x00\xf0\x07\xcd\x9d\x01\x51\x62\x45\x07\xce\xda\x8f\xe4\xc4\x99\xf6\x03\x35\xb2\x4b\x90\x3c\x70\x06\xfe\xa5\xbc\xa3\x11\xa1\x55\xc0\xb7\x00\x0f\x8d\x23\x50\x33\x4b\x56\x1d\xe7\x54\xd3\xf3\x74\xa2\x8b\x9b\x2f\x3c\x2b\xe9\x53\x99\x2e\xe3\x3b\xde\x1b\x66\xe1\x4f\xda\xdb\x69\x51\xd3\x9e\x4e\x7b\x92\x70\x9c\x96\xc9\x6b\x96\xa1\xb5\x80\xd6\x1a\xfb\x6a\xf6\xfa\x3f\xe1\xee\x2a\x90\x48\x5e\xaa\x96\x70\x9d\x0d\x5e\x3d\xe6\x6e\xfe\xc8\xe3\x22\x7a\xbb\x79\x7e\x85\x70\xc1\x48\xe2\x05\x3a\x3c\x98\x2a\x9e\x28\x09\x92\xcf\xe8\x50\x78\x84\x1c\xcb\x32\xe4\xbe\x00\xe4\x84\xd1\x8a\xbd\x89\x09\x48\x07\x0c\xcf\x57\x5a\x27\x9e\x9c\x94\x13\xb4\xf3\x51\x71\xca\x76\x69\x24\xc1\xd6\xa3\xf6\xa1\x6f\x53\xbb\x30\xbb\x3b\x94\xa1\xf7\x4d\x74\x78\x8c\xb4\x98\x58\x70\xdc\xe0\x37\x04\x28\x8d\x7c\x9c\x7b\xb2\x94\xfa\xf3\x6b\x53\xbe\x70\x2e\xe4\xdd\xec\xee\xfe\x24\xf3\xb3\xf3\x31\xe9\x66\x77\x17\x4f\x54\xfe\x3d\xc4\x7b\x96\xf6\xe8\xec\x22\xdb\xeb\x89\x17\x92\x1c\xfc\x7c\x31\xcd\x33\x3e\x21\xc3\xa5\x91\xaf\xc8\xf1\xac\xb2\x4c\xef\x49\x73\x4e\xf0\x7c\xaa\x9f\x32\x4c\x19\xf5\x66\x80\x0e\xf7\xd8\xc1\xf2\x70\x3f\x3e\x83\xa8\x1b\x54\x4a\x97\x1f\x51\x76\xe9\xf6\x56\xcc\x64\x18\x8c\x53\x24\x4d\xa0\x4e\xf0\x8b\x8e\x03\x72\x11\x4b\x20\xa8\x8c\x0d\xa7\xfc\x27\x7a\x1d\x2d\xb3\x45\x22\xe3\x50\x84\x03\x35\x3c\x9d\x4c\x38\x4b\x40\x36\x28\x5b\x97\xfa\xa4\x06\x41\xca\xf1\x9a\xf2\xd6\xa5\x37\x5b\x11\x5c\x71\x20\x96\xbe\xc6\x9d\x7a\xa1\x27\x10\x36\xbd\xdf\x82\xae\xb1\x48\x0f\x40\x0d\x46\x93\xaa\x70\xe4\x53\x30\x15\xb7\x75\xd4\x8e\xb1\xdf\x61\x49\xaf\xaf\x90\x6f\xd2\x73\x8d\xb0\xf1\xf5\x15\xf6\xac\x84\x14\x25\xce\xad\xee\x04\xe2\xef\x58\x8e\xb2\x3b\xa3\x39\xca\x0e\xe8\x6d\x44\x60\x3e\xfb\x36\x0a\x15\xf0\xaa\x12\x38\x61\x10\x22\x1b\x8c\x7b\xa6\x04\x08\xb5\xdf\xf0\x7f\x8e\x2b\x16\xc0\x60\x1c\x5d\x95\x51\xd3\xc5\xf9\x8c\xfd\x73\x08\xc5\xb9\xf7\x8b\x92\x78\x85\xd4\x0b\x75\x12\x82\x79\xb9\x4e\x66\x84\x63\xa1\x04\x4b\xc8\xe9\x12\xfe\x8a\x82\x99\x75\x57\x15\x73\xb6\x05\x3b\xb0\xbf\x07\x00\x69\x8d\x6a\x12\x08\x11\x00\x00")
+
+func jujugenerateapidocCacheGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocCacheGo,
+		"jujugenerateapidoc/cache.go",
+	)
+}
+
+func jujugenerateapidocCacheGo() (*asset, error) {
+	bytes, err := jujugenerateapidocCacheGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/cache.go", size: 4360, mode: os.FileMode(436), modTime: time.Unix(1792001365, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocCheckpointGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x57\xef\x8f\xd3\x3c\x12\xfe\x9c\xfc\x15\x73\x91\x16\xb5\x90\x37\xb9\xfb\xc2\x87\x7d\xd5\x4f\x87\x56\xc7\xe9\x16\x21\x38\x8e\x0f\x08\x1d\xde\x78\x92\x9a\x26\xb6\x35\x76\xba\x54\x4b\xff\xf7\x57\x63\x3b\x6d\xd3\x16\x04\x2b\xd1\x36\xb6\x67\x3c\x3f\x9e\xe7\x99\x60\x45\xb3\x11\x1d\xc2\x20\x94\xce\x73\x35\x58\x43\x1e\x16\x79\x56\xa0\x6e\x8c\x54\xba\xab\xbf\x3a\xa3\x8b\x3c\x2b\xda\x5e\x74\xe1\x7b\xf0\xfc\xa5\x4c\xad\xcc\xe8\x55\xcf\x0f\xc6\xf1\xa7\x15\x7e\x5d\xb7\xaa\x47\xfe\x51\xe4\x79\x56\x74\xca\xaf\xc7\x87\xaa\x31\x43\xfd\x75\xfc\x3a\xc6\x0f\x61\x95\x43\xda\x22\xd5\xad\x68\x84\xc4\x1f\x9e\x14\x56\x49\xd3\xd4\xf1\xab\x98\x1f\x22\xd3\x59\xb4\x16\x79\xb7\x31\x83\x15\x3e\x04\xea\x77\x16\x43\x2c\x9d\xe9\x85\xee\x2a\x43\x5d\xfd\xad\xf6\xc6\xf4\xae\xee\x4c\x9d\xb2\x4d\x27\xec\xa6\xab\x94\xae\x91\xa8\x33\xd5\xf6\x1f\x45\xbe\xcc\xf3\xad\x20\x68\xd6\xd8\x6c\xac\x51\xda\xbf\x52\x04\x2b\xe0\xcc\xab\xf7\x9e\x94\xee\x16\xc5\x71\xf3\x0f\xa9\xa8\x28\xa1\xe0\x7f\x4e\x6c\x11\xfc\x1a\x81\xd0\x8d\xbd\x87\xd6\x10\xa0\x68\xd6\x10\x53\x04\xa5\xc3\xae\x16\x03\x4a\x90\x8a\xb0\xf1\x86\x76\x25\x08\x2d\x81\x70\x74\x08\x42\xef\x92\xb1\x03\xd1\x13\x0a\xb9\x03\xf6\x2a\xd9\x90\xb0\x58\xe6\x79\x5d\x27\x77\xff\x3c\xc4\x00\x6b\xd3\x4b\xc7\x47\xd2\xe1\x74\xbf\x69\xc1\x92\x69\xd0\x39\xa5\x3b\x36\x14\xc0\xbf\x7a\x4c\x1e\xaa\x9c\x2b\x75\xe9\xce\x79\x1a\x1b\x0f\x4f\x79\x76\x17\xb6\x20\xfd\xc5\x1e\x54\x71\xf1\xb5\x6e\x4d\x9e\xdd\x29\xe4\xab\xd3\xdf\xa7\xcf\xd3\x11\x5e\xe6\x13\xbc\xfc\x85\x7b\x72\x5b\x94\x66\x50\x1e\x07\xeb\x77\xc5\x97\x3c\xfb\x28\x48\x2b\xdd\xb9\x33\xc3\xb4\x0c\xf0\x43\xc3\x3b\x11\xaa\xf6\x56\x68\xd5\xb8\xa3\xe1\xe9\xf2\x55\xc3\x7d\x28\xdd\xb1\x71\x28\x53\x72\xca\x41\xaf\x36\x38\x95\x2a\xad\xe2\xb7\x06\xad\x07\xbf\x16\x1e\x1e\xd7\xa8\xd9\x58\x9c\x80\xe2\xd8\x3f\x58\x0b\x07\x0f\x88\x1a\x9c\xc5\x46\xb5\x0a\x65\x09\x02\x2c\xe1\x56\x99\xd1\xf5\x3b\x36\x9d\xf5\x85\x71\xc1\xcd\x9a\x60\xe1\x60\x74\x28\x41\xb5\xdc\x42\x0a\x0b\x46\x63\xc0\x45\xea\xda\xd8\x70\x68\xed\xd8\x4f\x2e\x94\x4b\x2e\x0d\x9b\x3c\x2a\x87\x55\x5e\xd7\x7c\xfa\x8d\xf1\x0c\x42\xe1\x2f\xf1\x40\xd8\x22\x39\xf0\x06\xb8\xef\x8e\xf1\xa8\x74\x6b\x4a\x70\x86\x2d\x55\x70\x6b\x74\xbf\x83\xad\xe8\x95\x3c\xc4\xe9\xc4\x80\xc0\x84\x04\x67\x46\x6a\x92\x7b\xe5\xe1\x51\x38\x36\xec\x50\x23\x09\x8f\x12\x5a\x32\x43\x95\xb7\xa3\x6e\xae\x54\x7a\x61\x37\x1d\x3c\x9f\xd8\x57\xbd\x8d\x3f\xca\x10\x03\x3c\x3f\x10\xb7\x62\xdc\x94\x20\x53\x79\xaa\x57\xe8\x85\xea\xdd\x32\x3d\xbf\x8b\x05\x78\xca\x33\xd5\xc2\xf3\xe3\x2d\x81\xa5\x2b\x28\x0a\xc6\x6d\x46\xe8\x47\xd2\xf3\x9e\xf2\xfd\x65\xca\x58\x2e\xf3\x6c\x9f\x67\xac\x51\x70\xbb\x82\x49\xaf\xaa\x7f\x1b\xa5\x17\x73\xaf\x25\xb4\x83\xaf\xde\x5b\x52\xda\xb7\x8b\xe2\xc6\xfd\xb1\xbd\x91\x15\x87\x5b\x94\x20\xab\x37\x62\x40\xfe\xfe\x1f\x92\x53\x46\x2f\x97\x79\x46\x25\x98\x0d\xbb\xed\x8d\x90\x47\x5e\x2d\xf8\x86\x78\xb5\xf3\xc2\xe3\xfd\x58\xfd\xc7\x34\x9b\xc5\x32\xa4\x62\x36\x21\xf0\xe3\xd5\xff\x52\xde\xbd\x78\x91\x67\x7b\xc0\xde\xe1\xd9\xe6\xbd\x72\x0e\xe3\xf6\xd1\xdb\x07\xdd\x5f\xf8\x4b\x85\xa0\x90\x2e\xeb\xd8\x4f\x4b\xa2\x5a\xa0\x0a\x89\xe0\x6f\x2b\xd0\xaa\xbf\xf4\xa0\x5a\xe0\xed\xdb\x55\x80\xdf\x65\x6e\x25\xd0\xf2\x4f\x38\xf7\x10\x5c\xae\x78\xb9\x33\x15\x03\xb4\x5d\x20\x51\x09\x45\x23\xb4\x36\x3e\xf8\x3a\xe5\x16\x03\x2f\x91\xe3\xc6\x2d\x6e\xe4\xf2\x6a\xa5\x63\x4a\x53\x74\x91\xdf\xf3\x8a\x03\x2b\xa8\x3b\x97\x64\x99\x58\x11\xe5\x98\xad\x3a\xb5\x45\x1d\x40\x50\x32\xc1\xbc\x61\x95\x9f\x28\x1a\x38\x6e\x83\xae\x84\x42\x07\xf4\xb3\x55\xaa\x24\x1f\x65\x2e\x98\xb1\x97\xb0\xe6\x4c\x2c\x19\x39\x36\x28\x2b\x78\xed\x81\x90\xa7\xa9\x63\x11\x61\xaa\xb2\xa1\x48\xfc\x4a\x31\x3d\x0a\x07\xad\x19\xb5\x4c\xcc\xb9\x82\x1a\x70\x61\xf0\x5c\x63\xc5\xe2\x94\x16\x25\x3c\x18\xd3\x2f\xb9\x6f\x52\x78\x51\x4e\xcd\x8a\x43\xba\x7a\x87\x42\xde\xa9\x1e\x83\xcf\xe5\xa1\x9b\x97\xcd\x3e\xf5\xf9\xb4\x2f\xa1\x15\xbd\xc3\x50\xef\x30\x1a\xd3\xfe\x31\xc8\x53\x60\x30\x33\xaa\x0f\x7a\x10\xe4\xd6\xa2\x5f\xc4\x38\x9e\x35\x73\x58\x7c\xff\x0e\xd3\x20\x09\x7d\xe5\xf5\xc8\xa5\xd9\x56\x6a\x75\xdc\x9d\x1e\x18\x52\x75\x0d\xff\x25\x14\x1e\x04\x34\x86\x68\xb4\xfe\x04\x3f\x25\x58\xa4\xb5\xb0\x0e\x1e\x49\x79\x8f\x1a\x1e\x76\x20\xa2\x15\x8d\x3a\x89\xba\x60\x01\xf4\x18\x8c\x83\x64\x3b\x56\x60\xee\x24\x12\x82\x78\x70\xa8\x7d\xf5\x2b\x25\x39\x27\xb3\x64\x99\x85\xab\xa4\x94\xd3\x9c\x3a\xad\xb9\xe8\xfb\x98\xae\xfb\x14\x2f\x79\xfd\xea\xe9\x02\xed\xfb\xcf\xb0\x02\x4f\x63\xbc\x92\x09\xf2\xff\x12\x2c\xd7\x9b\x84\xee\x10\xe6\x13\xd0\x85\x66\x06\xd0\x6e\x50\xfe\x9a\xdb\xac\x9d\x39\x58\x81\xb0\x16\xb5\x5c\xcc\x96\x4b\xb0\x33\xde\xcd\xaa\x12\x7d\x08\x89\xb7\x00\x87\x1e\x96\xbc\xca\xaf\x03\x2e\xad\x86\xdf\xbc\xfa\x98\x5e\x01\x6e\xe1\x30\xf7\x5d\x99\x67\xfb\x32\xe6\x19\x09\x3d\x97\x99\xd0\x50\x9c\x31\x9a\xe6\xaf\x39\x20\xc3\x7c\xbb\xe4\xb5\xe8\x8d\xee\xe0\x51\xf9\xf5\x25\xb1\x1d\x10\x36\x86\x24\x4f\x30\x43\xa0\x7c\x62\xe2\x15\x8d\xfb\x21\x13\x4b\x98\x34\x2b\x56\x63\xc9\x70\x37\xc4\x8d\x68\xb8\x4d\xe7\x94\xe1\x06\xdd\x1d\xaa\x45\x55\x7b\xa8\xd6\xdd\xa1\x5a\x54\xb5\x87\x6a\x4d\x15\xba\x05\xaa\xa6\xca\x95\x57\xf1\x77\x09\x8e\x59\x0b\x39\x22\xa6\xab\x4d\x0d\x82\xd5\x81\x7a\xcf\x9e\x81\x9d\x90\x11\x97\xa7\x07\xb6\xc9\xce\x31\x76\x80\xc8\xd9\x46\x04\x49\xb6\x9f\x45\x77\xe4\xc1\x4c\x99\x82\x5a\xdc\x27\xad\x68\x7e\xa6\x49\x71\x7a\xdc\x0b\xb7\xe1\xe1\x11\x51\x58\xd7\xf0\x91\x11\xc1\x3d\x17\xc0\xef\x7a\x86\x04\xed\x82\x94\x43\xab\xc8\x79\x70\x8c\x06\x16\x0a\x7d\x4a\xf7\x60\x1a\xc0\x04\x69\x06\xf5\xc8\xd2\x2d\x18\x7b\xba\x09\x2f\x33\x27\x03\xe9\x01\xd7\x8a\xf5\x39\xf3\x43\xe0\x1c\xeb\x27\xbc\x80\xa2\xf2\x83\x2d\x4e\xc5\x2f\x09\x6d\x08\x2a\x28\xad\x1f\x6c\x09\x31\xe3\xbf\xbf\x7c\xf9\x72\xf9\xe7\x6f\x64\x77\x74\x6b\x5c\xf5\x0e\xf9\xff\x0d\xd1\x1f\x5f\xff\x5b\x9e\xd2\x96\x56\x7d\xbe\xcf\xff\x1a\x00\x48\xbc\x9e\x85\xee\x0d\x00\x00")
+
+func jujugenerateapidocCheckpointGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocCheckpointGo,
+		"jujugenerateapidoc/checkpoint.go",
+	)
+}
+
+func jujugenerateapidocCheckpointGo() (*asset, error) {
+	bytes, err := jujugenerateapidocCheckpointGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/checkpoint.go", size: 3566, mode: os.FileMode(436), modTime: time.Unix(1792001239, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocCompletenessGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x97\xdf\x6b\xe4\x36\x10\xc7\x9f\xed\xbf\x62\x6a\x38\x6a\xf7\x8c\xb7\xf7\x9a\x23\x07\x07\xd7\x42\x0a\xbd\x2b\x5c\xe9\x4b\x08\x45\x6b\x8d\xec\x49\x6c\xc9\x48\xf2\xe6\x96\x90\xff\xbd\x8c\x2c\xff\x48\xb2\x29\x4d\xa0\xed\x4b\x76\xb1\xa5\xef\xcc\x7c\x67\xf4\x59\x65\x10\xf5\x8d\x68\x10\x7a\x41\x3a\x4d\xa9\x1f\x8c\xf5\x90\xa7\x49\xa6\x7a\x9f\xa5\x49\xe6\x8c\x9d\x3e\xbd\x25\xdd\xb8\x2c\x4d\x93\xac\x21\xdf\x8e\xfb\xaa\x36\xfd\xee\x7a\xbc\x1e\xa7\x3f\x62\x20\x87\xf6\x80\x76\xa7\x44\x2d\x24\x66\xcf\x2d\xb4\x43\xbd\xb3\x43\x6d\x51\x75\x58\xfb\x67\x05\xc5\x40\xd2\xd4\xbb\xe9\x23\x88\x99\xe1\xa6\xa9\x48\xef\xd0\xda\xc6\x54\x87\x77\x59\x5a\xa4\xe9\x6e\x07\x53\xbc\x8b\x4f\x40\x12\xb5\x27\x45\xe8\x40\xc0\x01\xad\x23\xa3\xc1\x28\x10\x71\x49\x95\xfa\xe3\x80\xeb\x7a\xe7\xed\x58\x7b\xb8\x4b\x13\x2d\x7a\x04\x00\x98\xaa\x4c\x93\x79\x33\x69\x9f\xde\xa7\xa9\x1a\x75\x0d\x39\xc9\x65\x6b\x01\x5f\xc3\xca\xbc\x88\x5b\x58\xc4\xa2\x1f\xad\x06\xd5\xfb\xea\xeb\x60\x49\x7b\x95\x67\x6f\x5c\xfe\x46\x16\x59\x09\x24\x2b\x0e\x12\xbe\x44\xf5\x82\xa5\x77\x3b\xa8\x5b\xac\x6f\x7e\x45\xdf\x1a\xe9\x60\x12\x71\x20\x34\xa0\xb5\xc6\x02\x29\xf0\x2d\x42\x1f\xdf\x4b\x53\x8f\x3d\x6a\x8f\x12\x48\x83\xe2\xfa\x85\x45\xfd\xbd\x07\xfc\x26\x6a\xdf\x1d\xc1\xb7\xc6\x21\xf8\x56\x78\x58\x6d\x06\x8b\xdc\x59\x07\xca\xd8\xa0\x37\x55\x02\xb7\xe4\x5b\xd6\xe0\x47\x0d\x1d\x50\x83\x44\x2f\xa8\x73\x25\xa0\xa8\xdb\x6d\x38\xa3\x6b\x2c\xc1\x99\x49\x5a\x40\x23\x06\x4e\xc1\xb7\xc8\x02\xf8\xcd\x5b\x51\xfb\x60\x1a\x57\x51\x1b\x2b\x51\x82\x70\x8b\xfb\xb1\x1e\x2b\x7c\x8b\x9c\x83\xd0\x30\x8c\xfb\x8e\x5c\x8b\x92\x15\x84\x9b\x6b\x9d\x73\x43\x8b\x50\x9b\x7e\xe8\xd0\x63\x35\x35\x61\xeb\x55\x3e\xf7\xa3\xfa\x34\xe7\xac\xe0\x87\x69\x60\xaa\x9f\x83\xc6\x85\x56\xa6\x88\x81\xef\xd2\xe4\x56\x68\x0f\x67\xe7\xd0\x8b\x1b\xcc\x7b\x31\x5c\x4e\xcd\xbb\xda\x1b\xd3\x15\x69\xc2\xde\xfc\x59\x02\xf7\x89\x57\x59\xa1\x1b\xdc\x78\x58\x7d\xd9\x5f\xff\x7e\x1c\xf0\x8b\xca\x65\xc5\x5f\x8a\x6a\xea\xda\x67\xd1\xa3\xcb\x0b\x9e\x81\x10\xe2\x92\x15\xae\xe0\x1c\xbc\x1d\x31\x4d\xee\xd3\xa4\x36\xe3\xe9\xc8\xa4\xfd\x1a\xb8\x5f\xa3\xaa\x28\xed\x82\x68\xd8\x7e\xd9\x57\x1c\xe8\xea\xed\xdb\x20\x79\x10\x16\x06\x6b\xf6\x1d\xf6\x0e\x2e\xaf\xe6\xc9\x25\x05\x3d\x39\xc7\x23\x79\x76\x0e\xda\xf8\x0b\x9d\x73\x4e\x25\x04\x91\xe2\x3d\x74\xa8\xf3\xb8\xa4\x80\x0f\xf0\x63\x88\xb0\x28\x9d\x83\x18\x06\xd4\x32\x9f\x9f\x94\x0f\x07\x7a\x9e\xc3\x39\x88\xb2\xa6\x0f\x03\x65\x46\x3f\x8c\xfe\x0c\xde\xb8\xac\x8c\x87\xc2\x55\xbf\x18\x5a\x82\x95\x90\x95\x90\x15\x45\xb1\xa4\x1f\x66\xa6\xe4\xd1\x44\xc1\x03\xbd\x56\xc1\x9d\x60\x13\x4b\xd0\xab\x27\xa1\x80\x90\xad\xbb\x25\x5f\xb7\xe1\x6b\x2d\x1c\xc2\x77\xab\xeb\x67\x69\x92\x24\x41\x78\x2d\x25\xc6\x61\xc1\x62\xde\xa2\xe1\x03\xbc\x0b\x8b\x97\xf0\xcb\xfa\xf9\xc9\xba\xe5\x3e\xe4\x4c\x2a\x98\x17\xe4\x56\xeb\x98\x90\xd5\x84\x03\x17\xdf\xbd\xc6\x50\x6d\x3c\x04\x80\x4a\xd8\x1f\x37\xc7\xe0\x94\xa3\xb1\xa0\xad\x9f\x31\xb7\x39\xf5\x67\xd2\x5b\x5e\xbf\x26\xc3\x0d\x0b\x7a\x63\x03\x62\x74\xa0\xc2\xa9\x14\xe7\x48\x27\xb3\x9c\x03\xad\x59\x46\x76\x4e\x68\xff\x8c\xb7\x2a\xcf\x22\x05\x26\x82\x9e\x01\xe9\x19\x05\x4b\x22\x82\x71\x13\x83\xab\x70\x38\x4a\x50\xd5\x1f\x13\x5d\x1f\xe5\xb3\xd6\x96\xbd\xe7\x7c\x42\x43\x63\x54\x4d\xdd\x16\xc5\x13\x3a\x4e\xa0\x78\xd4\x1d\x3a\x07\x78\x40\x7b\x8c\xdd\x61\x02\x4a\x07\xad\x70\xb0\x47\xd4\xcc\x31\xa4\x00\xb8\x8d\x5b\x33\x99\xd9\x2b\x30\x76\xa5\x23\x05\xc8\xbb\x12\x84\x96\x7c\x56\x5b\xfe\xf1\x61\x89\xce\xe1\xa2\xb9\xf1\xbd\x82\x8f\xa0\x04\x75\xa3\x45\x68\x99\x8e\xc4\x78\xdd\x8f\xcd\x06\xc4\x0d\x6a\xb4\xc2\x1b\x1b\x58\x4d\x3e\x6c\x70\x61\xa0\xec\xa8\xb7\x18\x8d\x75\xe6\x92\xf9\xf1\x98\xa4\x9b\xf4\x2f\xaf\x9e\x30\xb5\x0c\x79\x3f\x7e\xf3\x13\xbb\xf4\x62\xdc\xca\xf5\x84\x4b\xb7\x32\x74\xfe\xa9\xbd\x93\xb1\xb3\x72\xee\xec\x7d\x1c\xe7\xbc\x78\x05\x63\xd5\x26\xda\x5a\xe2\x0a\xd9\x25\xec\x93\x81\xda\x84\x8d\x08\x8e\x92\x9b\x1f\x8b\xe0\xca\x09\x31\x8c\x0e\x95\x80\xcf\xcb\xfd\x4f\x44\x57\x71\xdc\xf5\xd3\xc1\xd5\xdb\x61\x15\x2e\x8c\x12\xca\x53\xc7\x3d\x86\x7f\x29\xe3\x49\xbe\x88\xf0\x24\xff\x8e\xef\x24\x5f\x48\x77\x92\xff\x32\xdb\x17\x6b\x8d\x07\x8b\x0d\x39\x8f\x16\x65\xb8\x74\x85\x03\xf9\xf1\xb7\x8b\x89\xf9\xf6\x94\xa7\xb1\xac\x13\xfc\x9c\x4b\x78\x26\xd1\xe5\xf5\x6b\x72\xbd\xb5\xe4\x3d\xea\xff\x04\xf1\xd3\x9d\x01\xa4\x89\x1e\xf5\x82\x9b\xce\xce\x6c\xdc\x8a\x89\x9d\xca\xe0\x9f\x41\x3d\x5c\x82\x16\x9a\xb3\x3a\xbb\x85\x12\x6e\xf0\xe8\xf8\xff\x03\x9e\xad\x78\xab\x9d\xae\xd2\xa4\xa7\x51\x8c\xb4\x5c\xcf\x1c\x3c\xa2\x58\x3c\x83\xdb\xc7\x4c\x9b\x65\xca\xb9\xf0\x83\xb0\xcb\x01\x7e\x38\xfd\x0f\x2f\x9a\x41\x9f\x7b\x49\x6a\x52\x9d\xaf\x91\xe7\xd1\xc0\x64\x56\x59\x5a\x19\x1f\x3c\xbe\xa7\x3c\x18\x86\xb8\xa6\x58\x8c\xe9\xc9\x39\xd2\x4d\x7a\x9f\xfe\x35\x00\xc5\xdb\x98\x67\xfc\x0d\x00\x00")
+
+func jujugenerateapidocCompletenessGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocCompletenessGo,
+		"jujugenerateapidoc/completeness.go",
+	)
+}
+
+func jujugenerateapidocCompletenessGo() (*asset, error) {
+	bytes, err := jujugenerateapidocCompletenessGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/completeness.go", size: 3580, mode: os.FileMode(436), modTime: time.Unix(1791998241, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocConstraintsGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x7b\x5f\x73\x1b\x37\x92\xf8\x33\xf9\x29\xe0\xf9\xfd\xe2\xcc\xd8\xe3\x91\xbd\x75\x2f\xa7\xac\xb6\x2a\x76\xe2\x3b\xdd\x39\xb6\x56\x72\x9c\xec\xa9\x78\x1b\x68\x06\x43\xc2\x1a\x02\xb3\x00\x86\x92\x62\xeb\xbb\x5f\x75\xa3\x81\xc1\x90\x14\xed\xbd\xab\xf8\xc1\x22\x81\x46\xa3\xd1\xe8\x7f\xe8\x6e\xf6\xbc\xbe\xe6\x4b\xc1\xd6\x5c\xaa\xf9\x5c\xae\x7b\x6d\x1c\xcb\xe7\xb3\xac\x5d\xbb\x6c\x3e\xcb\x96\xfa\x88\xdb\xf0\xa9\xd6\xca\x3a\xae\xc2\x57\xa7\xaf\x85\x0a\x9f\xef\x7a\x61\xe1\x73\xcf\xdd\x0a\xfe\x1a\xb1\x14\xb7\x3d\x7c\xb2\xda\xe0\x0a\xeb\x4c\xad\xd5\x86\x3e\x4a\xb5\x44\xf8\x41\xc9\x5a\x37\x22\x9b\x03\x1e\xe9\x56\xc3\x55\x55\xeb\xf5\xd1\xc7\xe1\xe3\x80\xff\xf1\x5e\x36\xba\x3e\xf2\x7f\xb2\x29\x90\xd1\xcb\x5e\xf4\xbd\x80\xd9\x5a\xaf\x7b\xee\x8e\x3e\x5a\xad\x22\x2d\x4b\xdd\x71\xb5\xac\xb4\x59\x1e\xdd\x1e\x39\xad\x3b\x7b\xb4\xd4\x47\x74\x64\x9b\xcd\x8b\xf9\xfc\xe8\x88\x79\x4a\x7f\x12\x6e\xa5\x1b\xcb\x56\xba\x6b\x2c\x73\x2b\xc1\x9e\xf8\x89\xea\x1c\xff\xb0\x35\x01\xb8\x15\x77\xb0\xac\xd6\x83\x72\x8c\x5b\x56\xaf\x44\x7d\x2d\xd5\x92\x71\xe6\xcf\xc5\xf8\x92\x4b\x65\x1d\x62\x11\xb7\xbd\x11\xd6\x4a\xad\xaa\xf9\x86\x9b\xad\xdd\x4e\xd8\x9a\xf7\x97\x7e\xd9\xe2\x4a\xeb\xee\xd3\x7c\x96\xfd\xc4\x5d\xbd\xba\xc0\xb1\xec\x98\xd1\x3f\x67\x06\x51\xce\x67\xd9\x6b\xa9\x9a\xed\xb9\x3d\x93\xa7\xaa\x11\xb7\xd9\xf1\xfe\xc9\x8b\xe1\x6a\x0d\x7b\x64\xc7\x34\x79\x3f\x47\xda\x14\x5f\x0b\xfb\x41\x18\xa0\xf6\x8c\x3b\x76\x42\xd4\x56\x3f\x0d\xd6\xbd\xd2\xeb\x5e\x76\x22\xff\xed\xbf\x37\x97\xcf\x9f\xfd\xeb\xe2\xe9\xff\xff\xcd\xf3\xaf\x95\xa2\x6b\x5e\x81\x68\x18\x2e\x95\xb3\xcc\x08\x37\x18\xe5\x99\x58\x27\xe3\xc0\x39\x1c\x5c\xca\x8d\x50\xc4\x50\xc0\xa0\x5b\x1c\x6e\x79\xcd\x1b\xc1\x5a\xc6\xfb\xbe\x93\xc2\x32\xa7\x3d\x72\x1b\x20\x6e\xa4\x11\x0c\xaf\x97\x49\xc5\xa4\x6a\x75\x05\xeb\xdf\xa9\xee\xce\x5f\x83\x65\x6b\x40\xd1\x48\x23\x6a\xd7\xdd\x01\x14\xac\xbb\xd2\xcd\x5d\xc0\xe1\xb7\x65\xdc\x08\xd6\xea\x41\x35\xc7\xf3\xa3\x23\x40\xc2\xd8\x33\x56\xf3\xae\xc3\x6d\x01\x10\xb9\xc1\x82\x82\x9c\xda\x0f\xbc\x93\xcd\x13\xd6\x0e\xaa\x76\x52\x2b\x5b\xb2\x9b\x95\xac\x57\x7e\x2d\x63\x46\xfc\x63\x00\xf2\x38\xdb\x00\x20\x13\xca\x49\x77\x87\x58\xbe\xfb\x0a\xfc\x67\xdc\x58\xf1\xe4\x3d\x5f\xfe\xb3\x1b\x38\xbe\x2c\x19\x57\x0d\x33\xa2\xd6\xa6\x41\xd4\xd7\x52\x35\x78\x5e\xbe\x8c\x7b\xe3\x8d\x0b\x1b\x85\x93\xc3\xe5\x0e\x1d\x37\x89\x88\xd2\xcd\x70\xcb\x38\xeb\xa4\x13\x86\x77\x61\x77\x6d\xd8\x4a\x74\x0d\x70\x94\x47\xa2\x37\xdc\x48\x7e\xd5\x09\x26\x95\x74\x92\x77\xf2\x77\xd1\xb0\xab\xbb\x91\xe2\x6d\xe9\x89\xd4\xd8\x1b\xe9\xea\x15\xb3\x8e\x3b\xb1\x16\x20\x1d\x37\xd2\xad\x58\xb0\x30\x41\x93\x6a\x6e\x81\x64\xd5\x30\xce\x1a\xd1\xf2\xa1\x73\x01\x39\x4c\x79\x91\x32\xe2\xa3\xa8\x51\xbe\x04\xb0\x66\x10\xc4\xb8\xc8\x31\xad\x04\xdd\x7e\x58\x8c\x60\x36\x88\x07\xa0\xb2\x15\xc9\xc1\x7b\x61\x1d\x0a\x1c\xf7\xc2\x17\x19\x26\x9d\x65\xbf\x0b\xa3\xfd\x1e\x28\x40\xbc\xb3\x9a\xa4\x88\xc9\xb8\x81\x9f\x97\x48\x90\x22\xea\x44\x53\xc2\x57\x42\x29\x41\x4e\xcd\xb5\x68\xc0\x7e\x10\x95\x0d\xde\x22\x50\x20\xdb\xf1\xb4\x4c\x5a\x66\x87\x2b\xeb\xa4\x1b\x9c\x68\x58\xab\x0d\x93\xee\x21\x5c\xb0\x5a\xf7\x20\x9d\xbc\xab\xe6\x20\x48\x3b\xda\x99\xf7\xd7\x4b\xf6\x84\x2e\xd0\x56\x67\xfe\x43\x89\xca\xc4\x9e\x44\xf3\x59\x9d\xaa\x56\x97\xa8\x8b\x60\x78\xab\xd7\xa8\x9b\x7e\xb0\x77\xec\x89\x07\x7a\x7f\xd7\x8b\xb7\x7c\x2d\x4a\xd2\x2a\xf8\x4c\x57\x57\xb0\xcb\x45\x58\x0b\x24\xc0\x52\xf6\x69\x3e\x6b\x44\xdd\x95\x0c\xfe\x3f\xbb\x5e\x96\x4c\x18\xc3\x8e\x4f\x68\xf9\x0f\xa2\xee\x80\x3e\xd8\x22\x45\x59\xcc\x67\xb2\x45\xd0\x47\x27\x4c\xc9\x8e\x7d\xfe\x8c\x18\xaa\x97\xa0\xd7\x27\x93\xb1\xb3\xeb\x25\x92\x65\x71\x43\x9a\xfb\x34\x9f\xcd\xbc\x55\x82\xaf\xf3\xd9\xfd\x7c\xc6\x61\xdb\xc7\xa3\x79\x02\xeb\x28\x0c\x00\x3a\x60\xc5\xf1\x2e\xb2\x72\x3e\x9b\x49\xfb\x8b\x34\x02\xf0\x1f\xa3\x9e\xe6\xa0\xde\x6c\xe4\x5a\x60\x48\xc1\xc0\x98\xc3\x79\x67\xb3\xa3\x23\xf6\x7e\x95\x02\x01\x32\xbc\xd7\x15\x37\xa0\x2f\xc2\xdd\x08\xa1\xc8\xfc\xa1\xb4\xd3\x32\x69\xd5\xb7\x8e\x59\xde\x82\xa9\x32\xa0\x1c\xf5\x60\x8c\x50\x8e\x0d\x56\x54\x00\x84\xfa\xf3\xd3\x50\xbd\xd1\xf5\x75\x5e\xc0\x48\x23\x5a\x61\x58\x18\xff\x59\x75\x71\x86\x18\x00\xa7\xf3\x87\xba\x04\xe2\x17\xc4\xd3\xf9\x6c\x76\x0f\x27\xec\xb9\x73\xc2\x28\x7b\x1c\x94\xfc\x8c\x06\x72\x62\x48\x51\x7a\xfe\x55\xad\x54\x4d\x1e\xaf\xa1\x98\xcf\x8c\x68\x81\xa9\x74\xeb\xde\xa1\x9e\x8b\x16\x98\xe0\xc5\xe7\x98\xb1\xb6\x02\xf6\xc0\x46\xe4\x67\x8e\x59\x5b\xd1\x47\x18\xf5\xab\x8e\x59\x72\xfb\x7e\x3f\x60\x80\x04\xf4\x86\xab\xa5\x60\xbc\x22\xcf\x00\xd8\x5b\x9c\x78\x1c\xc6\x2e\xe5\x02\x06\xb5\x61\x1f\xc7\x05\xad\xac\x52\x1f\x05\xcb\x66\xd3\xb1\xcb\x8f\x8b\x6a\xf4\xcb\x97\x8b\x9d\x73\x18\xd1\xde\x03\x9b\x40\x0e\x5a\xd6\xca\xea\xcc\x08\x2b\x54\x2d\x80\x85\x59\x16\x71\x86\xe1\x73\xc1\xad\x56\xec\x84\xb5\x6b\x57\x5d\xf4\x46\x2a\xd7\xe6\xd9\x37\x36\xff\xa6\x29\xaa\x6f\x2c\xfb\xc6\x66\x65\x60\x48\xc2\x85\xf4\xe8\xe9\x2e\x1e\x1d\x5c\xe5\x3d\x72\x84\xee\x33\x9c\x7a\x7e\x8f\xde\x78\x5b\xa2\x19\x5c\x13\xf9\xe2\xc4\x43\x6a\x35\x9a\x10\xb4\x76\xe0\x5a\x61\x7d\xf4\xae\x3c\x3a\x22\x74\x9f\x15\xbb\x10\x04\x9f\xf0\xac\x9a\x03\xfc\xee\xa6\xd6\x99\xa1\x76\xc0\x11\xd4\x26\x30\xb9\x2c\x18\x0d\x10\xff\x79\xa2\x49\xb8\x4f\xfe\x90\x0e\xcd\xe7\xa0\x40\x41\x2a\x21\x5c\xb2\xac\x35\x7a\xcd\x04\xaf\x57\x3b\x8e\x08\x81\x21\x84\x03\xe7\xc1\x59\xed\xbd\x4e\xb3\xc7\xd5\x21\x24\x79\xe2\x71\xb4\x9a\x47\x05\x80\xad\x2e\x89\xe4\x0f\xdc\x2c\xbc\x59\x9b\xcf\x67\xc4\xb3\x5d\xf3\x06\x37\x00\x67\x61\x39\x67\x4f\xb6\x59\x52\xe0\x45\xe4\xc0\x4a\xf6\x84\x5b\x57\xbd\x04\xcd\xbc\x70\x6b\x57\x00\x9b\x60\xe4\x54\xd9\x5e\xd4\x0e\x61\x4a\x32\x2f\x0c\x26\xde\xea\x26\xb5\x28\xe4\x3b\x15\x08\xb7\xaa\x72\x20\x11\x71\xcc\xc0\x8f\x79\xe4\xaf\x78\xd7\xfd\x78\xdb\x9b\x63\x10\x49\x5e\x61\x6c\x04\x63\xb9\x2a\x26\x70\x17\x88\x0a\xa8\x48\x21\xfd\xe8\x36\xec\x69\xbb\x0d\xf7\x5f\xc2\x68\x70\x97\x79\x90\xca\x60\x64\x21\xaa\x9c\xcf\xee\x8b\x20\x93\x61\x7b\x8a\x51\xc0\xc6\xdd\x25\x42\x43\x01\x1f\x84\x0e\x49\x7c\x08\xc1\x52\x75\x88\xa1\xe3\xa9\x00\x74\x7a\x70\x64\x48\x8b\x2c\xe2\x15\x4c\x0b\x91\xc3\x1f\xef\x4a\x5a\x95\x78\x8d\x56\x55\x67\xd7\xcb\xbc\x48\x86\x3a\xa1\x10\xba\xfa\xde\x2c\x2d\x4e\x3c\x4f\x7c\x08\x6a\x1f\xd8\x4e\xc0\xde\x2a\x54\x60\x30\xbe\x74\x2f\xfd\xf5\xf2\x8c\xbb\x15\x4d\x22\xea\x0a\x06\xf2\xe2\x3b\x40\x82\x0c\x95\x16\x16\x59\x98\x24\xf0\x82\x3d\x7e\xcc\x5a\x85\x96\x39\x2f\xaa\x9c\x24\xef\x42\x2e\x15\x77\x83\x11\x45\x75\x2e\xea\x4d\xa4\xf2\x78\x94\x83\x78\xf3\xf4\xaa\xaa\xfe\x9d\xdb\x33\x23\x5a\x79\x8b\xde\xa9\x64\x19\xc5\xae\x19\xee\x01\x63\x68\xb0\xc2\x28\xdd\x28\x6f\x9a\xf1\xcc\x97\xcf\x17\x65\x30\xe4\xa3\xba\xc3\x4e\xb3\xd9\x7f\x4a\xd5\xc4\xa7\x47\x06\xe8\x32\xb0\xdc\xb3\xd9\x0f\xc2\xd6\x46\x62\xf8\x71\xcc\xb2\x10\xa6\x66\xec\x29\xeb\xf4\x8d\x30\xbf\xc0\xcd\xe7\x81\xc8\xf7\x46\xae\x1f\xa0\xb2\x60\x4f\x59\xc6\x46\xc4\xf7\x51\x0e\x61\x0c\x18\x90\x61\xb4\xfc\x9e\x2f\xff\x8f\xc4\x3b\xbe\x3c\x48\xfb\x18\x62\x6f\x53\xf2\x20\xaf\x91\x32\xcf\xe9\x04\xe6\x62\x68\x13\x18\x20\xbc\x40\xca\x69\x83\xe3\x93\x08\x0c\x7c\x21\xe8\x87\x59\x45\x9b\x04\x54\x7f\x28\x0f\xb6\xee\xcf\x53\xec\xaf\x68\xca\x17\x2f\x43\xef\xf9\x12\x04\x64\x8b\x12\x47\xa3\x41\x21\x4a\x7a\xbd\x14\x64\x38\xf0\x76\x83\xe2\xc0\x05\x53\xfa\x20\x0a\x2c\x8c\xa5\xef\x62\x98\xd8\xd5\xd2\x3f\x01\x53\x65\x1b\xdc\x45\xc9\xf4\x35\xa8\x21\xaf\x3c\x2b\x51\x92\x27\x94\x15\xdf\x01\xc8\xa7\x48\x3d\x05\x3b\x09\xcc\x8b\x45\x19\xd0\x7d\x05\xad\x93\xd7\x3d\xc5\x57\x5f\xa9\xd7\x8f\x46\xbd\x16\x5d\xa0\x1c\xe9\x78\x3d\xa8\x2a\x47\xeb\x76\x21\x3a\x51\x3b\x6d\xd0\xc2\xf9\xa3\x3e\x0a\xf4\x07\xdb\x34\xbb\x7f\x80\x07\x9e\xb6\x70\x42\x2b\xba\xea\xd7\x2f\x1f\xff\xf9\xce\xf1\xef\x53\xa3\xee\x3d\x45\x62\xd6\x99\x50\xc3\x3a\x35\xed\x10\xb7\x71\x46\xa6\x4a\x2b\x08\x31\xc2\xc3\xea\x66\xa5\x2d\x3d\xbe\xe8\x35\xd5\x6d\x3f\x00\xc1\x53\x10\x20\xac\xa4\x27\x20\xae\xd9\x7d\xf6\x7d\xd9\x59\x90\x63\xb3\x6e\xed\xb6\xfd\x1f\x3a\x0c\xd9\x32\x98\xab\xe0\x19\x3e\x7a\x03\x1c\x3a\x55\xd2\x51\xa8\xbc\xed\x0a\x36\xdc\x84\x07\xe5\x65\x08\x13\x66\x81\x3a\xf0\x02\xbc\xb3\xc2\x47\xb0\x7f\x2f\x99\x1d\x63\x52\x44\x0c\xc1\x73\xf5\x46\x5a\x8c\x96\x66\x75\xc7\x07\x2b\x00\xc4\xd2\x9d\xbf\xe2\x56\xbc\xc2\x51\x90\x3f\xd9\x32\x0f\xe2\x97\x10\x91\x24\x00\x7e\xc7\x93\xc0\x99\x0f\x40\x53\x4e\xe0\x14\xa3\xcf\x66\xb5\x56\x4e\x2a\xf0\xcf\x40\x7b\xa0\x4a\x8c\x54\xa5\xf8\x11\xf1\x66\xbf\x1e\x09\x44\x97\x8a\xe0\x28\x83\x88\x7a\x46\x4c\x81\x27\x41\x2f\x54\x93\xfb\xef\x25\xdb\x44\x51\x02\x41\x7d\x14\x08\x27\xc7\xeb\xa1\xf6\x7b\x5d\xc8\x25\x56\x3e\x89\x65\x09\x5d\x31\x27\xdb\x17\x6e\xee\x01\xbb\x37\xb5\x7a\x20\xa6\x68\xf6\xa6\x46\x8f\x32\x05\x60\xf3\x82\xf5\xfd\x0f\x2d\x55\xfe\x8f\x41\x3b\xf1\x7d\xd7\x85\x3d\x4b\x96\x95\x2c\x83\xa7\xd0\x0c\xb9\x6c\x09\x33\x9d\x70\x3b\xf6\x09\x81\x52\x54\x14\x88\x72\x7a\x8a\xeb\x21\xfa\x86\x78\xc8\x2b\x45\x48\x92\x51\x2c\xdf\x48\x30\xc7\x94\xbd\xa0\xc0\x48\xb6\x63\xde\x04\x43\x5c\x6e\x04\xe5\x4f\xa4\xb3\xb0\x70\x4c\x53\x7c\x59\x27\x62\x10\x37\x6a\x85\x8f\xf4\x50\x23\x40\xb6\x03\xa1\x25\x33\xf8\x04\x21\xd6\xc4\x80\x27\x04\x35\x13\xb1\x9b\xca\x36\x7a\xbb\x1d\x3c\xf1\xad\x78\x1e\x53\x20\x59\x10\x06\x38\xee\x78\x8c\x8c\xb6\xe0\xd6\xca\xa5\xb2\xff\x1c\xf6\x77\x94\x11\x29\x59\x36\xa6\x52\x6c\x92\x63\x01\x1d\xd8\xd9\x8f\x26\x8f\xa7\x02\xb8\xab\x2e\x8d\xb4\x1f\xe1\xa1\x44\x44\xbd\xd2\xaa\x41\xce\x81\x68\xdf\x8e\x9a\xf3\x7b\x60\xb3\x98\x18\x5d\x2b\x5c\x78\xdf\xe5\xb7\xe5\x0e\xab\xb7\x6c\x6e\x40\x12\x53\xac\xb7\x90\x76\x12\x6c\xc5\x3d\xc3\x5a\x6d\xd6\xec\x16\x34\x07\x20\x21\x0d\x26\x8c\x3f\x16\x48\x85\xdc\xe6\x2a\x88\x15\x90\x68\x50\xef\x6e\xbd\xca\x1d\x94\x98\xf1\x14\xf8\x30\x41\x3f\xc4\xf2\xf0\xb1\xc4\x37\x0a\x9e\xfe\x4a\x46\xcf\x33\xa8\x9e\x1b\xa1\x72\x51\x90\x45\x7b\x29\x15\x37\x77\xe4\xc3\xc8\x7e\x7c\xfe\xcc\xae\xa4\xaa\xde\xf5\x60\x63\xb1\xb0\x50\xfd\xf8\xd7\x37\x89\xfa\x83\x9d\x2b\x83\x29\xbd\x9f\xcf\x6e\x4b\x7f\x90\xe3\x13\x5c\xf8\x6b\x89\x7f\xfe\x86\xe1\x3d\xaf\xa4\x05\xb1\xce\x6f\x91\x96\x08\x1b\xd8\x72\x1b\x4d\x4f\x84\x84\x89\xe2\xc0\x76\x60\x77\x79\xd7\x6d\x9f\xe9\x36\x9c\x29\xbe\x3b\xf0\x72\xf7\x06\x27\x2f\x82\x54\xc8\x66\x1b\x4d\x70\xf3\x01\xdb\x69\x23\x94\x8b\xa8\x64\x83\x4f\x0c\xb8\x9d\xac\x13\x8a\x12\x0d\xb2\x65\x7f\x1f\xc5\x0b\x9f\xd9\xd5\xcf\x56\xd8\x4b\xd9\x2c\x62\x98\xf1\x72\x90\x9d\x93\x2a\x11\xb9\x70\xbe\xa9\x8b\xf7\x0f\x36\x94\xb5\x69\x76\xe1\x96\xe6\xbc\xcf\xf7\xcc\x62\x46\x40\x99\xc8\x82\x78\xb9\x95\x30\x0c\x93\x9d\xe0\x18\xd1\xd7\x07\x17\x1e\x0d\x91\x1a\xd6\x57\xc2\x80\x98\x89\x75\xef\xee\xc8\x7c\x1c\x94\x33\xba\x95\x54\xca\xc2\xf3\xd7\x25\xee\xc8\x25\xc9\x2c\xb1\x88\xc2\x94\x5c\xe3\xe4\x06\xdd\xa6\x3a\xb5\x6f\x65\x97\x4f\x6e\x9a\x1e\xab\x01\x02\xed\x79\xea\x58\x77\x30\x91\xd9\x0b\xb0\x15\x78\x16\x8f\x12\x6d\x54\x38\x3f\xf9\xa9\xd1\x80\x6c\xcf\x7c\xe0\x5d\x1e\x90\xa0\x84\x64\xd9\x36\x8a\x53\xe5\xca\xf1\xdb\xeb\x4e\x73\xb7\x17\x9f\x5c\xaa\x29\xaa\xe7\xe9\x25\x7a\x26\xf8\x2b\x1c\x43\xf4\xc4\x19\x51\x5d\x26\xf1\x40\x82\x19\x48\x24\x42\xb1\xa2\x04\x1b\x23\xdd\xb7\x36\x8d\xdd\xc0\x67\x8d\xe5\x98\x92\xca\x66\x1c\x9e\x4a\x53\x67\x05\xa5\x88\x83\x57\x9d\xbc\x19\xc6\xeb\x2e\x71\x1d\x49\x0a\xb2\xd6\x95\x7e\xe7\xf1\xf2\x61\xf7\xd7\x30\x94\x8b\x62\xcf\xd5\x23\x03\x7c\x66\x90\x52\x64\x79\xc0\x51\x40\x12\xa7\xa2\x5d\x93\xf8\x24\x19\xf4\x04\x04\x27\x9e\x58\xe9\x07\x5d\xf8\x36\x03\x61\xdd\x2e\x0f\x1f\x60\x60\xc5\xbe\x4f\x16\x93\xb1\x0e\x85\x01\xc8\x96\x78\x4b\x2e\x55\x5a\xc2\xb2\x8e\xdf\x8d\x50\x07\x99\x9c\xba\x99\x94\xcb\xdb\xfe\xe6\x0f\xe4\xf8\x6e\xca\x74\xcb\xf9\x23\xa6\x04\x64\x4f\xf2\x93\x9d\xec\x50\x4c\xce\xf1\xb0\x80\x85\x77\xcd\xe4\xe8\x7e\x2c\x3d\xb1\x8f\x23\xc5\x57\x05\x90\xf4\x28\xda\x13\x43\xc6\x32\x30\x56\xdc\x20\x11\x09\xe1\x64\xf6\x1b\xfc\x4f\xab\xfc\x77\x58\x4a\x94\xf9\xf0\x91\x66\xd3\xf0\x91\x37\xa9\x9e\x92\x65\x40\x92\x58\x1d\xe3\x96\x3d\x7a\x0b\x6b\xbf\x42\xec\xbe\xa0\x98\x13\x86\xd5\xbb\x6c\xf9\x83\x34\x73\xdc\x60\xaa\x9c\xc9\x78\xc9\xea\xc0\x22\x5c\x18\x23\x22\x60\x92\x50\xce\x60\xed\x97\xd0\xdb\xc8\x29\xb4\x49\x71\x51\x09\xb2\x01\x17\x04\x61\xbf\x6c\x99\x12\xb5\xb0\x96\x9b\xbb\x83\xba\x44\x14\xef\xa9\xfa\xd0\x21\xa2\x48\x3d\xd9\xce\x10\xb3\x4f\x87\x0b\x19\xa8\x23\xdb\xb5\x8c\xef\x40\x0f\xc0\xc7\x81\x5d\x77\x90\xf8\x68\xa5\x47\x09\x03\x7e\xc7\x24\x03\xc0\x5a\x19\x9d\x78\x44\x1e\xb9\x18\x46\x4a\xb6\x4d\x1b\xa0\x80\x5d\x8e\x19\x73\x20\x99\xb8\xc3\x31\x31\x0a\x25\x32\x6c\x30\x12\x07\x61\x63\xf8\x52\x3c\x7b\xb1\xa0\x1b\x89\xf7\x3f\xb9\x15\x10\x38\x7c\xce\x43\x5a\x64\x6a\x31\x2d\xe6\x35\x44\x03\x37\x73\x75\xc7\x04\xca\xad\xa0\xe1\x51\x78\xa1\xd8\x84\x25\xb3\xb4\xbe\xcf\x31\x53\xd8\x84\x7d\xc9\x9c\xbe\xd2\x6a\xe3\xeb\x29\xb6\x64\x1c\x0b\x1a\xfe\x56\x72\x6e\x96\x16\x5d\x76\x51\x42\xd6\x01\xd6\x75\x5a\x43\xfd\xd4\xad\x8c\x1e\x96\xab\x83\x97\x9f\x88\x76\x54\x8d\x82\xe5\xfb\x64\xc1\xef\x97\xc4\xc4\x70\xf3\xc0\x65\xc1\xc6\xc8\x0f\x0c\xe9\x2c\x8d\x2c\xc5\x76\x40\x39\xa6\x79\x76\x13\xd3\x8f\x4e\xd8\x0b\xc8\x50\x3c\x9a\x86\x42\x21\x9c\x5c\x54\xa7\x16\x46\x28\xde\x99\x5d\x19\xc1\xaf\xe9\xdd\x0f\x44\xa4\x31\x20\xca\x8b\x15\x3b\x74\x6c\xa5\x9c\x76\x94\x99\x65\xf0\x12\xce\xd2\x58\xd9\xa6\xc1\x99\x5f\x0f\xed\x0c\x97\x56\x74\x3e\x46\xb3\x21\xbc\xfa\xfc\x99\xd9\x10\x3e\x41\xdc\x8f\x1c\x44\xf6\x7e\xe0\x31\x11\x0f\x15\xa3\x46\xdc\xe6\x05\x9d\xf7\xe0\xde\x46\xd4\x1b\xd8\xde\x52\x5a\x0d\xf7\xeb\x9d\x09\xc7\x82\xf9\x18\x1d\x9f\x69\xa9\x9c\xa0\x98\xdd\xa3\xad\x37\xe0\x63\x9c\xa9\x7e\xec\xc4\x3a\x2f\x62\xaa\xbf\xd9\x8b\x00\xc2\xf2\x66\xf2\x88\x41\x59\xac\xde\x5d\x7d\xcc\x8b\x69\x55\xe1\x20\xd5\xa1\x98\xb0\x2b\x46\xf9\x0e\x42\xaa\x25\x80\x0b\xf9\x7f\xe0\x52\x52\x00\x5c\x51\x10\x41\xbc\x1a\x6b\x6c\x88\xa6\x38\x4c\x04\x4d\x00\x64\x09\xaa\x57\x5d\x88\x8e\x8a\x93\xc9\x13\x00\x64\x46\x88\x89\x62\xc7\x3a\xa1\x36\x21\x34\x41\x28\xac\xe7\xc0\x27\x54\xe7\x6b\xa5\x6f\xd4\x41\xdd\x4a\xca\x34\xdb\xc5\x1c\xe2\xf7\x6b\xf0\x56\x9f\x22\xa9\xb8\xa0\x81\xc1\x9c\xc4\xad\xc4\x57\x5a\xf0\x0c\x93\x8c\xe7\x84\xe4\x3d\xad\x2f\xd8\xdd\xe2\x0b\x50\xb0\x76\xab\xfb\xcb\xbf\x97\x45\xea\x50\x61\x27\xe8\x15\xda\xed\x72\x81\xf5\x98\x8e\x19\x1f\x43\x8c\x9b\xe5\x80\x09\x1b\x7c\x23\x1d\x6a\xa0\x21\x23\xa8\xd5\x61\xf7\x3c\x4d\xe7\x8e\x8e\xba\x60\xf9\x8e\xe5\xd9\xb6\x38\x5b\xaf\xd9\x1d\x9b\x93\x28\x04\xd5\x98\x43\x25\x35\xec\x47\xb5\x34\xca\x7e\xca\x86\x12\x47\xf0\x6e\x8d\x0f\x24\x41\xb8\x63\x81\x32\xa9\x25\x02\x20\xbc\x64\x64\xc3\x4e\x98\x48\xa7\x52\x83\x33\x42\x80\x30\xee\xc9\xc9\x6c\x89\xf0\xe6\x8b\x0f\xe2\x0f\xfc\x61\x2b\x36\xe2\x09\x81\x58\xc4\x46\x03\xf6\x72\xb3\x88\xd2\x97\x00\x05\xdd\x98\x32\x69\x22\x71\x89\xa4\x05\x30\xea\x94\x8a\x1a\xf2\x45\xb9\x22\xa1\x8a\x65\xfe\x54\xae\x0e\x6b\xd6\x94\xb0\xbd\x2a\xb6\x2b\x35\xff\xeb\xf2\x69\xab\xa6\xa6\xea\x51\x52\x24\xf1\xd3\x60\x56\x68\x22\x39\x5f\xf6\x90\x8b\x7b\xf0\xa2\x68\xf0\x40\x81\x87\xae\x26\x99\x9f\x5c\x4b\xcc\x83\x89\xe4\x06\x42\xf3\x19\xf1\xf8\x20\x6b\xd3\x8d\x0f\x2b\xe1\x57\x24\x2e\x3e\x7f\xde\xc9\x40\x7c\xfe\xbc\x93\x68\x78\x74\x32\x92\xe6\x33\x09\x5f\x64\xd0\xa1\xcc\xc3\xc4\xbc\x93\x61\x22\x41\xb1\x5f\x30\x9a\x36\x4a\x16\xc8\xa4\x54\x4e\xef\x74\x64\x58\x88\xf4\xa0\xe7\xec\xea\x6e\x8f\x44\x13\x6b\xb7\x76\xdd\xdf\xa3\x56\xec\xef\xc8\x80\xb3\x07\xf5\x04\xe6\xae\xf9\xb5\xc8\xf7\x42\x16\x5f\xd1\xf5\xd5\x6f\x75\x7c\x8d\x29\x5f\x68\x71\x1c\xc3\xf6\x09\x1c\x16\x87\xde\x19\x68\x7c\xa1\x28\x1e\x02\x63\x58\x50\xbd\x59\xa5\x32\xbc\x5d\x7c\x79\x20\xab\x88\x2b\xcf\x57\x76\x7f\x72\x31\x64\xff\x12\xfb\x43\x7a\xba\x47\xcb\x93\x25\x91\x4b\x97\x81\xb2\xcb\xe7\x8b\x05\x84\x3b\x7e\x7c\x6f\xfe\x2f\xac\x21\xf1\x48\x53\xfc\x3b\x69\xc0\x31\xe9\x33\xb6\x75\x96\xcc\x0c\x58\xf4\x83\xe0\x97\x53\xda\x19\x5f\xaa\xbe\xb9\xed\x86\x2b\x87\xad\x8f\x7d\x2f\xb8\xf1\xee\x14\xb6\x18\x95\xb3\x24\x7f\xb8\xe6\xbe\xc9\x5a\x41\x23\xa0\xc6\x74\x22\x8a\x14\x90\x09\x13\x56\x43\xec\x01\x9f\x34\x51\xc3\x15\x68\x10\x89\x58\x4a\x39\x66\xea\xa1\x58\x07\xcc\xf5\xa5\x8e\x90\x5d\xc4\x66\xce\x2f\x16\xed\xfc\x6b\x2d\xed\xd9\xb1\x87\x1a\x76\x1e\xee\xd8\x49\xfc\xde\x39\xaa\x6f\x6c\xb1\x09\x52\xa4\xaa\x73\x61\x87\xce\xd9\x82\xfd\x85\xea\x61\x34\xc9\xad\x0b\x77\x1f\x81\x2e\xa7\x4b\x9e\xbd\x58\x6c\x65\x94\xc9\xca\x74\x30\xf6\x36\x74\x82\x28\xd9\x65\x01\x33\x71\xe0\x24\xa6\x84\xa9\x96\x37\xbb\x9f\x92\x1b\x64\xd2\x13\x1b\xbb\x61\x06\x55\x03\xda\x5c\xc1\xfb\xa3\x08\x07\x09\xb5\xb4\x57\x5a\x39\x68\x8d\x0f\x7d\x0c\x3f\x1a\x93\x15\x60\xf4\x1f\x02\x38\x55\x1b\xea\x5c\x39\x00\xf4\x56\x3b\xea\x1c\x09\x87\xd8\x39\xc3\x7d\x10\xee\x20\xd7\x8f\x10\x04\xde\x42\x45\x2a\xee\x7e\x94\x52\x2f\xbe\xd6\xb4\x23\xe6\x50\xab\xd3\xed\x5e\x69\x4f\x97\x91\xb0\x93\xf8\xa5\x85\xab\x87\x24\xef\x61\x49\x83\x8a\x5f\xb8\xeb\x50\x17\xfe\x1e\x31\x22\x8e\x50\x28\xb0\xd5\x7b\x7d\x0d\x7e\xd8\x17\x50\xbe\xbf\xb8\x38\xfd\xb7\xb7\x93\xdc\x00\x31\xe4\xfe\xa1\xec\x70\x2c\x66\x4d\x0c\xbf\xee\x85\xe1\x90\x29\x1d\xfd\x24\x64\xfc\x39\xab\x57\xf0\xb3\x11\x68\x3a\x6e\xe1\x16\x11\x8e\x5a\xd7\x21\xdb\xcf\xa4\xb3\xa2\x6b\xbd\x42\xde\x48\x1b\x02\xda\xb8\xcb\xc4\x63\x7a\xa6\x80\x4c\x51\x29\xfe\x2b\x6b\x48\xe1\xf4\x54\x3f\x8a\xc7\x7f\xf3\xee\x3c\xf5\x8b\x94\x00\x19\xf7\x06\xf8\x5f\x8b\x32\x29\xe0\xc1\xc8\xdf\x8a\xaa\xaa\x26\x32\x31\xd2\xf5\x49\x84\x42\x1c\xf5\xb3\x4c\xb8\x14\x9b\xee\x21\xd7\xc1\x97\x96\xf5\xd0\xa5\x13\x9b\xda\xc2\x2b\x09\x96\x63\xff\xce\x9f\x7d\x0b\xcc\x5f\xa0\xd9\x80\x12\xbb\xde\x7a\x4e\x7e\x18\x50\xb1\x53\x08\x4a\x1c\xbf\x86\x16\x61\xa3\xd7\xb0\x1e\x8c\x63\xb2\x1c\xe2\x82\x18\x12\x60\xba\x03\x51\x54\x3f\x2b\xe9\xc2\x34\x26\xe8\x61\x6d\x36\x28\xe9\xb2\x92\xfa\xd5\x0d\xd6\x6e\xc6\xb7\x46\x68\xd4\x51\xd4\x9e\x16\x5e\x5e\xb1\x4f\x9c\x3a\x96\x42\x8a\x6b\xf4\xc1\xe0\xcb\xc2\x75\x85\xd5\xd5\x45\xad\x21\xfd\x50\xbd\xd1\xfa\x7a\xe8\x73\x5a\xfc\x14\xfb\x96\x80\xea\x6c\xec\x88\xc1\x10\x2a\xd6\xbc\x6a\x28\x8b\xe4\x45\x08\x79\x4e\x0e\x86\x3c\x5b\x53\xb0\x92\xd6\x4f\x2e\x32\xd8\x8f\x73\xd1\x77\xbc\x16\xf9\x6e\x57\x53\xc9\x32\x46\xef\xe2\x67\x2f\xc2\x2b\x72\xec\xd5\xdb\xeb\xf1\xe8\x9e\x7c\x6c\x1e\x2f\x11\x16\xc2\x4f\xa1\x80\xbb\x9c\x51\x2a\x2a\x48\x07\xfc\xae\x69\x7a\xcb\x25\xb3\x43\xbd\xa2\x0e\xfe\xa5\x86\xe8\x42\x2a\xfc\xfd\xd3\x11\xc2\x55\x9b\x3f\x81\xc3\x4b\x7e\xfb\x34\xce\x1d\x6d\xfe\x85\x2e\x6f\xb7\xab\x90\xee\x67\xb4\x32\x90\xe5\x9c\xfe\xca\xa7\x4a\xda\xaa\x72\x20\xb9\x7a\xc9\xad\x88\x6d\x89\xde\x4f\x05\x74\x18\x2c\xac\xaa\x1f\xa4\x89\x00\xc8\xe1\x2b\x70\x63\xc7\x27\x6c\x77\x7d\xe4\x3e\x82\x40\x91\x0b\x0f\x94\xa5\x06\x7d\x6c\xa2\x03\xa0\x92\x40\xaa\x2c\xdc\xc0\x78\x51\xcc\xf6\x1d\xfc\x0e\x83\xb3\xb5\xbc\x15\xcd\xb3\x1a\xda\x81\x25\x3c\x26\x65\x2b\x85\xf1\x21\x28\x82\xc3\x3a\xf4\x56\x37\xb0\xae\x64\x16\x5a\x7d\xb9\x63\xd9\xab\x4e\x0f\xcd\x2b\x23\x70\x11\xef\x32\x76\x25\x6a\xbd\x16\xc8\xf9\xac\x86\x49\x56\x8f\xb3\xc4\xd9\x44\x54\xec\x1e\xd9\x87\x2e\x89\xab\x61\xf4\x73\x50\x76\x6d\x84\x99\xcf\x0c\x06\x0e\x97\x0b\x33\x28\x91\xdb\x82\xb2\xbf\x25\x33\xa3\x95\x37\xd1\xc4\x4b\xf4\xf0\x8f\x1f\x33\xfa\x4d\x5c\x75\x6a\x7f\xee\x7b\x61\x72\x83\xed\x84\xf9\x38\xfc\x06\xc8\xc9\x8d\xbd\x94\xcf\x5e\x2c\xd0\x37\xca\xa7\x2f\xd8\x9f\xf1\xed\x64\x6c\x31\xc5\x31\x02\x3f\x7d\xb1\x28\x42\x2a\x70\x68\xab\x5f\x8c\x74\xe2\xe5\x9d\x13\xf9\xb7\xec\x5b\xea\x34\x18\x27\xce\x81\xe4\x80\xe5\xbd\x26\x2c\x53\x8d\x02\x60\x92\x9c\x22\x56\x61\x62\xaf\x8c\x1d\xfb\xa1\x8a\xf8\x09\xb6\x47\x88\x26\x06\xeb\x61\xaa\x44\xfa\xad\x2d\x46\x3e\xa5\xde\xd0\xf3\xc9\xaf\xbd\x94\x10\xb8\xd2\x2f\x0a\xab\xbf\xc2\x58\x6e\x27\xa4\x79\x38\x12\x20\x72\x1f\xd1\x5c\x87\x97\xb4\xba\x63\x42\xd5\x9d\xb6\x70\x8f\x08\xe2\x56\x02\xda\xd1\x8c\x58\xeb\x4d\xac\xa9\x45\xef\x93\x78\xab\xf0\x29\x4d\xec\xf6\xdb\x09\x95\x33\x40\xb9\x95\xc5\x4d\xdd\x71\x78\x07\x40\x7e\xa6\xaf\x7e\x9d\xcf\xee\xe7\xf7\xf3\xff\x19\x00\x00\x07\xcb\x2a\x93\x39\x00\x00")
+
+func jujugenerateapidocConstraintsGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocConstraintsGo,
+		"jujugenerateapidoc/constraints.go",
+	)
+}
+
+func jujugenerateapidocConstraintsGo() (*asset, error) {
+	bytes, err := jujugenerateapidocConstraintsGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/constraints.go", size: 14739, mode: os.FileMode(436), modTime: time.Unix(1791996583, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocDebugdumpGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\x5f\x6f\xdc\x36\x12\x7f\x96\x3e\xc5\x54\x80\x53\x29\x55\xb4\xc9\x3d\xe4\x61\xdd\x3d\x20\x80\x93\x22\x45\x93\x18\x71\xef\xee\xc1\x67\xb4\x5c\x91\xd2\xd2\x2b\x91\x02\x49\xed\xc6\x97\xfa\xbb\x1f\x66\x48\xfd\xb3\xd7\x29\x8a\x16\x68\xac\xe5\x9f\x99\xe1\xcc\x6f\x7e\x33\x64\xc7\xca\x3d\xab\x05\xb4\x4c\xaa\x38\x96\x6d\xa7\x8d\x83\x34\x8e\x12\xa1\x4a\xcd\xa5\xaa\x57\xb7\x56\xab\x24\x8e\x92\xaa\x61\x35\xfd\x6d\x1d\xfe\xa9\xf5\xca\xdd\x75\xc2\xe2\xb7\xd4\x2b\xa9\x7b\x27\x1b\xfc\xd1\x31\xb7\x5b\x55\xb2\x11\xf8\x81\x03\x46\x54\x8d\x28\x69\x93\xd5\xc6\x25\x31\xee\x96\x6e\xd7\x6f\x8b\x52\xb7\xab\xdb\xfe\xb6\xf7\xff\xb0\x4e\x5a\x61\x0e\xc2\xac\x2a\x56\x32\x2e\x92\xa7\x16\x9a\xae\x5c\x99\xae\x1c\x05\x3f\xb1\x8e\x75\x92\xeb\x72\xe5\xff\x90\x30\xdd\x30\x55\x17\xda\xd4\xab\x2f\x2b\xa7\x75\x63\x57\xb5\x5e\x05\x17\xd0\x49\x6a\xdd\xed\xeb\x42\xaa\x95\x30\xa6\xd6\xc5\xe1\x55\x12\x67\x71\x7c\x60\x06\xb8\xd8\xf6\xf5\x45\xdf\x76\x17\xd2\xc0\x06\xd0\x1b\xc5\x95\x33\x52\xd5\x69\x42\x73\x2f\x78\xdf\x76\x49\x0e\x09\xfe\x7f\x34\xd2\x09\x70\x3b\x01\x86\x1d\x61\xb2\x15\x38\x73\x2c\x87\xc1\x79\x60\x65\xad\x98\xeb\x8d\xb0\xc0\x14\x07\x23\xac\x6e\x7a\x27\xb5\x02\x2e\x4a\x69\xa5\x56\x16\x2a\x6d\x40\xb0\x72\x07\xde\x2b\xe0\x34\x30\xf8\xf9\xea\xd3\x47\x40\x2f\x83\x54\xa4\x47\xb1\x56\x70\xe0\xd2\x88\xd2\x69\x73\x97\x64\x71\xbc\x5a\x85\x2d\x68\x36\xec\x74\xc3\x2d\x1c\x77\xcc\xd1\xfa\x5a\x28\x61\x98\xd3\x06\x2c\x3b\x92\x72\xd4\xc8\x05\x87\xe3\x4e\x28\xdc\xcb\x75\xd9\xb7\x42\x39\xa9\x6a\x60\x60\xa5\xaa\x1b\x11\x04\xe6\x64\x14\x97\xac\x56\x1a\x27\xa0\x33\x7a\xdb\x88\xd6\x82\xed\xcb\x1d\x30\x8b\xfb\x19\xb4\xc2\xed\x34\x87\x56\x5a\x5a\x54\x19\xdd\x92\x6e\xdd\xbb\xae\x77\x45\x8c\x00\x9a\x9b\x68\x9d\xe9\x4b\x07\x5f\xe3\xe8\x1d\x0d\x02\x58\xf2\x70\x1c\xfd\x5b\x18\x74\x06\x48\xe5\xe2\x68\xb5\x82\x9f\xf4\xaf\xb8\xd7\x9f\x09\x45\xfe\xa4\x81\xa4\xe9\x8a\x34\x04\x57\x31\x0b\x46\xd4\xd2\x3a\x61\x04\xa7\x8d\x47\xe9\x76\xb4\xe2\xcd\xe5\x7b\xf0\x68\x2b\xe2\x28\x88\x1b\xb4\xad\x56\x70\x69\x74\xfd\x40\xc5\x18\x34\x52\xe4\xd0\x91\x61\xdf\x91\xf4\x58\xdd\x1c\x82\x16\x0c\x91\x22\x4f\x1a\x01\xd2\x7d\x6f\x31\x9c\x0d\x33\x82\xe7\xa0\x0d\x1c\x77\x77\x20\x1d\x94\xba\x6f\xb8\xfa\xde\xc1\x56\x8c\xdb\x8b\x38\x1a\x55\xcf\xcc\xf9\x40\x9e\xb4\x33\x6b\xda\x30\x42\x76\xcc\x00\x56\xe9\x5e\x71\xd0\x6a\xe6\xa6\x1c\x8e\x3b\x89\x61\x31\x62\xb1\x95\x8e\xcf\x41\x1f\x84\x19\x5c\x52\xc4\xd1\xa0\xea\xfa\xc6\xaf\x23\xf0\xfc\x8e\x2c\xb0\x4e\x72\xdd\x4a\x27\xda\xce\xdd\x25\xbf\x93\x82\xab\xbd\xec\x3a\xc1\x67\x76\x89\x2f\x48\x21\x82\x8f\x5a\x74\x35\x39\x13\x8d\xa5\x7d\xf3\x8c\x90\xe4\x03\x67\x04\x73\xc0\x2c\x5a\x31\xec\xcd\xa7\x70\x19\xc1\xac\x56\x45\x1c\x0d\x1a\xaf\x6f\xac\xff\xf2\xf6\x3e\x69\xe1\x27\xc2\xda\xcc\xc0\x09\x1a\x03\xc0\x31\x2a\xde\x43\x2d\xbb\x83\xad\x20\x0b\xa5\x2a\x75\xdb\x35\xc2\x09\x90\x15\xbc\x35\x46\x1b\x90\xe8\x33\x57\xc4\x51\x10\xea\x99\xa5\xf0\x60\x7d\xaf\x2a\x1d\x47\x7e\x61\xc0\xed\x49\xa3\xee\x29\x31\x67\xbe\x9d\x4c\x9b\x7b\x85\x39\x46\x49\x36\x64\x51\x0e\xd2\xd9\x91\x38\x50\xc4\xc8\x1d\x39\x81\x0d\x05\x58\x27\x3a\x0b\x8e\xed\x85\x42\x9a\xa8\xa4\xe2\xb4\xcd\xa3\x8f\x21\xb1\x84\xac\x9b\xe9\x9f\xb2\xee\x23\x6b\x05\xd0\x7f\x03\xf4\x2e\x99\x61\xad\x05\xf8\xe6\x89\xa2\xcf\xc2\xf6\x8d\xfb\xb3\x55\x57\x83\xbd\xdf\x5c\x75\x31\xf2\xde\xf5\x4d\xb0\xc2\x7b\x6c\x19\x6e\xef\xb4\x91\x62\x74\x05\x2c\x30\x09\x81\x0c\xa4\x45\x50\x79\x84\xe3\xee\x25\xc8\xc9\x05\x4b\x81\xdf\xf2\xc2\x43\xcb\xe3\xe8\x33\xc1\x71\xb6\xc6\xdb\x48\xb4\x7f\x31\x54\x0a\xff\xd3\x87\x16\x8b\x03\xc5\x73\x06\xc1\x11\xdc\xb5\x3c\x04\xca\x15\x8e\xc9\xc6\x22\x1c\xb5\x15\xc8\xaa\xa5\xb0\x03\xc1\xf2\xbe\x14\x1c\x4c\x8e\x91\x65\x9e\xfb\x3d\xe9\xb3\xca\xf9\xc3\x4d\x8c\x3f\x54\x85\x17\x53\x69\x9a\x6a\x43\x11\x57\xbd\x2a\x1f\x18\x9b\x76\xfb\x1a\x9e\x0f\xb5\xb0\xb8\xf4\x1f\x39\xf0\x20\xb1\xb8\x18\x6c\x33\x61\xc4\x47\x3d\x03\x41\x90\xff\x1a\x47\xa4\x65\xbd\x09\xd3\xe8\x81\xaf\x71\x14\xa8\x7c\x0d\xc0\x0b\xf4\x6c\x1e\x47\x03\x9b\xaf\x81\x17\xe1\x13\x47\x3d\x99\xd2\x42\xfc\x18\xaa\x6b\x86\x73\x3e\xe1\xd6\x00\xa6\x08\x15\x28\x8e\xee\xe3\x48\x56\x60\x0a\x61\x0c\x7c\xb7\x01\x25\x1b\x8c\x1e\x59\x51\xf8\x34\xdc\xf8\x59\xff\x2b\xcd\x68\x4b\xe7\x72\xb4\x18\xd6\x1b\x74\x2f\xd1\x12\x1e\x3d\x0f\x5a\x33\x12\x7a\x4a\xe4\x48\x62\x1b\xa8\x5a\x57\x5c\x75\x46\x2a\x57\xa5\x89\xd2\x6e\x64\xef\x35\x9c\x1d\x12\x92\x8f\xca\x40\x34\x56\xfc\xb9\x80\xb3\xa9\x3a\x00\x73\x5e\x42\xe7\xc8\x9a\x34\xcb\x01\x1b\x92\x77\x48\x3b\x97\xda\x4a\xcc\xe0\xb4\xa3\xef\x34\xcb\x50\x49\x1c\x39\x3c\xcb\x44\x1d\xc5\xa7\xed\x2d\xee\xfd\x54\xa5\xe3\x91\x02\xd3\xaf\x37\xd0\xb2\xbd\x48\x5b\xd6\x5d\x7b\xdc\xde\x6c\xb5\x6e\xb2\x38\x42\x64\xfe\x96\x13\x9e\x48\x1a\x53\xb5\x00\x57\x78\x6e\xc5\xb0\xd9\x34\xa3\x93\x78\x49\xd7\xb8\xf0\x06\x36\xe0\x4c\x2f\xe2\x28\x6a\x73\xf8\x0d\xf7\x0d\x3b\x52\x9c\xcf\x70\x82\xe3\xf0\x44\x36\x28\x22\x42\x79\x6b\x00\xd2\x86\xc1\x0d\x2c\xb3\x86\x70\x02\x34\x3a\x04\xbf\x2d\xfc\x1c\x81\x20\xf0\xcc\xe9\x75\x01\x8d\xb8\xee\x3e\x8e\x30\x88\x9d\x9b\xc7\x30\x6a\x79\x31\xe6\x71\x0e\x2d\x2f\x26\xa6\x19\x2d\x1c\x46\x3c\x22\x10\x2a\xc3\x41\xee\x87\x28\x0e\xe5\x71\x03\xac\xeb\x84\xe2\xe9\x7c\x14\xe5\x66\x03\x32\x97\xfa\x69\xd9\x50\xbc\x36\x4b\x36\xb3\x29\xaa\xf2\xae\xf5\xdb\x7d\x9b\x18\x70\x8a\x64\x5a\x7c\x60\xc6\xee\x58\xf3\x5e\x71\xa1\x1c\x29\x0d\xad\xe6\x7f\x5d\x72\x12\xb4\x46\xb8\xde\x28\xc4\x62\xad\x8b\x0f\xcc\xee\xd3\x00\xcb\x38\xc2\xce\x1c\xe3\x32\x74\xe9\xc5\xcf\x5a\xaa\xf4\xf9\xbc\xc5\xcd\x1f\x62\xf4\xc5\xe1\x8c\x17\x68\x49\x92\x0f\x99\x3c\xa5\x6f\x36\x59\xb0\xde\x80\xbf\x0b\x14\xff\x41\x7e\x79\x27\x1b\x91\xa2\x8e\x3c\xb4\xbe\x2f\x5f\xbf\x7e\x9d\x9d\xff\x05\x63\xc3\x94\x92\xcd\xb2\x74\x8e\xd1\xf3\x0b\x1e\xf4\x68\x63\x71\x04\x5d\x2d\x39\x76\xaa\x18\x9d\xa3\xc2\xc9\x80\x0b\x5b\x1a\xd9\x61\x72\xe1\x38\x35\xdb\x58\x4a\x1f\x57\x52\x14\x30\x2b\xa6\x43\xdf\x20\xed\xd8\xeb\x51\x5b\x01\xa5\x6e\xb1\xb3\x18\x0b\x33\x53\xac\xb9\xb3\x92\xfa\x62\x5d\xa1\x24\xd8\x6a\x7e\x87\xeb\x04\xf5\xc5\x81\x93\x4f\xe0\xf0\x14\x29\x77\x0e\x9e\x63\x0d\xb3\x94\xe0\x3e\x18\x88\xd4\x50\x8d\x32\x48\xfd\x47\x3e\x16\x51\xca\x5e\xbd\xbd\x1d\x41\xe5\x35\x7d\xda\xde\xa6\x33\x98\x3f\x89\xa2\x24\x99\x44\x7d\x5d\x20\x03\xe9\xcf\xf7\x9c\x52\x2d\x43\x10\x1c\x6d\x85\x9b\xd1\xe2\x3d\xc5\x74\xba\xdc\xac\x37\x4f\xc8\x7d\x4c\x89\x8f\x78\x50\x6f\x6f\x07\x22\xf4\xb5\xa0\x52\x39\xe8\x3d\x22\x1b\xa7\xd2\xe0\xa2\x77\xbd\x2a\xb3\x73\x9c\xc0\x03\x61\xc9\x10\xe5\x01\x17\x55\x2a\x90\xec\xb8\x74\x24\x88\xac\xf8\x2c\xca\x43\x9a\x9d\xfb\xc5\xc1\x21\xcf\x9e\xc1\x77\x96\xb5\xe4\x70\x9e\xe2\xcc\xc4\xd2\x8e\x3c\x1c\xcd\x8e\x36\x31\xc4\x30\xf4\x20\xa9\x3a\xa3\x5b\xed\x04\x27\x00\xc0\x99\x4d\x72\x98\xc9\xcc\x02\xed\x78\x77\x35\x39\x15\x89\xcb\x7d\x3d\x46\x10\x31\x79\x81\x63\x1e\x15\x9e\xb3\x26\x9f\xc4\x91\x3d\x4a\x57\xee\xd0\xac\x92\x59\x31\x8b\xec\x3a\xfe\x4b\x76\xce\x00\x0f\x63\xb8\x67\x31\xcd\x82\x82\x60\x20\xf9\xc4\x62\x3b\x0c\x9b\xbf\xab\x2e\x3d\xfb\x35\x83\x8e\x19\x2b\xf0\x4e\x03\x5c\xb4\x4c\x8d\xfe\xca\xc1\xea\x59\x2a\xb1\x70\x95\xa2\x5c\xfb\x9f\xe0\x48\x54\x73\xc7\x15\x97\xfb\xfa\x92\xb9\x1d\xba\x86\x8b\x8a\x61\x29\xf9\x9b\x96\x91\x27\xc2\x25\x05\x5b\x4a\xa9\x2a\x6d\x5a\xbf\x40\x2a\x1f\xd2\x27\x6d\x98\xb8\x6d\x4a\xe5\x50\xce\x30\x8a\x03\xb2\x94\x6c\xb2\x7c\x7a\x0e\x08\x24\xb8\xac\x1f\x0b\x0e\x3c\x75\x03\xc3\x1c\x97\xaa\x6c\x7a\x7c\xd2\x41\x12\x72\xd4\x60\x06\x2a\xea\xb4\x54\xd4\x42\xd2\x65\x91\xfa\x67\x66\x04\xba\x53\xaa\x50\x96\x02\x3f\x3d\x2a\x5b\x8f\x79\x28\xf4\x1a\x0f\x7b\x8c\x47\x17\xb6\xaf\xa1\x73\x19\x5b\x1d\x4a\xe1\xef\xbc\xb8\xf7\xf6\x3d\x5a\x54\xb1\x52\xa4\x21\xb3\x1c\xb6\x1b\x34\xf9\x51\x1c\x2f\xbd\xc5\xa9\xf3\x7e\x3c\x30\x03\xf6\xf4\xc5\x30\x8e\x5a\x2b\x48\xd1\xb8\xd9\x4f\x5c\x09\x47\xdb\xb1\xf5\x91\x38\xff\xf2\x1c\x24\xfc\x08\xb8\xbc\xf8\x45\x28\x4c\x7e\xf9\xc3\x0f\xa4\x5b\x6f\x6f\x71\x05\x4d\xbd\x71\xa9\xcc\xb0\xc9\xc2\x86\x92\x4c\xc6\x68\xbd\x0d\x4e\x4f\x33\xf8\xe3\x8f\xe0\x83\x6b\x9c\x40\xa7\xa4\xd9\x0d\x89\x89\x4a\x8d\xcf\x29\xd4\x32\x61\x43\x61\x65\x3d\x90\xd5\x93\x44\x84\xcb\xc6\xae\x21\xe0\x33\x0c\xe4\xcb\x2e\x62\xd1\x5b\x01\xc0\xa4\x1d\x9b\xa2\xe9\x12\xb3\x7e\x8c\x37\x2b\xeb\x00\x34\x5c\xe9\x2f\x37\xd8\xa1\xe1\xcb\xd5\xb8\xef\xd2\xbf\xf1\xa4\x56\xd6\xd4\x8e\xdd\x7b\xdf\xe3\x9b\x5e\x71\xd5\xc8\x52\x4c\x76\x21\x56\x52\x99\xc3\x2d\x3e\xd8\x64\x80\x4d\xe6\xbc\x9a\x84\x75\xd7\xf2\x86\xae\x04\xf0\xe3\x38\x72\xeb\x47\xe2\xe8\x3e\x1b\xb3\x23\xcc\x05\xe0\x9f\xb0\x68\x44\x3f\xbe\xab\xcc\xee\xd0\xc7\xe1\x7d\x85\xc2\x01\x6c\x56\xfd\x97\x57\xaf\xe9\x2a\x5d\xcc\xf7\xd3\xb6\xa1\x8e\x61\x6e\x31\x87\x22\xb0\x29\xc0\xaa\xd4\x6a\xeb\x40\x2b\x01\xcc\xd4\xfd\x58\xed\x83\xd1\xf3\xf9\x03\x6b\x7a\x41\xad\x00\xa3\x5b\x1e\x5d\x98\xce\x81\xa9\x3b\xb7\xc3\xbb\x1d\x5d\x13\xa4\x05\xdd\x3b\x2b\xf1\x5e\x88\x09\xe8\x76\xd2\x42\xc9\x14\x88\x2f\x5d\xc3\xa4\x0a\x09\xf8\x44\x40\x86\x34\x1c\xe7\xb2\xd0\x0a\xa0\xdb\x65\x85\x07\x0c\x9d\x74\x9a\x79\x70\xc3\x3f\xe1\xd5\x3c\x26\x0b\xa2\xc3\x23\x5a\x38\xe3\xe3\xd1\x6c\x0e\xdb\xde\xcd\xdf\x66\x1e\xb9\x21\xc9\x4f\x68\x19\x68\x0e\x7b\x73\x2a\xf6\xb8\xc4\xb7\xea\x36\x9d\x4a\x54\x58\x10\x2c\x1b\x0a\xd6\xcb\x1c\x5e\xad\xc3\xf7\x3f\xd6\x21\xd9\xa4\xf5\xb7\xb9\x61\xcb\x1b\x97\xbe\xca\x42\xfa\x84\x12\x1c\x4e\x94\x8c\xa4\x78\xd4\x3e\x06\xe1\x14\x48\x92\x56\x94\x1a\x7b\x16\x8b\xf8\x40\x2f\xa3\xd0\x04\x51\xbd\x28\x0e\xa7\x9c\x33\x88\x3d\xe3\x0b\xa9\x73\xdf\x3c\x00\x81\x3b\xea\x24\x5f\x1e\x72\xc1\xff\x09\x59\x34\x84\x0e\xb8\x16\x64\x56\x88\x3c\x3e\x17\x9e\x43\xb9\x13\xe5\x9e\x20\xfb\x00\x6e\xe8\x06\x08\x4f\xf1\x3e\x45\x82\x8b\xc0\x08\xe4\x24\x6a\x4d\xdd\x0e\xe9\x1d\x1f\xb0\x50\x42\x67\xc4\xd8\x5e\x11\x18\x69\x7f\x40\xd8\xe0\xe0\x20\x94\x5c\x3b\xa5\x70\xb0\x38\xb0\x34\xde\x45\x64\xc9\x9a\xd4\xe5\x61\xf5\xbf\x94\x3c\x08\x63\x45\xf1\x8b\xd6\xfb\xbe\x4b\x13\x92\x9f\x8c\x11\x0a\x26\x8e\x5d\xd4\x63\x23\xe9\x85\x14\xad\x44\x81\xf8\x4c\x4a\xd5\x89\x3a\x67\xa7\xf3\xe1\x08\xd8\xed\x72\xd2\x09\x9d\x0b\x96\x4f\xad\xd9\xdc\xf6\x53\xdd\xf2\x74\x1e\xbc\xa7\x0d\x4d\xa3\x1b\xe9\x37\x94\x97\xa9\x6b\xc4\xe2\xd3\x15\x6f\x31\xdb\x7c\xe4\x48\xff\xe3\x8d\x28\x9c\x4f\xd4\xa5\xf7\xf0\xec\x19\x35\xd7\xdc\x57\x0c\xec\x88\x3a\x17\x9c\x10\x78\x66\xe2\xe1\x80\x1b\x0b\xd8\x63\x61\x1f\x41\xcd\x21\x3d\x02\xd2\x85\x0f\x7d\x10\x9c\x43\xef\x65\x43\x8e\xcb\xca\xc7\x56\xc9\x26\xb8\xe2\x91\xe8\xd4\x0d\xb7\xe6\x10\xd0\x05\x3d\xb8\xd0\xa9\xcd\x09\x21\x49\xe6\x10\x75\xe3\x73\x4c\x7c\x1f\xff\x7f\x00\x09\x47\x57\x20\x35\x1a\x00\x00")
+
+func jujugenerateapidocDebugdumpGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocDebugdumpGo,
+		"jujugenerateapidoc/debugdump.go",
+	)
+}
+
+func jujugenerateapidocDebugdumpGo() (*asset, error) {
+	bytes, err := jujugenerateapidocDebugdumpGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/debugdump.go", size: 6709, mode: os.FileMode(436), modTime: time.Unix(1791998191, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocExamplesGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\x4d\x8f\xdc\x36\x0f\x3e\x5b\xbf\x82\x11\x90\xc0\x4e\xfc\x7a\xde\xf6\x38\xc1\xdc\x8a\xb4\x4d\xd3\x34\xc0\xa6\xed\x21\x1b\x64\xb5\x32\xe5\x51\xd6\x96\x5c\x49\xde\x4d\xb0\x99\xff\x5e\x50\x92\x3f\xf6\xa3\x05\x7a\xb1\xc7\x94\x44\x3e\x7c\xf8\x50\x9c\x51\xc8\x2b\xd1\x21\x0c\x42\x1b\xc6\xf4\x30\x5a\x17\xa0\x64\x05\x47\x23\x6d\xab\x4d\xb7\xfb\xec\xad\xe1\xac\xe0\x6a\x08\xf4\xea\xec\x6e\x14\xce\xa3\xcb\x1f\xc1\x5e\x61\x5c\xf7\xc1\x69\xd3\x79\xce\xc8\xae\xc3\x71\xba\x6c\xa4\x1d\x76\x9f\xa7\xcf\x53\x7c\x88\x51\xb7\x56\xee\xd2\x8b\xb3\x8a\xb1\xdd\x0e\x5a\x2b\xcf\x8c\x1e\x47\x0c\x70\xb4\x7d\xeb\x41\x80\x42\x23\xb1\x05\x69\x5b\x84\xcb\xde\xca\x2b\x50\x76\x32\x2d\x68\x03\x82\xf6\x83\xb4\xc3\x80\x26\x34\x2c\x7c\x1d\x71\xeb\xc1\x07\x37\xc9\x00\xb7\xac\xd8\xed\xe0\x8d\x30\x5d\xf6\x19\x8e\x08\xbd\x30\xdd\x44\x89\x1a\x31\x60\x0b\x42\x05\x74\x71\xc1\x8e\x68\xb4\xe9\x52\xd8\x3a\x1e\xd5\x0a\x84\xf9\xda\xb0\x22\xfa\x48\x79\xb1\xe2\x3d\x7e\x89\x21\xe2\x07\x05\xd0\x06\xb7\x01\xe8\xd3\xaa\xf8\x7b\x83\x12\xc2\x51\x84\xb8\x9f\x16\xfc\x82\x54\xb8\xe0\xc1\x9a\x1a\xa4\x9d\x4c\x88\x00\x9c\x1d\xe0\x3b\x8a\x4a\x9e\xb4\x09\xec\x74\x8f\x22\x0f\x0e\xc3\xe4\x8c\x07\xd1\xf7\x31\x50\xe6\xaa\xbc\xb8\xb8\xa8\x36\x8c\x79\x22\xab\xb5\xb2\x61\x6a\x32\x72\xeb\xa1\x24\x68\x29\x89\x0a\x3e\x7c\x5c\x57\x88\xb5\x6b\xe1\x66\x84\xfe\xce\x62\x5a\x92\x93\x83\xe7\xf7\x8d\xbd\x36\x48\x9b\x67\x62\x94\x75\xa0\xeb\xc4\xc6\xfe\x00\x4e\x98\x0e\x73\x40\xdf\x9c\x8d\xbd\x0e\x04\xa1\x06\x7e\x6e\x78\x45\x41\x8b\xe0\xf4\x40\x25\xd9\x1f\x96\x7d\xef\x9d\x1e\xce\x46\x21\xb1\x24\x3f\x15\x2b\x0a\xad\xe0\xc9\xbc\xfa\x93\xf0\xef\x1c\x2a\xfd\xa5\xcc\x47\x6b\xe0\x17\x17\x17\xd9\x1d\x6d\x25\xa4\x4f\x0e\x60\x74\x9f\x4c\x05\xb9\xf1\x70\x00\x31\x8e\x68\xda\xe8\xd5\x27\x90\xe4\xbc\x38\xd1\x43\x5a\xaa\xc3\x84\x2c\x7d\x67\x37\x87\x8d\x1b\x72\x7b\x80\x67\x2b\x05\xd1\x1a\x55\xb2\x5f\xb1\xdb\x37\xf6\x06\x5d\xf9\x30\x97\xad\xe5\xf1\x04\xaa\xaa\x4e\x1e\xb5\xc1\x3d\x68\x78\x01\xdf\xd7\x0b\xbe\x39\x07\xa3\xfb\x47\xe0\xca\xc9\x35\x51\xa1\x2b\x8b\xaf\xad\x36\x73\xaa\x91\x6e\x56\x14\x4b\x79\x17\x2e\x66\x4b\x0d\xcf\xe5\xe4\x88\x8e\x94\x66\x0c\x73\x62\x45\x92\xdc\xa2\x8b\x2c\x4a\x6a\xa7\x45\x8d\x77\xfa\x2b\xb7\x40\xde\x5f\x43\x37\xa1\xf7\xd4\x33\xbb\x1d\xbc\x3e\xfb\xed\x2d\x90\x42\x26\xd3\x8b\x4b\xec\xb1\x5d\xf5\x46\x7d\x02\xbd\xb5\x57\xd0\xeb\x2b\x04\x1d\xb2\x78\x4b\xbf\xd1\x6f\x15\xfb\xb8\xac\x72\x8e\x54\x5d\xad\xc0\x37\x54\x02\x78\x72\x00\xce\xc9\xb4\x60\x8e\x76\x46\xfc\x68\x05\xe1\x71\x85\xf9\x48\x5b\xf5\x12\x1e\x91\x57\x0d\xfc\x96\x57\xf0\xed\xdb\x3f\x2c\x7e\xe0\xd5\x36\x1e\xcf\x57\xe5\xca\x1a\xe7\x99\x2f\x79\x44\x79\x95\x93\x58\xbb\xd8\x00\x3a\x47\x0d\x93\x38\xeb\xf4\x35\x2e\x4c\x83\xf6\xd4\xfd\xc6\x06\xb8\x16\xbd\x6e\x9b\xc4\xde\xc2\xd7\x30\xf9\x00\x97\x08\x37\xd8\xf7\xc4\xe9\x80\xed\x4b\xf8\xd1\x2e\x1b\xe8\x70\xdc\x13\x6f\x6b\x40\x1d\x8e\xe8\x40\xd0\x0d\x2b\xed\x30\xf6\x18\x10\x94\xee\x11\x6c\xb6\x7a\xfc\x6b\xa2\xeb\x04\xac\xa2\xb3\x3e\x88\x80\x74\xcf\xfa\x06\x32\xf0\x78\xad\xd8\xe8\x67\x2e\xb7\x07\xe1\x30\x82\x8c\x19\x62\x9b\xab\xb6\xcd\xf7\x5e\x05\x53\xca\xb7\xac\xf0\x37\x3a\xc8\x23\xf8\x26\xd7\xf4\x96\x15\x52\x78\xcc\x34\xee\x59\x11\x6f\x9d\x6b\xd0\x26\xa0\x53\x42\xe2\x6d\xee\x4b\x74\x8e\x6a\x49\xdb\x9a\xdf\xcd\x20\x9c\x3f\x8a\xbe\xfc\xf0\xf1\xf2\x6b\x58\xea\x59\xc3\xb3\xeb\xea\x25\xf1\x7b\xe7\x26\xc8\x75\x41\xe7\x52\xdf\xa4\x80\x9d\xe5\x35\x3d\x09\x48\x0c\xac\x3c\x46\xb9\xc4\xe1\xd6\xbc\xc5\x9b\x57\xba\xc7\x33\x0c\x65\xbe\x8b\x3e\xd5\x33\x88\xc8\xae\x6b\xde\xd1\x8b\x36\x95\x74\xb4\x06\xce\x6b\x48\x48\x6a\xf8\x7f\xc6\x71\x78\x88\x23\x36\x19\xe1\x28\xbc\x93\xe4\x8e\xcf\xf3\x78\x3c\x37\x91\xc8\x4f\x44\xcc\xb9\xe1\xf0\x22\xfb\x83\x17\x74\x77\x9e\xce\x0d\xff\x2f\x50\x9c\x5c\x71\xfc\x1b\x1f\xab\x74\x09\x5a\xd2\x2e\x7e\x11\xa4\x97\x3f\x85\xa3\x41\xb9\x19\x42\x70\x93\x4c\x24\x3f\x40\x21\x8f\xa0\x4d\xd4\x2a\x9d\xca\x3a\x24\xc9\xdc\x1b\x89\x7e\x1e\x93\x49\xef\x4a\x48\xd1\x22\x1d\x11\x34\xe5\x49\xda\x18\x8e\xb6\xf5\x59\x4a\xf7\xc2\x97\x0a\xd2\x5f\x88\xe6\x55\x3c\xf8\xb3\x51\x96\x46\x59\x36\xe6\x6d\xf3\x38\xcb\x08\xfd\x83\x0d\xac\x88\x12\x25\xde\x28\x4a\x99\x62\xd6\xb0\x9d\x8f\xa4\x18\x4a\xed\x53\x0d\x7e\x1d\x66\xab\x96\x3d\x8d\xb2\x75\xea\xe4\x32\xdc\x95\xfe\x23\x9c\x17\x0b\xa8\xe5\x12\x9e\x2d\x35\xdc\x45\x99\xf6\x17\xbf\x68\xd3\xee\x01\x00\x78\x26\xf8\x7f\x99\x14\x1e\xc7\x43\x51\x24\x2a\xf6\x00\xaa\x79\x2b\x06\xcc\xd6\x3f\xd0\x79\x6d\xcd\x1e\x54\x93\x7f\xe6\x85\x5f\x63\xb2\x7b\xc8\x4c\x2f\x56\xef\x45\x87\x7b\x50\x43\x68\xce\x46\xa7\x4d\x50\x25\xdf\xfe\x97\xa1\x61\x02\x4f\xdb\xfd\x5c\x67\x78\xea\x67\x75\xec\xe1\xe9\x35\x09\xad\x79\xa3\x0d\xd6\x4b\x53\xc7\x3e\xc9\x53\xed\xb4\xcc\xda\x24\xb4\x48\x54\x49\x9d\xa2\x9a\x1f\xac\xac\xd8\x4c\xf6\xb0\x92\xad\x9a\x84\xd5\x47\x96\xd3\x89\x21\xe5\x08\x43\x3e\xb5\x6a\xf6\x46\x38\xa3\x4d\xe7\xd9\x89\xfd\x3d\x00\x5a\x4f\xfa\x35\xd8\x0a\x00\x00")
+
+func jujugenerateapidocExamplesGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocExamplesGo,
+		"jujugenerateapidoc/examples.go",
+	)
+}
+
+func jujugenerateapidocExamplesGo() (*asset, error) {
+	bytes, err := jujugenerateapidocExamplesGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/examples.go", size: 2776, mode: os.FileMode(436), modTime: time.Unix(1791993647, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocFacadesGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\x5d\x6f\xdb\x3a\x12\x7d\x96\x7e\xc5\x5c\x03\xc1\x95\x72\x15\x29\x77\x1f\x1d\xfb\xa1\x68\xb0\x8b\x3e\xb4\x0d\xda\xee\x07\x90\xcd\x03\x2d\x8e\x24\xd6\x14\x29\x90\x94\xdd\x20\xf0\x7f\x5f\x0c\x49\xf9\x2b\x4e\xbb\x8b\x2d\xd0\x48\x22\x39\x33\x67\x86\x67\x0e\xe9\x81\xd5\x6b\xd6\x22\xf4\x4c\xa8\x34\x15\xfd\xa0\x8d\x83\x2c\x4d\x66\x8d\x64\xed\x2c\x4d\x66\x52\xfb\x87\x19\x95\x13\x3d\x1e\xbd\x56\x1c\x57\xa3\x9f\x0b\x13\x69\x32\x6b\x85\xeb\xc6\x55\x59\xeb\xbe\xfa\x3e\x7e\x1f\xc3\x1f\x36\x08\x8b\x66\x83\xa6\x6a\x58\xcd\xf8\xdb\x2b\xd9\x20\xb8\xae\xab\xf0\x98\x9d\x2e\x32\xba\x1d\x70\x18\x90\x66\x6b\xdd\x0f\xcc\x55\xdf\xad\x56\xee\x79\x40\xeb\x97\x6a\xc9\x54\x5b\x6a\xd3\x56\x3f\x2a\xa7\xb5\xb4\x55\xab\xab\x98\x5c\x5c\x31\xac\xdb\x52\xa8\x0a\x8d\x69\x75\xb9\xf9\x73\x96\xe6\x69\x5a\x55\x10\x50\x7d\x41\x3b\x4a\x07\x9d\x96\xdc\x82\xeb\x10\x4c\x18\xd0\x0d\x0c\x46\xd7\x68\xad\x50\x2d\x30\xa0\x87\xc4\x68\x54\xa6\x04\xe0\xd4\x83\x75\x66\xac\x1d\xbc\xa4\x49\x18\x06\x80\x90\x51\xf9\x57\xff\xfd\x41\x35\x3a\x4d\x1a\x81\x14\x08\xe0\xf1\x69\x9a\xa5\x91\x30\xb9\x65\x46\x09\xd5\xda\xc3\xe4\x3f\xc3\x48\x9a\xa0\x31\xe0\xff\xa1\x31\xda\xa4\x3b\x9f\x41\x04\x18\xfc\x5b\xa8\x99\x94\x36\x82\x22\x87\xd0\x68\x03\xc8\xea\x0e\x74\xe3\x53\x6b\xc5\x06\x55\x5c\x60\xc9\xc1\x18\x93\x1b\xb4\x96\xb4\x68\xab\xcd\x1a\x8d\x2d\x40\x2b\x3c\x58\xb3\x0d\x13\x92\xad\x24\xc2\xfb\x87\xbf\x17\xc0\x14\x0f\xa1\xc8\x03\xf6\xc2\xc1\x56\xb8\x2e\x2c\x8d\xc5\x13\xca\xc7\xb3\xac\x47\xd0\x86\xa3\x01\x66\x81\xdb\x12\x42\xb5\x2d\x30\x83\x64\x3d\x30\x6b\x91\x83\xd3\xc1\x0f\xb3\x60\xb5\x56\xb4\xd8\x75\xf8\xec\x23\x31\x29\x8f\xb6\xc5\xc2\x0a\x1b\x6d\x90\x86\x7a\xf2\xc0\x0c\x1e\xf0\x95\xf0\xa1\x09\x9e\x0c\xba\xd1\x28\x0b\x4c\x85\x82\x15\xe7\xb5\xb2\x4e\x0f\xbe\x04\x14\x63\x5a\x2d\x5c\x99\x36\xa3\xaa\xcf\x16\x67\xc3\xba\x85\xeb\x89\x53\xe5\x43\x78\x29\x40\x50\x8d\xaf\xf7\x74\x2c\xa9\xe6\x05\x70\xda\xbe\x48\x93\x7b\x74\x4c\x48\x5b\x04\x50\xe4\x3a\x3b\xe6\x4c\x1e\xc0\xc5\x07\x51\x67\xca\x72\xbe\x84\x9e\xad\x31\x7b\x7c\xaa\x3b\x36\x6d\x59\x30\x2a\x40\xa2\xca\xb8\xcd\xf3\x34\xa1\x2d\x12\x30\x5f\x82\x61\xaa\x3d\xd4\xe8\x25\x4d\x26\x4f\x8f\xe2\x09\xa2\xaf\x0b\x9e\xfe\xcc\xd3\x64\x97\x26\x42\x71\xfc\x81\x87\xa8\x7e\xa5\x50\x2e\x4f\x13\x4e\x4c\x38\x19\x0f\x3c\x7f\xd9\xd1\x24\x36\x68\xa0\x96\xda\x62\x46\x0b\xf3\x34\x69\x75\x48\x33\xa7\x6c\x4e\x16\xc4\x20\x79\x9a\x9c\xc3\xe6\x01\x71\x62\x51\x62\xe8\xa0\x24\xa9\x99\x45\x98\x70\x2d\x6e\x40\xcc\xf7\xa3\x8b\x1b\x8a\xe5\xbf\x93\xb0\x73\xf4\xba\x4b\xfd\xff\x5d\x76\x5c\x96\xdb\x3b\x10\xb0\x80\x28\x5c\xe5\xdf\x3e\x7f\x7c\xf7\xaf\x87\x2f\x9f\xdf\x7f\xcd\x6e\xf3\x3b\x10\x7f\xfc\xe1\x83\x9d\x62\x3e\x47\x37\x81\x78\x89\x01\xf7\x65\x5d\xdc\x40\xdd\x61\xbd\x1e\xb4\x50\x0e\x79\x20\x0b\x71\x25\x30\x83\x98\xf0\x28\x9e\xf2\x3d\x38\x42\xb6\x4b\xdf\x48\x5e\x34\x44\x02\x8a\x4a\x54\xc9\x16\x37\x87\x40\xf9\x9d\x9f\xfa\x6d\x09\x4a\x48\x0f\x38\xa6\x4d\xc3\xe4\xf8\x7c\xb7\x95\x90\x3e\x52\x5c\x45\x9f\xbb\x34\xdd\x30\x13\x77\xff\x9b\xe8\x51\x8f\x0e\x96\x40\x5a\x5f\xde\x8f\x86\x39\xa1\x55\x36\x0b\xd3\x37\x24\xeb\x7a\x74\xb3\x02\xfe\x72\x4d\xef\xe5\x47\xa1\x46\x87\x05\xcc\x7a\xf6\x43\xf4\x63\x0f\x34\x4a\x5d\x6b\x07\x54\x1c\xb4\x0a\xcd\x1f\xcc\x0b\x60\x8d\x43\x03\xdb\x4e\xd4\x1d\x08\x07\xc2\x82\xc1\x9a\x64\x80\x53\x67\x37\x4c\x48\xe4\x77\x70\x0b\x3d\x32\x65\x41\x69\x90\xa2\x17\x6e\x96\xbf\xd6\xb4\xcb\x92\x76\x26\xc6\x05\x99\xb5\x62\x43\x52\x36\x0e\x84\x86\x82\x36\x14\xda\xb1\x35\x5a\x90\x5a\xb5\x68\xc0\x11\xab\x49\x4a\x6e\x4e\xf3\x24\x73\x2a\x04\x30\x29\xf5\xd6\x16\x04\x92\x45\xdf\xf4\x70\xda\x3c\x93\xb1\x83\x95\xd4\xf5\xda\x52\x04\xeb\x98\x43\x60\x35\xa9\x04\xd9\x6f\xf5\x28\x39\x68\xd7\xa1\xd9\x0a\x8b\xd0\x31\xd5\x92\x46\xc1\xb6\xd3\x12\x89\x80\x25\xbc\x83\x56\x1b\x3d\x3a\xa1\x10\x6a\xa6\x94\x76\xb0\xf2\x2a\x48\x5a\x34\x20\x2f\xc0\x6a\x6f\x43\x2d\x27\xb1\x71\x64\x46\xea\x4f\x15\x64\x2b\xa6\x88\xf6\xfc\x0e\x98\x7a\x76\x9d\x1f\x76\x64\x1d\x6a\x6b\x41\x32\xaa\xba\xb0\xd0\x31\xd3\x4b\xb4\x21\x11\xf2\x17\x52\xf9\xdd\x46\x7d\x80\x8e\x05\xe5\x93\x06\x19\x7f\x86\x15\xa2\x02\x83\x74\x01\x08\x3b\x34\x89\xe6\x25\x31\xfc\x1f\xb5\x30\xc6\x9e\x84\x30\x3f\x3d\x32\x5f\xd2\x44\x34\x70\x7d\xca\xca\xc5\x12\x6e\xa3\x82\x79\xfa\x52\x7e\x1b\x34\x17\x1b\x2c\x8f\x3c\xf7\x69\xcd\x7f\xae\x73\xa7\x5d\x1e\x6d\x16\x37\xbf\xf4\x4f\x6d\x4b\x7c\xf7\xcd\x49\x2f\xe5\x27\xdc\x12\x58\x93\x9d\x22\xdf\x0b\x22\x2d\x32\xe5\x57\xa7\x07\xb2\x3d\xa8\x9a\x57\x2f\xef\x66\x6a\xef\xf9\x51\x9a\x71\x7e\xe1\x69\x69\xca\xf7\x47\x73\xc7\xc9\x10\xf8\x78\xc3\x98\xbf\xbe\x60\xf8\xd9\xe4\x13\xeb\x71\x4e\x97\x05\x5e\xd2\x6b\xe1\x07\xff\x81\xc6\x0a\xad\xe6\xc0\xcb\xf8\xea\xc7\x77\xfe\x2f\x1a\x33\xa7\x4d\x6f\x35\x65\xd7\x4c\x52\x00\x57\x36\xbb\xe2\xf9\xdc\x37\x3c\x07\xda\x9e\xd0\xdc\x57\x9b\x59\x31\x79\x3f\x72\x78\xb6\x97\x79\x11\x45\x39\xde\x57\xce\x4a\xfd\xdf\x75\x37\x50\x09\x84\x6a\x3d\x65\xd5\x33\x0c\x4c\x89\x1a\x84\x72\x7a\x7f\xba\x87\xc6\x61\xce\x5f\x5b\x7a\x61\x57\xd8\x31\x2f\x07\x01\x0d\x59\xc6\x86\x23\x41\x00\xae\xb7\xea\xa8\x39\x23\xbf\x4b\xf8\x46\x57\x16\xc7\xea\x35\x5d\x85\x58\x08\x44\xb6\x82\x24\xa4\x6d\x91\x83\x61\xd4\xe1\x24\x06\x0a\x84\xaa\xe5\x48\x9a\x16\x2f\x3b\xf1\x9e\x11\x7b\xce\x7f\x45\xe3\xbd\xfa\xc5\x95\x7a\x74\xc3\x38\x5d\x35\x2e\xd0\xef\xff\xe9\xaf\xcc\xc0\xe9\x0d\x23\xf6\x98\xbf\xb3\xdf\x8f\xfd\x70\x2f\x0c\xfc\xb6\x84\xd9\x8c\x18\x99\x54\x15\xdc\x13\x67\x0d\x72\x68\x84\xb1\x6e\x5f\x4a\xaa\x0f\x1f\xfb\x01\x98\xb4\x3a\xac\x9c\x94\x66\x2f\x8e\x7e\xa1\x2f\xd3\x1a\x79\xb9\x3f\xef\x8f\x0f\xd3\xaa\xf2\x65\xf5\x9e\x48\xc6\x80\x0b\xd6\x2a\x6d\x9d\xa8\x81\x89\xa0\x79\x74\x22\xd0\x6e\x39\x1d\x2d\xb6\x46\x38\x24\xfd\xe6\x1a\xad\xfa\xdd\x41\xad\x47\xe5\x80\xb5\x4c\x28\xeb\x8e\x34\x8d\x62\x1e\x1d\x9d\xde\xee\x7e\xca\x34\x74\x32\x2f\xc0\x5c\x38\x41\x13\xa9\xdb\xf2\xc1\x08\xe5\x9a\x6c\x16\xc9\xe1\xcd\xc1\x57\x2a\x00\x26\x3a\x9e\x35\xc2\x5b\xc4\x47\x63\x5e\x9d\xf4\xaf\xaa\x71\x40\x1a\x77\x3d\xbb\x80\xec\x18\x98\xaf\xed\x44\xd0\x03\xa1\x8f\xd0\xfc\x5b\x5d\xd9\x37\x11\x15\x21\x99\xf2\x2b\x91\x3a\xa3\x0b\x63\x92\x18\x58\x9e\x30\xc4\xc7\xfc\x95\xa0\x5c\x56\x94\xb7\x24\x25\x6a\xca\x2f\x45\xc5\x67\xf7\xf3\x92\x16\xaf\xae\x76\x05\x84\x9f\x50\x05\x4c\xbf\x96\x8a\xa9\xa8\x07\x21\x39\x17\x71\xd1\x9c\x97\x99\x4c\x96\x13\x38\xed\xb0\xc9\x7c\xbd\x4e\x21\x5e\xc2\x35\x1d\x39\x17\x85\x79\x2a\x23\x40\x43\xc8\x03\x52\xff\xe9\xdf\x68\x6c\x42\x3d\x3f\xe0\x4f\x63\xa9\xa6\xdf\x77\x45\x9a\xec\xd2\x5d\xfa\x9f\x01\x00\xb9\x3c\x78\x35\xa2\x0f\x00\x00")
+
+func jujugenerateapidocFacadesGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocFacadesGo,
+		"jujugenerateapidoc/facades.go",
+	)
+}
+
+func jujugenerateapidocFacadesGo() (*asset, error) {
+	bytes, err := jujugenerateapidocFacadesGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/facades.go", size: 4002, mode: os.FileMode(436), modTime: time.Unix(1791998197, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocGoMod = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\x41\x4e\xc4\x30\x0c\x45\xd7\xf4\x14\x59\xc2\xa2\x8e\x9d\x30\x4d\x7b\x9c\x4c\x6a\x42\x86\xb6\x0e\x9e\xa6\xe2\xf8\x48\xc3\x6a\x2a\xb1\xb1\xac\xaf\xff\x9f\xde\x2a\x73\x5b\xd8\xe4\xb2\x7f\xb6\x2b\x24\x59\xed\xad\xdd\xda\xe3\xc4\x5a\x66\x49\x8f\x37\xf3\xc6\x1a\x77\xfe\x8b\xba\x4e\xf9\xbb\x15\x65\xf3\xda\xbd\xfc\xbf\x34\x07\x02\x02\xf6\x0e\x69\x24\xf4\x48\xee\xdd\x3b\xdf\x07\x1a\x43\xc2\x71\x22\xf7\x71\x35\xd6\x9a\xb2\xcd\x45\x39\xed\x4f\x28\x95\x5c\xb9\x56\xb6\x6b\xb9\x9f\x40\x34\x92\xa3\xc9\x87\x7e\xc6\x89\x2e\x03\x5e\x62\xa2\xe1\x04\x92\x25\x6e\x19\x44\xb3\xfd\xb1\xbb\xc8\x72\x3f\xbb\x20\x62\xa0\xa1\x8f\x18\xc9\x33\x06\x9f\xc2\xd9\x45\xea\x57\x86\xb2\x59\x56\xcd\x02\x87\x33\x87\x03\x02\x7c\x6a\xbd\x75\xbf\x03\x00\xbd\xff\x16\x03\x3f\x01\x00\x00")
 
 func jujugenerateapidocGoModBytes() ([]byte, error) {
 	return bindataRead(
-		_jujugenerateapidocGoMod,
-		"jujugenerateapidoc/go.mod",
+		_jujugenerateapidocGoMod,
+		"jujugenerateapidoc/go.mod",
+	)
+}
+
+func jujugenerateapidocGoMod() (*asset, error) {
+	bytes, err := jujugenerateapidocGoModBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/go.mod", size: 319, mode: os.FileMode(436), modTime: time.Unix(1541680712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocGoSum = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\xd3\x49\xb3\xa2\x48\x17\x06\xe0\x7d\xfd\x8a\xda\x1b\x4a\x26\xc9\x90\x7c\x11\xb5\x50\xc4\x2b\x28\x50\x5c\x05\xc4\x1d\x24\x33\x42\x32\x09\xc2\xaf\xff\xc2\xea\xe8\x68\x6b\x5a\x74\xdd\xde\xb0\x7c\x9f\xf3\x26\xe7\x24\x59\x9f\xde\x83\x15\xa1\x25\x93\xdf\xf3\xfb\xb7\xcf\xe7\x01\xac\xc0\x0a\x2c\x59\x00\x31\x04\x08\x00\xc8\x23\x9e\x5f\xf2\x11\x92\xc2\x08\x23\x88\x63\xf6\x73\x0a\xff\xc7\x75\x1b\x5d\xdb\x16\xe3\xb0\xbd\xfb\xb4\xb5\xd6\x44\xd4\xfb\x86\x13\x5b\x25\x30\xa7\x83\x7b\xde\x11\x13\x85\x2d\xa6\xfa\xa4\x80\x2f\x9f\xfe\xd0\x61\x12\xba\x2a\x69\xf8\xe4\x0c\xc5\x1e\x3c\xfb\x61\xae\x4b\x4e\x88\x1a\x70\xf3\xa4\x98\xad\x8a\x37\xff\xb8\x4f\xaa\x51\x92\x6e\x7b\xb5\x3b\xf7\x69\x97\x99\x09\xfd\x35\xe7\xd7\x59\x48\xc9\x8f\x28\x64\x39\x08\xa5\xa5\xc4\x62\x2c\x49\x30\xf0\x01\xe0\x9e\x9a\x9a\xe3\x4d\x50\x80\x7a\x8d\xf4\xf9\x2c\xaa\x84\x2b\x9a\x53\x5f\xab\x1b\x73\x7f\x85\xe6\xe9\xc1\x07\x94\x28\xb6\x78\xcd\xd6\x02\xfe\xa0\xf6\x52\xd1\xdd\x64\x4d\x54\xbe\xc9\xe5\xa8\xee\xa0\xc3\xbb\x8b\xaa\xea\x3d\x64\xc6\xed\x02\xe7\x57\xc8\x74\x27\x1c\xdd\x6c\xd1\xbe\x58\xe2\xbf\x45\x11\x8b\x96\x22\xc4\x22\x01\x58\x82\x6c\x1c\x3c\x2b\x4a\x0e\x33\xc9\x31\x59\x17\x92\xed\xea\x25\x74\x3d\x6d\x6d\x70\xa7\x86\x53\x2f\x79\x47\xbc\x6d\x27\x85\x74\x50\xcb\x24\x55\xba\xe4\x83\xda\x7f\x50\xb1\x68\x99\xba\x8d\xfa\x7e\x7a\x72\x70\x05\x5e\x22\xc3\xf5\x84\x6e\xa1\x78\x93\x62\x90\x05\x5b\xc3\xb4\xcc\xfd\x5e\xf7\x3c\x55\x0d\xd2\x38\xd8\x9f\x4a\x24\x73\xd7\x8e\x6a\xe6\xbb\xf1\xfd\x62\x3c\x23\x9f\x79\x70\x05\x57\xf0\x25\xaf\xde\x59\x5e\x25\x08\xee\xbe\x35\xeb\xaf\x9e\x71\xcb\x47\x53\x6f\x28\x04\xe7\xc2\x4b\x61\x3c\x21\xe2\x65\x94\xbd\xa1\x40\xee\xac\x1f\xf3\xfa\xe8\xd1\xff\x3c\x20\xa7\x05\xc3\x62\xab\xb9\xc8\x3e\x33\xc7\xcc\x1c\xb5\xc8\xb3\x22\x18\xc5\x4d\x6f\x3f\x98\xcc\x49\xfd\x92\xe1\x86\x38\x5c\xb7\x86\xfa\x5d\x60\x4b\x93\x3a\xaa\xeb\x88\xf1\xeb\x8c\xd0\xb2\xf6\xfb\x97\xb7\x16\x00\xcf\x8a\x10\x43\x9e\xe7\x96\x80\xf0\x30\x46\x3e\x8a\x25\xe1\xdb\xf2\xfa\x55\xae\x1a\x33\x34\xdc\x31\x76\x2f\x5a\x92\xee\x11\xb9\x4b\xfd\x54\xbd\x13\x27\x7c\x3f\x39\x4a\xe5\x1d\x4a\x45\x29\x7b\x19\x72\x1f\x07\x5f\x8a\xba\xaa\x63\x4e\x0a\x8b\xe2\x4b\xc5\x9f\x78\xd4\x9d\x8f\x15\x4d\xd7\x21\x6c\x76\x03\x95\xe9\x6c\xd8\x6f\xf6\xc3\x5a\x47\x3d\xdf\xd9\xbf\x76\xcb\xac\xfb\x61\x9f\x20\x86\x2c\x94\x90\xb8\x0c\x81\x04\x79\x01\xf0\x3e\x81\xc2\xb3\x23\x3f\xf8\x6c\xaa\xfa\xc4\xda\x34\x41\x33\xdf\xa9\xa5\xc4\x20\xe5\xde\xb5\x6d\xa0\x58\xc3\x42\x20\x8e\xed\x75\xca\x30\x32\x09\x1e\x3f\x64\xbd\xd4\x4b\xcf\xe2\x41\xd0\xd8\xa3\x31\xda\xbe\xdf\x88\x6d\x3f\xee\x78\x47\xdf\x48\x85\x25\x4d\xb1\xb8\x4f\xbc\x9d\xe1\x98\x66\xcc\x41\xf3\xf9\x1f\xe9\xcd\xaf\x92\x15\x6d\x13\xe6\xc1\xf4\x94\xde\xba\xef\x31\x04\x00\x00\x22\x14\x96\x3e\xf0\x21\x8a\x80\x88\x88\xf8\xed\x2c\xed\x8a\xcf\x0e\xfa\x90\x1c\xd5\x37\x7d\x7e\xd3\x61\xa9\xb9\x43\xc6\xb2\xbb\x4c\xbf\x48\x97\x8d\xc0\x18\xe6\xec\x1d\xe8\x24\x08\x13\xfe\x43\xe5\xa5\x52\x25\x1a\xf2\x3d\x24\x1b\xa6\x52\xe6\x87\xf3\x56\x1e\x83\xad\xeb\xf1\x75\xec\x9e\x8f\xcd\x86\xc8\xec\xe1\x2a\xe4\x93\x37\xe8\x5c\xf9\xdc\x75\x5a\x17\xc9\x2a\xab\x18\x92\x46\xa4\x58\x0d\xf0\x79\x3b\x7f\x5b\x40\x60\x31\x14\x11\x04\x78\x29\x62\x1c\x87\x22\xe6\x00\x64\xc5\x17\x4b\xa6\x42\x16\x38\xda\x7a\xae\xd6\xbe\x5a\x34\x35\x4e\xef\xe7\xf1\xa6\x59\xf2\x15\x40\x21\xa7\x31\x43\x02\x83\x73\x5c\xde\x9b\xc1\x8b\x15\xb5\x6d\x42\xff\xb1\x9e\x2f\x54\x2d\xc4\x4b\x2c\x4f\x53\xb2\xd9\x05\xb8\x53\xf2\x44\x10\x24\xf6\x91\xcb\x82\xdd\xf1\xe0\xbc\x7b\x37\x79\x6e\xf1\xf0\x6c\x65\xcc\x95\xdf\x07\xbd\x0e\xf6\x18\xbb\xf9\xc4\x5c\x66\x28\x73\x92\x4d\x42\x36\x13\xae\xd9\x8d\xb7\xcf\xf4\x2b\x54\xca\xa9\xdd\xa5\x07\x5f\x77\x82\x04\x96\xc5\xcf\x79\xec\xe7\x81\x5d\xc1\xbf\x06\x03\xc3\xf1\x0c\x91\x72\x1f\x2c\x90\x1a\x83\x5f\x8c\xc7\xfb\xee\xca\xe4\x5e\x7b\xac\xf9\x1d\x2a\x88\xbb\xbf\x84\xef\x49\x92\xcb\x0a\xfe\x7d\xd0\xcb\x60\xa9\xd1\x85\x50\xf1\x16\x01\x9d\xe5\x83\x07\xbd\xbe\x96\x84\xb8\xd6\xd1\x90\x6b\x41\x73\xd4\x2a\x8c\xc7\x0e\x5f\x86\x78\x6b\xa8\x5f\x3e\xfd\x7f\x00\x6d\x9c\x46\xa2\xca\x07\x00\x00")
+
+func jujugenerateapidocGoSumBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocGoSum,
+		"jujugenerateapidoc/go.sum",
+	)
+}
+
+func jujugenerateapidocGoSum() (*asset, error) {
+	bytes, err := jujugenerateapidocGoSumBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/go.sum", size: 1994, mode: os.FileMode(436), modTime: time.Unix(1541680712, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocJuju2Go = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x8f\xe3\x36\x0c\x3d\x5b\xbf\x82\xf5\x61\x61\x6f\x0d\xa7\xd8\xf6\x34\x40\x0e\x83\x6e\x3f\x81\x2d\x06\xed\xec\xb9\x50\x64\xc6\xd6\xc4\x96\x0c\x89\xca\x47\x07\xf3\xdf\x0b\x4a\xce\xc4\xce\x66\x3b\xbd\x04\xb2\xc8\xf7\xf8\xf8\x48\x65\xb5\x6a\xed\xdd\x26\xe8\xbe\x81\x6f\x9e\xc2\x53\xf8\x1e\xde\xbd\x4b\xa7\x1f\xc4\x6a\x05\xdf\xce\x43\xd5\x74\x2f\x46\xa9\x76\xb2\x45\x18\xa4\x36\x82\xd3\x1e\x3b\xed\x61\xab\x7b\x84\xce\xf6\x8d\x07\xea\x10\x94\x6d\x10\xa8\x93\x04\x0d\x8e\x68\x1a\x0f\xd6\xc4\x40\x2f\x4f\x36\x10\xd8\x2d\x23\x7f\x0f\x4f\x01\x3e\xd4\x47\x20\x87\xe8\x2b\x38\x74\x5a\x75\xa0\x3d\x04\x8f\x0d\x1c\x3a\x34\x60\x2c\x70\xdd\xf7\x90\xb4\x90\x6c\x39\xee\x91\x6a\x21\xf4\x30\x5a\x47\x50\x88\x2c\x6f\x35\x75\x61\x53\x2b\x3b\xac\x38\x3d\xfd\xc8\x51\x7b\x74\x7b\x74\x2b\x65\x87\xc1\x9a\xfc\xed\xc4\xad\x54\xb2\xc1\xaf\x26\x8e\xe8\x06\xed\xbd\xfe\x0f\x2e\x4f\x92\x12\x81\x1d\x77\x6d\xad\x4d\x8a\x19\x39\xa0\xaf\xf7\x1f\x72\x51\x0a\xa1\xac\xf1\x04\xa3\x74\x72\xf0\x0f\xbb\x16\xd6\xf0\x96\xae\x94\x9b\x47\xbf\x53\x4f\x3f\x39\x67\x5d\x44\x5f\x5c\x1f\x25\x75\x60\xb7\xd3\x39\x8d\x89\x87\xc0\x28\x65\xcd\x1e\x1d\x79\xc0\x08\x04\xb2\x53\x1a\x13\xd7\x91\x0d\xf6\xb2\x0f\xc8\xee\x1a\x02\x6b\x18\xc5\x29\x07\xed\xb0\x82\x41\x8e\xa3\x36\x6d\x0c\x6a\x83\xfd\x8c\x27\x9e\xe2\xc8\x7d\x3d\xf5\x76\xad\x71\x0d\xff\x77\x44\x5c\x55\xc9\xbe\xff\x59\x2a\xb2\xee\x14\xcf\xa9\xbb\xed\x74\xb3\xb5\x2e\x7e\xb7\x7a\x8f\x06\xd2\xc4\xe0\xa0\xa9\x63\xa8\x04\x65\x0d\xe1\x91\xd2\xf6\x05\x8f\x7e\x96\x2c\x03\x75\xd6\xe9\x7f\xd0\xd5\x62\x1b\x8c\x9a\x57\x2a\x9a\x89\xab\xfe\x88\x24\x75\xef\xab\x98\x3e\xc3\x94\xa9\x67\x78\x16\xd9\xdf\x15\x9f\xe1\x6e\x0d\x4d\x7d\xc6\x4f\x85\x9f\x45\x96\x31\xe6\x2e\x22\x2b\x91\xbd\x94\x22\x73\x48\xc1\x19\xc6\x88\x97\x38\x44\xed\x1f\x5e\x77\x29\xda\x04\x0e\x79\x9b\x3d\xaf\x3d\x75\xe8\x38\x97\x57\x9d\xc5\xc7\xb2\x8c\x4a\x34\xd8\xc0\xe6\x74\x6e\x3c\xb9\xa2\x63\x9b\xf1\xb9\x99\x13\x48\xa5\xd0\xfb\xa9\xc5\x2f\x2a\x15\xcc\x1c\x29\x4b\xd8\x58\xdb\xc3\xf3\x5c\x1f\xac\xd7\x90\x66\xc1\x4b\xc1\x50\x56\x4c\xa7\x11\x5f\x9d\xf5\xe4\x82\x22\x86\x5d\x19\x24\xb2\xc9\xc1\x1f\x93\x15\x8c\x8c\x1a\x0a\x75\x06\x97\x70\x1f\xa8\x2b\xca\xb3\xd7\xf7\xaf\xe0\x99\x0c\x55\x33\xf1\x4d\xf4\x6f\x1f\x8b\x12\x3c\x39\x5e\xc5\x0b\x20\xcf\x6f\x26\xff\xc5\x6f\xb1\x28\xe1\x7d\x7c\x94\x75\xfc\x9c\xa1\x0c\x1e\x8a\x59\xa4\xbc\xc9\xf1\x27\x7a\x1b\x9c\x42\x7f\xd1\xfc\x7a\x35\xe7\xd2\xfd\xd7\x25\x3c\x58\xdb\x5f\xc9\x78\x58\x1a\x7f\x25\x85\xa3\xb7\xe5\xb0\xb3\xce\xf6\x3d\xba\x47\xd9\x16\x25\xa4\xff\x95\xc5\xed\x9c\x36\x46\xff\xc0\xc3\x12\x96\x1f\x8f\xc7\x63\x3e\x2b\x20\x17\x5b\xfe\xab\x9c\xad\x4c\x61\x47\x74\x92\xb4\x35\x70\xf9\xf7\xab\xef\xe3\x86\x55\x40\xd2\xb5\x48\x53\x9d\x47\xd9\x96\x50\xf0\x4a\x55\xe7\xfd\xe2\x1d\xa9\xf9\x91\x61\x53\xe4\x0b\xde\xfc\xf2\x2a\xc8\x05\xac\x96\x0e\x2e\x05\xfd\x82\xc4\x7b\x32\xef\x78\xea\xf3\x42\x7e\xc9\x61\x66\x7f\xd0\xa4\x3a\x90\xf5\x4e\x9b\x86\x0d\x51\xd2\x23\xf0\xc7\xc5\x89\xcf\x1e\x5d\x15\xef\x3e\xd9\x06\x7b\xfe\xbc\x13\xd9\x17\xd6\xf1\x3d\x57\xce\x37\x76\x93\x97\x33\xa6\xcf\x46\xd3\x7d\x8b\x86\x6e\xa2\x8c\xa6\xc9\xea\xd5\x77\x0b\xd8\x27\xa9\x3a\x6d\x30\x22\xab\x2b\x49\x53\xec\x16\xe1\x14\x8a\x9c\x91\xf0\x45\x64\xa3\x34\x5a\x15\x79\x30\x3b\x63\x0f\x06\x76\xda\x34\x79\x29\x5e\xc4\xbf\x03\x00\x76\x8d\x30\x15\xd8\x07\x00\x00")
+
+func jujugenerateapidocJuju2GoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocJuju2Go,
+		"jujugenerateapidoc/juju2.go",
+	)
+}
+
+func jujugenerateapidocJuju2Go() (*asset, error) {
+	bytes, err := jujugenerateapidocJuju2GoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/juju2.go", size: 2008, mode: os.FileMode(436), modTime: time.Unix(1791997344, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocJuju3Go = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x55\x4b\x6f\xeb\x36\x13\x5d\x8b\xbf\x62\xa0\x95\xf4\x7d\x82\x54\xe0\x76\x15\xc0\x8b\xa0\xb7\x4f\xe0\x16\x41\x9b\xbb\x2e\x68\x6a\x24\x31\x96\x38\x02\x39\xb2\xe3\x06\xf9\xef\xc5\x50\xb2\x2d\xfb\x3a\xb8\x9b\x84\x8f\x73\xce\x9c\x79\x50\xae\xaa\x96\x1e\xb6\x93\xed\x6b\x78\x99\x5e\xa6\x4f\xaa\xaa\xe0\xff\xeb\xbd\x1a\xb5\xd9\xe9\x16\x61\xd0\xd6\x29\xb9\x7e\xee\x6c\x80\xc6\xf6\x08\x1d\xf5\x75\x00\xee\x10\x0c\xd5\x08\xdc\x69\x86\x1a\x47\x74\x75\x00\x72\xf1\xa2\xd7\x47\x9a\x18\xa8\x11\xe6\x1f\xd3\xcb\x04\x9f\xca\x57\x60\x8f\x18\xa0\xf1\x34\xc0\x16\x1b\xf2\x08\x8d\x36\xba\x8e\xff\x98\xbc\xc5\x00\x4c\xb4\x03\x2d\xac\x81\x6a\xec\xc1\x90\x63\x7c\xe5\x02\x02\xf6\x68\x18\x6b\xd8\x1e\x63\x84\x68\x13\x66\xcb\xac\xdb\x52\x29\x3b\x8c\xe4\x19\x32\x95\xe8\xd1\x06\xf4\x7b\xf4\xe8\x3d\xf9\x00\x69\x6b\xb9\x9b\xb6\xa5\xa1\xa1\x12\xde\xfc\xe7\x8c\xaa\x66\x58\xaa\x92\xef\x01\x67\xbb\x1f\x02\x0d\x79\xac\x46\xf4\x83\x0d\xc1\x92\xfb\x10\x17\x58\xf3\x5d\x15\xa7\x07\x0c\xd5\xfe\xc7\x54\xe5\x4a\x19\x72\x81\x61\xd4\x5e\x0f\xe1\x69\xd7\xc2\xe6\x83\x3c\xfc\x68\xaa\x19\x95\xc6\x46\xcd\xa9\xff\x1c\x73\x12\xde\xa5\x5d\xa3\xe6\x0e\xa8\x59\xd6\x73\x7f\xa5\x7b\xc2\x32\xe4\xf6\xe8\x39\xc0\x52\x33\xa6\x05\x26\xc2\x65\x54\x83\xbd\xee\x27\x0c\x10\xd0\x31\x90\x13\x96\x40\x0e\xd6\x63\x01\x83\x1e\x47\xeb\xda\x78\x69\x1d\xf6\x2b\x9d\xb8\x8a\xb3\x12\xca\x25\xab\x5b\x8f\x1b\xf8\x5e\xe9\x4f\x3d\x92\xa8\x46\xf7\xfd\x2f\xda\x30\xf9\x63\x5c\xcf\xd9\x35\xcb\x49\x43\x3e\xee\x5b\xbb\x47\x77\x9a\xb0\x83\xe5\x4e\xa8\xfa\x34\x51\xf3\xd8\x4e\x01\xc3\x0a\xac\x27\xee\xc8\xdb\x7f\xd1\x97\xaa\x99\x9c\x59\x47\xca\xea\x45\xab\xfc\x8c\xac\x6d\x1f\x8a\x08\x5f\x71\xf2\x39\x67\x78\x53\xc9\x3f\x85\xac\xe1\x61\x03\x75\x79\xe2\x2f\x81\xdf\x54\x92\x08\xe7\x21\x32\x0b\x95\xbc\xe7\x2a\xf1\xc8\x93\x77\xc2\x51\xef\xb1\x89\x36\x3c\x9d\xe7\x28\x96\x09\x3c\xca\x78\x07\x38\x74\xc8\x1d\x7a\xc1\x82\x9d\xcd\xc7\xb0\xc2\x9a\x65\xe6\x47\xf2\xed\xd3\x92\x84\x6b\x74\x47\xd0\xc6\x60\x08\x4b\x8a\xdf\x44\xca\x44\x39\x4a\xe6\xb0\x25\xea\xe1\x6d\xed\x0f\x36\x1b\x38\x77\x25\xa2\xe2\x74\x88\x86\x58\xe7\xe3\x88\xe7\x12\x07\xf6\x93\x61\xe1\xdf\x54\x4a\x25\x4b\x29\x7f\x9a\x6b\x22\xcc\x68\x26\x33\x27\x72\x0e\x8f\x13\x77\x59\x7e\x2a\xfa\xe3\x99\xbc\xf2\x63\x4a\x11\xbe\xcb\xfe\xfd\x73\x96\x43\x60\x2f\x33\x79\x21\xa4\xe9\x5d\xf0\xdf\xf2\x20\xb3\x1c\xfe\x17\x5f\x66\x19\xb7\x2b\x96\xc3\x43\xb6\xba\xc9\xef\x6a\xfc\x85\x81\x26\x6f\x30\x5c\x3c\x9f\x8f\xd6\x5a\xb6\xff\xd8\xc2\x13\x51\x7f\x63\xe3\xe9\xba\x03\x37\x56\xe4\x76\x65\x47\x5f\x4d\xe3\x6f\x7a\xd5\xda\x8c\x46\xf4\x9a\x2d\x39\xb8\x7c\xa1\xca\xc7\x38\x09\x05\xb0\xf6\x2d\x32\xc4\xef\x4f\xf9\xac\xdb\x1c\x32\x69\x7d\x71\x9a\x03\x69\x61\x29\x8f\x01\xeb\x2c\xbd\xd2\x4d\x2f\xd3\xcb\x7e\xc2\xe2\x3a\xc1\x6b\x43\xbf\x22\x4b\x1b\x9f\x75\x9b\xe5\x97\x58\xd7\xe2\x17\x8c\x28\x87\x83\x65\xd3\x81\x2e\x77\xd6\xd5\x02\x34\x3a\x20\xc8\x46\x26\xc7\x53\xdf\xa3\xff\x1a\xd0\x17\xf1\xec\x8b\xfc\x64\xc8\xf6\x41\x25\xe7\x82\xc5\x30\x7f\xe2\x41\xce\x25\x72\xba\xa5\x6d\x9a\xaf\x94\xbe\x3a\xcb\x8f\x2d\x3a\xbe\xcb\x72\x96\x23\xeb\xf5\xb5\xfa\xe1\x8a\xf6\x45\x9b\xce\x3a\x8c\xcc\xe2\xc6\xd2\x72\x77\x4f\x70\xb9\x8a\x9a\x51\xf0\x5d\x25\xa3\x76\xd6\x64\xe9\xe4\x76\x8e\x0e\x0e\x76\xd6\xd5\x69\xae\xde\xd5\x7f\x03\x00\xc2\x15\x3e\x93\xa6\x07\x00\x00")
+
+func jujugenerateapidocJuju3GoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocJuju3Go,
+		"jujugenerateapidoc/juju3.go",
+	)
+}
+
+func jujugenerateapidocJuju3Go() (*asset, error) {
+	bytes, err := jujugenerateapidocJuju3GoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/juju3.go", size: 1958, mode: os.FileMode(436), modTime: time.Unix(1791997344, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocJuju4Go = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4b\x6f\xe3\x36\x10\x3e\x8b\xbf\x62\xa0\x93\x84\x0a\x52\x0f\xdb\x4b\x00\x1f\xd2\x37\x0a\xa4\x58\x14\xd9\x73\x31\xa6\xc6\x12\x63\x89\x14\xc8\xa1\x1f\x0d\xf2\xdf\x8b\xa1\x64\x5b\xc9\x66\x91\xbd\xd8\x7c\x7c\xdf\x37\xdf\x3c\xa8\xa6\xe9\xdc\xdd\x36\x9a\xa1\x85\xa7\xf8\x14\x3f\xa9\xa6\x81\x1f\xd6\x7b\x35\xa1\xde\x63\x47\x30\xa2\xb1\x4a\xae\x1f\x7b\x13\x60\x67\x06\x82\xde\x0d\x6d\x00\xee\x09\xb4\x6b\x09\xb8\x47\x86\x96\x26\xb2\x6d\x00\x67\xd3\xc5\x80\x67\x17\x19\xdc\x4e\x98\x7f\xc5\xa7\x08\xec\x89\x02\x1c\x7b\x17\x08\x76\xa8\xb1\x4d\x7f\xec\xbc\xa1\x00\x8c\x7b\x02\x84\xd1\xb5\x34\x80\x76\x96\xe9\xc4\x95\x50\x31\x80\xb1\xb3\xc0\xa7\xfa\x54\x41\xa0\x81\x34\x53\x0b\xdb\x73\x8a\x93\xcc\xc2\x6c\x9c\xb1\xab\x95\x32\xe3\xe4\x3c\x43\xa1\xb2\xc0\xed\x22\x05\xf9\xb2\xc8\x95\xca\x70\x32\x81\xfc\x81\x3c\x79\xef\x7c\x80\xbc\x33\xdc\xc7\x6d\xad\xdd\xd8\x88\xdc\xfc\x73\x45\x35\x33\x2c\x57\xd9\x47\xc0\x39\xab\x6f\x02\xb5\xf3\xd4\x4c\xe4\x47\x13\x82\x71\xf6\x3d\x9c\xc5\x91\x42\x73\xf8\x29\x57\xa5\x52\xda\xd9\xc0\x30\xa1\xc7\x31\x7c\xde\x77\xb0\xf9\x86\x53\x3f\xe9\x66\x46\xe5\xa9\x4f\x73\x72\xbf\x25\xd7\xc2\xbb\x75\x6b\x42\xee\xc1\xed\x96\xf5\xdc\x5e\x69\x9e\xb0\xb4\xb3\x07\xf2\x1c\x60\xa9\x0a\xbb\x05\x26\xc2\x75\x52\x83\x03\x0e\x91\x02\x04\xb2\x0c\xce\x0a\x4b\x20\x47\xe3\xa9\x82\x11\xa7\xc9\xd8\x2e\x5d\x1a\x4b\xc3\x4a\x27\xad\xd2\xa8\x84\x7a\xc9\xea\xad\xc7\x0d\x7c\x54\xdc\x4b\x17\x24\xaa\xc6\x61\xf8\x1d\x35\x3b\x7f\x4e\xeb\x39\xbb\xdd\x72\xb2\x73\x3e\xed\x3b\x73\x20\x7b\x19\xb5\xa3\xe1\x5e\xa8\x78\x99\xae\x79\x6a\x63\xa0\xb0\x02\x63\xe4\xde\x79\xf3\x1f\xf9\x5a\xed\xa2\xd5\xeb\x48\x45\xbb\x68\xd5\xbf\x12\xa3\x19\x42\x95\xe0\x2b\x4e\x39\xe7\x0c\xcf\x2a\xfb\xb7\x92\x35\xdc\x6d\xa0\xad\x2f\xfc\xdb\x38\xd6\x3f\xa3\xde\x77\xde\x45\xdb\x16\x65\x75\x71\xf4\xac\xb2\x4c\xc4\xee\x92\x64\xa5\xb2\x97\x52\x65\x9e\x38\x7a\x2b\x62\xea\x25\x75\xd7\x84\xcf\xd7\x11\x4a\xf5\x03\x4f\x32\xf0\xf2\xb4\x88\x7b\xf2\x82\x05\x33\x67\x95\xfc\x08\x6b\x96\x99\x9f\xcd\xd7\x8f\x4f\x2a\xd1\x92\x3d\x03\x6a\x4d\x21\x2c\xb9\x7f\x15\xa9\x10\xe5\x24\x59\xc2\xd6\xb9\x01\x9e\xd7\xfe\x60\xb3\x81\x6b\xbb\x12\x2a\x8d\x8d\x68\x88\x75\x3e\x4f\x74\xad\x7d\x60\x1f\x35\x0b\xff\x4d\x09\x55\xb6\xd4\xf8\x21\x0e\x6c\x1e\xe4\x73\xf0\xcb\x5c\x1d\xd1\x48\xb6\x0a\x7d\x91\x29\xe1\x3e\x72\x5f\x94\x97\xbe\xdc\x5f\x65\x56\xce\x74\x2d\x21\xde\x65\xff\x43\xc1\x45\xaf\x29\xdc\x24\xae\x47\x2b\x05\x6b\x86\x1b\x1d\x5f\xf5\xfb\x4f\x5c\xd5\xa8\xd0\x7c\x82\x55\x93\x17\xe3\x15\xb8\x89\x3c\xb2\x71\x16\x6e\xaf\xbf\xbe\x4f\xa5\xae\x80\xd1\x77\xc4\x90\x5e\x7e\xfd\x88\xdd\x6a\x88\xb0\x96\xf1\xa3\xb6\xc8\x5f\xc5\xc9\xcb\xef\xb1\xf6\x07\xb1\xd4\xe3\x11\xbb\xa2\xbc\xa9\xbf\x96\xbd\x61\x44\x33\x1c\x0d\xeb\x1e\xb0\xde\x1b\xdb\x0a\x50\x63\x20\x90\x8d\x64\xe2\xdd\x30\x90\xff\x12\xc8\x57\xe9\x2c\xf5\x46\xb6\x77\x2a\xbb\xda\x49\x61\xfe\xa6\xa3\x9c\x4b\xe4\x7c\xeb\xb6\x79\xb9\x52\xfa\x62\x0d\xdf\x77\x64\xf9\x5d\x96\x35\x9c\x58\xa7\x53\xf3\xe3\x2b\xda\x03\xea\xde\x58\x4a\xcc\xea\x8d\xa5\xe5\xee\x3d\xc1\xe5\x2a\x69\x26\xc1\x17\x95\x4d\x68\x8d\x2e\xf2\x68\xf7\xd6\x1d\x2d\xec\x8d\x6d\xf3\x52\xbd\xa8\xff\x07\x00\x93\xc1\xc7\x94\x11\x07\x00\x00")
+
+func jujugenerateapidocJuju4GoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocJuju4Go,
+		"jujugenerateapidoc/juju4.go",
+	)
+}
+
+func jujugenerateapidocJuju4Go() (*asset, error) {
+	bytes, err := jujugenerateapidocJuju4GoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/juju4.go", size: 1809, mode: os.FileMode(436), modTime: time.Unix(1791997344, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocLeaseGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x56\x5d\x6f\xdb\xb8\x12\x7d\x96\x7e\xc5\xc4\x0f\xad\xd4\xea\xca\xef\xbe\xf0\x4b\x93\xde\xde\x62\xb3\xad\xbb\xc9\x2e\x76\x11\x04\x05\x4d\x8d\x24\xd6\x32\x49\x90\x94\x13\x23\xcd\x7f\x5f\x0c\x29\x4a\x72\x3e\x0a\x04\x0e\x45\x72\xce\x70\xce\x9c\x19\x52\x33\xbe\x63\x0d\xc2\x9e\x09\x99\xa6\x62\xaf\x95\x71\x90\xa5\xc9\xa2\x51\x4b\x66\xdd\x22\x8c\x9c\xda\xa1\x8c\xe3\xa3\x46\x4b\x63\xeb\x8c\x90\x8d\x5d\xa4\x34\x2f\x5c\xdb\x6f\x4b\xae\xf6\xcb\x1f\xfd\x8f\xde\xff\x30\x2d\x2a\xc5\x97\xe1\x1f\x19\x34\xaa\x63\xb2\x29\x95\x69\x96\xf7\x4b\xa7\x54\x67\x97\x8d\x5a\x0e\x27\xb0\x8b\x34\x4f\xd3\xe5\x12\x3a\x64\x16\x7f\xc3\xe3\x9d\x32\x95\x85\x56\x75\x95\x05\xd7\x22\x84\x6f\xd7\x32\x57\x40\xad\x7a\x59\x81\x90\x7e\x41\xb2\x3d\x82\x32\x30\x00\x11\x86\x66\xae\x05\x55\x03\x83\x9a\x71\x56\x61\x01\xb6\x55\x77\xde\x18\x84\x83\xc6\x30\xe9\x6c\xf0\x64\x0b\x60\x04\x8b\xf4\x59\xa1\xb1\xad\xd0\x04\xc1\x64\x05\x56\xc8\xa6\xef\x98\x19\x50\x2c\x54\xaa\x4c\x0f\xcc\x3c\x39\xe3\x1a\x6e\x6e\x03\x19\x0f\x8b\x09\x64\x51\xc0\x22\x02\xd0\xd8\xdb\x2c\x1e\x7d\x8c\xc2\x5e\xd2\xd7\xff\x3c\x2c\x18\x24\xd6\x2d\xdc\xb5\xe8\x5a\x34\xfe\x30\xc1\x23\xdc\x09\xd7\xfa\xef\x46\x1c\x50\xfa\x50\x0b\x0f\xb0\xd7\x1d\xee\x51\x3a\x1c\x69\x18\xc2\x7f\x6a\x42\x54\x14\xa7\x11\x97\x69\xdd\x4b\x7e\x7a\x88\xcc\x43\x83\xde\x35\x1b\xe2\x2e\x84\x93\xc3\x56\xa9\x0e\x1e\xd2\xc4\xe1\xbd\x83\xd5\x7a\x98\xb7\xe5\xb5\xba\x54\x77\x68\xbc\x15\xbc\x87\x05\x2c\xe0\x7d\x34\xce\xd3\xa4\x56\x06\xbe\x17\xb0\x0b\x59\x24\x43\xc3\x64\x83\x4f\x78\x7b\x48\x93\x44\xd4\x23\xe6\xb9\x92\x8e\x09\x69\x33\x72\x36\x1a\xe7\xe4\x3e\x49\x0c\xba\xde\x48\x70\xa6\xc7\x34\x49\x1e\x53\xfa\x1b\xe6\x6a\xd6\x59\x4c\x1f\x27\xf1\x6c\x98\x61\x7b\x74\x68\x2c\x84\x2d\x21\xbf\x55\x6f\x98\x13\xca\x7f\x31\x07\x8d\x3a\x20\x21\xb6\xc3\xb1\x2c\xd9\x7b\x9e\xb0\x82\xed\xf1\xc5\x2c\x7c\x52\xe0\x8e\x1a\x41\x3b\xaf\x8f\x89\xe5\x3d\xba\x56\x55\x76\x45\x18\x2f\xf8\xa2\xa9\x61\x0b\x70\xb5\xd7\xcc\x20\xb0\x86\x82\x75\x05\xd8\x9e\xb7\x83\x06\xc9\xbc\x53\xb2\x41\xeb\x80\x77\x4c\xec\x81\x75\x9d\xba\xc3\x8a\x24\x1f\x46\x74\x34\x26\x8f\xa0\xbc\x54\xa2\x1f\xe0\x4a\x5a\x47\xaa\x26\x88\x0a\x79\xc7\xcc\x24\x8d\x20\xa6\xb7\x36\x96\xc8\x89\x4f\xa8\xb0\x66\x7d\xe7\xa0\x43\xd9\xf8\xb2\x21\x08\x16\x48\x19\xa4\xf2\x84\xd6\x4c\xef\x1a\x78\x37\x80\xd9\x72\x13\x51\xb5\x83\x77\xc4\x8f\x2d\xaf\x8f\x1a\xbf\x78\x45\x85\xb0\x69\x6c\xc7\x32\xc9\xe1\xe6\x36\xb4\x85\xf2\xf2\x04\x99\x72\x4d\xf5\xa5\x69\xc2\xbe\xb6\x2b\x4d\x78\x8b\x7c\x87\x5e\x58\x7b\xb6\xc3\x6c\xcf\xf4\xcd\xe0\xf9\x9c\x88\xb8\x25\xdd\x4e\x3a\xf4\x2a\x1d\x45\x38\x3f\x11\x69\x8b\xd8\x2a\x80\x7e\x37\xbb\xa6\x00\x34\xc6\xe3\xfa\x5d\x17\xc8\x3b\x8a\x96\x62\x0b\x38\x79\x10\x2d\xed\x3a\x5b\x83\x14\x1d\xfc\xfc\xe9\x8d\xcb\x0f\xaa\x3a\xc2\xfa\x64\x6e\xb3\x6b\x3c\x15\xf6\xb3\xac\x55\x5c\x23\x9f\x09\x57\xd2\x09\x19\xc5\x9c\x38\x41\x1b\x56\xeb\xe7\x56\x69\x92\x30\xeb\xca\xcf\xd2\x6a\xe4\x2e\x1b\x3d\x15\x40\x99\xc9\x24\xd0\xea\x17\x55\xe1\x54\xac\x49\xb2\x2d\x40\xed\x28\x0a\x59\x66\xef\x68\xc3\x07\x21\x99\x39\x7e\xbc\xd7\x26\xa7\x75\x51\xc3\x99\xda\xd1\xc9\xcf\x84\xfd\x6a\x2a\xa4\x6a\xcf\xb6\xe5\x57\x3d\x94\xdb\x93\x7a\xf3\x67\x8c\x6c\x5a\x51\xcd\xd8\xbc\xb9\x25\x7c\x42\x7e\xd8\x96\x7f\x17\xb0\x2d\xff\x79\x1c\x30\xdc\x81\xb6\xf9\xd0\x42\x3c\x37\x64\x7a\xeb\xd7\x44\x0d\xee\x50\xfe\xc5\xba\x1e\x67\x9c\x9d\x09\x7b\x31\x28\x3a\x73\x07\x6f\x14\x0f\x34\x67\xcc\x37\x80\x24\x49\x0e\x64\x3e\x86\xda\xef\xd1\x08\x7e\xe5\x15\x16\xad\x8b\xd1\x4b\x1e\xdd\x52\xe0\xaf\x43\x6a\x82\x7a\x51\x75\x83\x8d\x07\x5b\x01\x04\xdf\x61\xee\x77\xaf\x95\xd5\xd0\x9e\x69\x26\x39\x27\x85\xae\xc0\xb7\x0b\xeb\xe9\x19\x0e\xb6\xcd\x8b\x99\x3b\x51\x03\x27\x8f\xbd\xc5\xca\x2b\x37\xf3\x74\x05\x92\xf3\xff\x02\x8f\x22\x7b\xf3\x66\xec\x93\xff\x67\x76\x63\xb0\x16\xf7\x19\x2f\x37\xbb\x26\xcb\xcb\x0d\x73\x6d\x96\xbf\x5f\x2c\x17\x05\xd0\xcd\xbb\xd9\x35\x61\xc7\x44\x1e\x1d\x07\xab\x1b\x7e\x0b\xeb\x31\xa9\x49\xa2\x4b\x2a\x03\x58\xc3\x29\x12\x75\xf4\x92\x3a\x3a\xf7\xeb\x59\xe0\x2e\x59\x2e\xe1\x9a\x7a\x9b\xe2\xd4\xc4\xe8\xf6\x01\x61\x81\x49\xc0\x7b\x67\x58\x01\x56\x01\x1b\x7a\x8f\x4f\xe1\x68\xe5\x7b\x20\x67\xf2\xad\x83\x2d\xc6\xcb\xdb\xd2\x67\xcd\x1c\xeb\xca\x78\x98\x0b\xc5\x0b\xf8\x0e\xeb\x40\xee\x85\xe2\xe7\xc1\x4d\xa8\x40\x9e\xcf\xf3\x14\x3a\x04\xe5\x4a\xa3\xac\xb2\xd0\x31\x0a\xd0\xf9\x28\xd7\x53\x09\x3f\xe6\xfe\xce\xf0\xf9\xad\x85\xac\x86\xa6\x15\x8b\xfb\x24\xfe\x3c\xa5\x12\xd7\x33\x61\x6a\xaf\x45\x1b\x67\x1e\xd2\x11\x3e\x38\xf6\xd8\x96\x2b\xed\x2b\x63\xd8\x5d\x5e\xd1\x44\xf6\x5a\x1f\xf2\xdb\x3d\xc1\x36\x0b\x89\xe2\x51\xcb\x61\xe9\x52\xa9\x5d\xaf\xfd\x1d\x9b\x97\xd9\xbc\xbf\xe5\xe9\xbc\x86\x67\xc9\x7d\x52\x43\xdc\x1f\x24\xcb\x07\x1d\xcc\xf4\xfe\x98\xfe\xb2\x7c\xa2\x61\x01\x9c\x8a\x34\xcb\x67\x1e\x5f\x82\xaa\x42\xe6\x56\xbf\x4a\xdd\x6b\x29\x7b\xbd\xda\x88\x9b\x15\x80\xa6\xe4\x90\x32\x47\x5d\x8e\x85\x36\x54\xe3\x54\x8c\x17\x8a\xaf\x00\x48\xa3\xc5\x94\xf4\xd3\x54\xc5\x27\x58\xec\x7b\xcf\xde\x5f\x4a\x83\xa2\x35\x0b\xc2\x59\x50\x1a\x0d\x93\x95\xf5\xef\x2e\x66\x81\x05\xbe\xe3\xfd\x0d\x0c\x3a\xb1\x17\x0e\x2a\x35\x7b\x59\x8d\x3d\x55\x69\xf0\x8f\xe7\xf2\x9a\x7e\xa7\x1e\x6d\xef\x84\xe3\x2d\x28\x4d\x1f\x9c\x59\x1c\xb6\x5d\x5e\x5d\x15\x71\xf8\xf1\x5b\x1c\x7e\xba\xfe\x63\x1c\x7e\xfc\xb6\x9a\xd4\x17\x4a\xf9\xe5\xb7\xd0\xd8\x53\x4e\x5e\x41\xc3\xad\xfd\x9f\x0e\x0f\xd8\x8d\xcf\x06\xff\x24\x22\x23\x04\x83\x35\x85\xee\x54\x41\x8f\x6a\x52\xbb\xa8\x61\x88\x8f\x4a\xd6\xaf\x83\x53\xa0\x64\x7c\x1e\x3c\xe9\x5e\xf1\x11\x40\xd7\x57\x01\x08\xf1\x82\xc8\xe3\x82\xdf\x1b\xef\x7a\x51\x81\xbf\xa2\x3e\x57\x28\xdd\x48\x8c\xaf\x93\x5e\x6a\x66\x50\x66\x98\x97\x99\x8b\x77\x81\x27\x6b\xb2\x20\x32\x44\x05\x6b\xc0\xf9\xd2\x15\x76\xc8\x9d\x32\xd4\x78\xa7\x1d\xe5\x15\x76\x69\x32\xbc\x77\x66\x2c\x4a\xd1\x79\x12\xc7\xfa\xf3\x61\x94\x7f\x5a\xb4\x37\xa2\xba\x7d\x56\x7b\xb3\xd2\x23\x69\x66\xf9\xac\x53\xf0\x72\x43\x67\x76\x59\x0e\x67\x53\x5f\x1d\x3a\x01\x3c\x3c\x77\x6a\xd0\xf5\x46\x02\x4f\x1f\xd3\x7f\x07\x00\xd0\xcc\xa4\xb0\x8d\x0d\x00\x00")
+
+func jujugenerateapidocLeaseGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocLeaseGo,
+		"jujugenerateapidoc/lease.go",
+	)
+}
+
+func jujugenerateapidocLeaseGo() (*asset, error) {
+	bytes, err := jujugenerateapidocLeaseGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/lease.go", size: 3469, mode: os.FileMode(436), modTime: time.Unix(1791997837, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocLoaderrorsGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x55\x51\x6f\xdc\x36\x0c\x7e\xb6\x7e\x05\x6b\x20\xa8\xbd\xb9\xba\xe4\xb5\xc0\x15\x18\xb0\x3c\x6d\x6b\x83\x75\x6f\x87\xa0\xd0\x59\xb4\xad\x9c\x2c\x19\x12\x9d\xcb\xa1\xc8\x7f\x1f\x28\xd9\x77\xd7\x6e\x2d\x10\x04\x8e\xf9\xf1\xe3\x47\x7e\x34\x33\xa9\xf6\xa0\x7a\x84\x51\x19\x27\x84\x19\x27\x1f\x08\x2a\x51\x94\xdd\x48\xa5\x28\xca\x80\x9d\xc5\x36\x3d\x46\x1f\xa8\x14\xa2\x28\x7b\x43\xc3\xbc\x97\xad\x1f\x37\x4f\xf3\xd3\x9c\x7f\xa9\xc9\x44\x0c\xcf\x18\x36\x9d\x6a\x95\xc6\xf2\x47\xc0\x30\xb5\x9b\x30\xb5\x57\xc4\xbd\xb7\xca\xf5\xd2\x87\x7e\xf3\xb2\x21\xef\x6d\xdc\xf4\x7e\xb3\x28\x8b\x89\xc8\x4f\x87\x5e\x1a\xb7\xc1\x10\x7a\x2f\x9f\xef\x4a\x51\x0b\xb1\xd9\xc0\x02\xfa\xd3\x2b\x7d\x1f\x82\x0f\x11\x02\xd2\x1c\x5c\x04\x1a\x10\x30\xbf\xa2\x41\xd1\x8a\x8c\x92\xb1\x10\x90\x1b\x45\xcd\x1c\x9d\x0f\x30\x1d\x7a\x50\x4e\x83\xa1\x08\x1a\x27\x74\x1a\x5d\x6b\x30\x36\x70\xc0\x13\x6a\xd8\x9f\x60\x99\xcd\xa4\x68\x68\x20\xce\xed\x00\x2a\xa6\x5c\x75\x25\x04\x8e\x83\x8f\x08\x9d\xb1\x18\x41\x05\x04\x65\x2d\xe0\x4b\x6b\x67\x9d\x59\xf6\xb3\xb1\x1a\x48\xf5\x51\xc2\x6f\x2e\x2b\x04\xe3\x32\xc9\xb9\xf2\x09\x4c\x04\x65\xa3\x3f\x0b\x4d\x95\xb8\xa7\xb5\x8f\xdc\xd5\x22\xca\x50\x03\x2a\x32\x05\x0d\x78\x82\x56\xb9\xb7\x04\x7b\x04\x3a\x4d\xf8\xae\x1d\xb0\x3d\xa0\x86\xa3\xa1\xc1\xcf\x04\x86\xa4\xe8\x66\xd7\xfe\x77\x78\x15\x8f\xe1\x97\xb5\x82\x7c\xc8\x0f\x35\x8c\x6a\xda\x45\x0a\xc6\xf5\x8f\xbb\xc7\x73\x38\xe5\xc0\x57\x51\x60\x08\x11\xde\x6f\x61\x54\x07\xac\x7e\x82\xad\x45\xc1\x5d\xe4\x09\x4e\x9c\x11\x94\xeb\x11\x8c\xd3\xf8\xb2\x14\x4b\x1a\x6a\xb9\x26\x32\x7d\x61\x3a\xb0\xe8\xaa\x29\xb3\xc4\x1a\x3e\xc0\x6d\x0a\xa4\xca\x3b\xe6\x7b\x84\x2d\xac\x71\x51\x14\xaf\x82\x7f\xf2\x2a\xf0\x8c\xa3\x78\x4d\xeb\x92\x57\xf3\xdc\xf0\x79\x59\xd4\xd9\x89\xee\x7a\xc8\xa0\xb1\xb5\x8a\x7b\xe1\xb7\x17\x82\x34\x4a\x7e\x05\xbd\x79\x46\x07\x1a\x49\x19\x1b\x1b\xe0\x6d\x70\x27\xf0\x5d\x5a\xa4\x49\x05\x35\x46\x7e\x19\x30\xce\x96\x98\x80\x1d\x89\x0d\xb4\x7e\xb6\x7a\x31\xc9\x7a\xa5\xaf\xec\x49\x42\x22\xfb\x99\x2a\xe4\x8a\x6f\x93\xb9\xda\xb7\xf3\x88\x8e\x14\x19\xef\xe0\xc8\x1c\xec\xb2\x71\xad\x1f\x27\x8b\x84\x5c\xeb\x18\xbc\xeb\x17\x87\xbf\xeb\xb7\xd2\x4b\x03\xf2\xf7\x55\x31\x17\xbf\x67\xff\x7e\xe6\xdb\x32\x9c\xaf\x62\xb5\x62\xcd\xaa\x61\xbb\x5d\xbc\x58\x86\xed\x8c\x4d\xb3\x67\x53\xfe\x77\x29\xf6\xde\xdb\x5a\x14\x4a\xeb\x07\x45\x03\x23\x58\x69\x45\xb0\x1c\x04\xf9\xcf\x69\xc2\x7a\xf5\x9d\xe4\x1f\xc6\xe9\x2a\xd5\x59\x01\x0f\x14\x52\xb8\x20\xd8\x02\xc9\x7b\x8b\x63\x55\x67\xd3\x73\xca\xc3\xa1\x67\xee\xaa\x86\x37\x5b\x28\xcb\x0c\x4e\x82\x76\x57\x41\x5e\x19\x0a\x33\x9e\xd7\x65\x91\x54\xe9\x2c\x41\x14\xc4\xea\x2e\xa7\x4a\x7e\xda\x3f\x71\xe4\x53\x77\x81\xf0\x3e\x7f\x69\xc0\xa9\x11\x2f\xfb\x4c\xf2\x2f\xa4\xc1\xeb\x8f\x6a\xc4\x58\xe5\x5e\xc6\x06\xbe\x30\x62\x8d\x55\x9c\xc2\xaa\x4d\x07\xa3\x7c\xc8\x9b\xf2\x66\x0b\xce\xd8\x84\x5f\x07\x54\xad\xc1\xab\x0e\x47\xf9\x77\xda\xa7\x1f\xe0\x73\x70\xc1\xbf\x8a\x82\xef\x36\xea\xb3\x17\xbb\xc7\xec\x44\x03\xb7\x4d\xfe\xae\x78\x32\xf5\xd5\xc7\x79\xe9\x24\x0d\x2d\xe9\x59\x48\xb6\xa0\x26\xbe\x51\x55\xfe\xbb\x49\xf8\xfa\x5c\x46\x7e\x4e\xd4\x71\x09\x5f\x06\xf4\x2d\xed\x42\xc6\xbc\xeb\xed\x58\x37\x2a\x7f\xcd\x97\x8f\x1e\xbf\xdd\xb2\xa2\xf5\x8e\x8c\x5b\x5d\x2b\xc6\xd8\x73\x36\xa3\x76\xb7\x8f\xf9\x00\x54\xf5\xf7\xe9\x1f\xe0\x2e\x67\x33\xfc\xd7\x2d\x74\x23\xc9\xcf\x53\x30\x8e\xba\xaa\x84\x8a\x0f\xff\x8d\x86\xd1\x87\xf5\x5f\x46\x5d\x36\x97\xf4\x77\x77\xeb\xec\x2f\x07\xa5\xf7\xf2\x23\x1e\xbb\xaa\x6c\x95\x73\x9e\x92\xfc\xf3\xd5\xb8\x89\xef\xe1\x26\x96\xcd\x72\xe9\xc6\xd8\xd7\xd7\xf7\xc8\x19\x2b\x5e\xc5\xbf\x03\x00\xa2\xd2\x08\xc9\x79\x07\x00\x00")
+
+func jujugenerateapidocLoaderrorsGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocLoaderrorsGo,
+		"jujugenerateapidoc/loaderrors.go",
+	)
+}
+
+func jujugenerateapidocLoaderrorsGo() (*asset, error) {
+	bytes, err := jujugenerateapidocLoaderrorsGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/loaderrors.go", size: 1913, mode: os.FileMode(436), modTime: time.Unix(1792000005, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocOperationalGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x51\x8f\xdb\x36\x0c\x7e\x8e\x7f\x05\xeb\x87\xab\xdd\x1a\xce\xcb\xb0\x87\xdb\xf2\x30\x5c\x37\xec\xd0\x62\x0d\x70\xc5\x5e\x8a\xa2\x50\x64\xd9\x56\xe2\x88\x86\x44\x27\x77\xb8\xe6\xbf\x0f\x94\x64\x27\xb9\xa4\xc3\xf5\xa1\x97\x50\x9f\x28\xf2\xe3\x47\x32\xbd\x90\x1b\xd1\x28\xd8\x0a\x6d\x92\x44\x6f\x7b\xb4\x04\x59\x32\x4b\x1b\x9c\x4b\x34\x8e\x84\xa1\x34\x7c\xa5\xa7\x5e\x39\xfe\xec\xd0\x7a\x9b\x23\xab\x4d\xe3\x4d\xa4\xb7\x2a\x4d\x18\xa7\xa9\x1d\x56\xa5\xc4\xed\x7c\x3d\xac\x07\xff\x9f\xe8\x75\x85\x72\x1e\xfe\x30\xba\xc1\x4e\x98\xa6\x44\xdb\xcc\x1f\xe7\x84\xd8\xb9\x79\x83\xf3\x18\x8a\x4b\x93\x3c\x49\xe6\x73\xc0\x5e\x59\x41\x1a\x8d\xe8\x3e\xaa\xa7\x3d\xda\xca\xc1\x56\xf4\x0e\xc2\x47\x6a\x05\x81\x14\x06\x44\xdf\x2b\x61\x41\x1b\xa0\x56\x81\x11\x5b\xe5\x6f\xd7\x20\x0c\xfc\xb1\xbc\x07\xa7\xec\x4e\x59\x18\xb3\x01\x42\x0f\x94\x82\x54\x83\xf6\x09\xb0\x06\xa7\x88\xb4\x69\xbc\x4f\xbe\xec\xcf\x47\x7c\x8b\x5d\xe5\x4a\xf8\xd2\xaa\xa7\xb7\x56\x81\x6c\x95\xdc\xa8\x8a\xdf\x43\x5b\x29\x5b\x80\x63\x87\x82\x40\xf0\x55\x7e\x1f\xdc\x20\x5b\x10\x0e\x3a\x6c\xb4\xb9\x43\x63\x96\x62\x70\xec\x72\x30\xe4\xf8\x60\xa5\xf8\x39\xb1\xc2\x81\x02\xc8\x95\xc9\x4e\xd8\xab\x39\x2f\xe0\xeb\x37\x47\x76\x90\x04\xcf\xc9\x8c\x93\x07\xfe\x17\xd8\x4f\x66\x53\x1e\xd1\x70\x78\x4e\x66\xcf\x69\xaf\x4d\x93\x16\x10\x38\x2f\x3f\x9f\xba\x55\xbd\xe8\xf4\x4e\x1d\x0a\x8f\xc3\xd7\xe1\x36\xe3\xd7\xd7\x80\xf7\x56\x93\xda\x0b\x4d\xaf\x01\xfb\xf4\xaf\x02\x3f\xf1\x49\x00\x49\x34\xd7\x31\x4c\xae\x92\x9c\xda\xa1\x48\x0e\x2f\x75\xf3\x10\xea\xea\xc0\x2a\x1a\xac\x61\xd1\x28\x30\xc3\x56\x59\x2d\xa7\x02\x3b\xa8\x94\xec\x84\x0d\x35\xa5\xd6\xcb\xe7\x44\x38\x63\x8b\x08\x53\xf9\xfb\xa3\x4e\x61\xa5\x3a\xdc\x83\xa6\x02\x90\x5a\x65\x59\x04\xd3\xfd\x5a\x48\x51\x29\x57\xc0\xbe\x45\x17\x54\xe9\xc0\xb5\xb8\x67\x14\x31\xea\x09\x1a\xdc\x29\x6b\x40\x4e\x29\xb8\x22\x8a\x81\x23\x40\x0b\x13\xe9\xc0\xe5\x74\xc5\xa4\x2b\x0e\xa3\xd3\x5b\x4d\x80\xfe\x45\xb0\x82\x14\x0b\x79\xd4\x52\x3d\x18\x79\x8d\x88\xac\xdf\x34\xf0\x6e\xcc\xa0\x5c\x86\x0f\x39\x7c\xfd\x76\x49\x6d\xbc\xc3\xa2\x63\x69\xc6\x1e\x71\xff\x87\x4d\x66\x35\x5a\xe8\x05\xb5\x05\xf4\x70\xbb\x00\x2b\x4c\xa3\x40\x9b\x4a\x3d\xc6\xb7\x7c\x08\x79\x39\x91\xf8\x9c\xcc\x66\xba\xf6\x77\xe0\xcd\x22\x72\xbe\xdc\x34\x70\x73\x03\x6f\xe2\x84\x29\xff\x16\x6e\x69\x55\xad\x1f\x33\xc6\x15\x47\xd4\xfb\x74\x9e\xe6\xf0\xe3\x47\xec\x06\x57\xde\xa1\x21\xa1\x8d\x8b\xc0\x74\x1e\x0b\xc1\x30\x7e\x6a\x26\xd1\x90\x36\x83\x4a\x66\xb3\x43\x7c\xba\xfc\xc2\x93\x0d\x16\x0b\x30\xba\xbb\x8a\x72\x12\x7b\xc5\xf9\x44\x6c\xf9\xc0\x86\x2c\x4f\x66\x3e\xe1\xef\x85\x2f\xf0\x31\x61\x8f\x2f\xff\x11\x5b\xe5\xb2\xf1\xdd\x02\x70\xc3\x88\x70\xf6\x09\x71\x33\xf4\x19\x5f\xcb\xcb\xec\x9d\x9f\xad\x1c\xbc\x23\x76\xca\x61\xbd\xc1\x4d\xb8\x79\x1a\x8c\x8f\xe6\xd8\xf2\xb7\x8b\xd3\x22\xdf\x45\x73\xf0\x1a\xdd\x4c\xd8\xc5\x02\xd2\xf4\x67\x1e\x77\xa2\x1b\xd4\x18\xe1\x89\xcb\x7f\xd9\x9e\xc9\x57\xc4\x34\x9f\xf3\x84\x84\x0a\xb9\xb1\xb6\x5b\x65\x08\xb4\xe3\x11\xac\x1e\xc9\x0a\x3f\x24\x45\x6c\x34\xef\x3b\xde\x19\xa7\xf8\x5b\x82\x95\x82\x1a\x07\x53\x81\x76\xe6\x2d\x41\x2d\x48\x74\x25\xc3\x2a\x94\x05\x7c\xe7\xc8\x7c\x98\x1f\x50\xde\x85\x17\x58\x4a\x05\x84\xe8\x26\x79\x2e\xfc\x42\x30\x55\x36\x5a\x0a\xf8\xa9\x5e\x43\x2e\x23\x6f\xb7\x13\x59\x85\xb7\x73\xfd\x6e\x79\xd4\x06\x75\xbe\x87\xb4\x4c\xe1\xbd\x2f\x75\x00\x78\x76\x3c\xc2\x07\x16\x8c\x1f\x50\x7a\x13\x30\x17\xde\x74\xe0\x00\x0f\x09\x33\xcd\xab\xb3\x7c\xe8\xb4\x54\x27\xe1\x71\xb3\x66\xba\x80\x35\x68\x43\x39\xac\x10\x83\x0a\xc3\xd0\x9a\x1a\xef\xab\xfe\xe6\x25\x05\xbf\x1f\x4d\xeb\x60\x4a\xfc\x1b\x2f\xf0\x97\xe3\x70\x4c\xf4\x6c\x1c\x4e\x02\xc1\xda\x7f\x8f\xd7\xa1\x55\x5d\xc5\x73\x28\xee\xd5\x69\x7d\xee\x35\xb5\xde\xd2\xe8\x9d\x32\x81\x0d\x40\x9e\x7e\x0a\xd4\xb6\xa7\x71\x11\x81\xae\xfd\x75\x82\x0a\x95\xaf\x68\x5c\xd4\x84\x5c\x6a\x34\xea\x72\x4c\x8d\x01\x7a\x05\x47\x3f\xf9\xe8\xef\x39\x99\x79\xf3\x22\x1a\x5c\xf9\x05\x3f\xe1\x5e\xd9\x51\xef\xb1\x15\x37\xc7\x3e\xbc\xb6\x4e\xe3\xc0\xb9\x18\x18\xec\xa4\x80\x4d\xc9\xa8\xd8\xb3\x91\xd0\x4d\x39\x72\x34\xd5\x31\x9e\xa4\xe9\x25\xc9\x5e\x14\x67\x0c\x7b\x71\xf0\x74\x96\x50\xa3\xdd\x0a\x22\x55\xf1\x27\xa6\xe7\x52\x93\xa5\x77\x50\x80\x55\x3d\x5a\x36\x40\x2d\x3a\xa7\x40\xd7\xa0\x29\xf6\x46\x5c\x61\x97\x04\xc6\x7e\x85\xb3\x81\x02\x59\x48\xb6\xf0\xd2\xf2\xb9\xc5\xf8\xa3\x9f\x07\x7f\x9c\x49\x3f\xdc\xb2\xbc\x00\xc9\x41\x64\x79\x1e\xb3\x3b\x83\x9d\xa5\xf6\x72\x97\xc2\x8e\xf3\xe4\xc7\x81\x8e\xc9\x72\xa2\x5c\x9c\x0a\xe5\xc0\x7d\xeb\x13\x2e\x82\x92\xaa\x21\xc4\xee\x4e\xb8\xe1\x1f\x48\x4f\x7c\x89\x7f\x56\x96\x1f\x22\xa2\x0c\x61\x96\x70\x4f\x91\x1c\x77\xa4\x66\x77\x95\x99\xf3\xfc\xc8\x07\xe6\x7c\x96\x05\xec\xa6\xa0\x39\xd9\x41\x5d\xa3\x49\xd7\xb0\x2b\x3f\x6a\x53\x65\x39\x2f\xa7\xe9\xc2\xbd\x21\xde\x4f\x57\xcf\xfe\xea\x50\xd0\x69\xff\xa6\x69\x11\xe2\xf4\xca\xe1\x32\xba\x31\xa5\x8c\xf2\x51\x8f\x55\x01\xea\x51\x48\x82\xdb\x13\x5f\xf7\x86\x7e\xfd\x85\x4b\xb1\xcb\x7f\x8b\xc7\xa7\xc2\x3c\xa3\x27\xab\xf2\xc8\x10\x57\x90\xec\xa0\x5e\xaa\x75\x57\xfe\xc9\x2e\x5e\x80\x42\x89\x8f\x31\x4d\xdc\xee\x5b\x15\x7e\xd6\xf0\x20\x3f\xaf\x44\xd0\xdd\x69\x1e\x27\xd4\x1e\x27\x18\xb7\x54\x35\x6e\x15\x9a\xd6\x1c\x4f\xac\x2a\x4f\x4e\x17\x4a\x8c\xf0\x48\x13\xae\xd6\x7c\xc9\x7b\x28\x3f\xaf\xd6\xd9\x71\xbe\xe1\x6a\x5d\x2e\x37\x4d\xa0\x9d\x37\xf6\xcd\xcd\xd1\x56\x2e\x05\xb5\x59\xce\xcb\x3c\xe5\x98\xd3\xf1\x94\x1f\x8d\xf6\x31\xea\x34\x39\x24\xff\x0d\x00\xd6\xad\xea\x3f\x00\x0d\x00\x00")
+
+func jujugenerateapidocOperationalGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocOperationalGo,
+		"jujugenerateapidoc/operational.go",
+	)
+}
+
+func jujugenerateapidocOperationalGo() (*asset, error) {
+	bytes, err := jujugenerateapidocOperationalGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/operational.go", size: 3328, mode: os.FileMode(436), modTime: time.Unix(1791997817, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocOrderingGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x5f\x6f\xdb\x38\x90\x7f\x96\x3e\xc5\xd4\xc0\xa5\x52\x57\x55\x5a\xdc\xcb\x21\x59\x2f\xd0\xbf\xb8\x60\xbb\x6d\xb0\x69\xf7\x16\x08\x82\x82\xb6\x28\x9b\x6b\x89\x14\x48\x3a\x89\x2f\xeb\xef\x7e\x98\x21\x29\x51\xb6\xd2\x76\x81\x7d\xb8\x3e\x34\x0e\x3d\x1c\x0e\xe7\xef\x6f\x86\xe9\xd8\x72\xc3\x56\x1c\x5a\x26\x64\x9a\x8a\xb6\x53\xda\x42\x96\x26\xb3\xba\xb5\xb3\x34\x99\xad\xd4\x29\x33\xe1\x93\x55\x1b\x2e\xc3\xe7\x5d\xc7\x0d\x7e\xd6\xbc\x6e\xf8\x92\x48\x8c\xd5\x42\xae\xcc\x2c\x45\x12\x61\xd7\xdb\x45\xb9\x54\xed\xe9\x5f\xdb\xbf\xb6\xf4\x1f\xeb\x44\xa5\x96\xa7\xee\x07\x6e\x58\xa9\x86\xc9\x55\xa9\xf4\xea\xf4\xfe\xd4\x2a\xd5\x98\xd3\x95\x3a\xf5\x32\x99\x59\x9a\xa7\xe9\xe9\x29\xb4\xec\xfe\x93\xae\xb8\x7e\xc3\x9a\xe6\x2d\xef\xec\x1a\xd6\xaa\xa9\x0c\xd8\x35\xca\x7d\x2f\xda\x6d\x0b\x15\xad\xab\x1a\x96\xac\x69\xf0\x2b\x66\xe1\x4e\x34\x0d\xee\x5f\x70\xa8\x55\xd3\xa8\x3b\x5e\xc1\xdd\x9a\x4b\x68\x94\xda\x08\xb9\x82\x5a\x69\x62\xb2\x54\x15\x77\x5b\x16\x5b\x11\x38\x6b\x6e\xb6\x8d\x35\xc8\x40\xd5\xc0\x60\xb1\x6d\x36\xd0\x72\xbb\x56\x55\x99\x2e\x95\x34\x76\x42\xb0\x39\xfc\x27\x89\x6c\x94\xb6\xef\xb7\x72\x69\x22\x51\x71\x0d\x82\xbe\xeb\xad\x5c\x5a\xa1\x24\x9e\xc5\x2c\x6e\xd1\x5c\xe1\x25\x1d\x69\x23\x96\x1c\x3a\x66\x0c\xaf\x80\x21\x0d\x17\x1a\x6a\xa1\x8d\x05\xa6\x57\xdb\x96\x4b\x5b\xa6\xb7\x4c\x47\x07\xcd\xa1\x65\xdd\xb5\x33\xc1\xcd\x42\xa9\xe6\x21\x4d\x66\x57\xc8\x68\x76\x06\xee\x9f\xd5\x5b\x5e\x84\xd5\x2b\xcb\x16\x0d\x9f\x9d\x0d\xab\x4a\xdb\x9e\x74\x58\x0d\x64\x07\xab\x78\x8c\xf1\xcb\x8e\x76\x4f\x37\x77\x6a\x23\xb5\xc0\xb2\x61\xc6\x88\x5a\x70\x54\xc3\x5d\xac\x55\x50\x35\xfd\xba\x12\xb7\x5c\x7a\xad\xe2\xee\xa5\xd2\x9a\x9b\x4e\xc9\x0a\xac\x02\x61\x0d\x74\x4c\xb3\xd6\x14\x20\x6a\x10\xf6\xa9\x19\x1b\xa2\x00\x25\x39\xdc\xad\x95\xe1\xb8\xdb\xd1\x02\x93\x95\x3f\x07\x98\xe6\xb0\x50\x76\x0d\xc6\xea\xed\xd2\xa2\x1c\x4d\x85\xa6\x67\x4e\xc7\x25\x5c\x58\x72\x07\xb2\x33\xfa\x03\xc3\x5f\x3b\x50\xb7\xde\x14\x9e\x27\x5a\x09\xf0\x36\x2b\x69\x80\xb3\xe5\x3a\x9c\xb0\xd8\xe1\x4e\x21\x2b\x7e\x0f\xb8\xbd\xeb\xb8\xac\x0c\xc9\xe5\x29\x90\x2b\xed\x10\x96\xb7\x85\x77\x45\x94\x81\x5c\x15\x77\x13\xf3\xe8\x30\xa6\x7b\xe3\x5b\x55\xd0\x7d\x90\x49\xe4\xa6\x9a\xb3\x0d\xb9\xc5\x58\x69\x5c\x2e\xf9\x19\x5d\xc0\x4b\x6c\x36\xa2\xa3\x73\x0d\xdc\x09\xbb\x56\x5b\xeb\x25\xc4\xf3\xd9\x60\xaf\xc2\xff\x34\xb0\x54\x0d\x86\x33\xaf\xa0\xd6\xaa\x05\x86\x5e\x55\xe0\xcd\xd0\xd3\x78\x50\xac\x29\x53\xf4\x60\xff\x1b\x59\x3b\xeb\x36\x2b\x78\x16\x62\xb7\xbc\x74\x1f\x0a\xe8\x2c\x3c\xa3\x64\x51\x7e\xde\x75\xfc\x23\x6b\x79\xe1\xad\x87\x9f\xd1\x30\x42\xae\x0a\x7f\xf5\x20\x07\xf8\xac\x42\x7b\x72\x78\xe6\x52\x46\xf9\x7b\xe4\x5c\x0f\x69\x22\xe4\x7b\xc1\x9b\xca\x14\xa0\xb6\xd6\x7d\x84\xb3\xb9\xb3\xac\xfb\x35\x73\x6c\xf3\x62\xb4\xe8\xce\xc8\xd3\x44\xd4\xd0\x70\x99\x05\x3e\x39\xcc\xe7\xf0\x02\xfe\xfe\x9b\x56\x7b\x9e\x7e\xf9\x21\x4d\x12\xcd\xed\x56\x4b\x90\xa2\x49\x93\x7d\x9a\x30\x63\xb6\x2d\xaf\xf0\xd0\x93\x63\x11\x71\xc3\xeb\xdd\xa5\x32\x02\x23\xbd\x0f\xb4\xe4\x8d\x92\xb5\xf0\xb6\x9a\x35\xea\x6e\x86\x8b\xbf\x73\x66\x90\x08\x03\x6a\x16\xb9\xf8\x39\x84\x43\xac\xf2\xbe\xe3\x73\x96\xbc\xe5\x12\x19\xfb\x04\xe1\x35\x67\xd0\xe7\xe5\x53\x97\xca\x2c\x08\x49\xd4\x4c\xb2\x66\xf7\xbf\xbc\x02\xa3\xb6\x7a\xc9\xf1\xc4\x7d\x9a\x54\x7c\xd9\x14\x80\xff\x5f\x6e\x56\x05\x70\xad\xf1\x26\xce\x38\x6f\xf9\xb2\x41\x93\xa2\x01\x63\x83\x39\xad\x21\xe9\x93\x39\xea\x01\xb5\x85\x1c\xca\xd7\xaa\xda\xc1\x7c\xb4\x76\xb9\x59\x91\x01\xcd\x85\xac\x55\xf8\x2e\x52\xa3\xbf\x19\xc9\x42\x86\xc2\xe3\xe9\xc3\x2b\x9b\x1d\x31\x70\x92\x16\xf0\xc2\x89\x40\x74\xdf\x61\xaa\x90\xe1\x09\xe5\xd5\xf7\x18\xa2\x1a\xe9\xba\xcd\x2a\x64\x39\xbc\x5f\x9a\xf4\x7e\x74\x06\x10\x3e\xa2\x49\x7a\x07\x38\x1b\xfc\xcb\x29\x4e\xd4\x40\xaa\x52\x65\x2d\x64\x15\x44\x0d\x02\x92\x64\x28\xe7\x39\xf4\x5a\x8a\x04\xd4\xc4\xe2\x40\x5a\x97\x3c\x23\x49\xe3\xc2\x61\x99\xe5\xb0\xc5\x5a\xb0\xd8\x79\x33\x53\xc8\x95\x29\x06\xd6\x68\x97\xcb\x73\xf0\x90\x26\x18\x8e\x87\xff\x8e\xc2\x73\x3a\x86\x0e\x0a\x89\xcf\xec\x78\x55\x70\x62\x3b\x87\x0b\xe9\x7d\xc9\xc8\x0d\x55\x3d\x72\x43\xe7\x7f\x8b\x9d\x53\x0a\xde\xce\x25\x7b\xca\x4b\xa4\xa2\xe8\x8a\x3e\xf1\x79\x16\xe3\x0c\xaf\x49\x81\xa2\x46\x16\x8f\xfa\xb9\x5d\x73\xcd\x7d\x52\xca\x14\x3c\x8b\x74\x92\x43\x6c\xa4\xa9\x14\x85\x12\xc2\x33\x66\x6c\x89\xa5\xf4\xed\x60\xc4\x90\xb9\xfe\x60\xba\xf0\x10\x43\x48\xfb\x68\x3e\xb2\x02\xfd\xfc\x6c\x7e\xec\xfc\x69\x82\xc5\x3a\x4b\x93\xa4\xe2\xb7\xc2\xe9\xcb\x69\x98\xdc\xaf\xe2\xf7\xbc\x02\x00\x2c\xdb\x69\x92\xb8\x14\xcd\xab\x7e\xa1\x65\xdd\x07\xac\x4a\xfd\x02\x96\x0e\xce\x0d\x00\x5c\xdf\x90\xe0\x88\x8d\xde\xdd\x77\x3a\x4d\x72\x4c\x4b\xb6\xbc\x90\xa6\xe3\x4b\x17\x46\x14\x9b\x05\xa0\x72\x32\x09\xf8\xed\x47\x55\xf1\x9c\x98\xa1\xab\x24\xe6\x4e\xd8\xe5\x1a\x24\xfa\xb4\x2c\x33\xbc\x74\x4e\x5f\x2c\x99\xe1\x4e\x33\xbf\x33\xb9\xe2\x57\xb6\xb5\x67\x69\x92\x60\xfc\xa9\x52\x98\x4b\x32\x1b\x81\x8c\x8c\x2e\x5f\x80\x2c\xff\xf4\xca\x73\x1c\x7a\xe6\xee\x17\x62\xe8\xeb\xe8\xeb\xdd\x05\x96\xcd\x7e\x67\x4e\xac\x07\x7d\xcc\x29\x63\x0e\xdb\x96\x4a\x5a\x26\xa4\x79\x45\xea\x19\x0e\xc4\xdb\x85\xbd\xbd\xea\xa2\xcd\x28\xad\xd9\x88\x6e\x72\x63\xf9\x41\x18\x5b\x40\xcd\x1a\xc3\x73\x38\x39\x81\xc1\x40\xf3\x39\xcc\x66\xfe\x12\xb1\xe1\xe6\x30\x43\x3f\x9c\xc4\x09\x74\x0e\x18\xd5\xf2\xc7\xcb\xae\xf7\xe0\x99\x63\xbc\x4f\xfb\xff\xf7\xc0\x1b\xc3\x11\xea\x58\xb4\x04\xc9\x49\x3e\xf4\xa9\xce\x64\xf9\x67\x7e\x0e\x36\xe4\x93\x93\x93\x6f\xab\xc3\x8b\x2d\x6a\xf8\x5a\x80\xda\x10\xbb\xf2\x0b\xa6\x88\x66\x27\xe4\x2a\xcb\xcb\xcc\xfb\xf6\x6f\xac\xcb\xcf\x91\xc4\x5f\x34\xf8\x5a\xa4\x40\x12\x71\x3f\x72\x87\xe0\x6f\xc1\x1b\x6a\xf2\x1d\x72\xcb\x0a\x83\xa8\x17\x27\x3f\x87\x5a\x46\x52\xd7\xb2\xbc\xdc\xac\xb2\x3c\x2c\xf5\x72\x86\x2f\xca\x4b\x66\xd7\x19\xd5\xdc\x19\x62\x8e\x19\x1a\xa5\x87\xb9\xd7\xb5\x2c\xb1\x0c\x65\xf9\x0d\xae\x63\x9d\x96\xe5\x2b\xbd\x32\x39\xfc\x02\x2f\x70\x09\xfd\xd2\xc5\xe5\xa1\x63\x22\xd9\xf5\x8b\x9b\xa0\x9b\x63\x8b\x46\x99\xc5\xc3\x9d\xd9\x70\x7f\xbc\xa5\x6f\x70\xca\xff\x66\xe6\x52\xf3\x5a\xdc\x67\x07\x52\x17\x80\x7d\xce\xe5\x66\xe5\xbe\x26\x87\x62\x7a\x35\xf6\xf3\x3e\x3e\x7e\x09\xb0\x22\x19\x22\x7a\xee\x3d\x25\xf3\x0b\xa8\xc2\xb1\x11\xf6\x43\x29\x71\x16\xda\xe7\x69\x14\x64\x64\xa3\xe1\x6a\x4f\xd0\x89\xcf\x86\x2d\x8f\xe0\x94\x11\x50\xa1\x60\xc0\x0a\x38\x86\x2a\x2d\xaf\xc4\xb6\x45\xec\x30\x86\x2b\xfd\x61\xf8\xcd\xde\x4b\xe0\x83\xf8\x1f\x9e\x1c\x20\xd2\xf8\xe0\xb5\x58\xad\x8f\x8f\x9d\xc5\x30\x5c\x18\x8f\xce\xb1\x61\xb2\x54\x45\x3c\x26\xc7\xbe\xc1\x50\x30\xce\x62\xf9\x42\xa2\xf8\x57\x04\x7c\x4c\x33\xb3\x10\xeb\x20\x4c\x7f\xe2\xb8\x21\x20\x84\xb6\xd5\x72\x24\x9c\x8f\xc1\x7f\xc7\x6c\x01\x61\x8e\x25\x3b\xf4\xf7\x01\xf7\x2f\x76\xa8\x2d\xcd\x2c\xf6\x08\xd4\x07\xf9\x26\x80\xfa\x2c\x87\x36\xf0\x3e\x9a\xc9\x4a\xb5\x41\x6e\x87\x8a\x5c\x8d\xfc\x65\x3e\xd1\x1a\x4f\xc0\xe7\xd3\x53\xf8\x3c\xa4\xce\x96\xed\x60\xc1\x61\xcd\xd0\x2c\xa0\x24\x76\x80\xac\x6f\x94\xd3\x24\x34\x4a\x95\xe2\x0e\x7f\xdc\x29\xbd\x29\x80\xb9\xd6\xc6\x83\xe2\xb6\xf5\xa4\xbe\xca\xc3\x9a\x37\x1d\xd7\xa6\x87\x4f\x2d\x93\x3b\xa8\xd9\x92\x55\xdc\x94\x69\x82\xb6\xf8\x5a\xd0\xd4\x00\x13\x98\xc6\x2a\x07\x21\x16\x51\xe4\xc7\x12\x1b\xae\xe4\x7d\x25\x76\x98\x01\xd7\x38\x8f\xb1\x34\x22\x0f\xfc\xce\x43\x8d\x4c\x95\x08\x39\x01\xb3\x86\x32\x59\x8e\x0c\x8e\xd0\x74\xcf\x65\x1a\x3b\x27\x98\xf7\x85\xc4\xc0\x47\x7f\x49\x6a\xac\xf0\x21\xc7\x0f\xd2\x94\xd9\x08\xd0\xf8\x93\x9e\xa8\x0d\x02\xf6\xfa\x08\xb1\x4f\x71\xf6\x82\x1c\x02\xf3\x09\xf9\x0a\xf0\x52\x1c\xe6\x3a\xa4\x0d\xe9\xce\x8b\x10\x73\xfd\xc6\xe1\x07\x10\xbb\x3f\xb4\x3f\x2a\xe2\xe3\xb1\xd9\x4f\x2f\x0f\x11\x77\x70\x38\xdd\xfb\x68\xe4\x81\x0e\xd5\x8e\x40\x0c\x68\x8e\x03\x30\x83\x63\x22\x44\x94\xc0\xc1\x70\x6c\x87\x5d\x43\xef\xe0\x29\xee\xa2\x6e\x12\x6a\x07\x97\x55\x1d\x23\x80\x35\x6f\x2a\x0c\x6a\xba\xf4\x63\x88\x74\x02\x3a\x05\xac\x89\xf6\x2e\x80\x13\x4c\xc3\x0a\x7b\x0c\x45\x07\xe0\x66\x78\x6f\xf9\xad\xec\x10\x10\x67\x3c\xf7\x96\xbf\x22\xc1\x95\x46\x1e\x79\x1a\x19\xff\x89\x2a\x03\xe8\xbf\x36\xbc\x29\xaf\x78\x43\x25\xf5\x26\x0e\x51\x2a\x01\xa4\xb1\xfb\xc3\x13\x70\xcf\x9f\xe1\x94\x8b\x8a\x4b\x9b\xf7\x7a\x55\x1b\xac\x78\x74\x9f\xf2\x8b\xe1\xe6\xfa\xfe\x06\x3d\x8c\x6e\xe0\xdb\x88\x71\x75\x3e\xd6\x78\x01\x1d\xd7\x6b\xd6\xe1\x28\x42\xde\x72\x1a\x3a\x58\x85\x4a\x67\x52\x11\x09\xea\x09\x98\x41\x25\x63\x95\x2e\xaf\x94\xb6\xd9\x62\x87\x77\xc8\x7c\x4a\xf3\x79\xd2\xe4\x79\x81\x3b\x83\x11\x99\x33\xd9\x90\x30\x24\x8e\x20\x54\x1d\x59\x77\x64\x5a\x3f\xfc\x8b\x72\xe5\xe3\x06\x8d\x6f\xf5\x6d\x8b\x0e\xf6\xe3\x10\x1b\x2e\x0d\xe1\x11\x34\xce\xcb\x6c\x04\xb6\x1c\x4e\xf3\xb0\x07\xfd\xdf\x23\x9f\xf9\x1c\x5e\x0e\x7a\xa7\xa8\xbc\xa6\xaf\xdf\x6f\xe5\x4d\x79\x61\x70\x25\x73\xb0\x27\x3e\xb1\xe7\x80\xa0\x88\x6c\x6d\xf8\xd1\xd9\x07\x6e\x34\xb2\xb3\x2a\xfb\x9e\x71\xec\x49\xde\xd4\x63\x9c\x7f\x64\x6a\x54\xeb\x02\xa7\x06\x5e\xc5\xae\x3f\x44\x58\x1d\x6d\xa6\x2a\x20\x81\x37\x1c\x87\x9e\x10\x7a\x83\xc5\x0e\x02\x06\x7f\x6a\x60\xc3\x77\xde\x30\xe3\x23\xa7\xec\x80\xfc\x0f\x5a\x9a\xc1\x20\x1b\xbe\x0b\x0a\x40\xba\xf2\x57\xbe\x3b\x70\xf4\x21\x8e\xf0\x50\xf4\x39\x74\xf0\xd9\xd7\xd9\x74\xf4\x6c\xf8\xee\xd3\xe2\xaf\x01\xcd\xbf\xe5\xb5\xb9\xde\xf0\xdd\x0d\x19\xdb\x7f\x1b\xa5\xc1\xb0\xe2\xc9\x29\x86\x1c\xf9\x1e\x8b\xd5\x56\xd2\xb0\xc9\xf3\x8f\xfb\x3c\x12\xf7\x7b\x7d\x9e\xa8\xbd\x5a\xc3\x25\xa5\xbf\xdd\x2b\x5a\xc5\xf6\x2e\xea\x05\x7c\x6d\x6c\xd6\x66\x28\x8d\x6e\x7b\xf9\x61\x6d\x3c\x70\x1d\xc9\xb0\x36\xdf\x3a\xdd\x75\x0a\x64\xc1\xc3\xf3\x09\x20\x0f\x2e\xee\xa9\x89\xbc\x0a\xb4\xc1\x6d\x89\x81\xdb\x31\xce\x42\x21\x3a\x22\xd5\x89\x8a\xf2\x8f\xd7\x6a\x60\xeb\x15\x19\xb7\x88\x1e\xe3\x87\x1f\xde\x8e\x4f\x88\x90\x96\xf6\xf9\x04\xfe\x0e\xdf\xef\x87\xc8\x70\x2b\xce\xff\xa3\xa6\xf3\xc8\xf9\x99\x3c\xf0\x7b\x9a\xf2\xa0\x8f\x9b\x22\x54\x99\x10\x1c\x61\x26\x8d\xd3\xe9\x45\xa3\x96\x1b\x4a\x60\x42\x82\xb0\x05\x84\xba\x69\xfa\x80\x08\x3d\x27\xb2\xe8\x01\xe8\x9a\xdd\x22\x9e\x5b\x70\x2e\x7b\x24\x5a\xf8\xa3\x83\x4c\x98\x05\x59\xa3\x39\xab\x76\xb0\x66\xa6\x44\x06\x1f\xb9\xc1\x0c\x8c\x7c\x1d\x50\x94\xca\x02\xbf\x67\xad\x90\xbc\x22\xf4\xc5\x7a\x11\x86\x3b\xa0\x2c\x9a\x66\xf2\x9a\xd7\x08\xbf\xac\xf2\x98\x5c\x72\x4d\xb7\xf1\xf1\x7a\xd4\x97\x8f\x83\xd5\xd8\xd6\x1a\xb8\xbe\x41\x23\xa3\x77\x16\x03\x8a\xc6\x90\x1d\x9c\xcb\xfb\x6a\xe4\xa9\x6e\x6b\x34\xdd\xa0\xef\xcc\xf4\x74\xe3\xb5\x66\x72\xb9\x8e\xc7\x1b\xa6\xfc\xac\x36\xe8\x3b\xf4\xe8\x55\xbe\xf9\xf4\xf1\xf3\xc5\xc7\x2f\xef\xd0\xbf\x4c\xf9\x81\x2d\x78\x13\xd0\xcb\xc9\x09\x3c\xe9\xa5\x7a\x48\x23\xf7\x09\xee\x35\x6e\x9d\x5f\xa3\x05\x47\x47\x1d\xea\xa0\x00\xe3\xc7\x12\x81\x6f\xfe\x23\x8c\x2f\xea\xef\x72\x8d\x26\x1e\x3f\xc0\x9a\xd8\x94\xef\x70\x26\xe1\x41\xd5\xc9\xc9\x14\xdf\xc1\x3e\x0f\x8e\x7c\xff\x0f\x25\xbf\xa2\xee\xb5\x97\xde\x1b\x73\x19\x19\x73\x98\xd5\x78\x7e\xd3\x37\x5c\xfa\x84\xf0\x86\x19\xfe\xa6\x61\x5b\xc3\x73\x3f\xfc\x3a\x94\xe7\x50\xa0\x64\x7f\x2c\x16\xd6\xcd\xff\x57\xa2\xed\xe3\xe1\x60\x98\x14\xf0\x0a\xab\xd1\xf4\x34\xc8\xe4\x31\xea\x75\x65\xc3\xe5\xa6\x31\xfd\x51\x7a\x92\x3d\x01\x30\xc2\x24\xb8\xc7\x07\xb1\x3b\xd6\x0d\x5e\x85\xf4\x81\x3c\xe6\x37\x15\xcb\x53\x35\xe1\x5b\x15\x4d\x7e\xa7\x92\xc5\x48\x49\x4e\x22\xa5\x07\xef\xc3\xc7\x35\x24\xa0\xa3\xe9\x02\x22\xaa\xa1\xb0\xbb\xcb\xce\xa6\xe6\x69\xa3\x32\xd3\xcf\xd3\x5e\x3b\xb5\x8c\x0a\xd9\x51\xbd\x19\x59\xf4\xc7\x8a\xc9\xd0\x82\x8e\x86\xf0\xa1\x3f\xc6\xea\xe0\x5e\x69\x3c\x25\x2c\x76\x1e\x4c\x22\xd0\x90\xea\xae\xb7\xd4\x41\x2f\x3b\xb6\x12\x6e\x19\x8f\xf8\xf2\x40\x40\x67\x3f\xb8\x51\xb6\xa8\x7c\xce\x41\x58\xd4\x8f\x9f\xea\xad\x7c\x44\xcb\x7d\xd2\x1d\xa2\x8b\x74\x8e\x41\x25\x50\x35\xf5\x56\xc6\x5f\xc6\xb0\x33\xa6\x41\x8c\x89\x0f\x57\x35\xdb\x36\xf6\xec\x78\xa4\x50\xcb\x02\xbe\x7e\xc3\x3a\x78\x87\x48\xbf\xd2\x2b\x37\xf4\xad\x23\xd5\xf6\x03\x24\xac\x5c\xe1\xd9\x1d\xf1\x28\xe9\x28\xbc\xdb\x0b\x03\xb7\xf4\x40\xfa\xfc\x25\x4d\x6e\xb1\xf0\xe1\x78\x44\x2a\x19\x1e\x24\xc6\x5d\xf1\xf7\xf4\x5d\xc0\x6d\x20\xa1\x66\x4f\x48\x1b\x2a\x9c\x28\xf0\xf9\x7f\xc8\x3d\x3d\x82\x0f\x31\x71\xec\xe9\x4c\xaf\x7e\x14\x25\xdd\x8e\x7a\x66\x71\xd8\x33\x3f\x7f\xe9\x95\xe5\x07\x01\x63\x5d\x3d\xb5\x6b\xd7\xa8\x72\xcb\x35\x82\x16\x3f\x96\x38\x78\xbd\x19\x74\x83\x2a\x13\x16\x71\x06\x48\x45\x8d\x98\xd7\x56\x98\x33\x4c\x28\x6b\xea\xa1\x46\xf8\x27\x99\x5e\x63\x78\x0b\x04\x8e\xf0\xf3\xf4\xa3\xad\x4f\xe1\x35\xf6\x2d\x83\x2a\x91\x35\x25\xfc\xd2\x75\xe6\x43\x42\xf7\x8f\xc4\x44\x4f\x79\x21\xbc\x13\xf7\xe9\x25\xfa\x75\x74\x98\x8f\x73\xf1\xfc\xf9\xf4\x80\x43\xc0\xcf\xc7\xac\x89\xcd\xed\xc8\x8b\xa9\x69\x88\x88\xae\xc5\xcd\xe0\xd1\xe8\x23\x91\xd9\x6e\x03\x7b\x78\x3e\x3f\x62\x3e\x3d\x02\x89\x9e\xc6\x47\x36\x45\x9b\xf4\xd3\x0d\x22\x7a\x8e\x47\x56\xc3\xdc\x23\x3c\xc9\xf9\x57\x47\xfc\x16\x6c\x64\x74\xb0\xce\xda\x16\x98\xa7\xf1\x36\x8e\x5f\xe3\x0f\x1f\xfb\x0f\x5e\x1f\xbd\x39\x6d\x3c\x2c\x8a\xae\xe0\x9f\x63\x6d\xf9\x2b\x3e\xf2\x91\x65\x02\xbb\x4b\x4b\xaf\x72\x89\xc5\x76\xaa\x7c\xd7\xf0\x36\xcb\x8f\xe8\x9f\x0c\xf4\x57\xfd\xdb\xe9\xe1\x01\x98\xf1\xea\xe9\xb7\x51\x42\x04\x02\xfd\xe8\xc5\x39\xf9\x9c\x2d\x3f\x6e\x5b\x1a\xaf\x64\xf9\x39\x88\x9f\x7e\x0a\x4e\x54\x23\x91\x2d\xdd\x57\x22\x3f\x87\xda\xf9\xdb\xb1\xe0\x34\x15\xc2\x18\xf5\x14\x4e\xf4\x09\x89\xbf\x08\x69\xff\xab\xf7\x43\x2f\x61\xa4\xa7\x24\x09\x6b\xd0\xb2\x0d\xcf\x0e\x84\xef\x5b\x1a\x4f\x76\x5d\xfb\x41\x50\x5f\xa9\x46\x09\xc0\xcf\x44\x8e\xfe\xce\xe7\x7f\x98\x96\xf8\xd7\x40\xbd\xf3\x30\xb8\x73\x4b\xc3\xa8\xdb\x97\x26\x55\x83\x77\x1a\xfc\x73\x9a\xae\xe3\x4c\x3b\xff\xb0\xca\xef\xa6\x69\xbd\x1f\xb6\x84\xbf\x5e\x30\x58\x8c\x69\xee\x8c\x7b\x99\x89\xfe\x30\xc8\xfb\xd3\x84\x34\x59\x0d\x7e\x66\xfe\x9e\xa6\xbd\x98\x6e\x73\x6c\x25\xdc\xa2\x27\x0b\xf5\xcc\x0b\x6c\x8e\x08\xfa\x7c\xd1\x0e\xb9\xa2\x2e\x7f\xa3\xeb\x98\x60\x5a\xfa\x63\x80\x36\x1e\xce\x47\x83\x48\xec\x0f\x74\x39\x0c\xea\x69\x53\xd2\x1f\xd8\x3f\xf8\x84\x95\x02\xc6\x12\x10\x79\x82\xc6\xa7\x47\x85\x99\xbb\xeb\x73\xd2\x07\x4d\xdf\x93\xc4\xdd\xf0\x0c\xc0\x19\xd0\x2d\xfe\xc1\xb5\x71\xef\x02\xa5\xff\xe8\xd6\x9d\xec\x67\x00\x6d\x44\xfc\x1b\x37\x86\xad\xf8\x19\xd4\xad\x2d\xaf\x3a\x2d\xa4\xad\x33\x7f\x94\x1b\xcd\xa3\x95\x16\xfc\xd8\x24\xe1\xcf\x4f\x28\x6d\x9b\x33\xf8\x0f\x33\x2b\x40\x97\xee\xb1\x21\xa7\x23\xf7\xf9\x61\x2d\xb9\x63\x5a\x0a\xb9\x32\xe9\x3e\xfd\xbf\x01\x00\x55\x8c\xec\x52\x82\x28\x00\x00")
+
+func jujugenerateapidocOrderingGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocOrderingGo,
+		"jujugenerateapidoc/ordering.go",
+	)
+}
+
+func jujugenerateapidocOrderingGo() (*asset, error) {
+	bytes, err := jujugenerateapidocOrderingGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/ordering.go", size: 10370, mode: os.FileMode(436), modTime: time.Unix(1791996589, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocPlatformGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xdd\x73\xdb\xc6\x11\x7f\x26\xfe\x8a\x35\x66\x94\x02\x36\x7c\x54\xfa\xc8\x84\x9d\x49\x6b\xcb\x55\x6b\x3b\x1a\xd3\x4d\x3b\xc3\xd1\x64\x4e\xc0\x02\x3c\x13\xbc\x43\xee\x0e\x94\x34\x89\xfe\xf7\xce\xde\x07\x00\x52\x94\x9c\x3c\x44\x0f\x22\x3e\xf6\xf6\xeb\xf7\xdb\xbd\x3d\x74\xbc\xdc\xf2\x06\x61\xc7\x85\x4c\x12\xb1\xeb\x94\xb6\x90\x25\xb3\xb4\x6e\x79\x93\xd2\xef\xce\xd2\x4f\xa3\xe6\xdc\xc4\xab\x9b\x5e\xb4\x55\xb8\xee\xb8\x36\xa8\xc3\x8d\x55\x5b\x94\x74\x2d\xd4\x5c\xa8\xde\x8a\x96\x6e\x3a\x6e\x37\xf3\x5a\xb4\x48\x17\xf4\x40\x63\xdd\x62\xe9\xb4\x19\xa5\xfd\xaf\xd5\xa5\x92\xfb\x70\x29\x64\x63\xd2\x84\x74\x0a\xbb\xe9\x6f\x58\xa9\x76\xf3\x2f\xfd\x97\xde\xff\xe3\x9d\x30\xa8\xf7\xa8\xe7\x35\x2f\x79\x85\x4f\x4a\xf2\x4e\x54\xaa\x9c\xfb\x1f\x52\xdd\xa8\x96\xcb\x86\x29\xdd\xcc\xef\xe6\x56\xa9\xd6\xcc\x5d\x08\x2e\x07\xc6\x4b\x74\xdb\x86\x09\x39\x47\xad\x1b\xc5\xf6\xdf\xa6\x49\x9e\x24\x7b\xae\xa1\x6b\xb9\xad\x95\xde\x19\x58\x02\x25\x87\xad\xac\x16\xb2\xc9\xd2\xe1\x45\x5a\x40\xda\x0a\xd9\xdf\x15\xb7\x42\x56\xea\xd6\x3d\x28\xd5\x6e\xc7\x5f\x1b\xec\xb8\xe6\x16\x2b\x78\xf7\xe3\x8f\x2b\xd8\xf3\xb6\x47\x03\x56\x41\xa9\x76\x1d\xd7\x08\xb7\x1b\x94\xd0\x2a\xb5\x15\xb2\x81\x5a\x8d\xe6\x5e\x97\x4a\x56\xc2\x0a\x25\x79\x0b\x3e\x5e\x03\x5c\x56\xb0\x43\xbb\x51\x95\x49\xf3\x24\x99\xcf\x41\x63\x23\x8c\x45\x7d\xd1\xcb\xd2\xc0\x46\xb5\x95\x01\xbb\x41\x90\x7c\x87\x06\x54\xed\x6e\xea\x5e\x96\xa4\xc9\x40\x6f\xb0\xa2\x65\x56\x0d\x2b\x07\xe5\x37\xf7\x6e\x95\x33\xb2\x47\x6d\x84\x92\xcc\x65\xe0\xd0\xc6\x12\x76\xbc\x5b\x7b\xb0\xae\x6f\x94\x6a\x7f\x75\xc8\x36\xe9\x02\x9e\xfd\xb3\xba\xc7\x22\x99\xa5\x9f\x82\xb6\x74\xf1\xbb\x45\x57\x96\xcb\x8a\xeb\xea\xc2\x79\x9a\x2e\x7e\xb7\xe8\x85\xd2\x17\xc8\x6d\xaf\x31\x5d\x3c\x12\x3d\xa1\xed\x58\xeb\x87\xde\xd8\x28\x7e\x52\x30\x8a\x3e\x38\x30\x22\x76\xff\xe5\x5a\x12\x95\x41\xa3\xed\xb5\x34\xc0\xe1\xd6\x3f\x72\x10\x23\x2f\x37\x21\xeb\x01\x05\xcd\x09\x1e\x52\x41\xc9\x0f\xaf\x3c\xd0\x20\xa4\xc3\x90\xc8\x0d\x91\xb1\x0e\x47\xb8\xb9\x87\x6e\xdb\x80\xdd\x70\x0b\xc2\x80\x92\xed\x3d\xa9\x20\x6a\x89\x16\x2b\x67\xcb\xa8\x1d\x46\x1a\x44\xf7\x4c\xd4\xf9\x7a\x7c\xe2\x98\x4d\xab\x3f\x6f\x10\x1a\x94\xe8\x59\x5b\xa9\xb2\xdf\xa1\xb4\xce\x3f\x67\x01\x42\x11\x9b\x03\x95\xde\x09\xbb\x41\x52\x11\x96\x2b\x0d\xba\x97\xe4\x57\x01\x46\x81\xe9\xcb\xcd\x29\x22\xc3\x8e\xdf\xc3\x0d\xc2\x4e\x18\x23\x64\x43\x0a\x6a\xad\x76\x20\x6c\x01\x4a\xd3\x9b\x4e\xa3\x41\x69\xe1\xa6\xb7\xd0\x4b\xbe\xe7\xa2\xe5\x37\x2d\x02\xb6\x06\x6f\x37\xa8\x91\x25\xf3\x79\xf4\xdd\xe5\xc9\xa8\x5e\x97\x64\x46\x23\x08\x69\x3a\x2c\x29\x18\xcd\xed\x06\x35\xb9\x2a\x81\x5a\x99\x1d\xd0\x98\x82\x57\xc0\x0d\x96\xbc\x37\x08\x76\x4c\x85\xd2\x50\xf2\x98\x80\x9e\x2e\x40\x58\xaa\x37\x63\x59\x42\xe5\xf5\x08\xfb\x8c\xa0\x79\x19\x01\x63\x57\xfe\xa2\x80\xca\xc0\xfa\xda\x23\xcc\xde\xa0\xe5\xa2\x35\x39\x64\xeb\x6b\xdf\xac\x58\x58\x5e\x00\x6a\xad\x74\x0e\xbf\x26\xb3\x46\x29\x03\x8b\x25\x84\xfe\xc8\x56\x5d\x2b\x6c\xf6\x32\x1a\x34\x05\xa4\x45\x9a\x27\x33\x51\x43\x8b\x32\x23\xf1\x1c\xbe\x87\xbf\xd2\xda\x99\x67\x20\x48\xd1\x16\xf4\x2f\x99\x3d\x24\x33\xca\xb0\x73\xe0\xf3\x7d\x87\x86\xca\xd9\xf8\x94\x53\xc0\xbf\xf4\xbc\x15\xb5\xc0\xca\x37\x03\x55\xfb\x04\x8d\x8b\xc0\xde\x77\x48\x2d\x8c\xa4\x23\x9e\x0e\x7e\xca\x99\xb0\x2c\x99\x4d\xb5\x2f\xa8\x5f\x6c\x31\x9b\x34\x8d\x47\xf1\x27\x33\x02\xe2\xe7\x02\x2a\x8a\x53\x73\xd9\x20\xe5\x89\xfc\xb7\xf4\xa4\x62\xa4\x2b\x99\x51\x88\x96\xfd\x5b\xc8\x2a\xcb\x61\xb9\x8c\x54\x64\x57\x56\xbb\x60\x67\x16\x96\x60\xd9\xdb\x16\x77\x59\x9e\xcc\x28\x56\xbf\xe4\x23\xdf\x61\x96\xc3\x8b\x25\xa4\xa9\x97\xdc\xe2\x3d\x69\xb6\xec\x6a\xdb\x5c\x71\xbb\xc9\x72\x78\x05\x29\x4b\xe1\xd5\x20\x4e\x62\x93\x48\xd6\x5b\xbc\xbf\x86\x25\xf0\xae\x43\x59\x65\xc7\x6f\x0a\xa8\x82\xc9\x87\x64\x46\x5d\x33\x54\xbc\x81\x63\x6c\x93\x99\x41\x94\xa7\x12\x43\xdd\x34\xf7\xab\xf7\xc2\x08\x0b\xc4\xac\xac\x7b\x4c\xa3\xdc\xd3\x23\x99\x79\xb1\xe5\xd7\x04\x5d\xcc\xa2\x06\x32\xbc\xee\x62\xcc\xd7\xee\xf1\x84\x22\x21\x65\xc7\x52\x4b\xd7\xe4\xbc\x86\xe1\x31\x25\xd3\xef\xc6\x57\xdb\x06\xbe\xf9\x06\x5e\x44\x7e\xfe\x93\x9b\x2b\x8d\xb5\xb8\xcb\x06\xe1\xc2\x95\x25\xdd\xb8\x17\xf9\x13\x86\x03\x83\x3b\xf6\x4e\x5d\x88\x16\x4d\x0e\x7f\x83\x73\x2f\x7b\x6b\x5c\x49\x50\xd6\x42\x8c\x57\x81\xff\x21\xab\x66\x6a\x2d\x0e\x1d\xec\x8d\xd0\xa3\xba\xf5\xf9\x75\x5e\x00\xd5\x47\x11\x88\xeb\xe0\x23\xd8\x28\x34\x52\xff\x62\x49\x55\xe2\x4d\x46\xff\xfc\x44\xf0\x81\x9b\x6d\x86\x5a\x3b\x69\x72\x76\x36\x00\x3c\x70\x22\x3e\x29\xe0\xd6\x30\xc6\x22\x05\x03\xb9\x45\x37\xb2\xbb\x63\x97\x6e\xe4\xf2\x24\x8f\xd6\x17\x4b\x70\x80\x66\xa2\xcb\xbf\x7b\xce\x9f\xe8\xc3\xc3\x41\x85\xbb\xe2\x3e\x56\xd5\x6d\x9b\xc7\xba\xc6\x35\xc5\xe3\xf0\x1e\x92\xf8\x7e\x8c\x87\x30\x0a\x7b\xdc\xe9\xec\x0f\x5b\xdd\xc1\xae\x10\x15\xb8\x46\x1b\x76\x87\x00\x1f\xdc\x0a\xbb\xf1\x2d\x56\xec\x51\x02\xc1\x45\xfb\x52\x25\x34\x83\x15\x8e\x4a\xa2\x85\xd8\x67\x9f\x42\x3f\x62\x5f\x09\x1d\x5a\xa5\xc7\x1a\xd6\xd7\xf1\x76\x02\x3a\x3c\xd7\x8f\x9e\xef\xc7\x42\xd6\x6a\x64\xa3\x1f\x77\xd9\x27\xe4\x15\x91\xad\x12\x3a\x1f\x40\xf8\x03\x19\xff\x4a\xc7\xa8\x0d\xba\x4e\xe8\x06\x6d\xf6\x11\x6f\x89\xd0\x2b\xb4\xd9\xd8\x3b\xc9\xad\x91\x60\x74\xe7\xc9\xe5\xda\x38\x39\x2a\x6b\x35\x76\x36\x51\xfb\x07\x97\x86\xbc\xce\xe1\xb7\xdf\x0e\x0a\x78\xd5\xd7\x54\xc0\xb4\xb6\x80\x94\x35\x2a\x75\x22\x4f\x4a\xfc\x6c\xd1\x58\x2f\x46\x36\x67\xa5\x92\x56\xc8\x1e\x03\x47\x29\x3a\x25\x07\x24\xc6\x9a\x18\x98\x32\x38\xee\x20\xf3\x3a\xec\x9d\x8b\x99\xb6\xea\x8a\xbd\xc1\x9a\xf7\xad\x8d\x2f\x98\x9b\xa7\x97\x83\x02\x7a\xae\xb6\x03\x2a\x4e\xe4\x03\xb7\xe5\x86\x12\x95\x55\x42\x17\x6e\x3f\xfb\x5a\xad\x9f\xc6\x27\x14\xbc\xa8\x41\x6d\x83\xbc\x92\xe3\x56\xa0\xe4\x18\xc8\x20\x3d\xe9\x67\x4a\xba\xdd\xea\x9c\x32\x38\xb9\x1f\x37\xeb\x53\x29\xab\x87\x58\xfc\x49\x8b\x5d\xd1\x8f\x8b\x86\xc8\x30\xe9\x71\xff\x52\x42\x4e\x22\x74\xc5\x5a\xc0\x79\x9e\x9c\x8c\xf4\xf9\x40\xc9\xb2\x1b\xa9\x28\xf1\xf5\xce\xb2\x55\xa7\x85\xb4\x75\x96\xba\xd1\x47\x49\x38\x33\x90\x9d\x99\x3c\x2d\x86\x79\xc4\xd9\xa7\x1c\xd0\xb1\x27\x1f\xf3\x1c\x20\xae\xb0\x6c\x47\x5e\xd6\xec\x0d\x96\x6d\x40\xb8\xae\x0a\xca\x28\xed\xf0\x58\xb6\x2c\x7b\xc9\x8d\x65\x74\xca\x20\x99\x08\xd5\x0b\xb5\xa5\xc4\xd5\x15\xfb\x84\xe5\x9e\x12\x49\xb1\x10\x5f\xeb\xca\xf1\x99\x5d\x9a\xb7\x77\xd4\x4c\x91\xc6\x02\xa7\x78\x9a\x4c\x87\xc6\x4c\xd3\x5a\xf2\x02\x4b\x14\x7b\xd4\xb4\x30\x0b\x3a\xd9\x7b\x61\xec\xfa\xfc\xda\x4d\x19\xce\x6c\x74\x7d\xe2\xf7\xd8\x3a\xd6\xa1\xd5\xbc\x4a\x59\xfa\x8a\xf4\x86\x7d\xf4\xd9\x0d\xe1\xb0\xa0\xbd\xfc\x8c\x26\x19\x77\xaa\x48\x4f\x9d\xfa\xd2\xc2\x4b\xf9\x73\xca\x02\xc0\x87\x1b\x9e\xfe\xe4\x4f\x68\x0b\xa8\x58\xb8\x0c\x2f\x3e\xb8\xb1\x7a\x01\x10\xd3\x33\x59\xf4\x01\x8d\xe1\x0d\x2e\x0e\xa1\x0d\x07\x8d\x33\xc3\xce\x0c\x1d\x24\x2a\xac\x85\xc4\x0a\xce\xe8\x20\x1b\x8c\x1e\x6a\x03\xc7\x91\xdc\x6b\x7d\x38\xe0\x3c\x41\x78\xe9\x47\xee\xac\x2e\xfc\x60\x22\x81\x9e\x7e\x54\x15\xe6\x40\x33\x4e\x20\x3c\x6f\xdb\x88\xbf\x0c\xe0\xff\x83\xb7\xed\xdb\xbb\x4e\x1f\x81\x4f\xa5\x52\xf2\xb6\x65\x3f\xe8\x26\x0c\xb7\x44\x80\x83\x83\xe9\x9a\x4c\x91\x8b\x5e\xf2\xa2\x97\xf9\xf5\x61\x71\x87\x29\xc6\xb9\x39\x23\x96\x16\xf1\x9c\x1b\xdd\x98\x9e\xc6\x9c\x9a\xfc\xb8\xee\xff\x6c\x8c\xe5\x09\x84\xf7\x47\xf8\x9e\xc2\x30\xcc\xe6\x67\x26\x3b\xab\x72\x02\x31\xe6\x26\xe2\x78\x14\xef\x69\x00\x8f\x32\x45\x2f\x1e\x92\x19\x7d\xab\x61\xab\x56\x94\xb8\xb2\x74\xf0\x9a\x84\x4c\x39\xcf\x44\x01\x5f\x40\x48\x3b\x01\x37\xe8\x89\x82\x6b\x71\xcd\x3c\x8d\xe1\xfb\x61\x9b\x5b\x7f\x89\x0f\x13\x67\xe9\x99\xa1\x63\x8a\xcb\xc1\xa4\x11\xc2\x3e\xfe\x6e\x31\x8d\xfe\xc6\x1d\x8a\xc7\x41\x83\x60\x2d\x80\x8e\x05\x1b\xbc\xff\x8b\x8e\x8f\xb9\x81\x56\x58\xd4\xbc\x75\xc7\x63\x3a\xdd\xd5\x42\x1b\x0b\xf6\x56\x91\x02\xae\x1b\x77\x12\x8e\x73\xc8\x23\xaa\xc0\x21\x81\x21\x8b\x53\x87\x90\xb6\x70\x99\x71\xcd\x89\x5c\x7d\xef\xce\xb6\xae\xf1\x0d\xac\xa6\xfe\xe3\x6b\xe0\xef\xdc\x88\xf2\xbd\xb0\x79\x32\xa9\x80\xb0\xcc\x1d\x7e\xa8\x9f\xfb\x41\x60\xf5\xf9\xd3\xe5\xc7\x77\xd3\x8c\xa7\x69\x01\xe7\x34\xe9\xb4\x06\x1d\x78\x21\x23\x27\x4d\x7e\xfb\xac\xc9\x71\xe5\x91\xd5\xcb\x8f\x9f\x9f\x35\x49\xbe\x0e\xbb\x57\xf8\xc0\xc7\xfe\x23\x7f\xe9\x95\xc5\x2c\x06\xf2\x13\x6f\x7b\x7c\x6e\x54\x7a\x2a\x92\x47\x9a\x7f\xb0\x4a\x64\x13\x67\xff\xb8\xe6\xf0\xfc\xa8\x44\x5c\x11\x44\xfe\x8d\xbb\xc6\x01\xff\xe2\x19\x99\xe8\xe5\xce\xc5\xaa\x06\x1e\xbe\x6c\x0c\xab\x06\xc6\x4c\xb6\x1e\x74\x2d\xd1\xb5\xba\xb0\x91\x52\xec\x74\x44\xb3\x5c\x47\xa4\x30\xc0\xb3\xb2\x5c\x3b\xd1\xef\x62\x23\x42\xa0\xef\x01\x5c\xb3\xff\xc5\xa1\x5f\x54\xc7\xab\x2e\x2b\x94\x76\x5c\x12\x82\x14\xbe\x8f\x4f\xe3\x4e\xd3\x50\x66\xb1\x83\x3e\x19\x62\xfc\x90\xe8\x38\x34\xd4\x16\x77\xb7\x80\x77\xf4\xa1\x86\x20\x3a\x9e\xed\xe3\x32\x57\x76\x5c\xde\x87\x84\x0c\x0d\xfb\x74\x32\xcc\xad\xb0\xe5\x06\x30\xc4\x44\xe9\x75\x15\x54\x72\x83\x30\x46\xb8\x18\x63\xc3\x10\xda\x28\xb1\x42\xfa\x40\xa0\x5c\xf6\x0e\x04\x57\xd8\x9e\xce\xc3\xff\x07\x00\xd0\x9c\x6f\xa0\x19\x17\x00\x00")
+
+func jujugenerateapidocPlatformGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocPlatformGo,
+		"jujugenerateapidoc/platform.go",
+	)
+}
+
+func jujugenerateapidocPlatformGo() (*asset, error) {
+	bytes, err := jujugenerateapidocPlatformGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/platform.go", size: 5913, mode: os.FileMode(436), modTime: time.Unix(1791995789, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocProfileGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x4b\x4f\xe3\x3a\x14\x5e\xc7\xbf\xe2\xe0\x05\x4a\xae\x4a\x7a\xd9\xde\x2a\x77\x53\xcd\x63\xc3\x08\x89\x41\xb3\x40\x2c\x4c\x7a\x1c\xac\x3a\xb6\xc7\x76\x98\x41\x23\xfe\xfb\xe8\x38\x49\x93\x42\xcb\x63\x11\x1e\xc7\xe7\x7b\xf8\x3b\xb1\xe3\x44\xbd\x15\x0d\x42\x2b\x94\x61\x4c\xb5\xce\xfa\x08\x39\xcb\xb8\xd4\xa2\xe1\x2c\xe3\x36\xd0\x4f\xdf\x99\xa8\x5a\x9c\xfd\xb9\x74\xce\x5b\x39\x2f\x44\x2f\x6a\xe4\x8c\x65\xbc\xb1\x6e\xdb\x94\xca\x2c\xd1\xfb\xc6\x96\x0f\xe7\x9c\x15\x8c\x3d\x08\x4f\xcc\xb5\xeb\x2e\xbd\x95\x4a\x23\x54\x40\x2a\xe5\x55\xf4\xca\x34\x39\xaf\x5d\x47\x9c\x4a\x23\x5f\x00\xa7\xe7\x97\x57\x11\x41\xc0\xfa\xf2\x1a\x86\x25\x88\x16\xe2\x3d\x82\x11\x2d\x6e\x80\x68\x78\xc1\xb2\x16\xdb\x23\xa4\x2d\xb6\x47\x48\xef\x51\xb8\xe3\xac\x60\x0d\xe0\x6f\x15\x89\x3d\xed\xec\x33\x91\x3f\x67\x4f\x2b\xcf\x88\x09\x87\x75\x17\x95\x35\x90\xd6\x0f\x5a\x2e\x18\x5b\x2e\x21\x44\xe1\x63\xef\x5c\x99\xa6\xff\x37\x80\x30\x8f\x83\x31\x2a\x7a\xfc\xd9\x61\x88\xb8\x81\xbb\xc7\xa4\x1e\x4a\x82\x7e\xbf\x47\xf0\x18\x3b\x6f\x28\x87\xce\xd4\x49\x30\x44\xeb\xc2\x0c\x2c\xcc\x06\x92\xaf\x40\xa9\x11\xce\x63\x2b\x94\x21\xe2\x61\xef\x61\x05\x2a\x42\xdb\x85\x08\x77\x08\xb5\xd0\x9a\xa4\x50\x5a\x8f\x29\x01\x65\x9a\x92\xc9\xce\xd4\xcf\xdc\xe6\x05\xe4\x24\x97\xc4\xf3\x02\xd0\x7b\xeb\x17\xf4\x8b\x1e\xeb\x0b\xf8\xc3\x32\x1a\x3a\x35\x05\xb8\xb9\x9d\xf7\xb1\x8c\xaa\x50\xed\x81\x09\x90\x10\x52\xf9\x10\x3f\x8d\x44\x2c\xcb\xa4\xf5\xa0\xe0\xbf\x0a\x34\x9a\x24\x1a\x0a\x38\x83\xf3\x15\x28\xf8\xbf\x82\x7f\x57\xa0\xce\xce\x12\x3a\x53\x92\x40\xd4\x9a\xda\x6e\xd4\x6d\x5e\xac\x52\xe9\xa4\x02\xa3\x34\x9c\x9e\x4e\xf4\x55\x5f\x4a\xc0\x6c\xaa\x52\x3b\x95\x9e\x58\xff\xf4\x31\xef\x60\x8c\x8a\x4a\xc2\x3f\xb3\x57\xf9\xa4\x02\xce\x93\x03\xb9\x18\x0d\xd8\x50\xae\x3d\x8a\x88\xf9\xac\xb3\x60\x3b\x8f\x83\x21\x02\x8d\x12\x46\xe9\x04\x6f\x6c\x79\x21\xc2\x36\x47\xef\x8b\xc1\xc4\xb4\xb1\x74\xf2\xca\x2b\x1a\xc6\xfa\xf2\x7a\xe0\xcd\xe5\xfe\x36\x13\xab\x2c\xd7\xda\x06\xcc\x8b\xc3\x12\xdf\x6c\x44\x49\x1a\x0b\xe0\xb5\x30\xc6\xc6\x7e\xc4\xf3\xf3\xc6\x47\xfd\x14\x27\x54\x20\x9c\x43\xb3\xe9\x87\xb0\x78\x39\xbe\x6c\x74\x67\xdd\xcc\xdc\xdc\xc0\xdc\xd4\x53\xb1\x0b\x73\x3a\x64\x6f\x64\xb9\x6b\x3c\x12\x25\x39\xcb\x8b\x8f\x86\x9a\x58\xfb\x50\xdf\x8e\xf2\x35\x89\xa3\xa1\x26\x85\x0f\xc7\x39\xfa\xb2\xee\x3d\x21\xce\xee\xc1\x29\xc5\xf7\x4a\x1d\x4c\x7b\x62\x2c\x58\x76\x30\xef\xd1\xd2\xcb\x84\xc9\x55\x96\x6d\x50\xa2\xdf\x33\x9c\x2d\x97\x70\x21\xb6\x08\xa1\xf3\x48\xf7\xd2\xf8\xb2\x81\x47\xa9\xb1\x8e\x01\x84\xd6\x43\x23\x2d\x0b\xad\x6d\x2d\xe8\x82\x0b\xd0\x8a\x0d\x42\xb0\x20\x85\x2f\xa9\x65\xf8\xfc\x94\x5f\xd6\xf9\xdc\xe1\xee\x9c\xfc\xa0\xdb\xef\x2b\x0a\xf7\xda\x41\xd9\xdf\xc3\x81\x11\xa6\x3b\x74\xef\x93\xc1\xa7\x1d\x0e\x60\xa3\xf4\x34\x8d\xa1\x16\xa2\x75\x0b\x30\x4a\xb3\x27\xf6\x77\x00\x6e\xdb\x54\xa3\x6c\x07\x00\x00")
+
+func jujugenerateapidocProfileGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocProfileGo,
+		"jujugenerateapidoc/profile.go",
+	)
+}
+
+func jujugenerateapidocProfileGo() (*asset, error) {
+	bytes, err := jujugenerateapidocProfileGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/profile.go", size: 1900, mode: os.FileMode(436), modTime: time.Unix(1791993905, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocProgGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7d\x7b\x73\xdc\xb6\x96\xe7\xdf\xdd\x9f\x02\xee\x5d\x3b\x6c\x9b\x66\xdb\x35\x5b\xb9\x55\x72\x94\x2a\x5f\x3f\x6e\x3c\x1b\xc7\x5a\x4b\xc9\xad\x2d\x8d\x2b\x03\x91\x60\x37\x2c\x36\xc1\x10\x68\xc9\x1a\x8f\xbe\xfb\xd6\xef\xe0\x00\x04\xfb\x21\xdb\xb9\xf9\x63\xa7\xe6\xc6\x6e\xf0\xe0\xe0\x00\x38\x6f\x1c\xc0\x8b\x85\x38\x5b\x29\xb1\x54\xad\xea\xa5\x53\xb2\xd3\x95\x29\x45\xd7\x9b\x65\x2f\xd7\x42\x5b\x71\xb1\x69\xab\x46\x55\x42\x5a\x21\x5b\x21\xad\x55\x4e\xe8\xd6\x19\xf1\x71\xf3\x71\xe3\xc1\xa7\x8b\x85\xb0\x46\xb8\x95\x74\xe2\x5a\x89\xca\xb4\xdf\x39\xd1\x2a\x55\x09\x67\x44\xaf\xd6\x6a\x7d\xa1\x7a\xfc\xbd\x34\xeb\x4e\x37\xca\x43\xf2\x18\xe8\xac\x5b\x61\xfa\xca\xc3\x04\x4a\x84\x5b\x01\x55\x69\x8b\x69\x27\xcb\x4b\xb9\x54\x62\x2d\x75\x3b\x05\xbc\x55\x4a\x2c\xb5\x5b\x6d\x2e\x8a\xd2\xac\x17\xa0\x84\xfe\x23\x9e\xfc\xed\xfb\xc7\xb2\xd3\x56\xf5\x57\xaa\x7f\x5c\xcb\x52\x56\xea\x71\xa3\xad\x7b\x5c\x29\x27\x75\x63\xa7\x53\xbd\xee\x4c\xef\x44\x36\x9d\xcc\x54\x5b\x9a\x4a\xb7\xcb\xc5\x47\x6b\xda\xd9\x74\x32\xab\x1b\xb9\xa4\x3f\xd7\x0e\x7f\x2c\xcd\x42\xda\xf0\xb7\xd2\xb4\xd6\xc9\x36\xfc\xec\x64\x6f\x55\xcf\x3f\x9c\xb9\x54\x6d\xf8\xfb\x4d\xa7\x2c\xfe\xbe\x72\xeb\x66\xe1\xd4\xba\x6b\xa4\x53\x68\xd0\x66\xa1\xcd\xc6\xe9\x06\x3f\x1a\x43\x23\x19\x02\xed\xa4\x5b\x85\x3f\x17\xb5\x6e\x54\x68\xe8\x55\xdd\xa8\x92\xc6\xec\x37\xad\xd3\x6b\x42\x64\x4d\x4f\x4d\xd6\xf5\xa5\x69\xaf\xf8\xaf\xba\x5d\x12\x32\x7b\xd3\x96\xf8\xd3\x43\x4f\x27\x7e\x87\xad\x12\x95\xea\x54\x5b\xa9\xb6\xd4\xca\x0a\xbb\x32\x9b\xa6\x12\xad\x71\xe2\x42\x89\x6e\x83\x4d\xc5\x92\x13\xfc\xd2\x14\x6b\x53\x09\x50\x92\x63\xe3\xdd\x4a\xdd\x84\x1e\xa5\x59\x2b\x51\xf7\x66\x1d\xa1\xad\x02\x8d\xaa\x22\x8e\x10\x57\xaa\xb7\xda\xb4\x85\x38\x5b\x19\xab\xc4\x35\xfd\xb7\x31\xa5\x74\xda\xb4\x04\xef\xe9\xb0\xc2\xb4\x40\x31\xea\x25\x64\xaf\x84\xdf\x21\x55\x11\xf0\xc5\x4d\x04\x7a\x58\x2c\x0d\xd1\x64\x85\x6e\xad\x53\xb2\x2a\xb0\xe4\x5b\x7c\xa0\xfa\xde\xf4\x76\xb6\xe7\x0b\xfd\x27\x72\xc7\x97\x21\x16\x9e\x7f\x0e\x02\xf6\x5d\xb9\xe8\xbb\x32\xee\xd1\x01\x38\x2f\x23\x40\x5b\x99\x72\x0b\x59\x6f\x96\x9d\xea\x3a\x85\xaf\x10\x0e\xe9\x88\x17\x23\x0f\x2d\x4d\x23\xdb\x65\x61\xfa\xe5\xe2\xd3\xc2\x19\xd3\xd8\x05\xf1\x1e\xc9\x03\x43\x74\x97\xcb\x42\xb7\x0b\xd5\xf7\x4b\x53\x5c\x3d\x9d\x4d\xe7\xd3\xe9\x95\xec\xc1\xe1\x56\x95\x9b\x5e\xbb\x9b\xf7\x0a\x2b\x2a\x8e\x05\x18\xbc\x38\x75\xbd\x6e\x97\xd9\x2c\x7c\x7d\xdc\xd3\xe7\x59\x2e\x66\xf8\xdf\x75\xaf\x9d\x12\x52\xf8\x56\x61\x6a\x21\x97\xaa\x75\x8f\x65\x59\x2a\x6b\xf5\x45\xa3\xc4\x5a\xb9\x95\xa9\xac\xb8\xd6\x6e\x65\x36\x4e\x74\xaa\x5f\x6b\x8b\x6d\x17\xe5\x4a\x95\x97\x16\x82\x8c\x6d\x6b\xe5\x5a\x79\x3e\x9a\xcd\xa7\x93\x4e\xb6\xba\x64\x5a\x84\xd8\x26\x87\xbe\x1e\xa0\xe5\xdf\x4f\xdf\xfd\x92\x10\xe4\x37\x46\xd4\xb2\x74\xa6\xbf\x11\xd4\xf3\xc0\x98\x10\x8c\xd2\x89\xf0\x7f\x3c\xe6\xdf\x8d\x69\xb2\x99\xff\x36\xcb\x45\x2d\x1b\xab\x72\x31\xab\xa5\x6e\x84\xae\x81\xa6\x57\xc4\x8b\xb2\xbd\x11\xd7\xb2\x6f\x21\x5c\xf9\x81\x71\x4d\xcf\x1f\x20\x28\xd2\x89\x32\x95\xac\xca\x94\x9b\xb5\x6a\x9d\xaa\x72\xe1\x7a\x25\x9d\x6e\x97\x82\x16\xab\x5d\x42\xbd\x89\xd2\xac\xf1\xdd\x42\xce\xc2\x48\x58\x2c\xeb\xa4\xb3\xaf\xa1\x2d\xc5\x9e\xc5\xa2\xaf\xe3\x55\x42\x93\xb6\x8e\x28\xf2\x92\xd5\x6f\x5a\x12\x5f\xac\xde\x63\x52\x76\xd0\xe3\xc4\x87\xc5\x29\x10\xe4\xfb\xd7\x6c\xad\x9c\x7c\xdd\xc8\xa5\xd8\x3b\x34\xbe\x86\x91\xf7\x61\x7e\xab\x9c\x14\x95\xb2\x65\xaf\x2f\x30\xd9\x28\xe3\xd6\x6c\xfa\x52\xd1\x98\xd7\x2b\x5d\xae\x84\x1b\x0c\x0f\x58\x07\x0a\x4b\xc8\xb6\x12\xff\x30\x23\x7d\x20\xab\x4a\x55\xb3\x39\xf8\x7a\xb1\x10\x9d\xec\x9d\x96\xcd\xab\x4f\xda\xbd\x30\x95\x12\x2b\xd3\x54\x58\x78\x25\xd4\x27\xed\x68\x15\x36\x56\x6c\xac\xaa\xc4\xf5\x4a\xd1\x42\xc0\x64\x84\x7d\xf0\x43\x5d\x63\xb1\x7b\xed\x9c\x6a\xc5\xc5\xc6\x09\x4b\x4a\x8d\x37\x31\xdd\xbf\xb4\xab\xaa\x0a\xf1\xc6\x89\xf5\xc6\x3a\xb1\x96\x8e\x27\x10\xec\x02\x04\x05\x54\x58\xb9\xf6\xeb\xc9\x86\x6d\x50\x01\xc5\x94\x60\x77\x66\x70\x2c\xfe\x8d\x66\xa6\xfa\xfe\xc4\x7f\x82\xdd\xed\x95\xdb\xf4\xad\xaa\xc4\xc5\x8d\xe8\x37\xed\x5b\xa9\xdb\x38\xa1\xf1\x6c\xd0\x57\x43\x27\x96\x66\xdd\x35\xca\x29\x71\xa1\x4a\xb9\xb1\x2a\x11\x15\xaf\x15\x0b\x52\x0c\xc9\x38\xc7\xc2\xab\x8d\x5f\xd4\x75\x36\x3b\xb8\x08\xc9\x0a\xcc\xe6\xd3\x69\xbd\x69\x4b\xb2\xc5\xd9\x5c\x7c\x9e\x4e\x48\xa0\x4e\x60\x0e\x33\x62\x5b\xd3\x9d\xf4\xa6\xd6\x8d\x6e\x97\x39\xd0\x8b\xa3\x63\xec\x4a\xef\x62\x33\xe0\x74\x4d\xdf\xee\x1d\x8b\x56\x37\x40\x33\x69\xcc\xb2\x78\x2d\x9d\x6c\x32\xd5\xf7\xf3\xe9\xe4\x76\x3a\x01\xc4\x71\x98\xfd\xd0\xeb\xa9\x47\x99\x0c\x94\xcd\x9f\xe1\x83\x38\x1e\xd0\xd1\x4f\x34\x3e\x25\x54\x3c\xde\xf1\x71\x3a\xfd\x30\xec\x49\xaf\x5b\xc7\xc3\x4e\x8c\x2d\xb0\x35\xd9\xd6\x36\xcd\x53\x34\x77\x92\x7d\xcb\x4b\x14\xe9\x46\x17\xd3\x03\xfa\x1a\x94\xb7\xea\xfa\x4d\x5b\x9b\x7f\x42\xb7\xf5\x99\xb1\xc5\xa9\xab\xcc\xc6\x61\x7a\x6d\x6d\xe2\x9a\x05\x47\x08\xb0\xd9\xf5\xde\x25\xf3\x3c\xc2\x7b\xf8\x56\xda\xcb\x48\xc3\xe4\xba\xa8\xb5\x6a\xaa\x6c\xf6\x0a\x63\x83\xcf\xec\x2c\x17\xba\xad\x4d\x31\xb4\xe4\xa2\x51\x6d\xb6\xd5\x38\x9f\x27\xbd\x4f\x55\xeb\x74\xab\x1a\xea\x13\x31\x8c\x5b\x13\x2c\xe3\x0f\x23\x4c\xef\x3a\x96\x73\xd9\x04\x34\x49\x53\x82\x23\x69\x1d\x21\x78\xbe\xa9\xb4\x7b\xf5\xa9\x6c\x36\x50\x07\x8c\x62\xd4\x98\x20\x19\xb5\x8f\xd0\xfc\x33\xe8\x58\xc6\x10\x7e\x27\x9d\x43\xd3\xa8\xdf\x6b\xaf\xf4\x4f\x48\xe7\x87\xce\xa3\xc6\x04\xc3\xa8\x7d\x84\xe6\x79\x75\xa5\x7a\xa7\x6d\x32\x85\xd8\x92\x8b\xa7\x29\xe8\x2f\x6a\x69\x9c\xa6\xa5\x08\xb0\x49\x53\x32\x5a\xd2\x3a\x1a\xeb\xec\xa6\x53\xaf\xe5\x5a\x37\x7a\xd8\xfc\xb4\x2d\x41\x91\x36\x8f\x70\xbc\x06\x2d\xb1\xb7\xff\x95\xf4\xf3\x0d\xe3\x1e\xa4\x41\xc6\x0c\x93\xb6\xa5\xbd\x93\xe6\xf9\xc0\xe1\x47\xc7\xe2\xba\x28\x1b\x03\x8d\xf2\xec\x1b\x78\x5e\xd7\xe2\xe1\x96\xcb\x73\xef\x58\xcc\x66\xd4\x2f\xc1\x0d\xc1\x3b\x1d\xc1\x65\x5b\xfd\xfc\x74\x77\x07\x3f\x38\xfa\xe4\x36\x52\x90\x7a\x39\x07\x87\x87\xe1\x84\x71\xcf\x52\xf0\x5c\xec\x61\x9e\x3f\x45\xc3\xe0\x3c\x7c\x05\x05\x11\x38\x4f\xac\x31\xf9\x07\xd9\xfc\x4f\x2d\xc1\xae\x20\x89\x1f\xc5\x93\xa8\x2d\x49\xdb\xd6\xd9\xec\x7e\x15\x1d\x1e\x91\x21\xa4\x83\x65\x0b\x5d\x84\x55\x25\x38\x3f\x98\x55\xb3\x71\xdd\xc6\xcd\x67\xf9\x1e\xec\xc9\xee\x93\x47\xb7\x35\x5d\x72\x49\xe1\xbd\x94\x2e\xfb\xd3\xdb\x8a\x51\x69\xab\x2e\x55\x75\x68\x3a\x8b\xfb\x55\xb0\xb7\xec\xbf\x58\x11\xfa\xb0\xed\xee\x6f\xc8\x25\x32\xa2\x52\x0e\x4e\x73\xab\x84\xf7\xab\x45\xe6\x56\x30\xe2\x56\xb4\xa6\x5f\xcb\x26\xcc\x34\x8e\xe9\x7f\xca\xa6\xf1\xb2\x94\xcc\x7a\xbf\x38\x1d\x5a\xf2\x2f\x18\xf8\xa3\x59\x7e\x00\x21\xb6\xb8\x36\xbd\xf8\x3d\x17\x0a\x5c\xd4\xcb\x76\xa9\x76\xc5\x9b\xc6\x1c\x0d\xfa\x1f\xee\x3e\x14\x88\x2a\xde\x2a\x6b\xe5\x52\xf1\xba\x26\x8b\xce\xf6\x98\xd6\x9a\x5b\x5b\xdd\x4c\x6f\xc9\x2d\x1a\x78\x92\x3c\x4b\xff\xdd\x7b\x7c\x70\x45\x2b\xe9\xa4\x00\x5d\x89\x37\xa9\xaa\xd4\x6f\xcb\xbd\xfb\x81\x85\xe7\xb8\x55\x86\x68\x57\x3c\x06\x0a\xef\xdf\x7a\xa3\x3d\x1e\x2d\x9b\x8b\xec\x61\xe2\xd7\x92\x71\x36\x3d\xf9\x3d\x57\xb2\x47\x20\x24\x53\xbf\x97\xf6\xe3\x61\xf4\x9f\xf7\x09\x1f\xe2\xbb\xe2\xd7\x76\x2d\x7b\xbb\x92\x4d\x76\xfe\xe1\xe2\xc6\xa9\x2c\xf6\x99\xe7\xe2\x01\xfe\x7e\x98\x49\x5b\xdd\xe4\xcc\xa9\xbf\x18\xa7\x6a\x88\x5f\x2e\x66\xba\xbd\x92\x8d\xae\x92\x19\xcd\x06\x06\x46\x5b\xf1\x8f\xb0\x38\xe2\x98\x7c\xed\xe2\x17\x73\x9d\xcd\x8b\x5f\xcf\x5e\x04\xd7\xaa\x33\xe5\x0a\x34\x1a\x5b\xfc\x43\x39\xd5\x5e\x65\xb3\xd3\x77\xbf\xbe\x7f\xf1\xea\xf7\x97\xcf\xcf\x5e\xfd\xfe\xea\xe4\xdd\x8b\x9f\x66\xa0\x8c\x00\x87\xd9\x2d\x16\xe2\x79\xd3\x98\x6b\x84\x68\xbd\xa9\x36\x25\x45\x89\x17\x1b\xdd\x54\xf6\x99\x80\x68\xaf\x9c\xeb\xec\xd1\x62\x91\x02\x3c\xf6\x00\x14\xdd\xda\x4e\x95\x76\xe1\x23\x84\xc7\x95\x74\xea\x31\x8d\xb1\x28\xa6\x93\x89\x55\xa5\x4d\x3c\x49\xca\x79\x78\x87\xf3\x0d\xbc\x36\xc0\xe5\xe2\xe9\x93\x5c\x7c\xff\xbf\xe6\xc3\x52\x7f\xfb\xca\xfd\xcf\x3d\x73\x65\x56\xdd\xbf\x7e\xbf\xb6\xfa\x53\xe6\xa9\x7b\x12\xd7\x31\xae\xb6\xf9\x8d\x63\x18\xf2\x60\x69\xc1\xb9\x05\xcb\xcd\x24\xd1\x5e\xe7\x09\xb7\x8f\x74\xb3\xff\xe5\x79\x1d\x16\x43\x84\x8c\x15\xb4\xe2\xd5\x6e\xf0\xc6\x3c\x3c\xd6\xef\xf8\x80\x65\x23\x7f\xfc\x0a\xb9\x3b\xd5\xd7\xb2\x54\x9f\x6f\x13\xc7\x14\x52\x14\xd7\x98\x58\xf4\xad\x67\xd0\x37\xc8\x18\xb9\xec\x8a\x03\xbe\xff\x70\xb3\xf9\x74\xcf\x12\x1f\x52\xa0\x83\x40\xfb\xd4\x57\x41\x5e\x6f\xa4\x2b\x17\x7e\xe0\x27\xdf\x7f\xff\xfd\x7c\x2c\xef\xe4\xf7\xc6\x1f\x7e\x0d\x9e\x9f\xbc\x89\x52\x4d\x56\x0a\x59\x26\x25\x90\x2e\x21\x45\xd4\xaf\x63\x40\x84\x38\xd2\xad\x86\x78\x06\xc1\x7c\x88\xf8\x10\x80\xc6\xb4\x16\x3e\x78\x9e\x54\xd5\x33\xa1\xae\x54\x7f\xe3\x56\xba\x5d\x42\x83\xa8\xc6\xaa\x51\x2c\xa6\x5b\x4a\x82\x7a\x81\x27\x02\xaf\x64\xb3\x51\x94\x08\x11\x8e\x52\x5d\xe4\x05\x59\xd1\xa8\xda\x11\x8a\x75\xe7\x6e\x72\xd1\x2b\x59\xdd\x60\xc3\x2e\x06\x32\x38\xb5\x55\xca\xa6\x51\xfd\x58\xfd\xb0\xd3\x2f\x1e\xea\x18\x28\x24\x9a\xe8\x4d\x08\x13\x58\x13\x55\x16\x42\x1b\xf3\x56\xc5\xf3\x68\x24\xb2\x79\xf1\xb3\xb6\xee\xa5\x4f\x7e\x82\xef\x2a\x2b\x00\x8a\x0c\x5c\x06\x4f\x2e\xe9\x55\xad\x75\xeb\xfb\x45\xf8\xa2\x28\xe6\x94\x86\x3b\x85\x37\x93\xae\x67\xc8\xf7\xc6\x35\xe4\x59\x11\xb4\x6e\x45\x29\x5b\xd3\xea\x52\x36\x3e\xb3\x5b\x4c\x27\xc8\x5a\x16\xa7\x8d\x2e\x15\x0d\x8c\xe9\x66\x3a\x17\x1f\xc1\x91\x73\x71\x61\x4c\x13\x34\x65\x65\xcf\xf5\x87\xe2\x17\x04\xd2\xf7\x8e\x45\x65\xcf\x3f\xf2\xaf\x54\x98\x13\xa0\x1f\x12\x98\xb1\x6d\xf1\x40\x41\x10\x03\x1c\xff\x9e\x4e\x6e\x29\x60\x95\xbd\x13\x47\xa9\x4a\x9c\x4e\xae\x75\xaf\xe0\x12\xd3\xc2\xae\xe5\xa5\xca\xd6\xb2\x3b\xe7\x4c\x5f\x81\x2f\x1f\x40\xf0\x7c\x1a\x2c\x62\x35\x58\xc4\xca\xd2\x3c\x08\xe7\x90\x1e\x2c\xde\x5d\x7c\x44\xbf\x77\x75\x56\x11\x82\xc4\x9c\x42\x80\x87\xfe\xae\x78\x4b\xe9\x35\x4c\xcd\xfa\x10\x7b\x32\x59\xe7\xe2\x77\x80\x84\x8f\x19\xfa\x00\x05\x0c\xce\x1a\xda\x50\xae\xed\xc8\x5a\x0c\x73\x38\x0f\xdf\x3f\x40\x71\xf5\x1b\x85\x6e\xb7\xb1\xef\x7b\x65\x37\x8d\x3b\xdc\xd7\x7f\xdf\xee\xeb\x9d\xbd\xee\x72\x88\xf1\x1b\x23\xab\x13\xce\x4c\xd2\x0e\x47\x24\x77\x69\x8c\x44\x27\x8f\xd5\x06\x79\xa5\xc5\xf3\xaa\x3a\x75\x72\xa9\xb2\x19\xd0\x8b\x98\xf9\x64\x9b\x1e\xf7\x6f\xbc\x7d\x90\x9a\xa0\xc8\xa0\x1c\x6c\xf1\x8b\x8f\xb9\xb3\x61\xc7\xdc\xb0\x63\xe0\x4c\x55\x11\xa9\xd9\x40\x34\x51\x19\x83\x23\xea\x8d\x18\xfd\x96\x38\xfc\x05\x7c\xca\xc4\x31\x15\x48\x28\x29\xb1\x34\x90\xf1\x12\xc9\x21\x02\x63\x71\x36\xbd\xe8\xd5\xb2\x47\xfa\xd4\xb4\x56\x28\xd9\x37\x37\xc5\x74\x42\xa4\xbd\x6b\x9b\x1b\x90\xf2\x20\x11\x6e\x8c\x1c\x06\x3d\x22\xcd\x96\x07\x67\x8f\x17\x9b\x81\x7f\x83\xc9\x97\x4e\x65\x11\xd5\xfc\xd9\xb7\x2e\x74\x0c\xdc\x4e\xcb\x95\x5a\x4b\x16\x8e\x59\x1e\xd4\xdc\x8b\x4d\xdf\xab\xd6\x8d\xbe\xfa\x48\x75\x1d\x3c\xa2\x24\x5d\x11\x1d\xa7\x3f\xb3\xe7\x91\x14\xf8\x52\xb3\x9c\xdc\xab\x10\x14\xbb\xb0\x09\x58\x8e\xf9\x2e\x7f\x44\x23\xf0\x05\xde\xb8\x2e\xa8\x35\x2a\xc8\xe9\x44\x76\xfa\x0d\x33\xcc\x68\x13\x6e\xa7\x93\xe8\xc6\xef\xf9\x06\x4f\x8f\x42\x8b\xce\xe8\xd6\xbd\xd4\xfd\xde\x58\xcb\xd8\xe2\xed\x65\xa5\xfb\xe7\x4d\x93\x8d\xc1\x73\xf1\xe4\x6f\x7f\xfb\xdb\x57\xf9\x79\xc9\x2a\xb1\xe0\x61\xf0\x4a\x5d\x6c\x96\x2f\x37\xeb\xee\xab\xc6\x4e\xa1\xff\xa5\xa1\x65\x9a\x5a\xc1\x30\xa3\x06\xaf\x9e\x6c\xd6\x5d\x2e\xc9\xcb\x59\x0e\xae\x5b\x69\xda\x4a\xc3\x3e\xcb\xe6\xbd\x5a\x6a\xeb\x3c\xbb\x10\x6c\x2e\xaa\xaf\x55\x13\xa9\xeb\x56\xca\x16\x11\x0c\xad\x2b\x05\x01\xc9\x18\xcd\x0d\x84\x4e\x5b\xa7\x7a\x15\xc3\x9e\x59\x94\x60\x38\x0e\x7f\x6c\x74\x79\x49\xec\x40\xfe\x82\xfa\x24\x91\x40\x45\x82\x16\xda\x14\x9e\x81\xa5\x96\x8a\x0e\xbc\x48\xa2\x21\xf2\x96\x44\xc1\xc2\x09\x93\x4d\x43\x5a\x00\x6a\x83\xfc\x10\xe8\x3d\x4a\x6e\xa3\xf7\x65\x6b\xae\xc9\xce\xb7\xe6\xba\x98\x4e\x2a\x55\x93\x41\x89\xc2\x5a\x78\xa1\x7a\xa9\x6a\xdd\x12\xd5\x29\x3f\x8e\x73\x58\xe2\x98\xd5\x94\x37\x0b\xa3\x35\x9f\x4f\x27\x2d\xf0\x3e\x21\x0a\x9f\xf3\x5c\xf9\x30\x42\xb6\x3b\x31\x9e\x77\x69\x4a\x18\xe6\x4a\x68\x7f\x28\x37\x0a\xe1\xb0\x18\x70\x60\xa0\xdc\x44\x2f\x71\x1c\x02\x6c\x2d\x25\x5d\x3b\x4e\xea\x53\x37\x3a\x62\x08\xbe\x80\x69\x95\xb8\xe8\x71\x16\x1a\x48\xa8\x8c\xb2\x38\x0c\x2e\x8d\x75\xb1\xcf\xc8\x83\xa3\x5d\x0b\xab\x68\x30\x92\x2d\xa6\x13\x59\x55\x44\x0a\x66\x45\x8e\x42\x1d\xb4\x91\xa7\x33\x7a\x40\x89\x17\x14\xd7\x6d\x34\x95\xe8\xec\xec\xfb\x1a\x75\x5c\xd2\x08\x4c\x13\xff\xfb\x48\x88\x9a\x9c\x8a\x1c\x6d\xac\xfa\x8e\x44\x1d\x1c\x08\x6a\xe6\xa0\xf6\x08\x94\xf8\x2c\x6a\x36\xc7\x07\xf8\x16\xb7\xd8\x73\x72\xa5\x46\x7e\x84\x5f\x9c\x37\x2f\x3f\xf8\xbf\x14\xec\x6e\xdd\xe5\x4d\x30\x9a\xd8\xf5\x73\xe5\x09\x13\x55\x20\xe6\x16\x16\xba\x0a\xdc\x1d\x0c\xb1\xdf\x18\x9c\x6b\xf9\xd3\x78\x58\xd1\x5c\x98\x9a\xfc\xcf\xe1\x94\x60\xd3\x6e\xec\x46\x36\xb4\xa5\x14\x95\x09\x27\x21\xb9\x06\xc6\x49\xd6\xb5\x2a\xc7\xde\x1f\x61\xc5\x09\x83\x5b\xa9\x35\x18\x60\xb4\xb1\xc9\x5e\xc2\x2e\x12\xea\x62\x3a\xc1\xd8\xaf\xfa\x9e\x44\x80\x0d\xf9\xcf\xbe\xc9\xf4\x41\x61\x78\xe5\x65\x83\x3f\x01\xf0\x73\x7f\x1a\x7b\x72\xb9\xfc\xf0\x8c\x52\x13\xaa\x3f\x94\xde\xe0\x74\xd2\x91\xb8\x6f\xa9\x3b\xce\x80\xb4\x5b\x01\xa5\xe9\xed\xb3\x83\x53\x88\x7e\x85\xd0\xed\x95\x69\xae\xa8\x5f\xd3\xe0\xe8\x23\x88\xc1\x91\xb8\x7f\x05\xcb\x12\x68\x21\xee\xb3\xe7\x4f\x3e\xf8\x6d\xe6\xd1\xc2\x2e\x9f\x6f\x6d\x6d\x2e\x9e\xf8\xb4\x8a\xcf\x95\x1e\xdc\xe6\x41\x77\xfb\xfe\x71\x7d\xb2\x2a\x8f\x0b\xb2\x47\x6f\x07\x79\xc9\x76\xa4\xe4\x33\xd8\xe4\x48\x04\x76\x61\x66\x39\x4a\xf8\x66\x9f\x5e\x65\xf1\xbd\x6f\xb3\xfb\x15\x32\x51\x3b\xdc\x86\x59\x4c\x26\xa5\x41\xba\x9f\x9c\x42\xf8\x84\xbc\x08\x51\xe4\xfc\xef\x5c\x54\xe9\x69\x4e\xd7\x1b\xe4\xbb\x82\xfd\x25\xdd\x0f\x93\x9e\xf3\x8e\x71\x64\x10\xce\x54\xbd\xf7\x99\x44\xaa\xb0\x11\x7d\x71\x70\x01\xfa\xc2\xf7\xcb\x3d\xd0\x7c\x6c\xd5\x98\xd0\x61\x99\xc9\x72\x04\x9b\xb5\x23\x64\x01\x19\xcf\x3e\xfe\x0c\x4b\xf7\x21\x17\x0f\x42\xe3\x5d\xbb\x12\x60\x72\x71\x90\x24\xb0\x84\x1e\xf8\x21\xf4\x60\x77\x9f\xb3\x6a\x6b\x00\x3c\xd8\xfe\x76\xae\x3f\x00\xe5\x7a\xc7\x60\x8c\x8c\xc4\x79\xec\x86\xc9\x3c\x9a\x15\xb3\x47\x6b\x9a\xd7\x87\xb0\x28\xd5\xc0\x77\x5f\x31\x77\xde\x09\x25\xad\x69\x73\x61\x2e\xd1\xb9\x57\x4b\x5b\x38\x65\x1d\x9c\xda\x73\x5d\x7d\x78\x86\x0f\x44\x7a\xec\x7f\xc6\x9f\x73\xb1\xd3\xf4\x9e\x90\x71\xa0\x91\x33\xee\x40\x5d\x4d\x39\xad\xd1\x48\xb5\x92\x6e\xd3\x2b\xa4\xda\x6c\x1c\xed\xc1\x83\x01\xf1\x4f\xd2\x9e\xc9\x25\x52\x34\xbd\x72\xf8\x2b\x47\x53\x11\xe0\xbd\xfa\x63\xa3\x7b\x95\x98\x89\x9d\x4f\xd1\x46\x70\x03\x2c\x17\x21\x99\xfc\x6f\xdd\x56\x47\x62\xcf\xe7\xd7\x03\x5d\xb0\x04\x93\xc9\x6f\x48\x12\x1c\xf9\x09\xa0\xe1\x96\xbd\xa9\x49\x1c\xed\x45\x08\x97\xf5\x7f\xa9\x6c\x9e\x7e\xf9\x3f\x83\x8f\x92\x7a\x0e\x43\x73\x16\x19\x22\x17\xf0\x30\xe6\x7f\x09\x37\x41\x44\xb6\x7c\x15\x72\x85\x5e\x79\x1f\x29\x5b\xf3\x60\xcf\x84\x1a\xf1\xfc\x64\x5d\x30\x48\xb2\xa8\x43\x5b\x2e\x1e\xaa\x79\x12\x43\x06\x8f\x6f\x34\x52\x88\x6a\xbc\x8e\xc8\xbe\x5d\xc4\xbe\xa0\xcd\xbe\xc0\xda\xf3\xfd\x12\x7a\xcd\x60\x59\x3b\x74\x01\x64\xfb\xe8\x11\x92\x94\x14\x8e\x84\xf5\x7d\x74\x4c\xda\x7e\x7b\x6d\x01\xbe\x58\x08\x4c\x32\xb1\x45\x54\x2d\x60\xf3\xe1\xcc\x00\xee\x17\x97\xd8\xf8\x0e\xf0\x25\x51\x4f\xa7\xaa\x98\xe9\x6e\x87\x33\x3f\xe1\xe4\x05\x0a\xb5\xc8\xbf\x02\x38\xf6\x4c\xd4\x7c\x9a\x17\x33\x61\x96\xcf\x66\xd9\x1a\x22\xb3\x1a\x46\x64\x7b\x91\xec\xd8\xf6\x97\x2d\x4f\x29\x04\xa9\x13\x28\x87\x23\x54\x89\xc4\xa9\xee\xfa\x4b\xdb\xeb\xcb\x6e\x13\xcd\xef\x48\x6c\xaf\x51\xf0\x9d\xa2\x3b\x17\x4f\x84\x76\x5c\xb9\xf0\x05\xfb\xc1\xa6\xdf\xfa\x8c\xd5\xf6\x61\xd0\x37\x21\xdb\xb4\xc1\x91\x51\x55\x68\x8d\xfc\x35\xe7\x01\x6e\x53\x87\xd3\xa7\xfc\x76\x50\x86\xd3\xd2\xde\x87\xb5\x81\xb6\x94\xb3\x6e\xbf\x32\xe8\x49\xc2\xb0\x5b\xc4\xb0\xaa\xad\x78\x67\xb2\x3d\xb1\x30\xfb\x38\x5f\x88\x84\x7d\x2f\x46\x23\x8e\x45\x1b\x9a\x90\x7a\xc0\x74\xe2\x39\xd0\x56\x16\x27\xd8\xcd\x40\x41\x95\x30\x6f\xc0\x97\x8b\x7d\x0e\xf7\xfc\xd9\xb7\x4e\x75\xb1\x10\x6f\x65\x7f\x49\x1c\xdc\xf5\xca\xaa\xb6\x54\x69\xe0\xc5\xe9\x56\x38\xa4\x31\x3e\x63\xb1\x42\xbd\x93\xb0\x52\xd3\x29\x17\x52\xba\x42\x5e\x98\x8d\x2b\xa6\x93\xb5\xec\x71\x24\xf7\x35\x59\x97\x89\xdf\x44\xf0\xf8\xd6\xb6\x92\x4f\xe3\x31\x15\x20\xf1\x84\xa9\x4b\x43\xb8\xc8\x19\x0c\xe7\x7f\x4f\x27\x20\x6d\x08\x8c\x55\xac\xbd\x18\x79\xc1\x77\x2f\xd3\x9e\x30\x78\xa9\x1c\xfb\x49\xa5\x19\x62\xdd\x40\xcb\xab\x38\x8a\x38\xf6\x00\xc3\xb7\x71\xdd\x86\x38\x8e\xca\x62\x70\xce\x61\x8f\x6b\xd5\x63\xfd\x83\xcb\xbe\xbd\xe7\xf3\x64\xe6\x49\x15\x87\x38\x16\x66\xf8\x75\xaa\x1c\x6a\xe0\xc2\x54\xd9\x15\xee\x06\x63\x45\x2e\xd9\x7b\xb3\x69\xab\xb3\x5e\x77\x3b\x19\xb9\x6d\x79\xbd\x4b\x92\x79\x73\xb9\xe1\xf3\x74\x30\xda\x42\xcc\x7a\x0c\xf1\xd8\xf5\xba\x9b\x41\xe7\xd0\xd6\x63\x9b\xc9\x10\x41\x8b\x65\x5d\x81\xb6\xf9\x38\xcc\xab\xd7\xae\x38\xed\x38\xe0\xb8\x7f\x85\x58\x63\x96\x0b\x0f\x8a\x3f\x4f\x7a\x73\xd1\xa8\x75\x1a\x03\x7e\x0b\xc9\x9b\xd6\xaa\x5e\xc3\x0f\x80\x52\xf7\xfc\x82\xa5\x4a\xd3\xa9\x5e\x8f\xa0\xae\xb8\x36\xfd\xfa\xc5\x90\xf2\x88\x1c\x15\xbe\x05\xbc\x7f\x65\x8a\x25\xe0\x7e\x9c\xe4\x5a\xb6\xd2\x2b\xdf\x32\xe1\x3d\xd3\x88\xa7\x0e\xcc\x57\x7c\xd2\xa0\x71\xcc\x85\x8c\xc4\x5a\xde\x08\xeb\x38\x40\xeb\x37\x2d\xf4\x36\xc1\xc3\xd4\xf9\xc4\x06\xa4\xbd\xe3\xaa\x1c\xa4\x27\xe4\x25\x4a\x5a\x4b\xd3\x21\xef\x0a\x2d\xa7\xde\x6e\x8a\x9f\x4d\x79\x39\x92\xd6\xb4\xf0\x62\xa0\xf9\xfc\x03\xf3\x51\x5a\x98\x91\xb5\xba\x99\xe7\xa1\x14\xd4\x77\xf1\x74\x07\xec\xbf\xb6\xcd\x16\xfe\xa1\xf6\x07\xc8\xe3\x0f\x9e\x65\x56\xd9\x04\x36\xa9\xe9\x11\xc7\x83\x76\x4d\x9a\xcf\xc0\x20\x20\x3f\x7e\x0c\xca\x4b\x1c\x93\xf6\x1a\x90\xa5\xd5\x3d\x29\xb6\xd7\xba\xad\xd2\x6f\x29\xb1\xdb\x1e\xe9\xb6\x8d\x91\xad\x6c\x6e\xac\x4e\x53\xf1\xcc\x48\x8c\x21\x9e\x6a\xfa\xaa\xc7\x18\x39\x8b\x63\xb1\xb7\x4e\x7a\x28\xbc\x9e\xd1\x39\x60\x7a\x9e\x40\x3f\x90\x76\x50\xc3\x81\x55\x08\xda\x8b\xc1\x9f\x8a\x61\x3c\x18\x06\x38\x2a\x55\x36\xb2\x8f\x16\x01\xfc\x31\x64\xea\x44\x16\x5c\x23\x4e\xf8\x71\x77\xce\xe0\x25\xfd\xb9\xbe\x74\x50\xad\x79\xe2\x55\x11\x2d\xa4\x76\xf7\x61\x58\xcb\xce\xb2\xc7\xc5\xe7\xb5\xeb\x39\x9d\x97\x71\xb0\x4c\x27\x87\xf5\xa6\x69\x84\xbd\x69\x9d\xfc\xe4\x11\xdf\x74\x5c\x3e\x1a\xcf\x34\x9f\xf9\x84\xca\xb8\x88\x3f\xc1\x83\x04\xa5\x50\x9f\xa8\xe8\x89\x4a\x22\x64\x4b\x45\x10\x6e\xa5\x74\x2f\xfc\xc1\x3a\xf2\x7e\x74\x6f\xa1\x42\xed\x7d\xa5\xd6\x18\xeb\xe2\x46\xd4\xba\xad\x5e\xaa\xb2\xe1\xd5\xe6\xa3\xc8\xad\xf3\x1c\xb1\x9b\xd3\x88\x1a\x49\xec\x3f\x1d\x13\xa8\x6e\xf2\x08\x0a\xc6\x94\x1e\x5b\xe2\x92\x03\x1f\xb0\x75\xe7\xfe\x80\x9a\xfa\x41\x4f\x47\x6e\x39\xe2\x22\x64\x9c\x3d\x41\xa5\xfa\xad\xda\xf3\xc1\xf7\xf0\x96\x89\x3e\xf3\x87\x5b\xca\x26\x9e\x48\xb7\x8a\xc9\x44\x27\x52\x5a\xf9\xa0\xa7\x16\xae\x40\x44\x97\xcd\x51\x45\x1a\x00\x4e\x9c\x4f\x3a\x4c\x28\xfa\x2a\x5e\x35\x6a\x9d\x05\xf7\x8f\xba\x9c\x5c\x2e\x81\x3b\x9b\x27\x19\x78\x3f\xb3\xf3\xe4\x63\x72\x7a\xe6\xf3\xf7\x07\x33\x40\x4c\xeb\x70\x48\xc8\xc0\xc9\x71\xd5\xb0\xec\x69\x07\x3e\x9b\xea\xa4\x73\xaa\x6f\x87\x84\xe3\xf9\x87\x70\xf6\xcf\x29\x28\x22\x2e\x64\xa1\x3a\x5e\x17\x4f\x03\x7e\x79\xac\x11\x4d\xd4\x82\xa1\x25\x27\x28\x3e\xa3\x33\xbd\xe3\xba\x70\x1b\x01\xe6\xd3\x49\x59\x2f\x93\x24\x9f\x2d\x5e\x98\xb6\xd6\x4b\xe0\x7d\x6b\x90\x56\x8d\x1f\x90\xdf\x3a\x25\xbe\xc7\xde\xbe\xb6\xca\x1d\x09\x87\x04\x32\x0e\xec\x50\x25\x70\xaa\x9c\x4f\xa7\x52\xbd\x07\x5a\x8e\x38\x21\x8c\x7b\x48\x0f\x3d\x2c\x03\xe6\x54\xf4\x8f\x60\x2a\x96\x3b\xd8\xbe\x14\xbe\xc2\x86\x8e\xcf\xad\x23\xd8\x94\x09\xa3\xf9\x23\xc1\xe8\x8b\x38\x4e\x56\xdb\x14\x65\x2e\x6c\x5f\xe6\x23\xa8\x17\x5c\xb9\x4f\xfc\x90\x87\xf3\xd0\xc1\xad\x1b\xcd\x32\x7b\x50\xd6\x4b\xf4\xf7\x8b\xe4\x4d\xc5\x9f\xb4\xc5\x90\x4c\x71\xff\x8f\x34\x1d\x39\x30\x0a\x9c\xa9\xcb\x65\xb2\xa7\x97\xcb\x98\x5b\xc4\x55\x11\xe6\x49\x30\x79\xec\x3d\x5e\x08\xb8\x0a\x31\xec\xbd\x9d\xee\xa3\x49\x5d\xd7\xd9\x6c\x34\x3f\x51\x79\x3f\x9b\x6b\x25\x76\xc8\xf3\xb5\x1d\x9c\x5d\x6a\x6b\xc3\x70\x36\xd5\x71\xe1\xde\x02\x6b\x6b\x2e\xaa\xc0\x55\xaf\x2b\x45\x45\x1d\x9c\xa0\xcb\x85\x6c\x4c\xbb\x0c\x55\x17\x5c\x83\xdf\x4b\x8d\x6b\x14\x50\xfd\x42\x3b\x1b\x2f\xa9\xc8\xae\x6b\x6e\xd0\xdb\xe1\xf6\x10\xfc\x29\xd2\xb1\xe9\xcd\x0e\x51\xc3\x17\xf4\x25\x7a\x38\x04\xd2\xf0\x28\x84\x76\xac\x09\x07\xaa\xe1\x47\x89\x3d\x4a\x0d\x93\x10\x0f\x87\xe3\x66\xc0\x22\x8d\x3f\xd6\x98\x73\xb1\x9b\x9f\xcd\xc5\xe0\x5e\xc0\xd9\xdb\x6a\x63\x3f\x29\xe5\xd8\x3a\x39\xff\x1d\x47\xe3\x31\x18\xc7\xff\x73\xca\x76\x9a\x04\xe2\xbe\x39\x89\xc2\x9f\x5f\x49\xdd\xc0\x8d\x38\x33\x47\x42\x0e\x3f\xb2\x0a\x32\x07\xcd\x43\x39\x45\xf8\xfc\x56\xc4\x41\x63\xd3\xbb\x3a\xab\x8b\x04\x07\x74\x4a\xf1\xb3\x59\xea\xf6\x4c\xf6\x08\x46\x86\xf8\x2a\x69\x05\xa5\x2f\x4c\xeb\x7a\x83\xba\x97\xa3\xa4\x02\xe5\x8d\x1d\xda\x39\xf7\xe3\x67\x01\x6a\x48\x75\x34\x3c\xbd\xb4\x0f\xb5\xef\x80\x83\x78\xd2\x99\x5e\x91\x92\xac\xd5\x77\x69\xf8\x1a\xe4\xd6\x83\x8a\x07\x82\xe2\x4c\xb2\xf3\x9a\xac\x36\xda\x78\xa0\x5c\xd4\x89\x92\xcf\x45\xb2\x5c\xb8\xee\xe4\x06\x55\xd0\x9b\x25\xc8\x60\x3f\x3c\xe8\xf6\x83\xf2\x5f\x93\x04\xe6\x23\x99\xdb\xd5\x03\x58\x62\xdc\xa2\xe4\x84\x8f\xe9\xfd\x19\x45\x6a\x3a\x5c\x65\xca\x48\x05\xc0\x5e\x9a\x92\xf5\x96\xa7\xa5\x73\x7f\x0d\x1d\xc9\x55\xa6\xfd\x94\xd4\xc5\x4b\x53\xc2\x0c\x56\xa6\xc4\xaf\x53\xef\x92\x1c\xb3\x6f\x72\x62\x38\x48\x21\x82\xbe\xa2\x78\xe6\x4a\xf6\x41\x9c\x77\x25\x28\xaa\xc2\x2f\x97\xd6\x1c\xac\xac\xa9\xd7\x89\xa0\xf9\x6f\x49\xca\xab\x65\xe1\x42\x1e\x88\x4f\x93\xc7\xe2\x0f\x67\xcb\xae\x24\xce\x9e\x2f\x94\xbb\x56\xf1\x50\x94\xf2\x7b\xbe\x97\xa6\xc3\x51\x2b\x6b\x15\x0e\xaf\x4b\x5f\x6c\x81\xfb\x4b\x48\xd0\x6d\x47\x2a\x07\xab\x7d\x6a\x6e\x65\xd7\xbf\x78\xaf\xea\x2c\x00\x26\xfe\xca\xde\x6a\x9f\x3a\xb6\x8e\x3a\xf3\xc9\x4b\xc0\xae\xfa\x37\x4e\xad\x63\x82\x20\x1b\x65\x4e\xc6\x69\x93\xdb\x79\xf1\x93\xb4\xa3\x1e\x59\x1c\x24\x50\xb3\x1b\x26\x4d\xd6\x29\xb3\x7a\xf5\xbd\xcb\xae\xb9\x08\x1b\xb4\xcb\xb5\x7f\x05\xdb\x16\x09\xe7\x0e\x63\x81\xe2\x7a\xcd\x2c\xbc\x26\x16\xc6\x5e\x98\x8b\x8f\x5b\x04\xbf\xbb\xf8\x98\x45\x22\x77\x2e\x25\x4d\xea\xf5\x41\xc6\x37\x17\x1f\x93\x91\xde\x2b\xd7\xdf\x08\xa8\x29\xd7\xdf\xbc\x68\xa4\x8d\xe2\x31\x10\xc5\x95\xe3\x71\xec\xe7\xf4\xfb\x05\xf2\x27\x7b\xa0\x31\xf4\xa6\x53\xfd\xc6\xaa\x1e\x3a\x8d\x80\x0b\x1b\x9a\xa8\x5b\xc6\x80\x27\xf1\x42\xa7\x1d\x40\x87\x5b\x9e\x36\xf0\x62\x7d\xf0\x88\xa5\x5e\xa7\x87\x2b\x7e\x33\x93\x23\x92\x3d\xf4\xc5\x89\x83\xed\xde\xa1\x68\x90\xa6\x1f\x7f\x8d\x7b\xe4\x51\x0e\xf2\x58\xc3\xc6\xc4\x47\x1e\xf5\xa3\x32\x03\xee\x8c\xb7\x23\x5b\xf5\xba\x78\xd3\x5e\xf1\x4d\xe5\x2f\xb3\xf8\x00\x9b\x3d\xa8\x73\xf1\xa0\x5e\x33\x92\x53\xd5\x5a\xed\xf4\x95\xca\x45\xfa\x2b\x9e\x6e\x7d\x01\x6f\xe8\xa0\xdd\x4d\x8a\x78\x8f\xbc\xd4\xac\x96\x12\x2f\x3d\x36\x61\x6c\x74\x63\x15\x39\x00\x70\x4e\x9a\xda\x5f\x0c\x7e\x53\x7a\x10\x5b\x27\x0b\xe5\x1d\x55\x5f\x71\xa4\xed\xcf\x4a\xda\x70\x2c\xb3\xcf\x04\xfa\xcd\xaf\x0b\x82\x03\x59\x0d\xfe\x42\x6a\x08\xf7\x1d\x92\x5d\xd8\x52\xc5\xde\x46\x40\xa7\x47\x9f\x6c\xdb\x07\x9a\x4e\xe2\xa7\x38\x9b\xd0\x92\x27\x57\x90\x19\x9c\xc7\xa2\xb9\x70\xc6\xec\xae\xfe\x5c\xfb\x13\x3b\xd7\x5f\xd1\x27\x61\xce\x9d\x7e\x83\x26\x0a\x2b\x3e\xf4\x1b\xaa\xbd\x87\xcc\x6f\xf4\x87\x43\x62\x3b\x49\xe4\xe2\xa4\x4d\xe3\x2a\xa8\xb4\x02\x57\xf4\x1e\x7a\x87\x57\xb6\xce\xf2\x25\xd3\xdd\x24\x06\xbb\xae\xe3\xd4\xf2\xae\xeb\x3a\x17\x43\x7a\x2b\x26\x88\x47\xde\x26\x79\xc6\x88\x9c\x75\x1b\xd2\x01\xbc\x8b\x21\x12\xf7\x0e\x84\x07\x4c\x74\x1d\xaf\x40\xaa\x83\x29\x6c\x60\xed\x8b\xa4\x83\xb8\xff\x07\x2e\x62\x84\x0b\xff\x94\x5b\x99\x8d\x31\x33\x57\xe0\x8b\x15\xbb\xa4\x4e\x27\xb6\x34\x1d\xdd\x47\x21\x02\x48\x6d\xdb\xe2\x14\x8d\xd9\xfc\x80\x1b\x40\x5d\x8a\xd4\x09\x28\xc3\xb9\xb2\xff\xf4\xb3\x31\x97\x9b\x2e\xf3\x02\x90\x3d\xf4\x46\x9d\x84\x85\xf5\xde\x3d\x73\x29\xfe\xfb\xbf\xc5\x3d\x1f\x67\x5a\x68\xc1\x93\x5e\xd5\xfa\x13\xf5\xc9\xc5\x0c\xb4\xcd\xe6\x80\x29\x8b\xdf\x64\x93\xcd\x83\xe7\x79\xef\x38\x6e\x1e\x47\xce\xe2\xf3\x9e\x52\x8a\xd4\x12\x52\x89\x79\x62\x08\x69\xa2\xb9\x28\xef\xb6\x81\x7f\xc6\xf6\xcd\x06\xed\x08\x6d\x5c\xf2\x61\x01\x33\x7e\xc8\x7c\x6d\x6d\xc1\x1e\xa7\x68\x82\xe9\x1f\x6d\x4f\x14\xeb\xc0\xab\x41\x0e\xfd\xe4\xa5\x29\x8f\x04\xaa\x86\x92\x5c\x39\x53\xcf\x63\xb1\xa4\x40\x2f\xb8\x75\xd7\xbc\xde\xb4\x94\x98\x0d\xaf\x6a\x14\x68\x78\x2b\xbb\xcf\x78\xee\xe2\xa6\x53\x3f\xeb\xf6\x72\xc6\x09\x02\x97\xc6\x63\xe0\x8a\xf9\xd0\xed\xa7\xb3\xb7\x3f\xc7\xac\x8f\x38\xde\x5d\xbc\x59\xbb\x90\x33\x5e\x85\x46\xb7\x54\xdc\x90\x26\xfe\xff\xf3\x07\x29\x56\xbd\xaa\x8f\x67\xe1\x62\xcb\xd2\x60\x51\x70\x95\xe5\xbe\x9d\xfd\x78\xdf\xfe\xb0\x90\x3f\xfe\x67\x2e\x1c\x2b\x49\xff\x27\xfd\x27\x9b\x27\x87\x80\x23\x92\x32\x0c\x05\x9e\xcf\x59\x3d\x44\x97\x22\x6a\x07\x08\xba\xb9\xf8\x88\x7a\xac\x78\xe7\x49\x5f\xa9\x96\x2d\x2c\xd4\x01\x5f\x98\xa3\xa0\x99\x22\x03\x56\x05\x83\x7f\xe2\xb0\xc9\x82\xd9\xfa\x8c\x4f\x3b\x72\x46\xf1\xcb\x90\x3f\x99\x0b\x5f\x57\x8c\xda\x75\x55\xba\x54\x2d\x90\x83\x4e\x78\x48\xe2\xb8\xdc\xf7\x9e\x07\x7f\x63\xdf\x84\x4b\x26\x99\x9b\x87\x1b\x42\xbf\x86\x5a\x2b\x43\x57\x50\x88\x34\xa4\x44\xc1\x8a\xd2\x8a\x35\x02\xf2\x18\xb3\x5b\xd1\x19\xff\xd8\x04\xdc\xe0\x58\xfa\x00\x15\x72\xe2\xfb\x73\xc2\x6b\x3a\x59\x23\x13\x14\xea\x07\x00\xe0\x0d\x0b\x32\x47\x00\xb1\xaa\x01\xad\x80\x8a\x72\xad\x9b\x74\xb6\x9e\x76\xc0\x7d\xa3\xf6\xf2\x28\xc4\xfd\x2b\x24\x2e\x48\x7a\x06\xa4\xb9\xe0\x84\x1c\x23\xb2\xaa\xc1\x32\x66\xf3\xc8\xd4\xc9\xa6\x8c\xbd\xdc\x7d\x09\x86\x6f\xd8\xb2\x90\xfb\x1a\x36\x6b\xbf\x97\xea\xda\x2d\x14\x77\x05\x82\xb3\xd9\xe1\xe3\x59\xde\xb3\xae\x37\x6b\xe3\x62\x2a\x7a\x7d\xa1\xf0\x6e\x03\xa7\xda\x91\xa9\x0e\xd1\xd0\x0d\xed\x35\xf5\xe5\x88\x28\xc7\xf3\x41\x54\x6b\xd8\x18\x73\x29\x36\x9d\x50\xb2\x5c\x51\x3d\xa9\x69\x4b\x55\xc4\x55\x8c\xcb\x65\x8b\xa5\x72\x19\x4d\x0c\xeb\x98\xed\x9d\xf7\xb8\xd7\xbb\x8b\x8f\xe3\x75\x0e\x2e\xf7\xed\x7c\x6b\x3b\x76\x20\xf7\xed\x88\xb9\xf8\xc8\x2c\xe7\xa5\x63\x2f\x05\x38\x3f\x88\x4b\x1f\xd2\xec\x71\xec\x02\xbe\xff\xfc\xcf\x2c\xbb\xbd\xd6\x78\x7f\x02\xe8\xb1\xa9\xf8\xb3\x20\x59\xa5\x51\x4b\x69\x95\x78\x28\xad\xc3\x95\x35\x8c\x78\xc4\xf7\x6a\x00\x76\x66\x2e\x31\x90\xcf\x9c\x9e\xfd\xdf\x93\x57\x63\xc5\x17\x07\xf4\xec\x4e\xb6\x46\xb4\xa6\x7d\x0c\xec\x34\x90\xb8\xff\x3f\xc0\xea\xf8\x6b\x74\xdb\x7d\x36\x1b\x97\xf8\x06\x2b\x0b\x80\xe2\x14\xf7\xfa\x38\x83\x1e\x3e\xe3\xcf\xc2\x67\x63\xa1\x3b\x00\x02\x44\x13\xed\xc5\x98\x3e\xe3\x03\xc3\x44\x5d\xc2\x81\x7f\x1c\x6e\x3d\x8c\xa5\x83\x3b\x69\xe9\xbe\x13\x97\x22\x31\x9c\x4e\xb2\xec\xbe\xe4\x8d\x29\xa2\x45\xc1\x7b\x1d\x1c\x83\x15\x48\x40\xe7\x42\x57\x7e\x63\xd2\x3d\x0a\x1d\xc2\x3a\x51\x28\x58\x9c\xa9\x4f\x2e\x48\x34\x7d\xbd\x9d\xc6\xff\x86\x02\xa7\x03\x0b\xcb\xba\xa3\x8a\xa5\xdf\x94\x3c\xf5\xcb\x0d\x87\xee\xa6\xa3\xe7\x6b\x86\xad\x84\xa9\x4b\xf6\xf2\xde\x2e\xdd\xb4\xe0\x98\xde\x21\xf2\xff\x04\x29\x99\x74\xd8\x6f\xd4\x80\x86\x81\x80\x9d\x0e\x6a\xb3\x01\xff\x7c\x3c\x59\xa2\x64\x67\x81\x2a\x55\xcb\x4d\xe3\x8e\x0e\x2f\xca\xa6\x55\x9f\x3a\xff\x96\x14\x50\x48\x7e\x18\xe6\xfe\x99\xa7\x66\xe0\xba\x5b\x36\x90\x5b\xae\xd1\xc8\x4c\x6e\xbb\x37\xd1\x28\xc2\x48\xb2\x3c\x3f\x6e\xd4\x95\x6a\xa2\xa3\x22\x4c\x2f\xae\x64\xaf\x91\x05\x65\xab\xb9\xed\x7c\xfd\xff\xa8\x0d\x96\x1e\xb1\xf7\x60\xf1\xf7\x22\x4b\xa5\x9f\x6d\xb3\x77\x59\xb3\xe5\xae\x16\x78\xf1\xee\x97\xd3\x33\xf1\xe0\x81\xd8\xf3\xed\xb7\xe7\xef\xe7\xfb\x69\xd8\x56\x10\xb4\x52\x7b\x34\xc4\xed\x74\xbf\x7e\x58\x6e\x29\x88\xab\x3d\xfa\x81\x0a\x27\x83\x82\xd8\x23\xce\xd4\x27\x15\xe9\xfd\x92\x71\x87\x44\x27\x7e\x77\xbc\x13\xe7\xb1\x22\xd7\x93\xec\x41\x5c\x81\xf8\x75\x5b\xfc\xc7\xdd\x03\x4b\x1e\x46\xc1\x10\x87\xd0\xa0\xfa\x2a\x59\x23\x3a\x96\x7c\x3a\xc6\xb3\xdc\x2f\x68\x8c\x83\x81\x66\xb3\xbd\xc7\x39\xb3\xd9\x61\xc7\x66\xd8\x4a\x16\xc1\xd9\x60\x22\x77\x93\xc8\xfb\xe4\xc1\x6d\xfb\x2a\xdf\x2a\x10\xee\xcf\x8b\x83\xfb\x06\x71\x70\x77\xd8\xc4\x2f\x72\xfc\x01\x93\x78\x88\xe1\xdd\x16\xc3\x7f\xc9\x20\xee\x35\x4e\x2e\x72\x7c\x60\xe9\xb0\x52\x51\x00\xdc\x9d\xec\x1b\xbf\xde\xc5\x33\xee\x00\x63\x7d\x35\x07\xc5\xa5\x19\x31\xd0\x62\x11\x77\x79\xa4\xaa\x9d\xe9\x84\xd7\xc4\x49\x17\xbe\xbf\x64\x5a\x27\xb5\x87\x83\xe2\x26\x0d\x8e\xe0\x80\x4c\x10\x2b\xe9\x94\x75\xf6\x71\x63\x67\x2c\x6f\xee\x89\xa1\x53\x38\xeb\x8a\x97\x81\xf7\x46\xbc\xf8\xfb\x0e\x3b\x8e\x73\x1e\xc6\xce\xe3\xfc\x23\xf7\x6e\x4d\x8d\x7b\x08\x6d\x45\xa3\x2f\x55\x6c\xa7\x97\xc6\x64\x63\xe3\xd9\x27\xd7\x67\x04\x63\x14\xe6\x1a\x1e\x4d\x4b\xd6\xa2\x98\x2e\x16\x80\x7e\x53\x6f\x7f\xc1\x28\xb8\x95\x1e\x91\xd0\xaa\x5d\xcb\xd1\x05\x18\xb3\x71\xe8\xed\x2b\x4c\x72\x3a\x1d\xe5\x8a\x10\x1c\x6f\xef\x2b\x0b\x79\x86\xbc\x0c\x5f\x20\xf3\x71\x1b\x10\xc4\x7b\xf0\x61\x30\xff\xf8\x1a\x79\xee\x04\x4c\xe8\x70\xba\xba\x92\x78\xc8\x64\xe7\x66\x7e\x98\xc7\xcb\x61\x02\xbe\x8a\xa5\x94\xe5\xca\xc7\x06\x61\x6b\x29\x26\xa0\x30\x40\x53\x96\x8b\x06\xb1\x4a\xf6\x04\x08\x5b\xe0\x43\x83\x11\x03\x24\x9b\xf5\x6d\x7c\xb0\x07\x78\x60\x8d\x64\xbf\x7d\xdc\xd1\x99\x70\x81\xfd\xeb\x91\x04\x2c\x88\x70\x36\xdd\xa0\xe8\x3a\x63\xc7\x31\xc8\x18\xe0\x2f\x9c\x86\x33\x97\x28\x6c\x80\xc6\x09\xfa\x84\xca\x21\x32\x4f\x02\x34\x07\x43\x1c\x88\x83\x77\x82\xe1\x16\x27\xea\x8d\x62\x5f\x91\xf6\x84\xbc\x1f\x2e\x03\x8c\xe5\x18\x70\xeb\x3d\x6a\xce\x80\xd0\x68\x75\xd0\xd1\xba\xad\xd4\x27\x26\x98\xcc\xf6\xbc\x40\x57\x7b\x1e\x10\x0c\xf7\x48\x16\x0b\xf1\x4f\xf5\xdd\x55\x18\x12\xc2\x00\x20\x71\xad\xbe\xa3\x5a\x28\x73\x09\xe9\xa9\x4d\x5f\x88\xb3\xa0\x54\xfc\x59\x5b\x22\x33\x9e\xe5\x74\xcb\x47\x8f\xfe\x85\x02\xe2\x37\xcf\x5f\x60\xf7\xb5\xef\x15\x1c\xc7\x5a\xf7\xd6\x5f\x70\x24\x3e\xa7\xf7\x4b\x25\xf9\x8b\xb2\x46\x32\xa3\x33\xb8\xb6\x48\x4a\x84\x4a\x6f\x6a\x9a\x01\xf1\x85\x85\x2a\x47\x1b\xee\x6f\x16\xa7\x34\x42\x06\xe3\x4e\xc0\x73\x66\x24\xbd\xf5\x0c\x42\x58\x78\x02\xc2\x23\x06\xaf\xe8\xe0\xfa\xc7\x63\xe8\x20\x4e\x97\x61\x11\xb5\xf8\x41\x0c\xc8\xe0\xc4\xc5\x1e\x64\x16\xc4\x0f\xd4\x63\x2f\x4e\x1c\x58\x8f\xab\x3d\xee\xde\xee\x54\xed\xc4\x10\x21\xec\x75\x54\x9c\xa4\x4a\xde\xb5\x2f\xa9\xbc\x2c\xb1\x5c\x61\x33\xef\x32\xe9\xdb\xe3\x8e\x0d\xfb\x62\x21\x42\xec\x61\xf7\x14\xbc\xf5\x8a\xef\x2b\x96\xe5\x06\x8f\x00\x85\xf7\x51\x1a\xdd\x22\x2b\x09\x05\x88\x0b\x90\xe6\x32\xee\x6a\x3a\xa1\x8b\x1b\x02\x14\xed\x06\xaf\x15\xe3\xba\xa2\x6e\xc7\xb2\x12\xe3\x1e\xc8\x4b\xf1\xb3\x6e\xd5\x74\xff\xc6\xd6\xc5\xcb\xbb\xb6\x96\xe7\xba\x8b\x97\xfb\xc5\xdd\xc6\xe3\x1d\xad\x12\x3f\x1e\x13\x65\xf1\xb2\x40\xd8\x72\x06\xa7\x4d\xbf\x13\x19\x31\x02\x23\xfb\xc1\x23\x4b\x09\x19\x20\x73\xf1\x60\x5b\x81\x24\xd9\xdd\x70\x1b\x76\xb8\x12\x8b\x11\x51\x91\x11\x46\x47\x1e\x77\xe2\xeb\xb9\x8e\xc4\xf9\x87\x58\x70\xf5\xb9\xbe\xc5\xa7\x5b\xe6\xb4\xdb\xe9\xa1\xfd\xbe\x9b\xcf\x38\x13\x9c\xa1\x74\x10\xe6\xea\xed\x06\x45\x93\x65\xf1\x76\xe3\xd4\x27\xda\x60\x36\x63\xc3\x9b\xa0\x60\xba\x68\xdd\x2e\x6e\xc6\xcc\xe9\x99\xe2\x52\xdd\x28\x2e\x83\x6c\xfc\x63\x3a\x45\x18\x40\x70\x0d\x5d\x52\xa0\x18\xe7\x34\x4f\x06\xfc\x09\x16\x15\x66\x8f\xe9\xd2\xd6\xfa\x27\x34\xf9\x2e\x2c\x9e\x78\x81\xca\x1e\xba\x44\x12\x88\x17\x1f\xe3\x70\xcf\xc6\x61\x81\x2e\x1f\xe3\xd2\xad\x1b\xde\x3f\x1d\xba\xfb\x5f\x76\xeb\x19\x20\xa8\x12\x23\x7c\xfd\x9a\x67\x36\x7e\xcf\x26\xbe\x4f\xea\x4f\xae\x7c\x96\x0d\x8f\x23\x08\xed\xe0\x05\x80\x4e\x36\xae\x92\x3d\xad\xe4\x59\xa1\xd1\xc8\x5f\x55\x80\x77\xa8\xe8\x8e\xa7\x36\x9c\xbd\x56\xaa\x86\x12\x0d\xcd\xc3\x19\x27\xcc\x44\x54\x2a\x55\x6a\x10\xea\x54\x7d\x0c\xeb\xf6\xe8\x51\xc2\xd8\x03\xbf\x31\x6a\xda\x98\x47\x8f\x76\xd4\xd5\x5d\x75\x7f\xc4\xa3\x29\x14\x87\x3e\xff\x42\x31\x3d\x61\x0b\xd6\x07\x0c\x90\x70\x3c\xeb\xd3\xed\x09\xa3\x38\x29\x8a\x4d\x1d\x93\xc8\x50\x6a\x3e\x46\xe0\xe7\x91\xad\xb8\x5e\x29\xba\xb0\xdf\x3d\x41\xa1\x89\xe8\x9e\xa2\xda\x15\x17\xf8\xcd\xf0\x3e\x6d\xd7\xc8\x92\x2b\x8c\x7d\x23\x91\x52\x24\xea\x55\xb7\xc1\xa3\x8c\x9e\x64\xa2\x71\xd1\xf5\x2b\x94\x6e\x4c\xeb\x46\x3b\x1d\x1e\xc6\x05\x65\x00\x21\x04\x78\xb7\x16\xa9\x61\xe6\xb3\x10\xf4\xec\xe5\xb0\xee\x49\x8e\x29\x25\xee\x4f\xd0\xab\xa8\x6f\x7c\x82\x20\xb9\x7b\x9a\xee\x84\x2f\xbb\xc5\x8a\x1a\x8b\xbe\xc6\xd2\xf3\xb1\xf5\x48\x59\x76\x4f\xe6\xf9\x76\xd3\xd3\xc1\xd3\xef\x8c\x7d\x42\x4c\x0e\xf2\x69\x08\x63\x9f\x0e\x0d\xd0\xbf\x80\x20\x05\x1b\xbe\xe2\x07\xef\x50\xa8\xfe\x62\x61\xf4\xe2\x1a\x9e\x8a\x1f\x8a\xb7\x86\x53\x9b\x50\xf6\x84\x4e\x39\x96\x8b\xca\xc9\xfd\xcb\xc3\x78\x41\x0d\x17\x8d\x9c\x90\x2c\xf2\x48\xbe\x74\xbd\xe2\x5a\x75\x7a\x3e\x39\x39\xf6\x49\x4b\xcf\xf6\xf9\x87\xdb\xc5\xd0\xd9\x56\xe0\x9e\xca\xed\x17\x8a\xa4\xc7\x35\xd2\x83\x96\x0f\x24\x78\xe7\xd9\xb1\x59\xbc\x7b\xa8\xd0\x17\xf6\x7a\xd3\x9d\x24\x93\xe0\x93\x95\x6d\x87\xf9\xe4\x2f\x9c\x67\xb8\x01\x04\x46\x71\xa9\xcb\x1a\x3f\x1c\xc7\x62\xef\x3d\x02\x4f\x46\x0c\xa0\x78\x87\x40\x23\x10\x72\x7e\xab\x66\xf1\x54\xa8\xe3\x2a\x5c\x1a\x20\x96\x4f\x4c\xb9\x48\x37\x14\xe8\xf2\x10\xa8\x2f\x7b\xf7\xf2\x1d\x3f\xd9\xc8\x03\x02\xbf\x2d\xfe\x2e\xad\xf6\x39\x19\x41\x6f\x96\xeb\x5a\x5c\xc7\xbb\xa2\xce\x14\x5f\x41\x20\xa8\x8b\xbc\x33\x88\xfd\x40\xeb\x1d\x25\x00\x9e\xd4\xbf\xbe\x00\x20\xe2\xbd\x9d\xd2\xf1\xd5\x81\xf3\xfd\x70\xa0\x17\xb6\xc5\x13\x02\xf8\xaf\x20\x23\x9d\x7f\xcc\xbb\xd3\x65\xae\x80\x6e\x4c\x08\xe8\x18\x98\xc5\x47\x2e\x48\x27\x6e\x33\xd2\x90\x5f\xba\x6b\xf4\x81\x33\x24\x6d\x5f\x32\xec\x48\x76\x46\x83\x26\x4a\x3f\xd4\x8e\x45\x9d\x72\x4d\xfb\x8f\xb9\x6b\x1b\xf7\x13\xf6\xbf\x91\xa8\x3e\x0a\x7a\xb9\x37\xc6\x25\xa7\xc4\xb8\x7d\x23\xd6\xa6\xda\xc0\x40\x9b\x1e\x74\xe2\x01\x7c\xed\xbe\x1b\x90\xd0\x33\x78\x84\x3e\x28\xe8\xb4\x70\xed\xeb\xb2\xe1\xe1\xe9\xba\xd3\x48\xf7\xe7\xb0\x57\xc5\xc9\xe5\xd2\xeb\x13\x0c\xbe\xbf\xaa\x22\x82\x15\xe0\x8b\x6c\xfe\x68\xb6\x98\xe5\xf4\x8f\x42\x40\x76\x08\x66\xa4\x35\xa2\xd9\x37\x76\xbf\x0b\x3f\xe4\x81\x89\x8c\x7b\x88\xdf\xde\x58\xba\x78\x9e\xed\xc5\xc4\x35\x9e\x3c\x57\xd4\xf1\xaf\xc4\x85\xc2\x9b\x97\x58\x55\xbf\x82\x04\x05\x0d\x3e\xf8\x9e\x58\x46\xdd\x2b\xba\x24\x86\x17\x80\x74\x78\xc4\x12\x95\x40\xc5\x59\xaf\xd7\xdf\x30\xc3\xc8\x14\x0f\xb6\x57\x13\x53\x87\x39\xc2\xd5\x0a\xb7\x2a\xfe\xdd\xe8\x36\xab\xf0\xce\x53\xf8\xc7\x44\x8a\xbf\x4b\x4b\x81\x7e\xb4\x5a\xbe\x06\x03\x56\xea\x08\x06\x8b\xa2\x83\x7c\x38\x59\x49\x64\x7d\x64\xb6\xc2\x02\x8c\x8b\xf3\x69\x58\xb0\x55\xf8\x87\x41\xc6\xf7\x94\x4c\x8d\x2d\xd8\x61\xb0\x28\x79\xcc\x57\x5b\xfa\x65\x1f\x67\xb1\x40\x46\xff\x72\x07\x24\xc9\xd7\xec\x49\x2b\x04\xe8\x73\xc6\xf3\x21\x1a\x91\x51\xf5\xfb\x4e\xdd\x7e\xb8\x44\x13\xde\x7d\x95\xb1\x05\xea\xb1\x17\x3a\x17\x97\xba\xad\x4e\x5d\x3f\xa4\x7b\xd1\x10\x6f\x5e\x68\x1b\xeb\xe4\xf1\x94\x0b\x6e\xd7\xba\x1b\xb2\xa4\x3a\x64\x6e\xe5\x50\x69\x23\x23\x3a\x3e\x58\x1b\xf4\x81\x4c\xa2\x20\x38\x7f\xbe\x2a\x50\x2c\x37\xb2\xe7\x90\x27\x1c\x60\x59\xcf\x9f\xc9\x7b\x54\xc4\x9f\x9b\x0e\x4f\x2b\x54\x49\xb1\x71\x73\x13\x9e\xa2\x0c\x17\x2f\x4c\x7f\xe9\xdf\x61\x42\xca\x91\x73\x96\x3c\x02\x3f\x18\xe4\x56\xf1\x3c\x7f\x5c\xf6\x3c\x5c\xaf\x4c\x63\xb3\xe9\x64\x78\xb8\x38\x0d\xaa\xf8\x66\xc5\xcb\xf0\x9a\x62\x7c\x2d\x59\xdc\x01\x15\x2a\x07\x20\x53\xcf\x37\x6e\xf5\x82\xa2\x2b\x7f\xef\x13\x79\x39\xd3\xfb\xc0\x86\x67\xc3\xd7\x23\xf9\x7d\x84\xc5\x82\x4c\xb1\x0d\x93\x90\x1b\xb7\x32\xbd\xfe\x2f\xd5\xf3\xe1\x7f\x8c\x82\x2e\x6e\x28\x51\xca\x03\x15\xd3\xc9\xce\x90\xfb\x48\xdc\xba\xcb\x36\xa6\xd6\x5f\x13\xe5\x2b\xaa\x43\xa9\x1f\xff\x3b\x22\x68\xbe\xc2\x63\x65\xbe\x0b\x24\x89\xa7\xe0\x47\xd6\xca\x0e\x54\x30\xaa\xbd\x77\x53\xfd\x98\xc3\x63\x73\x31\x3c\x1d\x9a\x76\x42\x54\xae\xc4\xa0\x9e\xd7\xf4\x90\xa9\x95\x78\x07\xc9\x47\x8a\x78\xdc\xb0\xe7\xfb\x93\xb8\x03\xfe\x1d\xbc\x29\xfc\x1b\x1b\x5b\xf1\xeb\x78\xcc\x7c\x77\x40\x8e\x63\x49\xe4\x46\x32\xb1\x25\x72\x5e\x00\x12\x39\x99\x8b\xcc\x5c\x52\x0e\x85\xc4\xc5\x43\x07\x1f\xad\xe2\xa7\x3f\xb7\x9e\xb0\xd9\x79\xb5\xc8\x97\xb1\x56\x61\xb1\x52\x2f\x01\x0f\xa7\xe1\x01\x54\xee\x4d\x41\x4d\xb1\x27\x8a\xd0\xb5\xa7\xec\xf8\x98\xfe\x1c\x2e\xac\xfc\x8a\xb2\xea\x07\x0f\xc4\xbd\x3b\xef\xb3\x0c\x74\x8f\xac\x0c\xfd\x03\x34\xfb\xf0\xd3\xe5\x96\xbd\xa8\xd3\x6b\x2f\x5f\xc4\xca\x22\x19\xe3\xed\x41\x1a\xf1\x6e\x4e\xbc\x9c\xa8\xeb\x1d\xb9\xf2\xdf\x87\x75\xda\xff\x7d\x27\x4d\xc2\xf2\x7c\x3b\x9d\x40\x9a\x2c\x6f\xcb\x4e\xcf\x81\xb2\x21\xe4\xf7\xb9\x00\x0e\x11\xc2\xbf\xe4\x01\xad\xea\xc5\x23\x94\x9e\x6f\x57\xd6\xfb\x39\x73\x1a\x73\xb1\x48\x9f\x05\x23\xf9\x12\x26\xee\xed\xfd\x3f\x72\xd1\x9b\x46\xa1\x72\x2b\xbb\x7f\x35\xe7\x3b\xfc\xc3\x2a\x7a\xee\x23\x87\x0d\xa7\x7a\x17\x9b\x65\x01\x92\x51\xc0\xfc\x24\x17\xff\xf6\x64\xbe\xb7\x7e\xdc\x13\xbe\x3b\xa1\xa8\xd5\x46\x6b\xbd\x25\xc8\x51\xf7\x8f\x9a\x73\xb1\x47\xbc\xc7\x2f\xd4\x09\xc1\xd3\x8a\x59\x39\x66\xf6\xa3\x9d\xeb\x5e\x93\x57\x51\x9c\x8e\x68\x86\x5c\x98\x99\x6d\x3d\x71\x20\x44\x52\xec\x48\xe9\xd7\x50\xa0\x39\x31\x97\x71\x02\xb7\x98\x1b\xd4\x27\x36\x77\x50\xa3\xa0\x0e\xb8\x8f\x04\x0d\x81\x9e\xc4\x02\x47\xa4\x57\xf9\xd9\x0c\xde\x52\xb4\xf0\xcc\x60\x15\x81\x64\x08\xba\xef\x69\x3b\x5c\x43\xa0\x6a\xd3\x8c\x5f\xae\x79\x81\x67\xeb\xf0\x63\x4e\x41\x20\x8c\x4f\xa2\x29\x90\xfd\x0a\x97\xce\xb3\xe9\x64\x2c\xa5\x6f\x65\xb9\xa2\x28\x3d\xe9\x90\x69\xe3\xe4\xdc\x43\xf2\xf7\xe7\xf8\x17\xaf\x7c\xcb\xaf\xad\x76\xc9\xcf\x01\x15\xa4\x72\x3a\x19\x09\x69\x54\x6d\xd9\x65\x82\x7f\x2e\xc2\x32\xb3\xdb\x92\xf8\x28\xe8\x6e\xcf\x2f\x3f\x04\xab\x4e\xbf\xc5\x71\x74\x2f\x3e\x1f\x98\xc0\x91\x98\x95\xb1\xed\xf1\xda\x53\xfd\x58\x82\xce\x59\xbe\x3b\x15\xbe\x36\x37\xdb\x0b\x18\x67\xc8\x50\x00\xdc\xb4\xda\x8d\xa1\xc6\x13\x27\xd0\x94\x04\x5c\x21\x99\xe5\x5b\xeb\x91\x20\x5c\x43\x5d\x05\xa8\xb0\x69\x89\xf1\xb5\xae\xdf\x94\x6e\x50\xed\xc5\xf3\xf8\xcd\x23\x4d\x16\x94\xed\x5b\x6a\xf6\x63\x06\x74\x8f\x61\x27\xe8\x60\xdc\xe9\xf8\x66\x25\xaf\xf0\x8f\x24\xa9\x96\x6d\x7d\x11\xd4\xd4\x96\x06\x8b\xde\x61\x26\x13\x7c\x73\xee\x95\x8d\x32\x9d\x3e\x9e\x91\x05\xbe\x8d\xee\x66\xed\xe8\x09\x86\x39\x0f\x79\x3d\xd6\x07\xbb\x8a\xe3\xf6\xd0\xf8\x58\x9b\x61\x3f\xb2\x21\x07\xe6\x51\x2b\xfc\x3b\x33\x23\x90\xd9\x20\x56\xb2\xd8\x6f\xbf\x98\x5d\xee\x1a\x32\xe5\xa8\x83\x83\xa6\x40\x07\x87\x4d\x81\x50\x95\xf4\x2f\x10\x15\xb9\xf7\x20\x45\x11\xe2\x20\x39\x11\xe2\xae\x81\x5e\x34\xfa\xae\x51\xfc\xe7\xaf\x58\x68\x88\xcf\xee\x9c\x07\x1d\x72\x3b\xfd\x7f\x03\x00\x41\x29\xb9\xe4\x94\x73\x00\x00")
+
+func jujugenerateapidocProgGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocProgGo,
+		"jujugenerateapidoc/prog.go",
+	)
+}
+
+func jujugenerateapidocProgGo() (*asset, error) {
+	bytes, err := jujugenerateapidocProgGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/prog.go", size: 29588, mode: os.FileMode(436), modTime: time.Unix(1792001244, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocRegistrationGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x58\x69\x6f\xe4\xc6\xd1\xfe\x4c\xfe\x8a\x12\x01\xaf\x49\x83\x2f\xc7\x7e\x93\x4f\xda\x8c\x01\xc7\x6b\x25\x4a\x62\xaf\xb0\xb3\x4e\x02\x08\xc2\xa2\x45\x16\x39\xed\x21\xbb\x99\xee\xa6\xa4\xc1\x7a\xfe\x7b\x50\x7d\xf0\x98\x43\x0b\xc4\x80\x57\x33\x3d\x75\x3e\x75\x74\x55\xf7\xac\xdc\xb1\x06\xa1\x63\x5c\xc4\x31\xef\x7a\xa9\x0c\xa4\x71\x94\x3c\xee\x0d\xea\x24\x8e\x92\xba\x33\xf4\xa7\x91\x2b\xa6\xc3\xa7\x9e\x29\x8d\xca\x7f\x31\x72\x87\x22\x7c\xde\xf7\x8e\x8b\xcb\x15\x97\x83\xe1\x2d\x7d\xe9\x99\xd9\xae\x6a\xde\x22\x7d\xa0\x03\x85\x75\x8b\xa5\x95\xa6\x8d\x2a\xa5\x78\xf2\x1f\xb9\x68\x74\x12\x93\x2c\x6e\xb6\xc3\x63\x51\xca\x6e\xf5\xdb\xf0\xdb\xe0\xfe\x61\x3d\xd7\xa8\x9e\x50\xad\x6a\x56\xb2\x0a\x1d\xa5\x6c\x99\x68\x0a\xa9\x9a\xd5\xcb\xca\x48\xd9\xea\x95\xb5\xd0\xfa\x65\x6d\x69\x64\xbf\x6b\x0a\x2e\x56\xa8\x54\x23\x8b\xa7\xef\x92\x38\x8b\xe3\xd5\x0a\x14\x36\x5c\x1b\xc5\x0c\x97\x42\x83\xc2\x52\xaa\x4a\xc3\x56\x3e\x83\x93\xaf\x81\x29\xf4\x54\xa8\xb0\x22\x9e\x52\x8a\x8a\x13\x03\x6b\xdb\x7d\x01\x1b\xc4\xf9\xd1\x87\xb9\xc4\x22\x36\xfb\x1e\x8f\xb4\x68\xa3\x86\xd2\xc0\xe7\x38\x5a\xad\xc0\xa0\x36\xef\x45\xbb\x87\x8e\xf5\x1a\xcc\x16\x47\xc5\x66\xcb\x0c\xe0\x0b\xd7\x06\x24\x11\xd4\x52\x59\x6a\x2e\x1a\xc7\x29\x2d\xb9\x42\xa6\xa5\x80\xe7\xed\xfe\x1a\xcc\x56\x6a\x04\xde\xf5\x2d\x76\x28\x0c\x56\xc0\x05\x30\xcb\x05\x7a\xe8\x29\xb2\x96\xd5\x43\x93\x7b\x86\xc9\x3d\xa8\x95\xec\x40\x0a\xcc\x81\x89\xea\xe4\x67\xcb\x6c\x8d\x79\xde\x62\x90\xcc\x45\x03\x35\x32\x33\x28\x84\xba\x65\x4d\x0e\x7a\x28\xb7\xc0\x34\x54\xf8\x84\xad\xec\x51\xfd\x5f\x27\x2b\xcc\x2d\x37\xd7\x80\x82\x3d\xb6\x58\x15\x70\xa7\x64\x35\x94\x04\x0a\x01\x68\x94\x6c\x5b\x54\x1a\x04\x3e\xa1\x02\x1b\x66\xf2\xb0\x2b\xe2\x68\x8e\xd2\xbd\x03\xe8\xf6\xdd\x83\xcb\x96\xd8\xca\xf5\x16\xdc\xb4\xac\xd1\xa7\x58\xce\x3c\x9c\x99\x3f\x67\xb4\xa6\xcf\xac\x0b\xf0\xd2\x71\x4e\xce\xd4\x72\x10\x16\x4f\xb3\x45\xcb\xa8\xe5\xa0\x4a\x9c\xbc\x4d\xbc\xa4\x62\x83\xa5\x42\xa3\x93\x22\x8e\x8e\xad\x3a\xb1\xfd\x10\x1f\x65\xd4\x22\x7d\x40\xa1\x19\x94\x70\x09\x39\xf7\x87\x0b\xa8\xb4\x8b\x11\x13\x7b\x12\x21\xcd\x16\xd5\xc2\xd1\xc7\x3d\xa1\x07\x54\x36\x10\x6a\x01\x06\xed\x7e\xe9\x77\x4d\xfe\xc5\xd4\x8e\x57\x2b\x12\xfd\x83\x86\x67\x6e\xb6\xd0\xb7\xcc\xd4\x52\x75\xff\x62\x4a\x50\x95\xe6\x93\x7c\x87\x85\x2b\x16\x2e\x74\x8f\xa5\xc1\x2a\x87\x47\x2c\xd9\xa0\x91\x84\x98\xed\x71\x19\xd8\xfc\x26\x86\x8e\x55\x08\x15\xf6\x28\x2a\x90\x62\xf4\xb5\x41\x81\x8a\x19\xa9\x40\x0d\x54\x49\xf5\x20\xca\x8b\x48\xa5\xfd\xae\x81\x6f\x82\x9b\xc5\x5d\x48\xf0\x4a\xc3\xfd\x83\x43\xad\x78\x87\x86\xf1\x56\x67\x90\x7e\xb3\xb0\x24\x07\x54\x4a\xaa\x8c\x2a\x52\xc1\xf5\x1a\xde\x2c\x7e\xfe\x1c\x47\x63\xfe\x5d\x03\xfd\xd7\xb1\x1d\xa6\x67\xa2\x99\xe5\x71\xb4\x08\xf9\xf5\xab\xa4\x87\x38\xa2\x9a\xfe\x94\x43\x45\x5a\x15\x13\x0d\x42\xa5\xc9\x8c\xc8\xd0\x49\x55\x7c\xdc\xf7\x18\x47\x11\xaf\xc1\x14\x7f\xe7\xa2\x4a\x33\x58\xaf\xc1\xf7\xce\xe2\xce\x28\x4b\x1c\x19\x58\x83\x29\x7e\x6a\xb1\x4b\xb3\x38\x8a\x0e\x8e\x85\xeb\x8f\xa8\xcd\xc6\xd5\xbd\x47\x24\x35\xc5\xdd\xae\xb9\x63\x66\x9b\x66\xd6\xe1\x28\x52\x45\xf0\x6e\x34\xf2\x73\x55\xfc\xc2\x3a\xcc\xa1\x2a\xfe\x89\x4a\x73\x29\x0e\x0f\xb0\x86\xba\x33\xc5\xa6\x57\x5c\x98\x3a\x4d\x8e\xba\xcc\xbc\xc7\x84\x74\x83\xaf\x74\x92\xc3\x5c\xa3\x33\xee\x10\x47\x1a\x51\x90\x8b\x23\x3e\xae\x1e\x1e\x1e\xa5\x6c\xb3\x38\x7a\x62\x0a\x9e\xb8\xe6\x06\x28\xea\x69\x7f\x1a\xda\xcc\x05\x2d\x8e\x1c\xd9\xfa\x4b\x84\xd6\x57\x5e\x03\x29\xbe\xef\x83\x49\x0f\x1e\x02\x5b\x66\x20\x78\xeb\xd1\x3b\xa6\x5a\x83\x51\x83\x8f\xc4\x78\x0c\x57\x6b\xd7\xa5\xd4\xdd\xae\x81\x37\x6f\xe0\xca\x5f\x5f\xc5\x5f\x99\xbe\x53\x58\xf3\x97\x74\x24\xce\x6d\x21\xd2\x17\xfb\x43\x76\x41\x31\xaf\xa1\x45\x91\xf6\xc5\x5f\xe4\x0d\x6f\x51\x67\xf0\x3d\x7c\xeb\x68\x79\x4d\x9e\x10\x68\xaa\x60\x55\xe5\xdd\x9b\x6b\x08\xf7\x6b\xf1\x8e\xab\x49\xc4\xfd\xb7\x0f\x59\xf6\xd6\xf2\x5e\xad\xc9\x47\x27\x2e\xe8\x76\x37\xe2\xcf\x4c\xef\x52\x54\x8a\xd2\xc7\x22\x40\xff\xfb\xec\xe4\xfd\x94\x9e\x7d\x71\x6b\x07\x04\x7d\x6c\x93\x0d\x43\xca\xfb\xd7\x35\xcd\xa5\xfb\x53\xeb\xfb\x21\x3e\x11\xd5\xef\x9a\x53\x59\x13\x4f\x7e\x6a\xf8\x21\x0e\xbf\xab\x9c\x48\x7c\x73\x9d\xb0\x1a\xef\x77\x6a\x46\xb3\x4e\x72\xd4\x98\x6c\x3f\x7a\xdc\x87\xae\xe5\x13\xca\xf5\x40\x3a\x68\xf8\x13\x0a\x20\xa0\xe9\x3a\xa8\xb8\xf2\xbd\x29\x55\xb0\x6c\x2c\xd9\x4c\x37\x35\x28\x17\xa5\x8a\x2b\xf0\x4d\x60\x4a\x4d\x2e\x6a\xa9\xf3\x80\x80\x9b\x9b\x8a\x0f\xc8\x2a\x0a\x65\xc5\x55\x36\x02\x74\x8a\xc6\x39\x20\xa8\x1c\x29\x2b\xaf\xd7\xe7\xdb\x80\xb7\x26\x8b\xa3\x5a\xa3\x6d\x36\x76\x86\x2b\x7e\xc1\x67\xca\xbb\x0d\x9a\x34\x1b\xdb\x13\x19\x37\xa5\x00\x7d\x73\xe1\x17\xac\x43\x3a\xa7\x13\xdb\x30\x88\x87\x02\x69\x0f\x6e\x35\xd9\x9e\xc1\xef\xbf\x2f\x0a\x63\x33\xd4\x54\x18\xc4\x9b\x43\x52\x34\x32\xb1\x24\x17\x29\x3e\x91\x2b\x8e\x8c\x74\x46\x34\x29\x70\x61\x8b\x91\xb2\x48\xab\xf2\x1c\x6c\xe4\x44\x3a\xd6\xc3\xdf\x24\x17\x69\xc5\x29\x2d\x58\x87\x99\xb7\xf2\x08\xcd\x8b\x70\x12\x9e\x74\xe1\xff\x2c\xb5\x01\x92\xa9\xa1\x64\xe2\x6b\x63\x9b\xfb\x3c\x8f\x1c\xd9\x22\x05\x72\xd0\x12\x2a\x49\xd4\x76\x62\x0e\x03\x0d\xe9\xbf\x0a\x41\xa2\xd6\x61\x87\xed\xe2\x47\x29\x0c\xe3\x42\xa7\xd6\xad\xfb\x07\x3a\x4d\xc3\x60\x41\xa3\x48\x92\x65\x5f\x24\xbf\x91\xea\xc6\x71\x24\xd9\x79\xd0\xea\x11\x32\x6b\x94\x2a\xee\xe8\x8f\xc3\x4c\xa3\xc9\xe1\x12\x72\x39\x58\x4d\xdf\xfe\x8f\x08\x76\x4c\xed\xa8\x26\x2e\x0e\x67\x54\x63\x5c\x80\x00\x23\x1d\x98\x53\xbd\x75\xac\xcf\x61\x87\xd8\xd3\xbc\x49\xc7\x35\x57\xda\xc0\x13\x6b\x07\x74\xc3\x59\x11\x47\x91\xd5\x70\xed\x6f\x03\x01\x4c\x9b\xe2\x17\x9a\x3d\xa1\x3b\x37\x7e\xe5\x9e\x3d\x94\xa3\x75\x82\x78\x6e\xdd\x0c\x93\x8a\xfc\x58\x52\x06\x74\x45\xf9\xc6\x56\xb2\xb6\xcd\x41\xee\xa8\x08\x44\x91\x7e\x43\x44\x3f\xb2\xb6\xfd\xe9\xa5\xa7\x8a\x75\x0d\xf2\x4a\xee\x28\xc1\xa9\xad\x13\x7d\xf1\x83\x6a\x74\x06\x7f\x82\xff\xa7\xd3\xab\xe0\xfc\xcd\x20\x4a\x7d\x4f\xca\x6c\x21\x59\xca\x9b\x41\x64\xfe\x86\x1a\xb1\xf5\xf7\x90\x83\xd4\x95\x60\x0e\x4f\xee\x92\x0e\xa6\xcc\x33\xd0\x4a\x5a\xd8\xf2\xaa\x3c\x6e\x47\x91\x71\x0e\x58\x88\xf7\x14\x35\x7c\x0a\x8a\xba\x7b\x5e\x3d\xbc\x9d\x4b\xb5\x27\xb0\x76\xb8\xce\xe4\x1e\x69\x3b\x84\xa4\xa0\xc9\xc6\x57\x81\xb5\x8b\xe2\x97\xd6\x39\x4c\x43\x49\xbe\x1c\x3b\x66\xc9\xf2\xb8\xbf\x34\x75\x40\xfa\x95\xce\x92\x1c\xc6\x9e\x3b\x96\xfd\x49\x25\xb4\xac\x69\xb0\x3a\x9b\x32\x54\x6f\x63\xa7\x9e\xac\x13\x64\xdd\x7c\xca\x73\x94\x99\xbf\x0f\xdd\xd4\x45\xe3\x5f\x6a\x8f\x3d\x32\x13\xeb\x97\x1d\x9b\x56\x14\xca\xf3\xaf\xf4\xc5\x1d\xc5\xbb\x49\x7a\xe6\x3e\x86\x1b\x76\x9e\xc9\xf5\xab\x99\xac\x9f\xb9\x29\xb7\x20\x7c\x22\xd3\xd2\xea\x5d\x2e\x99\x46\xb0\x89\x7d\x5b\x6f\x4c\x67\xae\x43\x12\x38\xa5\x2e\x0f\xbc\x3d\xd6\x69\x41\x5d\xa9\xca\xde\xc2\x94\x14\x44\xda\x60\x95\x8a\xe2\xcf\xb2\xda\xcf\xe0\x8a\x0e\x4b\x15\xa1\x76\x26\x25\xa1\x1e\x84\x2d\x06\x1a\x7e\x93\x0f\x1e\xa8\x8d\x61\xa2\x62\xaa\xba\xb1\xc9\x3a\x6b\x7a\xd4\x20\xa9\xda\x84\x2f\xb5\xef\xd7\xf0\xc7\x13\x53\x72\x20\x27\x75\x41\xea\x36\x36\xc6\x9e\xfe\xfe\x0f\x0f\xd9\xcc\xb8\x43\x7c\x92\xbd\x87\xc5\xac\x31\x0d\x1a\x33\x14\x40\x21\xdd\xb6\x9a\xa2\x48\x8b\x99\xbd\x25\xa0\xdc\x62\xb9\x0b\x8b\xcf\x22\xa8\xf1\x62\x33\xce\x69\x43\xa3\xba\x9d\xad\xd6\x64\x78\xf1\x53\xf8\x9d\x16\xbf\xb0\x1b\xd2\x3e\xba\x5a\x9d\x5f\x54\xc7\x25\x95\x26\x5f\xe9\xe7\x94\x99\x9d\xa9\xb5\x8b\xa0\x27\x1c\x32\x48\x43\x5f\xa4\x26\x67\x33\xe0\x89\xa9\x79\x21\xd0\x4c\x40\xe2\xa9\x5e\x58\xab\x31\x5e\x24\x19\x49\x7b\x2d\xcf\x28\xa2\xd4\xa9\x17\xb7\x85\x97\x13\xda\xc1\x70\xdc\x50\x7f\x15\x4c\xed\xad\x79\x36\xa7\xde\xbc\x81\xa1\x78\xdf\xc3\x7a\x1c\x59\xde\x7f\x74\xf2\x56\x2b\x08\xb9\x41\xc0\xf9\x27\x8a\x50\x33\x15\xd7\xd6\x6d\x4f\xc9\x2d\x70\x20\xfb\x5e\x6a\x6e\x10\x64\x0d\xcf\x5b\x66\xbe\xd6\xd0\x4a\xb9\xa3\x97\x10\xa9\x8a\x0b\x56\x7e\xa9\xed\x5f\x6c\xfa\x57\x6b\xf8\x0e\x3e\x9f\xc9\x28\x1a\x64\x70\x94\x19\x9a\xbf\x17\xbd\x41\x7a\x29\x93\xea\x54\xbc\xc6\xb6\xd8\x60\x6b\x67\x2f\xba\x88\x13\x9f\x20\xc9\x25\x25\xbc\x86\x97\xa0\x85\x98\xff\xed\x55\xdc\x56\x28\x4c\xf6\x36\xc8\x7d\x99\x24\xce\xd2\xef\xa2\x54\x82\x38\xf7\x91\x5d\x9f\xd6\xd5\x08\x00\x6d\x22\x79\x60\x5c\xe2\x4a\x35\x15\x4e\x26\x69\xbe\xb0\xa6\x96\x7a\x52\x57\x14\x43\x6f\xa2\x65\x3c\x9a\xd2\xe3\x33\xef\x34\x9e\xbc\x78\x17\x5e\xa7\x7e\x96\x15\x82\x54\x90\x2c\xdf\xab\x92\xdc\x57\xa5\x6d\xc7\xf6\xe1\x64\xf6\x04\xe7\x6b\xe9\xa8\xdb\x8f\xd7\x45\x48\x79\xaa\xbb\x71\xd8\xf2\x0f\x9d\xc5\xaf\xe2\x3f\x83\x34\x68\x39\xfc\x8e\xb3\x9e\xa6\x28\x3a\x85\x35\x68\xdb\x64\xc2\x17\x3f\x1d\x7f\x94\xff\x90\xcf\xa8\x52\xdf\x41\x3d\x62\xe1\xd7\x71\x1c\xa4\x9f\x73\x48\xc8\xd4\xe5\x74\x7d\x4c\x31\x7a\x9c\x64\x0b\xac\x97\xdb\xc2\x59\xd4\xcf\xaf\x45\x04\x19\x0d\x8e\xb0\x95\x6d\xa5\xc7\xab\xb9\x24\x88\x03\x7a\x7a\x8a\xc5\xf4\xb0\x1b\x5e\x12\xa5\x22\x11\xe7\x1e\x7c\x3d\x45\x0e\x8a\x79\x23\x98\x80\x7e\xfe\x84\x58\xe1\x22\x2a\xe7\x57\x9e\x73\x21\x3a\xb7\xb8\xbf\xb2\xb6\x1f\xa5\xee\xb8\x27\x61\x8b\xdd\xb4\x27\x05\x99\x9b\xbe\xe5\xc6\x37\x56\x5d\x7c\x54\xbc\x7b\x5d\x45\x0e\xc9\xca\x2f\x3b\xbc\x1e\xa5\x4c\x7b\x11\x69\xf1\xc1\xe5\xa2\xb9\xb4\x3d\x79\xaa\x4f\x53\x0e\xd0\x09\x75\xcd\xa4\x93\xe5\x4e\x27\x8b\x23\xa2\xda\x62\xdb\xa3\xd2\x97\xaa\x7c\xba\xf1\x6a\xd6\x6a\x8c\x0f\xf1\x7f\x07\x00\xab\x74\x06\x12\x39\x18\x00\x00")
+
+func jujugenerateapidocRegistrationGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocRegistrationGo,
+		"jujugenerateapidoc/registration.go",
+	)
+}
+
+func jujugenerateapidocRegistrationGo() (*asset, error) {
+	bytes, err := jujugenerateapidocRegistrationGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/registration.go", size: 6201, mode: os.FileMode(436), modTime: time.Unix(1791999622, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocRetryGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x5f\x6f\xdc\x36\x12\x7f\x96\x3e\xc5\x44\x0f\xe9\x2a\xd1\x69\x7b\xc0\x3d\x6d\xb0\x05\x52\x27\xb9\x1a\xe8\xb5\x86\x7d\x69\x1e\x0c\xa3\xe0\x4a\x23\x89\x59\x89\xd4\x91\xd4\xae\xf7\x6c\x7f\xf7\xc3\x90\xd4\xbf\xdd\xb5\x5b\xf4\xda\x00\xb1\xe5\xd1\x70\x38\x9c\xdf\xcc\x6f\x86\x6a\x59\xb6\x65\x25\x42\xc3\xb8\x08\x43\xde\xb4\x52\x19\x58\x84\x41\x54\xca\x25\xd3\x26\x72\x4f\xe6\xd0\xa2\xa6\x67\x6d\x14\x17\xa5\x7d\xec\x04\xcf\x64\x8e\x51\x48\x2a\xdc\x54\xdd\x26\xcd\x64\xb3\xfc\xda\x7d\xed\xec\x0f\xd6\xf2\x5c\x66\x4b\xf7\x8b\x16\x94\xb2\x66\xa2\x4c\xa5\x2a\x97\xf7\x4b\x23\x65\xad\x97\xa5\x5c\x7a\x07\x74\x14\xc6\x61\xb8\x5c\x42\xc3\xee\xff\xd5\x19\x66\xb8\x14\x17\xac\xae\x3f\x60\x6b\x2a\xa8\x64\x9d\x6b\x30\x15\xf9\x79\xcf\x9b\xae\x81\xdc\xca\x65\x01\x19\xab\x6b\x7a\xc5\x0c\xad\xde\xf3\xba\x86\x0d\x42\x21\xeb\x5a\xee\x31\x87\x7d\x85\x02\x6a\x29\xb7\x5c\x94\x50\x48\x05\xda\x30\x83\xd0\xf8\x2d\x74\x1a\x66\x52\x68\x73\x7e\xdb\x35\xfc\x23\xf4\xef\xed\xb2\xab\x6d\x09\x6b\x38\x7b\xda\xa5\x55\x88\xec\x11\x14\xb2\xfc\x17\x54\x1b\x3d\xf1\xbb\xe0\x4a\x1b\xd8\x4b\x95\x6b\x90\x05\x34\x68\x2a\x99\x83\x60\x0d\x8e\xce\x67\x52\xec\x50\x90\x0b\xac\xae\x0f\x90\x4b\xf1\x8d\x81\xac\x62\xa2\x44\x60\xe2\x60\x2a\x2e\xca\x34\xdc\x31\x35\xd9\x61\x0d\x0d\x6b\x6f\x1d\x2c\x77\x1b\x29\xeb\x87\x30\x88\xfe\x89\x26\x5a\x81\xfd\x67\x54\x87\x49\x18\x44\x3f\x72\xdd\xcb\x7a\xd1\x35\xb2\xfc\x48\xf4\x85\x99\xac\x8a\x56\x53\xd1\x27\x2e\x8e\xb5\x2e\x2a\xcc\xb6\x73\xad\x1f\x79\x81\x47\x5a\x37\x86\x99\x4e\x47\xab\x89\xe8\x52\x14\xf2\xd8\x56\xa7\x14\x0a\xeb\x5a\x2f\xba\xe2\xa2\x3c\xb6\x55\xc9\xfd\x91\xe8\x17\x56\xf3\x9c\x42\xbe\x1a\x44\x97\xda\xeb\x8c\x5a\x3f\xb0\x41\xd6\x8b\x2e\x98\x98\x8b\x9e\xc2\x21\xf8\xaa\x44\x61\xfe\x04\xe8\x1a\xb9\x43\xb0\x78\x69\x30\x12\x18\x94\x7c\x87\xc2\xe5\x5e\x02\x5a\xda\xac\xa5\x8c\x34\x15\x36\xb4\x9e\x95\x8c\x0b\xa8\x98\x06\x21\xa1\xe8\x94\xa9\x50\x01\x16\x05\x66\xc6\x21\x7e\xec\xde\x59\xdc\x6f\x06\xdc\xfb\xc3\x7e\x14\xba\x53\x38\x8d\xee\xe7\xd6\x47\x6d\x90\x5c\x23\xb9\x3b\x95\x7c\x40\x6d\x94\x3c\x4c\x42\xfb\x01\x6b\x9c\xaf\xba\xa8\x91\xa9\x19\xba\x9f\x85\x46\x33\x93\x7c\x14\x6c\x53\xcf\x2d\x73\xed\x45\xbd\xe4\x13\x17\x5c\x57\x53\x9d\x8f\xf7\xad\xd4\x93\x55\x0e\x9f\xbe\x62\xaf\x14\x16\xfc\x1e\x5f\x00\x88\x44\x0e\x1d\x59\xb8\x90\xd3\xfa\x9e\xe8\x8a\x4e\x64\x64\x47\x03\x13\xb9\x07\x93\xcc\xb0\xa1\xd4\x68\xbd\x47\x8a\x54\x9c\x45\x32\xd1\x2b\xfb\x3d\x36\x2c\xdb\xa2\xc8\x81\x0b\x83\xaa\x60\x99\x4f\x07\x28\x58\xc6\x72\xd4\xbd\x39\x6e\xc0\x54\x4a\x76\x65\xe5\x90\x3c\x39\xc8\x1a\x6e\xef\x1c\x90\x54\xbb\xef\xf3\x3c\x4a\x3c\x96\x13\x74\xa6\xb0\xd0\xf3\x85\x42\x82\x71\x82\x28\x3d\x5e\x0a\x8d\xca\x44\x13\xc4\x26\x49\xe0\x1e\xff\xd3\x61\xe7\xd6\x5d\x77\xc2\xfe\x7e\xdf\xb6\xb5\x37\x6a\x31\x1d\xb1\xa4\xa7\xf7\xf9\x8e\x89\xac\x37\x9f\x29\x6c\xa8\x5c\x7b\x54\x14\x1a\x75\xb8\xa8\x99\xd6\x90\xd1\x4f\x5e\x70\xd4\x44\xba\x36\x81\x29\x92\x2e\xf3\x7d\xd1\x70\x0d\x9a\x15\x48\x35\x61\x57\x26\x64\x63\xc3\x34\xe6\x20\x05\x70\xa3\x2d\x23\x5a\x64\xa4\x18\xcc\x70\x03\xac\x6d\x91\x29\x5b\x4c\x54\x3a\x44\x87\x23\xb4\x2f\x82\x98\xc2\xa5\x01\x85\xa6\x53\x42\x83\xe0\x35\x59\x15\x20\xa4\xad\x4e\x5a\xad\xbb\xb2\x44\x6d\x34\x20\xb7\xbb\xed\xd9\x61\x05\x05\x17\x39\xd5\xa7\x90\xbe\x61\x38\x30\xc9\xfd\x83\x86\x9a\x1b\x53\x23\x48\x41\xeb\xc9\x6b\xb9\x17\x09\x30\xf2\x00\x0f\xdf\x28\x24\xf3\xc0\xea\x3d\x3b\x68\x28\x64\x27\xf2\x34\xa4\xb4\x9b\x44\x6b\xd1\x6e\x4b\x78\xd3\xf7\xbe\xf4\xca\x3d\x24\xd0\x1a\x78\x63\x9b\x6d\xfa\xef\x43\x8b\x3f\xb1\x06\x13\x7f\x3c\x7a\x06\x97\x26\x31\xbc\x71\x5d\x35\xbd\x1e\xc3\xff\x10\x06\x3b\x54\x1b\x58\xad\x5d\x3d\x7c\x91\x2a\x5f\x8c\x4b\xe3\x30\xc8\x31\xab\x13\xa0\x9f\x57\xdb\x32\x01\x54\x8a\x94\x9d\xca\x07\xcc\x6a\xf2\x89\x3c\x98\xee\x18\x87\x01\x2f\xac\xea\xab\xb5\x8d\xde\xe3\xa3\xb5\x90\x7e\x2f\xf3\x03\xac\x9d\xec\x21\x0c\x82\xe5\x12\xbe\x70\x53\xc9\x8e\xb2\x1d\x41\xcb\x4e\x65\x98\xc0\x1e\x21\x63\x02\xa4\xa8\x0f\x50\x4a\xd8\x1c\x86\xea\x4c\xc3\x80\x4c\x0f\x9d\xec\x96\xbc\xbf\x83\xc7\xc7\x63\xaa\xf3\x2f\x68\x93\xc0\xe1\x08\xaf\x4f\x8e\x6f\xdf\x06\x37\xac\x40\x4f\xed\x3d\x9b\x04\x41\x70\x21\x45\xc1\x73\x14\x19\xae\x20\xaa\xe5\x9e\x52\x39\x08\x82\x6b\x64\x5a\x0a\xa7\x1e\xd9\xb4\xd3\x86\x29\xa3\x61\xcf\x4d\x05\x11\xbc\x05\xda\x18\xde\x42\xf4\xce\x1f\xc7\xc1\x2a\x58\x7d\xf8\x2f\xda\x22\x0d\x82\xa7\xd0\xfd\xf7\x8e\x09\x5e\x87\xf4\x77\x5f\xe3\x14\x60\x4a\xa5\x7e\xb4\x70\x21\x1e\x20\xa0\x87\x04\xbe\x4d\xa0\x61\x5b\x5c\x10\xa1\xbf\x61\xda\xa4\x9f\x3a\x91\x11\x20\x96\xd7\xe3\x38\x0c\xf4\x9e\x9b\xac\x82\x87\x30\xc8\x98\x1e\x87\x17\x8a\x7f\x14\xc1\xeb\xd7\xc7\x51\x5c\x85\xbf\x15\xaa\xb3\x91\x9a\x05\xaa\xe2\x65\xe5\xce\x38\x0b\x14\xed\xf4\x37\x0b\xe7\x50\xa9\x27\x35\x62\x53\xde\xae\x7d\x7a\xd6\xe3\xb3\x20\xff\x19\x7e\x0f\x00\xff\x7e\x7c\xff\xd8\x29\x26\xce\x5a\xd8\xed\x41\xff\xb2\x63\x35\x98\xf3\xae\x39\x73\xb2\xde\x5d\xe7\xfc\x82\xd8\x51\xdb\xec\x1d\xbc\x7d\x0b\x51\x0c\x9b\xce\xc0\xb3\x41\x98\x1e\xf2\xff\x4a\xa5\x82\xd5\xfa\x77\x63\x72\x94\x4a\xe4\xe1\xa9\xf7\xde\xb5\xa7\xf0\x65\x2f\xce\x3a\xf1\x5c\x00\xff\x40\xfc\xc8\xfd\x27\xdf\xf3\xa6\x05\x3d\x74\x96\x9e\xd6\x68\xb8\x67\xde\x58\x3f\x6a\x80\x54\x9e\x52\x13\x90\x8a\x7a\x06\xf3\x7f\x5b\x6d\x31\x4e\x10\x96\x5b\x99\xa2\x7b\x0b\xa7\x06\x65\xd9\xf2\x6b\xf7\xb5\x83\x46\xe6\x5d\x8d\xc9\x38\x70\x7a\xb7\x87\x3e\x67\x93\x98\xde\x02\xd7\x76\xbc\xc4\xbc\xa7\x5b\xd7\x82\xa7\xde\x30\x71\x20\x37\x06\xd1\xf9\xdd\xec\x66\xc0\x3d\x2c\xe4\xbb\xd5\xc0\xa6\x35\x07\xdf\x8a\xc8\x0a\x2f\x48\xac\x90\x36\x16\x52\xa0\x6f\x76\xcf\xf2\xde\xb9\xbe\x47\xaf\x60\x46\x7e\x89\xbf\xe2\x71\x61\x12\xd8\x71\xcd\x0d\xe6\xf0\x0c\x45\x7a\x67\x88\x20\x79\xd1\x2b\xdf\x92\xcd\xbb\xb3\x0d\xcb\xcb\xae\xb6\xa5\x6d\xb3\x9a\xee\x25\xd3\x66\xe6\x53\x2d\x8a\x08\xf4\x60\x6e\x6f\x6d\x0b\x34\x0c\x2c\x3d\x10\xbf\x93\x16\xb9\x74\x29\x74\x8b\x99\x59\x0c\xbb\x25\x40\x71\x58\x08\xa0\xb7\x3f\xc9\x1c\x63\x20\x42\x27\x2f\xa9\xf3\x39\x03\xaf\xc8\xc0\xac\xb9\xd9\xf4\xf5\x6d\x85\x02\x9f\x80\xdc\xd2\x3e\x22\x5d\xd8\x00\xd1\xf5\xf4\xe3\x7d\xab\x62\x67\xe6\x95\xdc\xce\x96\x3b\xef\xec\x6a\x9a\x36\x79\xee\xc2\x7a\x99\xa3\x30\x61\xd0\xb7\x92\xa2\x13\x64\x93\xec\x53\xc4\xd3\x05\x0d\x1d\xb1\x35\x64\x79\x6c\x5c\x43\x34\x17\xf0\x1c\xd6\x74\x9a\xd9\xeb\x1b\xac\x31\x33\x52\x91\x33\x33\xad\xf4\x06\xeb\x30\x08\x72\x2c\x58\x57\x9b\xd5\x79\xe7\x0a\xd1\x1f\xec\x04\x8a\xf4\xb3\x46\x7d\xcb\xf3\xbb\x74\xe1\x87\x21\x82\x7b\x72\xde\xc7\x47\x28\x44\x7a\xb5\x2d\x17\xf1\x04\xd2\x57\xfe\xe3\x44\xfa\x03\xd3\x6e\xb8\x5e\xf4\x5a\xe9\x15\x33\xd5\x22\x4e\x6c\x76\x5f\x6d\x4b\xf7\x3a\x7e\x2e\x70\xbc\x18\xec\xfb\x95\xb4\xcd\xf0\x09\xe0\xf5\x6b\xe0\xba\x4f\x6d\x9a\x92\x68\x1f\xfb\x3b\xf6\x26\x1d\xb6\x6b\x88\xec\x9a\x94\xe8\x64\x50\x79\x06\x6a\x3b\x0b\x65\x3b\x0a\xc8\x40\x07\xd7\x98\xed\x16\x85\x88\xdf\xb9\x57\x93\x5c\x59\x2e\xe1\x72\x20\x8d\x7e\xfe\xad\xd8\x8e\x46\x4f\xd8\xd0\x6c\x66\xa4\xff\x08\x42\x83\x16\x25\xca\x6f\xb8\xec\x13\x7a\xed\xb6\x7a\x0b\xd1\xa9\xd7\x4f\x53\xd7\x9d\x36\x79\x34\x1e\xc0\x55\xec\x77\xeb\xf3\x5f\x53\x9e\x09\x36\xe5\x20\x22\x15\x73\x62\x89\x06\x71\x3a\x9e\x12\x8b\xd0\x3b\xcf\x14\x8e\x48\x08\x56\xa9\x17\xb1\x4f\x89\xc9\x78\xfa\x02\xa0\x94\x66\x7d\xca\x8d\x7b\xa6\x8b\x19\x9f\xc4\xef\x40\x6e\xe7\x18\x9e\xf2\xd8\xc4\xcb\xa2\x1f\xaa\x5b\x53\xbd\xfd\xfb\x40\x54\xb1\xdf\xd7\x7b\xe2\x4d\xb9\x60\x3d\xc5\x43\x2f\xb3\x72\xdf\x55\xe6\xf0\x80\x42\xfa\x12\x37\x5e\xa5\x5e\xee\x29\xc0\x88\x88\x4f\x2e\xa4\x83\x06\xf1\xfb\xa4\x15\xd8\x46\x75\xd2\x41\x52\xb2\xf1\x33\xf5\xe3\xf9\xa5\xba\xbf\xef\xba\x55\xb2\x13\x46\xdb\x6f\x18\xb6\x3b\xdc\xa0\x31\xf4\x99\x23\xa1\x8f\x6b\x64\x00\xef\x59\xd3\x52\xa7\xe2\x9a\x3e\x5f\x19\xb6\x45\xca\x16\x3a\xc1\x0d\x1a\xef\x91\x6f\x11\x47\x29\xe9\xe7\x13\x2a\xe2\x91\x2a\x4f\x2f\x35\xa4\x16\x13\x01\x2b\xf8\x35\x81\xd6\x96\x32\x69\x28\x3a\xcf\xe9\xf5\xda\xd3\xad\xb5\xb3\x5e\xf7\xfa\xe7\x13\x65\x1c\x33\x1c\x0b\x7b\x68\xfa\x78\x52\x3d\x9e\xed\xf8\x14\x9e\x31\xea\xc4\x59\xfe\x53\x80\xa0\xa6\xc8\xc6\x4b\x2a\xc8\x22\x01\xdd\x65\x15\x30\x0d\xd1\xf7\x0e\xb0\xe8\x6c\x6b\x05\xcb\x42\x3e\x8c\x4c\xcc\x1c\x39\x0a\xe3\x11\x5f\xf4\x57\x48\xcb\x9a\xbd\xb5\x87\x30\xd0\xbc\xec\x0b\xa0\x10\x96\x6e\x17\xf1\x40\xb1\x37\xbc\x14\xcc\x74\xca\x5f\xf9\x3c\xcd\x6a\x5e\xa6\xd6\x6a\xfc\x7c\x7f\x34\x64\x70\x54\xf4\x86\xad\x95\x5f\xfb\xfd\x4c\xfa\x59\xe4\xa8\xea\x03\x17\xe5\x64\xd3\x81\xc3\xe2\x77\x43\x23\x9b\x1b\xe7\x85\x0d\x72\x3e\x1a\xea\xd7\x52\xca\xe4\x63\xbd\xfa\x65\x56\x39\xfd\x79\xf3\x75\x11\x0f\xcc\x35\xa2\x1a\x0d\x11\x8c\x3c\xb6\x43\x5a\xcd\x70\x9d\x27\x3f\x83\x0b\xd6\x60\x7d\x41\x9d\x8f\xe6\x49\x43\x5f\x39\x94\x0f\xfe\x3c\x2f\x7d\xb4\xa7\x13\x09\xe5\x29\x4f\x40\x8d\x29\x6a\x15\x7d\x5a\x72\xf8\x0e\xbe\xa5\x2b\x9c\xff\x9c\x9e\x5e\xea\xcf\x6d\x8b\x6a\xa1\x3c\x2f\x4f\xce\x75\xbb\xe2\x77\xc7\x79\x2a\x58\x83\xe1\x53\xf8\xbf\x01\x00\xa9\x1d\x62\xb1\xc7\x17\x00\x00")
+
+func jujugenerateapidocRetryGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocRetryGo,
+		"jujugenerateapidoc/retry.go",
+	)
+}
+
+func jujugenerateapidocRetryGo() (*asset, error) {
+	bytes, err := jujugenerateapidocRetryGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/retry.go", size: 6087, mode: os.FileMode(436), modTime: time.Unix(1792001325, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocRoundtripGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x7b\x6f\xdc\xb8\x11\xff\x7b\xf5\x29\xc6\x02\xe2\x4a\xb0\xaa\x78\xf3\x70\x53\xe7\xb6\xc0\xf5\xee\x02\xb8\xa9\x7d\x46\x13\x1f\x8a\x1a\xc6\x81\x2b\x8d\xbc\xcc\x4a\xa4\x4a\x52\xb2\xb7\xc9\x7e\xf7\x62\x28\xea\xb9\xbb\x71\xee\x70\x80\x01\x2f\xa9\xe1\xbc\xe7\x37\x43\x96\x2c\x59\xb3\x7b\x84\x82\x71\xe1\x79\xbc\x28\xa5\x32\x10\x78\x33\x1f\x45\x22\x53\x2e\xee\x9f\x7f\xd2\x52\xf8\xde\xcc\xcf\x0a\x43\xff\x14\x66\x39\x26\xf6\xa7\x96\xaa\xf9\x6f\x14\x17\xf7\x9a\x7e\x1a\x5e\xa0\xef\x85\x9e\xf7\xfc\x39\x14\xec\xf1\x1d\xcf\xf3\x1f\xb1\x34\x2b\x58\xc9\x3c\xd5\x60\x56\x24\xe9\x91\x17\x55\x01\xa9\xdd\x37\x12\x1e\x56\x3c\x59\xd1\x81\x8c\xe7\xf9\x2f\x2c\xaf\x10\x1e\x78\x9e\x43\x29\xcb\x2a\x67\x06\x41\xa0\x36\x98\x42\x4d\x9f\x74\xec\x25\x52\x68\x33\xe6\xbe\x80\x37\x9e\x57\x33\x05\x24\xff\xe3\xa6\x44\x58\x80\x53\x34\xa6\xe5\xcf\x59\x40\x5f\xe2\x8f\xbc\xc0\xcf\xdb\x46\x3d\x25\x2b\x91\x7e\x54\xbc\xbc\x56\x72\x99\x23\x29\xa4\x13\xc5\x97\xa8\x81\xc1\x03\xdb\x00\x17\x8d\x6a\xc0\xc0\x10\xcb\x8c\xf1\x5c\xd3\x49\x23\x41\x57\xaa\xe6\x35\x42\xeb\x25\x30\x12\xfe\xf1\xe1\xe7\x2b\x60\x22\x85\x25\x4b\xd6\xb1\x67\xcf\xec\x08\xd1\x46\x55\x89\x81\xcf\xde\x8c\xf4\x02\x80\x91\x9e\xde\x6c\x40\xc7\xc5\xbd\xb7\xb5\xaa\x26\x2b\x4c\xd6\xff\x6a\x59\xe9\x66\x4d\xde\x64\x06\xfe\x87\x4a\x5a\xa9\xad\xbb\x5a\x47\x81\xcc\x80\xe5\x39\x9d\x27\xb7\xdf\xf3\x1a\x85\x35\x44\x43\xc2\x04\x2c\x29\x12\x4a\xaf\x58\x8e\xe9\x48\xfb\x4a\x74\xfb\x74\x96\x8c\x81\x07\x6e\x56\xb2\x32\x90\x4b\xcd\xc5\x3d\x70\x91\x49\x55\x30\xc3\xa5\x88\xbd\xac\x12\xc9\x54\xc3\xc0\x68\x28\x58\x79\x3b\xb4\xed\x6e\x29\x65\x1e\xc2\xed\xdd\x8e\x4f\x3e\x7b\x33\x8a\x5d\xd9\x2c\xf5\x1e\x12\x6f\x96\x49\x05\xbf\x46\x60\xe0\x7c\x01\x8a\x89\x7b\x04\xca\x3e\x4c\x89\xb3\x0e\x8c\x0e\xc9\xa5\x2d\x55\xd9\x53\x8d\x15\x0b\x4c\x43\x37\xeb\x64\x2d\x80\x95\x25\x8a\x34\x68\x77\xa2\x9d\xc4\xb0\x07\x6c\xb8\xce\x29\x5e\x26\xb2\x6b\xf7\xf1\x1c\x4a\xbb\xde\x86\xde\x6c\xb6\xf5\xe8\x4f\xa1\xa9\x94\xe8\xcc\xa1\x18\xee\xf1\x51\x60\x46\x91\x27\xc7\x34\x31\xdf\xe3\x0e\x97\x0c\x33\x1b\xeb\xf3\x3e\xb5\xaf\xf0\x21\x30\xa1\x37\xe3\x99\x33\xb9\x63\x4e\x94\xe1\x5b\x28\xe1\x68\x01\xbe\x4f\x2c\xbf\x6a\xb2\x4f\xf4\x4d\xde\x9c\x83\x7f\x52\x86\xd6\x90\xac\xca\xf3\x7d\xe2\xba\x3a\x0d\x88\x22\xfe\x29\xc7\x22\x08\x23\x38\xdd\xab\x09\x91\xfc\x16\x4d\x26\x69\x3c\x54\xc7\x05\x97\x99\x55\x1f\xdf\x4a\xe0\x23\x61\x16\xa6\xef\x38\xe6\xa9\x0e\x4c\x04\xbe\x1f\x41\xc1\xd6\x18\xec\x4f\xc1\xf0\x49\x25\xb2\xc2\xc4\x1f\x4a\xc5\x85\xc9\x02\xbf\x97\x00\x19\x89\x80\x67\x1a\xb8\x06\x21\x4d\x53\xfd\x98\xfa\x91\x2b\x57\x1d\x7f\x54\xbc\xb8\x56\x98\xf1\xc7\xa0\x64\x66\x15\x81\x1f\xfb\x61\x18\x1e\xca\x8b\x21\x0c\xb5\xe5\x48\x75\x8d\x4d\x2c\xa0\x94\x5c\x90\x2b\x8c\x84\xe5\x06\xea\x71\x7d\x6a\xe0\x06\xb8\x30\x92\xca\x94\x81\xc0\x07\x77\x4a\x66\x96\x85\x66\x05\xda\x82\x8f\xa0\x11\x4d\xa5\xcb\x1c\xcc\x95\x54\xbc\x2d\x65\xc6\x95\x36\xc4\x25\xe5\x59\x86\x0a\x45\x82\x90\x91\x5e\x11\x48\x65\x79\x61\x51\x9a\x8d\xb3\x12\xb8\x3d\xa5\x10\x1e\x18\xf9\x41\xa0\x03\x81\x3e\xe8\x75\x97\x33\x36\x4f\x42\x68\x9d\xeb\x58\xd8\x10\xa4\x98\xa1\x02\x3a\x19\xd8\x35\x25\x0f\x2a\x65\x63\x8b\x89\xac\x51\x05\xe1\x5b\xbb\x73\xb4\x00\xc1\xf3\x51\xe5\xc2\x62\x1c\xa5\x92\x09\x9e\x9c\xc3\xb3\xda\x8f\xe8\x48\x5b\x8d\x41\xe8\xcd\x52\x66\x58\xd4\x72\xa6\x6e\x16\x5f\x36\x0e\x0c\xea\xf8\x42\x18\x54\x19\x4b\x30\xa0\x20\xf1\x6c\x2a\xce\xc5\x6c\x24\x2a\x61\x82\x82\xef\xa2\x30\x92\xb9\xf5\x66\xf5\x7c\x5a\x32\xb5\x2d\xf0\x20\x74\x85\xd2\xcb\x69\xd5\xb9\x69\x23\x1a\x34\xaa\xd6\xf3\x91\x5e\x6f\x7f\x83\x52\x95\x38\xa0\x16\x41\x84\x4d\x48\xb9\x26\xfd\xf0\xbf\x15\x6b\x7a\xad\x0e\xea\xae\x82\xeb\x79\xf7\xd3\xf7\xc3\xb7\x70\x24\xd7\x56\x20\xa5\x32\x2c\xbe\x9e\xe4\xde\xac\x95\x01\x8b\xae\xd2\xdb\x93\xbe\x4d\x4c\xbf\x09\xca\x5e\xfd\x9f\x51\x57\x23\x5c\x4f\x81\x65\x06\x55\x53\x17\x60\x14\x2f\xfd\xa6\xe6\x47\x45\xe4\xfb\xae\x7c\xfa\x99\x41\xa3\xd1\x50\x53\x2b\x63\xae\x0e\xa8\x6b\x51\x0b\x84\x71\x01\xdb\x1e\x4e\x95\x84\x39\x16\x28\x8c\xee\x26\x8d\xd4\x36\x3a\x4a\xe9\x3f\xf7\x80\xa8\x5d\x76\xf7\xa8\x37\xc9\xee\xc8\x8d\x32\x5c\x34\xdd\x85\x67\x6e\xe3\x6f\xe3\x21\xe5\xcb\x17\x38\xaa\xe3\x1f\x98\xf8\x80\xc6\x65\x7c\x63\x4d\x1b\xa0\x36\x51\xc8\x81\xdd\x20\x43\xfe\xaf\x63\x3a\x32\x12\xda\x4e\x34\x3f\x32\x83\xc1\x8b\xd3\xd3\xb3\x08\xe6\x11\xbc\x88\x60\xfe\x3a\x82\x57\x11\xbc\x8e\x60\xfe\xe2\xe5\xab\xd7\x67\x7f\x79\xf3\xd7\xc8\x8e\x45\xf1\x3b\xfe\x88\xe9\x7f\xa4\xc0\xc0\xff\xb7\x1f\xc1\xcb\xb3\xd3\xd3\xd0\x62\x93\x73\xaa\x55\x43\x3f\x70\x93\xac\xa0\x8e\xdf\x73\x91\x36\x5a\x26\x4c\x63\x67\xf1\xdf\xa5\xcc\xcf\x5b\x95\x68\x11\x18\x55\x61\x38\xa1\xba\x10\x26\x1a\x2e\xde\x8c\x56\xf3\xb3\xd1\xf2\xe5\x8b\xd1\xf2\xec\x55\xc7\xff\x42\x98\x60\x3e\xe5\x7d\xc3\x87\xcc\x6f\xf8\x88\xfb\x0d\x1f\xb3\xbf\xe1\x63\xfe\xb4\x3e\x7b\x35\x5e\x97\x46\x75\x12\x6f\xf8\x3e\x91\xef\x72\xc9\x46\x6c\xec\xc6\x40\x51\xbb\x0e\xe6\xf1\xeb\xe9\xc9\x0f\xb6\x64\x3a\xba\x66\x19\xf8\x8f\xfe\x94\xf0\xba\x51\x02\x9f\x06\x8f\x41\xfb\xc5\xae\x5c\x6d\xc2\x9d\xcc\xc3\x2e\x59\x76\x42\xf2\x21\xe7\x09\x92\x08\x3d\x14\x71\xc9\xd6\x68\xbf\x74\x82\x6c\x1e\xcd\xc7\x72\x74\x7c\x21\x52\x7c\x0c\x4e\xf7\x49\xd2\x53\x49\xdf\x2b\xc5\x36\xe7\x6e\x1a\xe3\x24\xed\xf4\x2d\x70\xf8\x0e\xea\xf8\x9f\x28\x08\xd3\xf9\xc9\x09\x25\xd6\x50\x44\xed\x44\xf0\xb1\x88\xed\x84\xf7\x25\x2b\x89\x73\x31\xb5\xe1\x92\x95\x9d\x05\x74\x6e\x7d\xd0\x8f\xef\x71\x13\x84\xad\x3b\x47\x56\xae\x47\x92\x9f\x8c\xc4\x3e\x16\x38\x62\x51\x50\x24\x2e\x59\xd9\x58\xb6\x8e\x00\x7b\xaf\x15\x3b\xf1\xb1\x17\x83\xfd\x6e\xbb\xaa\x0a\x3b\xdd\x1c\xf4\x5d\xf3\xf5\x09\xdf\x75\x3d\x85\x84\x58\xb8\xb9\xaa\x8a\x4b\x34\x2b\x49\x85\xbe\x58\xc0\x69\x13\x94\xfd\x68\x43\x39\xeb\xb8\x6e\x1d\xf6\x0e\x9a\x08\x28\x24\x88\xd5\xf0\xb0\x42\x9a\x0d\x80\xd9\x59\x65\x69\xef\x7d\x0d\xa1\x43\xd3\x88\xd0\xd7\x28\x64\x86\x46\x09\x02\x26\x0d\x4c\x3b\x92\x87\x15\xdd\x51\x56\xb8\x21\x7e\x0a\x35\x0a\xd3\x8f\x32\x5c\x68\xc3\x84\x89\x89\xc1\x85\x1d\x41\x36\x7f\x52\xd8\x0c\x62\x74\x3a\xa2\x79\x88\xe5\x5a\xba\x71\xa7\x19\xa4\x6c\x07\x32\x92\x7e\xd3\x41\x3b\xea\xec\xcc\x39\x0e\xe2\x87\x5d\x91\x45\xb0\x9c\xe2\xbc\xe5\xd5\x4e\x30\x41\xf3\x23\x82\xe6\x5e\xd3\x00\x3f\x3b\x04\xe1\xae\x6f\x11\x87\x08\xd8\xb0\xbf\xc7\xfd\xd5\x34\x8c\x7f\x22\x0d\x82\xe5\xa1\xef\xe1\x10\xa3\xd9\x21\x8c\xbe\x36\xaa\x87\xaa\x69\xd4\x59\x7c\xa1\xaf\x78\x1e\x84\xf0\xe5\x0b\x2c\xbb\x05\xe9\xb8\xa3\xa4\xfb\xb6\x58\xf4\x84\xae\x85\xf3\xac\x17\xbf\x58\xec\x0a\x83\xe3\x63\x60\xae\x42\x5a\x97\x1c\x11\x9b\xf1\xd6\xae\xd4\x8c\xe5\x1a\xc7\x73\xc2\x28\x2a\x8e\x41\xd4\xb1\xea\x86\x83\x91\x0b\x2c\xa6\x45\xbb\x90\x64\xf5\xb6\x28\x44\x53\xde\xb2\x01\xa4\x27\xd4\x98\xd6\x23\xdb\x81\x31\x1a\x7c\xf6\x4d\x56\x6c\x00\x6a\xcb\xc1\xef\xc9\xe4\x73\xfb\x2c\xbd\x73\x43\x4e\x04\x3c\x1c\x0c\x5e\xbd\x56\xbd\x4a\xb3\xed\xd8\x3d\x74\xdb\xa1\x1e\xbc\x1f\x29\x7f\xaf\xc1\xbf\x46\xb0\xee\x6f\x59\x8c\xd8\xbd\xc7\x8d\x6e\xcf\x2e\x6b\xfa\xb8\x8c\x7b\x74\x0b\x9d\x23\x8e\x96\x75\x7c\xa1\x7f\x61\x39\x77\xa9\x39\x3b\x34\xf0\xdd\x3e\xab\x7b\xb3\xd7\xe1\xc4\xc2\xaf\x38\x75\x20\x34\x82\x65\x1d\x3d\xc1\xf8\x8f\xf1\xe7\x61\x7c\x66\xfb\xf1\x79\x80\x06\x1d\x3e\xc7\xd7\xeb\xfb\x6b\x02\x91\xfe\x32\x3c\x9b\x3d\x7f\x0e\x37\x93\x3b\xa6\x06\xa6\xd0\x61\x2a\xa6\xa0\xb1\x64\x8a\x19\xcc\x37\x31\x71\x9e\x25\x52\x18\x2e\xaa\x6f\xf1\x55\xdf\x19\x96\x9d\x16\x8d\x6b\x4e\xfc\xd8\x3f\xd9\xd5\xf0\x8a\x15\xf8\xc7\x78\xec\x5d\x25\x92\xbe\x04\x7f\x58\x31\x71\xee\x1d\xc4\x98\xe3\xe3\x21\xc6\x6c\xbd\x1d\xba\x16\x59\x3a\x3c\xea\x37\x5c\x3b\x9a\x3e\x05\xec\xb4\x01\x6d\x2f\x07\x79\x3e\x78\x34\xb0\xdd\x88\xe6\x7d\x2c\x96\x98\xa6\xe4\x6b\x1b\xe7\x36\x0c\x0a\x59\xb2\x62\xcb\x1c\x21\x53\xb2\x00\xe3\x1a\xc5\x54\xd4\xe4\x31\x67\xd4\x29\x22\xd0\x88\xe2\xf0\x73\x58\xff\xea\xc3\x33\x4b\x7a\x6b\xee\x08\x9b\xcd\xa1\x1e\x22\x78\x6e\x3d\xd4\xd2\x2e\x9c\xf3\xed\x1b\x9a\x35\xb3\x65\xda\xb5\x0a\xf3\x4d\xad\x62\x1f\x68\x46\x53\x40\x21\xcb\xe8\xe5\x66\xd7\x05\x23\x40\x6e\xac\xfe\xf6\x21\xc7\x1c\x18\x72\xa8\xce\x4c\x97\x9d\x2e\xd7\xb3\x49\x1d\x1d\x1f\xc3\x51\x16\x7f\x2f\xa4\xd8\x14\xb2\xd2\x2e\x71\x5b\x4d\xdb\xe7\x1d\x5a\x0e\x52\x3f\x6b\x52\x7d\x7f\x41\xed\x3f\xbb\x63\x73\x36\x08\xf7\x80\xa9\x33\x3e\x8e\xe3\x6e\x62\x1a\xe6\x73\xff\x24\x38\x7e\xc3\xfc\xca\x93\xe9\x60\x93\xac\x6b\xce\x91\x6f\xec\x3b\xd7\x98\x20\x82\xd3\x08\x72\x14\xf4\x2a\x1a\x36\x2f\xa7\x83\x67\x53\xd3\xb8\xc7\x71\xe8\x2c\x6c\xd6\x11\x18\x37\x62\x48\xe5\xf2\xa1\xfb\x42\x89\x1f\xf0\x08\x3e\xd1\x9b\x53\x68\xa7\x9e\x61\x56\x36\x64\xb7\xfc\xce\xdd\x79\x82\x10\xbe\x6b\x37\x3f\xf5\x9b\xde\x6c\x1b\x76\xce\xd0\x52\x19\x4c\xbd\xad\xf7\xff\x01\x00\xb6\x72\x7c\x11\x86\x18\x00\x00")
+
+func jujugenerateapidocRoundtripGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocRoundtripGo,
+		"jujugenerateapidoc/roundtrip.go",
+	)
+}
+
+func jujugenerateapidocRoundtripGo() (*asset, error) {
+	bytes, err := jujugenerateapidocRoundtripGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/roundtrip.go", size: 6278, mode: os.FileMode(436), modTime: time.Unix(1791993460, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocSecretsGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5b\x6f\xdb\xb8\x12\x7e\x96\x7e\xc5\x44\x0f\x85\xd4\x12\xf2\x39\xaf\x29\x7c\x80\x36\x3d\xc5\x09\x4e\xb7\x08\x36\xdd\xbe\x04\xc1\x82\x11\x47\x32\x63\x99\xd4\x92\x54\x2e\x48\xfd\xdf\x17\xc3\x8b\x2c\x27\x8e\xb7\x58\x60\xf3\x10\x9b\xe4\xdc\xf8\xcd\x37\xc3\xf1\xc0\x9b\x35\xef\x10\x36\x5c\xaa\x3c\x97\x9b\x41\x1b\x07\x65\x9e\x15\x9d\x5e\x70\xeb\x8a\xf0\xad\xd1\xca\x3a\xae\xd2\xd2\x3d\x0e\x68\xe9\xfb\xc0\xdd\x8a\x3e\xad\x33\x52\x75\xb6\xc8\xe9\x5c\xba\xd5\x78\x53\x37\x7a\xb3\xb8\x1d\x6f\x47\xff\x8f\x0f\x52\xe8\x66\x11\x3e\x48\xa1\xd3\x3d\x57\x5d\xad\x4d\xb7\x78\x58\x38\xad\x7b\xbb\xe8\xf4\x22\x06\x63\x8b\xbc\xca\xf3\xc5\x02\x2c\x36\x06\x9d\xfd\xc6\x3b\x58\xe9\x5e\x58\x70\x2b\x04\x3b\xde\xd8\x47\xeb\x70\x03\x8e\x77\xa0\x5b\xbf\xd9\xf2\x86\x0b\xb4\xa4\x74\xbf\xd2\x16\xc1\xe0\x1f\xa3\x34\xb8\x41\xe5\x2c\x70\x43\x1b\x8d\x36\x02\x45\x9d\xfb\xcb\xcc\x6d\x2f\xa1\x88\xab\xc2\xbb\xdd\x68\x81\xfd\xb7\xc7\x01\xcf\x48\xd2\xce\x7c\x2b\xbe\x41\x9b\x7c\x26\x50\xbc\x57\xb7\xe2\xce\x0b\x06\x6d\xf0\x10\x31\x90\xca\xeb\x59\xc7\x1d\x42\xc2\x9a\x2b\x01\x52\x05\x2d\x32\x63\x70\x11\x94\xa2\x40\x9d\xdf\x71\xf3\x22\x8a\x25\x6c\xf8\x70\x15\x90\xbe\xbe\xd1\xba\x7f\xca\xb3\xe2\x97\x49\xe8\xc3\x87\xcb\xe2\x14\x9c\x19\x91\xcd\xf7\xcf\xf7\xf7\xcf\xc2\x72\xfa\x4b\xfb\x51\x2c\x6d\x47\xf9\x6d\x80\x03\xdd\x4a\x8b\x5f\xe7\x80\x1a\x74\xa3\x51\x31\x1f\x1e\x3a\xb8\xe1\xcd\x1a\x95\x00\xae\xf6\x20\x98\xb0\x21\xd1\x4e\xde\xa1\x8a\xf6\xa0\x59\x61\xb3\xb6\xd0\x6a\x93\x50\x6a\xb4\x12\xd2\x49\xad\x3c\xc4\xd2\x59\x90\x2d\xd9\x23\x1b\xf6\x5e\xba\x66\x15\x80\xf4\x31\x30\xd0\x06\x9c\xcf\x75\xe2\xc0\xa8\x9a\xa0\x7d\x2f\xdd\x2a\xda\x24\xf6\xc5\x9c\x8e\x3d\x86\x50\xa4\x83\x86\xf7\xfd\x2e\x3d\x44\x0c\x03\xad\x1e\x95\xa8\xf3\x76\x54\xcd\x81\x3b\x97\xc3\xba\x83\xb7\x31\x43\xb6\xbe\x08\x5f\x18\x0c\x0e\xde\xfa\x9b\xd6\x04\xf7\x57\xbe\x41\xe6\x69\x02\x21\x53\x15\x5c\x5d\x07\xda\xd7\x33\x6b\xf0\x94\x67\x02\x9b\x9e\x01\xfd\xbf\x58\x77\x0c\xd0\x18\x38\x5d\x46\xc7\x9f\xb0\xe9\xc9\x21\x99\x0f\xe6\xaa\x3c\x93\xad\x17\x3a\x59\x82\x92\x3d\xfc\xf8\xe1\x75\xeb\x8f\x5a\x3c\xc2\x32\xec\x3d\xe5\x59\x16\x52\x43\xcb\x3c\xdb\xe6\x19\x31\xc9\xd8\x83\x41\xe4\x99\x45\x54\xde\x29\x5f\x63\x49\xec\x7a\x29\xe4\x99\x56\xe5\x19\x17\x82\x24\x09\x9c\x72\x38\x84\x03\x25\x0f\xb8\x75\xf5\x57\x2d\x90\x85\xec\x4e\x18\x50\x60\x74\x76\xae\xec\x80\x8d\x2b\x49\x98\xf9\x84\x95\x6a\x52\xaa\x80\x7c\x11\x34\x59\x86\x0c\xf4\x9a\x1c\xaa\xba\xa4\xf3\xff\x3e\x0c\xa6\xa2\x03\xd9\xc2\x89\x5e\x07\xa1\x74\x57\x22\x2b\xad\xb7\x51\xc0\x24\xe5\x59\x13\x28\x07\x06\x58\xbd\x87\x9d\x6e\x7d\xe6\x43\x5c\x86\x50\x49\x95\x10\x3e\x21\x4c\xae\xcc\x75\x94\xca\xd2\x72\x39\x79\xc9\x32\x63\x61\x09\x7c\x18\x50\x89\xd2\x58\x06\x21\xb2\x6c\x3b\x8f\xa9\xe5\xbd\xdd\x05\xb5\x1f\xe8\xb6\x9a\x52\x73\x27\xad\x74\x01\x88\xc8\x84\x43\xd8\xd2\x11\xbc\x25\x1c\x3e\x8f\xaa\xf9\x14\x89\x33\xb8\x15\x48\xe5\x58\x30\x82\xc2\xf7\x87\x3d\xa9\x94\x3c\x2f\x00\xcb\x7f\xd8\x8f\x87\x4c\xb6\x49\xec\x8a\xac\x5d\x1f\xe4\x69\xdc\xbb\x58\x77\xbe\x68\xec\xb9\x6a\xf5\x9c\xc3\x11\xaf\x3c\xa4\x74\xdf\xde\x94\x88\x39\x9f\x26\x0f\x47\x49\x15\x7b\x88\x8a\xbc\xa2\xaa\x0d\x41\x67\x0d\xb7\x18\x2e\x7e\xde\x5e\xba\x8d\x3b\xa5\x4d\xe2\x7c\x42\x8b\x81\xaa\xcf\x3c\x69\x49\xcb\x7a\x3e\x5e\x7a\x72\x97\xe1\xa0\xaa\xf6\xed\x9c\x71\x8b\x67\x3d\x1f\x2d\x06\x5b\xd4\xe6\x7e\x67\x80\xe4\xdb\x70\xd5\x21\xa8\xfa\x8b\xb4\x2e\xf1\x6c\xcf\x17\x32\x28\xbc\xa9\xe2\xdd\x0b\x77\x58\xcd\xd8\x36\xf7\xd7\xf7\x24\x75\x9a\x88\x1c\xf2\xf6\x1f\x2a\xee\x87\x0f\xa3\x5b\x91\xc0\x27\xbf\x17\x3d\xee\x73\x32\x1a\xf4\xa4\x94\x22\x62\x21\x7c\x97\xd8\x21\xd7\x8e\x11\xbb\xcf\xe3\x3e\x7e\xb3\x40\xbc\x52\x88\x22\x93\x22\x90\xee\x99\xc8\x25\xf6\xd8\x38\x6d\x76\xf1\x4e\x92\xf5\x25\xf6\x5e\x5a\x60\xcb\xc7\xde\x9d\x1e\x8b\xb5\x55\xa9\xd0\x5f\xb0\xa9\xfe\xcd\xa2\xbd\x92\xe2\xba\x2e\x63\x77\x26\xae\x56\x53\x95\xeb\x35\x11\xb3\x55\xf5\xc5\xba\x2b\xab\x19\x2f\x4f\xe2\x28\x53\xff\x8f\xdb\x0b\x83\xad\x7c\x28\x93\x54\x7d\xc1\xdd\xaa\xac\x98\x7f\x53\x2e\xd6\x5d\x38\x4e\x08\xbc\x12\x23\x3d\x34\x88\x54\x24\xcc\x3f\x3a\x88\xf3\x6e\xdf\x4a\x25\xe8\x2c\x56\x60\x68\xf9\xe4\x4f\xdb\x32\xe6\x79\xbf\xeb\x1f\xf5\x25\x5b\x68\x09\x89\x84\xca\xce\x77\x5d\xee\xd5\xeb\xbc\x0f\x86\xf2\x2a\x67\xb1\x45\x1b\x9e\x40\xef\xfe\x3d\x95\xfd\x8c\x76\x47\x7a\x9a\x37\x36\x11\x99\xbe\x30\xf8\x17\xdb\xbd\x30\x07\xfa\x46\x95\x27\x5b\xc6\xc6\x91\x63\xd6\xb8\xf7\x66\x8d\xf9\x3e\x3e\x0c\x06\xad\x45\x01\x37\x8f\x80\x8c\x66\x05\xe9\xc0\x60\x8b\xc6\x82\xd3\x69\xbc\xa2\xec\xd3\x38\xc1\x9f\x0d\x2a\x0c\x56\xd8\x8b\x34\x01\x7c\x0c\xd3\x0b\xf1\x67\x9a\xeb\x40\xb7\x64\x44\xab\x69\xc2\x88\x53\xe2\x62\x30\xfa\x4e\xd2\xc8\x90\x5a\x68\x18\x46\x34\xf0\xd9\xe8\x33\xd9\x89\x23\xc5\x2c\xf6\xd7\x66\x09\x84\xe9\xad\x83\xf2\xe5\x5b\xcc\x60\xea\xb3\x07\xca\x34\xd6\xa8\xef\x30\x38\xab\xce\x03\xa5\xe9\xab\x0d\xf3\x23\x25\x19\x24\x42\x35\xce\x4a\x31\xe6\xe9\x65\x68\x4f\x5b\x96\x9e\xbc\x6d\x9e\xe9\x9b\xdb\xc4\xc1\xe1\x78\x55\xfa\xd9\xb6\xca\x67\x35\xa9\x6f\x6e\x7f\xa6\x28\x27\xb1\x63\x55\xf9\x93\xe1\x12\x98\x6b\xa9\x44\x1c\x57\x26\x28\x87\x75\x47\xc6\xe9\x1a\xcf\xdd\xbd\x9f\x90\xa5\x13\x9a\xfa\x42\xc0\xc5\x8c\x48\x05\xbc\x79\x13\x4d\xfa\x8b\x3a\x2e\x95\x2d\xa3\x51\x06\xc5\xe2\x39\x9d\x16\x45\x45\xd8\xfb\x50\x96\x07\xa2\xbe\xf4\xf2\xd1\x43\x74\xff\xec\x57\xc2\xd5\x2e\x9c\x6b\x72\x5f\xd2\x2f\xb4\xfa\x23\xb7\x98\x1c\x87\x30\xfd\x1c\x5d\x50\x0f\x7c\x45\xc0\xdb\xfd\x8b\x78\xa6\x1f\x19\x7f\x87\x23\x77\xbc\x1f\x3d\x57\x13\x42\xdf\xf4\x17\x7d\x8f\xa6\xdc\xdd\x20\xf0\x82\xd6\xdf\x79\x5f\x56\xf5\xff\xa5\x12\x01\xe6\xa9\xb4\xc2\xa3\x48\xc9\x88\x06\x5f\x9c\x91\xea\x64\x22\x34\xa9\xd7\x23\xcc\xb3\x8c\x9c\x9c\x02\xd0\xa5\x59\x9e\x65\xdf\xc9\xea\x29\x78\xe3\x2c\xcf\xb6\x0c\x9c\x19\x31\xdf\xe6\x7f\x0e\x00\xcf\x3f\x91\x02\x39\x0f\x00\x00")
+
+func jujugenerateapidocSecretsGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocSecretsGo,
+		"jujugenerateapidoc/secrets.go",
+	)
+}
+
+func jujugenerateapidocSecretsGo() (*asset, error) {
+	bytes, err := jujugenerateapidocSecretsGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/secrets.go", size: 3897, mode: os.FileMode(436), modTime: time.Unix(1791999653, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocSecurityGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x58\x51\x8f\xdb\x38\x0e\x7e\x8e\x7f\x05\x6b\x60\xba\x76\xd7\x75\x5a\xe0\xb0\x0f\x39\x64\x81\x6e\xf7\x8a\xeb\xed\xb5\x3b\xd8\x76\xaf\x0f\xb3\x83\x83\x62\xd3\xb6\x1a\x5b\xca\x49\xf2\x64\xb2\x9d\xf9\xef\x07\x52\xb2\x63\x27\x19\xe0\x70\x40\xd1\x71\x64\x92\xa2\xc8\x8f\x9f\x48\xef\x44\xb1\x15\x35\x42\x27\xa4\x8a\x22\xd9\xed\xb4\x71\x90\x44\x8b\xb8\xea\x5c\x1c\x2d\xe2\x5a\x2f\x85\x1d\x9e\xdc\x61\x87\x96\x9e\xa5\x5e\x4a\xdd\x3b\xd9\xd2\x0f\xab\x0d\x0b\x58\x67\xa4\xaa\x6d\x1c\x91\xb0\x74\x4d\xbf\xc9\x0b\xdd\x2d\xbf\xf6\x5f\x7b\xfe\x4f\xec\x64\xa9\x8b\xa5\xff\x43\x0a\xb5\x6e\x85\xaa\x73\x6d\xea\xe5\xfd\xd2\x69\xdd\xda\x65\xad\x97\xc1\x23\xde\xa7\xd6\xbb\x6d\x9d\x4b\xb5\x44\x63\x6a\x9d\xdf\xbd\x8e\xa3\x34\x8a\x96\x4b\xe8\xc4\xfd\x9b\xde\x35\x6f\x45\xdb\xfe\x8c\x3b\xd7\x40\xa3\xdb\xd2\x82\x6b\xe8\x24\xf7\xb2\xeb\x3b\x28\x79\x5d\x57\x50\x88\xb6\xa5\x57\xc2\x91\xe6\x5e\xb6\x2d\x6c\x10\x2a\xdd\xb6\x7a\x8f\x25\xec\x1b\x54\xd0\x6a\xbd\x95\xaa\x86\x4a\x1b\x10\xb0\x43\xd3\x49\x6b\xa5\x56\x50\x34\x58\x6c\xf3\xa8\xd0\xca\xba\xf3\x5d\xd7\xf0\x97\x28\xbc\xa3\x13\x5e\x6f\xeb\x6b\x83\x95\xbc\x87\x35\x5c\x0c\xc1\x32\x66\xef\x45\x8d\xca\xfd\x22\x55\x69\x27\x7e\xa3\x72\xd2\x1d\x60\xcb\xcb\xe4\x2d\xaf\x5a\x2c\x7a\x23\xdd\x81\xd4\x0c\x72\x76\xa4\x85\x42\xab\x02\x8d\x22\xef\xa5\x6b\xf2\xe8\x4e\x98\xa9\xd1\x35\x74\x62\x77\xe3\xf3\x71\xbb\xd1\xba\xfd\x16\x2d\xe2\x4e\x14\x8d\x54\xf8\x92\xe5\xe2\x15\x38\xd3\x63\x16\x2d\xe2\x5e\x49\x37\x2e\x02\x84\xf5\x47\xef\x68\xef\x9a\x0f\xe8\x1a\x5d\x7e\x14\x1d\x4e\xbd\x55\xfc\x5b\x57\x2c\xa2\x8d\xfc\x13\x0d\x74\x2c\x69\x49\x91\xfd\x2f\x74\xaf\x1c\x08\x7b\x31\xa2\xec\xf2\x89\xf9\x8b\x7e\xff\x5d\xd8\xeb\x51\x7b\xe2\x37\x65\xe2\xd7\xbd\x42\xe3\xdd\x3e\xf3\xfb\x2d\x65\xee\x8b\x36\xb3\x20\xef\xf9\x37\x7b\xd7\x89\x2d\x82\x80\xaa\x57\x85\x23\xbf\xf6\x8d\xb6\x48\xbe\xd3\xd1\xc0\x3a\x61\x9c\xe5\xf0\x7a\x0c\x64\xd0\x08\x9b\x01\x2a\xdb\x1b\x04\x6d\xc0\xe0\x7f\x7a\x69\x70\x72\x4a\x52\x3e\x3d\x68\x46\xe7\x97\xe1\xc7\x5b\xa1\x7e\x43\x51\x82\x50\x65\x30\x74\x3c\xd9\x31\x22\x13\xc7\x2f\x06\xa4\x10\x2a\x1c\xf9\x78\xec\x45\x4c\x9a\xf1\xea\x74\xf5\xe8\x4e\xbc\xba\xb4\x6a\x27\x01\xa5\x55\x3b\x9a\x18\x56\x45\x51\xa0\x1d\x97\xc7\xd5\xb2\x93\xea\x4c\xd6\xf6\x3b\x34\xbd\x0d\x29\x99\x26\xe4\xb8\xe3\x17\x61\x14\xd1\x04\x18\x74\xbd\x51\x04\x8e\xbd\x5f\xe2\xe2\x43\x51\x34\x01\x48\xa0\x2b\xce\x59\x2d\xef\x50\x91\x91\x4a\x14\xa2\x44\xae\x64\x90\x16\xc4\x9d\x90\xad\xd8\xb4\x08\x4e\x43\x40\x37\x25\x86\x00\xed\xab\xc1\xc2\xa6\x77\x5e\x5e\xe1\x1d\x1a\x32\x22\x76\x3b\x14\xc6\x92\x0e\xe7\x64\xe2\x1a\xa5\x57\xba\x06\x0d\x6c\x0e\xcc\x1a\xc4\x08\x5a\x61\x70\x64\x00\xd6\x1c\xed\x20\xd5\x19\x8e\xf5\xcc\x82\x50\x53\x4d\xc1\x60\x1b\x50\x97\x47\xf4\x74\x21\x3c\xc9\x6e\x5b\xc3\x8b\x81\x0b\xf3\x6b\xff\x90\x41\x05\x9e\x3f\xf3\x77\x1c\x8c\xf7\xaa\xd2\x19\xec\x1c\xbc\x60\x6e\xce\x3f\x1f\x76\x48\xa5\x9a\xc2\xcd\x6d\x10\x0c\x16\xe1\x5b\xb4\x18\x69\xc2\xc2\xcd\xad\x47\x55\xb4\xa0\xa8\xff\x3b\x63\xde\x81\xd5\x1a\x8c\x50\x35\x42\x95\xbf\x19\xa2\xfb\x59\x93\xea\x42\x56\x13\x86\xb9\x21\xe9\x5b\x5e\x5f\x04\x83\x6b\x8e\xac\x2a\x13\xff\xdb\x1b\x4c\xa3\xc5\xe2\x31\xa2\x7f\xb2\x82\x16\x55\x78\x99\xc2\x7a\x0d\xaf\x58\xdd\xa3\x00\x94\x6c\x59\x8c\x3c\xac\x44\xe1\xb4\x39\x10\xcb\x4e\xfd\xb4\x4e\x38\xfc\xd0\xe7\xff\xd4\xc5\x36\x49\xbd\xdf\x5c\xaa\x47\xa7\xbd\xe2\x40\xd1\xf6\xc6\x03\xe6\xfd\xcf\xdf\xaa\x9c\x82\x92\x41\x95\xff\x0b\x0d\xa1\xf0\xd1\x3b\x3f\xdb\x6a\x3c\xc1\x74\x35\xe3\x3d\x52\x76\x6e\xf0\xe0\x77\xd5\x06\x1f\xe8\xea\xcb\x3f\x71\x20\xed\x4c\x2d\xf5\xc1\x0e\xc8\xb6\x67\xd9\x18\xc3\xde\x4d\x63\xfe\x21\x40\x8a\x5c\x2b\xb1\x68\x33\xa0\xff\xaf\xb7\x75\x06\x68\x0c\x49\xfa\xca\xf8\x19\x8b\x96\x00\x42\x99\xcf\xa0\xe3\xc3\x51\xa8\x65\xc5\x72\xcf\xd6\x14\x4f\x78\x78\x60\xf5\xfc\x27\x5d\x1e\x28\xe0\xb4\x46\x96\x17\xcb\x25\x7c\x41\x28\x84\xfa\xce\x81\x50\xa2\x3d\xfc\x89\x20\x95\x43\x53\x89\x02\x47\x5c\xeb\x23\xa1\x7b\x1d\x26\x48\xb0\xba\x37\x05\xc2\x7e\x30\x50\x49\x55\xe6\x64\xb5\xd0\xca\x49\xd5\xa3\x4f\x39\xe1\xa5\x11\xf6\xcd\xc0\x67\xde\xdd\xf1\x38\xf4\x90\xc1\xab\x8c\x59\x38\x21\x92\x7b\x21\xac\xcb\xdf\xf5\xaa\xa0\xc3\x31\xd7\xa5\x29\x7c\xbb\x60\xb8\xb3\x35\x45\xa2\xea\x5c\xfe\x69\x67\xa4\x72\x55\x12\xcf\xa8\xe0\xca\x97\xbd\xd2\x93\xb2\x0a\xb5\x5e\xe9\x5e\x95\x71\x06\xa1\x55\xc9\xff\xa1\xe5\x80\xc9\x0c\xe2\x0c\xe2\x34\x84\x91\xb0\x3a\xcb\x27\xfc\x18\x00\xcb\xfb\x7f\x7f\xb2\x3f\x0c\xc2\xa1\xd9\xb8\xb2\xe9\xe9\x2e\x53\x6b\x93\xbd\x1e\xa3\xc5\x62\x44\xc9\x88\xc0\x61\x25\x83\x39\x6c\x38\x1e\x54\x81\xcc\xbc\xb1\xd2\x2f\x8f\x47\x7c\xc9\x47\x8c\x33\x12\xf1\xe4\xb0\x02\x08\xc0\xa7\xb5\x80\xfc\xd5\xb1\x08\x58\xd4\x63\x6e\x05\x01\x46\x61\xcd\x5a\x51\xe3\x0a\x3a\x5b\xd3\xc2\xa3\xc7\x7f\xa8\xd5\xc1\xb9\x40\xed\xd3\x2c\x87\x16\xc5\xc2\xbe\x41\x26\x52\xe2\xef\x0d\xe1\x6f\xca\xe5\xe3\x9d\x9b\x11\x5d\x0b\xc5\xcd\xcd\xb0\xc6\x57\xae\x54\x2c\x4d\x7d\x13\x74\xba\xec\xdb\x81\xf5\x9d\x0f\x70\x36\xa5\xf1\x1d\x9a\x4a\x9b\x8e\x8c\x5c\x6a\x34\xc8\xf0\xd3\x50\xbc\xc4\xb1\x04\x4e\x98\xe1\x31\x0b\xdd\xa4\x54\x2e\x83\x3b\x69\xa5\xc3\x12\x9e\x40\x2d\x10\x76\x09\x2a\xb2\x1a\x44\x6f\xc8\xe2\xed\x93\x05\x19\xe2\x5a\x89\xd6\x22\x07\x7a\xae\xb6\xe6\x1b\x9a\x18\xa3\x57\x25\x43\xdf\x0b\xd2\xd6\xef\x95\xdd\x61\xe1\x92\xd1\x6e\xc6\xc1\x4d\x14\xd0\xdb\x8f\xba\xc4\xa3\x3f\x54\x92\xde\x06\xc3\x68\xbe\x2b\x6d\xbb\xa0\xd8\x66\xa0\xb7\xb4\x89\xca\x13\x0e\x01\x31\xe9\xdf\xee\x77\x26\x14\xc6\x33\xbd\x9d\xa9\x7b\xd7\x58\xfb\x4e\x18\x90\xa5\x0f\xdc\xfb\x12\x95\x8b\x16\x0b\xbb\x97\xae\x68\xc8\x27\xb2\x49\xf6\x29\xa6\x79\x42\xb7\x95\x2f\xef\x42\x58\x9c\xe8\xac\xc8\xb6\x2c\x61\x4d\x3a\xb3\xd7\x9f\xb0\x45\x2a\x21\x72\x66\x26\x95\x7f\xc2\x96\x09\xb3\x12\x7d\xeb\x56\x97\x9d\x93\xd5\xe9\x2d\x7d\x23\x4b\x46\x3c\xe7\x45\x32\x86\x29\x91\xb4\x94\x84\x57\x81\x80\x7c\xd0\x86\x34\x5c\x8c\x9c\xac\x02\x42\x7e\x5c\x9f\x4f\x09\x21\xef\xd7\xdb\x9a\xef\x67\x4b\x97\xf6\x8c\x90\xcf\xdd\xad\xd4\x90\x87\x33\xcd\xfc\x77\xcb\xce\xdf\xe6\x49\xb8\xf4\xc9\xed\x49\x7a\x1e\x1e\xa0\x52\xf9\xf5\xb6\x4e\xd2\x61\x97\x87\x07\x78\x36\xb0\x11\xf5\xd3\x3c\xa4\x24\x83\x54\x7e\x2d\x5c\x93\xa4\xd9\x7c\x86\x49\x9f\xf2\x8d\xb2\x88\x48\x80\xcf\xb8\x1a\x11\xa7\x37\x14\xdd\x07\xf4\x2e\x54\x93\xe7\x7d\xda\x49\xdb\x24\x3d\xbf\xa4\x9e\xd8\x83\xa0\x4a\x27\x1f\xa2\x70\xdc\x33\x4f\x66\x35\x97\xfe\x95\x24\x9e\x3f\xbf\x50\xe1\x13\xdf\x82\x2d\x4e\xd1\xf7\xaf\xc7\x12\xfe\xdf\xf3\x3b\xf3\x90\xe8\x70\x90\x21\xd5\x40\x84\x73\x0c\x9d\x51\xe1\x74\xd4\xa0\xa9\xe2\x48\x87\xd4\x61\x10\x7b\xd1\x10\x6a\xa1\x95\x5b\x04\xe9\x06\x5a\xb3\xf3\xce\x31\x03\xdb\x17\x0d\x08\x3f\x85\x15\xc7\x81\x82\x0c\x14\x42\xbd\xe1\x76\x7d\xdc\xca\x42\x6f\xb1\xa4\x6e\xb4\x13\xea\x10\x3a\x68\x9b\xc3\xe7\x30\xc2\x51\x1f\x6d\x77\xad\x74\xd4\x00\x68\xb2\x21\x9d\x85\x4e\xde\x63\xf9\xb2\x10\x3b\xeb\x07\xa6\x0c\xac\xf6\x04\x7c\xb4\xcb\x6e\xbe\x15\xaa\xc0\x96\xb6\x07\x3f\x27\x68\xc3\xe3\x1f\xbf\xf4\xae\xfc\x82\x07\x28\x35\x75\x0a\x3c\x28\x05\x3e\x3e\xa9\xb7\x30\x72\x11\x40\x8f\x7c\xc5\x5b\x53\xf2\x07\xe4\xbe\x93\xd8\x96\x36\xa1\xb1\xdd\xf0\x60\xc7\x7a\x69\x3a\x76\x97\xac\x71\xde\x5c\x86\x44\x52\x07\xe7\xe9\x88\xe5\x6e\x5e\x71\x0f\xc8\xfc\x13\x0f\x31\xc6\x38\x1b\x7f\x58\x8c\x57\xa7\xa9\xf7\xd2\x85\x50\xd3\x57\x93\xad\x7f\x84\xd7\x53\x93\x2c\xb5\x5c\xc2\xbb\x31\x6c\x43\xf6\xe8\xed\x67\x51\x87\x29\xc8\x4b\xf9\x8c\xb6\x2d\x9a\xef\x2c\x48\x62\x50\xe9\x0e\xf9\x53\xfb\xc0\xf3\xe7\xe1\x20\xaf\x6f\xe1\xd9\x1a\x62\x27\xea\x78\xf4\x90\xee\x3e\x3a\x4b\x23\x2c\xfd\xf1\xd3\x26\x3d\x85\xb1\x95\x3d\x0b\x3d\xe8\xfe\xd8\x83\x06\x83\xab\xd0\xdb\xcb\xea\x64\x22\xbd\xd9\x87\x37\x27\x35\xbb\x78\x1c\x3b\xfd\xf0\xc2\x07\xdd\x57\xc6\xb1\x6b\x1d\xa7\x3e\x3a\x2b\x15\xa5\x30\x7e\x20\x9a\xf5\x07\x5e\x9e\x34\x09\x59\x24\x1a\x2e\x69\x90\xee\x3b\x1b\xf4\xb0\x04\x39\xcc\x4f\xf3\xb6\xf8\xd2\x9d\xee\x08\x2a\xa7\x53\x52\x16\x14\x3f\x4e\xe1\x37\xe7\x97\xec\x92\x31\x34\x46\x1b\x26\x0f\xbd\xf9\x7a\xd2\x9b\xff\xba\xf9\x9a\x38\x75\x62\x3c\x8d\x2e\xd0\x5e\x08\x94\x92\x6d\x46\x6b\x6c\xa7\xd6\xf9\x07\x61\xb7\x09\x1a\xba\x70\x1f\xa3\x27\xc6\x80\x8b\x24\xab\x37\x5f\x47\x96\xfd\x3f\x77\x9b\x71\x6e\x79\x81\x6d\xa3\x69\x0f\xf0\x84\xc9\x8f\xb8\xaf\x92\xf8\xca\x12\xb7\x28\xed\x8e\xe9\xa2\x6f\x24\x23\x83\xc4\x27\xf1\x99\x20\xe7\xe4\xcc\x34\x1b\x7a\x1c\xed\x8d\x74\xf8\x29\x7c\x11\xfb\x8d\xf9\xd5\xaf\x91\xe1\xf0\x75\x8c\x3e\x49\xb5\x2d\x83\xe9\x42\x7b\xcc\x56\x42\xff\x4a\x93\xbb\xa4\xab\xd8\xe9\xf1\x93\x56\x09\x95\x6c\x31\xa0\xea\xc2\x76\x09\xbd\x0e\x50\xc9\xbc\xf6\x8b\xd0\xa2\xd3\xad\x9e\x52\x08\xb4\x19\x66\xed\x4d\x5f\x05\x59\x9b\xff\xd4\xcb\xb6\x44\x13\x2d\x68\x70\x78\x17\x06\x87\xe7\x9b\xbe\xca\x20\xf6\xed\x7a\x88\xc7\xc9\x87\x8d\x30\x5e\x53\x57\xfc\xf4\x48\x93\xff\xa1\xe2\xf4\xa2\xe9\xcf\x0d\x5a\x04\x61\x10\x1a\xec\x8d\xb4\x4e\x16\x60\xd0\xf6\xad\xb3\x5c\x5c\xfc\xbd\x45\x21\x96\x16\x3a\xa1\x7a\xd1\x82\xc1\x3b\x89\xfb\xfc\x0f\x15\x6c\x9e\x71\x04\x9d\x7a\x18\x48\xec\xd0\x58\xee\x73\x1a\x4b\x88\x7a\x2f\x4e\x25\xe1\xa2\x3d\xf7\xef\xca\x26\x57\x65\x9a\x5f\xd9\x15\x5c\xd9\x3f\x54\x9c\xc1\x3e\x7c\xda\xa0\xa7\x61\x50\x81\x7d\x98\x8d\x69\x31\x4c\x27\xc7\x6f\x0b\x01\x37\xfe\x8b\x74\xfe\x85\xb2\xf6\x4e\xb6\xc8\xb9\xca\xe0\xe6\x76\x73\x70\x98\x6c\xfa\x2a\x4c\xea\x49\x9a\x66\xf0\xea\x87\x1f\x7e\x48\xa3\xc7\xe8\xbf\x03\x00\x08\xa0\x5f\x42\x00\x17\x00\x00")
+
+func jujugenerateapidocSecurityGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocSecurityGo,
+		"jujugenerateapidoc/security.go",
+	)
+}
+
+func jujugenerateapidocSecurityGo() (*asset, error) {
+	bytes, err := jujugenerateapidocSecurityGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/security.go", size: 5888, mode: os.FileMode(436), modTime: time.Unix(1792001283, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocSentinelsGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x59\x6d\x73\xdb\x36\x12\xfe\x4c\xfe\x8a\xb5\x66\xce\x25\x1b\x1e\xd4\xde\x7d\x73\xc7\x37\x93\x26\x6e\xcf\xd3\x24\x75\xeb\x24\x73\x37\x1a\x4f\x07\x26\x97\x12\x2a\x0a\x60\x01\x48\xb6\x2e\xf1\x7f\xbf\x59\xbc\x90\xa0\x24\xa7\xfd\x50\x97\xc2\xcb\x62\xb1\x2f\xcf\x3e\x8b\xf4\xbc\x5e\xf3\x25\xc2\x86\x0b\x99\xe7\x62\xd3\x2b\x6d\xa1\xc8\xb3\xd9\x52\xcd\xb9\xb1\x33\xff\x55\x2b\x69\x2c\x97\xf1\xa7\x55\x6b\x94\xf1\x7b\xdf\xa3\xa1\x6f\xa3\xb4\x9b\x37\x56\xd7\x4a\xee\xc2\xa7\x90\x4b\x3f\xbb\x97\xf5\x2c\xa7\x2d\xc2\xae\xb6\xf7\xac\x56\x9b\xf9\xef\xdb\xdf\xb7\xee\x0f\xef\x45\xa3\xea\xb9\xff\x1f\xad\x5e\xaa\x8e\xcb\x25\x53\x7a\x39\x7f\x9c\x5b\xa5\x3a\x33\x5f\xaa\x79\xd0\xd5\xcc\xf2\x32\xcf\xe7\x73\xd8\xf0\xc7\x2b\xad\x95\x7e\xc5\xbb\xee\x35\xf6\x76\x05\x2b\xd5\x35\x06\xec\x8a\xee\xf3\x28\x36\xdb\x0d\x34\x6e\x5c\xb5\x50\xf3\xae\xa3\x29\x6e\xe1\x41\x74\x1d\xed\xbf\x47\x68\x55\xd7\xa9\x07\x6c\xe0\x61\x85\x12\x3a\xa5\xd6\x42\x2e\xa1\x55\xda\x09\x31\x28\xad\x90\xd8\x01\xd2\x31\x61\x37\xa7\xad\x1b\xb4\x2b\xd5\x40\xcd\x25\x68\xb4\x5b\x2d\x59\xee\x6c\x74\x42\xa7\x4b\xf8\xa7\xd3\xd6\xc9\x78\xbf\xef\x31\xd1\xd2\x8d\x81\xdd\xf7\xc8\xf2\x1d\xd7\xc9\x9a\x4b\x37\x6a\xd8\x07\x29\x76\xa8\x0d\xb2\x37\x4a\xad\xb7\x7d\x31\x73\x4b\x66\x25\xa3\x55\x45\x39\x4a\xfe\x61\x2b\x6b\x93\x88\x96\x7c\x83\x06\x54\xeb\x7e\xb4\x5b\x59\x5b\xa1\xa4\x81\x87\x95\x32\x08\xad\xd0\xc6\x02\xd7\xcb\xed\x06\xa5\x25\x19\x22\x98\x0d\x8d\xa1\x70\x50\x6d\xaa\xdf\x8a\x5b\xfa\xb9\x0f\x77\xad\xc0\x6c\xeb\x15\x70\x13\xec\xc2\xde\xe1\x43\xa2\xbf\xd7\xe4\x12\x36\xbc\x5f\xf8\x08\xb8\xbb\x57\xaa\xfb\x94\x67\xb3\x77\xf8\x30\xbb\x00\x00\xb0\x7a\x8b\x55\x9e\xcd\x9c\xad\xda\xd9\xc5\x30\xf0\x0e\x1f\xe8\x67\x5c\xf1\x94\x8f\xd6\x76\x6b\x4d\xd0\xc1\xab\xfb\xc7\x96\x77\xa2\x15\xd8\x4c\xaf\x7b\xe0\x37\x12\x11\xef\x00\x4b\xb1\x43\x79\xec\xbf\x0b\x37\x89\x8f\x14\xff\xd8\x40\x08\xb5\xbf\x77\xb8\xc3\x6e\xb0\x31\xec\xb8\x16\xfc\xbe\x43\x03\x0f\xc2\xae\xc2\xa1\xf7\xb8\x14\x52\x52\xdc\xb8\xc1\x2b\xad\x47\x03\x5d\x69\x7d\x83\x7a\x53\x0d\x2a\x08\x6b\xa0\x56\x0d\x82\xc6\x16\xb5\x01\xab\xaa\x10\x84\x24\x20\x04\xa9\x4a\x1c\x26\x24\x69\x46\xfb\x29\x53\x60\xa3\x9a\x6d\x87\x0c\x5e\x0e\xba\x04\xb9\x06\x94\xec\xf6\x50\xab\x4d\xcf\x35\x36\xc0\x97\x5c\x48\x63\x2b\xd2\x42\xc8\x70\x05\xb8\xbc\x8c\x2a\x81\x0a\xee\x32\xec\xda\x14\x48\x4a\x87\x99\xb2\x82\x46\xa1\x91\x5f\x59\xa8\xd5\x56\x5a\x96\x93\x36\x13\x27\x14\xfd\x7a\x09\x5f\xc7\x7c\x64\x37\xfe\xa3\x82\xde\xc2\xd7\x3e\x6e\x29\x3c\xdf\xf1\x0d\x56\x61\x1f\x7d\x83\x8f\x86\x12\x16\x77\xfe\x0b\x3e\xe5\x59\x83\x75\x57\x01\xfd\xbd\x59\x2f\x2b\x52\x09\x2e\x2e\xc3\xa6\xd7\x58\x77\x74\x14\x09\x4e\x05\x95\x79\x26\x5a\xb7\xf4\xec\x12\xa4\xe8\xe0\xf3\x67\x27\x81\x7d\xaf\x9a\x3d\x5c\x4e\xc6\x6e\xd6\x4b\xa7\x8c\xb9\x96\xad\x8a\x73\x9f\xf2\x2c\xf3\x71\x44\xdb\xf3\xec\x29\xcf\x5a\xb5\x95\x8d\x3b\x9a\xaf\xb1\x38\x88\xdd\x32\xcf\x78\xd3\x50\x60\x8f\xf7\x4f\x94\xa6\x0f\x72\xe3\x56\x36\x15\x7c\x53\x8d\x22\xbe\xe6\xc6\x32\xda\x46\x37\xf1\x92\xca\x3c\xf3\x61\x13\x8f\x8a\xc6\x70\x3b\x3b\x94\x85\x93\x53\x96\xa4\x92\x76\x21\x46\x5a\x69\x2e\x97\x04\x57\xa4\x24\x69\x4f\xe3\x94\x64\xbc\xef\x51\x36\x85\xfb\x59\xb9\xd5\xa5\xbb\x0e\xa1\x31\xbb\x75\x56\x36\x7e\xb6\xcc\x87\x2b\xd3\xcf\x90\x5a\x93\x6b\x01\x6f\x4e\xa1\xc7\x21\x0c\xba\xd0\xd5\xd8\x50\x50\x59\x05\xf7\x7b\x67\x89\x8a\x22\xea\x7e\x7f\x80\x37\xc2\x7a\xe4\xad\xc0\x2a\xaf\x7e\x08\xa7\xe7\xed\x79\x2a\xb0\xe8\x00\x98\x58\x33\xd8\xfb\x10\x65\xaa\x80\xf8\x42\xda\x0a\x76\xc2\x08\x8b\x0d\x3c\xe3\x0a\x0a\x3f\xd1\xc6\x55\x0b\x3a\xe3\xee\x64\x28\x8d\xe1\xe2\x6c\x3b\xdd\x70\xe9\xa0\x2a\xcf\xac\xa0\x00\xbb\xb8\x3c\x8e\xba\x3c\x9b\xcf\xc7\xc4\x1c\x21\x5a\x34\x64\xd8\x56\xe0\x50\x5a\x34\x0e\xeb\xdc\xa6\x21\x89\x1f\x56\xa2\x5e\x81\x45\x63\x5d\x71\xe2\x32\x00\x92\xe6\x76\x85\x54\xac\xb8\x74\x1b\xbc\x96\x04\x25\xc2\x32\x78\x69\x80\x6e\x7d\x2d\x4d\x8f\xb5\xf5\x17\x35\xc0\x41\x12\x00\xdd\x63\xab\x34\xba\x5d\x34\x5a\xaf\x44\xd7\x68\x94\x15\x39\x70\xff\x95\xa6\x12\xaa\xd7\xd8\x84\x75\xc3\xa8\x41\x94\x2c\xcf\xa2\x9a\x93\x8c\x71\x0e\xba\xa6\x5b\xc5\xac\x21\x19\xaf\x92\xa5\xe4\xfa\x02\x0d\x30\xc6\x68\xed\xd5\x63\xaf\x9d\x1b\x5c\xac\xff\x56\x41\x12\xeb\x68\xdc\x44\x66\x1e\x84\xad\x57\x7e\x66\x2b\x49\x92\x2c\xb0\x64\x05\xe1\x8c\xdf\x9b\xd5\xdc\x20\x8c\x87\x5f\xd0\xb6\x41\xc3\x05\x8e\x3e\x4a\x97\xde\x62\x87\xb5\x55\x9a\x74\x38\xdc\xc1\x6e\x31\xf1\x6c\x46\x4e\xa7\xff\x9e\xf2\x2c\xb1\x67\x31\xc4\x49\xe5\x02\xbe\x90\xce\xda\xef\x54\x83\x25\xd0\xfd\x9d\x72\x41\x7d\x49\xea\xcb\x54\xeb\x51\x93\xef\x85\xe4\x7a\x3f\xe8\x21\x5a\x90\xec\xe7\x9e\xb0\xca\x11\x2d\x76\xf5\xcb\x1b\x0a\xcb\xe9\xe0\xbb\xab\x5f\x9c\xfc\x6c\x62\xe3\x42\xb2\xff\x54\x20\xd9\x7f\xcb\xa8\xf6\x29\xdb\x50\xd0\x57\xa0\xd6\xa4\x93\x0b\x5b\xf6\xc1\xa0\x59\xc8\x3b\x56\x04\xf8\xfe\xc8\x75\xf9\x1d\xad\x38\x3f\x87\xb3\xc1\x2e\xf2\x0e\xce\xcf\x41\x98\xdb\x00\x08\xc5\x2e\x38\xc0\xa3\xe7\x22\x02\x05\x61\x74\xb1\x2b\x0f\x2d\x38\xaa\x42\x8c\x2d\xbd\x2f\x99\xcf\x6d\x92\x94\xdf\x25\xdd\x72\x76\x6d\x66\x74\x1a\x21\xa2\x64\x2f\xf5\xd2\xb8\xe1\x7f\x84\x03\xe7\x73\x8a\x6e\x21\x9f\xab\x61\xec\x94\x69\x48\xca\xe2\xdb\xbb\xc1\x36\x74\xb4\x87\x8b\x7f\x5d\x9e\x60\x6e\xfe\xa4\x00\x9a\xe9\x45\xb2\xd6\xb9\x93\x80\x0d\x1d\x8a\x15\xce\x8a\x15\xc8\x32\x5e\x48\x46\xec\xf8\xfc\x19\x5a\xc9\x6e\xd6\xcb\xa2\x4c\x86\xce\x02\x35\x66\xff\xe6\xe6\x46\x63\x2b\x1e\x8b\xb8\x8a\xdd\x70\xbb\x2a\xca\xca\xd5\xfb\x9b\xf5\xd2\x4f\x97\x5f\xd0\xc6\xe9\x81\x84\x6b\x95\x03\x5b\xc4\xb4\x94\xb6\x42\x36\x34\x17\xb0\xd4\x97\x2e\x3a\x4c\x99\xa2\x8c\xfa\x4e\x6b\xe9\x20\xe4\x74\xe5\x3c\xad\x85\x68\xa1\x0d\x75\x60\x3d\x58\xc7\x69\xc5\x8a\x09\xf8\xfa\xc0\xf2\x82\x4e\x54\x81\xe4\x06\xed\xa4\xb0\x3a\x4f\xbd\xf8\x76\x80\xf5\xc1\x8d\x4f\x63\x35\xf7\x5e\x7a\x2a\x43\x75\x1b\x43\x15\x34\x12\xb5\x23\x02\x8c\x0e\x32\x77\x20\x08\x0a\xa7\xb5\x6d\x20\x55\xa1\x48\xa5\xa1\x1e\x99\x0d\xa5\xc6\x90\xde\xe1\xd8\x5d\x70\x70\x30\xe1\xf9\x79\x9e\x65\x3b\x76\x43\x58\x65\xbd\xdf\xc3\x0a\x76\x5b\x2b\xe2\xed\x71\xc9\x55\x20\x9c\x71\xe4\x38\x2c\x76\xcc\x25\x46\x59\x01\x91\xe5\xd9\x17\xd6\xa5\xd1\xf3\x62\x36\x9f\x1d\x45\x90\xdb\xe9\x2f\x71\xbd\xe9\x3b\x24\xee\x6f\x8a\x5d\x68\x25\xaa\xb1\xfb\x60\x1f\x64\x83\xba\xdb\x0b\x49\x12\x23\x26\x5c\x4b\x8b\xba\xe5\x35\x96\xd1\xbc\x69\xbe\x4f\x78\x39\x11\x0e\x62\x0f\xbb\x84\xa1\xdf\xef\x5d\x9d\x09\xb5\x1d\x7a\x6e\x57\xc1\xca\xa9\x98\x03\x3b\x8f\x3c\x71\x6a\xe9\x70\x4d\x78\x01\x33\x36\x83\x17\x10\xad\x14\x14\x73\x0c\x05\x65\x8d\x81\xaf\x1e\x29\xe7\x5a\x05\xde\x75\x13\x7e\x33\xf0\xfc\x50\x8e\x69\xce\x93\xce\x81\x0a\xf9\xee\xa1\xe5\x35\x6f\xd0\x24\xed\x83\x23\xf8\x42\x02\xd1\x2e\x6c\x40\xe9\x06\x75\xb8\xdd\xa1\x2e\x45\x6b\x60\x71\xe7\x7b\x5d\xf6\x83\x93\x44\x09\x36\x65\xc5\x7f\xc6\x46\xa9\xd9\x22\x23\x9b\x61\x57\x1e\x4b\x68\x9b\xd0\x45\x93\xd6\xd6\x4d\x32\xc1\xde\x86\x7b\xd1\x7c\x5c\x30\xe5\x9a\x1b\x16\x6c\xe7\x96\x10\xa8\x9d\x39\xad\x16\xb4\xec\x2e\xe4\x6f\x00\x7e\x3f\x34\xa2\xfd\x9f\xf0\xd3\x08\x19\x63\x59\xfd\xab\x74\x35\xba\xea\x84\x5b\x51\x5a\x2d\xd0\x38\x7e\x44\x06\x65\x31\x75\xaf\x86\x26\xf0\xb9\xbe\xde\xf5\x6d\xa3\x7b\x83\xb2\x3c\xca\xf7\x4c\xf7\x7e\x1f\x82\xc1\xcb\x63\xf0\x7e\x45\x6c\x8d\xe2\x80\x6b\x04\xcb\xd7\x28\xa1\xd5\x6a\xe3\xb4\xb1\xbe\x4d\xf4\x9d\x17\x0d\x18\xd4\x3b\x8c\x2d\xd7\x90\x05\x2e\xce\x36\xbc\x8f\xe3\x56\x79\x91\x07\x99\xf1\xe5\xae\x6b\x1a\x08\xe5\x18\x5d\x13\x0b\x90\xc7\x9c\x6c\x72\x71\x14\xfc\x8a\x06\x48\x6e\x88\x29\xd4\xda\x3c\xb3\x3f\x3f\x1d\x26\xfe\x70\x8a\x06\x37\x78\x6a\x27\x4d\x66\x94\x9f\x17\x6e\x6f\x45\x3f\xe9\xe0\x0b\x7f\x57\x1f\x50\x55\x00\x72\x41\x52\x22\xbc\xbd\xe1\xc6\x5e\xcb\x06\x1f\x5d\x4c\x54\x94\xec\x14\x3e\xa2\x85\x3e\x96\xb6\x49\x59\xa3\x55\x8b\x0b\x71\x57\x7e\x07\x7d\xac\x67\xe7\xe7\xd0\x3b\x88\x33\x71\xe4\xd3\x21\x07\x0a\xf3\x11\x9c\xe3\x5b\x8b\x93\x26\x5e\x7c\x7b\x71\x57\x1e\x13\xa3\x81\x86\x50\x18\x34\xaa\x26\xe2\x4e\x80\x0a\x5c\x36\xc3\x33\x0a\x05\x06\x3e\x5a\xcd\x4d\x05\x46\xc5\x1d\xdc\xb5\x08\x5c\x73\xea\xf0\x3d\xd4\xd4\x9c\xda\xed\xfb\xd8\xe1\x09\xd7\x7d\xb7\xdc\xf2\xce\x13\x19\x64\xaf\x55\x5d\xc1\x6f\x70\x09\x3b\xde\x6d\xf1\xb5\xaa\x5f\xf9\xf3\xc8\x79\x15\xec\xca\xb0\xec\x6d\x38\x79\x74\x71\x18\x49\xd7\xc5\xa2\xe9\xbc\x3d\xe4\x28\xfd\xaa\x20\xf4\x8f\x21\xf7\x68\xec\x20\xf5\xe2\x09\x69\xee\xc5\xfb\x06\x94\x74\xc1\x0c\x2b\xec\x1a\x10\x12\x76\x20\x5a\x10\xf6\x2b\x43\xa9\x20\xa4\xb0\x82\x77\xe2\x7f\xbe\x22\x70\x47\x13\xa8\x37\x54\x72\xba\x9d\x68\x55\xc8\x4c\x0e\x9d\xb0\xa8\xb9\x7b\x88\x09\x47\xb9\x6e\x93\x8e\xc6\x4d\x6f\xf7\x21\x60\x40\x51\x69\x7f\x10\x06\x0f\xf2\x27\xb1\xc1\xa9\x04\x7a\xae\xe6\x90\x9b\x8e\x78\x54\x30\xe4\xc0\x9f\xa6\xec\x29\x79\x59\x98\xcd\x9c\x25\x97\x29\x29\x6a\x46\x3a\xf4\x23\x4a\x22\x47\x5e\xc2\x99\x5a\x9f\xd8\x1a\x12\x8e\xba\x8d\x31\xe1\x9c\x3c\x76\xdb\x63\xed\xd3\x6e\x47\xd3\x51\x3e\x7d\x07\xf9\x1f\x29\x4e\x68\x59\xc8\x18\x3a\xe2\xf3\x67\xc7\xa7\xdd\x16\xbf\xc0\x94\xa4\xf9\x38\x48\x69\x6a\x02\xd9\xac\x15\x45\x90\x43\xf4\xa7\x50\x46\x44\x05\xa2\x19\x75\x49\xf6\x0c\x59\x75\x66\xf8\x06\xc9\x38\xfd\x7a\xc9\x7e\x30\x68\x69\x8b\xb7\xd6\x68\xb6\x50\x3e\x92\x13\x46\x2a\x1b\xef\x12\x9b\xbd\x54\xdb\x85\x70\xb9\x98\xf6\x10\x91\xc2\x26\xf7\x23\x29\x63\xcb\xf0\x0d\x51\xda\xb3\x31\xa8\x16\x43\xb7\xe1\xd6\x51\xc3\x11\xcb\x59\x62\xfe\x50\xa2\x3a\x61\xa3\x3e\x83\xd4\xc5\x37\x77\x41\x87\xef\xb9\x11\xf5\x1b\x61\x0f\x75\x10\x96\xfd\x24\x64\x43\xa6\xf5\xdd\xda\xed\xfb\x5f\xaf\xdf\xfd\xf8\xec\x29\x1b\x33\xf2\xf5\xf0\x7e\xce\x3e\xc8\x3f\xb6\xca\x62\xd1\x89\xe0\xcb\xd3\x5c\x3d\x42\xe5\x2b\x25\x2d\x3d\x16\x14\x4e\xd6\xec\x6f\xb3\x68\x63\xea\x94\xa8\x28\x6e\xb8\x75\x6f\x21\x21\x55\x1b\xa4\xa4\xa7\x57\x43\x47\xcb\xe2\x5b\xb0\x61\xcf\xa8\x18\x46\x36\x66\x39\x14\xed\x71\xd1\x53\xee\x9e\x7f\x8b\x3c\x8b\x19\x47\xf8\x6e\x7e\x96\x35\x82\xd9\xcb\x9a\xd1\xd7\x74\xf2\x2d\xef\x01\xd2\xd7\x9b\x40\x63\xca\x09\xd8\xd0\xca\xb1\xcc\x73\x5a\x3f\xd6\xd8\xe9\xeb\x2f\xa1\x07\xf2\x7a\x95\x6e\x0f\xac\xde\x2a\x77\x47\xff\x83\x6a\x4e\x7c\x3c\xba\xdf\x0f\x6f\xfe\x42\x26\x65\x3a\x61\x82\x01\x2d\xc8\x82\xb1\x6a\xfb\x57\x61\xd2\x65\xe1\x16\x05\xd5\x63\xcd\xa7\xb7\x5f\x12\xc0\x3d\x36\x12\xb0\x6d\x8d\x87\xbb\x5b\x27\xdd\x95\xe3\x03\x84\x1a\x0a\xf1\x31\x3e\x95\xc7\x56\x22\xd7\x4e\x76\x92\x7d\xd9\x6b\x55\x90\xcc\xc2\x7b\x3e\x9d\x27\x63\x1f\x73\xc9\xc0\x17\xf2\x2c\x3b\x5d\x4d\xcd\xa8\xad\xb9\x71\x14\x81\xc2\xaf\x4f\xda\xd8\xfe\x99\x06\x31\x3e\x96\x0d\xb8\x31\xe5\xa5\x3d\xbb\xdd\x4b\xcb\x1f\x27\xec\x93\x60\x6d\x5c\xd2\x32\x02\xc7\x48\x3e\xff\x1c\x43\x27\xf9\xe7\x96\xb3\xf7\x6a\x3d\x26\xe0\xc7\x97\xbf\x06\x59\x13\xd0\x71\x0a\x66\x7f\x0d\x68\x47\x55\x5d\x09\x1e\x17\x9e\x04\xdd\x00\x58\x71\x27\xbd\x1f\xdf\x4e\x7d\x3d\xda\x2e\x48\xac\x26\xd1\xf0\x96\xf7\x64\xf1\x41\xc7\x09\x63\x1e\xd9\xf1\xc1\x96\x50\xac\x0f\x4f\x1b\x5f\x76\x23\x4d\xa6\x6c\x19\xe8\x66\x5a\xa1\xf9\x71\x60\x87\xf2\xeb\xab\x71\xfc\xb7\x40\xaf\x73\x64\xaa\x47\xd7\x73\x2f\x22\xb1\xae\xfa\x4b\x22\xc4\x37\xbf\x2a\x1c\x7b\x1c\x8c\x64\xaf\x04\x73\xd3\x07\x3f\x67\x60\x7a\x4c\x54\xd4\xfe\x7b\xd4\x3d\x2a\x9d\x0e\x98\xec\xf8\xb0\x45\x36\xfe\xb9\x25\x10\xf5\x85\xd6\xa6\x71\x9a\x6c\xd9\xc4\x13\xed\xe9\xe6\xd7\x7b\x63\x0c\xb1\xb3\x70\x31\x7a\xec\x14\x35\xef\x8a\x0d\xfb\x09\xf7\x93\x2e\xba\x3c\x3c\x23\x44\x0f\x76\x76\x8c\x1d\x42\xf7\xab\xce\xfa\x40\x5f\x0f\x8c\x14\x3b\x1b\x2e\xfc\x13\xee\x5d\x24\xc5\x52\x97\xdc\x38\x8d\x64\x8a\x0e\x82\x60\xd1\x24\x8f\x7d\xe3\xf3\xe3\x1a\xf7\xa9\x39\xd7\x3b\xd2\x76\xf2\x88\x7a\xf2\x9d\xb0\x81\x4b\x58\xe3\x3e\xff\xe2\xbb\x69\x5c\x45\x33\x79\x96\x35\xd8\xf2\x6d\x67\x2f\x4e\x29\x78\xe2\xd1\x51\x34\xd3\x57\x47\x7a\xa2\xd8\x4d\xfd\x67\x16\xeb\x9d\x4f\xa7\xbb\x09\x95\xb1\x61\x34\xba\x34\x19\x72\xe5\xd7\x3f\xc8\xc4\x78\x0d\xed\xe5\x49\xcb\xb9\x68\x3c\xf5\x7c\x79\xb0\xf9\x23\xef\x8a\x78\x44\x99\x67\x4f\xf9\x53\xfe\xff\x01\x00\x63\xdd\xf6\x4e\x41\x1f\x00\x00")
+
+func jujugenerateapidocSentinelsGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocSentinelsGo,
+		"jujugenerateapidoc/sentinels.go",
+	)
+}
+
+func jujugenerateapidocSentinelsGo() (*asset, error) {
+	bytes, err := jujugenerateapidocSentinelsGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/sentinels.go", size: 8001, mode: os.FileMode(436), modTime: time.Unix(1791997344, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocStatsGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\xb1\xae\x9b\x30\x14\x86\x67\xfb\x29\x8e\x18\x2a\xa8\x28\xec\x48\x99\xda\xa1\x95\x7a\xbb\xb4\x9d\xaa\x0e\xbe\xf6\x01\x9c\x80\x8d\x7c\xec\x56\x51\x94\x77\xaf\x6c\x93\x40\x9a\xe1\x2e\xc0\xf9\xe4\xdf\xff\x67\xe4\x45\xc8\x93\x18\x10\x66\xa1\x0d\xe7\x7a\x5e\xac\xf3\x50\x72\x56\x0c\xda\x8f\xe1\xb5\x91\x76\x6e\x8f\xe1\x18\xd2\x43\x2c\x5a\x59\xd9\xe6\x57\xc1\x2b\xce\xdb\x16\xc8\x0b\x4f\x30\xda\x49\x11\xf8\x11\xd3\xac\xc9\x6b\x49\x30\x08\x3f\xa2\x43\x05\xaf\x67\x18\xd0\xa0\x13\x1e\xbf\x98\xde\xd6\x31\xf7\xd7\x69\xef\xd1\x80\xb7\x29\xf6\x21\xe6\x08\x7a\x3d\x61\xc3\xff\x08\xb7\xee\x9b\xbb\x9a\xef\x71\x48\x75\xeb\x3e\xda\x9a\xc4\xc0\xa1\x0f\xce\x3c\x55\xf7\xd6\x25\xe4\x82\x49\x6d\xda\xc8\x29\x28\x6d\x86\x44\x03\xc5\x13\x2b\x04\xdb\xa7\x59\x0a\x39\x22\x35\xbc\x0f\x46\xfe\xdf\x50\x56\xf0\x7e\x6f\x01\x17\xce\x08\xba\x43\x16\xe4\x6c\x11\x8e\x50\xbd\x84\xe6\xab\x95\xa7\xb2\xba\x81\x6c\xd7\x1d\x60\xcd\x7e\x8c\x15\x09\x5e\xbe\x89\x19\x3b\x28\xf2\xba\x74\x62\x2a\x6a\xf8\xac\x3d\x75\x90\x61\xfc\xae\xe1\x45\x13\xe1\x9d\xe5\xe9\xba\x2b\xfc\x69\xa6\xb5\x32\xaa\xe0\x4e\x41\x8e\x28\x4f\x8b\xd5\xc6\xbf\xad\xb1\xad\xdd\x2c\x36\xf6\x68\xb2\xf1\xbb\xcd\xad\x7a\x27\x93\x4b\x08\x0e\xf0\xeb\xf7\x73\x2d\x67\x6c\x71\x76\xf8\x71\x5e\x90\x9a\x98\xa6\xb2\xaa\x39\x63\x33\xfa\xd1\xaa\x4f\x56\x3e\x50\x85\x72\x7a\x00\xbb\xbf\x1b\x53\x9b\xd1\x0d\x5d\x39\xcb\x77\x02\xde\x11\xbf\xf2\x7f\x03\x00\x91\xfb\xd2\x3b\xe1\x02\x00\x00")
+
+func jujugenerateapidocStatsGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocStatsGo,
+		"jujugenerateapidoc/stats.go",
+	)
+}
+
+func jujugenerateapidocStatsGo() (*asset, error) {
+	bytes, err := jujugenerateapidocStatsGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/stats.go", size: 737, mode: os.FileMode(436), modTime: time.Unix(1791999898, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocStreamGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x4d\x6f\xa4\x38\x10\x3d\xe3\x5f\x51\xe2\xb0\x82\x0c\xa2\xe7\xdc\x52\xaf\xb4\x87\x89\x34\x7b\xc8\x1e\x66\xa5\x39\x44\xd1\x8e\x1b\x0a\x70\x06\x6c\x64\x9b\x46\xbd\x2d\xfe\xfb\xaa\xca\x40\x93\x4e\x7a\xa5\xc9\x21\x2d\x17\xf5\xf1\xea\xd5\x73\xb9\x97\xc5\x4f\x59\x23\x74\x52\x69\x21\x54\xd7\x1b\xeb\x21\x11\x51\x7c\x1c\x2a\x65\x62\x11\xc5\xa8\x0b\x53\x2a\x5d\xef\x5e\x9d\xd1\x64\x08\x66\x67\xac\x8f\x85\x88\xe2\x5a\xf9\x66\x38\xe6\x85\xe9\x76\xd6\xd4\x3d\xf6\x3d\xee\x64\xaf\x0a\xd3\xf5\xd2\x73\x90\x3f\xf7\xe8\x6e\x7d\x5f\x87\xd7\x81\xff\xc9\x5e\x95\xa6\xa0\x90\xd2\x14\xb1\x48\x85\xd8\xed\x40\xe9\xca\x7c\xb7\xca\xa3\x85\x91\x7e\x1c\xf8\x06\xe1\xcf\x6f\x7f\x3d\xc1\x82\x07\x4c\x05\x52\x43\x88\xcb\xbf\xea\xca\xc0\x49\xb6\x03\x52\xb8\x84\x5e\x61\x81\x20\x3d\x48\xf0\xaa\xc3\x0c\x9c\x01\xdf\x48\xcf\x79\xc6\xc6\xb4\x08\xa5\x29\x86\x0e\xb5\x07\x8d\x27\xb4\xa0\x11\x4b\x47\xc1\xde\xc0\x11\xa1\xc1\xb6\x04\xa5\xa1\xc3\xce\xd8\x33\x65\x32\xba\xc0\x5c\xec\x76\xe4\xf3\x87\x3e\x03\x5a\x6b\x2c\x28\x07\x16\x3b\xec\x8e\x68\xb1\x04\xa9\x4b\xb0\xe8\x07\xab\xb1\x84\xe3\x19\x8a\xd6\x38\xcc\x05\x31\xb0\xed\xc9\x79\x3b\x14\x1e\x2e\x22\x1a\x81\xff\x1e\x98\xee\x3c\xb4\x2c\x22\xd4\x05\x5b\x89\xbd\xfc\x0b\x35\x4c\xd6\x4a\x61\x5b\x3a\x50\xda\x8b\x08\xad\x25\x0f\xc6\x20\x26\x21\xaa\x41\x17\xa0\x71\xfc\xba\x16\x49\x46\x58\x33\xa6\xf0\xb0\xa9\x7e\x11\xd1\x71\x84\xfd\x01\x42\xd1\x27\x1c\x97\x88\x54\x44\x01\x3d\xfc\x76\xf5\xbf\x88\x28\x1a\xf7\x00\x70\x1c\x33\x11\x11\xb6\x3d\x30\xb0\x27\x1c\x67\x6c\xc9\x71\x4c\x33\x11\x4d\x84\x84\x18\x3c\xf7\x48\x40\xb6\xb3\xfb\x7b\xb1\x71\x17\xd9\x3a\x46\x62\x13\x65\xd1\x70\x10\xa0\xf6\xf6\x0c\x0e\x7b\x69\xa5\xc7\xf6\x9c\x87\xc6\x92\x71\xdb\x40\xba\x16\x48\xc8\x08\x0f\xab\xc8\x58\x06\x29\xf1\xaa\x65\x87\x8e\x7a\xec\xe4\x4f\x4c\x9e\x5f\x9c\xb7\x4a\xd7\x19\x7c\xce\xa0\x45\xcd\x71\x39\x41\x72\x69\x2a\xa2\xca\x58\xa0\x00\xf2\xb7\x52\xd7\x08\xd7\xef\x94\x6c\xce\x76\x00\xd9\xf7\xa8\xcb\x84\x8f\x19\x84\x9c\x7c\xa2\x2c\x93\x88\xe8\x52\xe4\xdf\xd8\xec\xd8\xee\x52\x11\x8d\xb9\xf3\xd2\xfa\x47\xea\x3b\x89\x17\x1e\x62\xfe\xc2\x04\x85\x80\xe4\xc7\x85\x3f\xba\x78\x7f\xf9\x31\x83\x52\xd9\x0d\x2e\x3a\x05\x48\xaa\x02\x05\xbf\xc3\x67\x3e\xdc\x64\x8a\x33\xca\x1e\x4d\x34\xb8\x9c\x89\x46\x46\x93\x8a\x77\x9e\xfb\x38\xdd\x7a\x5d\xfb\x7e\xbe\x92\x4a\xa8\x9e\x64\x37\xe7\x78\x09\xad\xde\xe4\x99\xa6\x38\x9d\xa7\x1f\xba\x95\x85\x2c\xd1\x85\x43\x50\xc0\x62\x62\x01\xe4\xf0\x85\x86\x5e\xb1\x8d\x34\xe0\x1a\x33\xb4\x25\x39\x6a\xba\x7e\x94\xdc\xa3\x86\x51\xf9\xc5\x2b\xe3\xdb\x45\x99\x38\x03\x05\x55\x4a\x2b\xd7\x60\x19\xdc\x50\x97\x73\x8d\x3b\xb2\xd9\x22\x4b\x58\x26\x6f\x67\x33\x7f\x7a\x3f\x9a\xf8\x79\xed\x2e\x60\x59\x94\x2d\xc1\x29\x5d\xb7\x08\xd8\x22\xef\x12\x53\x7d\xd0\xeb\xc7\x68\x42\xa6\x44\xd1\x85\xce\xa0\x5a\x36\x59\x00\xb1\x0a\xf9\xcd\x9c\x6f\x50\xf1\x98\x27\x71\x1d\x5f\x95\xae\xbb\xe0\xb6\xda\x95\x9c\xa5\xf3\x37\xa9\x5e\xae\x0d\x12\xe4\x6b\x7f\xcc\xf5\xd2\x96\x37\x3d\xb4\x78\xc2\x16\xcc\xf1\x15\x0b\x1f\x78\xa7\x2f\xb5\x3a\xa1\xa6\x91\x90\x46\x78\x50\xbc\x8c\x73\x78\x34\x6d\x6b\x46\xa5\x6b\xe6\xc5\x74\xca\x63\xd7\xfb\x33\x78\x59\x3b\x30\x6f\xf6\x77\xc6\xf1\xc6\x37\xe4\xad\xdc\xaa\x01\x55\x81\xce\x38\xbc\x45\x5d\xfb\x66\x41\xc3\x15\x32\xda\xc0\xff\xa2\x35\x77\x66\xce\xf8\x59\xb9\xf3\x7d\xcd\xe0\x44\x8c\xa3\xad\x64\x81\x97\x29\x03\x4d\xc7\x85\x6b\x0d\x87\xc3\x4c\x76\xd8\x84\x33\xc1\x1b\x99\xcc\x37\x69\x25\xfd\xb4\x30\xc7\xcb\x7e\xd1\xa4\xfb\x98\x30\x62\xa6\x6a\x07\xd7\x60\x78\x6b\x88\x93\xc1\xf7\x83\xbf\x03\x9f\x73\x26\xe9\xfc\xd8\x04\x8c\x63\x3e\xbf\x04\x87\xc3\xc7\xba\xb8\xac\xba\x78\x63\xa6\x2b\x1a\xe2\xe9\xf5\x38\x1c\x40\xab\x76\x0e\x67\x03\x8c\xf9\x98\x3f\x12\xb8\x24\xc4\xcf\x6f\x01\xfb\xdf\x15\xd6\x0d\x33\x33\xcb\xe9\xaf\x61\x05\x6c\x1d\xfe\xbf\xc4\x43\x9e\x4f\x9f\xc4\xbb\x9d\x76\x13\xb2\x8f\xef\xdf\x82\xad\xa3\x7b\x07\xf5\x86\x96\x7f\xb2\xc5\xc6\xcc\x7c\xdf\xc6\xa6\xf3\x6b\xf7\x61\x95\x45\x18\x5b\x9d\xdd\xad\x72\x2d\x81\xba\x98\x1f\xfb\xe4\x94\x8a\x68\x12\x93\xf8\x6f\x00\x22\xe3\xe0\x91\x9f\x09\x00\x00")
+
+func jujugenerateapidocStreamGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocStreamGo,
+		"jujugenerateapidoc/stream.go",
+	)
+}
+
+func jujugenerateapidocStreamGo() (*asset, error) {
+	bytes, err := jujugenerateapidocStreamGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/stream.go", size: 2463, mode: os.FileMode(436), modTime: time.Unix(1791994043, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocStrictGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\x5d\x8b\xe3\x36\x14\x7d\xb6\x7f\xc5\xa9\x21\x4c\xd2\xba\x4e\xfb\x1a\x98\x3e\x75\x17\x96\xb2\xcb\xc2\x96\xf6\xa1\x94\xa2\x48\x57\xb6\x76\x2c\x5d\x21\xc9\x1b\xc2\x32\xff\xbd\xc8\x5f\x49\x3a\x19\xe8\xdb\x3e\xcc\x38\xb9\xba\x3a\x3a\xf7\xe8\x1c\xc7\x0b\xf9\x24\x5a\x82\x15\xc6\x95\xa5\xb1\x9e\x43\xc2\xb6\x2c\x2a\x6d\x53\x55\x16\x55\xcf\x6d\x55\x96\x45\xd5\x9a\xd4\x0d\xc7\x46\xb2\xdd\x7f\x1e\x3e\x0f\xe3\x3f\xe1\x8d\x62\xb9\x9f\x1e\xb9\xb9\x65\xff\xd4\x36\xc6\xed\x29\x84\x96\x9b\x2f\x3f\x57\xe5\xae\x2c\xf7\x7b\x0c\x4e\xb1\x1c\x2c\xb9\x44\xea\x4f\x11\x9c\x71\x6d\x44\xa0\x34\x04\x17\x21\x70\x9a\x4a\xd0\x1c\xa0\x6b\x08\xa7\x40\x42\x76\x60\x0d\x93\x62\x06\xb0\x94\x3a\x56\xb1\x46\xea\x44\x42\x27\x22\x1c\x43\xb1\x84\x64\x9b\x61\x1b\xfc\xde\xd1\xf9\x21\x10\xd8\xf5\x67\xf8\xc0\x6a\x90\xa4\x60\x5c\xde\xfd\x63\x4c\xc1\xc8\x04\xcb\x8a\x6a\x88\x08\x2b\xdc\x19\x5a\x48\xa1\x28\x42\x04\x72\x0f\x09\x7a\xe8\xfb\x33\x2e\x3c\x9b\x52\x0f\x4e\xde\xa5\xbe\xd5\x98\x86\x6e\xde\x8e\x18\xef\x9c\xe6\x1d\xfe\xfa\x7b\x2e\xce\x6d\xf8\x5a\x16\x5f\x44\x58\xa6\x8b\x2f\x1a\xca\xc2\x68\xe8\xe6\x57\x96\x78\x7c\x44\x55\xe5\x0d\xc5\xda\xfd\x08\xe1\x3d\x39\xb5\x5d\x2a\x35\x6e\xb7\xe7\xee\xe2\x37\xe3\xd4\x01\x00\xaa\x6b\xa2\x55\x9d\xd7\x26\x72\x07\x40\x37\x1f\x84\xa5\xb1\xf6\x07\x85\x68\xd8\x1d\xa0\x9b\xf9\xe3\x58\x7e\x4f\x31\x8a\x96\x0e\xd0\x36\x35\x9f\x7c\x30\x2e\xe9\x6d\x35\x29\x84\x4d\xdc\x6e\xd4\xee\x8e\xea\x55\xbd\x40\x5f\xe0\x76\x19\xef\x79\x57\x16\xcf\x65\x91\xef\xf3\x9f\x1a\x16\x87\x47\x04\xe1\x5a\x82\x6e\xde\x4f\x37\x39\xce\x6a\x34\xec\x38\xfe\x77\xeb\xf8\x85\x64\x97\x8c\x1b\x28\xa3\x7c\x43\x39\x32\xc9\x03\x60\x2f\xad\xf7\x25\x9a\x8c\x39\x4b\xd4\x6c\xe2\xff\x54\xa9\x9e\x91\xaf\xd5\x9a\xf2\xb0\xda\xa5\x7c\x1e\xa3\x23\x3b\x92\x4f\x9f\x26\xff\xae\x89\x71\xa0\x10\x38\xc0\x68\x18\xa7\x19\x81\x24\x07\x95\x17\xce\xa9\xcb\xd6\x1b\x63\x62\xc5\x13\x8d\xe9\x49\x1d\xad\xc6\x16\xc9\xb0\x83\x71\x92\xad\xef\x29\x11\x38\x40\xf1\x70\x4c\x7a\xe8\x6b\xf4\xdc\xb6\x79\xff\x18\x3f\x1f\xf8\xd8\x93\xad\x33\x44\xe4\x29\x7a\x4b\x94\xa4\x70\x88\x89\x3d\xc4\x0d\xd8\x72\x0a\x74\x60\x8b\x23\x65\x97\xef\xf7\xf0\xc3\xb1\x37\xb1\x23\xd5\xe0\x5d\x7a\x88\x90\xa2\xef\x49\x81\x9d\xa4\x91\x1c\x0f\xc9\x0f\x53\xae\x8f\x44\x0e\xa7\x60\x52\x22\xb7\x9c\xba\xcc\x30\x13\xca\xfb\x1d\x44\x1f\x19\x47\x82\xe6\xc1\xa9\x8c\x12\x68\x8e\xec\x95\x64\xdb\x51\x9e\xef\x67\xa7\x4c\x39\x9d\xa4\xfb\xba\xda\xf3\x74\xb1\x67\xee\x5e\xfc\x14\x47\x3f\xf6\xdc\x36\x1f\xe7\xbb\x9e\x6f\xe6\x80\x4d\xcc\x7f\x55\x8d\x53\x93\x0d\x97\x9f\xb3\x3b\x6e\x7c\xef\xff\x03\xfc\x56\xc8\xc4\xe1\xfc\x51\x38\x23\x5f\xa2\xcf\x61\xd3\x53\x13\x7c\xee\x3a\x2c\xd9\xcb\x88\xeb\xa9\x7e\x7e\xed\xd4\xf0\x17\x3f\xf9\xe6\x8d\x4b\x26\x9d\x27\x42\xfe\x2e\x21\x7a\x49\x48\x28\x7a\x93\xf5\x78\x95\xcf\xa8\xd6\x7c\x30\xdd\xa2\x1a\x8d\x9e\xdc\xf6\x46\xb4\xdd\x0f\x6b\xe9\x66\xdc\xdb\xfa\x7a\xea\x0e\xbf\xe0\xa7\xf1\xe8\xd9\xfd\xd3\x2f\xc7\x07\x3a\xe9\x6d\x75\xf5\xd6\x3e\x60\xa3\xd6\x64\xd4\xf9\xcb\x3d\xb9\x72\x00\xd4\xd5\xe2\xc8\x3d\x56\xf5\x1d\x9a\x35\x5e\xe1\x59\xe3\x3e\xd1\x9b\x8c\x3a\xd3\x97\xcf\xe5\xbf\x03\x00\x66\x01\x0c\xac\x3c\x07\x00\x00")
+
+func jujugenerateapidocStrictGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocStrictGo,
+		"jujugenerateapidoc/strict.go",
+	)
+}
+
+func jujugenerateapidocStrictGo() (*asset, error) {
+	bytes, err := jujugenerateapidocStrictGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/strict.go", size: 1852, mode: os.FileMode(436), modTime: time.Unix(1791998325, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _jujugenerateapidocUnserializableGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x6d\x6f\xdc\xb8\x11\xfe\x2c\xfd\x8a\xb1\x0e\x4e\xa5\x64\xa3\xed\x15\x45\x51\x38\xb7\x05\x0e\x71\x73\x48\xaf\xce\x19\xb0\x83\x7e\x08\x82\x82\xa6\x86\x12\xb3\x12\x29\x90\x94\x9d\xad\xb3\xff\xbd\x18\x92\x7a\x59\x7b\x37\x40\x51\xdc\x97\x5d\x89\x9c\x79\x66\xf8\xcc\x0b\x47\x3d\xe3\x5b\x56\x23\x74\x4c\xaa\x34\x95\x5d\xaf\x8d\x83\x3c\x4d\x32\x54\x5c\x57\x52\xd5\x59\x9a\x64\xa2\x73\xf4\x57\xeb\x35\xb3\xe3\x93\xdb\xf5\x68\xe9\xd9\xa0\x68\x91\xfb\x65\xeb\x8c\x54\xb5\xcd\x52\x12\x91\xae\x19\xee\x4a\xae\xbb\xf5\x97\xe1\xcb\xe0\x7f\x58\x2f\x2b\xcd\xd7\xe1\x2f\x3b\x14\x32\xba\xee\xb1\xef\x91\x76\xb9\xee\x7a\xe6\xd6\x5f\xac\x56\x93\x99\x5a\xb7\x4c\xd5\xa5\x36\xf5\xfa\xeb\xda\x69\xdd\xda\x75\xad\xd7\xd1\xfd\x28\xd1\x6f\xeb\x52\xaa\x35\x1a\x53\xeb\xf2\xfe\xc7\x2c\x2d\xd2\xf4\x9e\x19\x70\xf8\xd5\x5d\x31\x63\x1b\xd6\xa2\xb9\xdd\xf5\x08\x1b\x88\x6e\x97\xf4\xfa\x9b\xc8\xf3\x97\xe3\x81\xcb\xdb\xa5\x74\x91\x2b\xd9\x16\x45\xf9\xf7\x16\xbb\xbc\x48\xd3\xf5\x1a\x06\x65\xd1\x48\xd6\xca\xff\xb0\xbb\x16\xdf\x49\x6c\x2b\x0b\x06\xdd\x60\x94\x05\x06\x0f\xcc\x28\xa9\x6a\x10\xda\x00\x32\xde\x80\x75\x66\xe0\x0e\x04\x09\x82\xa1\x25\xd2\x23\x24\x61\x74\x07\xae\x41\xa8\xe5\x3d\x2a\xf0\x67\x85\x87\x46\x5b\xf4\xcf\xc0\x99\x52\xda\x81\x60\xd2\x35\x62\x68\xdb\x1d\xdc\x21\x78\x3f\xb1\x02\x66\xe1\x1f\x37\xbf\x7d\x28\x09\xe8\xbd\x72\x68\x04\xe3\xf8\x9a\xf4\xaa\x60\xcb\x02\x33\x08\xac\x6d\xf5\x03\x56\xa0\x55\xbb\x83\x87\x86\xcc\x34\x28\x0d\x54\x9a\x03\xd7\x5d\x87\xca\x11\x42\x85\x96\x1b\x79\x87\x96\xb6\x81\x6b\xc5\x0d\x3a\x8c\x2e\xb9\x06\x77\xd0\xb1\x1d\x34\xba\xad\xca\x54\x0c\x8a\x1f\x65\x21\xef\xb7\x35\xbc\x1c\x63\x52\x5e\x87\x87\x15\x38\x0b\x1d\xeb\x3f\x2d\x29\xff\x7c\xa7\x75\x5b\xc0\xa7\xcf\x21\x19\xca\x7f\x45\xd6\x1e\xd3\x84\x22\x16\x49\xb4\xcf\x04\xd2\xc4\x22\x2a\xb8\xd8\x40\xc7\xb6\x98\x1f\x87\x0d\x18\xf7\xd2\x4a\x07\xe4\x6c\xee\x0e\xc2\x5d\xa4\x49\xd8\xdb\x1c\xdd\x85\xc7\x34\x49\x28\x7a\xae\xfc\x55\xaa\x2a\x2f\x60\x33\xa7\xcb\xb5\x33\xf0\xed\xdb\xd1\xad\x9b\x56\x72\x3c\xb5\xf9\xb3\x31\x6c\x77\x6a\xf3\x8a\xf5\xde\x68\x42\x2e\xb9\x31\xd7\x92\x64\x9f\x26\x89\x14\xb3\xca\xd9\xac\x72\x13\x92\xea\xdb\x37\x20\x3e\x3e\xb9\xcf\x1e\x9b\x40\x9d\xec\x90\xce\x11\x10\x43\x5e\x46\xac\x51\x74\x03\xce\x0c\x18\x4f\x29\x89\xcc\x3f\xbe\x01\x09\x3f\x81\x2b\x3f\x0c\x9d\xcf\xe8\xbc\x78\x03\xf2\xd5\xab\x00\x22\x48\xc4\x95\x61\x43\x92\x67\xe4\x56\x2e\xca\xeb\x6d\x7d\xcd\x5c\x03\x67\x1b\xc8\x32\x78\xf1\x02\xce\x44\xf9\xb3\xd2\x6a\xd7\xe9\xc1\x16\xe4\x92\x28\x6f\x59\x5d\xfe\x82\x2e\xcf\xa8\x9c\x33\xcf\x58\xf6\x3a\x0b\xc0\x09\xd7\xca\x49\xe5\x7d\xf1\x1e\x12\x6e\x6f\xf4\x5d\x8b\x1d\xd9\xf4\x79\x7c\x1d\xde\x73\x11\x82\xf7\x66\x12\x08\x56\x03\x90\x14\x10\xf6\x8f\xd0\x3b\x55\x07\x79\xe8\x21\xdf\xdb\x4b\xcd\x07\xca\x7d\xac\x28\x69\x57\xe0\x56\x20\xca\x0f\xac\x8b\xe1\x7f\xe2\x5a\xf0\x2d\x99\xb2\x72\x03\xac\xef\x51\x55\xf9\xb8\xb2\x82\xc3\x34\x8d\x18\xe4\xcb\x05\x00\x40\x76\x58\x2e\xaf\xbd\x17\xd9\x2a\x48\x91\xdb\x5e\x8a\xaa\x8d\x7c\xc8\x5d\x11\xb7\x3c\xe5\xb4\x17\x9c\x8b\xab\x57\x68\x2d\xab\xf1\x62\x64\x22\x2c\xef\x8b\x89\x45\x9f\xde\x23\x61\x21\xf8\xfb\xd4\x47\xfb\xdf\x2b\x70\xc4\xac\x61\xaa\x46\xb0\xda\x38\xac\xc8\xbe\xcd\x9d\x0d\x47\x0f\xba\xae\xf0\x2a\x21\x7d\xa6\x72\x4c\xf7\xbe\x03\x2e\xc3\xb2\xe8\x7c\xa1\x87\xf4\x4e\x6a\x05\x5a\xc0\x43\xb3\x03\x16\xdb\x9e\x16\xbe\x95\x80\xa3\x9e\xf6\x07\x47\x20\x77\xb8\x6c\x6c\xb1\xab\xad\x80\xea\xae\x41\xc0\xae\x77\x3b\x6a\x9d\xd4\x4a\xa5\x00\xe9\x35\x63\xef\x39\x48\x8b\xa7\xd5\x1b\x75\x1e\xd3\xdf\xa9\x86\x1f\xd3\xa7\x75\xba\x4f\x13\xfb\x20\x1d\x6f\x66\xad\xc7\x34\xe1\xcc\xe2\xa4\xfa\xb6\x61\x6a\x35\xbd\xbd\x1b\x14\x9f\xdf\x3e\x2a\xcb\x04\x5e\x6b\x49\x69\x3a\x2f\xbf\xd5\x5d\xdf\xe2\xd7\xbf\xfc\xf9\xd9\xd2\x8f\x7f\xfa\xeb\x45\x3a\x96\x36\x88\xce\x95\x37\xbd\x91\xca\x89\x3c\x3b\xb7\x70\xcf\xda\x01\xed\x78\x77\x3c\xbf\x30\xb2\xd5\xe4\x66\xf1\xc4\xcb\xa9\x50\x4e\xc1\xcb\xa9\x92\x7c\x34\xcf\x2d\x54\x1a\x2d\x90\x21\xdb\x23\x97\x62\x17\x82\x17\x2d\x92\x10\x99\x7b\x6a\xe7\x8a\xf5\x64\x61\x4b\x89\xe8\xca\x5f\x71\x47\x2c\x8e\x1c\x12\xbf\xde\xab\xed\x91\x10\xdc\xf8\xe0\x5e\xa4\xc9\x21\xe0\xb5\x33\xb7\x3a\xdf\x16\xe5\x7b\x22\x88\xea\xda\xe6\xcf\x2e\x7d\xdf\x8f\xb6\xdf\x17\xb9\x48\x93\xe3\x27\xef\x58\x0f\x5b\xdc\xd9\x29\x93\xcf\xc3\xf5\xba\x20\x97\xd0\xb2\x15\x6c\x8b\x67\x07\xf8\xdb\x7c\x80\xf7\xca\x51\x17\x9a\xb6\x7e\x9a\xb7\x3e\x4a\xe5\x7a\x67\xfe\x1f\x17\x2a\xe4\xb2\x63\x6d\xac\x01\x3b\x7a\x53\xa1\x60\x43\xeb\xfe\x27\xe4\xef\xe5\xcf\x76\xbc\x9c\x46\xb0\x83\x7a\x8c\x75\x71\xd0\x40\xb2\x6c\xd9\x3a\x96\xed\x17\x0c\xd2\xcc\x69\x69\x36\x71\x0d\x86\xea\xf7\x05\x0e\x0f\xd2\x35\xf3\x78\x44\x3d\x43\xb1\x0e\x41\xaa\x71\xa4\x8a\x2d\xa5\x61\x34\x77\x2d\x06\x9a\x65\x9b\x78\xda\xea\x8f\xce\x27\x53\x0c\xa8\x85\xac\x82\x22\xf5\xdb\x48\x64\x01\x34\xad\x50\x77\xec\xdd\x0a\xd0\x18\x4a\xdc\xde\xe8\x9a\xc4\xe3\xfd\x51\xa4\x74\x77\xd1\xde\xd9\x06\x94\xf4\xd2\x13\xd9\xac\xb5\xe8\xe9\xa8\x34\x9f\x00\xbc\x95\x4b\xcd\xdf\x86\x29\x2c\xe0\xf4\x6e\x61\xbe\x98\xf8\x23\x95\x4d\xc0\x7d\xf1\x62\x0c\x6f\x79\x6b\x64\x77\xd3\x33\x8e\x79\xa5\xb9\x1f\x0f\x0e\x79\x9e\xc1\xa7\x2e\x4d\x74\x2e\x98\xf2\xe9\x3c\x0d\xa0\xde\x30\xf1\xac\x05\xb0\x25\xc9\x4b\x42\x0f\x3d\x3e\x4e\xe7\x4b\x52\xb2\xe5\x6d\xbc\xcf\x8e\x31\x9a\x87\x07\xcf\x86\x36\xbe\x63\x56\xc8\xdb\x05\x3b\xaa\xba\x44\xde\x46\x7a\xcb\x6b\x6d\xf3\xe2\x7b\x24\x67\x99\xd7\xad\x75\x79\xc5\xec\x36\x47\x63\x42\x06\xba\x00\xab\x7d\xb7\xa1\xe7\x32\x7f\xc9\xac\x2b\x7f\x41\x45\xf8\x01\xf2\x4c\x6f\x8f\x63\x7d\xc0\x07\x91\x67\x42\x0f\xaa\x02\xa5\x95\x9f\xaf\x3d\x0a\x9c\xff\x70\x9f\xad\xfc\x63\xb1\xbc\x5d\xa9\x0f\xce\x17\xac\x37\x5e\xde\xf4\xc8\xad\xc7\x77\xe3\x36\xfd\x47\x47\x88\x25\x92\xa0\xa2\x92\x02\xce\x2c\xeb\x90\x4e\x4b\x5f\x33\xef\x2c\x3a\x9a\x9f\x49\x9a\x98\x0c\x34\xcc\x7c\x78\xd0\xe5\xa8\x42\x83\x8a\x75\xe3\x71\x83\x22\x19\x88\xb6\xc2\xd8\x38\x8e\x05\x8b\x83\x9f\x3a\xf9\xb9\x05\x19\x1a\xfc\x41\x42\x50\x57\xf7\x13\x89\x8f\x09\x1d\x7f\x3c\xbf\x58\x4c\x17\x71\x64\xb4\xe5\x3f\xa5\x75\x71\x94\x0c\x52\xb2\x9a\xc5\xc2\x68\x63\xe7\x41\x4e\x56\x7e\x85\xf2\x79\xce\x9b\xd3\x53\x99\x1f\xfd\x2e\x35\x5f\xe6\xc4\xa2\xd1\x95\x97\x9a\xfb\x6f\x3a\xe2\x4d\xc9\x76\xa1\x39\x89\xc4\x84\x7e\x2a\xb6\x8f\x47\x3b\xc1\x8d\x77\x0e\xce\x03\x3d\x21\x45\xa4\x82\x73\x9b\x2d\xf2\xfd\x80\xa7\x7d\x7a\x0a\x2a\x76\x5b\x21\x55\x05\x53\x8a\x31\xc3\x68\x96\xca\x8a\x58\xd3\xe3\x78\x78\x50\xcc\xd3\x47\x72\x68\x8e\x22\xce\x4f\xe1\x8b\x92\x96\x02\xe0\xca\x97\xf5\xc9\xd9\x2a\xc6\xd8\xcb\xc7\x62\x9f\x87\xd1\x83\xee\x58\xcc\x16\xa7\xfa\xa6\xd0\x49\x71\x74\x66\xa2\x59\xeb\xe8\xc4\xe4\xe5\x3d\xbe\x97\xcf\x32\xba\x9d\xdd\xf8\x45\x31\x2d\x1e\x14\xe5\x92\xc1\xe7\x5e\xe4\x4b\xed\x57\x90\xfd\x90\xc1\xab\x05\xfb\xfb\xf4\xbf\x03\x00\x43\xb1\x10\x91\xec\x10\x00\x00")
+
+func jujugenerateapidocUnserializableGoBytes() ([]byte, error) {
+	return bindataRead(
+		_jujugenerateapidocUnserializableGo,
+		"jujugenerateapidoc/unserializable.go",
+	)
+}
+
+func jujugenerateapidocUnserializableGo() (*asset, error) {
+	bytes, err := jujugenerateapidocUnserializableGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "jujugenerateapidoc/unserializable.go", size: 4332, mode: os.FileMode(436), modTime: time.Unix(1791993790, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocAdvertisedGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xc1\x6a\xdc\x30\x10\x86\xcf\xab\xa7\xf8\xc9\xa5\x17\xb3\x7b\xef\x2d\x14\x0a\x81\xb6\xf4\xd2\x5e\x42\x20\x8a\x34\xb6\xa7\x2b\xcf\x18\x69\xbc\xc5\x94\xbc\x7b\x91\xe2\x66\x97\x6d\x0f\x8d\x8f\xf2\xfc\x9f\xbe\x99\xd1\xec\xc3\xd1\x0f\x04\x3f\x73\xd4\xe0\xdc\xe1\x80\xdb\x78\xa2\x6c\x5c\x28\x7e\xf4\xc1\x47\x2a\x18\x35\xc5\x02\x1b\x09\x7d\x3b\xc1\x89\x72\x61\x95\x7a\xe6\xad\xfd\xb8\xfd\x7a\x87\x42\xf9\x44\xb9\x22\x12\x17\x2b\x60\x01\x5b\xc1\x27\x1d\x58\x90\xa9\xcc\x2a\x85\x3a\xf4\x9a\x41\x3e\x8c\x38\xb2\x44\x68\x8f\x54\x0b\x3a\xf8\x02\x8f\x90\x98\xc4\x2a\xa3\x10\x55\x3e\x4d\x78\xa2\x5e\x33\x41\x68\x50\x63\x6f\x2c\x03\x7e\x8e\x1c\xc6\x0b\x0d\xc5\x52\x68\x8f\x6f\x92\xf8\x48\x35\x7d\x27\xbd\xee\x37\xff\xae\x62\x56\xb0\x84\xb4\x44\x82\x97\x75\xeb\x63\xf3\x0f\xba\xa4\x28\xef\x0c\x4f\x84\xa8\x61\x99\x48\x8c\xe2\xde\xd9\x3a\xd3\x3f\xa6\x51\x2c\x2f\xc1\xf0\xcb\xed\x0e\x07\x7c\x50\xb1\xac\x29\x51\xfe\x6b\x4a\xa5\x8d\x81\x22\x7c\x6f\x94\x6b\x97\x43\x55\x67\x69\x41\xd3\x56\x1a\xce\x79\x92\x38\x2b\x8b\xed\xdd\xee\x82\x7a\xff\xf0\xd2\xc5\xf7\x3f\xbd\x3e\xfe\x28\x2a\xef\x6f\x3a\x9d\xd8\x68\x9a\x6d\xbd\x79\x74\x8d\xf8\x59\x23\xa5\xb7\x5b\x78\x4c\x2d\x78\x71\xfd\x0b\xe9\xbf\x6e\x7e\x6e\x4f\xe6\xaa\xf0\xec\xf0\xba\x21\xed\xe1\x37\xa3\x0e\x2c\x35\xa4\x39\x52\x6e\x6b\xdf\x0c\x59\xe0\xaf\x5e\xcb\xb6\x84\x2b\xfe\x79\x03\x5f\xfc\x44\xa8\x5f\xb1\xcc\x32\xb8\xdd\x6b\xcd\xfd\x03\x8b\xb9\x67\xf7\x7b\x00\xa9\x34\xf6\x01\xe1\x02\x00\x00")
+
+func apidocAdvertisedGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocAdvertisedGo,
+		"apidoc/advertised.go",
+	)
+}
+
+func apidocAdvertisedGo() (*asset, error) {
+	bytes, err := apidocAdvertisedGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/advertised.go", size: 737, mode: os.FileMode(436), modTime: time.Unix(1791999550, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocAudienceGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xdf\x6b\xe4\x36\x10\x7e\xb6\xfe\x8a\x39\x3f\x94\xdd\xb2\xb7\xdb\xf6\x31\x61\x0b\xe1\xa0\xb4\x0f\xd7\x42\x49\xe9\xc3\x12\xca\xc4\x1a\xdb\x4a\xb4\x23\x23\xc9\x09\x21\xe4\x7f\x2f\x23\xff\x52\xb2\xbb\x57\x7a\x5c\x48\x6c\xcd\xcc\x37\xdf\x7c\x9f\xc6\x1d\x56\x8f\xd8\x10\x60\x67\xb4\xab\x94\x32\xc7\xce\xf9\x08\x2b\x55\x94\xc4\x95\xd3\x86\x9b\xdd\x43\x70\x5c\x2a\x55\x94\x8d\xeb\x1e\x9b\xad\xe1\x1d\x79\xdf\xb8\xed\xd3\x4f\xbb\xfa\x18\xe5\xc1\xf9\x50\xaa\xb5\x52\xbb\x1d\xdc\xf4\xda\x10\x57\x14\x20\xb6\x18\xa1\xc6\x0a\x35\x05\xa8\x90\xe1\x9e\xc0\x70\x24\xd6\xa4\xa1\x76\x7e\xab\x2a\xc7\x21\x61\x65\x79\x5f\xac\x21\x8e\x60\x82\x84\xcc\xe9\x7d\x20\x0d\xf7\x2f\x50\xa5\xd3\xa0\x8a\xdd\x0e\x42\x5f\xb5\x80\x82\x43\xf0\xd0\x3f\xf4\x50\xb9\xe3\x11\x59\x6f\x55\xf1\xa1\xd8\x1e\xca\x21\xb1\x54\xef\xb0\x6e\x9a\x6f\x40\x1d\xb1\x6a\x0d\x53\x4a\x40\xd6\xd0\xb3\x89\x80\x92\x11\x32\x84\xa1\xc4\x1e\x4a\x6c\x4e\xeb\x7f\x71\x1c\xbd\xb3\x96\xfc\x05\x90\xa1\xb8\x64\x06\xf0\x3d\xb3\xe1\x06\x1c\x43\xb5\xe4\x8d\x5d\xe4\x90\x59\x55\x21\x36\x3f\x4d\x0a\x10\x47\x13\x5f\xa6\xe8\x00\x47\xec\x02\x10\x56\xed\x78\x02\x8f\x86\xf5\xa0\x8e\xa8\x82\x5d\x47\xe8\xc1\xb0\xe4\xfe\x92\x86\xf0\x1b\xd7\x6e\x7b\xf3\x84\xc6\xe2\xbd\xa5\x5b\x07\xd1\x81\x89\x01\x70\xac\xb9\x55\x4f\xe8\x4f\x70\xf6\x82\x74\x08\xd1\x1b\x6e\xee\x86\x5f\xaf\xaa\xc8\x1a\xfc\xdc\x07\xf2\xe5\x15\xcc\xff\xa6\xdc\x41\xa6\x8d\x2a\xca\xa3\xd3\x64\x4f\xe2\x2e\x05\x0f\xb3\xf9\x3c\x8c\xfe\xea\x5c\x70\x92\x47\x0a\x8b\x7a\xe7\x02\xcf\xc5\x66\x1d\x7f\x84\x38\xd5\x60\xa3\xde\x92\xef\xd1\xda\xe9\x30\x40\xeb\xac\x0e\x80\xd6\x26\x73\x4e\x63\x0b\x60\x38\xbd\x70\x5e\x93\x97\x24\xc3\xf0\xdc\x9a\xaa\x95\xb7\x2f\x80\x9e\xc0\x9a\x10\x49\x0f\x03\x7e\x57\x72\x0f\x87\x65\xa8\x27\xb3\xf8\xc8\xe1\x72\x9f\xd3\x49\xf8\xa3\x06\x4f\xb1\xf7\x1c\x3e\x34\xe9\x6a\xc0\xd1\xa8\x92\x80\x93\x0f\xc4\x05\x12\xd9\x98\x27\xe2\x64\xa2\x14\x3b\xd8\x60\xab\xea\x9e\xab\xbc\xfa\x6a\x4e\xbc\x75\x73\xef\xeb\xf9\x2f\x78\x55\x45\xed\x7a\xd6\x70\x25\xc6\x79\xa4\x55\xe6\x9e\x7b\xe7\xec\x5a\xce\x3d\xfc\xb3\x49\x50\x12\xe5\x91\x1b\x5a\xfa\xb9\x75\x52\xa3\x30\x35\xe0\x06\xdc\xa3\x44\x7c\xb0\xe4\x41\x32\xef\xae\xe5\x50\x22\x07\xbc\x03\xde\xc1\x1e\xa2\xef\x49\x15\xc5\x9b\x92\xff\x69\xd8\x53\xd2\xdc\xe1\x8c\x8f\x19\x78\x2e\xc9\x88\x3e\x57\x95\xe7\x62\x99\xe3\x3e\x5d\x2d\xd6\xab\xf9\xd5\x06\x70\x3d\x83\x0e\xc3\x5f\x70\x47\x7d\x7e\xc5\x30\x21\x80\x27\x59\xc6\x01\x9e\x5b\x8a\x2d\xf9\xa4\xd3\x20\x0c\x98\x20\xda\xe4\xeb\x34\xd3\x66\xaa\x39\x8a\xb2\xaa\xe1\xfb\xe5\x62\xaf\x73\x88\xb9\x37\x98\xf4\x91\xd1\xc3\xeb\x39\xee\xf5\xf6\x84\x3a\xc2\x7e\x3f\x33\x90\xac\x62\xa2\xf5\x6e\xbe\xe3\xbb\x1a\x6d\xa0\x91\x26\x6a\xbd\x54\xeb\xbb\xc6\xa7\xdd\x8b\xf0\x44\x3e\x18\xc7\xf0\x23\x68\x57\xf5\x47\x59\xae\xf7\x2f\x42\x35\x50\x8c\x62\x1b\x61\xb9\x64\xd6\x86\xac\x4e\x2e\x94\xfd\x36\xcc\x66\x64\x9d\x23\xac\xb4\xab\xf2\xed\x24\x5f\xb4\xed\x9f\xf8\xfc\x95\x42\xc0\x86\xd6\x90\xbe\x61\x42\x40\x63\x9c\xdd\xa4\x5d\x75\x28\x87\xc1\x85\xf2\x4e\x89\xd3\x3e\x8d\x56\x1a\x19\xb1\xb1\xb3\x81\xa6\xd5\x7e\xb8\xbb\x0c\x94\x6a\x90\xf7\x32\xd4\xd4\xc3\x5f\x7c\x44\x1f\x5a\xb4\xab\x01\xf8\xbb\xb1\xca\xfa\x5a\x5a\x82\x4f\x7b\x60\x63\x73\xc4\xd4\x68\xd8\xfe\xee\x22\xd5\x2b\xf2\x7e\x23\x01\x1b\x28\x2b\x64\x76\x11\x3a\xf4\x61\xf2\x48\x28\xd7\xa9\xb9\x51\xc9\x3a\x53\x72\x6c\x55\xea\x26\xef\x9f\xb9\xa9\x83\xb7\xf3\x71\xd4\x87\x32\xfb\x26\x94\xd9\xbd\xfa\x2f\x52\xd9\x95\x3d\x25\xf6\xff\xa8\xe5\x1d\xc8\x5d\x12\x82\xe9\x47\xdc\x38\xdb\xe2\x6a\x7f\x69\x13\xad\xaf\xc1\x12\x2f\x57\x72\x0d\x3f\xc3\x0f\x69\xc0\xa3\xf4\x39\x91\xaf\x23\x8d\x25\x3a\x63\xfb\x0d\x0a\x7f\x7b\xec\x44\x9c\xb9\xc1\x42\x66\x37\x15\x29\x65\xff\x08\xd8\x7c\x3f\x2e\x23\x4f\x76\x50\x67\x50\x2f\x62\x4a\xc5\x77\xd6\x9d\xf1\xc6\x14\x36\x56\xbd\xa9\x7f\x07\x00\x9e\xff\xc1\xcc\xfb\x09\x00\x00")
+
+func apidocAudienceGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocAudienceGo,
+		"apidoc/audience.go",
+	)
+}
+
+func apidocAudienceGo() (*asset, error) {
+	bytes, err := apidocAudienceGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/audience.go", size: 2555, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocCanonicalGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x58\x4b\x73\xdb\x36\x10\x3e\x8b\xbf\x62\xa3\x83\x47\x4a\x69\x3a\xf2\xd1\x23\x75\xc6\xe3\x38\x9d\xb4\x69\x9a\x89\x3d\xcd\x21\xd6\x01\x06\x97\x12\x1c\x12\x60\x00\xc8\x8a\x9b\xf1\x7f\xef\x2c\x1e\x24\xa8\x48\xf2\xc5\x02\xf6\x85\x7d\x7c\x0b\x2c\xdd\x32\xfe\x8d\xad\x10\x58\x2b\x4a\xc5\xb3\x4c\x34\xad\xd2\x16\x26\xd9\x68\x6c\x94\xb6\x63\xfa\xb5\x5a\xc8\x95\x19\x67\xd3\x2c\x3b\x3b\x83\x2b\x26\x95\x14\x9c\xd5\xe2\x3f\x84\x76\x63\x0d\x08\x59\x29\x10\xd2\x2a\x60\xc0\x23\x17\x2a\xa5\x9b\x1c\x8c\x02\xbb\x66\x16\x4a\xc5\x37\x0d\x4a\x6b\xc8\xc4\x0a\x25\x6a\x66\xb1\x84\x4a\xab\x06\xec\x1a\xc1\xb0\x06\xe1\xf2\xd3\x7b\xe0\xaa\x69\x99\x46\xc0\xef\x1b\x56\x83\xc6\x15\xd3\x65\x8d\xc6\x80\xaa\x48\x90\xd4\x95\x2e\x51\x83\x90\xb0\x5d\x0b\xbe\x06\xbb\x26\xf7\x60\x8b\x1a\xa1\x52\x1b\x59\x82\xd2\x24\x0a\x8f\xa8\x8d\x50\x32\xd1\xb4\x4a\xd5\xde\x9f\xde\x05\xbb\xc6\xa6\xc8\xce\xce\xc8\xf2\x3b\xc6\x59\x89\x06\xc8\x01\x0a\x1f\x4b\xb8\x7f\x02\x49\xbe\x31\x59\x46\x83\x39\x34\x68\xd7\xaa\x34\x91\x99\x03\x93\x25\xe9\xa3\xd6\x4a\x03\x57\x25\x9a\x1c\x0c\x4a\x2b\x24\xd6\x9e\x6a\x72\x50\x2d\x45\x2d\x94\x64\x35\x18\xb4\x96\xdc\xce\x81\x6d\x4a\x61\x4f\xf1\x07\xaf\x37\x25\x3a\x2b\xc1\x7a\x0e\x5b\xa6\xa5\x17\xaa\x18\xb7\x4a\x3f\x41\xcb\xa4\xe0\x86\x8e\x83\xca\xf9\x1a\x8c\xc7\xf4\x57\xe2\x87\xb7\xe1\x72\x54\xc0\xb5\xb4\x5a\x20\x95\x08\xde\x09\xac\x4b\x43\x65\xe9\x13\x5e\x11\xcd\x45\xcb\x55\x73\x2f\x24\x96\x2e\x94\x98\x2e\x0c\xda\x21\x15\xcc\x40\x89\x86\x6b\x71\x4f\x95\x53\x3a\x98\x2c\xe0\xad\xe2\x54\x37\x57\x5f\xb2\x46\xba\x52\xe9\xc6\x61\x64\x47\x4f\x48\xf8\x18\x59\x6f\x15\x2f\xe0\x76\x8d\xdd\x41\x42\xc2\xed\x53\x8b\xef\x65\xa5\xc8\x06\x39\xb6\x26\x0f\x85\x04\x06\x0d\x6b\x9d\x73\x46\x81\x44\x2c\x41\x2a\xe7\x98\x90\xab\xce\xe9\x00\x0d\x55\x81\xb1\x7a\xc3\x2d\x19\x71\x31\x1a\x10\x06\x8c\x58\x49\x51\x09\xce\xa4\x25\x5c\x0a\x4b\xc4\x1a\x2b\x0b\xac\x56\x12\x8b\xac\xda\x48\x0e\x13\x07\xe7\xd7\xe4\xc3\x74\x00\xf6\xc9\x14\x7e\x66\x23\xca\x9f\x80\x8b\x05\x68\x26\x57\xe8\xb0\x5f\x44\xd8\xfc\xcc\x46\xa3\x94\xf0\x55\x2c\x8b\xa1\x85\x6c\xf4\x9c\x8d\xc8\xeb\xe2\xa6\x16\x1c\x6f\x2c\xbb\xaf\x71\x92\xea\xe4\x40\x5e\x4c\x44\x0e\x0f\x54\xd4\x29\xdc\x13\x66\xc9\x72\x35\xcb\xa1\x3a\xa7\xa3\x4f\x76\x0e\xc9\x77\x28\x0f\x4b\x72\xa4\x82\x6a\x56\x7c\xa4\x32\xbf\x5a\x40\x75\xee\x97\x64\x68\xa4\xd1\x6e\xb4\xec\xd8\xf3\xc8\xcd\x46\xe4\x5e\xc2\xfe\x37\x74\x90\x93\x08\x9b\x6c\xf4\x3c\xdd\x9f\x86\x6b\xad\x95\xbe\x52\x83\x4c\xf4\x34\x4a\x06\x01\x65\x31\xa8\xff\x64\xbf\xd4\xb1\x44\xf5\xc2\x87\x73\x15\x42\xd8\x63\x3c\x44\xbc\xcb\x79\x58\x86\x0c\x3c\x4f\x0f\x9d\xfb\xa5\xef\xc6\xe3\xa7\x86\xb6\xfd\x80\xc6\x4c\x4e\x06\xaa\x49\xad\x3a\xd2\xc3\x72\x7a\xec\xd4\x77\xbe\xf3\x3f\xb9\xc6\x3f\x7c\x74\x3b\xcb\xa1\x1d\x82\xa3\x57\x1b\x42\x24\xa1\x47\xa0\xb4\xb3\x80\x1d\x78\xb5\x80\xf6\x3c\x6e\x52\xb0\xf4\x22\xf3\x5e\x22\x00\xc6\x5b\x88\x60\xf1\x26\xe2\x6e\xc7\x46\x24\xcf\x13\x99\x21\xec\xda\x59\x71\x2d\xad\xb0\x4f\x7f\x09\x59\x7a\xb9\x7e\x7f\x18\x7c\x37\xe1\xb6\x75\xe8\x48\x00\x38\xa4\x1f\x03\xe1\x5e\xc9\x63\x40\x1c\x2a\xbc\x08\x8b\x03\x87\xa4\x80\xdc\xe1\x0e\x40\xb9\x2f\xe8\x7f\x92\x17\xa5\x8b\x38\x21\x1e\x0b\xf7\x57\xb1\x63\xb1\x26\xd2\x87\x03\x35\xb3\x1c\x4c\x02\xc2\xe1\x11\xf9\x1e\x6a\x04\xa0\x99\x15\x57\xcc\xe2\x8a\xde\xb8\x57\x0b\x30\xe7\xfd\x36\x05\x50\x2a\x36\x4f\xa5\x86\x10\x32\xdd\xc5\x66\xe2\xc5\x46\xb0\x71\x3e\x5d\xd2\x73\x7b\x1d\x5e\x5b\x58\xc0\x46\x8a\xef\x1b\xbc\xf1\x33\xce\xe4\x57\x91\x63\x8d\xc9\x4a\x7c\xa9\xf6\x38\xcb\x01\x87\x7d\xd9\x69\x0d\xdb\xb2\x27\xc7\xa4\x60\xda\x95\xb8\xb7\x2b\x7b\x91\x79\x2f\x31\x4c\x06\xa6\x4d\x87\x49\xd3\x75\x29\x09\x93\xc1\x22\x0e\x01\x7e\x1f\xa2\x74\xeb\x69\xf6\x7c\x60\xf6\xab\xf6\x0f\x7e\x17\x20\xac\x89\x63\x12\x69\xd2\x03\x7d\xf9\xc8\x44\x4d\x90\xba\x55\xdd\x8b\x9f\x4c\x5a\xf1\x0d\x2f\xd3\x69\x42\x55\xa4\x4d\x64\x3f\xef\xe4\xa9\x61\xf7\xec\xd3\xbe\x46\x66\x10\x5a\xa6\x59\x83\x16\xf5\x9e\x21\x24\x3e\xee\x15\xbc\xf6\x49\x3a\xf8\xbe\xef\x6b\x98\x2a\xb4\xc7\x4e\x0f\x56\xc5\xdf\xc1\x11\x2a\x75\xb7\x3b\xd0\x75\xbb\xfc\x03\xed\xd6\x89\x1d\x06\x55\xa8\xec\xc0\x60\x00\x7c\x4f\xdb\xf3\xa2\x05\x90\x57\x45\x52\x89\x7d\x51\x7d\xa0\x74\xc6\xa0\xfc\xe6\x60\x4c\x03\xb6\x0b\xc9\x43\x25\x15\x03\xef\xb0\xa1\xaf\x00\xd8\x0a\xbb\x86\x2f\x42\x96\x6a\x6b\xa0\x16\x92\xa6\xbf\x92\xfc\x02\xae\xe4\x23\x12\x14\x72\x32\x60\x35\x13\xb5\x90\x2b\x9a\xf2\x2d\x82\x69\x19\x47\xd0\xd8\xa8\xc7\xf8\xdd\x80\x8c\xaf\x9d\x05\x3f\xff\xd5\xc8\xc8\x4e\x9c\xc5\x3b\xfd\xfb\x9a\xc9\x6f\x4e\xce\x44\xfd\x02\x2e\x41\x2a\x79\x8a\x4d\x6b\x9f\x40\xa3\xd9\xd4\x34\x04\x6e\xd9\x93\x21\x6f\xdc\x47\x8a\x9b\x39\x8d\x90\xab\x1a\x41\xe2\x36\x9c\x63\x42\x2c\xfe\xcb\x60\xa5\xce\x98\xb1\xc5\x95\x47\xeb\x1f\x5a\x6d\xda\xe2\x16\x7f\xd8\x80\xb6\x41\xaa\x28\x76\xe3\x2a\x30\x0d\xbf\x94\x60\x32\x6b\x28\xf7\xe1\x2b\xab\xb8\x69\x6b\x61\x27\x71\xf7\x19\xdb\x9a\x71\x9c\x94\x8a\xe7\x30\xbe\xd3\x77\x72\x4c\xbf\xf4\xf7\x74\x36\xf5\xcb\x58\xc1\xdc\xc5\xd8\xd7\x91\x76\xbe\x88\x6e\xf5\x55\x2c\xa1\x3f\xe6\x56\x8b\xe6\xb3\x58\xad\xed\x84\x98\x39\x8c\xe1\xce\xde\xe9\xb1\x2b\xa0\x33\x57\xa3\x74\x2c\x33\x85\xdf\xe1\x0d\x9c\x9c\x38\xeb\xe6\xeb\x9b\x25\x2c\x16\x30\x1e\xf7\x86\x61\x11\x58\xb3\x8b\xe5\x8b\xea\x3d\xfd\x74\x76\xd8\xd2\xc5\x40\xcc\x19\x15\x55\x6a\x73\xb1\x80\x37\x69\x2b\x8c\xc7\x4e\x28\xec\x62\x90\x7f\x2a\x11\x34\x42\xa6\xe0\x37\xf7\x4b\xb7\x99\x2b\x50\x3a\xa9\x6d\x67\x39\x6c\xcf\xe1\x75\x18\xcc\xfa\x76\x33\x5b\x61\xf9\x9a\x56\x9c\x6e\x99\xed\xac\xa0\x49\x84\xee\xe4\xed\xb9\x5b\x5e\xf4\x7e\x44\xe6\x3c\xf2\x7a\xa5\x70\x4f\x7b\x35\xbf\x19\x2a\x76\x17\x79\xc7\xef\x95\xe3\x0d\xee\xb5\xc3\x6e\xa8\x1e\x45\xe6\x89\x44\x6f\xc0\xdf\x0a\x41\xdf\x6f\x86\xea\x41\x60\xde\xf3\x7b\x65\xfa\x26\x0b\xaa\xb4\x1c\x2a\x12\xc5\x3b\x4d\xab\x5e\xc9\xbd\x22\x41\xcb\xad\x87\x6a\x8e\x14\x82\xa5\x65\x5a\x3f\xe7\x8e\x31\xf4\x7f\x89\xe0\x8f\x31\x6c\x85\xd9\x73\xf6\xff\x00\x4d\x54\x32\xe0\xaf\x10\x00\x00")
+
+func apidocCanonicalGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocCanonicalGo,
+		"apidoc/canonical.go",
+	)
+}
+
+func apidocCanonicalGo() (*asset, error) {
+	bytes, err := apidocCanonicalGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/canonical.go", size: 4271, mode: os.FileMode(436), modTime: time.Unix(1792002011, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocConventionsGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x4f\x8f\xdb\xb6\x13\x3d\x8b\x9f\xe2\xfd\x74\x08\xac\x85\x20\xdf\x03\xf8\xf0\x3b\x64\x11\xb7\x4d\x10\x74\x53\xf4\x58\x30\x12\x69\x31\x95\x48\x81\x1c\xc5\x35\x9a\xfd\xee\xc5\x90\xb2\x25\xdb\x4a\xbb\x68\x2f\xb9\x18\xb0\x86\xf3\xe6\xdf\x7b\x43\x0e\xb2\xfe\x5d\x1e\x14\xe4\x60\x1a\x57\x0b\x61\xfa\xc1\x79\xc2\x46\x64\x79\x20\x6f\xec\x21\xe4\x42\x64\xf9\xc1\x50\x3b\x7e\xaa\x6a\xd7\x6f\xbd\x3b\x0c\x6a\x18\xd4\x56\x0e\xa6\x76\xfd\x20\x69\xfb\x39\x38\x4b\xa7\x41\x85\x5c\x14\x42\x6c\xb7\x78\x2b\xc3\x07\xe5\xf7\xa4\xfa\x37\xde\x3b\x1f\xe0\x15\xc3\x06\x1c\x5b\x45\xad\xf2\xa0\x56\xc1\xab\x30\x76\x04\x76\x04\x41\xbb\xae\x73\xc7\xc0\x16\x46\xa8\x9d\xfd\xa2\x2c\x19\x67\xe1\x34\x5a\xd7\x35\xc6\x1e\x20\xd1\x99\x40\xfc\x25\x39\x87\x12\x4a\xd6\x2d\x8e\x86\x5a\x18\x0a\x70\x47\x8b\x18\x92\x31\xb4\x51\x5d\x53\x42\x06\x0c\xd2\xcb\x3e\x54\xd1\xf2\x73\xf2\x44\xe3\x54\xa8\x84\x1e\x6d\x8d\x8d\xb1\xda\xe1\x61\x6f\xb5\x2b\xee\x72\xdf\x10\x1e\x2e\x05\x56\x1f\x4f\x83\x2a\xf0\xc9\xb9\x0e\x7f\x8a\x8c\xb0\x03\xfb\x56\x8d\xf2\x4a\x6f\xa8\x10\x99\xd1\x20\xec\x76\xb0\xa6\xc3\xd7\xaf\xa0\xea\x47\x63\x1b\xfc\x6f\x87\x19\xe3\x89\xfc\x58\x13\xfb\x67\x5e\xd1\xe8\x2d\xb4\xec\x82\x12\xd9\xb3\xc8\xb4\xf3\xf8\xad\x84\xc6\xeb\x1d\xbc\xb4\x07\x05\xaa\x1e\xb9\x8e\x10\xcf\x6b\xc2\xeb\xab\x90\x3a\x65\x24\x32\x0e\xac\x57\xa3\x75\xa6\x56\x78\xf5\x6a\xdd\xfa\x4e\x0e\x11\x38\xab\x9d\x25\x63\x47\x25\x32\x4e\x83\xd1\x62\x94\x56\x86\xd8\xb5\x98\xc3\x46\x53\xf5\xa6\x53\x7d\x91\x5c\xa6\xe4\xc9\x9f\xbd\x9e\xc5\x75\x41\xcf\x91\x0c\x7b\xfb\xc5\xd5\x32\x8e\x32\x59\x03\x5a\x77\x84\x44\xdd\x19\x65\x09\x63\x50\x71\xec\xe8\x15\xb5\xae\x41\xcf\xf3\xe5\xff\x5a\xd6\xb2\x51\xd0\x25\xa3\x70\x63\xde\xc5\x03\xd5\x0c\x58\xe1\x31\xd2\x86\xb9\xc1\x1e\x3f\x8c\x9f\xc7\x05\x75\x42\x09\x39\xa1\x32\x44\x20\xc9\x1c\x94\x38\x4a\xaa\x99\x85\x5c\x24\x85\x89\x4b\x25\x9c\x4f\x6c\x72\xcb\xcf\xa1\x44\x2b\xd9\x29\xb2\x89\x61\x66\x32\x5e\x70\x9a\x12\x61\xac\x5b\xc8\x80\xf7\x8e\x8c\x3e\xfd\x9a\x2c\x7b\xa6\x9f\x6d\x16\xd5\x05\x2e\x4e\x32\xcc\x54\x5d\xa4\xae\x84\x95\xbd\x82\xb2\x91\xe5\xc6\x62\xf2\x87\xf4\x8a\xfb\xd3\x80\x1c\x06\xd7\x75\x73\xd0\x8a\x31\xfe\xdf\x75\x70\x51\x50\x67\xf4\xe8\x60\xa5\x3f\xad\x51\x7b\x6e\xdc\x46\xe3\xe1\x31\x26\xc0\x96\x12\x3d\x1e\x52\x73\x0b\x24\xd9\xf3\x84\x8d\x9e\xfe\x84\xea\xad\x0c\x4f\xa3\xd6\xe6\x8f\x8d\xae\xde\xcb\x5e\x95\xc8\xa7\x14\xf3\x62\x49\xe4\x39\xc2\x64\x8e\xa4\xb8\x25\x6d\x5f\x25\x11\xfe\x5b\xb9\xcc\x51\x7e\xe1\x52\x63\x8c\x05\x61\xa7\xd0\xfb\x26\x91\x96\x5e\x90\xe1\x7f\x94\x1d\x27\xcc\x82\x5f\xa8\x6c\xb7\xaa\xc1\x6f\xa4\xb8\xaa\xab\x95\x4c\xaf\x45\x36\x1f\x48\x6d\x48\x72\xbb\x43\xbf\x5f\xbe\x30\x4c\xe8\x90\xd6\x50\x22\xe0\x65\x5f\xfe\x03\xbd\x9f\x12\x21\x2e\x11\xd6\x68\x76\x5f\xdf\xf7\xb4\x42\x57\x59\x7d\xfe\xf2\xd1\xfd\xe4\x8e\xca\x4f\x2c\x2f\x4a\xe4\x53\x13\x4c\x33\x11\xfd\xa5\x5b\xef\x6a\x71\xbe\x68\x04\xb7\x37\xd7\x62\x12\xcc\xa2\xb8\x22\x9a\x74\xe6\x1b\x5d\x5f\x6c\xea\xef\xac\xe3\xa9\x9f\x2c\x93\x3c\x26\x99\xaf\x5e\x3a\xe9\x76\x4b\xda\x3a\x4b\x6b\x45\x4d\x1f\xc8\x27\x77\xcd\xf7\xef\xa4\x9d\xf9\xde\xd2\x14\x83\xc5\x9f\x4d\x81\xdd\x4d\xcc\x17\xce\x2f\x36\xe7\x72\x61\xf1\x02\x6f\x94\x36\xd6\x9c\x9f\x24\x54\x4e\x4f\x16\xde\xd8\xd2\x9e\x30\x38\x63\x49\xf9\xd5\x37\xc5\xd4\xe9\xfb\x99\xdc\x7c\xb8\x1a\x0f\x9f\x88\x4f\x0a\x5e\x4e\xcb\x15\xf3\x77\x3d\xb9\xf1\x8e\xad\x29\x96\x35\x92\x78\x16\x7f\x0d\x00\x2b\x5a\x83\xf6\xf5\x09\x00\x00")
+
+func apidocConventionsGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocConventionsGo,
+		"apidoc/conventions.go",
+	)
+}
+
+func apidocConventionsGo() (*asset, error) {
+	bytes, err := apidocConventionsGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/conventions.go", size: 2549, mode: os.FileMode(436), modTime: time.Unix(1791997553, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocCrossmodelGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\x4d\x6f\xe4\x36\x0c\x3d\x5b\xbf\x82\xf5\xa1\xb5\xb3\xae\xe7\x1e\x60\x0e\xc1\xa2\x8b\x16\x6d\xda\x62\x3b\xb7\x20\x28\x18\x8b\x1a\xab\x6b\x49\x86\x44\x27\x98\x16\xf9\xef\x05\x65\x8f\xe7\x63\x93\x05\x06\x03\x98\xe6\x7b\x7c\x7c\x24\x3d\x62\xf7\x05\xf7\x04\x38\x5a\x1d\x3a\xa5\xac\x1b\x43\x64\xa8\x54\x51\x26\x8e\xd6\xef\x53\xa9\x54\x51\xee\x2d\xf7\xd3\x53\xdb\x05\xb7\x89\x61\x3f\xd2\x38\xd2\x06\x47\xdb\x05\x37\x22\x6f\xfe\x49\xc1\xf3\x61\xa4\x54\xaa\x5a\xa9\xcd\x06\x3e\xc6\x90\xd2\x7d\xd0\x34\xec\x70\x0f\x7d\x18\x74\x02\xee\x09\xd2\xf4\x94\x0e\x89\xc9\x01\xe3\x1e\x82\xc9\x41\x83\x1d\x6a\x92\x04\x64\x01\x5b\x37\x0e\xe4\xc8\x33\x74\x42\xf3\xa3\x13\x1e\x88\x34\x20\xdb\xe0\xd3\x2d\x04\x63\x48\xa4\x01\x8e\xe3\x60\xbb\x39\x2c\xc8\xea\xee\x14\xf8\x43\x92\x52\xdd\x40\x17\x7c\x9a\x9c\xa4\x73\x4f\x0e\x4c\x0c\x0e\xd0\x07\xee\x29\xca\x3b\x8e\x61\x18\x28\x66\xf8\x49\xf6\xe7\x63\xb5\x66\xee\xe5\xe3\x9a\x58\x03\x7a\x9d\xd5\x1c\x84\xb3\xeb\xd1\xef\x29\x09\x9c\x43\x6e\x67\x66\x4e\x56\x13\x54\x9f\xc9\x05\xa6\x95\xac\x6e\x95\xa8\xe1\x2b\x7f\xb6\x50\x9e\x75\x5a\x5e\x39\xf8\x69\xb1\x27\x12\x4f\xd1\xa7\x0b\xcb\xac\x07\xeb\x8d\xd4\x45\x3e\xf9\x26\xf8\x37\xad\x6b\xc0\xfa\x8c\x0f\x51\x53\x14\xff\x05\xdd\x2e\x15\x5a\x65\x26\xdf\x41\x25\x31\xb8\xf9\xc5\x9b\x50\x7f\x2d\xa3\xaa\xe1\xe1\x71\x06\x48\x06\xfc\xa7\x8a\x67\x8c\x60\xd2\x45\x58\x15\x26\x44\xf8\xbb\x01\x03\xb7\x5b\x88\x62\xd1\x45\x29\x81\x15\xd6\x80\x69\x7f\xc6\xb4\xc3\x7d\x75\xaa\xb3\xc3\x7d\x9d\x5f\x17\x26\xc1\x56\x46\x4c\x5e\x57\x26\x35\x60\x6a\x55\x14\xaf\x4a\x7e\xb3\x17\x60\x92\x7a\xcd\x6e\xdd\x63\x87\x31\x04\xff\xc9\xd2\xa0\x2f\xad\x4a\x1c\xa7\x8e\xc1\x1c\xdf\x60\xd7\xe3\xd3\x40\xf3\x1e\xf0\xba\x74\xb2\xa3\xe0\x16\x9a\xd4\x00\x26\xd8\x1d\x46\x6a\x33\xe3\x95\x6f\xd6\xc3\x4b\x6f\xbb\x5e\x42\x87\x1f\x22\x89\x02\x13\x26\xaf\x5b\xb8\x03\x47\xdc\x07\x0d\x2f\x7d\x48\x04\x23\x46\x74\x09\x7a\x7c\x26\x40\x7f\x80\x44\x5e\xa7\x53\x19\xe0\x20\x58\x9c\xb8\x0f\xd1\xfe\x4b\x42\x08\x1d\x0e\x43\xae\x9f\x1f\xce\xc6\x78\x1c\xba\x0e\x6f\x4d\xea\xd2\x82\x8a\xe1\x66\xbd\xca\x56\x3a\x91\xb9\xcd\x17\xbd\xce\x4c\x5a\x4b\x6b\x58\x15\x42\xd7\xa2\xd6\xd7\x54\x0d\x7c\x3f\xdb\xd7\x80\xc3\x2f\x54\x39\x1c\x1f\x2e\xc9\x7f\x47\x47\x8f\x4f\x21\x0c\x75\x7d\x1a\x4e\x86\xc8\x80\xbe\x16\xfb\x46\x91\x6b\xbd\xcd\x51\xdf\xcd\x51\x60\x03\xcf\x36\x59\x26\x0d\xdf\x12\x20\xdd\x59\x03\x0c\xdb\x2d\x78\x3b\xc8\xe3\xa2\x28\x6f\x8e\x47\x47\xb2\x93\xdc\x0a\x24\xff\x55\xf5\x8c\xc8\x0f\xf0\xdd\x16\xca\xf2\xb8\x9f\x4b\xc1\x87\x39\xfb\x31\x87\x57\x36\xa1\x2b\xae\x33\xb6\xc0\x71\xa2\x5c\x8a\x61\x3b\x2f\xbd\x08\xac\xb8\x56\x45\x7a\xb1\x2c\x6b\xd3\xfe\x6a\xbd\x16\xb2\x0e\x13\xc1\xa9\x93\xbf\xf2\xae\xde\xaa\x62\x3d\x1f\xb1\xe0\x74\x42\xdc\x2e\x0b\x9e\x75\x58\x03\xcb\x27\xba\x95\xcf\x13\x5a\x9f\xaa\x63\x60\x17\x7e\x0b\x2f\x14\xab\xec\x61\x16\x5f\x37\x50\x1e\x17\xaf\x5c\x2e\xac\xb8\x59\x3c\x5e\xef\x6c\x09\x34\x20\x3e\x7d\x28\xdb\xf2\xc3\x19\x83\x14\x2d\xe4\x93\x69\xbd\xb4\x98\x8f\xb1\x78\x6f\x6b\x66\xdc\xf9\x24\xd7\xf9\x2d\x77\x7c\xd5\xfc\x9f\x1c\x9b\x73\x2f\x06\xdb\xd1\x79\xe0\x2e\x46\x3c\x9c\x07\xee\x71\xbc\x55\xef\xd6\xe7\xf6\xa7\x81\xdc\x5b\xb5\x5f\xd5\xab\xfa\x7f\x00\x77\x52\x20\x72\xf7\x06\x00\x00")
+
+func apidocCrossmodelGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocCrossmodelGo,
+		"apidoc/crossmodel.go",
+	)
+}
+
+func apidocCrossmodelGo() (*asset, error) {
+	bytes, err := apidocCrossmodelGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/crossmodel.go", size: 1783, mode: os.FileMode(436), modTime: time.Unix(1791997999, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocDeltaGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x95\x4f\x8f\xdb\x36\x10\xc5\xcf\xe2\xa7\x78\xd9\x43\x23\x2d\x04\xd9\x67\x03\x3e\xf4\x0f\x02\xa4\x40\xd3\x22\x49\x7b\x59\x2c\x1a\x5a\x1c\x5a\x6c\x24\x52\x20\xa9\xdd\x1a\x1b\x7f\xf7\x62\xa8\x3f\x96\x37\xbb\x3d\xd9\x30\x87\x6f\xde\xcc\xfc\x86\xee\x65\xfd\x55\x1e\x09\xb2\x37\xca\xd5\x42\x98\xae\x77\x3e\x22\x17\xd9\xcd\xd1\xc4\x66\x38\x54\xb5\xeb\x36\xde\x1d\x7b\xea\x7b\xda\xc8\xde\xd4\xae\xeb\x65\xdc\xfc\x13\x9c\x8d\xa7\x9e\xc2\x8d\x28\x84\xd8\x6c\xf0\x0b\xb5\x51\x42\x51\xa8\xbd\x39\x50\x80\x84\x72\xf5\xd0\x91\x8d\x88\x8d\x8c\x68\x5c\xab\x02\x9c\x6d\x4f\x88\x0d\xf1\x0d\x2d\x6b\xa9\x28\x8c\xc7\x75\x23\xed\x91\x14\x82\xb1\x35\x41\xe2\x20\x03\xb5\xc6\xd2\xa2\x52\x09\x4e\x37\xa5\x09\xd1\x0f\x75\xc4\x93\xc8\x36\x1b\xfc\x34\x87\x8e\x29\x62\x43\xe8\x28\x4a\x25\xa3\x84\xd3\x9c\x6d\x51\x4b\xf1\xb3\x62\x09\xa3\x61\x22\x1a\xa9\x20\xed\xa9\x12\xd9\xa2\x74\xfb\x1b\x45\x89\x2f\x5c\xe4\xee\xa6\x74\x9d\x89\xd4\xf5\xf1\x74\xf3\x45\x24\x85\xf7\x56\xd1\xbf\xab\x74\x0f\xe4\x83\x71\x36\x70\x3a\x7a\x20\x7f\x9a\x6a\x83\xb1\x7c\x9e\xee\xe8\xa1\x6d\x97\x62\x4a\x18\x5b\xb7\x83\x32\xf6\x88\xd8\xb8\x40\x68\x49\x47\xb8\x21\xe2\x40\xb5\x1c\xc2\x78\x27\x36\x74\x42\x23\x1f\xc8\xbe\x5d\x3a\x54\x42\x06\x78\x8a\x83\xb7\xa4\x70\x38\xe1\x5d\x4a\xf5\xd7\x64\xa1\x12\xd9\xe8\xae\x93\xfd\x5d\x88\xde\xd8\xe3\xfd\xdd\xbd\xb1\x71\x74\xfe\x91\x3a\xf7\x40\xea\x15\xef\xdc\xaa\x79\x2a\x2b\xeb\xcb\x2c\xd2\xa4\xa4\x27\x58\x87\xd6\xd9\x23\x79\xf4\x9e\x42\x9a\x4e\x36\x4b\x3f\x4f\xfc\x62\x1b\xcf\x89\x99\x9f\xc7\xa1\x7f\x4a\x33\x1f\x6b\x62\x70\x6a\xd7\x9f\xb8\x95\xc6\x6a\x97\x9c\x72\x9b\x66\x72\x26\x83\x7c\x7d\xb1\x7e\xf1\x45\x8f\x70\x1e\xca\x68\x4d\x9e\xd1\x1b\x71\x5a\x23\x70\x99\x01\x4b\xc8\x00\x6d\xda\x48\x7e\xea\x65\xfa\x5e\xe1\xc7\x29\xcb\x9c\x02\x26\xac\x44\x13\x36\x81\xaf\xbb\x47\x0b\x45\xda\x58\x13\x8d\xb3\x68\x64\x58\x40\x76\x3e\xb9\x5d\x9d\x3a\xcd\x98\x21\x61\xcc\x8e\x59\xc0\xc4\xc0\xb0\x36\x4e\x05\x0c\x81\x58\xa1\xc2\xe7\x86\xe0\x29\x0c\x6d\x7c\x1b\x26\xe0\xb5\xa1\x56\xc1\x53\xed\xbc\x0a\x2f\x2e\xd0\x23\xf9\x15\x45\xd2\xaa\x09\x2c\xce\x94\x18\xc2\x81\xc8\xc2\x8f\x53\xaa\x84\x1e\x6c\x8d\x3c\xb5\xf8\xf6\xbd\xd5\xae\xb8\x9a\x46\xbe\xb4\x6b\x3a\x4c\x1f\xbc\x70\x2a\xf9\xd9\xed\xf1\x43\x72\xf6\x24\xb2\x65\x6d\x76\x4b\x93\x2b\xde\x9f\x52\x64\x23\x8b\x3b\x00\xe0\x4c\xd5\x35\xab\x79\x51\x8a\xec\x2c\x32\xed\x3c\xfe\x2e\xa1\xb1\xdb\xc3\xb3\x87\x8b\xce\xbb\xa9\x44\x4e\x63\xf4\x5a\x24\xd7\xd5\x07\xd9\x51\x09\x5d\x4d\x7a\x05\xf6\x7b\x58\xd3\xb2\xcb\x14\x9d\xac\x56\x33\x97\xeb\xc3\xec\xd9\x11\x3a\xf9\x95\xf2\xe7\xe4\x16\x1c\x7a\x16\xcf\xc3\xef\xc6\xcc\xf7\xd8\x43\xf6\x3d\x59\x95\xbf\x78\xbc\x76\x26\x92\xce\x59\x64\x33\x1d\xbb\xfd\x54\x4b\x02\x2e\xe7\x61\xe4\x1a\xb7\x63\x69\xdc\xea\x12\x5d\x7a\x85\x1a\xa7\x0a\x1c\x9c\x6b\xe7\x16\x74\x78\xb3\x2a\x64\x5c\x1a\x44\x3f\xd0\x98\x63\xfe\x25\xa9\x8f\x10\x4f\x73\xcd\x75\xb9\xf4\xb5\x10\xd9\xb9\x58\xdc\x54\x69\x92\xd8\x23\xd5\x21\x66\x89\xe9\x74\xda\xd5\x2b\x2d\x78\xe2\xff\x89\x80\xc7\x86\x62\x43\x1e\x9a\xd7\x8e\xe9\x9e\x5e\x11\x86\x3c\x6d\x48\xc2\xcf\x44\x86\x7b\x5e\xa1\x00\xed\x5d\xc7\x2b\xb4\xde\x1d\x63\x2f\x53\x7f\x01\xce\x67\xa5\x5c\xb7\x6a\xbe\x38\x07\xcf\xfd\xd2\x5b\x46\x6a\x91\x7d\x15\x1c\xc1\xb4\xe8\xed\x8c\xc8\xb7\x6f\x78\x13\x64\x47\xbf\x7e\xfa\xfd\x43\xfe\x68\x62\xe3\x86\xf8\xc9\x0d\xbe\xa6\x90\xdf\xea\xa2\xc4\x77\xbf\x6d\x8b\x02\x4f\x97\xe6\x8f\xe3\x38\x8b\x05\xd9\xcf\xa7\x9e\xd8\xda\x1a\xc2\x29\x56\xcb\x96\x5f\xfb\xb3\xc8\x86\x30\x82\xb1\xb0\xb8\xfc\xc7\xa6\xfb\x09\x2a\xae\xac\x58\x36\xa6\xbb\x6c\x8c\xe6\x95\x4b\xcf\x08\x6b\xa7\xe9\x4b\xa5\xfe\x0c\xa4\xf8\x6e\xc8\x59\xbc\x44\x57\xfd\x21\xbd\xec\x8a\xff\x0d\xf9\x98\x9e\x9e\x62\x59\x4d\x2b\x3b\xba\x24\xe2\xa8\x54\xeb\x83\xf4\x88\x5b\xdc\x5e\xbb\x64\x65\x7d\x69\xf9\x52\xf8\x1a\xda\xb8\xc5\xfe\xfb\x90\xf4\x25\xdc\x71\xb6\xfb\x89\x65\xa3\x11\x5f\x9e\xca\x55\x57\xd7\x37\x4b\xc4\x6d\xf1\xca\x6e\x9c\x17\xb4\xb5\x6c\x03\x89\xb3\xf8\x6f\x00\xae\xe0\xa7\x69\xff\x08\x00\x00")
+
+func apidocDeltaGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocDeltaGo,
+		"apidoc/delta.go",
+	)
+}
+
+func apidocDeltaGo() (*asset, error) {
+	bytes, err := apidocDeltaGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/delta.go", size: 2303, mode: os.FileMode(436), modTime: time.Unix(1791998736, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocDiffGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x59\x5f\x6f\xdb\x38\x12\x7f\x96\x3e\xc5\x54\x40\x0f\x56\xab\xc8\xf2\x3e\xba\xe7\x03\xf6\xf6\xb6\xc0\x5d\xb1\xcd\xa2\x5d\xdc\x8b\x61\xdc\x32\x12\x95\xb0\x91\x48\x41\xa4\x13\x18\xa9\xbf\xfb\x61\x86\x94\x28\x29\xb2\xe3\x74\xfb\x52\x8b\x9c\x3f\xbf\xf9\xcb\x21\xd3\xb0\xfc\x9e\xdd\x72\x60\x8d\x28\x54\x1e\x86\xa2\x6e\x54\x6b\x60\x11\x06\x51\x59\x9b\x28\x0c\x22\xad\x5a\xfb\xbf\x69\x85\xbc\xd5\x51\x18\x06\xd1\xad\x30\x77\xfb\x9b\x34\x57\xf5\xb2\x55\xb7\x0d\x6f\x1a\xbe\x64\x8d\xc8\x55\xdd\x30\xb3\xfc\xa6\x95\x34\x87\x86\xeb\x28\x8c\xc3\x70\xb9\x84\x5f\xee\x98\xbc\xe5\x9f\x84\x2c\x20\xaf\x98\xd6\xa2\x14\x5c\x03\x83\x9c\xd6\xe1\x86\x9b\x47\xce\x25\x98\x47\x05\x85\xca\xf7\x35\x97\x46\xa7\x21\x8a\x18\xb2\x5a\x00\x61\x98\x2b\xa9\x09\xe1\x47\x96\xb3\x82\xff\x5c\x14\xbc\x80\xee\xdf\x80\x7e\x03\x51\x49\x14\x57\x0c\x49\xa2\x8e\xe1\x0b\xaf\xd5\x03\x2f\xce\x31\xb4\x96\x24\x0a\x83\x9f\x1f\x98\xa8\xd8\x8d\xa8\x84\x39\x58\xd9\xc5\x84\x85\x0d\x28\xae\xac\x45\x45\x14\x06\xbf\x71\x73\xa7\x8a\x73\xe0\x6a\xa2\xe8\xc1\x59\x86\x73\xe0\x1c\x83\x07\xf7\x3b\x6b\x59\xdd\xa1\x9a\x65\x69\x90\x62\x80\xea\x0b\xd7\xfb\xca\x8c\x59\xc6\x1c\x2d\x51\x0c\x58\x7e\xe7\x6d\x2d\xb4\x16\x4a\xea\x9e\x6f\xa2\xc4\x53\x0c\xf8\xfe\x38\x34\x93\xd8\x4c\xf9\x30\xc0\xbd\xf9\x48\x3e\x36\x7e\x96\xdc\x1b\xff\x51\xf0\x6a\xe2\xdf\x09\x43\x89\x14\xbd\x02\xa2\x9f\x68\x98\xa3\x9f\x68\x40\x5c\x03\x7f\xcd\x71\x10\x30\x6f\xf8\xaf\x6d\xab\xda\x5f\xd4\x28\x33\xc7\x6c\x1c\x29\xae\x72\x35\x48\xcd\x9e\x69\x80\xf0\x24\x53\x0f\x31\x0c\x96\x4b\x40\x84\x5f\xf8\x83\xd0\xbc\x00\xa1\x61\x8f\xff\xdf\x1c\xe0\xdf\xb2\x54\x29\xee\xfd\xfa\xa0\xaa\xbd\x11\x4a\x82\x51\xd0\x72\xac\x6f\xe2\x63\x20\xf9\x23\xb4\xc8\x89\x9b\xaa\x04\x06\x68\x0a\x08\x09\x8c\xa4\x7e\x64\xb5\xa8\x0e\x69\x17\x1c\xab\x62\x36\x28\xb4\x35\x2e\x77\x28\xb8\xce\x5b\x71\x43\x95\xae\x85\xbc\xad\x38\x14\xa2\x2c\x79\xcb\x65\x7e\x49\xd1\x83\x36\xed\x3e\x37\xf0\x14\x06\xa4\xcd\x2b\x0e\x09\xbf\xad\xe6\x04\xfe\xcb\x5b\xcc\x4e\x60\xb2\x00\x5b\x44\x20\x0a\x2e\x8d\x28\x0f\x60\xee\x38\xd8\x26\x40\x2c\xb6\x82\xc0\xdc\x31\xe3\x9a\x4f\x91\x80\x28\x81\x49\xb4\xd2\x0a\x04\xd7\x68\xe0\x4f\xec\x63\xeb\x28\x51\xb5\x30\xbc\x6e\xcc\x21\xfa\x33\x0c\x3a\x65\x42\x1a\x0c\xec\x2c\x8d\x03\x71\x4e\x4e\x1f\x38\x42\x4d\x89\x36\x06\x4d\x7e\x50\xad\xf3\x01\x91\x53\xb2\x9d\xc2\x8e\x11\x02\xe8\x3b\x2f\x05\xfe\x33\xab\xf9\x3c\x42\xab\xd0\xe1\xeb\x8a\xe1\x94\x3d\xa4\xbc\xcf\x4f\xb8\x53\x55\xa1\xc9\xb1\x94\x91\x80\x19\x69\x51\x3d\x32\x0d\x54\x6d\xc4\xa1\x5a\x70\x79\x3a\x80\xe9\xc5\xbc\xe4\x9c\xeb\xaa\x20\xdf\x7c\xe6\x8f\xa4\xd2\xa5\x53\x83\x89\xac\x41\x95\x84\x40\x55\x56\x17\x12\x62\x32\x3f\xb0\x6a\xcf\x35\x94\xaa\x75\x2e\x42\xa0\xcc\x40\xad\x0a\x51\x1e\x88\x54\x2b\x4c\x02\x8c\x4b\xcb\xcc\x1d\x6f\x91\x40\x22\x6c\x5c\xea\x30\xe3\x6f\x61\xd2\x30\xb8\xae\x8a\x73\x48\x03\x44\x77\x66\xff\x48\xc7\xdf\x57\x4b\xd0\x72\xb3\x6f\x25\x56\x83\x92\xfc\xaa\x12\xb2\x2b\x11\xb2\xa9\x33\xc9\xe2\x4e\xc3\x72\x2f\x73\x58\xe4\xae\x16\x62\x27\x64\x11\x77\xea\x9e\xc2\xe0\x81\xb5\x50\xa9\xdc\xad\x84\x81\x7e\x14\x26\xbf\xc3\x7a\xc9\x99\xe6\x90\xa7\x2e\xa1\xdf\x6c\x20\x8a\xd6\x61\x10\x20\xf1\x06\xca\xda\xa4\x5f\x9b\x56\x48\x53\x2e\xa2\xb7\x7a\xf1\xb6\x88\xa3\xa4\xa7\xc6\x5f\x2e\xc9\xe3\x30\x08\x44\x09\x79\xea\x12\x9a\xe4\xa0\x78\x2b\xe9\xfd\x06\xa2\x34\x82\xf7\x3d\x41\x18\x04\xc7\x5e\x37\x26\xe0\x54\xb3\x05\xba\xb0\x9b\xbd\x74\x9b\x8c\xa7\x85\xd3\xbe\x95\x5d\xf0\x92\xed\x2b\xe3\x25\xe6\x69\x9f\x51\x21\x12\x68\x58\x3f\x33\x70\x0d\x6f\x75\x94\xa0\xa7\xd0\x36\xec\x1f\x71\x68\xed\xba\xee\xf5\x7e\xff\x0e\x79\x8a\xc1\xf4\x30\x34\xbc\x9f\x88\x82\xc5\x5b\x0d\x57\xff\x80\xb7\xda\x3a\xec\xba\x2a\xf0\xbf\xcf\xfc\x31\x26\xe5\x36\xc0\xa0\x5d\xd8\xff\x25\xca\xb2\x0f\xba\x0f\xad\x06\xc9\x39\x1e\x0c\x46\xc1\x2d\x37\x50\xb6\xaa\xee\x93\x79\xb9\xec\x9b\x21\x76\x6b\x5c\xc6\xbc\x56\x92\x27\xb6\x2d\x17\xdc\xe0\x69\x2b\x85\x36\x22\x07\xd5\x16\xbc\x75\xb9\x82\xea\x16\x0a\x21\x21\xc7\x3b\x6c\xff\x31\x6c\x77\xae\x97\xba\x74\xe9\x20\x74\xeb\x61\xd0\xad\x6c\x80\x35\x0d\x97\xc5\xc2\x2d\x24\xd4\xac\x6d\x06\xe9\x5e\x6e\x9c\xa6\x69\xfc\x12\x13\x46\xf7\x95\x2c\x7d\x14\x9f\xf1\x39\xa7\x3a\x6a\x74\x2d\x59\x3b\x07\x6e\xce\x68\x55\x15\x8e\x8c\xf0\xbb\xdf\x94\x25\xf4\xf3\x37\xd6\xa0\xc6\x38\x19\x7c\xa3\x9d\xa7\xdc\x85\xad\xe5\x7f\x09\xdc\xf3\x03\xca\x68\x71\x11\x70\x42\xe6\x4e\xcd\x27\x7e\xd0\x8b\x79\xa5\x31\xe2\x09\xca\x2c\x01\x75\x9f\x21\xb7\x27\xdb\xde\xf3\xc3\x0e\x37\x57\xb8\xb9\xc2\x4d\xcf\xd7\x6d\xfa\xf2\xb6\x35\xf6\x46\xdd\xaf\xb0\x12\xce\xb8\xd6\xa2\x7e\xc2\x9c\x5f\xc3\x68\xfa\x4d\xdc\xe7\x1a\x4d\x49\x25\xab\xfd\x41\x6a\x97\x1e\xec\xc7\x11\xcb\x34\xc8\x95\x34\x42\xee\xf9\x40\x75\xf6\x7a\xd5\x34\x0f\xfd\xb0\xe2\xa3\xed\x17\x2c\x4b\x80\x91\x87\xac\xdb\xff\xa3\x84\x5c\x94\x59\xea\x06\xf5\x8a\xff\xa1\xe2\xc4\x85\xc4\xee\xad\x46\x7b\x1f\x80\x65\xd8\x97\xd8\x0a\x9e\x2e\xb2\x00\x89\x68\x00\x59\xe3\x01\x39\x73\x1f\x48\x88\xa2\xb3\xca\x9b\x45\xcb\x73\xa6\x59\x86\xeb\xca\x4a\x04\x96\xd9\x85\xcf\xfc\xd1\x2d\xac\x68\x81\x3c\x70\x0c\xcf\x41\xc4\x2a\xb0\xcd\x59\x2f\xee\xf9\x21\x01\xcc\xae\x72\xe5\x4a\xc7\xb7\xa4\xb9\xea\x19\xf0\xb9\xdc\xff\xe4\x25\xc0\x3b\x6b\xcf\xf3\x82\xc2\x88\x51\x97\xad\xd9\x3d\x5f\xd4\xac\xd9\xda\xae\xbe\xbb\x51\xaa\x8a\xfb\x02\xa9\x7d\x79\x94\x99\x3b\x1f\x34\xf2\x5b\x01\xdb\x3a\xc5\xe9\x64\x07\x1b\x30\x2d\x86\xf7\x38\xcb\xb9\xba\x8c\xf3\x6c\xa9\xa2\x3e\x2f\xd2\x26\x06\xea\xd6\x0b\xdc\x71\x55\x59\x67\x09\xd4\x94\x55\x3d\x5a\xda\x8e\x13\x0f\xc2\x2e\x60\x3c\x90\x6e\x90\x1d\xf3\xa1\x3f\x19\x79\x2b\x6d\x0d\xd0\x91\x1e\x67\x8a\xbb\x5e\xc1\x66\x03\x52\x54\xb6\xcc\x52\x37\x6f\x8f\x2e\x89\xe7\xd3\x37\x9f\xad\xdc\x3a\x3b\x23\x97\x0a\xf4\x95\x52\x5d\x59\x9a\x2c\x01\x43\x0e\xc4\xee\xef\x86\x95\x3a\x4b\xe9\x8a\x1a\x27\xa3\xd5\x95\x5b\xfd\x00\x86\x4a\xd1\x74\xa5\x48\x56\x8e\x4f\x56\xd8\xc0\xf0\x96\x9b\x38\x45\x17\x80\x7c\x01\x98\xbd\x09\x3f\x43\xe6\x96\x2f\x84\x36\xba\x4e\xbf\x1e\x5b\x93\x25\xd0\x10\xb6\xc1\x15\xda\x43\x1c\xdc\xbc\xe3\x64\x8e\x64\x35\x22\xf9\x00\x0d\xb9\xb3\x79\xc1\x9d\x9e\xa5\x07\x6e\x81\x5c\x06\xfc\x7c\x57\x19\x9f\xfd\x27\x4e\x64\x22\xa2\xa3\x91\x7e\xa1\x03\xf0\xd6\xe2\xcf\xe2\xee\xcb\x9e\xc4\x97\xb4\x9c\x71\x95\x77\x3a\x06\x6d\xc3\xcd\x9e\x48\x17\x3f\x6b\x3b\x63\xee\x1e\xd7\x05\xdc\x7f\xbd\xf5\x98\x8e\xea\xf9\xe5\xad\xef\x38\x3e\x89\x3b\xcb\xb6\xc4\xb6\xf3\x4e\x74\x0b\x33\xad\xc4\x8c\x5b\xc9\x4b\xe7\x1d\x26\xfa\xda\x3d\x2a\xb8\x51\x01\x3f\xd6\x40\x0a\xe6\xe7\x01\x93\xfd\xa0\x0a\x37\x12\x9c\x53\xf0\xf2\x09\x48\x17\x04\xbd\x20\xf6\xae\x08\xcf\x1d\x81\xcb\xe5\x80\x6d\x76\x42\x77\xa3\x37\xdd\xb8\xed\x65\x33\x73\x53\x3b\x0e\xf6\x6e\x70\x47\xa2\x55\xe2\x5e\x54\x78\x01\x4c\x4f\xf9\x31\x9a\xd6\x6d\x6e\x46\x9f\xa2\x9d\x89\x79\x67\x00\xbc\x1b\xef\x8d\x8b\xe8\x6c\xda\x95\x99\x4f\x3a\x93\xa5\xce\x4e\xcc\x87\x92\x9a\x8d\x59\xd9\xb5\x7f\x1e\x28\xc9\xca\x8c\x4e\x54\x77\x1f\x2b\xbb\x6c\x71\x4d\xe4\xa4\xe7\x47\xb1\x1c\x3e\xae\x8d\xc2\x99\xd8\xad\x35\x38\x2d\xb3\xe1\x15\x25\xe8\x2c\x01\x3d\x6d\xd3\x65\x46\x6e\x19\x37\xe9\x72\x65\x17\x3f\x80\xa6\x76\xa7\x57\x17\x22\x1d\x0e\x72\xd3\xa7\x3d\x3c\x86\x03\x7a\xe6\x5a\x83\x03\x4e\x2b\x63\xf0\xa3\xc1\x4d\x8f\xc7\x36\x3d\x1e\xda\xfc\x3c\x53\xae\x06\xd1\x70\x9e\xb7\x93\x10\x9d\x4e\xe3\x50\xac\xc8\x49\xf1\x0f\x87\xe0\x79\x3d\xf9\x00\xac\x7c\x00\x5e\x6c\xe3\x33\xf7\xb1\xb9\x5e\x8e\x6f\x3f\xb3\x9d\x59\x48\xe3\x67\xc1\xdc\x3b\x40\x55\x85\xbf\xb1\x5b\x2f\x90\x8c\x6d\x9e\xe2\xca\x0e\xbe\x6f\x60\x35\xf4\xde\x80\x57\xf2\xc7\x17\x79\x7f\x7a\xb9\x29\x23\x8f\x17\x6a\xe7\x41\xba\xb5\xe1\x86\xeb\xc9\xae\x85\x3a\xf1\x04\xac\x6f\xa7\xaf\xba\x73\xf5\x78\xfb\xda\xe8\x57\xd6\xf4\x72\x46\xe1\x20\xb9\x3f\xfd\x90\x5c\x17\xf0\x39\xa9\x67\x83\xec\x6f\xbb\x42\x96\xaa\x8b\x2d\x46\xb0\xbf\x0b\xec\x06\x77\x00\xf4\x4a\x3d\x0a\xf4\x2c\x99\x8b\xb9\xf0\xfe\x45\xe9\xee\x65\xc9\xb5\x20\xdc\xfb\xdb\x70\x79\x2b\xf0\x76\x5b\x7b\x89\x4f\x25\x65\x6a\x02\x65\xf7\x12\x75\xc4\x33\xb7\x1c\xda\x53\xf7\x96\x3c\xbb\x7d\xd7\x1a\xd2\x34\x3d\x8d\x11\xb6\xbb\x7e\x03\x21\x69\xfc\xdb\xd3\xbc\x69\x6e\xc0\xc0\x6e\x7b\xcf\x0f\x7a\xc8\x39\x77\x5f\xa9\x9d\x89\xaa\x1d\x3f\x10\xd4\xb4\x8c\x13\xdf\x1b\x54\x46\x17\x7a\xbb\x14\xf8\xef\x6e\xa8\x08\x82\x80\x74\xf5\x19\x80\x5f\xf4\xe2\x80\x41\x0d\x8e\x7d\x64\xd1\xf0\xf4\x6b\x25\x72\x8e\x77\x3f\x9d\x00\xba\x63\x21\x12\xf8\x06\x58\x7e\x80\xe0\xbb\x3e\x83\x04\x5b\xb1\xa3\xdb\x09\x36\x4d\xfa\xfe\xe6\xbe\x91\xa6\xf3\xeb\x88\xf0\xef\x23\x3a\xd7\xac\x27\x84\xee\x66\x33\xa0\x75\x2b\x61\x70\xf4\xcf\x37\xb8\xd7\x47\xac\x9b\xee\xa6\x99\xf7\xfc\x30\xdc\x4d\x0e\x41\x34\x47\x94\x20\xba\x3f\x6d\x20\xef\xb0\x57\x3a\x6d\x52\x54\xc3\x5c\x19\x91\xd3\x0f\x8f\x65\x38\x94\xd5\x30\x9d\x2d\x61\xbb\xf3\xcf\xad\xe3\x31\xb4\xdb\x49\x20\x4b\xa0\xe2\x72\x51\xc7\x2e\xfb\xc7\x53\x5f\xed\x67\x50\x5f\xd4\xf4\x99\x80\x1b\xef\xfa\x58\x92\xaa\x6e\x38\xec\xe1\xd3\xe7\x04\xb0\x4d\xf4\x21\x5e\x0a\xf9\x10\x2e\xba\xfc\x02\xb4\x33\x89\x7a\x3a\xfd\xa6\x48\x71\xef\x44\x90\x07\x2f\x30\x5a\xf7\x7e\x1c\x3e\x5f\xeb\x81\x92\x6e\x7f\x21\x45\x85\xcf\x37\xda\x8e\x6f\x23\x65\x7a\xa0\xca\xfd\x31\x3a\x75\xf2\x13\x88\x92\x28\x0e\x8f\xe1\xff\x07\x00\xf8\x1e\xbe\x7a\xcf\x1e\x00\x00")
+
+func apidocDiffGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocDiffGo,
+		"apidoc/diff.go",
+	)
+}
+
+func apidocDiffGo() (*asset, error) {
+	bytes, err := apidocDiffGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/diff.go", size: 7887, mode: os.FileMode(436), modTime: time.Unix(1792000086, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocDocGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5b\x5f\x6f\x23\x39\x8e\x7f\xb6\x3f\x05\x61\x1c\xd0\xbb\x0d\x77\xe5\x7d\x0e\x73\x40\x6f\x4f\xcf\x4c\xef\xce\x4c\xe7\x3a\x99\xdd\x87\x20\xd8\x95\xab\x68\x5b\x93\x2a\xa9\x56\x52\x25\xed\x39\xec\x77\x3f\x90\xa2\xfe\x94\x63\x3b\x39\x1c\xee\xe1\x5e\xba\x53\x76\x89\x22\x29\xfe\xf9\x91\x94\xaf\xae\xe0\x5a\xb5\x0f\x6a\x87\xa0\x46\xdd\xd9\x16\xf6\xb6\xef\x3c\x84\x3d\x82\xdf\x2b\x87\x1d\x74\x2a\x28\xf0\xc1\x4d\x6d\x98\x1c\xc2\x06\xc3\x13\xa2\x81\xdf\xa6\xdf\x26\x59\xa2\x4c\x57\x3d\xee\xc3\xd0\x37\xcb\x71\x46\x75\xb9\xd4\xc3\x68\x5d\x80\x3f\x2c\x17\xab\xa0\x07\x5c\x2d\x97\x8b\xd5\x4e\x87\xfd\xb4\x69\x5a\x3b\x5c\x39\xbb\x1b\x71\x1c\xf1\x4a\x8d\xba\xb5\xc3\xa8\xc2\xd5\x6f\xde\x9a\x70\x18\xd1\xaf\x96\x7f\x5c\x2e\xaf\xae\xe0\x93\xd9\x5a\x61\x4f\x9b\xad\x75\x83\x0a\xda\x1a\xb0\x86\xb9\xfd\xf3\xf4\xdb\x04\x5f\xae\x3f\xbc\xdb\x28\x8f\x1d\xbc\xbf\xfe\xd4\x2c\x69\x79\x5c\x16\xf9\x87\xff\x5a\x2e\xae\xae\xe0\xa6\xdd\xe3\xa0\xfe\x8a\xce\xd3\xfa\x22\xf0\xa3\x7c\x62\xb7\x4c\xb1\xb3\xed\x34\xa0\x09\x10\xf7\x6a\xe2\x5a\x44\xf8\x30\x39\x87\x26\xcc\xc8\x34\xcb\xc5\x9c\xac\x36\x61\xc9\x2b\x7e\xc6\xa0\xc0\x61\x6b\x5d\xe7\x61\x6f\x9f\xe6\xb4\x9f\x94\x87\x1d\x1a\x74\x2a\x60\x17\xb7\xf8\x14\x40\x7b\x30\xba\xa7\x8d\xf3\x9b\xd5\x6b\xb0\xc1\xad\x75\x08\x3a\xf0\xfb\x44\x42\x75\x1d\x76\x6b\x3e\x09\x5a\x34\xa0\xdb\x61\x57\xd6\x36\xcb\x05\xb3\xf1\x96\xff\xfd\x07\xa9\xf6\x9b\xd5\xda\x0e\x3a\xe0\x30\x86\xc3\xea\x1f\x91\xd5\xef\xb0\x0f\x8a\x36\xf7\x18\xe0\x69\x8f\x66\xce\x6b\xd4\x94\x35\xfd\x81\x3f\xdf\xaa\x56\x75\xe8\x79\x65\xd8\xab\x00\x7b\xf5\x88\xd0\xee\x95\xa1\xbd\xbd\x36\x2d\x82\x02\x3a\x8e\x5e\x9b\x42\xa6\xd2\x63\x7c\xf5\x86\xde\x6c\x96\x8b\xb8\xfb\xdb\xf8\xdf\x69\x1e\x6f\x0f\x23\xf2\x79\x02\xbc\xcd\xf6\xd1\xd0\x27\xcb\xc5\xf7\x91\x1d\x00\x80\xbb\xfb\xf8\x10\xbf\xf8\xe8\x9c\x75\x1f\x6c\x87\x1e\xee\xee\xf3\xc3\xc9\x1d\x16\x7f\x53\xce\x68\xb3\xf3\x4c\x44\x1e\x00\xce\x70\xc3\xe6\x60\x82\x36\xd8\x33\x59\x5f\xd9\x92\xd8\xff\xbb\x1e\x1f\xb1\x07\xa4\xaf\xe1\x51\xf5\x53\xad\xaf\xa8\x40\x18\x30\xec\x6d\xe7\xa1\x55\x06\x1c\x86\xc9\x99\x35\x38\xdc\xa2\x23\xef\x0b\x16\x36\xac\x6e\x5e\x26\xdb\x6c\x35\xf6\x1d\xd8\x2d\xa0\x6a\xf7\xb2\x9e\x0c\x70\xce\xcc\xdd\xfd\xec\x83\xf3\x42\x7c\x1e\xc9\xfc\xb4\x35\xaa\xaf\x24\xf0\x18\x02\xab\x42\x47\x33\x78\x7f\xfd\x09\x3c\xba\x47\x74\xe0\xed\xe4\x5a\x2c\x82\xec\xec\x23\x3a\xf2\xa4\x27\x68\xad\x31\xd8\x92\x63\x7a\xd8\x20\x59\xc4\x1a\xfc\xd4\xee\x41\x45\xbd\xf4\x76\xa7\x0d\xaf\x24\x53\x86\x5e\x0f\x3a\xb0\xd9\xd2\x97\xda\x04\x74\x8f\xaa\xcf\x41\x66\x24\x06\x9a\xe5\xa2\xe6\xf0\xee\xbe\x7a\xba\x89\x4c\x9e\x97\xed\xfd\xd4\xe9\xf0\xf1\x6b\xdb\x4f\x1d\x76\x95\x74\xa2\xf4\x35\xf1\x15\x8d\xa5\xf9\x99\x3f\x5a\xb3\x48\x22\xdb\x4c\xea\x1e\xd5\x23\x7a\xb0\x53\x48\x11\x42\x11\x71\xe8\xed\x8e\xce\xa8\xc3\xad\x9a\xfa\xe8\x92\x1b\x6c\xd5\xe4\x91\x28\x1c\xde\x38\x04\x87\xaa\x7b\x47\x8e\xd3\xc0\xed\x1e\xa1\xd7\x3e\x80\x1a\xc7\x5e\xa3\x87\x60\x01\x1f\xd1\x1d\x78\x61\x15\x82\x94\xd8\x47\xf4\xea\x41\x1d\xc0\xa8\xa1\x58\x0b\x71\x09\xc6\xce\x56\xed\x95\x6f\x38\x42\x45\x51\x9a\x99\xf0\xcd\x72\x31\x57\xc6\xdd\xbd\x0f\xee\xa2\xf2\xbe\x57\x6d\xb0\xee\x70\xad\x8c\x6e\x7d\x8e\x61\x24\xf9\x28\x1f\x29\x4d\xc1\x76\x73\x10\x5e\x79\xd5\x96\x57\x91\x68\x1c\x42\x3a\x0c\xe8\x06\x4d\x6e\x05\x4f\x7b\xcb\x66\x4e\xba\x61\xdb\x8d\xcb\x9a\xe5\x62\xbe\xd5\xdd\x7d\xfd\x7c\x91\x41\xd5\xa1\xd8\x7b\xcd\x9f\xc4\x26\x3e\x4b\x68\xed\xd4\x77\x60\x6c\x80\x4d\x34\xda\x14\x8a\xb0\xe3\xf3\x38\x80\x72\x08\x83\xf6\x9e\x78\xdc\x3a\x3b\x08\x61\xbf\x06\x6f\x41\xcd\xd6\xc0\x93\x0e\x7b\x50\xe6\x40\x71\x52\x1b\x4a\x56\x3d\x06\x11\xa1\x30\x93\x42\xd0\x0b\xae\xf7\xbe\x7b\x44\x17\xb4\x9f\xd9\xa6\xc4\x05\x39\x55\x39\xea\xb9\x31\xf2\x62\x32\x23\xe2\x01\x74\xf0\xf0\x13\x39\x16\x38\xf4\xa3\x35\x1e\xd7\x10\xd4\x03\x9a\x28\x0c\x7d\x2d\x34\x1d\xee\xb4\x0f\xee\xb0\x66\x02\xde\x8a\x82\x7a\x4d\x59\x82\x8f\x66\x43\x21\x1c\xdb\x07\xec\x40\xed\x94\x36\x64\xa9\xc9\x03\x84\x23\x5e\x4b\x6a\x20\x57\x48\x0e\x6f\x76\x64\xc9\x3a\x90\x99\x15\xa1\xde\x96\xbf\x53\x7c\x3e\xab\x8b\x5f\x70\x67\x83\x66\xc7\xae\x94\x61\xa7\xd0\xda\x01\xc9\xe5\xe6\x7a\x01\x53\xde\xe7\xf5\x94\xf8\x14\x38\xca\x29\xf4\xb6\x08\xd5\x48\x3e\xed\xd0\xe9\x47\xec\x66\xc7\xfb\xef\xbc\xce\x23\xd6\x7b\xdf\xaa\x4d\x8f\xcd\x72\x51\xb3\x93\x4e\xb3\xfe\xec\xac\x1c\x94\xa4\xbe\x57\x83\x66\xef\xae\x4f\x55\x3e\xa2\xe0\x41\xb8\xa6\x4a\x9a\x1b\x44\x09\x8a\xf8\x98\x3c\x4a\x75\x1d\x99\xa3\xca\xf2\xfa\x69\xbb\xd5\x5f\x49\xcb\x61\x8f\xda\x71\x38\x48\xe2\xf1\xe2\x99\x88\x39\x55\x52\xf4\x48\xf2\xb2\xac\xf4\x69\xf3\xbd\x36\x5d\xcd\x68\xb3\x5c\xd4\x8f\x70\x77\x9f\x1f\x0f\xe7\x45\xfd\x9e\x12\x91\x3f\x0f\xca\x38\x51\x55\x12\x4b\xe8\xcf\xbc\xb1\x06\xb4\x37\x6f\x02\xec\x29\xa5\x49\xaa\x49\xea\xc1\xc1\x63\xff\x88\x5e\xac\x55\xd2\xc8\xd3\x1e\xc3\x1e\xe9\xac\x39\xa1\xc2\x30\x79\xf2\x6c\xd8\xe9\x47\x34\x1c\x2c\xf7\xf6\x89\x57\xf0\xf7\x9e\xbd\xfb\x51\xf5\xba\x13\x8c\x25\x5c\xdf\xdd\xf3\x1f\xcc\xc8\x29\x09\xff\xc5\xa8\x93\x11\xd3\x59\x01\x05\x93\xe5\x90\x9d\xa2\xc4\x7a\x59\xb9\x17\xf9\x9f\x75\x9a\xfc\x53\x3c\x2c\x38\xd5\x62\x97\xf3\x5e\x5a\x45\x8b\x0a\xca\x63\xf7\x13\x10\xcb\x5c\xcc\x40\xec\xcf\xb6\x9b\x7a\xac\x1c\x65\x54\x61\x9f\x12\x13\xa1\x71\x18\xf8\x8d\x92\xa5\x67\x58\xae\x43\xdf\x3a\xbd\xe1\x83\x17\x52\x31\x1b\xc4\x83\x4d\x28\xb6\x90\x4f\x56\x68\xb7\x10\xdf\x8f\x87\xa2\x28\x2f\x78\xdb\x93\xdd\x45\xa0\x02\x3b\x0b\xad\x1d\x06\x65\x08\xcf\x26\x42\x35\xf1\x0f\x76\xa0\xac\x5f\x68\xef\x95\xcf\xac\xd3\x52\x01\xb6\x76\x9b\xf8\x58\x83\xde\xc2\x83\xb1\x4f\xa6\x59\x2e\x64\xf9\x4b\xc9\xeb\x87\xac\xc8\xb2\x11\x95\x1e\x27\x94\xf1\xa4\x22\x2a\xcb\xba\x5f\x83\x75\x65\x81\x36\xf0\x6f\x37\x9f\x7f\xfd\xf2\xe1\xe3\xdf\xbf\x7b\x7f\xfb\xf1\xef\x1f\xaf\x3f\x7f\xf8\x91\x17\x68\x52\xb7\x8a\x28\xde\x23\xc5\xbe\xb2\x2b\xed\xd5\xdc\xea\x01\x25\x2a\x58\xdb\x27\x65\x9c\xd4\x6a\xa9\xa0\xca\x91\x15\x63\xa8\xf9\x9d\x69\xa3\xa6\xfb\x92\x4a\xe8\xdd\x57\x28\xbf\x20\xa0\xc2\x13\x8b\xb8\x99\x74\x1f\x38\x82\x3e\x63\xe1\x7f\x76\x28\xd6\xfd\x48\xbb\x9e\x66\x82\xaa\x50\x11\xdc\x3a\xc9\x53\x84\x37\x01\x87\x0d\x52\x9d\x43\x29\xaf\x70\xb6\x86\xa7\xbd\x6e\xf7\xa0\x3b\x42\xbb\x5b\x0a\xba\x09\x2e\x67\x2a\x84\xb0\x4c\xa9\x68\x82\xb5\xfd\x1b\x9f\x95\xdf\x59\xa4\x10\x54\x4e\x4f\xd8\x7b\x51\x18\x7b\xf1\x40\x7f\xb0\x73\x28\x39\x93\xab\xd2\x27\x65\x52\xda\xdb\x1e\xf9\x4a\x8c\x3f\x33\x20\x94\x20\x8e\x8a\x00\xac\xc2\x5f\x09\x2d\xd2\x7f\x84\xd9\x68\x29\xcb\xab\xa3\x75\xb6\xaa\xef\xb1\xa3\x62\x99\x50\x79\xbf\x25\x65\x13\x15\x17\x74\x3b\xf5\xca\xc1\x83\x36\xb1\xa4\x30\x41\x87\x43\x43\xeb\x6f\x0b\x16\xd1\x2c\x9d\x01\xe5\xfd\x34\x48\x59\x82\xa0\x1e\x95\xee\x29\x5d\xd2\x33\xc9\xca\x54\x68\x69\x26\xc4\x08\x8a\x02\xe0\xfb\xf4\xee\xad\x8d\x69\x81\xf0\xd3\x6e\x42\xef\x9f\xac\x7b\x90\x30\x37\x13\xb6\x84\xbb\x98\xbe\xa8\xb2\x4b\xba\xc9\x71\x05\x80\x2b\xed\xc5\x47\xde\xed\x2f\x24\x44\x7a\xe5\x67\xf4\x9e\x1a\x1b\x00\xcf\x34\x9a\x81\x59\x56\xa8\x91\x1a\x8d\xa5\xf0\xc1\x8e\x23\x76\x59\xa9\xb4\x8a\xac\x1e\x36\x48\xd6\x9d\xfc\x10\xbb\xc2\x76\xa6\xf8\x9c\xeb\x63\x96\x99\xdf\xc4\xdc\x8c\xb3\x54\x70\x46\x73\x52\x30\x3a\xbb\xe9\x71\x80\xad\x9d\x4c\x17\xcd\x57\x0c\x88\xde\x12\x9b\x62\x54\xca\x49\x49\xb8\x49\x54\x0a\x27\x57\x57\xc0\x9a\x69\x7b\xe5\x7d\x76\x91\x44\x7e\xcd\x7d\x06\xfc\xaa\x08\xc9\xc2\xca\xd1\x66\xef\x82\xd3\xe3\x2a\x86\xf8\xd5\x64\x3c\x3a\xad\x7a\xfd\x3b\x9d\xdf\x3b\x3e\xbd\xd5\x1a\x56\x63\xaf\x02\x65\xc4\x77\xad\x35\x9d\x26\x0e\x54\xbf\x02\xf1\xd9\x95\x43\x3f\xf5\xe1\x9d\x75\x1d\xba\x55\xb3\x5c\xd4\x67\x53\x81\xf7\x75\xce\x35\x94\xb9\x63\xe1\x92\x7c\xb9\xee\x33\xf0\x92\x58\xfc\x94\x08\x9e\x14\x44\x16\x1e\x95\xa4\x0d\x87\x26\x65\x0e\xcd\xf1\x09\x9c\x74\xe4\xfa\x54\xe0\x4c\xa5\xbf\x10\xae\x2e\xd1\xc9\xb8\xa6\x8a\x05\x7c\x1a\xaf\xe5\x95\x30\x11\x94\x9e\x06\x3d\xfe\x42\xf5\xde\xd9\xcd\x18\xbc\x54\xbb\xe5\xa6\xc0\xed\xb9\x6d\x05\x25\x3f\x57\x13\xaf\xbc\x28\xdc\x49\x73\x2d\xbd\x94\x93\xf8\x28\xfb\x54\x4b\xaf\xb0\x1e\x5a\x65\xc8\x97\x36\x28\x8d\x0e\xcc\x90\x8f\xfa\x33\x12\x17\x28\x32\x19\x6a\xe5\xc5\xe5\x62\xd5\x65\xb3\x62\xd7\xac\x20\x61\x69\x91\xbe\x23\xfb\x5a\x7c\x67\xdb\x8b\xc7\x15\x23\xc1\xbc\x3f\x72\x5a\x88\x53\x9d\x1c\x5a\xfb\xa8\x9c\x26\x77\x28\xed\x8d\x8f\xce\x5d\xa3\x1b\x62\x03\x41\xac\xf6\x44\x73\x47\xe4\x99\xef\x3d\xf3\x55\x16\xab\x1c\x2c\xe1\xfc\x94\x1b\xd3\xae\xf0\xcf\x49\xf5\xe4\xc9\x14\xfc\x73\x86\x49\x10\x90\xe2\xad\xb0\xcd\x0d\x0e\x81\xdd\x75\xc3\x95\xf2\x67\xfc\x47\x8d\x3a\x96\x77\x57\xac\x6e\xdf\x88\x1c\xab\x66\xae\xe0\xa8\xd4\x8b\x56\x42\xb0\x54\x0c\xa5\xb0\x3f\xc8\x27\x22\x01\x6f\x92\x73\xb6\x97\x0a\xcc\x04\x50\xe7\x5f\x4f\x68\xfb\x49\x3b\x81\x9d\x9a\x84\x94\xc2\x7e\x83\xb9\xc7\x40\x11\x39\xc7\xfd\x97\x58\xad\x2c\xb7\xec\x54\x6c\xb5\x7c\x26\xe5\x95\x4f\xd5\x3f\xbb\x0e\x61\x28\x45\xdd\x50\x6c\x66\xb6\x77\x72\xbf\x68\x6e\x1f\x54\xc0\x9d\x75\x52\x03\x3e\xef\x62\x35\xcb\xd6\x52\xbd\xfd\x87\xe3\xae\xdc\x87\xdc\x54\xa3\xa4\x49\xd1\x3a\x37\xe7\xd8\xd6\xb8\x8b\x96\xad\x80\x00\x23\xe1\x57\x33\x0d\x1b\x74\x64\x0f\x6d\x5e\x7f\xd4\x4c\xab\x08\x7f\x0b\xab\xf2\xda\xea\x59\x63\x30\xb6\x17\x5e\xbd\xfb\x96\xba\x62\xfa\x78\xbb\x48\xe4\x5b\x58\xf1\x97\xcf\x37\xf9\x0b\xe2\xa8\x7a\xfd\x88\xa7\x37\x8a\x6d\xc6\xbc\xd3\x58\xbe\x79\x40\x1c\x41\x55\x72\x02\x93\x99\xef\x5e\xa8\x7f\x0b\xab\x87\xf4\x90\xa6\x0b\xcf\x8f\x43\x6c\x83\xc9\xfa\xa0\x4c\x48\xb1\xea\x79\x27\x34\xe3\x75\xe6\xd0\x9f\xed\x84\x46\xab\x8f\x9d\x09\xd0\x94\x6f\x29\x79\xa3\xf3\xc4\x81\xc1\x08\xac\xa8\xce\x01\xb5\xa1\xe6\xca\x66\x0a\xd9\x53\xa8\x48\x26\xbc\x46\x07\x4a\xed\xa7\xd4\x3a\xe3\x68\x72\x82\xfb\x59\x48\x11\xd3\x3b\x54\xf6\x9e\x00\x1f\xf3\xa6\x05\xfa\x64\xe5\x8a\xe6\x93\x44\xb1\x73\x70\xd2\x72\x78\x49\xaa\x64\x93\xaa\xe4\x5c\xb6\xb6\xef\xed\x53\xec\x0a\x65\x16\x72\x3c\xb9\x1c\xea\xb2\xd6\x73\xa8\xe3\x9d\x36\x87\x73\xa1\xee\x59\xbc\xba\xba\x82\xbf\x52\x07\xa0\xda\x80\x3b\x02\xc7\x3b\xac\xd9\xab\x99\x7a\x37\x45\x6b\xf1\x32\xf4\xa1\xd2\x8b\x80\xfa\x81\x2b\xc1\xe6\x3b\xf9\xba\xb9\x61\x19\xa8\xbe\x65\x82\xaf\x0d\x91\x35\x00\x3d\x3f\xce\xaa\x71\xf9\xf2\x74\x53\x58\x8e\xbd\xa2\x54\x8e\x9b\x83\x36\xc0\x39\xb8\x4c\x48\x47\x12\xe4\xec\xa5\x4b\xd0\x87\xe6\x21\x34\x11\x89\x4f\xcb\x45\x0d\xe6\x5f\x6e\x23\x53\xdb\x19\x4d\x3b\xeb\x86\xa9\xfc\x59\x0e\xb6\xb9\xd4\xe0\x93\xa0\x59\x80\xa1\x62\x6f\x6b\xdd\x7a\xde\xda\xaa\x76\x8f\xed\xee\xbc\xc1\xe7\xad\x74\xb9\xf9\xe9\x15\xac\x71\x44\xba\x55\x6e\x87\x75\x49\x8c\xa6\x1b\xad\x36\xe1\x39\x73\xb1\x7f\xc3\x4b\x27\xaa\xbc\x8e\x5a\x13\x35\xb9\xb7\xf5\xc3\x59\x06\x6e\xd1\x87\xcf\x34\x51\x8b\xbb\xa7\xde\x56\xb5\x25\x7e\xe5\x36\x2f\x4d\x0f\xc8\x28\x21\xa0\x27\xff\x5e\xcb\xa0\x81\xc4\x2b\x91\xa4\xa3\xc0\x1f\x9b\xbc\xe8\xa4\x25\x83\x3e\x80\x9f\x46\x9e\xbf\x72\x7a\x13\x84\x5e\xbd\xc6\xc4\xb9\xbe\x50\x89\x3e\x6c\x51\xf1\xc0\x77\xdb\xab\x1d\xc5\x63\x34\x04\x3c\xba\x35\x78\x2b\x51\x42\x05\x02\xb5\xdd\xc4\x61\x80\xa2\x64\x70\xb6\xef\xd1\x79\xe8\x2c\x45\x2b\x8e\x91\xe4\xfc\x59\xca\x2f\xa8\xbc\x44\x0c\xfc\x3a\xf6\xd4\x5e\x86\xa7\x3d\xc3\x5f\x79\x43\x4c\x72\x63\x6d\x7f\x0e\x8d\xcf\x89\x5d\xb2\x5e\xde\xe8\x0b\xfe\x73\xd2\x6e\xde\x8a\x8d\xa2\x3d\x3f\xde\x0e\x47\x34\x1d\x65\xf5\x79\xcb\xb1\x5a\x14\xf5\x21\x7d\xbd\x37\xbe\x56\xf6\x64\x3a\x74\x69\x86\xfb\x46\x8e\x2c\x56\x98\xd1\x8e\xc1\x63\xeb\x30\x77\xe3\x29\x3b\x66\xee\xee\xee\xe5\x4f\xca\x08\xe7\xa5\xb9\x55\xbb\x5a\x12\x3f\x6d\xfc\xc1\x07\x1c\x9e\xcb\xb2\xc1\xde\x72\x82\xb4\x73\x59\x56\x3e\x58\xa7\x76\x48\x95\x1a\xac\x0c\x06\xaa\xbf\xb5\xd9\xad\xa8\x0e\xa4\x70\x9d\x3b\x79\xbc\xec\xc8\x51\x75\x78\xe3\x8f\x7c\x33\x13\x8e\x79\x4d\xaa\x46\xca\x08\x94\xa6\x02\x31\xcc\xe3\x69\xa2\x4a\xd9\xc4\xe9\x0e\x65\x4e\x15\xe3\x17\xcb\xa4\x4c\xc7\xeb\x3e\xcb\x1b\xf4\x21\xd9\x85\xda\xbd\xc6\x8f\xbf\x60\x8f\xca\xcf\x0e\xf9\xcf\xd4\x03\x75\xe9\xf3\xd2\x6b\x2f\x2a\xaa\x67\x67\x95\x1b\x33\x67\xef\xbb\x4e\x68\x7e\xa1\x81\x42\x3c\x2a\xa1\xf5\xb6\xfe\xe6\x3c\x4f\x3f\x1d\x73\x54\x92\x4b\x05\x16\x98\x57\x26\x27\x3d\x48\xa7\xa8\xb3\x40\xca\x52\xd0\xa3\xea\xd0\xf9\xbd\x1e\xe9\xb0\x68\x3c\x45\x39\x41\xd8\xcf\x9a\xcf\x49\x9b\x0e\x9c\xbc\xbd\xed\x95\x1e\x40\xc5\xc3\x5c\x43\x55\x70\x72\xbe\x8c\xe3\x5b\x0a\x57\xbc\x2d\xdc\xdd\x33\xab\xd7\xca\xa9\x81\x80\xf4\x79\x91\x6e\x78\xa5\x88\xf4\xb4\x47\x57\xab\xf3\x8d\xe7\x2e\x3f\xc5\x8a\x0e\xdb\x9e\x2e\x8f\x64\xb0\x4e\x02\xbf\xc9\xa3\xe4\xaa\x3d\x4d\x63\xeb\x48\xf4\x6d\x24\x7e\x6d\x2f\x8c\x88\xfe\x73\xd2\xed\x83\x0f\x84\x82\x22\x0f\xa3\xb3\x3b\xa7\x92\xf5\x0b\xe4\x22\x10\xa5\xaa\x78\x94\xed\x91\x9a\x60\x84\xd8\x13\x00\xc8\x7c\xa7\x2a\x8d\x70\x40\x1c\xaa\x47\x5b\x96\x31\xdb\xc6\x29\x77\x88\x16\x4b\xb9\xb6\x29\x6c\x34\xcb\x45\xc5\xd3\xdb\xea\xef\x53\x32\xc4\xf4\x7f\xa4\xec\x84\x32\x93\x75\xcc\x91\x64\x65\x1d\xc7\xc6\x21\x7e\x2e\x13\x3c\xa5\x07\x7f\xc6\x5e\x68\x53\xd1\x46\xf1\xd6\x9f\xf2\xab\x37\xe8\x1e\x75\x8b\x64\x61\x37\xb2\x42\xe0\xc5\x11\xa7\xaf\x2d\x52\x13\xae\x62\x3b\x91\x4e\xd5\x0c\x5d\xad\x67\xe5\xeb\xf9\xd2\x55\x12\x15\x25\x1f\xb2\x42\x72\xd1\xda\x8f\xc8\xd2\x64\xe8\x43\x0a\xec\x75\x40\xa7\xfa\xb4\x8a\x1d\x28\xb3\xc2\xc0\xc1\x4e\xc1\xeb\x2e\x86\x86\xa2\x09\x46\x76\x3f\x6b\x33\x85\x23\x08\x79\xde\x0e\x8f\x61\x65\x11\x2c\xc3\xc6\x34\x1d\x79\x35\x74\xe4\xe2\x99\xcc\x90\x4d\xf5\xc3\x1e\xdb\x07\x56\xa0\x54\xc5\xa5\xf1\x45\x23\x67\x45\xd9\x4b\x06\xd3\x42\x38\x8f\x6b\x0b\xce\x4d\xa8\x5c\xfa\xe7\xd1\xea\x79\x51\xa4\xa1\xbd\x35\x45\x0f\x2b\x0e\x1b\x99\x51\xf8\x8f\x5a\x33\x12\xd5\x69\xc4\xea\x70\x7e\x1c\xc2\x5b\xac\x50\x12\x12\x12\x51\x2e\x28\x72\x11\x45\xbc\xac\xeb\x57\xe1\xe9\x84\x51\x4f\x83\xe9\xd8\x4a\x4a\x4c\x16\xa4\xb4\x4c\x36\x32\x43\xd4\x85\xef\x39\x9a\x4e\x40\x5a\x70\xb3\xf0\x24\x68\xe5\x0c\x50\xe1\x80\x3a\xbb\xa9\xc4\xad\xb9\x93\xef\x7e\xe1\x6e\xe9\xab\xde\xe5\x83\xb8\x46\xf7\x29\xe0\x20\xb7\x0d\x9e\x83\xc7\xd8\x7d\x15\x95\x28\xf0\x38\x2a\xea\x0b\xf0\xda\xd8\xd5\x20\x2c\xc9\xa1\x8e\xb8\x48\xb9\x3f\xf5\x95\xe9\xed\x81\x5b\x41\xd6\x45\xd6\xc4\x6c\xcc\x23\xf5\xad\xac\x69\xe0\xd3\x16\xbc\x5d\x93\x8f\x51\x54\xe5\x28\xe4\xa7\xb6\x45\xec\x3c\xdf\x56\xf1\x41\xf7\x3d\xa7\x5c\xb9\x15\xa2\xfb\x84\x82\x78\x9e\xef\x28\x41\x25\x97\x27\x1e\x04\x13\xfc\xa8\xfc\x4c\xb8\x66\xb9\x98\x0b\xcb\xd8\xf0\xac\x62\xbe\x60\x70\x09\x4d\x2b\xd8\xe3\xe4\xb4\x0f\xba\xcd\xbd\xef\x56\x2c\x63\x9b\xd4\x95\xb3\x67\xb6\x63\xf0\x6a\x4b\x43\x22\x6a\xd8\xb9\xc3\x0c\xe0\x47\xea\x6f\xf9\xbf\x0f\x44\xf1\xfc\x09\xdd\x4c\x23\xba\xc9\xe7\x08\x4f\x6a\xe5\x9b\x15\x05\xa9\xc9\x8e\x83\x7a\x40\x2e\x38\x93\x8e\x25\x6f\x81\xcf\x24\x54\xdb\xa2\xf7\xa9\xf1\xa4\xc6\x11\x95\x23\x68\x07\x2e\x82\x46\xd0\x41\x50\x9e\x0c\x62\x54\x37\x10\xb8\x96\x88\x3c\xd8\x0e\x7b\x50\x3d\xe5\xbe\x56\x19\xba\x0a\xc3\xb9\x90\x0b\xf3\xc2\xe8\xdb\xfc\x67\xf4\xcd\xb3\xb2\x51\x9f\x93\xae\xcb\x10\x96\x29\xd2\x45\x26\x81\xaf\xbc\xf9\x63\x29\x79\x1d\xcb\x4f\xa7\x2d\x03\x29\xb6\xc2\x75\x82\x05\x3c\x2a\x88\x50\x85\x4d\xe1\x68\xab\x0a\x1f\x47\x9b\xc8\x2c\xdc\xdd\x9f\x7c\xeb\x3c\xff\x1f\xe3\xb0\x23\x31\x2f\xb3\x0f\x56\x17\xf1\xe5\xe5\x92\x85\xb0\xbe\x86\x96\x62\xa2\xc4\x74\x6b\xe8\xb0\xb4\xf3\x61\x0e\x98\x49\xb7\x8c\x1f\xe4\xd6\x81\x3f\x98\xb0\x47\xaf\x7f\x97\xb2\x95\x17\x93\xe4\x9e\xef\x89\x8a\xb5\xc7\x78\x23\xfc\x34\xcb\x45\xe6\xec\xee\x7e\xf6\xd5\x79\x59\x44\xe0\xcb\xf5\x8d\x58\x5a\x31\x1c\xb9\x30\x42\xe5\x0e\xd4\x49\x20\xcf\x2d\xec\x56\xaa\x15\xd8\xa8\xf6\x81\x5f\x73\x84\xd9\xb0\xcf\x83\xff\x0c\x2a\x25\xe9\xc7\x19\x90\xec\x4a\x92\x72\xb5\x19\xcf\x9c\xee\xa9\xbc\xf1\xa2\xc0\xe3\xb2\xa8\x70\xc8\x47\xf0\xbf\xaf\x92\x62\xc8\xfa\xcc\xf6\x14\xd5\x92\x2e\xde\xc6\xb8\xc8\xdb\x28\xd8\x4c\xfd\x83\x6c\x2c\xae\xe7\xe2\x05\x2a\xee\xc3\x91\x04\x12\x07\x8f\xee\xe4\x26\x5e\x73\xc7\x2c\xd6\xbb\x74\xe5\x8a\x71\x88\xe7\x1b\x82\xe4\x33\x34\x59\xec\xa4\xf1\x5f\x7d\x25\x5c\x34\x29\xee\x47\x4e\xdf\xd6\x0f\x17\x64\x93\x32\xe4\x55\xa5\x4e\x35\x36\xfb\x3f\x28\x71\x66\xe9\x27\xc1\xc0\x1c\x75\xd0\x04\xa7\xcb\xad\x1f\x86\xce\xb3\xb1\xc7\x73\x03\xad\xee\xc0\xce\xad\x6c\x73\xa8\x32\x54\xb2\x2d\x8e\x1c\xde\x3a\xaa\x97\xc8\x9a\xa8\xf8\x16\xc2\x2f\x97\x8c\x9f\xcc\xa3\x95\x64\x50\x4c\x44\xa5\xe6\xec\xe4\x65\x48\x1a\xcf\xfa\x9b\xa3\x25\xbf\x1a\xe5\x0e\x84\x98\xcb\x47\x7f\x53\xa1\xdd\xa3\x4b\xf7\xcd\x58\x4f\xa9\xfc\xa1\x80\x5b\x69\x9e\x35\x51\x56\x36\xcb\x45\x79\xb8\x04\x77\x98\xdc\xaf\xd5\x7c\x85\x58\xb6\xdb\x80\xa6\xd6\xe1\x7c\xdc\xaf\x8e\x92\xca\x09\x2b\x60\x8a\xcd\x72\xc1\xff\xf3\x1d\xf1\xbd\xed\xe2\xc3\x59\x2e\xe6\x57\x49\xe7\xf8\xe3\x44\x08\x65\xd4\xd8\xe3\x56\x2e\xf6\x5c\xbe\x42\xbb\xce\x59\x2c\xec\xf1\x40\x45\x8d\x60\x08\x63\xe5\xfd\xe0\x94\xee\x2b\x5d\x5e\xbe\xe8\x7a\x19\x30\xdc\xa0\xf1\x3a\xd0\x58\x60\x2e\x45\x61\xfe\x0d\xc5\x81\x43\x6f\x95\x20\x1b\x7a\x4f\x26\x42\x1c\xa8\x4a\xf4\x1c\x15\x5f\x67\xa0\x0e\x92\x83\xb6\xb7\x53\x07\xad\x43\x1e\x66\xab\xde\xc7\x9b\xbc\xd2\x3e\xf3\x7b\x1a\x5e\x51\xd8\xd8\xf0\xad\xe8\x1d\x5d\x46\xcd\xbc\x48\x6f\xeb\xa8\x49\x26\xf7\xe6\x93\x23\x31\xdb\x7c\x59\x63\x91\x17\xbe\xa2\x7f\x76\xbc\xc9\x4b\x06\x77\xa6\xe8\x97\x83\xad\xab\xfd\x59\xa5\x9f\x71\x55\x5d\xed\xc3\xfb\x64\x10\xa3\xb3\x83\x0d\xa9\xa5\xab\x4c\xb9\xdd\x43\xe9\x27\xd5\x7d\x89\x34\x77\xe8\xc5\x26\x0e\x23\xae\x61\x44\xb7\x57\x23\xa1\x7d\x50\xc6\xf2\x81\x95\x31\xc0\xeb\x3a\x0a\xb1\x1a\xe7\x2a\x8c\x43\x96\x5c\x9a\xae\xfd\xb2\x9e\xc7\x95\xcf\xa3\xf3\xcb\x88\x4a\x25\x81\x44\xf0\xec\x79\x72\x41\x3e\xc3\xec\x18\xf3\x67\xbe\x1e\x09\x7d\x0b\xab\x89\xfe\x58\x2d\x8f\xf6\x91\x88\x72\x72\x27\xe0\x2e\x48\xaa\x01\x9f\xe2\x9b\x6b\x89\x9d\x29\x46\xea\x74\x91\x8d\xf2\xf1\xf3\xa0\x20\xab\x24\xcf\x16\x2b\xfe\xc5\x06\xbd\x3d\xa4\x78\xf6\x0b\x7e\x0d\x47\xf3\x5a\x21\xe4\x70\x44\x82\x45\xfd\x01\x26\x13\x74\x4f\xd0\x34\x32\xe0\xe5\x67\x20\x7e\x26\x6e\x92\xe7\x5b\x58\xc9\xd6\x69\xf8\x56\x27\xbe\x7c\x33\xf1\xc5\x9c\xbd\xe6\x5e\x0e\xe9\x9d\x68\x50\xf6\xf5\x17\xd2\xaf\x7f\x9e\x7f\xd7\x55\xc6\x67\x12\x04\x9e\xe9\x77\x35\x5c\x8c\xfc\xe9\x50\xd5\x3b\xeb\x19\x27\x14\xcd\x04\xf7\x78\xaa\x0f\x39\xf9\x10\x05\x41\x50\x4c\x21\x8e\x95\x98\x47\xa9\xc6\x8e\x4a\x2e\x9a\xee\x91\xed\xd2\x71\x21\x8d\x80\xda\xd8\xe5\x09\x7b\xed\xa5\x1c\xad\x15\x33\x6b\xbf\xfc\xe9\x70\x6d\xbd\xae\xb2\x57\x0a\x5b\x95\xad\x25\xc3\x49\x30\x80\xb8\x9a\xb1\x3d\x26\x12\xda\xd4\x55\xdf\x72\x51\x51\xa7\xe0\x99\xe6\xe5\x66\x4b\x17\x73\x72\x2c\x90\x29\x27\x7f\xc8\xb1\xfa\xa8\xc8\x12\x27\xfe\x06\x56\x7b\xbd\xdb\xd3\x8d\xa1\x01\x3b\x3d\x0d\xb1\xf3\xdc\xdb\x27\xba\x62\x50\x51\xad\x5b\x21\xc7\x01\xf0\x39\x75\x86\x2b\x55\x00\x13\x7f\xae\x4a\xb3\xf4\x3e\x16\xed\xa8\x13\x55\x1e\x59\x73\x6c\x9e\xf0\xad\xdd\xfa\x32\x94\xda\x52\x3b\x74\x36\x58\xde\x2a\xdd\x4f\x0e\xd7\xe9\x76\xdd\x1b\x5f\xb2\xbb\x5c\xba\xcb\xe9\x83\xab\x04\xa9\x8f\xad\x7d\x00\xdc\x6e\xb1\x0d\xf9\x68\x33\xa3\xb3\x93\xbd\x51\xdb\x2a\xd8\xe6\x84\x9a\x2a\x73\xe1\x9f\xf9\xe5\x1b\x08\xe9\x74\xf9\x44\xe3\x0e\x20\x08\x80\xd6\xe8\xc0\xb1\x88\xea\x3c\x22\xfc\xff\xed\x38\x8f\x2a\xd2\x74\x5f\x8f\x4c\x2f\x9f\xa5\x54\x95\x19\x4b\x92\xe8\xe8\x68\x35\xe9\xe7\xb8\x88\x4e\x5e\x5e\x20\x51\x03\xb7\xb9\x36\xd7\x02\x38\x79\x75\x6a\x1e\xa8\xbe\x3f\xcc\x11\x68\x39\x09\x0a\xf9\x36\xb7\x8f\x8e\xd8\x9d\x1d\x6c\x69\xe9\xf9\xe7\x5d\xb9\xaa\x8b\x12\xdb\x01\xe9\x58\x59\xb8\x1c\x9b\x19\xce\xaf\xd4\xa8\x1b\x35\x85\xbd\x75\xfa\x77\x74\x4d\xec\x98\x48\xfd\xfb\x87\x31\xff\xd9\x64\x6e\xde\x4b\xfb\x80\x16\x16\xb9\x6f\xd5\xee\x8f\x7c\x66\x89\xd7\xa2\xf6\x72\x33\xfe\xcc\x84\x5a\x24\x2b\x37\xb6\xa4\xf7\x6f\xd2\x2f\x35\x9b\xe3\x4b\xfe\xf3\x19\x2e\x8b\x9c\xef\xda\x30\xda\xd6\xc9\x33\xca\xe6\x33\xfd\x5d\xbc\x5d\x17\x19\x29\x33\xaf\xf3\x37\xea\x4e\xdf\x9e\xfb\xc1\xce\x5a\xd8\x4c\xae\x4c\x4e\x64\x22\x5d\x51\xe3\xf5\x0d\xd1\x3b\xba\x40\x27\x0d\x12\x87\xbe\x72\xad\xcb\x3f\x59\xa8\x63\xb4\x6c\xbc\x92\xbe\x4e\x17\x63\xa5\x1d\xe5\x76\x65\x84\x90\x89\xfa\xb9\x71\x6a\x41\x89\xcc\x66\x7a\x9d\xba\x26\xf2\xa7\xc0\xc4\x0b\xd0\x2f\xbf\x3a\xf3\xca\x8b\x83\x49\xba\xe1\x59\x17\x83\x74\xc3\xc4\x97\xab\xc0\x10\xd4\xee\xe8\xc0\x24\xa4\x50\xe2\xa1\x69\xba\xf2\x05\x84\xac\x26\xa3\x43\x94\x7e\x50\xed\x5e\x1b\x5c\xe5\x98\x9b\x70\x88\x4a\x5c\xa5\x41\x83\xa2\x3d\x08\x46\x23\xdc\xaa\xdd\x77\x8c\x24\x46\x89\x2e\x99\xc1\x97\x4b\xc3\x0f\x04\xfb\x9c\xe2\x1b\x00\x45\x1c\xf9\x09\x49\x1e\xd1\xc8\xfc\x45\x9a\x01\x2c\x0a\xfd\xa6\xef\x90\x02\x0c\x4b\x48\xd7\xcc\x09\x61\x36\xf0\x3e\x95\x96\x54\xe3\xb2\x57\x27\x73\x88\x4b\x4b\xf7\x7e\x48\x3f\xf0\xf5\x68\xf8\x37\x40\x3a\x44\xb0\x10\xd2\x6f\x07\x73\x94\x5a\x3c\x0b\x53\xe9\xc7\x82\x1b\x9c\xff\x3a\xad\x96\xe9\xee\xbe\x3c\x5d\xba\xaf\x56\x5e\x2a\xa0\x4c\x09\xef\x03\xcd\x98\xad\xa9\xa6\x0d\x1c\x05\x58\x68\xf1\xe3\x6a\xfd\x8b\x77\x91\x99\xe8\x37\xb0\x22\x27\x5c\x09\xdc\x8d\xbe\xc2\xba\x26\x87\x91\x0b\x0f\xf1\x0b\xdd\x25\xab\xa2\x15\x6b\x58\x05\xb5\x8b\xeb\x12\x26\xcc\x4e\xa6\xd2\x6f\x81\xca\x9a\xa0\x76\x74\x7f\x99\x06\x35\xce\x9c\x5a\x36\x10\x3e\x15\x98\xec\x90\x67\x62\xe4\x62\x0e\x39\xb0\x32\xaa\x5e\xa1\x99\x86\x53\x6b\x37\xa4\x17\x4c\xbf\x60\x51\xb0\xd5\x5f\xe9\xf7\xd0\xc8\xa8\x94\x65\xf2\xa7\xae\x43\x57\xd6\x5a\xa9\xbb\x74\x8d\xb5\x01\x2e\x2d\xd7\xcb\xc5\x11\x4a\x59\x25\x8d\x90\xcb\x70\x18\xa3\xa8\x5e\xd3\x9b\x85\xa6\x28\x75\xe5\xa7\xcf\x05\x2c\x6e\x5a\x0c\xb4\xe8\x85\xdb\x2e\x3f\x58\xee\x70\xaa\xaf\xd4\x05\x75\x95\x32\xdb\x7c\xea\x24\x66\xda\xed\x25\x97\x93\x32\xac\x30\x25\xd3\x6b\xf9\xd1\x74\xdc\x23\x6a\x7c\xbe\x81\x2c\x7c\xd9\xa9\xe7\x53\xa2\x32\xfe\x95\x3c\x2e\xf8\x81\x92\x6f\x31\xc8\x3c\xc8\x2a\xfd\xd8\x2f\xb8\x15\xf7\xc8\xcf\xf5\x4f\x4f\x32\x28\x39\xfe\x7d\xc5\xf9\x4b\x5c\x85\xce\xab\x7f\x3b\x50\x5f\x47\x5f\xfe\x6b\xf9\xdf\x03\x00\xae\x01\xbf\xc8\xc6\x41\x00\x00")
+
+func apidocDocGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocDocGo,
+		"apidoc/doc.go",
+	)
+}
+
+func apidocDocGo() (*asset, error) {
+	bytes, err := apidocDocGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/doc.go", size: 16838, mode: os.FileMode(436), modTime: time.Unix(1792000310, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocEntitytagGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4f\x6f\xdb\x3e\x0c\x3d\x5b\x9f\x82\xf0\xef\xd2\xa0\xb1\x9b\x5f\xff\x2c\x5b\x87\xdc\xb6\xc3\x76\xde\xad\x28\x0a\x56\xa2\x1d\xa1\xb2\xe4\x49\x72\xba\xa0\xc8\x77\x1f\x28\xc9\x49\xba\x53\x73\x09\x9e\xf8\x48\x3e\xd2\x8f\x23\xca\x17\xec\x09\x70\xd4\xca\x49\x21\xf4\x30\x3a\x1f\xe1\x42\x54\x75\x88\x5e\xdb\x3e\xd4\x62\x21\xc4\xd5\x15\x44\xec\xbf\xff\xc1\x61\x34\x14\x60\xeb\x8c\x0a\x80\x16\x28\xbf\x70\x10\x3a\xe7\x81\x50\x6e\x99\xec\x3a\x88\x5b\x02\xe9\x86\xc1\x59\x78\xd1\x56\x05\x70\x1d\x90\x8d\x3a\xee\x99\xdd\x8a\x1d\xfa\x77\x35\x37\x30\xe0\xf8\x90\x9b\x3e\xe6\xbf\x37\x51\xd5\x28\xa3\x76\xb6\xbe\x87\xf4\x2b\xb0\xe9\x6e\xd7\x28\xff\x5f\x3d\x37\x77\x9f\xa5\x6c\x6e\x6f\xd6\xd7\x0d\xde\x7d\x5a\x37\x2b\x5a\x5d\x3f\x5f\xcb\x1b\x75\xbb\xfe\x52\x2f\x39\x7d\x1c\x8d\x96\x58\x6a\x9c\xc3\x66\xd8\x87\xdf\x26\x91\xa4\x71\x93\x9a\x5b\x40\x86\x0d\xbe\x86\x53\x50\x7a\xca\x84\x13\x64\xc2\x13\xaa\x41\xdb\x27\x45\x1d\x4e\x26\x66\xba\xb3\xd1\x3b\x63\xc8\x33\xff\x0c\x7e\x5c\x73\xa7\x0d\x85\x7d\x88\x34\xa4\x12\x27\xd8\xac\x9a\x55\x6a\x32\xa0\xdc\x6a\x4b\x45\xf2\x0c\xe7\xa0\x53\x64\x4e\xd3\x24\xf8\xf1\xe6\x9e\xcc\xbc\x2d\xce\x9e\x61\xf3\xea\xbc\x1a\x3d\x85\xd0\xaa\xe7\xff\xd2\xea\xda\x40\x7e\x47\x3e\xf5\x0c\x23\xca\x59\x0e\x40\x86\x45\x4e\x88\xce\x63\x7f\xd4\x5a\x60\xa3\x30\x62\x61\x4c\x56\xc7\x63\x2e\x24\x98\x3f\xce\x1c\x0f\xe4\xcf\xe3\x81\x7c\x93\x16\x9f\xa2\x3b\x67\xa6\xe1\xd8\xbb\xc0\x94\x79\x48\xbe\xfd\x85\xfd\x37\x0a\xd2\xeb\x91\xe7\x00\x4f\x71\xf2\x36\x00\x82\x3a\x7b\x2d\x7e\xdd\xa1\x99\x28\x39\x15\xa1\xd3\x64\x14\x17\x60\xb3\x6b\xdb\x27\xbb\x1f\xfd\xcb\x1c\x67\x69\x36\x7a\xaf\x77\x54\x7c\xbe\xcc\x87\x90\x0f\x83\xf3\xd3\x40\x6c\xf5\x25\x50\xdb\xb7\xf0\x6e\xbe\x16\x7e\xc4\xa3\x26\xae\x44\xc3\x18\xf7\x90\xfd\xcf\xd9\x3a\x35\xf0\x04\xe8\x09\xac\xcb\x3d\x5a\xd1\x4d\x56\xfe\x33\xda\x45\x0a\xc1\x43\x39\x9e\x45\x29\x02\x6f\xa2\xd2\x1d\x18\x2a\x84\x05\x6c\x36\xb0\xe2\xd7\x2a\xf7\x85\xba\x16\xd5\x41\x54\xbc\x0f\xb8\xdf\x94\xb4\xd0\xfe\x74\xba\xa4\x2c\xa1\x06\xe7\xa1\x5e\xc0\x25\xd4\x3c\x49\x2d\x2a\x1e\xf2\x69\x99\xe4\x70\x92\x47\xdb\x53\x39\x74\x2e\xad\xbb\x79\x05\x4b\x70\x2f\xcc\x38\xbb\xf5\x07\xe6\x3d\x7e\xe5\x00\x73\x67\x1d\x49\xc0\x25\xd4\x65\x4f\x35\x5c\x1e\xd7\x58\xb1\xc0\x83\x38\x67\x8a\x83\xf8\x3b\x00\x08\x17\x5e\x94\xbd\x04\x00\x00")
+
+func apidocEntitytagGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocEntitytagGo,
+		"apidoc/entitytag.go",
+	)
+}
+
+func apidocEntitytagGo() (*asset, error) {
+	bytes, err := apidocEntitytagGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/entitytag.go", size: 1213, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocExampleGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x57\xdd\x52\xdb\xc8\x12\xbe\x96\x9e\xa2\xa3\x13\xce\x91\x52\xb6\x7c\x92\xbd\xd3\xc6\x5b\x95\x0d\x50\x05\x45\x02\x85\x61\x73\x01\x54\x6a\x2c\xb5\xac\x09\xd2\x8c\x76\x66\x64\x48\xb1\x7e\xf7\xad\x9e\x19\xc9\x32\x31\xe4\x22\x96\xa6\xbb\xbf\xee\xfe\xa6\x7f\x44\xcb\xf2\x7b\xb6\x42\x60\x2d\x2f\x64\x1e\x86\xbc\x69\xa5\x32\x10\x87\x41\x84\x22\x97\x05\x17\xab\xd9\x0f\x2d\x45\x14\x06\x51\xd9\x18\xfa\xd1\x46\x71\xb1\xd2\x51\x98\x84\xe1\x6c\x06\x05\x96\xac\xab\xcd\xa7\xa2\xe1\xe2\x2f\x54\x9a\x4b\x01\x95\xac\x0b\x0d\xa6\x42\x58\xfb\x13\x59\xda\x57\xab\x05\x25\xcb\x59\x81\x64\xdc\x69\x2c\xc0\x48\xa8\xe5\x0a\xb8\x80\x87\x0a\x85\xd5\x2b\x64\xde\x35\x28\x0c\x14\x12\xb5\xf8\x9f\x01\x2e\xf2\xba\x2b\x10\xb8\x49\xc3\x5c\x0a\x6d\xf6\xfa\x9d\xc3\x6f\x36\xa6\xa3\x47\xd6\xb4\x35\x8e\xe2\x68\x50\x6b\xb6\x42\x0d\xf8\x98\x57\x4c\xac\xb0\x70\xce\x72\x56\xd7\x5c\xac\x80\x41\x83\xa6\x92\x05\x59\xcb\x35\x2a\x60\x20\xf0\x01\x1e\x70\xa9\x65\x7e\x8f\x06\x72\x29\x04\xe6\x86\xb2\x33\xd2\x42\x9e\x76\x3f\x3a\xf8\x74\x71\x02\x1a\xd5\x1a\x55\x1a\x9a\x9f\x2d\x0e\xae\xb5\x51\x5d\x6e\xe0\x29\x0c\x8e\x6d\xb6\x00\x8e\xb7\x30\xe8\x83\xe5\xc2\x84\xc1\x17\xeb\x75\x10\x86\xc1\x6c\x06\x67\x72\xc5\xc7\x1c\xd6\xf6\x5d\xe1\xdf\x1d\x6a\x33\x81\x87\x8a\xe7\x15\x34\x9d\x36\x56\x7b\x89\xa0\x89\xa9\x92\x2b\x6d\x40\x0a\xc0\x35\xaa\x9f\xa3\x78\xd3\x30\x70\x88\x97\x0e\xe1\x58\xb1\x06\x43\x6b\xeb\x4f\x46\xbe\xbc\x17\x28\xa5\xf2\xbc\x51\x7c\x13\xab\xfd\xc0\x4d\x05\xda\xa5\xd7\x32\x42\x31\xa8\x74\x1a\x06\x3d\xcc\x3e\x07\xba\x95\x42\x8f\x6f\x42\x57\xac\xc5\xbe\x1e\xf0\xb1\xc5\xdc\x60\x01\xca\x2b\xfe\xea\x69\xcd\xea\x0e\x9d\x17\x8f\xd5\x3f\xb8\x44\x36\xe3\x1b\xd7\xa0\xd0\x74\x4a\x68\x60\x02\xd0\x5f\x05\xe5\xe2\x48\x71\x77\x4c\x95\xc6\x45\x29\x53\x32\xbc\x7a\x4e\x30\x74\x1a\xb5\x0d\xae\xad\x59\x8e\x14\x38\x2a\x0d\x6f\x4f\xaf\x4f\xaf\xbf\x5f\x2f\x8e\x2e\x81\x09\x5b\x26\xee\xe4\xe2\xd3\x62\xf1\xed\xfc\xf2\x70\x60\x2c\x57\x58\xa0\x30\x9c\xd5\x3a\x0d\xcb\x4e\xe4\x10\x93\x33\x78\x77\x22\x4a\x99\x0c\x81\xc6\x09\xdc\xdc\xf9\x17\xaa\x92\x02\x4b\x0d\xd9\x1c\x48\x37\x5d\xe4\x15\x36\xec\x10\x4b\x2e\x38\x95\x9c\x8e\x93\x30\x60\xe3\x4a\xcf\xe6\xfb\x1a\x20\x0c\x78\x09\xeb\x2d\x8e\x2b\x3d\x2f\xd4\x71\x72\x13\x59\xed\xe8\xee\x77\xa8\x51\xc4\x6b\x9d\xc0\x1f\xf0\x7f\x72\xbf\x8b\x3e\x87\xb5\xbe\xf1\x1a\xd3\xf7\x77\x61\xb0\x09\x83\x35\x53\x3d\xa3\x7a\x1b\x7a\x18\x50\xde\xdf\x27\x50\x92\x53\x45\x9d\x35\x76\xad\x2d\xb6\x57\x69\xb6\x2a\x65\xea\x0a\xdf\xc9\x03\x24\x89\x47\xb4\x07\xbe\x69\x32\x80\x32\xfd\xca\x1a\x9c\xd8\x43\x1f\x5e\x06\x65\xea\x1f\xdd\xb9\xc3\xca\x00\x9a\x91\xb2\xad\xf9\x6c\xa7\x26\x1d\x74\x5f\xae\x27\x45\x06\xef\x1d\x40\x70\xf5\xb3\xc5\x0c\xec\x3f\x4f\x91\x17\x0c\x2e\x01\xc6\x0c\x4d\x76\x90\xc8\x32\xb2\xfe\x22\x2f\xb8\xa0\xf6\xd0\x19\x34\xac\xbd\x71\xad\x7d\xc7\x85\x41\x55\xb2\x1c\x9f\x36\x3e\x8e\x20\x62\x9d\xa9\xa6\x86\xad\x22\x42\x80\xa8\xd3\xa8\xa6\xdb\x42\xeb\xc1\x82\x68\x54\x53\x51\x06\xd1\x6e\xe5\xf5\x6a\x9b\x49\x38\xfa\x19\x42\x7b\x9d\x80\x0f\xbf\x12\x30\x66\xbc\x1f\x56\x14\x5f\x99\xbe\x98\xfc\x98\xf7\xc1\xbf\x6b\xd2\x6c\x98\x01\xaf\x47\xe0\xcc\x36\xf4\x1f\x2f\xa1\x49\x2d\x83\xf0\x66\x0e\x82\xd7\xae\x48\x02\x4c\xbd\x99\x13\x6a\xd8\xe9\x96\xd8\xdb\x24\xe9\xc2\xd6\x51\x4c\x1d\x95\xec\x82\x5e\xa2\xee\x6a\xb3\x8b\x5a\x30\xc3\x26\x80\x4a\x51\x0d\xd2\xaa\x4b\xbf\x30\xa5\x2b\x56\xc7\xbb\xe0\xce\x76\x17\xdd\xc2\x13\x34\x99\xcf\xc7\xa8\x36\x58\x97\xf7\xf0\x00\x73\x20\x67\xd6\x66\x33\x04\x36\xf4\xd4\x1c\x58\xdb\xa2\x28\xe2\xfe\x64\x02\x48\x0e\x36\xb6\xfd\xdc\x5c\x1b\x3a\xd0\x8f\xbd\x45\xae\x78\x6b\xb6\x43\x0f\x74\x85\x75\x0d\xda\x1d\x9b\x8a\x19\x68\xd8\xbd\x9f\x67\xb4\xeb\x68\xf6\x99\xca\xee\x5e\x0f\x05\x9d\xa6\x05\xf8\xa0\x73\x66\x52\xb8\xda\x9d\x60\x13\x67\x28\x85\x51\xb2\xae\x69\x2d\x16\x85\x42\xad\xc9\x9e\x89\xc2\x4a\x1b\x59\x60\x0d\xd7\xd7\x27\x87\xc0\x14\x82\x61\xf7\x28\xa0\x54\xb2\x01\x14\x6b\xae\xa4\xb0\x8b\x7c\xcd\x14\x67\xcb\x1a\x87\xa1\x88\xf0\xce\x77\x7c\xe2\xd3\x88\x13\xbf\x06\xe9\xbe\x69\xdc\x2c\xbb\xd2\x9f\xe8\xf4\xcf\x8e\xd7\x05\xaa\x30\x28\x1b\x93\x1e\xb7\x8a\x0b\x53\xc6\xff\x5d\x76\xe5\x04\xa2\xff\xbc\x99\x2d\xb9\x98\xe9\xea\x56\x44\xc9\x7e\x0d\xf8\x4c\xc9\x1f\xe8\xf8\xa0\x48\xd2\x03\x4d\x7b\x92\xb9\x0d\x3e\x4a\xce\xae\x1d\x47\xc4\xad\x88\x26\x80\x7e\x8a\xd1\x53\x5f\xfb\x80\x7e\x74\xbd\xe4\x68\x81\x06\x6c\x7f\x7e\x3e\xff\x7a\x75\x79\x7e\x76\x76\x74\x09\x71\x25\xb5\xc9\xe8\xcb\x2a\x99\x38\xe1\x97\xf3\xc3\xa3\xb3\xef\x44\xda\xe4\x95\xa0\x77\x76\x0e\xec\xee\x9b\x25\x96\x52\x21\xa8\x4e\x08\xa2\x8c\x9b\xf4\x65\xa0\x57\x5c\xd0\x85\xf7\xcb\x17\x74\x25\xbb\xba\x80\x5a\xca\x7b\xa8\xf9\x3d\x82\xa9\xb8\xce\x5e\xc1\x35\x07\xda\x32\x65\x7b\xfb\x74\x71\xfe\x35\xde\x96\x7b\xb2\xdf\x48\xa3\x81\x29\xbe\x88\xf9\xe4\x25\x7e\xab\x10\xee\x76\x6d\xdc\xdc\xf9\x09\x60\xfd\x3d\x61\x6a\x87\xee\x04\x86\xc9\xb0\xa1\xd2\xd9\x07\x7b\x6b\x72\x66\xe0\xe3\xc7\xa3\xf3\xe3\x5b\x41\x41\xdb\x87\x9d\xc8\x4b\x7a\xa2\xa0\xe9\x0b\xe4\x1b\xe3\x66\x58\xe9\x03\x3f\x9e\x73\x8d\x82\x3e\x8c\xad\x4c\xe0\xa3\x71\x26\xd6\x1e\xa4\x82\xbc\x96\xba\x17\xef\x7c\x86\xed\x0f\x4c\xd7\x88\x2d\x7c\x70\x79\x6f\xf6\x92\xb2\x81\x7f\x5c\x5d\xc2\x74\x2a\xe4\x34\xaf\x30\xbf\x87\x69\x0e\xb7\xd1\x83\xd6\xd9\x6c\xf6\xf6\x59\xc1\xcd\x6c\x4f\xce\xde\x3e\x2b\xb5\x19\x6b\xf9\x6d\xe4\x1c\xf9\x79\xb2\xec\xca\x74\x61\x5b\x2c\x4e\xfc\x4c\x19\x18\x19\xc6\x0a\xe5\x49\x14\x41\xff\x27\x01\x7d\xbe\x31\x01\x97\x17\x9f\xfb\xac\xa9\x9d\x28\xe9\x1a\xa1\xe6\x02\x7d\x93\x3f\x23\x17\x46\x3b\x70\xdc\xeb\x2f\x8f\x60\x6b\x96\x84\xfd\x88\x1d\x0d\x6e\x1f\x3f\x91\xb5\xf0\x64\x45\x39\x13\x42\xd2\xc4\xb3\xc6\x2e\xb4\x0c\x0e\xd6\xd4\xca\x4a\x25\xe3\x39\xea\x9c\xc7\x05\x33\x2c\x09\x37\xe1\xbf\x03\x00\x68\x33\x34\xe1\x07\x0d\x00\x00")
+
+func apidocExampleGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocExampleGo,
+		"apidoc/example.go",
+	)
+}
+
+func apidocExampleGo() (*asset, error) {
+	bytes, err := apidocExampleGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/example.go", size: 3335, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocFamiliesGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x57\x4b\x6f\xdb\xba\x12\x5e\x4b\xbf\x62\xae\xef\x5d\x48\x8d\x2a\xb7\x05\xee\xe2\xb8\xf1\x22\x2d\x1a\xe0\xe0\xa0\x3d\x41\xd3\x76\x63\xf8\x34\xb4\x38\xb2\xd8\x48\xa4\x40\xd2\x71\xdd\x20\xff\xfd\x60\x46\xd4\xc3\x71\xfa\xd8\xb4\x1e\x0d\xe7\xf1\xcd\xc7\x6f\xd8\x56\x14\xb7\x62\x8b\x20\x5a\x25\x4d\x11\xc7\xaa\x69\x8d\xf5\x90\xc4\xd1\xcc\xe2\x16\xbf\xb7\xb3\x38\x9a\x39\x63\x3d\xff\xed\x6d\x61\xf4\xdd\x2c\x8e\xa3\xd9\x56\xf9\x6a\xb7\xc9\x0b\xd3\xcc\xad\xd9\xb6\xd8\xb6\x38\x17\xad\x2a\x4c\xd3\x0a\x3f\xff\xe6\x8c\xf6\x87\x16\xdd\x2c\x4e\xe3\x78\x3e\x87\x4f\x87\x16\x2f\x45\xa3\xea\x03\x54\xa6\x96\x0e\x7c\x85\x60\xf1\x4e\x39\x65\xb4\x03\x53\x82\x00\x3a\x00\xbe\x12\x1e\x2a\xe1\x60\x83\xa8\xa1\xa8\x84\xde\xa2\xa4\x08\x9b\x03\x48\x2c\x6a\x61\x95\xde\x82\x00\x8d\xfb\xee\xc0\x5e\xf9\x0a\x04\xdc\xa1\xa5\x50\xe0\x76\x65\xa9\xbe\x83\xd1\xa0\xbc\x03\x2d\x1a\xcc\x40\x38\x0a\xc0\x8e\xad\xb0\xa2\x71\xf9\x85\x94\x17\x6d\x5b\xab\x42\x78\x65\xf4\x67\x4d\xbe\x42\xcb\x5f\x7c\xfe\xf2\xff\x8c\x82\x38\xd3\x55\x68\x6a\x89\x16\x4a\x51\x08\x89\x7d\x6e\x07\x85\xd0\x70\x8b\xd8\xc2\xce\x51\x95\xd4\xa3\xa9\x25\x18\x8d\x79\xcc\xc5\x4e\x60\x70\xde\xee\x0a\x0f\xf7\x71\x34\x9f\xc3\x1b\xe1\x70\x02\x0c\x95\x4d\x98\x50\x80\xa1\x49\xb3\xf3\xec\x2b\xf4\xe1\x51\xb7\x39\x7c\xaa\xd0\x22\x34\xe2\x00\x1b\x04\x6d\x18\x19\x76\xe6\xa6\xb9\x62\x8a\x99\xc7\x11\x67\x1a\xc6\x93\x53\x41\x1f\x44\x83\x31\x7b\x7f\x1c\x06\xf2\xd4\x90\x94\x06\x63\x25\xda\x3c\x8e\x46\xc7\xd5\x9a\x42\xf4\xbf\xe3\x87\x61\xda\xbd\x29\xb4\x65\xf4\x18\x6a\x32\x6e\xa5\x41\x4c\xc8\x31\x81\x69\x38\x3f\x02\x45\x7e\xbf\xad\x7d\x02\xe3\x31\x4a\x3d\xa0\x04\x44\xc6\xed\x1a\x0b\x3f\xd0\x1a\x28\x8d\x3d\x81\x1a\x44\x0f\xee\xd8\x2d\x28\xed\xbb\x64\x9f\x1d\xca\x37\x53\x2a\x07\x26\x34\xe8\x2b\x23\x1d\xec\x2b\xe3\x30\xb0\x09\x8c\xe5\x33\x16\xdd\xae\xf6\x0e\x2c\x96\x68\xc1\x9b\x21\x65\x06\x52\x59\x2c\x7c\x7d\x00\x63\x41\xe9\xfe\x57\x1e\x47\x21\xcf\x6a\xfd\x9e\x03\x7f\xc4\x12\x6e\x08\x80\xc5\x2c\x33\x8d\xf2\xd8\xb4\xfe\x30\xbb\x09\xa8\x87\x76\xaf\xb9\xec\x2b\xe1\xa1\x11\xbe\xa8\xd0\xf5\x58\x53\xe3\x8f\xae\x0b\x0d\xab\x6b\x33\x03\xb7\x2b\x2a\x10\x0e\x9e\x24\x7f\x1e\xdf\x09\x7b\x9a\x61\x09\x9d\x4a\xe4\xef\x77\xce\xbf\x35\x4d\xab\x6a\x4c\x6e\xfe\x49\xf2\x67\x2b\xf1\xfc\xc7\x3a\xfd\x92\xac\x5e\x3c\xff\x63\x7d\x96\xfe\xef\xa6\xd3\x81\x4b\xa5\xe5\x30\x6e\x85\x84\x86\xdf\x59\xed\x46\xfc\xcb\xfe\x8b\xd2\xa0\x74\x69\x32\x20\xf9\x41\x09\x9b\x03\x05\xd8\x10\x7f\xa9\x93\x1c\x2e\x3a\xdf\x03\x28\x47\x23\x6c\xd8\x67\x18\xb1\xe4\xa6\x39\x0c\x99\x1c\x91\x7c\x3e\x87\x5e\xf0\x82\xce\xdc\xd1\xbf\xba\xaf\x7c\x0a\x44\x2b\xac\x87\xd2\x9a\xe6\x44\x54\x58\x00\x84\x83\xda\x90\x02\x71\xc9\x16\x29\x79\x63\x2c\x85\x11\x9a\x6e\x7a\xa0\x59\x93\xc7\xe5\x4e\x17\x90\x50\x0f\xf0\xec\x4f\x5d\x9a\xf4\xa4\xfb\x24\x0d\xf7\x27\x68\xc2\x7d\x1c\xa9\x92\xbb\xce\x09\x24\x3a\x04\xcb\x25\x68\x55\x13\xfd\xa3\x0e\x2b\xfa\x19\x47\x0f\x71\xb4\x39\xf0\x65\x5e\x2c\xa1\x11\xb7\x98\x34\xa2\x5d\x9d\x5e\x8e\xf5\xf1\x05\x4d\xe3\x88\xd8\xce\xad\x2e\x96\x60\x49\x5e\x8f\x13\x72\x66\xc7\xf9\x08\xeb\x8c\x6e\x2d\x2c\x96\x41\x4a\x5f\xc4\x11\x95\xd8\x90\xe5\x31\x1b\x72\x6a\xef\xda\x93\x40\x5f\xef\x36\x4c\xbe\x84\x4e\xe5\x54\x47\x92\xa6\xaf\xa1\x81\xff\x8c\xdd\x70\x78\x58\x3e\x71\xa1\xbb\x53\x57\xb7\xdb\x2b\xe1\xab\x24\x85\x33\x98\xfd\x77\x06\x67\xd0\xac\x5e\xae\xd3\x38\x8a\x22\x8b\x77\x19\x7c\x85\x25\x84\x8d\x94\x5f\x78\xa3\x92\x66\xf5\x8a\x3f\x3f\x50\xe9\x8c\xcd\x8a\x52\xac\x61\x09\xa2\x6d\x51\xcb\x64\x6a\xcd\x8e\x54\x86\x0b\x22\xc3\x02\xe8\x4f\xd0\x88\x68\xb8\xfa\x0b\x82\x81\x2c\x0f\x29\x63\x4f\xb7\x61\x60\xea\x74\x86\x71\xb4\x73\x68\x1d\xe1\xc3\xb0\xd2\x34\x3e\x93\x25\x09\xd0\x0f\xa0\xb2\x4f\x37\x80\x30\x49\x2a\x42\x95\x50\xa3\x4e\xe8\x7b\x0a\xe7\xf0\x8a\x07\x11\x15\x46\x7b\xa5\x77\x18\xba\xa3\x1b\x91\x5f\xd7\xaa\x40\x76\xcc\x80\xa8\x96\xa8\x0c\xbe\x91\x44\xa5\xb0\x31\x26\x60\x1c\x28\x43\x5e\x2b\xb5\xce\xfb\x76\xe0\x9c\xfa\x71\xab\x6f\xa3\x29\xf4\xc6\x35\xaa\xb1\x34\x72\xeb\x43\x75\x31\x82\x26\x2d\x81\x1b\x5d\xf5\x66\x82\x60\x1d\xea\x1b\x90\x19\x90\xef\x2d\xd9\x44\xec\x39\x2a\x35\xde\x61\xde\x21\x33\x05\xdd\x31\xea\x6e\x02\xfb\xa4\xf1\x31\xe2\xcf\x9a\x0f\xbd\xf7\x8e\x54\x24\x65\x83\xf3\x61\x72\xd4\x3f\x99\x62\x6e\xfd\x91\x7f\x50\xd5\x61\x80\x47\x52\xd5\x0b\x3d\xcb\xc8\xa0\xe8\x28\x8a\x6a\x22\x3e\x2c\x18\x4a\x87\xe5\x90\x0d\x72\x42\x2f\x8d\x2e\x40\xbf\x50\x4f\xa5\x62\xc2\x1b\xf8\xe9\xcd\x1e\xb7\xc2\xfd\x84\x77\xbf\x56\x83\xe1\x4c\xe0\xe3\xd7\x0c\xca\x71\xdc\x54\x42\x7e\xc9\xf5\x76\x1a\x10\x5c\x9a\xd1\xa5\xcc\xdf\x87\xe6\xe9\x3b\xa5\x95\xbf\xcb\x4a\x7c\x24\x6e\x45\x1c\x5e\x48\x49\x1c\xa2\x3e\x5c\x42\xc7\x33\x68\xf2\x2b\xda\x95\xbf\xf1\xf9\xc8\x1b\x94\x9d\x4e\x45\x8c\x02\x71\xc5\x5c\x92\x75\x2b\xfa\x3c\xb9\xfb\x13\x63\x06\x03\x08\xf7\x25\xab\x53\x06\x65\xfe\xa5\x9b\x0e\x15\x43\x26\x62\x44\xc7\xe6\x07\xa6\x5e\x9f\x91\xee\x6e\x39\xb9\xbb\x1c\x97\x13\x1f\xa7\x25\xaa\x0e\x69\x5c\x42\x87\x3a\xe9\x08\x34\x63\xef\xc0\x31\x82\xe2\xdd\x9d\xa9\x77\xb4\x71\x8f\x78\xd6\x3d\x80\xe9\x35\xec\xf7\xf4\x20\x66\x86\x4d\xdf\x51\x64\xe8\x69\x3a\xec\xc3\x6e\x73\x32\xcf\x68\xbb\xd1\x56\xda\x60\x49\x5b\x4a\xf9\x1c\xde\x1d\xc5\x50\xfc\x38\xb6\x48\xef\x7e\x94\xfd\xfb\x60\xd0\x46\x94\xe1\x11\x9e\x41\x69\xea\xda\xec\xc7\x2d\xdb\xd7\xe6\x0d\x45\xa0\x27\x74\xa9\xb0\x96\x2e\x83\x7d\xa5\x8a\x6a\xbc\x16\xe4\xac\x71\x8f\x76\xc8\xfa\x14\xe3\x8f\x40\xe0\xcd\xf8\x96\x13\x10\xb6\xfd\x9d\x1c\x64\xf5\x74\x93\x76\x9a\xdc\xd7\xd4\x1f\x1e\x39\x2e\xa6\x14\xee\xc3\xf5\x0c\x67\xc1\x7b\xf9\x1a\x14\x9c\xb3\xfc\x96\xa2\x19\x84\xd1\xa5\xaf\x41\x9d\x9d\x51\x19\x51\x64\x6a\x99\xf1\xff\x3d\x16\x4b\x38\x72\x5a\xa9\xe7\x2f\x3b\x11\xcc\x1e\x7f\xe8\xcc\x74\xba\xaf\x6e\x20\x65\x30\x64\xd0\x55\xcb\x29\xa2\xbf\x94\x96\x8b\xe9\x04\x48\x00\xfb\xed\xa4\x71\xdf\xfd\xfc\xbb\x96\x0b\xa0\x05\xa8\xf4\x36\x31\xb5\x4c\x3b\xf3\x07\xdc\x8f\x66\x8d\xfb\xce\xfc\x90\xfe\x3a\xbd\x54\x65\x79\xc9\xc3\x4b\x28\xc1\x53\x2f\x82\x95\xa9\xe5\xfa\xe9\x2f\x1a\xf7\xeb\x34\xcf\xf3\xb0\x7c\x47\x8e\x17\x95\xd0\x5b\x74\xf1\x43\xfc\xef\x00\xa7\x0f\x96\xcf\x68\x0e\x00\x00")
+
+func apidocFamiliesGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocFamiliesGo,
+		"apidoc/families.go",
+	)
+}
+
+func apidocFamiliesGo() (*asset, error) {
+	bytes, err := apidocFamiliesGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/families.go", size: 3688, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocFieldsGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x57\x4d\x6f\xdb\x3c\x12\x3e\x4b\xbf\x62\x5e\x1f\x52\xbb\xd0\x2a\xb1\x8f\xd9\xf8\xb4\x8b\x02\xdd\xee\x47\xd1\x06\xdd\x83\x37\x28\x68\x6a\x68\xd3\x96\x48\x95\xa4\x93\x1a\x69\xfe\xfb\x62\xf8\x21\x51\x6e\xd3\xb7\x28\x10\x91\xf3\xc1\x99\x67\x9e\x19\xd2\x3d\xe3\x47\xb6\x43\x60\xbd\x6c\x34\x2f\x4b\xd9\xf5\xda\x38\x98\x97\xc5\xcc\x6a\xe3\x66\xf4\xd7\x19\xa9\x76\x76\x56\x96\xc5\x6c\x27\xdd\xfe\xb4\xad\xb9\xee\xae\x8d\xde\xf5\xd8\xf7\x78\xcd\x7a\xc9\x75\xd7\x33\x77\x7d\xb0\x5a\xb9\x73\x8f\x76\x56\x2e\xca\xf2\xfa\x1a\xde\x49\x6c\x1b\x30\xe8\x4e\x46\x59\x70\x7b\x04\xa9\x84\x36\x1d\x73\x52\x2b\x30\xc8\xb5\x69\xb0\x01\xa1\x8d\x17\xee\xe4\x23\x2a\x10\x64\x44\xd6\x5a\x64\xbb\xe4\xb7\x02\x6d\x40\xc9\x16\xa4\x97\x18\x04\x69\x41\x69\x85\x75\x29\x4e\x8a\xc3\x9c\xbc\xc3\xdb\xf7\x4a\xe8\x45\x38\x7b\xee\x60\x08\xaa\xbe\x3f\xf7\xf8\x6f\xd6\x61\x15\x8e\x80\x90\xd8\x02\xde\x7a\x55\xb2\x82\xe7\xb2\xa0\x60\x24\xdc\xae\xc1\x30\xb5\x0b\x01\xd7\x5e\xc1\x92\xb4\x10\x24\xba\xca\x76\x37\xf2\xa1\x2c\x0a\x29\x40\xf8\x03\x60\xbd\x06\x07\x57\x57\x20\x82\x9c\xd6\xe1\x38\xb2\x2e\x02\x16\x20\xca\xa2\x78\x29\xe9\x7f\xdc\x50\xb2\x2d\x5f\x3c\x66\x5c\x77\x5b\xa9\x30\x1e\x99\xb0\x13\x16\x9e\xa4\xdb\x53\xda\x80\xca\x19\x89\xd6\xc3\x86\x8c\xef\x47\xc4\xa2\x6d\x03\x52\x39\x0d\x5a\x61\x05\x4c\x35\xc0\xb5\xb2\xce\x30\xa9\x1c\x15\x81\x39\x68\xa4\x10\x68\x40\xab\xf6\x0c\x52\x91\x25\xf9\xed\xd0\xed\x75\x13\x55\x3a\x76\x44\x3a\xad\x1b\x9d\xb6\xf2\x88\x4f\xd2\x62\x0d\xf7\x6c\x07\x47\xa9\x1a\x4b\xa6\xcc\xe0\xc5\xc1\x0c\x88\x3b\xde\xc2\xba\x1a\xfe\xbb\x47\x95\x82\x26\x83\x46\x5a\xb6\x33\x88\xa0\x15\xf4\x06\x2d\x2a\x8e\x15\x7c\xc2\x6f\x27\x69\xb0\x81\x27\xa9\x2c\xe8\x47\x34\xf0\x9f\x9e\x78\xc2\xda\x0a\x98\x05\x46\xa6\x8f\xac\x3d\x51\x58\xcc\x51\xe9\x03\x5f\x88\x03\x48\xea\x4f\x46\xab\x5d\x0d\xf7\x7b\x04\x83\xf6\xd4\x3a\xb2\x90\x36\x05\xb3\x3d\x03\xf1\xc0\x23\xe2\x11\x03\xe5\xc9\x40\xa9\xe7\x08\x69\xe1\x51\x25\xe3\xa0\xb6\x3d\xfb\x64\xbd\x61\x83\x96\x1b\xe9\xe3\x0a\xd8\xba\x3d\x4a\x33\x40\xb7\x3d\x83\x60\x9c\x35\x58\x91\xf9\x23\x1a\x4b\x44\x27\xbd\xa0\xe1\x8f\x8c\x6c\x8d\x98\x85\x42\xcf\x85\x85\xcd\xc3\x40\xc4\x45\xbe\x20\xde\xf9\xc8\x7d\x38\x1f\xf0\x4c\xcc\x3d\x71\x47\xfb\x85\x03\xfa\xf7\x33\xc9\x89\xaa\x19\xcb\x3d\xd7\x48\x23\x4b\xf5\xc2\x13\xe5\x58\x4d\x13\xec\x99\x73\x68\x54\x15\x70\xb7\xb9\xaf\x47\x66\xc6\xaa\x67\xc1\x96\xe1\xd8\xf7\xaa\xc1\xef\xd4\x2a\xc4\xa3\x79\xc7\xfa\x4d\x0a\xfe\x41\x2a\xb7\x28\x8b\x31\x8c\xdf\xa8\xd2\x62\x12\x6f\x34\x26\xe6\x7f\xad\x40\x8c\x6d\x2a\x62\x77\x1e\xf1\x4c\x9b\xc9\xc3\x73\x68\xca\x2a\x75\xe3\x0b\xb5\x6a\x05\xfa\x38\x28\xf9\xd3\x37\xe2\x88\xe7\xd8\xc6\x7f\xe8\xa3\x77\x55\x48\x58\x43\x8b\x6a\x9e\xb2\x5c\xd0\xe6\xa5\x0d\xac\x41\xd2\xfe\x45\x3a\x83\x70\xc8\xe9\x57\x69\x14\xc5\x80\xe0\x1a\x58\xdf\xa3\x6a\x86\xd3\x2a\x18\x20\xf5\xd1\x14\x94\xc7\x2d\xc4\x29\x53\xf9\x2d\xaf\x71\x9b\x72\xf3\x7b\x2f\x14\x25\x65\xc9\x3d\x38\x57\xc9\xdd\x30\xa5\x52\xc3\xfd\x13\xad\x9d\x73\x51\xc1\x95\x58\x84\x7c\xb9\xa8\x3f\x0e\xdd\x98\x2d\x3e\x21\xb3\x5a\xc1\x1a\x72\xf9\xa5\x78\x38\xb5\xbe\x67\xbb\x0f\x34\x1a\xb2\x94\xc6\xcd\x0a\xc6\xef\xba\xae\x29\xd8\x58\x4b\x9e\xd5\xb2\xfe\xdb\x00\x96\x8d\xb1\xc5\xba\x4e\x50\x7c\xe6\x35\x39\xad\x80\xd7\x7f\xcf\x59\xcb\xeb\x8f\x89\xb7\xf1\xee\xaa\xff\xa1\xa5\x9a\xf3\xfa\x8b\xa7\x71\x05\xb3\xff\x7d\xbf\xb9\x99\x2d\x08\xa7\xe2\x90\xe8\xf0\xcb\x12\x6e\x78\x64\xc6\x84\x1a\xc5\x21\x71\x63\x12\x2b\xa5\xf3\x0a\x15\x82\x1f\x58\xc3\x21\xe8\xd4\xff\x8a\x13\x63\x4d\x17\x5a\xd8\x9b\xf8\x9a\xc0\x97\xed\x57\xc0\xfd\x31\x3e\x76\xee\x51\xbb\x9a\x6a\x6c\x0e\x54\xea\x82\xe7\x67\x24\x57\xc3\x5e\x05\xc3\x67\xac\x43\xb8\x8e\x2e\x6e\xbf\x44\x1f\x5f\x85\x8c\x4b\x43\x11\x61\x0d\x27\x25\xbf\x9d\xf0\x73\x40\x7a\xfe\x2b\x25\x0a\x98\x5b\x0a\x35\x97\x66\x21\x47\x1a\x1c\xb2\x83\x53\xe1\xed\xe6\xf0\x90\x22\x85\xb5\x9f\xe5\x61\xf5\x09\x85\x9d\x4f\xc4\x89\xfa\xa4\x53\x7f\x6e\x25\xc7\xcf\x8e\x6d\x5b\x9c\x73\x5b\x01\x8d\xdc\xb9\xac\xe0\x40\x77\xe3\x02\xb6\x5a\xb7\xb1\xcd\x05\x70\x4b\xf1\x50\xa8\xf0\xc7\x9a\x56\x87\xb8\xf2\x0a\xe9\x8a\xce\xb4\xee\x32\xa5\xa1\x18\x13\xb5\x8c\x90\x83\x76\xb6\x47\x91\x2e\x3c\xe0\x3f\x07\x1b\x21\x7a\x3d\x64\xb1\xac\x40\xac\x2e\xdb\xbb\xca\x56\x87\xd8\xec\x62\x59\xd3\xd8\xa0\xac\xc4\x2a\x7c\x4e\x5e\x21\x51\x7c\x97\xa4\x11\xc0\x51\xec\x27\x4c\x90\xfb\xcf\xd2\x87\x1d\xe5\xe9\xb8\xf8\x70\xc9\x27\x0b\x18\xec\xb5\x71\x16\x9e\xf6\x48\x2f\x35\x7a\x49\x0c\x77\x3d\x68\x8a\x0c\xec\x5e\x9f\xda\x06\xb6\x48\x57\xa5\xc1\xbe\x65\x3c\xde\xd2\x7b\xe6\xbc\xce\x8a\xcc\x15\xd9\x9e\xdf\x64\x8f\x8c\x78\x7f\xe6\xe7\xcd\x23\x26\xe3\x53\x6e\xc4\xcb\x30\x15\xc6\x3d\x31\xa0\x8f\x23\x61\x41\x34\x20\x69\x61\x9f\xa4\xe3\x7b\xe8\xfd\x82\x33\x8b\xc3\x4b\xe4\x36\x83\x6a\x95\x84\xe9\x4d\x92\x0b\x97\x53\xdc\x6e\x7c\x61\xa5\x00\xb3\xac\xc0\xac\x22\xa9\x8f\x73\xb1\x1c\x86\xe6\xa2\x8a\x5b\xab\x71\xeb\xaf\x60\x96\x54\x29\xb3\x82\xe7\xd1\x99\x59\xc2\x1d\x98\x55\xfe\x56\xcc\x8c\x48\x7f\x36\xf3\xcf\xcd\xd5\xe5\xc0\xbe\x03\xb1\xbc\x9c\xd2\xa1\x52\xd3\x26\x1a\xde\xe7\xdd\xf0\x54\xf2\x4f\xcd\xe6\xd4\xb7\x92\x33\x87\x16\x0c\x76\xfa\x71\x00\xfe\xa2\x07\x3b\x7a\xb9\x0c\xeb\x45\xbe\x80\xe7\x9c\xe0\xf3\xee\x37\x7d\xd8\x2d\x2b\xe8\x3c\x56\x1d\x35\x50\x05\x5d\x1c\x64\x52\x40\xb7\xac\xdf\xf9\x57\x15\xa5\xdb\xad\xd2\x22\xa7\xf2\xa8\x72\x37\x6a\xc4\xb2\x04\x0f\x5f\xe2\x63\x2c\xb8\x48\xab\x0b\x1f\x69\xfb\x2e\xd3\x99\x16\xb7\x5b\xc6\x71\x13\x74\xc2\x77\x6a\x0b\x7a\x74\xc6\x14\x6e\x6f\x1e\xe2\x34\xad\xa0\x1b\xe7\x5a\x17\xe6\x9a\x14\x20\xe9\x97\xc1\x0d\xfc\xf8\x01\x9d\x4f\xcb\x6e\xe4\x5f\x96\x0f\x29\x20\xef\x69\x98\xdb\x61\x5d\x41\x37\x0e\xea\x18\x4e\x90\xc4\x0e\x9c\x4c\xe2\xa1\xac\xf6\xb5\xb2\x12\x15\x62\x65\xf3\x5f\x56\xd2\xbd\xb1\x80\x5d\xef\xce\xb1\xde\xd3\x01\x6f\xa9\xdc\xa9\x89\xd2\x17\x85\x2d\x85\xbf\x12\xad\x5d\x84\xcc\x32\x12\xfb\x2b\x6e\x18\x76\x83\xa3\x09\x66\xd6\x6e\x6e\x97\x11\xb3\xaf\x15\xd8\x11\x33\x6b\x37\xcb\xdb\x87\x84\x9b\x25\xb4\x42\xda\x1b\x3a\x2f\x7c\x2e\xfe\x14\x3b\xfb\x3a\x76\xff\x1f\x00\xc2\x96\x12\x69\x05\x0f\x00\x00")
+
+func apidocFieldsGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocFieldsGo,
+		"apidoc/fields.go",
+	)
+}
+
+func apidocFieldsGo() (*asset, error) {
+	bytes, err := apidocFieldsGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/fields.go", size: 3845, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocFilterGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x58\x5f\x8f\xdb\x36\x12\x7f\x96\x3e\xc5\x64\x1f\x36\xd6\x42\xb5\x9b\xd7\x3d\xf8\x80\x43\x2f\x0b\x14\xb9\xe4\x82\x36\x77\xf7\x60\x18\x05\x57\x1a\x5a\x4c\x24\x52\x20\x69\xfb\x8c\xd4\xdf\xbd\x98\x21\xa9\x3f\xb6\x77\xdb\x3c\x6d\xe6\xcf\x6f\x66\x7e\x33\x43\x52\xee\x45\xf5\x4d\xec\x10\x44\xaf\x6a\x53\xe5\xb9\xea\x7a\x63\x3d\x2c\xf2\xec\x6e\xa7\x7c\xb3\x7f\x5e\x56\xa6\x5b\x59\xb3\xeb\xb1\xef\x71\x25\x7a\x55\x99\xae\x17\x7e\xf5\xd5\x19\xed\x4f\x3d\xba\xbb\xbc\xc8\xf3\xd5\x0a\x9e\x54\xeb\xd1\x82\x45\xbf\xb7\xda\x81\x80\xca\xf4\x27\x30\x12\x94\x96\x06\x2c\x3a\x6f\x55\xe5\xb1\x06\x6f\xc0\x37\x08\x52\x54\xa2\x46\x07\x42\xd7\xd0\xa1\x6f\x4c\xed\xf2\xd5\x0a\xa4\xb1\x70\x6c\x54\xd5\xc0\x37\xc4\x7e\x80\xf3\x76\x8f\x4b\x78\x32\x16\x50\x54\x4d\x74\x2e\x83\x8d\x72\x20\x95\x75\x1e\x2a\xd1\xb6\x58\x53\x32\x47\xe5\x1b\x10\xa0\x55\x1b\xb1\x29\x6a\x8d\x95\xaa\x11\x8e\x0d\xfa\x06\x2d\x49\xd8\x7d\x4c\x06\x84\x07\xd1\xb6\x25\x08\xcd\x28\xbe\x41\x1d\xa0\x38\x28\xd5\xe2\x5d\x04\x74\xa0\x34\x50\x6e\xcb\x7c\xb5\x22\xe3\x2f\x44\x06\xf8\x86\x30\x2c\x82\x36\xd0\x1a\xbd\x63\x46\x44\xd5\x88\xe7\x16\x41\x5a\xd3\x71\xed\x16\x3b\xa1\xb4\xd2\xbb\x01\x4c\x58\x24\x10\x8b\x9d\x39\x60\x1d\x2c\x09\xf1\x67\x2d\x4d\x09\xc2\x31\xe6\x51\x58\x72\x0a\x9c\x49\x51\x79\x63\x4f\xd0\x0b\xad\xaa\x18\xd8\xa2\x44\x4b\x38\xde\xa4\x92\x0e\x68\x9d\x32\xda\x81\xb1\xe0\xc7\x14\x1b\x71\x40\x78\x46\xd4\x29\xe4\xdf\x40\x24\x7c\x2e\x99\x50\xb4\x81\xff\x06\xf7\x80\xec\x88\x33\x3c\xa0\x3d\x25\xd8\xc4\x49\x08\xb6\x84\x9f\x8c\x76\xde\x0a\xa5\x3d\xf3\xf3\xa4\xb0\x0d\x6d\xa5\xf4\xbf\x61\xef\xc1\xe8\xf6\xc4\x4d\xbe\xc9\x03\x53\x0f\x0e\xb5\x57\x1a\x5b\x40\x6b\x8d\x75\xec\x43\x20\x4a\x82\xb8\xf2\x81\x4a\xe8\x38\x75\xc4\x6d\xb7\x84\xf7\xe4\x05\x95\xe1\xe9\x8a\x71\x4b\xf2\x27\x1a\xf5\x29\xf9\x75\xe2\x94\xfc\x84\xe6\x49\x25\xf7\x81\x6c\xd3\xa3\x15\x5e\x19\x2d\x5a\xf2\x75\xe8\x3d\x71\x5f\xc6\xe9\x14\x7d\xdf\x9e\x46\x3e\x2a\xa3\x35\x56\x64\x5e\x82\xd8\xd7\xca\xff\x80\xff\xaf\xda\x7d\x1d\xc6\x71\xa8\xee\xa6\xef\xbc\x53\x81\x82\xc9\x86\x10\x80\xa8\x0f\x68\xbd\x72\x58\xc3\xf3\x89\xca\x04\x87\xf6\x80\x36\x21\xd6\x46\xbf\xf5\x50\x63\x8f\xba\x06\xa3\xe1\xd8\x08\xff\x96\x89\xaf\x4d\xb5\xef\x50\x7b\xac\x97\xf0\x73\x5c\xc6\x86\x4a\x04\x8d\x3b\xe3\x15\x97\x08\x9e\xe7\x33\x8e\x08\x48\xd1\xa9\x56\xa1\x2b\xe3\x12\x9c\x98\x10\x8b\xb4\xfa\x7b\x9f\xc6\x93\x62\xc4\x6e\x38\x5a\x02\x78\x0a\x75\xc4\xa6\xa5\x8e\x33\xa1\xb4\x70\xf8\xd6\x81\x1e\xe6\xd2\x1b\xe8\x85\x73\x69\x0b\x97\xb9\xdc\xeb\x0a\x16\x9c\xdf\x03\x4d\x7d\x11\x4f\x94\x05\x2f\x29\x69\x17\x12\x1e\x42\x0c\xd2\x97\xd0\xc1\xc3\x47\x26\xb6\x80\x67\x63\xda\x22\xf8\xc1\xf7\x3c\x93\xec\x89\x35\x3c\xae\xe1\x9e\x84\xdf\xf3\x2c\xfb\xb5\x6a\xb0\x13\x71\xa0\x1f\x99\x89\xe5\x4c\x56\xe6\x59\xf6\x11\xbd\x78\x84\xe1\x1f\x1b\x91\x8c\x74\xff\xc4\x76\xaa\x64\x1d\xcb\x48\xc9\x43\xf7\x13\xcd\xdc\xe3\xa0\x1c\x65\x64\xf1\xef\x71\xa0\x1e\x93\xc5\x44\x46\x26\xff\x18\xda\x3c\x82\x8c\x32\xb6\xa0\xd9\x7a\x1f\x47\x2b\x16\x31\x93\x91\x51\x20\x89\xa3\xbb\xc7\x08\x33\x95\x95\x79\x76\xce\x33\x6a\x0e\x11\xd4\x89\x6f\xb8\xe8\x44\xbf\x09\x9d\xf9\x80\xa7\x2d\xd3\x19\x2c\x3e\x89\x0e\xdd\xcc\xcc\x79\xab\xf4\x6e\x6a\xf3\x31\x1e\x62\x53\xab\x20\xfb\x05\x65\x32\xa4\x9d\x57\x04\x64\x85\xde\xe1\x34\x29\x47\x2d\xcb\x24\xe9\xee\xa7\xe2\x8d\xda\xe6\x59\xa6\x24\xbc\xa1\x11\x58\xc8\x92\xce\xf3\x82\x8d\xb3\xca\xd0\x11\xb1\xc7\x3c\xa3\x52\x32\xf9\x8e\xbc\x1f\x24\xff\xb9\x4c\xf9\xac\xc9\x81\x44\xc6\xc2\xd7\x31\xb4\x1c\x0c\x18\x4a\x49\x48\xf8\xf7\x83\x6a\xf3\x75\x1b\x23\xcd\x01\x45\x4f\x2b\xb6\x18\x65\xe5\x08\x47\x3e\x04\x38\xe5\x64\xe4\xe1\xbb\x5c\x12\x97\x64\x9f\xe6\x6d\xe6\xca\xda\xf3\x16\xd6\x7c\xd7\x11\xce\x39\x15\x17\xa7\x79\xa0\x6b\x4c\xe3\x42\x53\x82\x7c\x57\xe4\x21\x81\xb1\x9d\xd7\x91\xa7\x61\x86\x26\x6f\x82\xd9\xa8\x3a\xe7\xd9\x41\x58\xd8\xd3\xa9\x43\x2d\x1d\xae\xfc\xe5\x97\x53\x8f\x6c\x4b\xbd\xcd\xa9\x45\xdc\xb7\x74\x61\xc1\x1b\x66\x9e\xf9\x1b\x52\x1c\x94\x6b\x18\x81\x3e\xe1\x91\x64\x0b\x4a\x9a\xe3\x4c\x26\xe8\x85\x70\x45\x6c\xe8\x6f\x25\xc8\x49\x4f\x2f\x98\xe0\xd8\xc9\xae\x7b\xb1\xf7\x19\xe7\x2d\xea\xfa\x3f\x0e\x6b\x0a\xe3\x16\x94\x46\x09\xdd\xf2\xb3\xb0\xa2\x2b\xfe\xc4\xe8\x17\x74\xfb\xd6\x17\xb3\x6e\x19\x0b\x5a\x74\x38\xc6\x24\xe3\x98\xcf\x25\x19\xcc\xa4\xdb\xe8\xc8\xfb\x8c\xc5\xa9\x2e\x60\x9f\xf3\x54\xd1\x71\x44\x67\x9f\xff\xa5\x97\x01\x85\x71\x47\xe5\xab\x86\x23\x56\xc2\x21\x1c\x23\x29\xb0\x5e\xc3\xdd\xdd\xe3\x28\x8e\xd3\x40\xf2\x1f\xe1\xfe\x1e\xde\x8c\xb3\x90\x7c\xb6\x8f\x17\xdb\x76\xe1\xfa\x66\xea\x3a\x19\xb9\xe4\x5f\x8e\xb6\xe7\x2b\x2c\x1a\x6e\x25\xe1\xc8\x95\xd2\xd0\xdc\xdd\x51\x1a\xcc\x57\x1c\x21\x82\xa6\xff\x6f\x82\xd1\xf6\xf6\xf6\x27\x5a\x07\x1a\xae\x37\x24\xa9\x4a\x38\x16\x53\x26\xfb\x0b\x26\x9f\xc2\xb3\xea\x73\x78\x55\x51\x34\x3e\x1f\x66\xc5\xf5\x43\x71\xfd\x58\xdc\x45\x87\xe7\x38\x37\x37\x76\xd4\x97\xd0\x17\x97\x2d\x96\x57\x87\x25\x3f\xa6\x52\x4a\x37\x49\x92\xea\x55\x96\xf8\x90\x94\x2a\xfc\xbd\x9c\xbe\xd5\xa6\x27\xe5\x6f\x25\x54\x63\x68\xa9\x66\x86\x14\x9d\x4f\x85\xf8\xa4\x81\xcd\x76\x38\xe1\x6e\x6f\x5c\x75\xb9\x71\x81\xce\x28\xdc\x74\x31\xd9\x2c\x4b\x88\x03\x57\x51\x50\x42\x5c\xc3\x73\x5a\x33\xc2\x68\x51\x27\x83\x02\xfe\x0e\x3f\x46\x94\x31\xda\x3a\xbd\xba\x58\x7e\x5d\xf0\xd0\x90\x99\xa2\x84\x6a\xb6\xcd\x4a\x02\xb9\x7e\xb6\xe8\x50\x57\x69\x48\x7f\xff\x9d\xe3\x93\xe6\x8b\xd8\x7d\x50\x3a\x25\x31\x51\x4c\x40\x27\x09\x8e\xfd\x0f\xdd\xbc\x31\x18\xac\x28\x29\xec\x38\x13\x68\xed\x6b\x97\xf0\x5f\x3c\x0f\xff\xf4\x38\x8c\x06\xf3\xe3\xab\x5b\x86\x57\x43\x64\x98\x52\x19\x0e\xac\x8b\xab\x6a\x9c\xde\x89\x3f\xaf\xd5\xaf\xf1\x49\x3f\x81\x52\x92\xde\xf7\x6e\x83\xf1\xda\x99\xf3\x73\xe1\x70\xcd\xd3\xdc\xa0\x04\x1c\xd9\x4a\xf7\xd1\xa7\xc9\x0b\xf7\xd6\x95\x34\xd5\xaf\xe1\x96\xf8\x0b\xbd\x8b\x17\xc5\x0c\x95\x36\xec\x29\xbe\x90\x5f\xbc\xe9\x06\x83\x09\xee\x93\xd2\xf5\x54\x17\x71\xe3\x37\x48\x32\xcb\xcf\xfc\x39\x3d\xbd\x6e\x40\xd4\x35\x7d\xb7\x21\x5f\x2c\x8e\x3e\x55\x44\xdb\xb2\x80\x2f\x48\xfa\xd4\x9a\x5d\x1d\x04\x30\x7c\x89\x5e\x7e\x7f\xd2\x93\x9b\x4e\x8b\x5b\x4f\xee\xab\x4b\xee\xb5\x8b\xbf\x04\x0f\x0f\x73\x1d\xbf\x99\x94\x04\x0f\xeb\x91\x98\x50\x60\xe2\xd0\x73\xbb\xe3\x22\x4d\x0e\xb3\x8d\x9f\xce\x41\xf2\x21\xa7\xac\xf6\x34\x4d\xb7\x2e\xc7\xe8\x13\x50\xea\x59\xd4\x21\x2c\x43\xcc\x22\x0c\x73\xeb\x61\x0d\xb5\x0f\x99\xbd\x70\xc7\xfb\xe5\xfb\x16\xbb\xe2\x35\x83\x0f\x78\xba\xb9\x83\x3e\x2d\x39\x55\xf4\x92\xbb\xe4\x92\x78\x12\x42\xe3\xff\x25\x3c\x3a\xff\xd2\xef\x28\x8d\x69\x6b\xfa\x5e\xa6\x4f\x62\x1e\x00\x8d\x47\x74\x9e\x1c\x27\x9f\xe4\xb3\xdf\x48\x84\x1b\x46\x90\x3e\x1e\xc3\x57\xd5\xad\xe6\x87\xc8\x8b\xc9\x77\x54\x84\x74\x03\xfd\xe1\xde\x8b\x77\x9e\x5b\x14\xc3\xf4\xc6\x1b\x8a\xa0\x17\x7f\xe1\x5b\x8d\x3b\x74\x60\xdc\x14\x23\x3d\x3f\x87\x81\x19\x9f\xab\xd4\xd6\x83\xdb\xd0\x91\x7b\x70\xc5\x0f\xef\xb6\x79\x76\x2e\xf2\x73\xfe\xc7\x00\xf1\xea\xaf\x59\xbd\x12\x00\x00")
+
+func apidocFilterGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocFilterGo,
+		"apidoc/filter.go",
+	)
+}
+
+func apidocFilterGo() (*asset, error) {
+	bytes, err := apidocFilterGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/filter.go", size: 4797, mode: os.FileMode(436), modTime: time.Unix(1792001002, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocFlatGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\xc1\x6e\xdb\x38\x10\x3d\x8b\x5f\x31\xf5\x02\x85\x15\x08\x32\x7c\x35\xe0\x43\x80\xa0\x40\x0e\x5d\x04\xdd\x60\x2f\x41\xb0\xa5\xc5\xa1\xc5\x2d\x45\x6a\xc9\x71\x5a\x23\xf5\xbf\x2f\x86\x94\x2c\x39\x75\xd1\x1a\xb9\x88\x33\xef\x71\x38\xef\xcd\xa4\x97\xcd\x17\xb9\x47\x90\xbd\x51\xbe\x11\xc2\x74\xbd\x0f\x04\x4b\x51\x2c\x22\x05\xe3\xf6\x71\x21\x44\xb1\xd8\x1b\x6a\x0f\xbb\xba\xf1\xdd\x2a\xf8\x7d\x8f\x7d\x8f\x2b\xd9\x9b\xc6\x77\xbd\xa4\xd5\xbf\xd1\x3b\x3a\xf6\x18\x17\xa2\x14\x62\xb5\x82\x0f\x56\xd2\x47\xa4\xd6\x2b\x68\xbd\x55\x11\x24\x44\xe3\xf6\x16\xa1\xcb\xa7\x5e\x83\x04\x2d\x1b\xa9\x10\x5e\x30\x44\xe3\x1d\x7c\x35\xd4\x32\x18\x5f\x30\x1c\xa9\x35\x6e\x0f\x0e\x51\xa1\x02\xf2\xa0\x30\x36\xc1\xec\x10\x0c\x55\x10\x3d\x50\x2b\x09\x24\x58\x13\x09\xbc\x06\x6a\xb1\x63\x6c\x23\x1d\xec\x10\xfe\x3b\x60\x30\xa8\x12\xa5\x3f\x10\x68\x6f\xad\xff\xca\x8c\x01\x35\x06\x74\x0d\xc6\x5a\x70\xc9\xf3\x52\x23\x85\x43\x43\xf0\x2a\x8a\x0f\xb9\xb2\xf4\xcb\x6d\x10\xc5\xdf\x43\x99\x7c\x66\x1c\x89\x62\x40\x5d\x24\xdd\xf9\x06\xc6\x5f\x06\x02\xc0\x67\xee\xcf\x66\x51\xf9\xce\x10\x76\x3d\x1d\x17\x9f\x45\x71\xfb\x22\x8d\x95\x3b\x8b\x8f\x1e\x9e\x9e\x87\xdc\xab\x99\x0f\x32\xc8\x2e\x26\x4a\xb8\xf9\xab\x69\xb1\x93\x3f\xe1\xfc\x84\xf1\x60\xe9\x37\x32\x4f\x67\x95\x08\x1d\x04\xa4\x43\x70\x2c\x52\xc0\xc6\x07\x05\xda\x87\xac\xc2\x4c\xae\xfc\x9d\x25\x63\xb0\x71\x60\x9c\xf6\x35\x3c\xb6\x08\x31\xdd\x15\x13\x90\x5a\x84\x3e\x97\x2c\x9d\x82\x90\x6b\x6a\xe5\x0b\x82\xb4\x96\xa1\xd4\xa2\x09\x33\x25\x58\x5f\x27\x3b\x16\x9a\x4d\x04\x01\x7b\x2b\x1b\x54\xb0\x3b\x02\x4d\xec\x0c\x4d\x52\xfb\x88\x39\xb3\x4a\x02\xa7\x1c\x32\x64\x11\x22\x12\x93\xf1\xc1\x1d\x6a\xe3\x0c\x19\xef\xfe\x94\x1d\x9e\xb1\x19\x59\xc3\xed\x74\x3f\xe8\xe0\x3b\x90\x29\xc0\x68\x43\x11\xad\xae\x40\x99\x80\x0d\xd9\x63\xc2\x06\x30\x6e\x3c\xa8\xc0\x44\xb0\xa8\x09\x24\x37\x2d\x3f\x3e\x19\x9d\x1d\xe6\x9d\x3d\x4e\x25\xd5\x42\x1f\x5c\x03\x4b\xee\x15\xdc\xdc\x3b\xed\xcb\xb1\xef\xcb\x12\x9e\x9e\x67\xf6\x7b\x15\x85\x42\x1d\x61\xb3\xcd\x9d\xcd\x52\x4f\xef\x88\xcb\x52\x14\x2f\x32\x0c\xa2\xc4\x0b\xb4\x28\xb8\xf7\xff\x54\xa0\x19\x1f\xa4\xdb\x63\x66\xc9\x56\x8e\xec\xea\x31\xa5\x9b\x52\x74\xfd\x71\x20\xe3\x78\x31\x32\x6f\x41\xf6\x3d\x3a\xb5\x1c\x0e\xaa\xd9\x98\xa4\xc4\x61\x42\x36\xec\x34\x00\x5d\x73\x8f\xab\x14\x18\xc6\x64\x33\x04\x86\xcf\x1c\xcb\x77\x0d\xa0\x6e\x06\xba\xf3\xcd\x70\x9a\x02\x77\xbe\xc9\x80\xd9\x90\x6c\x40\xd7\xb3\xcf\x1c\xcf\xa3\x31\x40\xd3\x73\xef\x9d\x35\x0e\x73\xeb\x96\xdc\xce\x0a\xba\x3a\xa5\x95\x19\x92\x67\xe4\x97\x90\x9c\x96\x31\xa7\x52\x14\xc5\x49\xf0\x5f\x1e\x95\x51\x81\x61\x8e\xe6\x04\xe7\x61\x9a\x8c\x9b\xa7\x22\x5b\x55\x5a\x3b\x33\x3e\x83\x8d\x4b\xdb\x4d\x47\xc0\x6f\xbd\x74\x0a\x55\xc5\xb6\x1a\xf7\x5d\x1e\xc6\xc1\x31\xd7\xcc\xf4\x43\xf5\xd0\xc9\xfe\x29\xef\x93\xe7\x61\x09\x54\x40\x70\x73\x5e\xd2\xf5\xe3\xb1\xc7\xf2\xbc\x20\x5e\xcf\xaf\x32\x6f\xa9\xaa\xb9\x11\x97\x54\x56\xd0\xc9\x2f\xb8\x9c\x5d\xb0\xf3\xde\x96\x25\xf7\x21\x55\x66\x7e\xab\x98\x38\xde\x5d\x0d\x6f\xe6\xb1\x79\x4b\x3a\xaf\xcf\x68\x88\xb0\xdd\x82\x33\x96\x3f\xc7\x7a\x9d\xb1\x49\x14\x0e\xd7\x9f\x50\xc3\xbb\x2d\x2c\x16\x29\x83\xd7\x09\xbb\x7c\xf8\x1f\x56\x3f\x06\xd3\x3d\x04\xd4\xe6\xdb\x32\xa5\x56\xb0\xf8\x63\xa5\xa6\xd1\x5a\x2d\x58\x63\x85\x69\x7a\xf8\xe9\x4f\xcc\xf0\x2c\x8a\xc2\x68\x56\x67\xbc\xfd\xfb\xf7\xa9\xe4\x9c\x92\xae\x1b\x2b\x7a\x9f\x6b\x4e\x47\xc5\x23\xaf\xa4\x0d\xb8\xd1\xe5\xa7\xec\xa2\xe2\x2d\xc1\x16\x28\x1c\x30\x5f\x8f\x01\x14\x5a\x24\x5c\x9e\xb3\xaa\xc4\xc0\xe5\xc5\x35\x57\x77\x45\x25\xc5\x0f\x3a\x03\x72\x6a\x9d\xae\x87\x6d\x42\x4f\x3d\x8b\xeb\xd4\xb2\x4c\x75\x13\x45\x11\xd7\xf5\x3d\x61\x17\xe1\x2a\x73\xcc\xc1\x4b\xf6\xb8\xae\x6f\x95\x4a\x8d\x93\xf6\x21\xf8\x1e\x03\x19\xfc\x29\xc3\xb5\xdc\x4b\xc2\x24\xe0\x8c\xe8\xdd\x24\x75\x5c\xcf\x03\xdb\x1f\x0c\x38\xb8\xa4\x1c\xb6\x1b\x3f\xb6\x82\x3e\x4e\x1b\xee\x82\x98\x19\x2f\x29\xcf\x1a\x5c\x29\xbd\x7f\x53\xe6\xe5\x0e\x78\x1f\xd7\xe2\x24\xfe\x1f\x00\x7a\x6a\xab\x25\x40\x09\x00\x00")
+
+func apidocFlatGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocFlatGo,
+		"apidoc/flat.go",
+	)
+}
+
+func apidocFlatGo() (*asset, error) {
+	bytes, err := apidocFlatGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/flat.go", size: 2368, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocFormatGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x91\x41\x6b\xdc\x30\x10\x85\xcf\xd6\xaf\x78\xb8\x50\xec\xb2\xb1\x7b\x2b\x2c\xe4\x56\x5a\xd2\x43\x02\x4d\xfe\x80\x22\x8f\xec\xd9\xc6\x1a\x21\x8d\x1b\xc2\xb2\xff\xbd\xc8\x76\x4b\x97\xdc\xc4\xe8\x7d\x8f\xf7\x66\xa2\x75\xbf\xec\x48\xb0\x91\x07\x71\xc6\xf0\x1c\x25\x29\x1a\x53\xd5\x89\xfc\x0b\x39\xad\x8d\xa9\xea\x91\x75\x5a\x9e\x3b\x27\x73\x9f\x64\x8c\x14\x23\xf5\x36\xb2\x93\x39\x5a\xed\x4f\x59\x82\xbe\x45\xca\xb5\x69\x8d\xe9\x7b\x7c\x93\x34\x5b\x7d\x74\x13\xcd\x16\x89\x74\x49\x21\xc3\xe2\xc7\xe3\xc3\x3d\xf6\xe9\x40\xd9\x25\x7e\xe6\x30\x42\x27\x82\x5f\x09\x88\x2f\xf8\x69\x39\x2d\x5b\x20\xc8\xa2\x71\xd1\x03\x74\xb2\x0a\xce\xe5\x41\x9b\x0f\x05\x27\x43\xc1\xc5\xe3\x2e\x78\xe9\x70\x57\x14\x85\x1f\x28\xf1\x6f\x1a\xe0\x93\xcc\x2b\xf0\x5d\xb0\xe6\x03\x07\xe8\xc4\x19\x7b\xed\x03\xb2\x80\x15\xce\x86\x20\x8a\x21\xb1\xd7\x62\x20\x8b\x42\x3c\x06\xab\x84\x57\xd6\xa9\x98\xcc\x9d\xf1\x4b\x70\x57\xdd\x9a\x16\x9f\xf6\x3e\x67\x53\x29\xe3\x78\x8b\x7f\xcb\xe8\xee\xe9\xb5\x04\x6b\x5a\x53\x71\xf0\x52\x3e\x3f\x96\xc1\xd9\x54\xd5\xd3\x5b\xa4\xf2\x3e\x42\xf9\x60\xaa\x8b\xa9\x92\x88\x16\x49\x91\x76\xbb\xbd\x72\xf7\x93\x7c\xb3\x5f\xa2\x2b\xd0\x83\x6f\x0a\x77\xbe\xb4\x6d\xbb\x41\xbb\x18\xb7\xa8\x27\xd5\x78\xec\xd7\x7b\xdc\xe4\x75\xda\x49\x1a\xfb\x21\x59\xaf\x37\x9f\xbf\xf4\xdb\xec\x43\xbd\x83\x4f\xac\x2f\x54\xb8\x77\x0b\xff\xab\xf8\x4a\x9e\x03\x2b\x4b\xc8\xb8\x8a\xf6\xdf\x47\xe9\xb7\xdd\x18\x49\x44\xcd\xc5\xfc\x19\x00\xbd\xc1\x01\x88\x55\x02\x00\x00")
+
+func apidocFormatGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocFormatGo,
+		"apidoc/format.go",
+	)
+}
+
+func apidocFormatGo() (*asset, error) {
+	bytes, err := apidocFormatGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/format.go", size: 597, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocHtmlGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\xef\x92\xdb\xb6\x11\xff\x4c\x3e\xc5\x96\xb1\xa7\x92\x2b\x51\xb6\xa7\x4d\x3b\xb2\xa4\x8e\x6b\x3b\xa9\x33\x3e\xdb\xf1\x5d\x92\x0f\x9d\xce\x18\x47\x82\x14\x72\x24\x40\x03\xe0\xdd\xa9\x32\xdf\xbd\xb3\xf8\x43\x42\x94\xee\x9c\xa4\xa9\x6f\xe6\x8e\x00\x16\xbf\x5d\x2c\x16\x8b\xc5\xae\x1b\x92\x5d\x91\x92\x02\x69\x58\x2e\xb2\x38\x66\x75\x23\xa4\x86\x49\x1c\x25\x45\xad\x93\x38\x4a\xb6\xba\xae\x16\x9a\xd6\x4d\x45\x34\xc5\x0e\x26\xf0\xb7\xd2\x92\xf1\x52\x25\x71\x1c\x25\x25\xd3\xdb\xf6\x32\xcd\x44\xbd\x90\xa2\x6c\x68\xd3\xd0\x05\x69\x58\x26\xea\x86\xe8\xc5\xcf\x4a\x70\xbd\x6b\xa8\xc2\x69\xa5\x68\xae\xca\x94\xf1\x05\x95\xb2\x14\xe9\xf5\xd3\x45\x51\x6b\x6c\x08\xa9\x92\x78\x1a\xc7\x8b\x05\x7c\xa0\x3c\xa7\xf2\x9f\x17\x67\x6f\xe0\x46\x32\x4d\x15\x30\x5e\x08\xd0\x02\x6e\x80\x28\x20\xa0\x18\x2f\x2b\x0a\x86\xa2\x21\x25\x9d\xc1\x0d\xd3\x5b\x20\x38\x59\xd1\x4c\x33\xc1\xa1\x10\x12\x28\xc9\xb6\x50\x90\x8c\xe4\x14\x18\x07\xbd\xa5\x20\x64\x4e\x25\x94\xec\x9a\xf2\x14\x9e\x2b\x4f\xae\x80\x48\x8a\xd3\x59\x4e\xb9\x66\x05\xa3\x39\x5c\xee\xfc\x5c\x4e\x6a\x0a\xa4\x12\x9c\xce\xac\x28\x6a\x2b\xda\x2a\x87\xad\xa8\x72\xe0\x02\x6a\x61\x27\xeb\x2d\xe1\x20\x38\x85\x6b\x2a\x15\x0a\x21\x8a\x50\x86\x34\x2e\x5a\x9e\x05\xcb\x9b\xdc\x00\x13\xe9\x4f\xb8\x46\xe9\x90\x1f\xbd\xe6\x85\x98\x82\x51\x08\xec\xe3\x48\x52\xdd\x4a\x1e\xcc\x39\xa3\x4a\x91\x92\xaa\xc9\x8d\x9d\x31\x03\xce\xaa\x69\xdc\x8d\x34\xe7\xc9\x80\x29\xa8\xd8\x15\x0d\x86\xe0\xb2\xd5\xa0\xc9\x15\x55\x38\x85\x69\x05\x9a\xde\x6a\x28\xa4\xa8\x8d\x8a\x8c\x72\xa0\xb6\x00\x90\x11\x4d\x2a\x51\x1e\xc9\x3e\xc8\x71\x7a\x0d\x33\xa8\x55\xa9\xc0\x93\x05\x4b\xd2\x33\xfc\x86\xe5\x1a\xbc\x55\xa5\x6f\xe9\xcd\x24\x49\xa6\xe9\x37\x2d\xcf\xd4\x04\x2d\xce\x7c\x85\x1d\x0e\xc8\x76\x20\xf4\x74\x9a\xbe\x27\x52\x51\x43\x7e\x51\x37\xd5\x34\x8e\x58\x61\xa0\xff\xb0\x46\xa5\xa0\xfa\xbc\xfe\x0c\x73\x95\xfe\x24\x49\x33\xa1\x52\x4e\xe3\xa8\xeb\xa9\x51\x90\xf4\xd5\x2d\xcd\x5a\x4d\xbd\x56\xa7\xcf\x7e\x0d\x90\x1b\xe3\xac\xc2\x7d\xb8\x26\x12\xbc\x4c\xb0\x86\x8f\xf1\x0a\x5b\x9b\x78\xb5\xa5\x24\xdf\xc4\x2b\xa5\x77\x15\xdd\xc4\xd1\xa5\xc8\x77\x46\xc6\x42\x70\x3d\x2f\x48\xcd\xaa\xdd\x12\x7e\xb8\x6c\xb9\x6e\xe1\x0d\x2b\xb7\x7a\x06\x8a\x70\x35\x57\x54\xb2\xe2\x59\x1c\x45\x0d\xc9\x73\xc6\xcb\x25\x3c\xfd\x4b\x73\xfb\xcc\xac\x61\xfb\x14\x88\x01\xc9\x44\x25\xe4\x12\x2e\x2b\x92\x5d\x21\x2d\xee\xe9\x3c\xa7\x99\x90\x04\x0d\x7c\x09\x5c\x70\x3a\xcc\x59\x6e\xc5\x35\x35\xdb\x71\x4c\xd9\xe2\xf1\xab\x58\x40\xfe\x27\x68\x60\x3f\x08\x30\xaf\x68\xa1\x43\x29\xb4\x5c\x72\xbd\x9d\x67\x5b\x56\xe5\x13\x7a\x4d\xf9\xd4\x90\x5f\x92\xec\xaa\x94\xa2\xe5\xf9\xdc\x89\xf7\x55\xf1\x04\x7f\xdc\xac\xdc\x50\x5d\x53\xa9\x59\x46\xaa\x39\xa9\x58\xc9\x97\xa0\x45\x73\xb0\xd8\x27\x8f\x3d\x9b\x54\x93\x72\xd0\x98\x62\xff\xa1\x4b\xf8\xfa\xf1\xc3\x67\xa7\x59\xd1\xc7\xf8\x73\xa8\xb7\xe6\x16\xbe\x36\x68\xd1\xa5\xf1\x04\x73\x49\x72\xd6\xaa\x25\xfc\xb9\xe7\xd1\x50\x39\x67\x9a\xd6\x73\x6b\x33\x33\x48\xad\xbb\x99\xa3\x63\xd0\xbb\x19\xa4\x92\x6a\x69\xff\x56\x94\x28\xaa\x66\x90\x56\xa2\x64\x7c\xae\x89\x2c\xa9\x36\x23\x9f\x5a\x26\xcd\x48\x43\x65\xcd\x14\x7a\x03\x6c\xdd\x10\x9d\x6d\xf1\xa0\xa4\x2d\x9e\xae\x19\xa4\xa4\xcd\x99\x9e\xd3\xdb\xac\x6a\x73\x9a\xcf\x20\xad\x49\x46\xa4\x40\x97\x34\xac\x14\x2d\x66\x09\x4c\x93\x8a\x65\x4e\xcc\x4f\x2d\xcb\xae\x94\x26\x52\x43\x23\xe9\x0c\x52\x7a\x4b\xea\xa6\xa2\xd8\xfa\xa2\xf2\x4f\x2a\x57\x51\xae\x98\x66\xd7\x08\xa6\xda\x86\xca\x56\x19\x49\x35\x55\x7a\x2e\x78\xb5\x0b\xed\xec\xab\xcb\xbf\x3e\xc9\x9e\xa0\x30\x76\x33\x6e\x28\x1a\xec\x12\x2e\x45\x95\x1b\xbc\xd5\xc2\xd9\xf9\x4a\x33\x5d\xd1\xcd\x7e\x5f\xab\x12\xcc\x6d\x32\x37\x3d\x49\xd7\xad\x16\xe6\x6b\x13\xaf\x16\xee\x6c\xe0\x99\xc0\x93\xf2\xc4\xd3\x0f\xa4\xdb\x27\x9b\x78\xbf\x37\x8e\x3e\xbd\x20\xe5\x6b\x9e\xd3\xdb\xae\x8b\xa3\xd5\xf6\x29\xb0\x7c\x9d\x68\x52\xaa\xa4\x9f\x46\x4a\x35\x47\x4c\xc6\x4b\xc3\x68\xfb\x74\x13\x47\xab\xe6\x60\xbc\x60\x95\xa6\x32\xe9\x3a\x58\x29\x5a\xd1\x4c\x7b\x1c\x3f\x02\x82\x67\x5b\xc2\x4b\xba\x4e\x6c\xcf\x05\x29\xd5\x44\x6f\x99\x4a\xaf\x49\xd5\xd2\x69\xb2\x89\xa3\x68\x25\x1a\x3c\x37\x60\xba\xd6\xc9\xa1\x0c\xa4\xaa\x0c\x7f\x4b\x83\xe4\xfb\xbd\x44\x48\x48\xbb\x6e\x34\x73\xbf\xc7\x85\x75\x1d\x22\xd8\xaf\x7e\xde\x7e\x4f\x79\x6e\x56\xbb\xb0\xa2\x6e\x56\x8b\x06\x57\xd4\x56\x63\xcc\x8a\xf5\xcb\x18\x00\x57\xaa\x21\x1c\xb2\x8a\x28\x65\x34\x15\xb2\xc0\xa1\x0d\x78\x88\x07\x6c\x06\x0f\xcc\x85\xb7\x5c\x43\xfa\x8d\xb9\x00\x55\xd7\xed\xf7\xac\x80\x07\xac\xeb\x66\xe0\x64\x59\x11\xd8\x4a\x5a\xac\x93\xaf\xf6\x7b\xc2\xb3\xad\x90\x76\x1e\xb2\xdb\xef\xdd\xe7\x6a\x41\x36\x9e\x7e\x51\x31\xff\x8d\xeb\x40\xc9\x7d\xd3\xef\xeb\x0b\x29\x94\x3a\x13\x39\xad\x7a\xc6\xc3\x06\x67\x38\x38\xaf\x71\x74\x2e\x69\x65\xdc\xda\xb0\xe3\xe1\xe8\x3d\x1b\x1f\x92\x31\xae\xa5\x30\xbb\x73\x42\x95\x59\x2f\xca\xb9\xa6\x8d\x32\x8a\x75\x20\xe9\xe9\xc5\xdc\xc1\xc5\xde\xfd\xea\x2e\x3e\x08\x56\xb1\xcd\x09\x6d\xa6\x6f\x7b\x6d\xba\x4f\xd4\x26\x5c\xef\xf7\xe9\x8f\x36\xbe\xf8\x65\x4a\x3d\x63\xa5\xf5\xec\x3f\x09\x79\x55\x54\xe2\x26\x54\xaa\xd5\x44\xed\x49\x7a\x75\xf6\x3d\xf7\x29\x73\x20\x1a\xa9\x52\x9c\x5a\xa2\x53\xde\xeb\x97\x5d\x07\x13\x3f\x66\xcc\x4d\xd2\x02\xc3\x81\xf4\x8c\xea\xad\xc8\xbf\x68\x6d\xb5\x21\x7b\xee\x6c\x4e\xd2\xc2\x99\xa9\xfd\x76\xba\xb1\x0d\x8b\xe8\x4c\x72\xa0\xec\xba\x74\xbf\x3f\x20\x08\x0c\x75\x3a\x56\xaa\x38\x50\xaa\x5b\xd3\x60\xa0\x2b\x1f\x72\xba\xe3\x65\x37\x3c\x81\x9c\x68\x32\x47\x47\xe3\x4f\xb6\x82\xcf\xf0\xb3\x60\x1c\x12\x48\x50\xa4\x61\x17\x8e\xf7\xfc\x37\xda\x03\xd8\x83\x6e\x5c\xef\x3a\x19\xae\xc9\xbf\x3d\x7e\xf8\xec\xf8\x2a\x31\x50\xcf\xaf\x09\xab\xc8\x65\x45\x2f\xc4\x81\x7c\xce\x31\xf4\x0b\x46\xdf\x87\xae\x92\x84\x6e\xc4\x0b\xe9\xbc\x8d\x93\x6e\x74\xee\xcd\x11\xdc\xef\x1f\xb8\x68\x1a\x77\x1a\xb7\xf8\x81\xbf\x3f\xcd\xde\x7f\x70\x0d\x1c\xb1\x76\x3b\xf4\xac\x1a\xcf\xd3\x4f\xe9\xed\xb4\xef\x80\x89\xfb\xfc\x60\xf7\x67\x8a\x42\x34\x5e\x88\x38\x32\x0e\x2c\xbd\xa0\x4a\xbf\xe3\xd5\x2e\xc4\xec\xef\xb7\x1e\x74\xe8\x19\x66\x7c\xa0\x44\x09\x7e\x04\xea\x45\xb5\xf7\xfd\xa1\xa8\xb6\x0f\x51\xdd\x77\x4d\xb9\x56\x6f\x85\xb6\xa7\xfe\x14\xd0\x1b\x8c\x23\x2e\x4c\x18\x11\x62\x85\xe1\x05\xe2\x55\x03\xd9\x1d\x70\xe9\x4b\x91\xc1\x67\xc8\x45\x86\xaf\xa4\x03\x16\x4e\xa9\x71\x14\x9c\x64\xa7\x44\x7f\x80\xd1\x49\xf5\xbc\x9d\xc2\xe3\xe8\xe0\x44\x63\x33\xb2\xc7\x3a\xfd\x11\x6f\xbe\x61\xe3\xac\x9d\xc2\x64\x95\x89\x9c\x82\xb9\xc1\xd1\xc4\x9d\x75\xa8\xad\x90\x1a\x49\xac\xd4\x48\xb3\x99\x3a\xc9\xed\x26\xf9\x33\xb9\x84\x50\xba\x79\xb6\xa5\xd9\x15\xcd\x93\x81\x00\x0c\x07\x34\xe2\x17\x38\xd6\xc3\xf5\x68\x56\x9e\x97\x22\xeb\xba\x14\x8c\x04\x6e\xc8\x1c\x71\x5c\x82\x6b\xe3\x92\xad\x13\xef\x3b\xfc\xec\xef\xfb\x40\x0b\xb5\x18\xad\x72\xaa\x09\xab\x94\x57\xcf\x10\x87\xe1\x81\x8e\xa2\x95\x6a\xeb\x9a\xc8\x9d\xd7\x6c\x30\x0e\x93\x46\x32\xae\x0b\x48\x1e\xaa\xf4\xa1\x4a\xc0\x9d\x08\x73\x9a\xfd\xaa\x8c\xdd\x7a\x8c\x03\x9d\x9f\x73\xd6\x34\x54\x2b\xaf\x7b\xdc\xbc\xf4\x0d\xbb\x94\x44\xee\xba\x6e\xe9\x76\x0e\x07\x24\xdd\x18\xcd\xf4\x5b\x48\x78\xd9\x92\x92\xce\x71\x82\xfb\x76\x47\xf5\x85\xc8\x69\xaf\xb7\xd5\xa2\x91\xf4\x48\x2d\x6e\xc1\xa1\x6e\x56\x1a\x1d\x06\x52\xae\xb4\xc4\x3f\xd1\x4a\x6f\xfd\x8a\xf1\xe6\x37\xfe\x43\x6f\xc7\x43\x0d\x91\xa4\x56\x77\x0c\x4a\xaa\xda\x4a\xdf\x35\x9a\x53\x95\x49\x66\xe2\xa5\x80\x62\xb5\xb0\xfc\x7b\x25\xf5\x57\x88\xe5\x2c\x9d\x7b\x3d\xbc\x32\x42\xb5\xfb\x86\xbf\x36\x9c\xf5\x26\x4e\x97\x3a\x0f\xdd\xad\xce\x0f\xba\xdf\xe3\x72\xe0\x33\x60\x76\xe3\x0d\xe3\x57\x27\x48\x3e\x98\x45\xdd\x4f\x33\x3a\xaa\xde\xf0\x7e\xc0\x17\x82\xdb\xec\x68\xf0\x05\xe6\xe1\x80\x7b\x67\x3e\xc2\xe3\x1f\x47\xc3\xce\x39\x10\xff\x98\x30\x64\x93\x07\xe9\x99\x6b\x7f\xc3\x68\x95\x2b\xc0\x17\x34\xa9\xa7\xa7\x46\xac\xe0\xd3\x63\xfe\x1e\x72\xf0\xc1\x43\xcf\x3d\x92\x04\x3e\x72\x8c\xf8\x4b\x3c\xe5\x29\xc4\xf7\xc3\xd3\xea\x18\x34\x78\x77\x21\x6e\xd0\xfc\x12\xec\xb9\x7f\xfb\x1c\x83\xf6\xcf\xa2\x24\x70\x6b\xce\xf7\xf4\xea\x18\x88\x4e\xf1\x60\x05\xa4\xe7\xfe\xa5\x75\x82\x83\x1f\x0a\x39\xf4\xf4\xfe\x12\x1a\x78\xf5\xe4\x77\xf1\x7a\x8e\xef\xcb\x57\xee\x79\x79\xcc\xef\xf0\xf9\xd9\xe3\x8e\xba\xef\x00\xa7\x9f\x20\x7d\xcd\xaf\x45\x66\x82\x40\x48\xdc\xeb\x36\x39\x66\xe3\x47\x3c\xfe\x40\x79\x5a\xea\xf7\x54\xbe\xd6\xb4\x7e\x65\x9e\xe0\xc7\x70\xa3\x37\x7a\x0f\x3b\xee\x3f\x05\x6f\x36\xf9\x41\x7a\x6e\x1e\xf5\xaf\xcc\x9b\xde\x9d\x03\x6f\xf4\xc7\xfc\x0e\x32\x00\x3d\xb7\xc3\x5e\x07\xa2\xcc\xd1\x72\x0d\xe7\xd9\x2d\xac\x1f\xb1\xad\xde\xa7\x9c\x94\x2f\xfd\x80\x39\x86\x63\x41\x4c\xea\x21\x34\x8d\xd0\x22\x8c\x69\x91\x82\x76\x5d\xef\x51\xb5\xdc\xcd\x15\x29\x68\x02\xe9\x0b\xc1\x0b\xcc\x6e\x66\x66\x9c\x56\x6a\x4c\xd7\xf2\xd3\x94\x28\x56\x7a\x2c\x27\x2b\x80\xf0\x7c\x08\xd3\x60\xc2\xa9\xbb\xe1\x86\xce\xa9\xef\xe9\x83\xbd\xe9\x09\x97\xe2\xc7\x7a\xcd\xde\x19\xd5\xf5\xb8\xf7\x69\x0e\xd5\xfb\x0e\xb3\x3b\xa7\x38\xe1\xe0\xdc\xe4\x7e\xee\x53\xe3\x3f\x76\xef\x05\x1e\x2c\xc1\x7b\x25\x99\x39\x34\x3f\xd6\x4f\xa8\xc9\x96\xdf\x49\x76\x87\x1a\xad\xd0\x77\x19\xfa\xc8\xbe\x7b\xb3\xee\xef\x3b\x0c\x82\x82\xa7\x82\xc2\x24\x15\xa7\x95\xbb\xe9\x4e\x87\x5c\x41\x68\x3e\x96\xc6\xa1\xbe\xb2\xb9\xa4\x41\xa0\x51\xd0\xe3\x72\x4d\xee\x92\x3c\x0e\x7a\xfc\x38\x4c\xdc\xd7\x05\x7a\x4b\x48\x8f\x82\x1b\xc3\xdb\x45\x69\xc3\x05\x8f\xa1\xaf\x7f\x47\x04\x51\xed\x01\xb9\x39\x5f\xca\x51\x86\x4c\xe7\x41\x90\xd1\x6c\xee\x09\x86\xb0\x2e\x81\x9a\x75\xf3\xbe\x3b\x7f\xf7\x36\x08\x49\x6d\x2c\x74\x9a\xb7\x77\x13\xc7\xbc\xad\x7d\xfd\x1f\x78\x87\x61\xd8\xb0\x61\x7d\x28\x31\xc4\x41\x6e\xce\x6a\xe1\x42\xb4\xd5\xc2\x3d\x4f\x8f\x13\x03\xef\x1a\x6a\x5f\xfd\xa4\x0a\x53\x02\x62\xe8\x9e\x5f\xd2\x2d\xb9\x66\x62\x70\xdc\xe1\xe0\x3d\x99\x81\x90\x6c\x94\x1b\xb8\x2f\x74\x0c\xa7\x65\x44\xd3\x52\xc8\xdd\x1d\xf1\x60\x48\xaa\xa8\xd6\x5e\x90\xfb\x29\x4d\xa6\xed\x7f\x8b\x30\xfb\xd0\x32\x8c\xe1\x10\xc0\x79\xba\x03\x7e\xe8\x2d\xdc\x3a\xa6\xa3\xc8\x2f\xf0\x3e\xc3\xc3\x3e\x38\xa9\x77\xc4\x9c\xee\xad\xf5\xa5\x20\xf2\x17\x58\xc6\xd8\x1c\xce\x9d\xef\x18\x7c\x91\xb7\x08\xef\x55\xc6\xd7\x6d\xdf\x7f\x6c\x09\xbf\xf1\x81\xd0\x23\xe2\x19\xfc\x12\x8d\x2b\x51\xfd\x4e\xdb\xe9\x5e\x0a\x63\x0f\x3a\x7e\x0e\x9c\xdc\xb6\xf4\xdc\xef\xdb\x89\x9d\xf1\x4f\xac\x51\xb7\x2b\x68\xfd\xae\x5b\xb9\xb2\x2e\x74\x13\x47\x8b\x05\x0c\x29\x6b\x50\x5b\x71\xa3\xc0\xe4\xf0\xb1\xc2\xe7\x52\x92\xb6\x6a\x3a\x94\xfc\x34\x29\x67\x66\xa6\x90\x40\xaa\x0a\x44\x81\xf5\xc0\x1a\x58\x01\x4c\xff\x51\x01\xad\x1b\xbd\x4b\xe3\x08\x2b\x81\xa8\xd6\x80\xc3\x44\x93\xd2\xd6\x7c\x72\x91\xb5\x98\xe7\x48\x3f\xb5\x54\xee\xce\x4d\xb2\x5a\xc8\xe7\x55\x35\x49\x9c\x23\x4a\x5d\x82\x6c\x9a\x16\x42\xbe\x22\xd9\x76\xe2\x01\x27\xca\x62\x44\x58\x42\x43\x99\x61\x0d\x58\xf2\x59\xaf\xd7\x90\x24\xf0\xf9\x33\xa8\x14\xb3\x6a\x8a\x6a\x2c\x05\xa9\x54\x35\x15\xd3\x93\x04\x92\x69\xca\xb0\x1e\xf0\xae\xb0\x82\x6c\xd6\xf0\x18\x4b\x13\x91\x4a\x4d\x2a\x2c\xcd\x99\x6a\x2a\xb2\x83\xb5\x51\x05\xfc\x1d\xe1\x96\x90\x60\x4d\x2c\x41\xc2\x6e\xea\x8b\x16\x4e\x81\xab\x85\x2b\x45\x2c\xb0\x62\xb1\x89\x3f\x0e\x75\x3d\x53\x82\x84\xa0\x7a\x89\x1d\x67\xa4\xd9\xc7\x51\xe2\x36\x2d\x59\x82\xfb\xf7\xd2\x76\xcc\xe2\xa8\xb7\x5b\x6b\x59\xc9\x12\xfc\x81\xb3\x1d\x86\xc4\x9b\x91\x07\x30\xfe\xe0\xfb\x96\x54\xa6\x24\x8d\x23\x48\x46\x3c\x82\xe7\x32\x20\x60\x7e\x31\x59\x02\x6a\x74\xa2\x68\x03\xb6\x4c\x3f\x03\xa5\xe0\x5f\xff\xb6\x8d\xa9\xeb\x0c\xcb\x9a\xae\x9a\x9f\x7e\x27\x18\x9f\x28\x35\x03\x45\x1b\xac\x6d\x22\x64\xf8\x76\x4e\x96\x70\x16\x34\x71\x38\xb8\xc2\x50\xa2\xa0\x89\xa3\xa3\x34\xba\x17\x6d\xda\x4b\x13\x4a\x31\x22\x36\xfc\x6d\x61\x7b\x5c\x01\x06\x5b\x72\x55\x68\xa0\xfd\x46\x80\xb7\x23\xe5\x8a\xf1\x1a\x1a\x29\xf2\x36\xa3\xbf\xa2\xc2\x7d\xb2\xd6\x1c\x94\xb1\xc7\xbb\x1e\x14\xe9\xc7\x43\xb8\xb2\xa4\x56\x65\xb0\x51\xa6\x28\x9e\x7e\x4b\xf5\x0c\xc7\xc2\xd8\x36\x59\xda\xb1\xb0\xcf\x10\xf9\xc4\x81\x57\x9d\x86\x47\xfd\xff\xa8\x48\x2f\x76\x0d\x0d\x64\x42\x53\x43\x81\x22\xac\x6d\x6b\x58\x0f\xe5\xeb\xe8\x48\x48\xa4\x9d\x1c\xb4\x5e\xa9\x8c\x34\xf4\xdc\x6c\xcb\xc4\x0b\x3a\xb1\x87\x64\x3a\x9d\x22\xac\xb9\xfb\x2a\xc6\xaf\x30\x67\x5b\xd4\x3a\x3d\x37\x97\x5e\x31\xf9\xd8\x47\xa1\x0f\x55\xb2\x79\xa8\x30\xca\xfc\x38\x83\x6f\x45\x2e\xb2\x1f\x3e\xbc\x99\x68\xe3\x2d\xa7\x33\xb8\x9b\xa3\x25\x31\xbf\x26\x8e\xdd\x49\x99\x91\x3d\x8e\x76\x46\x3d\x7d\x16\xc4\xeb\xa7\x85\x47\xd6\x44\x4d\xfe\xe4\xc0\xd8\xbd\x0e\xfa\x39\x66\x95\x33\x68\x07\x38\x67\xbe\x26\x5e\xf5\x88\xd4\xd9\xbc\x8b\x8a\x4f\x42\x86\xf3\x1c\x2a\x1d\x50\x47\xd9\x07\x0f\xdc\xe0\xa1\x1c\xb2\x18\x2e\x43\x82\xde\xf3\x24\x8f\x11\x8a\x63\xd3\xa8\x81\xcf\x38\x7b\xe2\x19\x49\x64\xf4\x25\xf8\xf1\x64\x87\x2f\x03\xfc\x51\xde\xd9\xc3\x6b\x78\x14\x24\xae\x4f\x82\x8f\x66\x3a\x6c\x3d\x40\xfb\x24\x52\x88\x6b\x23\xf9\x19\xb8\xcc\xe0\x69\x0f\xe6\x39\x84\x00\x0e\x7e\x34\xdf\x33\xeb\xe2\x2e\xfe\xef\x00\x1e\xa9\x7d\xe6\xf8\x24\x00\x00")
+
+func apidocHtmlGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocHtmlGo,
+		"apidoc/html.go",
+	)
+}
+
+func apidocHtmlGo() (*asset, error) {
+	bytes, err := apidocHtmlGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/html.go", size: 9464, mode: os.FileMode(436), modTime: time.Unix(1792000328, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocIntersectGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\x4d\x6f\xdb\x38\x13\x3e\x4b\xbf\x62\x6a\xa0\xad\x95\x0a\x72\x73\x2d\x90\x43\x90\xb6\x40\xda\xb7\x1f\x78\x53\xec\xc5\x30\x0a\x5a\x1a\x59\x6c\x24\x52\xe0\x50\xce\x7a\x0b\xff\xf7\xc5\x90\xd4\x57\xec\x74\x73\x49\x64\x72\xbe\xf8\xcc\x33\xc3\x61\x2b\xf2\x7b\xb1\x43\x10\xad\x2c\x74\x1e\xc7\xb2\x69\xb5\xb1\xb0\x8c\xa3\x05\xaa\x5c\x17\x52\xed\x56\xbf\x48\xab\x45\x1c\x2d\xca\xc6\x2e\xe2\x24\x8e\x57\x2b\xb8\x55\x16\x0d\x61\x6e\xc1\xa0\xed\x8c\x22\x10\x50\xe8\xbc\x6b\x50\x59\xa8\x74\xcd\x7a\x60\x2b\x84\xeb\xef\xb7\x60\x2b\x61\x41\xd4\xb5\x5b\xd8\xc9\x3d\x2a\x36\xd1\x8b\x13\x54\x62\x8f\x20\x15\xe4\xba\x69\xb4\x4a\x81\xba\xbc\x02\x41\x4e\x7c\x94\x2a\xb5\x01\xc2\x3d\x1a\x51\xc3\xa7\xee\x57\xc7\x36\x0c\xd6\x28\x08\x29\x05\xd2\xc1\x0d\xe4\xb5\xe4\x20\x72\xa1\xc0\x0a\xb3\x43\x5e\xa3\x6e\x4b\x68\x41\x97\xb3\x98\xd8\xc2\x83\x36\xf7\x04\x0f\xd2\x56\x2e\x42\x2f\xd1\x64\xf0\xa3\x42\x28\xa5\x21\x3b\x1e\xab\xe9\xc8\x82\xd2\x16\xb6\x08\x4a\xd6\x59\xbc\x5a\xb1\x85\x6b\x28\x45\x2e\x0a\x84\x3d\x1a\x92\x5a\x81\x24\xb8\xc7\xd6\x82\x2c\x81\xc3\x3d\x8c\x16\x2a\x41\x20\x6d\x0a\x42\x15\x80\x22\xaf\x38\x20\x69\x89\xad\x34\x68\x2b\x5d\xd0\x7f\x2a\xfb\x50\x5b\x61\x44\x43\xce\x8e\x00\x83\xd4\xd5\x96\x8d\x84\xf3\x91\x68\x10\x3e\xdd\x7d\xfb\x0a\x54\x89\x16\x53\x90\x3b\xa5\x4d\x9f\x11\x25\x1a\xa4\x5e\xd4\x1e\x5a\xf4\x76\x6c\x85\xd2\x84\xbc\x50\xea\xe0\x77\x7b\x8d\x38\x40\xa3\xf7\x08\x5b\xb4\x0f\x88\x0a\x02\x63\x08\x4a\xa3\x1b\xd0\x0a\xfb\x2c\x80\xd5\xac\x2f\x94\xb6\x15\x9a\x00\xa1\x87\x46\x12\x88\xbd\x90\xb5\xd8\xd6\x08\x5a\xd5\x07\xb0\x9c\x2f\x04\x54\x56\xda\x03\xdc\x4b\x55\x70\xbe\x85\x05\x69\xd9\xc8\x4c\xc1\x6a\x66\xc7\x1c\x0e\x0f\x62\xad\x09\x59\x0f\xc1\x8a\x5d\x7f\x28\xd6\x17\x5d\x21\x51\xe5\x38\x18\x7d\xcd\x5f\xa8\x40\x69\xa8\xb5\xda\xa1\x01\xa9\x2c\xaa\x02\x0b\x28\xb5\xe9\x73\x39\x86\x4c\xe9\x90\x12\x76\xe4\x71\x0a\xa0\x79\xc0\x41\x18\x76\x7b\x8f\xca\x21\xc1\xea\xf6\x94\x34\xac\x5c\xca\xda\xa2\xc1\x82\x41\xdd\x1e\xe0\xa3\xfb\x99\xc1\x47\x8f\x0d\x1a\xa3\x0d\xb1\x35\x36\x91\xeb\x66\x2b\x15\x47\xc5\xe8\xf6\x45\xd3\x9b\x0b\x89\xa9\xf0\x00\x0d\x32\xc1\xf9\x70\x63\x48\x6c\x80\xf3\xb5\x45\x68\x24\x11\x67\x3c\x9c\xc6\x67\x23\x04\xce\x34\x54\x1a\xbe\xa0\x15\xa0\x0d\x5c\x17\x7b\x34\x56\x12\x16\xac\x3f\x53\x70\xc5\x3a\x7a\xe7\x20\xa1\xc6\xd2\x42\xa7\xf2\x4a\xa8\x1d\x16\x59\x5c\x76\x2a\x1f\xfb\xc1\x52\xaa\x52\x13\x64\x59\x76\x71\xab\x4a\x9d\x80\xfb\x07\xbf\xe3\x88\x21\x0c\x98\x7e\xc6\x03\x90\x35\x5d\x6e\x79\x23\x0a\xf5\xc3\x4b\x52\xed\xe2\x28\x1a\x2a\x49\xd9\x38\x8a\xbc\xce\xb8\x7d\x8c\x83\xc6\x8d\xee\x94\x85\x77\x57\xd0\x88\x7b\x5c\x36\xa2\x5d\xfb\xf5\xcf\x78\xd8\x48\x65\x93\x38\x0a\x1c\x92\x35\x93\xec\xbc\x20\xff\xf2\x96\x83\xce\x6a\xe5\xcb\x86\xa0\x11\x2d\xf9\x3a\x0d\x21\x04\xd2\xba\xed\x50\xbb\xa1\x14\xe3\x88\x49\xa7\x8a\x1e\x62\xa9\xce\x90\xc1\x73\xd6\xdb\x72\xb1\x3b\xad\x9c\xbf\x1e\x37\x3b\x57\x0a\xae\x2f\x0e\x05\xed\xbc\x66\x71\x14\x82\x9b\x9e\x66\x40\x75\xe3\x4f\x92\xc4\x01\xb4\x53\x84\x46\x51\x7f\x5a\xee\xaa\x32\x05\xce\x1a\x23\x69\x38\xa9\xee\x17\xb9\xd4\x14\x58\x12\xaf\xf3\x4a\x76\x97\x57\xd8\x88\xf7\x58\x4a\x25\xad\xd4\x8a\x96\x09\x67\x4f\x1b\xf8\x99\x42\x39\x57\xcf\x3c\xbb\xbd\x95\xa8\xbc\x47\x87\xff\x00\xfb\xef\x32\xfb\x2a\x1a\x4c\xa1\xcc\xfe\xf2\xc9\x3e\xc6\x51\xcf\x04\x17\xf5\x9a\x75\x36\x6f\xde\xf0\xb2\x2c\xfb\x6e\xe0\x32\xe9\xb7\xe0\xea\x8a\xdb\xb0\x77\x10\x9d\xdb\x1f\x4f\x3d\x4b\x70\x14\x79\x5f\x3e\x6e\x6e\x3d\x63\xe8\x65\x76\xdd\xb7\x9d\x1f\xfa\x49\xd3\x6b\x56\x0a\xb1\x4d\x6d\x35\x53\x43\x5f\x42\xfb\xf0\x46\x9a\x80\xc0\x80\xff\x29\x02\x29\x34\x6e\xc9\x59\xf4\x69\x66\x7b\x65\x63\xb3\xbb\xd6\x48\x65\xcb\xe5\xe2\x25\xc1\x4b\x5a\xa4\xf0\x20\x0d\xde\xb1\x84\xab\xb7\xec\x56\xd5\x52\xa1\xcf\xcf\x92\x73\xc6\xb6\xbe\x33\x33\x93\xe4\x79\xc2\xff\x77\xbc\x4d\x12\x87\x4f\x24\x4b\x90\x8c\xef\xdb\x00\x41\x20\xdd\xba\x09\xc0\xba\x9f\x6e\xe7\xd8\xcb\xcf\x25\x82\x48\xaf\x3e\x61\xa3\x97\xf0\xd8\xf9\x44\xf0\x9f\x63\xcc\xdf\x7e\x00\xe8\xf9\x46\xeb\xb7\x9b\xcc\x37\xcb\x25\x37\x99\x65\x09\x17\x9e\x55\xdc\x52\x18\xed\x0b\x8f\x71\x02\x5b\xad\x6b\xe7\x4b\x96\xd0\xcc\x88\xe1\xc7\x13\x98\x11\xeb\x0f\x24\xdc\xb0\x72\x8d\xca\xc1\x4a\x0c\xc6\x31\x1e\x8c\xcc\x4e\xf1\x8c\x3c\x9e\x18\x3b\x26\xfd\x19\x33\xd7\x7c\x5d\x9c\xc3\xd2\xd8\x87\xfb\x0d\x57\x9c\x23\xa7\x82\xdc\xb4\xb2\x5c\xd5\xbd\x9a\x6f\xac\xe5\x26\x8e\xa2\xd0\x56\xde\x5d\x3d\x2a\x9d\x3f\x1d\x9e\x5b\xaf\x30\xbd\x82\x2b\x81\x75\x68\x29\xf1\xf3\x0b\x46\x96\xe0\x9d\xfb\x32\x99\x83\x10\x18\x31\x75\x71\x05\xa2\x6d\x51\x15\xcb\xc9\xa2\x77\x33\x14\x2b\x27\x61\xee\x69\x38\x16\x47\xe9\x76\x09\xed\x75\x7f\xeb\x2f\x87\xaf\x6f\xe5\xd4\xac\xa3\xf7\x6a\x05\x37\xba\x3d\xb8\x8e\xdb\x8f\x8f\x0f\x95\xcc\xab\x70\x33\x33\x75\x0d\x86\xa1\xd0\x56\xe8\x55\x1e\xb7\xf2\x7e\xe0\xcc\x85\xd2\x4a\xe6\xa2\x96\xff\xf0\x7d\x5b\x68\x24\xf5\x9a\xaf\x2d\x6e\xed\xee\x8e\x04\x69\x33\x17\xe1\xff\x9c\xb3\xf1\xbc\xeb\x8d\x5b\x71\x75\x8a\x16\xcd\x52\xc9\x3a\xe1\x96\xe8\x96\x29\xcb\xb2\x64\x52\x16\xd9\x07\x1e\x14\x6e\x74\x31\x37\x31\xac\x06\xed\x13\x61\x6f\x26\x2c\x7f\x6b\xd1\x08\x2b\xb5\x12\xf5\xd4\xc8\x64\xf9\x0e\xad\x95\x6a\x37\xb7\x36\xd9\x9f\x99\x63\x98\xed\x87\xbf\xf3\xba\xe3\x41\x6a\x62\xd0\x93\x66\x6e\x64\x26\x3c\x33\xe3\x19\xed\x4e\x42\x3d\xf7\x89\x67\xcd\xe9\xcd\x35\x11\xda\x70\xbd\x87\xcb\xeb\xe7\xd3\x97\x57\xd8\xc6\xb3\x37\x53\xf0\xd6\x33\xf6\x05\xfb\x5b\xe3\x26\xf0\xb3\xff\x75\x05\xd6\x74\xcc\x80\xe8\x89\x58\xc3\x89\xcf\x6c\xa6\x80\xc9\xb9\xf6\x96\xdd\x8c\x8c\x41\xbe\x41\x43\x77\xf1\x26\xe2\xa3\x7b\x5f\x0d\x2d\x7b\x78\x5f\x31\x5b\xdd\x4c\xdf\x3f\xcb\x78\x00\x99\x90\x94\xc7\x4f\x69\xeb\x30\xcd\x17\x48\xb9\x91\x2d\x67\x9a\xc0\x20\x0f\xf0\xc5\xc8\x59\x69\x83\xc0\x16\xdd\xe3\xc3\x4f\xe4\xd3\xc9\x86\xbd\xed\x45\xdd\xf5\x03\x34\xf1\xeb\x48\x3f\x50\x18\xf7\xc6\x1b\x85\xe0\xc2\x5f\x3b\x49\x98\xcf\x18\xc0\x42\x58\x91\x02\x1a\xc3\xc8\xf3\xeb\x31\xfb\x22\x0c\x55\xa2\x5e\xb2\x4c\x1b\xae\x1e\xe2\x6a\xe4\x47\x92\x31\xf0\x62\x6c\xd9\xab\x15\x78\x01\xf6\xf9\x20\x0e\x3c\x87\x39\x65\x2e\xa2\x56\x28\x99\x2f\xd1\x18\x5f\x19\x01\xba\xc0\x36\x76\x9b\x04\x00\x27\x8e\x26\x4f\xd4\x9c\xeb\x7e\xc0\x4d\x77\xfe\xad\x61\xe9\x29\xe8\xc2\x71\x67\x51\x8f\x07\x0e\x1f\x7c\x62\xbe\x00\xa7\xf7\x4e\x08\xcc\x31\xf9\x18\x47\x74\xc9\x48\x5c\x10\x7f\x65\x3f\xd8\x57\x0a\x74\x99\xbd\x1f\x5d\xc1\x15\x2c\x16\x29\x2c\x16\x4e\xe4\xd6\x62\xc3\xec\x9a\x39\xf6\xab\x89\x13\xb8\x2e\x0a\x37\x82\x89\xfa\xbb\xd1\x2d\x8f\xef\x78\x2a\x7f\x4e\xc8\x43\x4e\xd9\x44\x6d\x82\x3d\x5d\x4e\x37\x4e\x07\xa8\xfe\xe8\xa1\xb8\xf8\x29\x99\x42\x4b\x63\x81\xcd\x0c\x33\x10\x73\x93\x6b\xd6\xd8\x3c\x0a\xb4\xed\xef\xd9\x63\x88\x6d\x32\x61\x4e\x89\x41\x97\xb3\x9d\x67\x46\x57\xcc\xa2\x9b\x1a\xe8\xc3\x9b\xac\x9d\x8d\xaf\x98\xc4\x17\xf2\xfa\x8a\x2e\xe3\x63\xfc\xef\x00\x9b\x9a\x74\xb2\x3c\x11\x00\x00")
+
+func apidocIntersectGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocIntersectGo,
+		"apidoc/intersect.go",
+	)
+}
+
+func apidocIntersectGo() (*asset, error) {
+	bytes, err := apidocIntersectGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/intersect.go", size: 4412, mode: os.FileMode(436), modTime: time.Unix(1792001979, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocLegacyGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xcf\x6b\xeb\x38\x10\x3e\x5b\x7f\xc5\xd4\x87\x92\x2c\x46\x59\xf6\xf8\x4a\x0f\x0b\xbb\x85\xd2\x6d\x5f\x79\xec\xb2\x87\x10\x8a\x6a\x8d\x6d\xb5\x96\x64\xa4\x71\x4a\x28\xfd\xdf\x97\x91\x9c\x3a\x69\x1b\xd8\x77\x73\x34\x33\xdf\x7c\xf3\xcd\x8f\x0c\xaa\x7e\x56\x2d\x82\x1a\x8c\xf6\xb5\x10\xc6\x0e\x3e\x10\x2c\x44\x51\xa2\xab\xbd\x36\xae\x5d\x3d\x45\xef\x4a\x21\x8a\xb2\x35\xd4\x8d\x8f\xb2\xf6\x76\x15\x7c\x3b\xe0\x30\xe0\x4a\x0d\xa6\xf6\x76\x50\x94\xdc\x68\x37\x60\x2c\xd9\xd5\x0f\xcf\xad\x34\x6e\x85\x21\xb4\x5e\x6e\x7f\x5b\x35\x96\xf8\x87\x0f\xb1\x14\x4b\x21\x56\x2b\x18\x87\x36\x28\x8d\x7f\x61\xab\xea\xdd\xfe\x57\x04\x05\x5b\x0c\xd1\x78\x07\xbf\x82\xf6\xf5\x68\xd1\x91\x84\x3f\xa6\xaf\x08\x43\xf0\x7a\xac\x51\xc3\xe3\x8e\x51\x9e\xc6\xa7\x31\xb3\x87\x80\x3d\xaa\x88\x11\x9a\xe0\x2d\x3c\x62\xe3\x03\xc2\xdf\xbb\x01\xaf\x5d\xe3\xe1\x45\x45\x50\x5a\xa3\x86\x4e\x6d\x11\x9c\x7f\x37\x31\x4c\x63\xb0\xd7\x17\x60\x5c\x24\x54\xba\x02\xea\x10\xee\x55\x50\x16\x94\xd3\xf0\x03\xe3\xd8\x53\x76\x8a\xe0\x1b\x40\x55\x77\x60\x91\x3a\xaf\xa1\xf3\xbd\x66\x08\xd6\xa1\x47\x42\x60\x15\x40\x63\x63\x9c\x21\xe3\x5d\x94\x70\xe5\x03\x50\xe7\x23\xbe\x57\x14\x2b\x50\x7d\x9f\xd2\x38\x65\x51\xa7\xa0\xc8\x30\x2a\x20\x58\xbf\x45\x0d\xc6\xd1\x4c\x32\xf1\x08\x38\xf4\x2a\xd7\x0e\x01\x1b\x0c\xe8\x6a\x64\xa8\x08\xc6\x31\x18\x03\xd4\x63\x08\xe8\x08\x1a\x1f\xac\x22\x29\x9a\xd1\xd5\xc7\x62\x2f\xb4\xaf\xc1\xaa\x61\x1d\x29\x18\xd7\x6e\xb8\x75\xf2\x87\x7a\xb9\xc5\x18\x55\x8b\x4b\x48\x8d\x82\x57\x51\x98\x06\x1e\x2a\xf0\xcf\xf0\xed\x92\xa9\xaf\xcb\x3d\x9d\x72\x73\xc1\xcf\xaf\xa2\x28\x02\xd2\x18\x1c\x38\xd3\x8b\xe2\x4d\x14\x5b\x15\xa0\x51\x75\xea\xe5\x7a\x73\x95\xbe\x38\x22\x81\x69\x45\xea\x08\x2f\xdb\xe3\x01\x9c\x69\x38\x3d\x3b\x24\x5a\xff\x38\xab\x42\xec\x54\xbf\xc8\xb1\xe7\x13\xf6\xf2\x22\xb9\x9d\x5d\x72\xe2\x14\xb8\x27\x92\xc8\x47\x79\xe7\x09\x9b\x05\x86\x50\xb1\x47\x05\x65\xad\x9c\xf3\x04\x83\x0a\x11\xa1\xcf\x53\x37\x81\x95\x4b\x51\x30\xf7\x37\x51\x90\xd9\xa7\x4e\x1d\x91\x77\xf8\xc2\xec\x17\x4b\x51\x34\x3e\x40\xb2\x06\xe5\x5a\x7c\x2f\x92\x73\xb3\xe9\xe9\x93\x69\x6d\x36\xf2\x36\x0d\x49\xcc\x0c\x2d\xbb\x9c\x7f\xb6\xae\x9f\x36\xc9\x2c\xf3\xc8\x5d\x42\xe7\x4d\x24\xd6\x3a\x2e\xc8\x54\x30\x19\x98\x65\x61\xe5\x34\x8d\x5f\x78\x65\xcb\x5c\x0c\xf7\x82\x55\x4a\x92\xe4\x06\x1c\x89\x5e\x25\x0d\x27\xa5\x6f\x27\x9d\x4f\x0a\x7c\xac\xef\xbf\x41\x0d\x2c\xef\x32\xc9\x66\x9a\x8f\xf3\xf1\x25\x36\x99\x9f\x83\x9d\x6c\x3c\x5b\x6f\xe9\x68\xcc\x25\xf3\x32\xc7\xaf\x96\x28\xaf\x5e\xda\x9f\xde\x38\x4c\x9b\x01\xe4\x81\x4c\xc5\x08\x79\x87\x8c\x6b\x39\xce\xc2\x8b\xa1\xee\x60\x97\x24\x5c\x13\xe4\xac\x91\x1d\x26\x77\xb4\xd3\x46\xc1\x7e\xa1\x8e\xb4\x87\x5f\xe6\x81\xe1\xea\x2b\xa0\xc3\x27\x16\x65\xf9\xf1\x61\x5a\x2f\x82\xcb\x4f\x52\xec\x57\x89\xcd\xf2\x4e\x59\x94\xf7\xcf\xed\xbd\xa2\x6e\xb1\x64\xef\xb2\xe4\xd8\x22\x31\xf8\xb3\x47\x3b\x4f\x00\x2d\x67\x10\xda\x43\xbc\x2f\x30\x19\x99\x5c\xd7\x19\x74\x73\x01\x67\xfe\x19\xce\xcf\x81\xe4\x8d\x71\x1a\xce\xde\xa1\x57\x2b\xf8\x5d\xeb\x54\x3f\x13\xde\xdf\x52\x43\x11\xb0\x4f\x5a\xc4\xec\x45\x1e\x86\x80\x5b\x16\xc7\xb8\x74\xf0\x10\x02\xd6\x63\x3a\xe0\x52\x14\xc5\xc7\x9c\x70\x09\x74\x9a\xfb\xdc\xf1\xf3\x63\xb1\xb8\x5e\xe6\xfc\x6d\x12\xa4\x62\xdf\x37\x71\xd0\x8a\x43\xb0\xff\xd5\x8e\x57\x51\x90\xe4\xa8\xcf\xeb\x96\xdf\x97\xec\x70\x83\xbb\xaf\xec\x37\xb8\x9b\x0e\xc2\x43\x05\xcd\xbc\xf8\x24\xaf\xf2\x7f\x04\x13\x6e\x52\xa6\xcf\xe1\xf9\x3d\x0f\xf8\x87\x9b\x42\xf2\xda\xa5\x0e\xf0\xc7\xda\x6c\xbe\xca\x9d\x0c\x27\xa2\xbf\x8f\x34\x85\x7f\x1f\xe9\x44\x7c\xb6\xcc\x00\x0f\x15\xd8\x43\x8c\xc3\x8b\x65\x4f\x94\x60\xe7\x12\xde\xc4\x7f\x03\x00\x13\xda\x06\x38\x45\x08\x00\x00")
+
+func apidocLegacyGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocLegacyGo,
+		"apidoc/legacy.go",
+	)
+}
+
+func apidocLegacyGo() (*asset, error) {
+	bytes, err := apidocLegacyGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/legacy.go", size: 2117, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocLoadGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x5d\x6f\xdc\xb6\x12\x7d\x96\x7e\xc5\x5c\x01\xc9\xdd\xbd\x51\xb4\xb6\x93\x0b\x04\x76\x5d\x20\x68\x1b\x34\x40\x53\x04\x69\xda\x3e\x18\x7e\x98\x25\x47\x12\x13\x2d\x29\x90\x94\x36\x9b\xc0\xff\xbd\x18\x52\x1f\xbb\xf6\x3a\x71\x1e\xfa\x60\x63\x49\xf1\xcc\x0c\xe7\x1c\x1e\xb2\x45\xf1\x11\x2b\x02\x6c\x95\x34\x22\x4d\xd5\xa6\x35\xd6\xc3\x22\x4d\xb2\xf5\xce\x93\xcb\xd2\x24\x13\x66\xd3\x5a\x72\x6e\x55\x7d\x56\x2d\x4f\x90\x16\x46\x2a\x5d\xad\x3e\x38\xa3\x79\x42\x99\x95\x32\x9d\x57\x4d\x96\xa6\x49\x56\x99\xf6\x63\x55\x28\xbd\x22\x6b\x2b\x53\xf4\x67\xab\x72\xe3\x79\x60\xac\xcb\xd2\x65\x9a\xae\x56\xf0\x53\x67\x2d\x69\xff\x87\xa8\x69\x83\x7f\x91\x75\xca\x68\xa8\x4d\x23\x1d\xf8\x9a\xa0\x1f\x66\x4c\x19\x86\xd2\x88\x6e\x43\xda\x43\x69\xec\x06\x3d\x07\x90\x54\x2a\x4d\x12\xd6\x3b\x78\xad\x4b\x53\xc0\x6b\x0f\xae\x36\x5d\x23\x61\x4d\xa0\xb4\xb0\xc4\x08\x92\x39\xa0\x96\x80\xb0\x51\x95\x45\xcf\x69\x50\x4a\x92\x1c\xc3\x9b\x79\xd6\xe5\xb0\xad\x49\x53\x4f\x36\xa4\x8c\x99\x40\xd4\xa8\x2b\x72\xa0\x34\x20\x6c\x71\x07\xbe\x46\x0f\xa6\x91\x64\x39\xc2\x58\x98\x83\x6d\x48\xad\x8d\x07\x49\xc2\x48\x02\x61\xac\x25\xe1\x9b\x5d\x91\xae\x56\xbc\xf6\xe7\x69\x6d\x45\x9a\x2c\x7a\xae\x9e\x4a\x63\x09\x0e\xdb\xb0\x45\xce\xe7\xad\x91\x9d\x88\x85\xd6\xd8\x13\x68\x73\x6b\x5d\xa9\xa8\x91\x71\x77\x96\xc0\x5b\x0a\x21\xd1\x4d\xdd\x3b\x29\x52\x61\xb4\xf3\xc7\xbb\x7d\x09\xcf\x03\x15\x73\x0b\xf6\x08\x28\x3b\x2d\x42\x5b\xe2\x86\xbb\xb6\xb2\x28\x67\x22\x1c\x03\xd7\xe4\xb7\x44\x7a\x4c\xe7\xce\xf7\xba\x79\xa5\xae\x47\x90\x03\x9c\x70\x50\x5a\xb3\x61\xec\x58\xa2\x02\x6f\xe6\xc1\x93\xd3\x02\x7e\x41\x51\x83\x72\xd0\xa2\x73\x24\x43\x31\xde\xb4\xd0\x50\x4f\x0d\x23\xc3\xae\xdd\x6d\x65\x30\x7b\x8a\x81\x1e\x36\xb8\x1b\x68\x63\xd6\xda\x06\x05\x15\x69\x8f\x76\xaf\x3a\xb8\x84\xab\x6b\xde\xe2\x42\x1a\x01\x1b\x6c\xaf\x9c\xb7\x4a\x57\xd7\x2c\xe8\xe2\x1d\x6e\xdf\x90\x73\x58\xd1\x12\x82\x6a\xbf\xa4\xc9\x6a\x05\x63\xdf\x4e\xa3\x80\x42\xfa\x23\x84\x5c\x4c\xdb\x39\x09\x30\x6c\x9c\x01\x61\x78\x72\xaa\xd6\x85\x3e\x8c\xec\xbf\xdf\xb5\xc4\x1a\x0e\xc4\x87\xd8\x45\x9a\x9c\x9c\x8f\xfd\xfb\x8d\x2a\x14\xbb\xfc\xa0\x86\xb3\xa1\x86\x57\x28\x50\x06\x70\xf1\xb2\x93\x8a\xb4\x20\x57\xa4\xc9\xe9\x39\x7f\x9f\x66\x0e\xb1\xcf\xee\x62\xdf\x63\xc5\xb0\xb3\x00\xe3\xc1\x21\xe2\xf9\x80\xe0\x1d\xe3\x10\x14\x7c\xc4\x3c\x3b\x48\x15\xb1\x37\x29\x13\xf5\x8e\x50\xbe\x52\x0d\x81\x25\x1c\x54\xf5\xa1\xfb\xd0\x45\xa7\x01\xd3\xf9\xb6\xf3\xcc\x10\x47\xd5\xb8\x21\x09\xa5\x6a\x28\x67\x68\xdc\xb9\xd2\x15\x28\xcf\x02\xe1\x25\x22\x6a\x78\xb0\x00\x50\x25\x68\x12\x4c\x93\xdd\x15\xf0\x9e\x25\xab\x1a\x62\x30\xf3\xbf\x26\x60\xb3\x7a\x3a\x5a\x17\xc9\x22\x65\xbe\xa7\xa2\x16\x2d\xfa\x1a\x22\xeb\x4b\x58\xfc\x8f\x5b\x98\x47\xb6\x97\xf0\x25\x4d\x24\x7a\x0c\x63\x38\xbf\x84\xe8\x6d\xc5\x01\x76\x99\x26\xaa\x0c\x0b\xfe\x73\x09\x5a\x35\x0c\x4a\x2c\xf9\xce\x6a\x1e\x0e\xb1\x5c\xf1\xb7\xc5\x76\x41\xd6\x2e\xd3\xe4\x66\x3f\xec\x65\xf0\x89\x58\xdd\x82\xa7\x1f\x1a\xf0\x77\xe3\xa9\xe4\x88\x39\xaf\xca\x21\x13\xa8\xd9\x76\x1a\x83\x12\x1e\xb9\x2c\x87\xa1\xbc\x9b\x34\x51\xe3\xae\x78\x17\x6f\xd1\x3a\xfa\xd7\x52\x0d\xf0\x98\x51\xab\x66\x10\x41\x48\x0a\x2d\xff\x8f\x12\xa8\x54\x4f\xfa\xae\x10\xf2\x03\xce\x07\x73\xfe\x16\xed\x91\xd2\x79\x5f\x70\x75\xcd\x97\xd6\x31\x3e\xf9\xfc\x7f\xfd\xac\x4f\x3d\x39\xbf\x84\x60\x03\x7f\xea\x0d\x5a\x57\x63\x13\x7a\x96\xc3\x63\x69\xc4\xf2\xe2\xbb\x29\x1f\xdd\xe0\xfc\x12\x4e\x42\x8e\x3e\x07\xf3\x91\x09\x91\x46\x5c\x65\x07\x0e\x92\x5d\x5f\xf0\x37\x8e\x7b\x6f\x31\x7d\x0e\x8f\x87\x98\x77\xab\x79\x10\x8b\x4a\xf7\xd8\x28\x79\x78\x9b\x64\xcb\x34\x61\x85\xb2\x6a\xca\xc9\xc2\x7e\x3c\x7e\x75\xdc\xa7\x17\xda\x96\x8b\x6c\x34\x39\xa8\xd1\x81\x0b\x39\xa6\x78\x8f\xe4\x64\xd4\x0e\x34\x6d\xc3\x65\x8b\xd1\x03\x5c\xd7\xf2\xdb\x83\xe4\xde\xea\x2c\x1f\x07\xf9\xd1\x4a\x96\xb7\x0b\xfe\xe1\xfe\x82\x4b\x63\xa1\xe7\x86\x0e\x6b\x2f\xa0\xbf\x67\xf9\x05\xf4\x4f\x9e\xb0\x6a\xf6\x69\xd8\xbb\xdb\xfa\xeb\xc5\x51\x2d\x7c\xd7\x21\x1a\xdc\x7d\xba\x13\xe2\x95\x70\xa7\x5f\xdc\x01\xa6\x86\xf7\x19\xfe\x58\xc9\xac\xc1\x10\x3e\x0a\xe5\x98\x90\x46\x97\x09\xea\x79\x33\x68\xe7\xd8\x66\x1f\x26\xa2\x3d\x4d\x87\x2a\x54\x09\xfb\x5e\x76\x90\xe5\x78\x6f\xbe\x1d\x94\x0f\x0b\xda\x60\x21\xc0\xe7\xf7\xdb\x27\x92\x97\xde\x4d\xf5\xf5\x4c\xb3\x57\x3d\x3e\x30\x2b\xee\xeb\x22\x4d\xf8\xda\x78\x83\x95\x12\xe1\x8d\xc0\x76\xf2\xe5\xe4\xd3\x69\x99\xc3\xc9\xa7\x17\xeb\x9b\x34\xf9\xec\xbc\xbc\xf3\xfd\xec\x05\x7f\x5f\xff\x9f\xff\x9f\x85\xb5\xa5\xbc\x19\x5e\xba\xb3\xcd\x43\x2c\x2c\xfa\xe0\x3c\x4d\x12\x84\xd1\x3e\x3c\x0b\x4c\x6c\x2b\xfb\x9f\x2a\x41\xf9\xff\xba\xdb\xf7\x58\x0e\xc6\x86\x35\xa0\xbc\xa3\xa6\x04\xe3\x6b\xb2\x5b\xe5\x68\xb0\xc3\x39\xf0\x2d\x4f\x8c\x3f\x86\x96\x84\x4b\xce\x6d\x95\x17\x35\xff\x12\xe8\x08\xf8\xb3\x2b\x7e\x45\xf7\xd6\x52\xa9\x3e\x0d\x4d\x9e\x1a\xb2\x3c\xe7\x53\x3f\x5d\x27\x3c\xcf\x27\x9e\x6f\x45\xb2\x8b\x08\x9e\xc7\x0c\x5e\x2e\x67\x23\xfb\x3e\x29\xdc\x7b\xff\xbe\x6c\x9a\x85\x7d\x78\xd8\xfb\x0e\xdf\xdc\xa4\xd1\xf7\x46\x74\x4c\xcc\x9a\xf8\x5a\x53\x26\x15\xc4\xa6\x1c\x49\x1c\xac\x90\x97\xed\x71\xb7\xf7\xfe\x43\xcb\x0f\x7a\x3f\x9b\xde\xc5\x5e\x4d\xb0\x55\xbe\x06\x06\xc3\x53\x7e\x15\x59\xe7\xb3\x03\xe5\xce\x45\xde\xa4\xff\x0c\x00\x11\x96\x69\xe5\xc2\x0d\x00\x00")
+
+func apidocLoadGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocLoadGo,
+		"apidoc/load.go",
+	)
+}
+
+func apidocLoadGo() (*asset, error) {
+	bytes, err := apidocLoadGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/load.go", size: 3522, mode: os.FileMode(436), modTime: time.Unix(1792000310, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocLogintargetGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x6b\xdb\x4c\x10\x3e\x6b\x7f\xc5\xa0\x43\x5e\xfb\xc5\x91\xee\xa1\x29\x84\x52\x4a\xa1\x29\x3d\xf4\x56\x0a\xd9\xac\x46\xd2\x36\xd2\xae\xd8\x19\xc5\x98\x90\xff\x5e\x66\xf5\x65\x39\x0e\x49\x7d\x92\xa5\x9d\xe7\x73\xa4\x4e\x9b\x07\x5d\x21\xe8\xce\x16\xde\x28\x95\xe7\xf0\xcd\x57\xd6\xfd\xd4\xa1\x42\x86\x80\xc6\x87\x82\x60\x5f\x5b\x53\xc3\xcd\x8f\xaf\x40\x18\x1e\x31\x00\xba\xa2\xf3\xd6\x31\x81\x86\x52\x1b\x5d\x20\x18\xed\xe0\x1e\x05\xa1\x27\x2c\xc0\xbb\x0c\x6e\xc0\x78\xe7\xd0\xb0\xf5\x0e\x1a\x5f\x11\x58\x07\x68\xb9\xc6\x00\xec\x81\x6b\x94\x03\x1c\x7c\xd3\x1c\x61\xee\x04\x23\xcd\x75\x67\xd3\x1d\xf8\xf9\xe4\xf4\x18\x7c\x09\x1a\x5a\x5f\x60\xb3\x83\x34\x8f\x17\xf9\x87\xbe\xb7\xc5\xc7\x71\x46\xbb\x42\x26\x04\xe6\x48\x72\xc0\xb2\x27\x24\x30\xba\x69\x48\x40\x07\xdd\x04\x5c\x6b\x06\x1d\xd0\xfd\xc7\x83\xbd\x02\x4a\xa1\x1d\x10\x1e\xac\x2b\x84\xb2\x91\x58\x76\x63\x12\x96\x00\x35\xd9\xe6\x00\xad\x25\xd6\x0f\xe8\xe2\x88\x86\x0e\x43\x6b\x89\xc4\x6f\x17\xfc\x7d\x83\x6d\xa6\xf2\x5c\x70\x6e\x2a\x8c\x71\x35\x7b\x7d\x20\x09\x43\xb2\x60\x3f\x59\x59\xdc\x03\x79\xf0\xae\x39\x88\x80\xe1\x99\x8c\xcf\xee\x5b\xcd\x8c\x81\x26\x89\x6b\x17\x5c\xe3\x01\x7a\xc2\x4c\xf1\xa1\xc3\x55\x95\xc4\xa1\x37\x0c\x4f\x2a\xc9\x73\xf8\xb4\xa4\x1e\xb0\xf3\x81\xa5\x62\x1c\x7a\xa9\xf1\xa4\xd1\x64\xaa\x54\x97\x8c\x41\xa4\x57\xd6\x4d\xf2\x5f\xe9\x30\x53\xc9\x11\xc7\xbd\xf7\x0d\xdc\xfd\x21\xef\xae\xd2\x9d\x6f\x2d\x63\xdb\xf1\x21\xbd\x53\x51\xcc\xad\x78\x7c\x5b\x87\xf8\x2a\xe2\xc0\x39\x1d\xa7\x31\x66\x2a\x19\x70\x5f\xe5\x7e\x8e\xdb\xfe\x79\x3c\x0f\x9d\xe6\x7a\x49\x55\x5a\xa7\xb9\xf6\x4c\x19\xef\x88\x61\x73\xec\x6a\x9e\xbc\x1e\x97\x75\x64\x9c\xef\xc7\xdf\xf5\xb9\x15\x55\xdb\x15\x37\x41\x40\xee\x83\x93\x55\xc4\x51\x88\x2f\x57\x4b\xbf\xf4\x2b\x83\x2f\x73\x91\xd7\x4d\x95\xbd\x33\xb0\x61\xf8\xff\xa8\xf7\xed\xc2\xb2\xd9\xc2\xaf\xdf\xc4\x41\x42\x7b\x52\xc9\xa3\x5e\xea\xa2\xf9\x89\x4a\x6c\x09\x9c\x1d\x95\xf7\xa4\x92\x64\x39\x77\x0d\xba\xeb\xd0\x15\x9b\xf9\xd6\xee\x68\x9b\x26\xae\xad\x4a\x9e\x47\xa4\x98\xc9\x3b\x40\x56\xd9\x0d\xf3\x43\x2a\x8b\xc8\xb1\xb2\x66\x71\xf7\xdd\x33\xce\xe1\x69\x70\xf2\xf7\x6c\x72\x32\x37\x7f\xa9\xf6\x96\xeb\x78\xa6\xb2\x8f\x18\x3f\x4c\xb2\x43\x11\xf0\x7c\xa6\x27\x8c\x9b\x96\x2a\x82\x5b\x24\xd2\x15\xd2\x0e\x4e\x13\x5f\x32\xa6\xbd\x65\x53\xcb\x95\xd1\x84\xeb\x5c\x2f\x2e\xa6\x74\xae\x54\x32\x79\x15\xe4\xec\x0b\xf2\x26\x8d\x9c\x97\x1c\x49\x2f\xb5\x3b\xa4\xe7\x72\x7e\x19\xdb\x0b\x9e\xb7\xc1\x97\xd7\xf7\xdf\x38\xde\xa9\x3d\x6e\x7f\x7a\x82\x72\x8e\x68\x55\xfa\x2b\x60\xce\x3b\x4c\xb7\xea\x59\xfd\x1d\x00\xf7\xa0\xfb\x62\xb9\x06\x00\x00")
+
+func apidocLogintargetGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocLogintargetGo,
+		"apidoc/logintarget.go",
+	)
+}
+
+func apidocLogintargetGo() (*asset, error) {
+	bytes, err := apidocLogintargetGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/logintarget.go", size: 1721, mode: os.FileMode(436), modTime: time.Unix(1791999182, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocLookupGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\x41\x6b\x1b\x31\x10\x85\xcf\xab\x5f\xf1\x9a\x83\xf1\x1a\xb3\xbe\x37\xf8\x5a\xc8\xa1\xa5\x94\xd0\x4b\xc8\x41\x5d\x8d\xd6\xd3\xec\x4a\x42\xd2\x26\x98\xc4\xff\xbd\x8c\x76\x63\xd6\x49\x0a\x01\x1f\xec\xa7\xa7\x37\x9a\x6f\xc6\x41\xb7\x0f\xba\x23\xe8\xc0\xc6\xb7\x4a\xf1\x10\x7c\xcc\x58\xab\xea\xaa\xe3\x7c\x18\xff\x34\xad\x1f\x76\xd1\x77\x81\x42\xa0\x9d\x0e\xdc\xfa\x21\xe8\xbc\xfb\x9b\xbc\xcb\xc7\x40\xe9\x4a\xd5\x4a\xed\x76\xf8\xa6\x5b\x6d\x08\x91\xf2\x18\x5d\x42\x3e\x10\xec\x24\x3d\x71\x3e\x94\xdf\x1d\x3f\x92\x83\xd3\x03\x41\x3b\x83\x47\x8a\x89\xbd\xdb\xca\x6d\x1f\xe1\xb8\x07\x5b\x31\x46\x02\x27\x38\xef\xa8\x51\x76\x74\x2d\xd6\xec\xac\xc7\xe6\xc6\x59\x5f\xcf\x85\xd6\x25\x26\xe5\xc8\xae\xdb\xbe\x46\x81\x5d\xae\xb1\x99\x1c\xe2\xc6\xb3\xaa\xac\x8f\x60\x7c\xdd\x23\x6a\xd7\x11\x24\xaa\x99\x1c\x49\x8e\x2b\x2b\x67\xab\xa5\x7c\xc7\xf7\xaa\xaa\xd8\xc2\x36\x3f\xa4\xca\x7e\x3f\x3d\x7a\xb5\x82\x6d\x7e\xcf\xa5\xf6\xfb\x73\x55\x49\xa9\xa6\xc6\x61\x55\x55\x9d\x94\x7c\x66\xc1\x71\xaf\x4e\x85\xd0\x77\xca\x07\x6f\x2e\x08\x0d\x93\xf4\x01\xa1\xcf\x50\xb1\xcb\x56\xeb\x39\x7f\x09\xa6\xc6\x66\x2e\xfa\x9e\x83\x6d\xa6\xa3\x09\xc2\x20\x07\xab\xb3\x76\x26\x30\x5c\x12\x58\x76\x3a\xfc\xbf\xd3\xdb\x63\x78\xb3\x09\x63\xdf\xc3\x90\x65\xc7\x99\xbd\x83\xb7\xc8\x0d\x6e\x2c\xb2\xf4\xa4\x11\xc9\x52\x24\xd7\x92\xdc\xce\x1e\xba\x94\x33\x90\x0d\xdb\x42\x27\x58\x3f\x3a\x03\x76\x02\x02\x3f\x75\xd4\x43\x59\xa1\x5f\x94\xc6\x3e\xc3\x32\xf5\x26\xc9\x5d\x6f\x67\x0c\xdb\xe2\x5c\x94\xe4\x84\xde\xfb\x07\x32\x18\x83\x04\x95\x81\xdf\x1e\x43\x81\x77\x5d\xee\xca\xea\x3d\x71\xa2\xe9\x55\xd3\xfb\xc5\xef\xda\x83\xac\x8e\xf9\x68\x1b\x25\x61\x9d\xb1\x39\xff\x1f\x1a\x51\xea\xb7\x82\x40\x96\x31\x16\x92\xdc\xe3\xe5\xe5\xf2\x01\xaf\xfa\xb3\x3a\xf3\xcd\x85\x2d\x5b\x98\x2c\xc3\xb9\xb0\x97\x2f\xe9\x2e\x97\xe9\xdc\x5f\x8b\xe5\xcb\xbb\x00\x93\x97\xd3\xc9\xea\xa4\xfe\x0d\x00\x01\x52\x55\x9d\xee\x03\x00\x00")
+
+func apidocLookupGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocLookupGo,
+		"apidoc/lookup.go",
+	)
+}
+
+func apidocLookupGo() (*asset, error) {
+	bytes, err := apidocLookupGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/lookup.go", size: 1006, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocMarkdownGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5b\x73\xdb\xb6\xf2\x7f\xb6\x3e\xc5\x96\x19\x75\x48\x5b\xa2\xdb\xff\x63\x3c\xfe\xcf\xa4\x49\xdb\x93\x4e\xd2\xa4\x96\xdb\x3c\x24\x99\x0a\x26\x97\x12\x62\x12\x60\x00\xd0\x8e\xe7\xd8\xdf\xfd\xcc\x02\x20\x09\x52\x94\x2f\xe7\xf2\x10\x47\x24\xb1\xbf\xbd\x62\x77\xb1\xa8\x59\x76\xc9\x36\x08\xac\xe6\xb9\xcc\x66\x33\x5e\xd5\x52\x19\x88\x67\x07\x51\x51\x99\x68\x76\x10\x71\x49\x7f\xb5\x51\x5c\x6c\x74\x34\x9b\x1d\x44\x1b\x6e\xb6\xcd\x45\x9a\xc9\xea\x58\xc9\x4d\x8d\x75\x8d\xc7\xac\xe6\x99\xac\x6a\x66\x8e\xbf\x68\x29\xcc\x4d\x8d\x9a\xc8\x36\xb2\xbe\xdc\xa4\x5c\x1c\xa3\x52\x1b\x99\x5e\xfd\xdf\x71\x51\x19\x7a\x90\x4a\x47\xb3\x64\x36\x3b\x3e\x86\x33\x14\x39\xaa\xb7\x4c\x5d\xe6\xf2\x5a\xc0\xb5\xe2\x06\x35\x70\x51\x48\x30\x12\xae\x81\x69\x60\xd0\x7d\xce\x65\xd6\x54\x28\x0c\x6c\x65\x99\x73\xb1\x21\x04\xb3\x45\xd0\xac\x42\x4b\xa4\x2a\x66\xb8\x14\x44\xe6\x90\xff\x71\xfe\xf6\xcd\x02\xae\xb9\xd9\x02\x03\x8d\x99\xfd\x5a\x48\x05\xc8\xb2\x2d\x91\x17\x2c\x63\x39\x11\x5b\x20\xa9\x72\x54\xb0\xe1\x57\x28\x80\x89\x9c\x68\x9a\x8b\x31\x19\x54\x68\xb6\x32\x4f\x89\xfc\x85\x76\xe0\x21\x37\x12\x04\xf4\x56\x36\x65\x6e\x05\x05\x21\xa1\x92\x0a\xc1\x6c\x99\x00\x29\x90\x08\xaf\x50\x69\x02\x95\x85\x15\xc5\xcb\x91\xce\x8a\x46\x64\x23\xab\xc4\xd7\xc0\x65\xfa\x81\x4c\xa3\x3c\xfa\xe1\x6b\x51\xc8\x04\xac\x2d\xe1\x9f\xb3\x03\x85\xa6\x51\x62\x44\xf7\x16\xb5\x66\x1b\xd4\xf1\xb5\xa3\x5a\x80\xe0\x65\x32\xbb\x9b\x30\x7c\xbb\x14\xb8\x86\x92\x5f\xe2\xe8\x33\x5c\x34\x06\x0c\xbb\x44\x4d\xa4\xdc\x68\x30\xf8\xcd\x40\xa1\x64\x65\xcd\xe6\x0c\x56\x39\x10\xc8\x98\x61\xa5\xdc\x4c\xea\xd2\xcb\x34\xad\xd3\x02\x2a\xbd\xd1\xd0\x2e\x0b\x54\xbc\x62\x0a\x2e\x9a\x02\x7c\x30\xa6\x3f\x35\xbc\xcc\x51\xcd\x0e\x8a\xca\xa4\xbf\xd4\x8a\x0b\x53\xc4\xdf\x5f\x34\xc5\x02\xa2\x67\x30\xd7\x9f\x44\xe4\xc0\xd2\x5f\xd1\xc4\x91\xe1\xa6\xc4\x28\x49\x66\x07\xbc\x80\xac\x52\xf0\xfc\xd4\x1a\x25\x7d\xa9\xa4\xd6\x6f\x65\x8e\xe5\x2f\xd6\x05\x3a\x4e\x4e\xa0\x44\x11\x67\x95\x4a\xe0\xff\xe1\x07\xb2\xef\x14\x93\x4f\xe2\x99\xe3\x33\xe2\x94\x11\xe0\xb2\x22\xc4\xe5\x16\x19\xc5\xa9\xe5\x7b\x70\xd1\x14\xce\x8b\x2b\xab\x42\x3c\x4d\xc2\x85\x51\x32\x4a\xe0\x88\x38\x7c\x12\x11\x51\x52\xe4\xfd\xbd\x00\x6d\xb0\x26\xb9\x15\x13\x64\xe6\x4e\xf0\x95\xc1\x5a\x5b\x31\xa7\xe4\x5c\xee\x18\x83\x70\xac\x44\x77\xfb\x34\x7b\x40\x2d\x17\xac\x3a\x4a\x02\xe1\x8a\x40\xb2\x4a\xdd\x2b\x0d\x5c\xcd\x73\x8b\x5e\xa4\xbf\xb3\x0a\x17\x50\xa4\x7f\xb9\xed\xe0\x85\xba\xb3\x5e\x22\x31\x75\xe7\xa7\xb7\x7c\xa3\xec\xe6\xfe\x20\xd5\x65\x51\xca\xeb\xd6\x4f\x76\x59\xef\x29\x26\xb2\xad\x54\x96\xae\xf2\x41\xf7\xc2\xbd\x8a\x09\x27\x79\x9a\x33\xab\x96\xeb\x63\x5d\xd9\x13\xec\x73\x24\x1f\x3b\x52\xf7\xee\x2b\xb9\xb8\xf4\xa2\x5f\x62\xfc\xf1\xb3\x0b\xf6\x45\xa7\x67\xfa\xd6\xa6\x1e\x6d\x0d\x6f\xd1\xbe\x2c\x40\x61\x60\xfb\x70\x95\x73\x82\x03\xfd\xf8\xe5\x33\x9c\x02\x69\xbe\xf2\x9a\x47\x1f\xe7\x3a\x9d\xeb\xcf\xf1\xb3\xb9\x4e\x22\x0b\x93\xba\x2d\xe0\x7e\x3b\x56\x0b\xf0\x06\xfd\xa8\xb0\xf8\x6c\xd9\xde\xed\x71\xed\x3c\x4f\x61\xae\x21\x9e\xeb\xc4\x5a\x91\x1f\xfd\x18\x98\xd2\x0a\xf6\xfa\x55\xb2\xe8\x76\xf0\x6f\x92\x8b\xd8\x0a\xb7\x80\x68\x01\x51\x1b\x92\x77\xb3\x89\x98\x22\xdf\x79\xf1\xf4\x03\x3b\xd2\x85\xd7\xfe\x00\xe3\x85\xb5\x67\x91\xbe\xb8\x62\xbc\x64\x17\x25\x9e\xcb\x3e\x7e\xa6\x80\x0f\xe7\xfa\x70\x27\x34\x58\x4b\xbd\x34\x32\x1a\x69\x35\xc0\x6e\xb5\xf3\xea\x51\x68\x17\xe9\x19\x96\xc8\x34\x6a\xf8\xee\x94\xd2\xf2\xd3\x58\x2b\x4f\xdc\xbe\xf4\xcf\x67\x64\xa9\xb8\xc7\x1e\xb1\x3c\x47\x6d\xde\x89\xf2\x66\x3f\x2f\xd2\x73\x97\x9b\x41\x6d\x96\x52\x94\x37\xd1\x22\x40\x39\x43\xa6\xa5\x08\x39\x38\xa3\x9e\xe1\xd7\x86\x2b\xd4\x8f\xb6\xa8\x72\x04\x54\xd7\xf5\xef\xd2\xa0\xcd\x8b\x0b\x08\x90\x06\x5a\xbc\x91\x1b\x2e\xce\x99\xda\xa0\x79\x82\xed\xca\x9e\x6a\xc0\x23\x40\xdb\x55\xe5\x9c\x6d\x1e\x50\x63\x22\x63\x18\xb6\xd1\xe4\xef\x75\x74\x34\x0a\x09\x82\xa3\x0f\xb0\x8e\x92\xa3\x68\xdd\xc5\x3b\x31\x2c\xd2\x57\x32\x23\x7d\xa2\xc8\x31\x1b\x67\x98\x16\xeb\x5c\xf1\xea\x8c\x6f\xb6\x26\x7e\x25\xb3\xb6\xaa\xc6\x96\x3c\xb1\x5b\xc0\xc1\xee\xd0\xf7\x39\x68\xa0\xe2\x1b\x1b\x47\x4f\x56\xd2\x87\x5f\x9f\x86\xfe\x5e\x40\x90\xd1\x5a\x5c\x9f\x80\x26\x30\xa9\x0c\x44\x0b\xa8\xd3\xbf\x58\xd9\xa0\x85\x21\x33\xd4\x76\xbf\x86\x76\x98\xa4\x86\x78\x3d\xd7\x6b\x4a\x5a\x7a\x2b\x95\xf9\xa3\x61\x25\x2f\x38\xe6\x44\x1c\x3b\x0c\x27\x9a\xf5\xa7\x55\xb7\x4d\x8a\x0f\x62\x3f\xa7\x1c\x46\xf0\xbb\x0a\x2f\xb3\x2d\x66\x97\x98\x5b\xc1\x1d\x5c\x42\x3f\x5f\xd2\xeb\x11\xbf\x5c\x66\x64\x8f\xd0\x6d\xab\x9a\x65\x18\xd7\xd6\x55\x27\x90\xcb\xec\x41\x59\x28\x9f\x06\xa9\xe5\x0c\xeb\x92\x20\x72\x99\x39\x57\x93\x29\xa2\x05\x2c\x7f\x1c\x68\x3b\xe1\xfa\xa8\x4f\xdc\xfb\xbe\xfa\xb0\xf8\x4a\x52\x17\xe9\x1f\x0d\xcf\x2e\xb5\x61\xca\x9c\xc0\xd7\x87\xf6\xd9\x44\x80\x7c\xed\xe8\xbb\x2c\x7c\x14\xa5\xd1\xd1\x57\xef\x86\x41\xe4\x68\xc1\xeb\x1a\x4d\x1f\x3f\x5f\xd3\x95\x7b\x75\x4f\x04\xcd\xf5\x73\xe2\xba\x5e\xaf\x89\xfd\x5c\xaf\xd7\x6b\x2f\x85\x87\x4b\xdf\xf0\x0b\xc5\xd4\x4d\xf0\x82\x89\x4d\xc3\x36\xd8\xbf\x79\x29\x73\xec\x4c\x73\xd7\x37\x33\x55\x18\xcb\x83\x6a\x3a\x21\xc9\x33\xdb\x07\xa6\x73\x3d\x2a\x3a\x95\xfd\x3f\xd9\x6b\x34\x1b\x69\x40\xb2\x3f\x9f\x6a\x3d\x6a\xa6\x58\xa5\xa3\x64\xd1\xf5\x31\xe7\x37\x35\xbe\xe1\xe2\xd2\x67\xaf\x2a\x7d\x4f\x4b\x92\x90\x48\xa1\x6e\x4a\x73\x3f\xd5\x99\x5d\xe3\x3c\xc0\x0b\xa8\xc6\xa9\xe7\x89\xb9\xa7\xda\xc9\x3d\xf7\x24\x1f\x17\xa2\x96\xeb\xb8\x02\x7e\xff\x3d\x04\xb5\x0b\x4e\xdd\xdb\xdb\x5b\x38\x1c\xae\x3d\x0c\x0a\xdc\xfe\xf0\x08\x72\xff\x23\xeb\x66\xcf\x25\x49\x06\xa2\x36\x14\x0c\x55\xfa\x27\x1d\x6e\x4e\xa0\x19\xec\x86\x07\x58\x37\x44\x13\x14\x9c\x66\x88\xec\x5c\x4c\xed\x16\x79\x24\xe8\x75\x59\xc6\x94\x94\xe2\x17\x8e\x65\xae\xe3\xde\xd1\xd3\x5f\xbd\x43\x5d\x3b\xec\x30\x5d\x46\xbf\xbd\xb5\xaf\x3c\x7c\x90\xe5\x1f\x6d\xb1\xca\xf3\xa2\x92\xd6\xfe\x0e\xf4\x19\x29\x30\x32\x1c\xf1\xae\xfa\x32\xfe\x68\xf6\x7b\x3a\x82\x00\x6a\x82\xcd\x7b\x54\x15\xd7\xd4\xe3\x3d\x81\x53\xdd\x13\x0d\x18\x85\x60\x03\x5e\xd6\x47\x55\xba\x6a\x6a\x54\x8d\x46\x75\x02\xc3\x0e\x6e\x92\x1f\x31\x3c\xf4\x35\x6b\xc7\xc2\xba\x85\xa2\x3d\xab\x83\x5a\xd2\xb2\xac\xd2\x15\x0a\xcd\x0d\xbf\xc2\x87\x78\xec\x82\xb7\x94\xcb\xeb\x2d\xb5\x6f\x01\x56\xd0\xbf\x75\xda\x55\xe9\x8b\x26\xe7\xe6\xe7\x6f\x59\xd9\xe4\x98\x3f\xca\x84\x3d\x33\x46\xb4\x4b\xf4\xc4\xd1\x18\xfa\xb5\xb8\x92\x99\x3d\x1a\xd1\xde\xee\x9f\x3e\x30\x93\x6d\x51\x3d\x91\xd9\xb5\xa3\xda\xe1\xf2\x1e\xd5\x6b\x83\xd5\xcf\x34\x19\xd1\x4f\xc4\xac\x51\x2d\xb9\xc1\x6a\xe9\x67\x54\x43\xec\x7e\x7b\xae\xb8\xd8\x94\xf8\xb3\x30\xdc\xdc\xb4\x9b\x33\x48\xac\x8f\x8a\x89\x29\xfe\xda\xe2\x2e\xd1\x02\x53\x21\x73\xd0\xda\xa6\x81\xee\xd1\x56\x95\xd8\x06\x8b\x63\xd8\x7f\x77\xcf\x7e\xc1\x50\x7a\x3b\xf0\x20\x11\x8d\xba\x39\x01\x35\x14\x90\xe7\xf4\x35\x52\x68\xd4\xcd\x52\xb3\x02\xa3\xb6\x8d\xf9\x4e\xa5\x2b\x56\xb4\x81\x77\xc0\x73\xe8\xd6\x35\xa2\x5f\x79\x77\x8f\x9e\xcf\xa9\x38\xee\x28\xcb\xf3\x05\xa8\xf4\xa5\x14\x05\xcf\x51\x64\x98\xd0\xa3\x8f\xc9\x49\xb9\x49\xb3\x77\x34\x9e\xdb\x27\xbd\x9d\xdd\x61\x1e\x8a\xfe\xd3\xcd\x7b\x49\xf1\x2f\xc5\x50\x81\x46\x0c\x16\xff\xef\xa4\x77\x19\xd0\x05\x63\x98\x95\x04\xab\x70\xdf\x39\xbf\x5b\x6f\xfd\xd7\x8e\x0c\x88\xa2\x6f\x4a\xda\x35\xad\x5a\xf4\x55\x7f\xe4\x74\xc4\x8f\xd6\x11\x1c\x4d\xf5\xc5\xb4\xc6\x4e\x23\xd6\x0f\x68\x3d\xd7\x53\xed\x48\xbb\x27\x46\x27\x5d\x42\x0d\xcf\xef\x07\x77\x41\x63\x37\x94\xf8\x1b\xab\xea\x12\xf5\x93\x93\x18\x3a\xc2\x68\x01\xfe\xd7\x39\xcd\xf2\x7c\xae\x46\x5f\x73\xc8\xda\x98\xbe\x42\x9d\x29\x5e\x5b\x8f\x3f\xd4\x5c\x77\x3a\x86\xed\x8d\xeb\xd1\x07\x40\xc9\xa8\xb3\x27\x1f\xa1\xdf\x99\xa1\x4f\xf7\xf3\x58\xaf\xd7\x34\x18\xa7\x4e\xcf\x3e\xec\xd3\x70\xd9\xb7\x7c\xfe\xcd\x6f\xab\x77\xbf\xf7\xcc\x26\x05\xf1\x49\xe7\xbf\x2b\x88\xab\xe7\xbb\x82\x78\x66\x81\x20\xbe\x71\x76\x13\x3b\x32\x8d\x6d\x51\xde\xd5\xe8\xc6\x66\xac\xec\x4d\xb4\x7f\x62\xb3\x23\x87\xec\xe9\x1f\x3b\x78\x0b\x49\x06\xa3\x37\xdb\x77\x66\xcc\xe0\x46\xaa\x1b\x9b\x29\xa2\x59\x17\xa1\xba\x8f\xd0\xb1\xe0\xce\x9a\x54\xf3\xd3\x97\x2d\xf5\x77\xa7\xd0\x21\xd9\xcf\x07\xdd\xe3\x69\xb0\x6e\x5f\x80\xd3\xc8\xf8\x41\x7d\xa3\xa3\x16\x33\x49\xf6\x01\xdd\xd2\xd1\x21\xf8\xf3\x49\xdc\x2e\x97\xcb\xee\xdf\x3d\xe8\x1a\x8d\xb1\x13\xe9\x7d\x0b\xae\xe8\x40\x3e\xfc\x9c\xf7\x9b\x21\xdc\xe4\xa3\x23\x6e\x7b\x3a\xdd\xdd\x4e\x3a\x38\x21\xf4\x47\xd6\x3d\xe7\xa2\x5b\x58\xcf\xf5\x7a\xa8\xdc\xf4\x41\x5f\xdb\x42\x67\x0b\xa1\x9d\x22\x2c\xe8\x4c\x9d\x4c\xc6\xe3\x8a\x6a\xaa\xc0\x72\x9c\x89\xef\x0b\xc9\x81\x09\xb5\x07\x18\x46\xe3\x6e\x9a\x9b\xe0\xb6\xf7\xdc\x38\x8a\x06\x4c\x57\xa4\xa3\x2f\xde\x7b\x68\xc8\x36\xdd\xfa\xee\x78\x69\x73\x1f\x9d\x65\x07\xc7\xb8\x09\x72\xaa\x67\x04\x01\xb0\x4f\xbf\x4c\xe6\xd6\xfb\x38\x3c\x1b\xd3\xee\xc6\xd4\x5f\xcb\x3c\x96\xcb\x27\xb1\x8f\x8b\xbf\x2a\x72\x8c\x3c\xea\x88\xd7\x7f\x76\x28\xc5\x27\x1f\x4a\xbb\xa0\x21\x87\x2a\xdb\x76\x70\x39\x20\xb8\x1e\x95\x8a\xa6\x28\xf8\xb7\x98\x60\xfd\x82\x8e\xdf\x89\x45\x08\x9a\x14\x7f\x3f\xe7\x6a\x68\xfa\x41\xb1\x3a\x46\xa5\x12\xcb\xd2\x7f\x13\xbc\xf4\x57\x73\xe3\x83\x3b\xb8\x15\x83\x9b\x50\x1a\x9b\x83\x91\x74\xfb\x46\x57\x72\xed\xc5\x28\xeb\x2e\x2a\xcd\x02\xa4\x82\x48\x1c\xb3\x08\x78\x01\x86\x6e\xf6\x04\x2f\xfd\xa5\xdc\xe4\x6c\xa0\xbb\x75\x5b\x80\x81\xc3\xee\x2a\x37\xa5\x45\x89\x57\x9d\xb4\xb1\x70\xa7\x3b\xca\xf5\x6e\x16\x52\x60\x34\x50\x6e\x7c\xef\xf0\x99\x2e\x09\xa2\x05\x18\xbb\x85\xbb\x8e\xf6\x57\x99\xcb\xec\xcf\xb3\x37\xb1\x69\x67\x78\xce\x22\xdd\x31\x3a\x30\x85\xa0\x47\x59\x90\x05\x40\x34\xd5\x05\x2a\x90\x05\x64\xac\x2c\x35\x28\xcc\xa8\xc1\xcb\x89\x96\x0b\x68\xec\x5d\x2e\x2d\xa4\xcf\xa8\x34\xdd\xc4\x1a\xa8\xe8\xe2\x97\xde\x56\x52\x1b\x0f\x55\x79\x03\x0d\x0f\xee\x81\x65\x1a\x38\x74\xf3\x20\x3b\x0e\x18\x99\x85\xea\x5f\x93\xbe\x74\x4c\x12\x3a\xe6\xfc\x30\x6d\x21\x0b\x4f\xf3\x01\xbb\x58\x3b\x5b\xb5\xc2\x4d\xb7\x85\x3d\x6e\x32\x6b\x9b\xc2\xac\x4f\x3c\xdd\x67\xcb\xd0\x43\x7d\xe4\x3b\x97\x3e\xf6\x82\x26\xa7\xd1\x69\xe6\x29\xda\x5f\x7a\xe0\xb1\x91\xac\x4b\x8f\xd8\xcb\xdc\x6f\x06\xdb\x07\xfa\xef\x5d\x27\xd8\x86\x72\x3f\x32\x98\xf0\xdd\xf5\x16\xed\xb5\x38\xfa\x49\x08\xf9\x89\xc8\xfc\x38\x81\x7c\xc2\xfc\x6d\xbb\xbb\x49\x6f\xf1\x74\x17\xc7\xa3\x91\x44\xe0\xa9\xf1\x70\xa5\x35\x67\xe8\xb3\x2b\xa6\xac\x30\xfd\xd7\xce\x8f\xe1\x1c\x85\x4c\xea\x96\x9d\x02\xab\x6b\x14\x79\x6c\x1f\xc3\xdc\xd6\x89\xb6\xa4\x5a\x11\xb4\x95\xd6\x3c\xad\x30\xdd\x4d\x50\x5f\xa0\xbc\x7c\xff\x0e\x27\x85\x75\x79\x33\x66\xe5\xf1\x86\xbc\xbc\x57\x07\x0b\x3d\x70\x74\x02\x51\x32\xbb\x9b\xfd\x6b\x00\x65\xff\x27\xd5\x0e\x22\x00\x00")
+
+func apidocMarkdownGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocMarkdownGo,
+		"apidoc/markdown.go",
+	)
+}
+
+func apidocMarkdownGo() (*asset, error) {
+	bytes, err := apidocMarkdownGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/markdown.go", size: 8718, mode: os.FileMode(436), modTime: time.Unix(1792000212, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocMatrixGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x58\xdb\x8e\xdb\x38\xd2\xbe\x16\x9f\xa2\x46\xff\x8d\xfd\x43\x96\xb7\x67\x67\x4f\x8e\x6d\x20\x98\x4d\xb0\x8b\x99\x0c\x82\x74\x26\xb9\x08\x1a\x3b\xb4\x54\xb2\x88\x96\x48\x2d\x49\xb5\xba\x61\xe8\xdd\x17\x45\x52\x07\xbb\xdb\xc9\x0c\xfa\xa2\x25\xb2\x8e\x1f\xab\xbe\xa2\xdc\xf0\xec\x9e\x1f\x11\x78\x23\x72\x95\x31\x26\xea\x46\x69\x0b\x0b\x16\xc5\x28\x33\x95\x0b\x79\x5c\x67\xe6\x21\x66\x51\x5c\xd4\x96\xfe\x95\xb6\xae\xd6\x16\xeb\xa6\xe2\x16\x69\x41\xa8\x98\xb1\x28\x3e\xaa\xe6\xfe\x98\x0a\xb9\x46\xad\x8f\x2a\x7d\xf8\x7e\x5d\xd4\x96\x5e\x94\x36\x31\x5b\x32\xb6\x5e\xc3\x1b\x69\x85\x7d\xfa\x49\xc8\xdc\x40\xa9\xaa\xdc\x80\x2d\x11\xee\xdd\xbb\x2a\x00\xdd\x36\xd8\x92\x5b\xc8\xb8\x04\xde\x34\xc8\x35\x08\x49\xba\x6f\x79\xc6\x73\xfc\xb7\x2c\x54\xfa\xfa\x81\x8b\x8a\x1f\x2a\xfc\xa8\x12\x10\xd2\x19\x51\x3a\x47\x12\x85\xae\x14\x59\x49\x4b\x4f\xc0\x35\x92\x66\x25\x8c\xc5\x9c\xf6\x38\x34\xa8\x6b\x61\x8c\x50\x12\x6a\x6e\xb5\x78\x4c\xd9\x03\xd7\x67\x81\xed\xe0\xcb\x9d\xb1\x5a\xc8\xe3\x89\x45\x71\xa6\xa4\xd5\xaa\xaa\x50\xaf\x6a\x9e\x95\x42\xe2\x8a\x1f\x51\xda\x38\x61\x51\xfc\x7c\xa5\x95\xc2\xce\x5e\x67\xda\xad\x41\xed\xd6\x6a\x95\x63\x35\xbc\xf6\x0e\x97\xd7\x59\x86\xc6\xc0\x03\xaf\x5a\x34\x50\x62\xe5\xa2\x7d\x3f\xc6\xfa\x41\x75\xa9\x97\x49\x59\xa6\xa4\x71\x07\x34\xea\xbd\xae\x2a\xd5\x51\x82\x06\x0a\xa5\x81\xcb\x01\x48\x02\xd6\xa1\xc9\xa2\xf5\x9a\x20\x81\xc2\x81\x48\x92\x7c\xc0\x10\xac\x4a\x59\x74\x6e\x69\x07\x31\xf7\x46\x63\x36\x77\x64\x4c\x5b\x7f\xd5\xd1\xb9\x13\xa7\xca\x83\x92\x55\x70\xc0\x33\xb7\x70\xc0\x8c\xb7\x06\x41\x58\x43\x4a\x56\xe9\x27\x68\xb8\x14\xd9\x3d\xe6\x4e\xb7\x2b\x51\x42\xc6\xab\x0a\x73\x50\x12\x0e\x58\xf2\xaa\x00\x55\x78\x5f\xe4\x35\x85\x5b\x44\x78\xeb\x95\xdf\x93\xee\x94\x4c\x70\x4c\xc9\xf8\xc7\xb3\x64\xfe\x89\x52\xfc\x51\xd0\xa4\xb2\x2f\x03\x17\x8c\xed\x20\x1e\x4a\xfd\xec\xf0\x66\xc5\xae\x55\x47\x09\xbc\x50\x89\xa4\x45\xa1\x28\x89\x50\xa3\x2d\x55\xee\x05\xbd\xfb\x94\xd9\xa7\x06\x2f\xcc\x1a\xab\xdb\xcc\xc2\x89\x45\xbe\x3b\x00\x7c\xe1\xb2\xe8\x13\x6a\x67\x5a\x48\xcb\xa2\x77\xde\xda\xb0\x39\x03\x61\x16\x18\xf7\x0b\xd4\x86\x3c\x2b\xcf\xd0\x10\x92\x45\x17\xdd\x6b\x95\x53\xf2\x71\x8e\x4d\x68\x78\x1d\x3a\x71\x44\x66\x6c\x26\x8f\xfd\xaf\x32\x2b\x91\xce\x37\x78\xee\x4a\xb4\x25\xea\x99\xb1\xd0\xf5\x1e\x6c\xab\x9c\x96\xd3\x99\x21\x66\x00\x1f\x90\xfa\x5e\xb5\xc7\x12\x5c\xbb\x19\xc7\x18\xbe\x9a\x52\xa7\x44\x85\x41\x66\x63\xa9\x56\x93\xea\xca\xd9\x8a\xa1\xe3\x5a\x0a\x79\x4c\x59\x34\x85\x74\x50\xaa\xf2\x61\xde\xb6\x0d\x6a\x6a\xd1\x6f\x87\x69\x15\x68\xfc\x6f\x2b\x34\x3a\x4d\x33\x6a\x06\x40\x03\x52\x13\x13\xf8\x92\xf5\x67\x92\x8e\x8e\x52\x16\x4d\x4e\x5d\x20\xfd\x45\x1d\xbd\x73\x55\x02\x1a\x6d\xab\xa5\x01\xee\x4a\x89\x0a\xc6\x9d\x57\x88\x6a\x38\xbe\xa1\x66\x1d\x75\x0a\x59\x5c\x12\xa5\x2a\xdc\x6a\xea\xeb\xc6\x24\xa0\x31\x53\x9a\xe8\x3e\x10\xe8\x40\xca\xa4\x1f\x6a\x81\x00\xa6\x66\x04\x61\x53\x08\x1c\x2c\x2a\xaa\x12\x61\x20\x47\x4b\x18\x4b\xcc\x3d\x09\x0d\x11\x70\x43\x16\x38\x74\xa5\xaa\x30\x01\xa3\x80\x2c\x4c\x38\x9a\x79\x91\x43\xc9\x1f\x70\xaa\x24\x1e\x28\xaf\x68\x65\x06\x0b\x8a\x17\xfe\x9f\x06\xc0\xf2\x19\x2a\x8b\x25\x7c\xb9\x9b\x16\xa9\xe5\x4e\x2c\x72\x1d\x43\x89\xfc\x84\x4f\xb3\x5e\x89\x8a\x8b\x66\x89\x1e\xe6\xdd\x12\x91\x06\xc0\xb4\xdd\xb3\x68\x20\xb1\xcd\x0e\x6a\x7e\x8f\x8b\x9a\x37\x5f\x82\xe1\x3b\x3a\xac\x25\x8b\x28\xeb\xff\x24\xd0\xc0\x66\x07\x9a\xcb\x23\x8e\x00\x8f\xd4\x64\xa8\x53\x07\x5b\x83\xfe\xa9\x09\x87\x90\x40\x93\x86\xb6\xa5\xc7\xa9\xdb\xfa\x3b\xd8\x81\xd5\x2d\xba\x50\xda\xb1\x5c\xe7\xc1\xf8\x72\xfa\x80\xc5\x45\x38\xdd\x45\x38\x9f\x7d\xd9\xfb\x48\x44\x01\x5d\x4a\x1e\x60\xb7\xbb\xd2\x26\x24\x36\xb9\x9c\xdc\x9c\xba\x31\xea\x6e\x8a\xba\x4b\xbd\xc0\x2c\x62\x0a\xb9\x67\x11\x0d\x59\xad\x3a\x73\x79\x4c\x63\xa0\xc5\x73\xdc\x78\x8e\x01\xb1\x91\x72\xe7\x29\xfb\xe3\x19\xf2\x1d\xec\x10\xaa\x93\xa9\x62\x7e\x57\x70\xb6\x26\x63\xee\x00\xce\x03\x8d\x42\xcf\x0e\x6e\x06\xee\x4a\xa0\x42\xb9\x98\xf1\xdf\x72\xf0\x28\x2e\x3d\xce\x84\xbc\x3f\xd3\x09\x9b\x95\xfe\x39\xe3\x06\xe1\xbb\x8b\x08\x36\xb4\x13\x3c\x7f\x11\x14\xd0\x7c\xa6\x8c\x6a\x97\x65\x53\xa4\xbf\xf0\x1a\x13\x28\x26\xf8\x69\xab\xbf\x6a\x2f\xcc\x43\xda\xcd\xb1\xe0\x6d\x65\xaf\x4a\xfa\xd9\x4f\xbb\x7d\x00\x26\xa0\x5b\xcf\xa1\x7d\x17\x1a\xd8\xa5\xe6\x0e\x77\xe7\xc8\x5b\xe6\x0b\x7a\x4b\x66\x5d\xfa\x41\x75\x4e\x2a\xcc\xa9\x0d\xb5\x57\x48\xc0\x2d\x87\x14\x68\x7d\xca\xc7\xed\x78\x27\xb4\x01\xf5\x4c\xc1\x07\xea\x96\x7d\xfc\x7e\x79\xe4\xf2\x0d\xbc\x54\xb4\x2f\x60\xe6\x8d\xf6\x77\x5e\x7f\xa4\xe0\x0d\xd4\x13\x37\xc3\x77\x3b\x90\xa2\x72\x22\xfd\x72\xac\x69\xcf\xc4\x44\xc3\x26\x90\xf5\x67\x2d\x2c\x4e\x59\x7b\xc6\xfe\xf1\xf6\x13\x74\xb4\xe1\x6f\xbb\xcf\xe6\xfe\xc0\xc5\x64\xc0\x2a\xe8\x80\x1b\xf8\xf1\xf6\x53\x02\x9d\xb0\x25\x70\x28\x91\x13\x63\x13\xdb\x4b\x5e\x13\x43\x93\x99\x4c\x55\x6d\x2d\x8d\x1b\x26\x2f\xcd\x89\xc0\x9a\xd7\x22\x5a\x74\x20\x54\xea\x76\x75\x02\x73\x6a\x75\x37\x76\x2a\xd7\xcc\x71\x47\x66\x1e\xd2\x5f\xb0\xf3\x92\x8b\x6e\xc9\xa2\x10\xcf\x66\x3c\xec\xa1\x4b\x4e\xb1\x27\xd6\x38\x81\x38\x30\x2a\x3d\x7a\x9e\x8f\xfb\x64\xde\x1b\x69\x9a\x4e\xa6\x46\x4b\xde\x74\x02\xf1\x78\x78\x64\x60\x1c\xa9\xf1\x92\xa2\xf2\x51\x07\xd9\x89\xe9\xf4\x54\x9a\x94\x4e\x7a\x99\xf4\x62\x49\x49\x45\x7e\xce\xbd\x14\xbd\x1e\x19\xad\xa8\x6d\x7a\xdb\x68\x21\xed\x42\x0f\x95\xb2\x4c\x40\x0f\xec\x46\x8f\xe1\x3a\xee\xd2\x98\x08\x92\xec\xc6\xb1\xa7\x56\x9d\x4e\x37\x8b\x73\x1a\x85\x1d\xc4\x4f\x68\xe2\xd0\x5b\xd3\x95\xe1\x4c\x7b\x2a\x3f\xa7\x3d\x49\xed\x20\x0e\x37\x8e\x7c\x30\x31\xa2\x12\x92\xf2\x59\x26\x53\x13\x24\x30\xea\x13\x77\xf5\x0e\xc8\xb7\x55\x6b\xca\xc5\x92\x51\xb4\xa8\x9d\xfb\xac\x4b\xdf\x50\x01\x2c\x96\xaf\xdc\x92\xaf\xfc\x80\x9c\x2b\x77\x57\x1f\x26\xfd\xac\x79\xb3\x40\xad\x97\xf3\x56\x90\x62\xb8\xb6\x7c\x40\x99\xa3\xbe\x3c\x83\x7f\x7d\x7c\xf7\xf3\xb7\x7a\x61\xb8\xb0\x8c\xbd\xc0\xc1\x08\x79\xac\x10\x9c\x76\xc3\x8f\xe8\x8a\xfe\x5a\xc5\x5f\xf7\xfc\x3b\x6a\x3e\xe4\x71\xdd\xc6\x3b\x34\x86\x1f\xd1\x2c\x3a\x6f\x21\x21\x78\x96\xdf\x4c\x7a\x50\xa3\x8f\x88\x4a\xdc\xe3\x57\x44\x29\xff\x43\x6b\xc1\xf2\x7b\x92\xb7\x06\x2c\x3e\x5a\x28\xb4\xaa\x1d\x7f\x1c\x05\x5d\x7d\x6b\x6f\x10\x32\x6e\x79\xa5\x8e\xdf\xcc\x7d\x8a\xfb\x65\x0c\x12\xa8\xcd\xd1\xc0\x20\x36\x83\xc4\x26\x43\x6d\x0c\x1f\xfd\xc4\x07\x8b\x38\x5e\xa6\x6f\x5b\x99\x99\x05\xfd\x20\x10\xf4\xfc\x02\x59\x5a\x2e\xd3\xf7\x5c\x1b\x9a\xd4\x04\xdd\xc7\xba\xa9\xa6\x3a\xfb\x9d\x45\x45\x6e\x77\x60\xd3\x37\x8f\x98\xb5\x16\x09\xf2\xd9\xdc\x17\xd2\xa2\x2e\x78\x86\xa7\x9e\xaa\x33\x76\x23\x37\xde\xcc\x49\x86\x08\x3b\xfe\xa0\x3a\x13\x6f\xe0\x1a\x29\x24\x2c\xea\xff\x70\x64\xe7\xe5\x4e\x77\x9b\x29\x4d\xd8\xc1\x6f\x6c\x4b\xa0\xec\xd9\x96\x48\x6a\xcf\xb6\xc6\x3e\x55\xb8\x67\xd1\x41\xe5\x4f\x2e\xed\x42\x49\xbb\x2a\x78\x2d\xaa\xa7\x0d\xfc\x7a\x68\xa5\x6d\xe1\x67\x71\x2c\x6d\x02\x86\x4b\xb3\x32\xa8\x45\xf1\x8a\x45\x51\xc3\x73\xba\x94\x6f\xe0\xfb\xbf\x34\x8f\xaf\x1c\x2c\x56\x6f\xa4\x2d\x57\x59\x29\xaa\x7c\x41\xdf\x41\x9e\xd8\x0e\x3c\xbb\x3f\x6a\xd5\xca\x7c\x95\xa9\x4a\xe9\x0d\xfc\x5f\x71\x43\x7f\x41\xab\x4c\xc0\x7a\x22\x1a\x6d\xfe\xd0\x3c\xc2\xcd\x9f\x06\xbb\x69\xf8\xe4\xbf\x66\x2d\xfb\x3b\xfe\x35\xfb\xc7\x20\x1b\x2e\xc4\xd7\x3c\x17\xc5\x9f\xb3\x1f\x82\xec\xc4\x7b\x24\x3d\x88\x1c\xfe\x76\x93\xdd\x64\x4e\x64\xbb\x0e\x00\x6d\xad\xb0\x15\xee\x4f\xa7\xda\x1c\x21\xf6\x98\xae\x08\xca\x95\xdb\x88\xfb\x7e\xbb\x76\x4f\x7b\xb6\x5d\x07\x6c\x09\x53\x42\xfa\xe6\x42\x6d\xd2\x28\x6f\xf6\x6c\xdb\x5c\x6c\x87\x04\x9c\xc9\x66\xcf\xb6\x96\xae\x84\x7b\x16\x6d\xad\xde\xb3\x28\xda\xda\xf2\x42\x21\xcc\x36\x92\xb7\xe5\xcb\x22\xc3\xd0\xfb\x9a\xcc\x30\x0d\x47\x91\xd3\xc9\x0f\x2d\x77\x11\x37\x7d\xef\x55\xd2\x20\x70\x3a\xa1\xcc\xfb\xfe\x45\x53\xcf\x6e\xeb\x5f\xf3\x3b\xd2\xff\xe4\x7a\xbb\x76\xb9\x8e\x01\x50\xa7\x04\x57\xb4\x4e\x56\xf2\xfd\xe9\x14\xc6\xa2\x53\xcb\xe7\xeb\x61\x32\x3e\xdf\x08\x63\x72\x5a\x1f\x5d\xf8\xa9\x49\x49\xe6\x90\x55\xdc\x98\x5d\xec\x72\x8d\xc7\x94\xf3\x59\xca\xc1\x9e\x28\x60\x1a\xa5\x7d\xbf\x35\x0d\x7d\x88\x7a\xed\xb1\xb6\xe2\x8b\x7c\xa7\x0d\x32\x4b\x2a\x83\xe1\x8b\x68\xc9\xfa\x38\x6a\xfb\xfe\xdc\xca\x38\x67\xfb\xfe\x42\x7b\x04\xcf\xad\xb2\xed\x3a\x14\xd0\x76\x1d\x0a\x72\x5d\xda\xba\xda\xb3\xdf\xd8\xff\x06\x00\xe2\xf5\x09\x39\x63\x15\x00\x00")
+
+func apidocMatrixGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocMatrixGo,
+		"apidoc/matrix.go",
+	)
+}
+
+func apidocMatrixGo() (*asset, error) {
+	bytes, err := apidocMatrixGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/matrix.go", size: 5475, mode: os.FileMode(436), modTime: time.Unix(1791999280, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocMergeGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x58\x5f\x6f\xdc\xb8\x11\x7f\x16\x3f\xc5\x64\x01\xb7\xab\x42\xd5\x16\x7d\x4c\xb0\x05\x82\x5c\x0c\xe4\x5a\xfb\x0e\x70\x70\x7d\x58\x18\x07\x5a\x1a\xed\xb2\xa6\x48\x85\xa4\x76\xad\x73\xf6\xbb\x17\x43\x51\x12\x25\x6f\x9c\x4b\x10\x63\xa5\xf9\xcf\x1f\x87\xa3\x19\x36\xbc\x78\xe4\x7b\x04\xde\x88\x52\x17\x8c\x89\xba\xd1\xc6\xc1\x9a\x25\x2b\x54\x85\x2e\x85\xda\x6f\xfe\x67\xb5\x5a\xb1\x64\x55\xd5\x8e\x7e\xac\x36\xfd\xaf\x33\x42\xed\xed\x8a\xb1\x64\xb5\x17\xee\xd0\x3e\xe4\x85\xae\x37\x46\xef\x1b\x6c\x1a\xdc\xf0\x46\x14\xba\x6e\xb8\xf3\x06\x5c\xd7\xa0\x25\xb5\xbd\x6e\x1e\xf7\xb9\x50\x1b\x34\x66\xaf\xf3\xe3\x3f\x37\x55\xed\xe8\x45\x1b\xbb\x62\x29\x63\x9b\x0d\xdc\xa0\xd9\x23\x14\xba\x7e\x10\x0a\x2d\xb8\x03\xc2\x5e\x1c\x51\x41\xa9\x8b\xb6\x46\xe5\x2c\x08\xe5\x34\x68\x85\x39\x7c\x3e\x08\x0b\x5c\x4a\x7d\xb2\xa4\x3b\x89\xec\x51\xa1\xe1\x0e\x4b\xb0\xd8\x70\x7a\x92\x5d\x06\x95\x36\x80\x4f\xbc\x6e\x24\xc2\x43\x07\x0d\x37\x4e\x70\x49\x9a\x06\x83\x86\xd0\xca\x66\xe0\x34\x3c\x20\x34\xad\x03\xa7\xf7\xe8\x0e\x68\x72\xb6\xd9\x90\xe0\x7b\xa8\x78\xc1\x4b\x84\x23\x1a\x2b\xb4\xca\x80\x56\x97\x81\x5f\x04\x14\xba\xc4\x0c\x2c\x2a\x27\x14\xca\x40\xa4\xff\x4d\xb0\xdd\x7b\xb3\xe8\x9c\x50\x7b\xa8\x79\x07\xbc\x69\x90\x1b\x10\x0a\x6a\x6d\x10\xdc\x81\x2b\x5a\xdb\xb8\x16\xe0\x16\xa4\x56\x7b\xfa\xc5\x23\x9a\x8e\x0c\x94\x58\x09\x25\x9c\xd0\x0a\x44\x8f\x91\xe5\x35\xbe\x03\x4d\xa1\x9e\x84\xc5\x00\xa3\x41\xd7\x1a\x65\x81\xab\x3e\x96\x5e\xd7\x16\x46\x3c\x90\x7f\x2e\xa5\x57\x2e\xb4\xaa\xa4\x28\x9c\xa5\x55\xc2\x7f\xb9\x51\xb4\xbb\x19\x2d\xd5\x69\x43\x40\x29\x51\x90\x95\x12\x78\x5b\x0a\xf7\x77\x7c\x2a\x64\x5b\x62\x09\x35\xba\x83\x2e\x2d\x70\x33\xee\x59\x49\x36\x4e\xc2\x1d\xa0\x6c\x1b\x29\x0a\xee\xd0\x82\xc1\x5a\x1f\xb1\xcc\x68\x15\x24\x1b\xbc\x5a\x67\xb8\xa0\xfd\x12\x0a\xae\x05\xca\xd2\xe6\x70\xed\xf1\x25\x23\x3e\xe4\xb9\x6d\x70\x5a\x67\x80\x4f\x05\x36\xce\x6f\xa7\x3b\x68\x8b\xfe\xa9\xdf\x17\x42\x83\x3b\xd2\xe6\xca\xa3\x31\x01\x79\xe0\xfd\xfa\x3e\x55\xc0\x55\x37\xa3\x03\x07\x85\x7b\xed\x84\xdf\x24\x70\xfc\x41\x22\x90\xf5\xae\x41\xa8\x78\x2d\xa4\x40\x9b\x91\xb2\x3b\x60\xe7\x23\x32\x48\x19\xde\x52\x8a\x91\x77\x5a\x50\x4d\x98\x97\xa3\x61\x4a\x4f\x04\x83\xb6\x95\xde\x09\xa9\x2b\x0d\x37\xe8\x38\xd9\x7e\x5f\x1e\xd1\x38\x61\xc9\x40\x1f\xba\x47\x47\x38\x28\x35\x5a\xf5\x57\x47\x80\x22\x54\x46\xd7\xc0\xc1\x0a\xb5\x97\x48\x26\xa6\x44\xf5\xab\x89\x7c\x08\x8f\x63\xc1\x95\x56\xa2\xe0\x92\x50\xa9\x61\x6d\x11\xe1\x93\xaa\x74\xfe\x61\x60\x88\x3f\x30\xcd\x59\xd5\xaa\xa2\x4f\x93\xb5\x50\x95\xb6\x90\xe7\xf9\xdf\x48\x30\x85\xb5\xff\x0d\x49\x9d\xc2\x33\x4b\xc2\xd2\xde\x6e\xe1\x2f\xc4\x7a\x66\x49\x72\x57\x1c\xb0\xe6\xbf\xf5\xc7\xe0\x2d\x7c\x68\x8d\x41\xe5\x66\xd4\x8c\x25\xc9\xe7\xae\x41\x52\x79\x0b\xfe\xdf\x58\x0f\xf2\x5b\x3c\x11\x7d\x9d\x66\x2c\x39\xb3\xe4\xc8\xcd\x94\x87\xb0\xbb\xef\x4b\x0c\x4b\x86\x6d\x7d\xbb\x85\x9a\x3f\xe2\xba\xe6\xcd\xae\xa7\xfd\x1b\xbb\xfb\x3e\x59\xc8\x4e\xca\x92\x42\x2f\x05\x7b\x23\xf7\x1f\x29\x91\x3e\xe8\x12\x53\x96\x0c\x87\xf3\xa2\xe0\x5d\x60\x7a\x85\x94\x25\xd1\xb9\xbd\x24\xfe\xcb\xc4\xbe\xeb\x8f\x74\xca\x92\x53\x38\x3e\x33\x85\x70\xa6\xee\x1f\xb4\x96\x29\x4b\xc2\x81\x8a\x25\xae\xfb\xb3\xf6\x2b\x71\x06\xb1\x7e\x9d\x3e\x98\x17\xc2\x03\x63\x90\x8d\xf2\x37\x1b\x53\x96\x94\x2a\x2e\x2d\x12\x49\x5a\x64\x49\xa5\x0d\xfc\x9e\x01\xed\x38\x31\x0d\x57\x7b\xf4\x6f\x96\xb6\x39\xb6\x02\xdb\xd9\x99\xf8\xfa\xd5\x8b\xe5\xb7\x11\xed\xcd\x16\x94\x90\x2c\x49\x46\x7f\xdb\xc9\xf5\xa0\x40\x09\x70\x3d\x10\x47\x0d\x51\x4d\x5c\xda\xbe\xc0\xf1\x41\xf8\x20\x15\xaf\x31\x03\x37\x0f\x72\x14\xf7\x0f\x7d\xc8\x49\x22\x2a\xd0\xb2\xcc\x40\x3f\x92\x74\x9f\xaa\x0b\xc9\x1d\x99\xbb\x7f\x47\x22\xbd\x0e\x29\xbd\xa1\xa2\xf9\xf3\xdd\x2f\xb7\x6b\xaf\xee\xd2\x81\x97\x4c\x89\xb8\xf5\x05\x5a\x95\xeb\x91\x94\x41\x55\xbb\xfc\xae\x31\x42\xb9\x6a\xbd\xa2\x64\x86\x2b\x4b\x07\x7c\xcc\x5f\xaa\xad\x53\x89\xb6\xab\x0c\xc8\x7d\x9a\xf6\x9e\xcf\x6c\xf0\xe1\x84\x6a\x91\x4d\xb4\x57\x42\x87\x2d\x38\x16\x04\xe9\x2f\xec\x63\xb5\xc0\xe7\x3a\x9c\x16\xbf\x90\xcd\x06\x3e\xe8\xa6\xf3\x25\xde\x4a\x51\xa0\x05\xab\x7d\x7d\x9c\x8a\x84\xf8\xc3\x9f\xb2\x24\xd9\x6c\xa6\xba\x73\xf0\x59\x41\x6a\xda\x88\xbd\xa0\xec\x1f\x8b\x1a\x19\xae\xf2\x9b\x50\xf7\x47\x78\x76\xf7\x3d\x69\xad\x84\x4c\x33\x18\x25\xf2\x3c\xf7\xcb\xae\xf2\xf7\x47\x2e\x24\x15\xd6\xcf\x7a\x42\x75\x38\xe7\xa3\x5a\x24\x35\xa9\xfe\x07\xb9\xc5\x99\x33\x4f\xf9\x95\x1b\x5e\xa3\x43\x33\x6a\x7b\xb2\x9d\x14\xe3\x92\xb7\xf6\x71\x3c\x62\x47\x90\x8d\x15\xe4\xb9\xca\x6f\x7d\xa6\x55\x79\x28\x5a\x67\xf6\x22\xa5\x42\x0d\xda\x3d\x62\x17\xe7\x50\xa8\xbe\x94\x45\x8b\xae\x80\xf0\xa5\x0e\xa2\xc4\x42\x72\x83\x25\x08\x35\x68\x94\xa2\xaa\x90\x2a\x25\x34\x92\xd3\x96\x08\x15\xd1\x06\x98\x6d\xce\x5e\x24\x29\x7d\x53\x75\xeb\xee\x74\x6b\x0a\xb4\x94\xb3\x69\x06\x0b\x62\x95\x8e\x49\x3c\x26\xec\x84\xdb\xb7\x72\x38\x04\x7f\x65\xd7\x57\x65\xfa\x9d\x4c\x7e\x81\x57\x48\xeb\x33\x5b\x26\xb5\xa7\xc4\xd0\xc1\x16\x2a\x36\xa5\xf9\x90\xab\x63\x78\x73\x7a\x06\x55\x3a\x4f\xf6\x62\x91\xec\x63\x59\x0f\xf9\x3e\xdf\x34\xff\x35\xd8\x15\xf9\xed\xe2\xe4\xf7\x52\x39\x7d\x0e\xa8\xe8\x14\xfd\xd3\x8f\x83\x36\x75\x7c\x97\x8e\xff\x91\xcb\x16\x2d\x5c\x7d\xf1\x5d\xd3\xd5\x97\x55\x06\x45\x40\x6e\xf0\x9e\x05\xdf\xaf\x03\x38\x5b\x06\x6c\xa1\x88\x10\x8c\x00\x58\x82\x38\xb1\x32\x28\x16\x38\xe2\x02\xc7\xd9\x57\xef\x22\x96\xe3\x47\x73\x87\xdf\xc3\x13\x7b\x3c\xbf\x7e\xf5\x28\xdf\xa0\xb5\x34\x63\x78\xc6\xf0\xf2\xe3\x58\x2f\x3a\xea\xef\x96\xdb\x3e\xca\xd7\x81\x7d\xb1\x26\xd8\x02\x46\xe0\x2e\x50\x59\x02\x3c\x67\x67\x80\x0b\x90\xed\x02\xe4\xa8\x57\xb8\x84\x70\xd4\x69\xec\xec\xb7\x30\xfe\x8d\x92\x8a\x40\xb6\xe1\xf1\xc7\x91\x8c\x3b\x9a\x61\x0c\x79\x25\x7d\xfb\xa6\xff\x8a\x3e\x61\x36\x4a\x5f\xef\x3d\x1b\xc2\x78\x1d\xe7\x0b\x2b\x83\x2d\xd8\x08\xe9\x18\x9a\x25\xcc\x11\x2f\x03\xbb\xc0\xf8\xb4\xc0\x78\x98\x5c\x46\x80\xdf\x0c\xcd\xd8\xee\x74\x1f\xa0\x8c\x29\x5b\x70\x26\x7c\x80\x83\xbb\xd1\xc2\x32\x8e\x81\x91\xc1\x29\x1d\x96\x16\x45\xd2\x2c\x22\x89\xbb\xb9\x28\x9c\xbe\xef\xdb\x35\x43\x30\xd3\xfb\xcb\x50\xe6\x26\x96\xf1\xcc\xb8\x19\x34\xb3\xa0\x06\x19\x3f\x51\xbd\x54\xf5\xe4\x2c\x04\xea\x5f\xc2\x17\x33\x08\xbc\xa7\x19\xef\xe3\x30\xe2\x2d\xd5\x67\xdc\x60\x65\x46\x0b\xc6\xbe\x51\x6c\xa2\xd6\x35\x02\x26\xee\x74\x77\x38\xc0\xb3\xa4\x5e\x04\x69\x94\x78\xb9\xd0\x88\x19\x0e\xe8\x00\xd1\x99\x91\x5b\x89\x6a\x3a\x28\x29\xfc\x0b\xfe\xe1\x1d\xd3\x2d\x47\x7e\xe7\xfb\x12\x1b\xf1\x59\x92\xf4\xf3\x34\xb5\xb0\x61\x38\xf2\x63\x4c\xb5\x5e\x15\x5c\x29\xed\xc0\x3b\x1e\x7b\x25\xfb\x36\x9c\x9c\xde\x54\xfe\xb3\x16\x91\xbf\x0c\x56\xef\x60\x45\x67\xe7\xcc\xa8\x31\xf8\xc9\xe8\xc6\xb7\x6a\x61\xec\x5d\xce\xb4\x70\x42\x83\x5e\x72\xb0\x4f\xb7\x1b\x6d\x51\xa0\xb5\x55\x2b\x65\x07\x28\x2d\x9e\x0e\x68\x30\xef\xc7\x29\x34\xc6\xc2\x2e\xcc\x48\x1e\x45\x76\x61\x57\x2e\x21\x49\x28\x88\x0a\x7e\x5f\x36\x40\x53\xdb\x84\x41\x21\x03\x1c\x1b\xa7\xfb\x77\xf0\x66\x28\x59\x68\xe2\x0d\x41\x33\xee\xc0\xd9\xaf\xf7\x92\xd3\x2d\x41\x6a\xfd\xc6\xc4\x83\xc7\xf3\x94\x98\xf1\xec\xb1\x85\x97\xc4\xcf\xd4\x5e\x52\x9f\xd7\x6f\xef\x38\x8a\x44\x26\x66\xd3\xc8\x68\xe3\x5a\xa8\x32\xe6\x04\x1b\x81\xbb\xec\x22\x43\x16\xf4\xdc\x8c\xb2\x81\x9d\x19\x0b\x77\x05\x01\x20\xb0\xce\xb4\x85\x23\x30\xa8\x7f\xa7\xd1\x77\x98\x68\x87\x1e\x51\x28\x47\x7a\x9b\x0d\x0c\x2d\x1e\x18\xa4\x2b\x38\x0b\xa7\x83\xbf\x74\x82\x27\x5f\x7e\x3b\x38\xf0\x23\x8e\xd7\x3c\xe0\x45\x87\x2b\x3a\x7f\x09\xf0\xc9\xd1\x3d\x50\x4b\x77\x09\x86\x7b\x4d\x7f\x8f\x64\xb0\x92\x58\xb8\xfc\x27\xc4\xe6\xe3\x97\x96\x4b\x78\xc0\x82\xb7\x16\xfd\xc5\x86\xf5\xf7\x4f\x07\x2d\xfd\x8d\x4d\xab\xf0\xa9\xd1\x86\xee\x33\xac\xe3\x8e\xdc\x71\xb2\x4a\x17\x11\x74\x51\x06\xba\xf2\x11\x0c\xc9\x17\x2e\x10\xc6\xee\xf4\x29\x83\x8e\x6e\xe6\xd0\x54\xbc\xc0\xe7\x73\x0a\x34\x98\xd2\xfa\x9f\x4a\xee\xb8\x3f\x2f\x94\x4a\x74\x01\x90\xdf\x70\x63\x0f\x5c\xae\x9f\x52\xbf\x51\xc4\x8a\xe6\xbf\x80\x6f\x98\x58\xcf\x2c\xe9\xbe\x6d\xa1\xfb\x73\x16\x02\x21\x0c\x1b\x3e\xa2\x14\xb6\xdb\xb0\x27\xeb\xae\xe4\x8e\xa7\xec\xcc\xfe\x3f\x00\x6e\x7b\xd9\xa5\x14\x15\x00\x00")
+
+func apidocMergeGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocMergeGo,
+		"apidoc/merge.go",
+	)
+}
+
+func apidocMergeGo() (*asset, error) {
+	bytes, err := apidocMergeGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/merge.go", size: 5396, mode: os.FileMode(436), modTime: time.Unix(1792002030, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocMessagesGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x3a\x5d\x93\x1b\x37\x72\xcf\xe4\xaf\xe8\x9b\xaa\x8d\x96\x09\xc9\xbd\x5c\xf2\x44\xd7\xa6\x4a\x91\xed\x8b\x2e\x5e\xc9\xd1\xea\x2e\x0f\x2b\x55\x0a\x3b\xe8\x99\x81\x89\x01\xc6\x00\x86\x2b\x46\xa5\xff\x9e\xea\xc6\xc7\x0c\xb9\x94\x9c\x3a\x5b\xae\x92\x06\x68\xf4\x77\x37\xba\x1b\x1c\x44\xbd\x17\x2d\x82\x18\x94\xb4\xf5\x72\xa9\xfa\xc1\xba\x00\xd7\xcb\x45\x85\xa6\xb6\x52\x99\xf6\xe6\x17\x6f\x4d\xb5\x5c\x54\x4d\x1f\xe8\x2f\x65\x6f\x94\x1d\x83\xd2\xf4\xe1\xad\x0b\xd5\x72\xb9\xa8\x5a\x3b\xec\xdb\xad\x32\x37\xe8\x5c\x6b\xb7\x87\x3f\xdd\x34\x7d\xa0\x0f\xeb\x7c\xb5\x5c\x2d\x97\x37\x37\x70\x87\xde\x8b\x16\x3d\x74\x56\x4b\x0f\x02\x6a\x11\x84\xb6\x2d\xd8\x06\x42\x87\xd0\x8d\xbd\x30\x1b\x87\x42\x8a\x47\x8d\x10\xf0\x53\x80\xc1\x59\x39\xd6\x28\xe1\xf1\x48\x28\xde\xa1\x91\xe8\xfe\xe3\xfd\xdd\x4f\x19\xd9\x3a\xad\xdd\x09\xb7\x97\xf6\xc9\x14\x22\xc2\xc8\xe9\xc4\xcf\xe8\x7a\xe5\xbd\xb2\xe6\x4e\x04\xa7\x3e\x9d\x62\xf0\x63\xdd\x81\xf0\xe0\xb1\x0e\xca\x1a\x3a\xd6\xa1\x20\xe1\x3d\x08\x23\xc1\xd8\x80\x1e\xac\x81\x1e\x43\x67\xa5\x5f\xc3\x1e\x8f\xcc\x13\xf4\x91\x1c\x28\xb9\x06\x6f\x21\x74\x22\xd0\x71\x69\xeb\xb1\x47\x13\x04\xe1\x83\x5a\x18\x78\x44\x70\xcc\x28\x4a\x50\x06\x6c\xe8\xd0\x81\x16\xa6\x1d\x89\x07\x68\x9c\xed\x59\x09\x5e\xf4\x38\xc7\xb0\x9d\xd4\x46\xc8\x21\x88\x3d\x82\x70\x2d\xa3\xf7\x20\x1c\x42\x63\x5d\x2f\x42\x40\x49\x32\x44\x3d\x35\x7d\xd8\xde\x0f\x4e\x99\xd0\x30\x5f\x02\x82\x13\xc6\xeb\x89\x1f\x87\xd6\x49\x74\x44\xb3\x87\x27\x15\x3a\xc0\x4f\x83\x56\xb5\x62\xfe\x33\x01\x50\x46\xe2\x27\xf4\x45\x45\x57\x0f\x7f\xfa\xe8\xb7\xcb\x9b\x1b\x82\x7a\x6b\xf4\x91\x10\x44\x53\x25\x2b\x26\x29\x9d\x07\xe5\xa1\x43\x2d\x77\xd0\x88\x5a\x48\x64\x55\x46\x0d\xd2\x61\x23\x7a\xd2\xbd\xb4\x35\xd4\xb6\x4f\xd2\x18\x99\x70\x08\x6f\x8d\x87\x56\x1d\xd0\x90\x9a\x69\xb1\x45\x83\x4e\x04\xeb\xe8\xb4\x18\x06\x14\x8e\x04\x0e\x1d\x1e\x59\x0d\xca\xf0\xd9\xa2\xb8\x65\x38\x0e\x38\x69\xaf\x17\xc3\x83\x0f\x4e\x99\xf6\x63\xfc\x8b\x7d\xf2\x7b\x6c\xc4\xa8\x43\x81\x8a\xae\x49\x78\x7e\x30\xad\x56\xbe\xcb\x16\xf6\x6b\x18\x3d\x4a\x52\x36\x08\xc3\x4a\xce\xb6\x67\xcf\x32\x6d\xb4\x61\xf1\xea\xed\xf2\x20\xdc\x33\xfc\xb7\x85\xa1\xcf\xcb\x45\x15\x54\xd0\x58\xed\x80\xff\xab\xfe\x32\xfe\x32\xc2\xcb\x9f\x5f\x27\x7d\xf9\x6a\xbd\x5c\x54\x5d\xe8\xf5\x26\xc3\x4d\x20\xd2\xd6\x1e\xae\xc5\x18\x6c\x52\x0b\xca\x15\xc3\x1b\x6b\x0a\x46\xa8\xcc\x8d\xa8\xd6\x14\x9f\x41\xb4\x7e\x93\x9c\xba\xda\x41\xf5\x5e\xb4\x11\x3f\x6f\x34\x4a\x07\x74\x74\xac\xba\xef\xec\x13\xd8\x6c\xd8\xc4\x09\x04\xd1\xb6\x28\xa7\x03\x42\xeb\x44\xa4\xba\x16\x5a\xaf\x22\x91\xda\x59\xef\x37\xbd\x95\xa8\xe7\xb4\x5e\x4d\xcb\xe0\x30\xfa\x60\x24\x3e\x3f\xa0\x4c\x70\x96\xc0\x5f\x42\x7d\xe1\x00\xd4\xd6\x18\xac\xd9\x49\x40\x0c\xe4\xaa\x8c\x08\x6c\xd3\x94\xa0\x32\x08\xf1\x54\x05\xff\xb4\x5c\x2c\x2a\xf6\xeb\x33\x78\x65\x40\x98\x18\x7e\x0c\xbb\x86\xa7\x4e\xd5\x1d\xf4\xe2\x48\x41\x6a\xa7\xed\xda\x12\x4b\x5a\xa3\xdb\x66\x84\xef\x67\x3a\x51\xe6\x60\xf5\x81\xa2\xce\x21\xd4\x42\x6b\xf2\x0e\x72\x01\xa9\x98\x25\x13\x60\xd0\xa2\x46\xbf\x7b\x26\xab\x0f\x38\x6c\x98\xf1\x28\xf0\xe8\xd1\x41\xa3\x0c\x65\xc5\x24\x51\x8c\xc9\x97\x13\xe3\x6f\x69\x95\xb3\x10\x19\x86\x61\x94\x69\x67\x4c\xae\x33\x93\x14\x67\xc4\x8f\x87\x3f\x63\x78\x65\x8d\x1f\x7b\xfc\x1e\x83\x50\xda\x43\xb0\xd0\x62\x98\x50\xbc\xf0\x20\xd3\x16\x1d\x13\xd0\x8b\x5a\x38\x6b\x4d\x46\xc6\x49\xa7\x75\x82\xc3\xb3\xae\xd1\x33\x0e\x15\xb6\x97\x85\x22\x73\x1d\x49\x28\xd2\x54\xcd\xb4\x4f\xb9\x7c\xe1\xc1\x61\x6f\x03\x4e\xae\x00\x4f\xd6\xed\xd1\x51\x7c\x79\x78\xc7\x9b\xef\xca\x5e\x62\x83\x0c\x17\x3c\xd8\x27\x33\xc3\x45\x9c\x3c\x89\x50\x77\x1c\x95\xda\xd6\x42\x43\xdd\x09\xc3\xd1\x4a\xe2\x10\x89\x23\x87\x73\x4f\x59\x24\xe1\x22\xdd\x10\x53\xec\x97\x77\xc4\xfb\x44\xee\xeb\xea\xfd\x8a\xc0\x52\xf9\xba\x13\xae\xa5\xa0\xab\x7e\x38\xa0\x3b\xb2\x2f\x10\x6b\x97\xf0\xd7\xc2\x39\x85\xbe\xa8\xd9\x33\xeb\x85\x24\x58\x57\xf4\x52\x9c\xee\x75\x03\xd6\x20\x18\x44\xc9\xca\x7f\x44\x28\x54\xe5\x9a\xf9\x65\x92\x0d\x9b\x31\xfb\x3c\xdf\xbd\xd1\x8b\x18\xc2\x4a\xcc\x08\x3f\x54\xe5\x3c\x38\xfc\x75\x54\x0e\xe5\x87\x8a\xaf\x65\x92\x99\xc0\x8b\x1b\x04\x3b\x11\xdb\xc2\xfb\x44\x0b\x5d\xc6\x55\x36\x3d\xa8\x30\x91\x0b\x9d\x72\x12\x06\xe1\x02\x25\x12\x11\x68\x33\x65\xfc\xd1\x8f\x42\xeb\xe3\xa9\x9e\x13\xb6\x49\xdd\xeb\x78\x5d\x88\x3d\xfa\x49\x42\xd1\x0a\x65\x26\x22\x85\xb6\x2c\xfc\xfa\xe7\x66\x4a\xf1\x9a\x9d\x72\x0a\x5f\xc6\xdb\x3a\x3b\x0e\x14\xa1\xcb\x45\xd5\xab\xd6\xb1\xea\xe7\xd9\x8b\x1d\x04\xca\x56\xb5\x3e\x01\x9c\x65\xad\xfe\x14\x10\x7a\x7b\xa0\x32\x24\x65\x24\xbe\x1c\xc8\x8c\x93\x88\x64\xcb\x94\x6b\x26\x5b\x07\xba\x36\xa5\x9b\x5f\x7c\x33\x94\xc2\x07\x4a\x0d\x31\x5e\x92\xaf\x7a\x3b\xba\x1a\x2f\x25\x82\x98\xd8\x48\x73\x1e\xee\x32\x92\xbb\x88\xe3\x72\x40\x91\xce\x0b\xe4\x7b\xb2\x79\xc8\xc8\x12\xb5\x10\x17\xe7\xd4\xa2\x26\x3d\x02\x05\x44\xca\x76\x85\xe7\x98\x16\x94\x51\x41\x89\x80\xb3\x74\xe7\x83\x70\xc1\x9f\x09\xf8\x18\xa3\x87\x3c\xe2\x75\x3a\x52\xd8\x39\x63\xe4\x82\xd8\xad\x3a\x64\xff\x7d\xc6\xe6\x94\xe8\xb6\x97\x18\xe4\x34\x92\x5d\x64\xe2\x27\x2b\x9c\x76\x71\x8a\xd5\x02\x90\xd3\x4c\x6d\x9d\x2c\xb9\x0a\x45\xdd\xc1\xd0\x09\x8f\xc5\xf5\xa3\x2f\x28\x4a\xbc\xce\x8e\x6d\xc7\xc7\x54\xf0\x54\xdf\xb6\x0e\xbd\x2f\xd5\x23\xd4\x5a\x71\x2d\x94\x90\x51\xad\xd6\x58\xad\xed\x53\x49\xba\x85\x7a\xd4\xed\xe0\xb0\xee\xb0\xde\x7b\x62\xff\xdf\x6d\xe8\x66\x52\x7b\xe0\xad\x54\x3a\x76\xf9\x7a\x4c\x05\x69\x44\x84\xb2\x38\x0c\xe5\x22\xfc\x24\xfa\x41\x17\xd6\x39\x55\x75\xca\x60\xba\x20\xda\x52\x76\x76\x28\x74\xe8\x8e\xa9\x68\x4b\xf8\x13\xa2\x67\xea\x27\xaf\x36\xf8\x04\x68\x8a\xf8\xd2\xa2\x37\x2f\x02\x08\x4d\x25\xff\x11\x3a\x71\xc0\x89\xc7\x8b\xa2\xe2\x27\x6a\x4f\xbe\x6a\xa6\xb8\x9d\x7c\x6a\x8a\xba\x8b\xde\x72\x11\xbf\xea\xbf\x89\x3f\x6e\x47\xfc\x91\x16\xca\x44\x48\x99\x60\xe7\x8e\x97\xf4\x30\xd1\xa3\x32\x03\xa9\x4a\x0d\xe0\x83\x38\x52\xfe\x11\x75\x50\x07\x84\xd1\x04\xa5\x69\x5d\xd1\x0d\x1b\xd4\x81\x4c\x72\x91\xbd\x83\xd0\x4a\xf2\x77\x61\x91\x88\xbf\xf0\x73\xb3\x04\xab\x25\x88\x47\x3b\x26\x83\x67\x14\xeb\xe4\x0a\xd9\x42\xc9\x23\x8e\xa9\x1b\x20\x9f\xbd\x18\x38\xd9\xc5\x49\x5c\x78\x14\xf5\xfe\xbb\x6f\xca\x19\xa9\xcc\x6c\xf0\x62\xee\x41\x94\xbf\x3d\xd3\x85\x5a\xdb\xf1\xb2\x98\x59\x09\x24\xe4\x5b\x53\x23\x20\x5f\xab\x2c\x23\x74\xc2\x27\x66\x50\x52\x57\x42\x15\xc9\x1a\xc2\x25\x73\x25\xde\x32\xba\xb9\x5f\x7c\x2d\x99\x6d\xe1\x47\xf2\x18\xb6\x94\x35\x67\x68\xb3\xac\xc2\x18\x1b\xa8\x56\x14\x8f\xd6\x7d\xcd\x56\xda\xb6\xdc\x72\xa5\x1a\xef\xa2\x3f\x65\x80\x53\x6d\x69\xdb\xfa\x12\x91\x9c\x25\x29\xa9\x89\x86\x12\x11\xc1\x69\x11\xd0\x07\x2e\x09\x4a\xd8\x3d\x13\x64\x16\x55\x97\x13\x9e\x43\x31\x64\xc6\x9e\x9f\xa6\x2e\xd3\x83\x3d\xa0\x3b\x61\x8d\x6d\x06\x0e\x63\x34\x4d\x5c\xe6\xbe\xed\x99\x84\x54\xea\x1d\xf0\x77\x44\x24\xab\x98\xf8\x7c\xdd\x9c\x51\x88\xd5\xce\x23\x36\xd6\xcd\xd2\xc6\x49\x10\xad\xe7\x29\xe9\x9b\xbc\xa9\xfe\x24\x9a\x0b\x93\xcf\x34\x43\x4c\x2e\x17\x95\x38\x08\xa5\x69\x4a\xb1\x09\x74\xfb\x03\x54\x2f\xf3\x0a\x04\xbb\x83\xab\xd8\xdf\x38\xd4\x28\x3c\xfa\xa9\x21\x7b\x97\x56\x9e\x81\x6c\xec\x80\x86\xe0\xaa\xab\x98\x69\xc9\xcc\xee\x14\xc4\x51\x69\x4b\xca\xb8\x4a\x75\x20\xcb\x9e\x10\x51\x23\x57\xe8\xd0\xff\xdc\xe3\x15\x3a\xe4\x33\x1b\xea\xeb\x32\x4c\xc5\xdd\x3b\x65\x7c\xda\x52\xa6\xdd\xa5\x61\x4b\xee\xb6\x92\xc4\x1e\xa4\xa5\x34\xed\xd1\x71\x7e\x56\x3e\x95\x50\x70\x7d\xe5\x57\xa4\x8f\x45\x75\x26\x25\x40\xf5\x13\xad\x50\xfd\x27\x7a\x0c\xe8\x52\x55\xc0\x70\x1b\xce\x10\x28\x09\xbc\x4a\xff\xa6\x52\x87\xe4\x8e\xa2\xfc\x3a\xaa\x7a\xcf\x9e\x9f\x50\x56\xff\x55\x56\x76\xb9\x11\x84\xdc\xe6\x44\x09\x59\x4f\x5c\xca\x52\xf3\x7f\xaa\x09\xfa\x53\x7d\x8f\x03\x52\x83\x65\x0d\xe9\x64\x5b\xad\x4f\x4f\x6c\x1a\x14\x61\x74\xb8\x69\xb4\x68\xf9\x74\x45\xce\x73\xe5\x21\x6d\x00\x6f\x9c\x9f\xf2\x58\x3b\x0c\x1b\xca\x8b\x68\x48\xa4\x7c\x2a\x6e\x40\xde\x38\x3f\xc7\xce\xba\xa1\x09\x45\x16\x31\x9d\xe3\x0d\xe0\x0d\x16\x4a\xdb\x56\x99\x4d\xf4\xc3\x8d\x30\xc5\x7c\xd4\xaa\x93\x45\x64\xce\x53\x93\xc5\x00\x8d\x1c\xac\x32\x81\x2d\xc4\x7a\xa2\xd9\x15\x23\xce\x5b\x7e\x6e\xbd\x39\x89\x09\x4d\xb5\x9b\x91\xd0\xc7\xdf\xa2\xb3\x4b\x85\x26\x35\xd0\x67\xc4\x12\x1f\x8e\x7a\xbe\x86\xa6\x28\x17\xe8\xf2\x89\x6a\x77\x2e\x9a\x3e\x7e\x8d\xf7\x19\xbd\x6f\x8b\xff\x4d\xb2\xa7\xe3\x92\x37\x36\xf9\x39\x91\xa6\x31\x4f\xc1\x95\xe2\x9e\x5a\x98\x02\x0e\xd5\x1b\xfa\x24\x9c\xec\xe8\xc5\xe9\xaa\x9f\xe3\x27\xed\x38\xf4\xa3\x2e\xfe\x58\xbd\x4b\x9f\xb4\x25\xd1\xd7\x4e\x0d\xf9\x3e\xff\x7e\xf6\xc9\xc4\x46\x9a\x13\x65\x9c\x29\x66\x1f\x13\x7b\x2c\xfb\x0e\xae\x92\x4c\x0c\xba\xa1\x45\x74\x91\xd6\x05\xd0\x35\xf4\xd6\x07\x7d\x8c\xc1\x96\xd2\x6d\xee\x9b\x0a\x9d\xea\x2e\x2f\x4d\x71\x52\xba\xab\x8d\x47\x13\x08\xb4\xa2\x7f\xd0\xcc\xe5\xca\x9f\x41\x38\x1c\x38\xc9\x54\x0e\xc3\xe8\x0c\xca\x19\x94\x47\xe3\x15\x15\x3d\x13\xb5\xfb\xbc\xb4\x83\x41\x1c\xb5\x15\x92\xea\x85\x23\x37\xa3\x29\x88\x62\x3e\xf4\x9d\x1d\x35\xcf\x62\xe9\xea\xd5\x96\x06\x52\xdb\x53\xac\x9b\xa7\x8e\x28\xff\x5e\xac\xb3\xd0\x10\xa3\x54\x61\x83\x9f\x6a\x3d\xca\x94\xb1\xc8\x47\x62\xc1\x1f\x45\x23\xe7\x63\x30\xa0\x51\xf6\xe3\x11\x64\x1c\xf9\xad\x69\x38\x19\xab\x3a\xaa\x6f\x39\xf7\x46\x7e\xb9\xd7\xe0\xf8\x4a\x4a\x80\xea\xaf\x34\xfa\x10\xa9\xcf\x70\xbb\x3c\xc5\x60\xe7\x1d\x28\x0d\xc7\xc1\xe3\xac\x3d\x7a\xc3\x23\xd7\x48\x3e\x1d\x4b\x79\x39\x29\x65\x1c\xd0\x8d\x7e\x46\xa6\x7a\x17\x5b\x7d\x0f\x65\x6f\x36\xd1\x39\x8d\xa1\x1d\x08\xd9\x2b\x73\xb6\x5f\x6e\x58\x32\x42\xac\xe4\x23\xb1\xa1\x8c\xd7\x8b\xa3\xbf\xa2\xc4\xce\x1d\xd3\xe4\x46\x13\x58\x66\x8a\x2f\xbb\x13\x1a\xc9\x53\x06\x74\x1b\x15\xb0\xdf\xa4\x27\x04\x1a\xae\x50\x89\x1a\xc3\x29\xbd\x20\xe4\x06\x96\x61\x76\x20\x72\x3d\xd8\x8c\x9a\xf5\xc4\x26\xf7\x41\x69\x1d\x9b\x0b\x2a\x19\x92\x26\xbd\xed\xa9\x16\xc7\x3e\xb1\xe6\x95\x69\x35\x6e\xd0\x04\x15\x92\x0f\xfd\x48\x93\x5e\x88\x1b\x10\x37\xd6\xe0\xd1\x48\xf8\xfc\xa1\xba\x7a\xf8\xe7\x8f\xfe\x43\xb5\x83\x07\x9e\x88\x7f\xfc\x92\xaa\x64\x11\x0b\xa1\xab\x87\x7f\xfd\x48\x25\x3e\x5c\x3d\xfc\xcb\x47\xff\xf0\xc7\x8f\xf9\xbe\x09\xee\xb8\xf1\xa2\x29\x01\x50\xdd\x8b\x86\x6a\x76\xe0\x2d\xf2\x3c\xba\xdf\x1a\x25\xd1\xd4\xb8\x9a\x1d\x1a\xcd\x74\x8c\x7d\xd0\xff\xe6\x41\x1e\xf0\xa3\x9c\xfb\x59\x4a\x3f\xf3\x79\x39\x3d\x38\x00\x83\xa6\x69\x7a\xbc\xb5\xfd\x45\x94\xa3\x39\x47\x5a\x50\x92\xae\x53\x18\xfd\x3d\x98\x8b\x9d\x13\x5e\x80\xea\x07\x5e\x4a\xb3\x99\xd4\x96\xce\x01\x48\xa0\x1f\xe2\x72\x29\x73\x12\xd8\xc6\x1f\x4d\xe8\xd0\xab\xff\x65\xf9\x2b\xcf\xab\x70\x10\x7a\xc4\x53\xc0\x8b\x99\x7b\x77\x02\x12\x7d\xae\x80\x44\x81\x13\x5b\x76\xa0\x37\x09\x65\x8d\x98\x8f\xbc\x89\xb3\xb7\xd3\x0e\x3c\x62\x27\x0e\xca\xc6\x72\x6e\x7e\x24\x8f\x8f\x72\x2e\x0c\x81\x1f\x9d\x92\x02\x69\xd0\xcf\xf9\x9e\xaa\x70\x1a\xc3\x52\x41\x6e\x52\x3b\x94\xca\x20\x1a\x14\x3e\x43\x5b\x8b\x80\xad\x75\xc9\x91\x5f\xe5\xaf\x73\x30\x8f\x21\x4c\x0c\x27\xea\xcf\xa0\x58\x69\x85\xc7\xbf\xf1\xd7\x39\xcc\xc4\x0c\x69\xfb\x55\xf9\xf2\xcf\xb0\xf1\xed\x5b\xb0\xfd\x44\x5f\xcf\x81\xf6\x88\x83\xd0\xe9\x9e\xa8\xfe\xb3\x7c\xb1\xca\x7b\x7e\xc1\x2b\x6f\x22\xd9\x15\x4e\x5e\x50\xa6\x4c\x03\x11\x3c\x5d\x52\xf4\xcf\xcd\xc9\x93\xca\x6f\x9e\xbc\xf8\xca\x92\x30\x09\x4f\xb3\xf5\x79\x84\x41\xf5\xa1\x4a\xab\x1f\x2a\xe8\x51\x98\xfc\x6e\x57\x46\x8f\xf4\x57\xb0\xee\x08\x83\x30\x8a\x8b\xdf\xa7\x0e\x4d\x7e\x41\xb0\x34\x99\xe9\x84\x6e\xe2\xab\xa8\x08\xb0\x57\x54\xc0\x35\x53\x0a\xb2\x73\x64\xd4\xef\x44\x7a\x69\x34\x5c\x5a\x93\xf9\x9c\x3e\xf1\x1b\x2f\x88\x39\xbb\x50\xfd\x18\xd7\x66\x50\x07\x74\xf3\x1c\x4d\x7f\xaa\xbf\xa5\xb5\x19\x58\x7c\xca\x3b\x45\x76\x17\xd7\x66\x50\x93\x3e\x63\xdd\x4f\xfe\x31\xbd\xc6\x42\x5c\x9b\xc1\x8f\x66\xd6\x1e\x24\xac\x54\xa4\x41\x63\x47\x23\xe7\x90\xe5\x16\x9b\x41\xde\x97\xb5\x19\x60\xaa\xbb\xe7\xac\xe6\x5a\x9c\x10\x52\xf6\x27\xdd\x9a\xd3\x57\xab\xfb\xb4\x06\x29\x33\xa5\x4a\x83\xd7\x36\xb5\xcd\x6a\xac\x5e\x59\x89\xa7\x9b\xe9\x5d\x90\x90\xa4\xc7\xbe\x6a\xbd\xfc\xc2\xcf\x8d\x75\x79\x12\xb8\xa7\x59\x69\xba\xc7\xc8\x9c\x8a\x5a\x93\xd4\xe3\xe6\xc7\x42\xad\xb8\x31\xa3\x83\xb4\x4e\x0d\x31\x03\x89\x8b\x2f\x64\x3c\x88\xe5\x74\x1b\x9f\x1e\xcf\x69\xdd\xc2\x43\x7a\xf9\xfc\x7c\xe9\x21\x83\xdf\x1f\x2e\xbf\x71\xd0\x8b\xc4\xf1\xf2\x56\x19\xc1\x17\x11\xdf\xa1\x90\xf9\x8d\x93\x2f\x44\x2a\x6c\xf2\x53\x69\x7a\x1b\x9d\xa6\x00\x54\x50\x4b\x68\x94\xc6\xf4\x0e\x47\xc2\xe6\xdf\x07\xfc\xe5\xfe\xed\x1b\xb0\x8f\xbf\x60\x1d\xa0\x17\xc3\x40\x73\x90\x8c\x89\xf4\x15\x6c\xfe\xf4\x5b\x78\x1d\x5e\xf8\xf2\xee\x41\x58\xf2\xb0\x96\x90\x53\x2c\x10\x56\x02\x50\x69\x5c\xa9\x78\xfa\xa8\xcc\xf9\x1b\x2d\x17\x6e\x04\xf1\xc2\x13\x1a\xaa\x99\x41\xab\x3d\xea\x23\x09\xa2\x3c\xcd\x46\xf2\xe5\x39\x7b\x55\xdf\x2e\x9b\xd1\xd4\x27\xe2\x5f\x0f\x22\x74\x10\x75\xbe\x82\xeb\x89\x00\xf3\xb8\x82\xcf\xcb\x85\x14\x41\xf0\x37\xec\x6e\x21\xfe\xae\x62\x4b\x28\x7e\x54\x1a\xf9\xf8\x6a\xb9\x50\x0d\x03\xfc\xe1\x16\x8c\xd2\x74\x68\x11\x4b\x6b\xfa\x4c\xb8\xfc\xf6\xbf\x9d\x18\xae\xd1\xb9\xd5\x72\xf1\x65\xb9\x20\xfb\xf7\xbe\xf5\xe5\xb5\xb9\x60\xd9\xdd\x02\xfd\xa0\x63\xfb\x57\xd3\x0b\xe7\x3b\xa1\xaf\x23\x0b\xff\x40\xe0\xab\xef\xfe\x1f\x94\xde\xd8\x80\x0d\x91\x5a\xc7\xe5\x3c\x10\x1b\x84\xf3\xd4\xc2\x56\x6b\x48\x8c\x27\x46\x46\xb3\x37\x54\xa6\x65\xf7\x5b\x2e\xc8\x34\x4a\x92\xc8\x3c\xd2\x00\xa2\xcd\x82\xa9\x06\xfe\x67\x0d\x76\x4f\x5b\x67\x66\x79\x50\xf2\xe3\x77\xf0\x07\xbb\x67\xc0\x45\x46\x7a\x4b\x8f\xbb\x68\xe4\x75\x5a\x58\x83\x92\xab\xe5\x82\x94\xf0\x85\x85\xd6\x68\xf2\xde\x0a\xfe\x0d\xfe\xc8\xc7\xbd\x75\x61\x7b\xcf\x86\xf1\x65\xf7\x2b\xe2\xe2\x53\x73\x4d\x75\x2a\x4d\x1e\x13\xe8\x89\x17\x5e\xfd\x9a\x24\x5e\xe7\xed\x28\x7a\xc2\x45\xb2\xb1\xa6\x52\x7c\xfc\x19\xa9\x7f\xa0\xad\x34\x16\x4b\xa8\xca\xab\x56\xcb\x6f\x3f\x4a\xce\x7e\xd7\x91\xf7\xc8\x1f\xe3\x76\xf9\x05\xc8\xec\xf7\x21\x27\xbf\x43\xe8\xb9\xc6\x23\x5f\x35\x1c\x6d\x17\x7e\xec\xf0\x1d\xf4\xf9\xd5\xdb\x28\x9d\xfc\xf7\xba\x2f\x08\x57\xf4\x7e\x7c\xad\x64\xf2\xe0\x35\xfd\xec\xc4\xc3\x76\xbb\x55\x26\xa0\x6b\x44\x8d\x9f\xbf\xac\xd2\x26\x69\xb5\xf7\x6d\xb6\x5d\x4f\xd6\x62\xf5\x67\x83\xe5\xcd\x8b\x76\x5d\x2e\xe6\xa0\x8b\x9b\x1b\x78\x99\x7f\x2d\xe3\x68\x82\x09\xc2\xef\x53\xe1\x3e\xe5\x12\x0a\xd1\x04\xdd\x09\x8e\xe5\x47\x44\x03\x42\xca\x78\x17\x9e\xd1\xd9\x12\x2c\x5f\xb9\xd7\xb3\x5f\xc7\x5c\x57\xcf\x4d\x1a\x2d\xaa\xe4\xea\x99\x1f\x91\x02\x56\x70\x7b\x9b\xbc\x68\xb2\xf0\xdc\xe0\x73\xec\x2c\x35\x9d\xda\x6e\xb7\xab\xe4\x00\x69\x9c\xf7\x8e\x3d\x3f\x7b\x82\x83\x38\x0d\x78\x9c\x59\x3b\xb3\xc4\x8d\x44\x7f\xc9\x3e\x73\x54\xd7\x0e\xfe\x31\x4d\x17\x19\xf5\xdc\x32\xaa\x01\xb7\xa5\x97\x75\x9a\x07\xdc\xde\x42\x55\x9d\xf0\xbf\x25\x3b\x9f\x4d\x22\xd7\xe0\xb6\xaf\xa9\x3a\xe5\x9f\x5f\x9d\x7a\xf4\x19\x3c\xc7\xf0\xd9\x81\xf5\x44\x70\xb5\xfc\xb2\xfc\xbf\x01\x00\xdc\x7e\x0e\x90\x68\x26\x00\x00")
+
+func apidocMessagesGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocMessagesGo,
+		"apidoc/messages.go",
+	)
+}
+
+func apidocMessagesGo() (*asset, error) {
+	bytes, err := apidocMessagesGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/messages.go", size: 9832, mode: os.FileMode(436), modTime: time.Unix(1792000328, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocMethodexampleGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x5f\x6f\xe4\xb8\x0d\x7f\xb6\x3f\x05\x63\x60\xb3\xf6\x9e\xab\xb9\xde\x63\x16\x29\xd0\x62\x7b\x45\x0a\xec\x5e\xb1\xb9\xa2\x0f\x41\xd0\xe3\xd8\xf4\x58\x19\x5b\xf2\x49\x72\xa6\xee\x6e\xbe\x7b\x41\x49\x1e\x7b\xf2\x0f\x41\xf3\x32\xb1\x44\x52\xe4\x8f\x3f\x92\xd2\x80\xd5\x1e\x77\x04\x38\xc8\x5a\x57\x69\x2a\xfb\x41\x1b\x07\x79\x9a\x64\xdb\xc9\x91\xcd\xd2\x24\x23\x55\xe9\x5a\xaa\xdd\xe6\xce\x6a\xc5\x0b\x3b\xbd\x71\x7a\x4f\xfe\x7f\xa9\x37\x52\x8f\x4e\x76\xfc\x61\xb5\x71\xfe\xd7\x19\xa9\x76\x36\x4b\xbd\xf0\xb0\xdf\x09\xa9\x36\x64\xcc\x4e\x8b\xfb\x9f\x36\x4d\xef\xf8\x43\x1b\x9b\xa5\x45\x9a\x6e\x36\xf0\x99\x5c\xab\xeb\xbf\xfe\x07\xfb\xa1\x23\x68\x75\x57\x5b\x40\x05\x14\x17\x74\x03\xae\x25\x18\xd0\x60\x6f\xc1\x92\x72\xe0\x34\x20\xf4\x5e\x8d\x0d\xa0\xaa\xbd\x88\x21\x3b\x76\x0e\x0c\xb9\xd1\x28\xaa\x4b\x68\xb4\x01\xdb\xea\x83\x54\x3b\x90\xca\xcb\xd4\xba\x1a\x7b\x52\x0e\x9d\xd4\x4a\xa4\x6e\x1a\xe8\x91\x03\xd6\x99\xb1\x72\xf0\x2d\x4d\x7e\x95\xae\x23\x08\x7f\x21\xa8\x34\xf9\x44\xb6\x32\x72\x60\xed\xb8\x16\x05\x00\xe0\x37\x86\xe8\x22\x2b\x75\x2f\x1d\xf5\x83\x9b\xb2\xdf\xd2\xe4\x1f\xc1\x6f\x2f\xc1\xfb\xe2\x2b\x1e\x3e\x93\xb5\x8c\xfb\xb3\x0a\x5f\x43\x14\x6f\x56\x48\x93\xcd\x06\xae\x27\xe5\x5a\xb2\xf2\xbf\x54\x47\x04\x0f\x2d\xb9\x96\x8c\x0f\x7a\x86\xf2\x80\x16\xec\x22\xe9\x35\x1b\xa3\xfb\x05\x60\x8f\x65\xc4\xd1\x56\x2d\xf5\x68\x4b\x86\xee\xd0\xca\xaa\x85\x0a\x2d\x81\x74\x5e\x8f\x71\xb5\x5e\xd1\xb6\x38\xac\xd2\x34\x75\x1a\x6b\x0b\x06\xe3\xf1\xa8\x7c\xb2\x50\x49\xb5\x6b\xc6\xce\x2b\x57\xd8\x75\x02\x7e\xf1\x12\xd1\x39\x0b\x68\x08\xaa\xd1\xa0\xa3\xfa\x23\x58\x22\xf8\x73\x3d\x27\xc5\x8a\x34\x59\x87\xb8\xd5\xba\x7b\x16\x8c\x07\xcf\xa8\x6b\x6f\x71\x4e\x68\xe0\xc3\x09\xa7\x98\x18\x3d\x1c\xa4\x6b\xc1\x06\xa1\x7b\xec\x46\xb2\xac\xbc\x02\x28\x80\x23\x9d\x7d\x05\x1c\x6d\x40\xc9\x0e\x64\xc3\xc0\x6c\x36\xd0\xa2\x05\x45\x92\x43\x13\xf0\x2b\x33\x8e\x9a\x10\x1b\xda\x23\x35\x61\x3b\xc1\xb5\x87\xf7\x13\x35\x52\x49\xa6\x93\x15\x69\x33\xaa\x0a\x72\xa9\x1a\x0d\x1f\xae\x54\xa3\x8b\xd3\x48\xf2\x1e\x3e\x04\xae\x96\xc1\x6a\x8f\xc3\x4d\x20\xe1\xed\x87\x60\xae\x98\x25\xe6\xe0\xbf\xa5\x89\x6c\xa0\x17\x9e\x86\x70\x79\xe9\x9d\x3d\x3f\x87\x5e\x44\x9e\xc5\xa5\x6f\x69\x92\x04\xef\xf8\x33\x4d\x1e\xd2\x84\xe0\xe2\x12\xce\x4f\xcc\xb1\x94\xaf\x8a\x8b\x48\xf9\x4f\xd4\xe0\xd8\xb9\xc8\x4f\x2b\xfe\x46\x2e\xcf\x22\xca\x7f\x58\x21\x99\x15\x65\x9a\xac\x53\x78\x01\xce\x8c\x54\xfa\x83\x56\x0e\x9e\x2d\xde\xc8\x06\x6a\x74\x58\x02\x19\xc3\x9e\x70\xb6\xc5\x67\x34\xb6\xc5\xce\x63\x24\x42\xc8\x79\xd4\x2d\x44\x00\x2b\x67\x68\x8a\xe2\xa3\xd7\x5b\x45\x97\x50\x90\xb3\x70\xe9\x0d\xa7\x49\xf2\xb0\x1c\x1f\xd1\xf8\xff\xce\x0f\xca\x6f\x70\x60\xc6\xfc\xd4\x81\x88\x3b\x45\xf6\x7e\x25\x9c\xf1\x66\xc6\x70\x31\xc5\xb2\x98\xf9\x6b\x97\xaa\xfd\xfb\xf5\x2f\x5f\xa0\x91\x1d\x01\x3a\x18\xd0\xb5\x25\x9b\x08\xe5\x7a\x6c\xa5\x7a\x7b\x47\x95\x83\x1e\x87\x81\x1b\x96\x57\x0e\xed\xd3\x96\x80\x16\x7e\xc6\x0a\x6b\x12\x33\xb7\x9c\x66\x1b\xae\x25\xb9\x54\xa7\x80\xeb\xc7\x15\xe9\xd9\xba\x76\x36\xe7\xf3\x63\x57\x2c\x20\x5f\xb1\xf3\xe6\xf6\x84\x46\x1e\x53\x6d\x0a\xc6\xf9\x04\xe3\x30\x4c\x04\x1b\xfd\x59\x76\xe4\x0d\x16\x9e\xc1\x2c\x70\xf6\x1c\x55\xa3\x2d\x2b\xfe\x65\x70\xc8\xc9\x98\xc2\x27\xf5\x1e\x57\x9d\xe5\x65\x4f\x8e\xb6\xe7\x04\xff\x53\xf5\x31\xc5\xc1\xb1\xf3\xd9\x48\xf1\xf1\x0d\x3e\x7c\xd1\x8e\x1a\x76\xa2\x0c\xcb\x59\x85\x4a\x69\x4e\x8c\xb1\x04\xef\x6c\x56\x42\x0c\x29\xba\xb8\xc5\x1a\x6e\x6e\xe7\xe1\xc2\x6d\x69\x4f\x53\x09\x64\x99\xf1\x06\xd5\xee\xd8\xbc\xad\x8f\x7b\x40\xe3\xfc\x5e\x50\xb1\xe2\x7a\xe8\xa4\xcb\xbd\x52\x26\xb2\x22\xf0\xb6\x23\x95\x7b\xc9\x82\xfd\xfd\x09\xbe\x7f\x87\x33\x3f\xb3\xc5\x95\xbd\xaa\x49\x39\xd9\x48\x32\x41\xe4\xe6\xc7\xdb\xe2\x75\x81\x3f\xde\xfa\x44\x25\x09\x3b\x7b\x09\x38\x0c\xa4\xea\x7c\x8b\x75\x09\x7b\x9a\xf8\xc8\xa4\xd2\xca\x49\x35\x52\xa8\x29\x1f\xc7\xbf\x4b\xa0\x55\x10\xc1\x7d\x76\x8e\x84\xef\x20\x5c\x19\x59\xc6\x27\x93\x58\xb7\x76\x2f\xf6\xca\x51\xc9\xd6\x10\xee\x59\xe8\x61\x5d\xc1\x1c\xf2\x16\xeb\x02\xfe\x04\x3f\xfa\xa3\xf8\x36\x22\xae\x03\x4a\xec\x6c\xf1\x42\xc6\xe8\xd0\xe4\xd9\x3b\xeb\xfb\xb6\x54\xf7\xd8\xc9\x7a\x81\x9c\x03\x79\xf7\xfb\x05\x1f\x6e\xa1\x1f\xad\x83\x2d\x3d\xae\x16\x54\x2b\x05\x45\x54\x03\x42\x88\x90\x77\x2a\x54\xef\xbd\xd6\x2a\xc6\xc8\x82\x12\x82\x5b\xab\x0e\x10\xcd\x78\xf6\xc4\x6e\xb0\x2a\x39\xc0\xba\x0e\x23\x77\x27\xef\x49\x3d\xe9\x0a\x3e\x1f\x61\xb8\x84\xea\x06\xf4\x13\xed\x49\x79\x03\xdd\x93\x99\xe0\x9e\x8c\xe5\x7b\x4c\x1c\xdd\xb1\x23\xf0\xb8\xe7\xf6\xc6\xa3\xdb\xf1\xc6\xc4\x36\x78\x80\x35\x3a\x8c\xb4\x09\x76\x1a\xb6\xd4\x68\xc3\x31\x4e\x27\x13\x73\xf1\x05\xbd\xab\xd3\x7b\x43\xac\xdf\xb3\xf0\x68\xa9\x19\x3b\x01\x57\xf3\x25\xcd\x77\x27\x9f\x09\x50\xd8\x73\x6f\x5a\x3b\xe2\x1d\x40\x43\xea\xbd\x9f\xac\xd1\xaf\xb5\x65\x18\x8c\xde\xe2\xb6\x9b\xa0\x97\x96\xef\x72\xf5\x73\x43\x74\x05\x61\x3e\xfb\xf7\x4a\x4f\x28\xb8\xd0\xb5\x61\x16\x8d\x96\x6a\x26\x71\x8f\x7b\x5a\xf7\x33\xbe\x7f\x14\xa1\x5e\xe5\x42\x72\x3e\x53\x04\xb0\x03\xdd\x1b\xde\x3b\x5f\x2f\xdf\xc8\xdb\x58\x1f\x77\x8b\x5e\x13\x53\x13\x6b\xa4\xe7\x9d\xf3\xe3\xe2\xcd\x1d\xab\x24\x7b\x9a\x78\xbd\x11\x5f\xb0\x27\xf8\x01\x32\x91\xc1\x0f\xd0\xfb\x4f\xde\x67\xc8\xf5\x9e\x45\xe6\x10\x6f\xf6\x34\xdd\xc6\xa2\x3b\xd3\xfb\x58\x59\xab\x52\xe5\xea\x49\x7c\x8c\x5e\x14\x2e\xfd\x4c\xe6\xb5\x5e\xcc\x80\x2d\x55\x18\x7f\x1e\x81\x95\x2b\xd9\x15\xdc\xb0\x84\x10\x45\x09\x8b\x22\x7f\x1f\x0b\x94\xbb\xf1\xa8\xf6\x4a\x1f\xd4\xd3\x76\xb7\x00\x71\x4c\x4e\x9c\xbf\x67\x8b\x6f\xbc\x92\xcc\x26\x8e\x3e\xc5\x85\x63\x77\x38\x69\x07\x71\xf3\x85\x96\x30\xef\x2e\x6d\xe1\xa4\x23\x1c\x5d\x09\xa5\xc6\x99\x8e\x1a\x47\x7a\xbe\xfb\x3d\x2b\xe7\xc5\x93\x32\x5e\x8a\x37\x5a\x09\xed\x60\xa6\x3c\x53\xdc\xf9\x15\xdd\x00\x95\xe0\x70\x3f\x33\x7f\x5e\x66\x5d\x7c\xae\xae\x96\xc1\xdf\xc7\x27\x41\x85\x0e\x3b\xbd\x8b\xbc\x5f\x1f\x98\xf7\x76\x67\x61\xbe\x9a\x95\xf0\xe8\xa1\x53\xcc\xef\x97\x70\x45\x7c\xda\x85\x63\x34\x6c\xe5\x95\x7b\xdd\x49\xff\x0a\xad\xfd\x34\x78\x7f\x43\x59\xc7\x1e\x10\x8d\xbb\xf3\xbb\x4e\x1b\x8e\x39\x5e\xae\xa5\xe2\x19\x44\xf5\x1b\x1e\x70\xeb\xa8\xf9\x24\x3f\xb6\x1f\xbf\x9b\xd6\xa1\x32\x15\xb7\x63\x03\xfe\x81\x2b\xfe\x32\x36\x0d\x99\x27\xb7\x80\x2b\xef\x40\x7e\xbe\x1d\x9b\x32\xde\x02\xb3\xac\x84\x0c\x20\x7b\xf1\x1e\x10\x8e\xf0\xe7\x9f\xb0\x61\x3b\x36\x91\x74\x79\x91\x3e\xa4\xff\x1b\x00\x5c\x37\x75\x10\x74\x0f\x00\x00")
+
+func apidocMethodexampleGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocMethodexampleGo,
+		"apidoc/methodexample.go",
+	)
+}
+
+func apidocMethodexampleGo() (*asset, error) {
+	bytes, err := apidocMethodexampleGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/methodexample.go", size: 3956, mode: os.FileMode(436), modTime: time.Unix(1792000212, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocMigrationGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x5b\x6f\xdb\x36\x14\x7e\x96\x7e\xc5\x99\x80\xd6\xf6\xa2\x28\xdd\xe3\x92\xb9\x40\xd0\xee\x12\xa0\x1e\x8a\xa4\x58\x81\x05\x41\xc1\x48\x87\x16\x61\x89\x14\x48\x2a\x6e\x96\xfa\xbf\x0f\x87\xa4\x2e\x76\x14\xaf\x0f\xf3\x83\x21\x92\xdf\xf9\xce\xfd\x90\x0d\xcb\x37\x6c\x8d\xc0\x1a\x51\xa8\x3c\x8e\x45\xdd\x28\x6d\x61\x1e\x47\x09\xaf\x6d\x12\x47\x89\xb1\x5a\xc8\xb5\x49\xe2\x45\x1c\x9f\x9d\xc1\x4a\xac\x35\xb3\x42\xc9\x1b\x8b\x0d\x94\xaa\x2a\x0c\x30\x30\xb4\x50\x1c\x18\xd4\xaa\xc0\x0a\xea\x0e\x05\x4c\x16\x60\x4b\x24\x51\xce\x72\x56\x20\xd4\x68\x4b\x55\x18\xc8\x59\x55\x61\x01\x42\x82\xb0\x59\x6c\x1f\x1b\x3c\x20\x37\x56\xb7\xb9\x85\xa7\x38\x3a\x3b\x83\xab\xf7\x41\x99\x2d\x11\x44\x01\x8a\x13\x2d\xd4\x68\x0c\xd9\x5f\xa0\xc9\xb5\xb8\x17\x72\xed\xb6\xc9\x9e\x2c\x8e\xae\xde\x83\x37\x3f\x76\x1c\xab\xa0\x79\x20\x7a\x6e\x4b\x27\x9d\x86\x85\x13\x94\xb8\x45\x63\xe1\x01\xb5\x11\x4a\x06\xdd\x42\x77\x1e\xd9\x92\x59\x28\x99\xa3\xac\xb3\x38\xea\xf4\xdc\xde\xf9\xaf\x6b\xe4\xf1\xce\x45\xaf\x8f\x0b\x39\x38\x36\x84\x54\x9a\xc9\x08\x3a\x43\x94\x2e\x50\xa7\xc4\xb0\x15\xb6\x1c\x9b\x9e\x02\x33\xf0\x9b\xb3\x23\xf3\xda\xd2\x91\x37\xc8\xf2\x32\x83\x4f\x25\xee\xa5\x6e\xc5\x8c\xc5\xde\x7a\x61\xa0\x35\x58\xc0\xfd\xa3\xe7\xed\x50\x50\x7b\xd8\x56\xe9\x0d\x6a\x22\x50\x21\x3c\xaa\xd5\x39\x42\xae\xa4\xd5\xaa\xaa\x50\xa7\xb0\x2d\x45\x5e\x42\xa1\xc5\x03\x9a\x7d\x96\x0b\xb0\x07\xda\x3f\x31\xbd\x46\x3b\xd2\xde\x68\xf5\x20\x8a\xc1\x02\xeb\x01\x03\xff\x05\xc9\x87\x42\x1a\x79\x21\x24\x25\x63\xda\x0b\xb6\x46\x69\x4d\xc8\x14\x89\xfb\xa8\x5a\x05\x1a\x5d\x85\x7b\x5f\x84\x86\x46\xab\xb5\x46\x63\xb2\xf8\x81\xe9\xc1\x6e\x9f\xa0\x25\xdc\xde\x0d\x65\x28\x0a\x70\xbf\x50\x53\x51\x57\x3d\x0e\x23\xe4\x3a\xde\x3d\xc5\xd1\x53\xd2\x73\x9c\x52\x56\x4f\x85\x14\x56\x30\x8b\x49\xda\x03\x9f\x92\x77\xbd\x73\xd9\x55\x38\xef\x1d\x4b\x76\xbb\x74\x82\x67\xcb\x6c\x5e\xee\x91\x0c\xa1\x70\x99\xca\x3e\x07\xc4\xb3\x83\x7e\x7d\x63\x99\x6d\xcd\x14\xe4\x06\xed\xc7\x92\x19\x7c\xe1\xcc\x0b\xae\x7c\xb7\xbd\x60\x60\xa3\x31\x2f\x31\xdf\x98\xa3\x46\x7e\x1c\xa1\x86\x43\x5f\x14\xa3\xc3\x69\x15\xf8\x95\x92\x77\x94\x7f\x45\x89\xbe\x92\x5c\x4d\x79\xf2\xab\x97\x9f\x26\x17\xf5\xcb\xe4\xc1\xbe\xab\xfa\x88\xfc\x03\xab\x44\xe1\xb4\x1d\x35\xd0\x65\xc9\x17\xef\xb5\xab\xc5\xc9\x7c\x1c\x01\xb8\x93\xa9\x64\xfb\x03\x2f\x33\x15\xdd\x77\x94\x9d\x15\xcb\x4b\x21\xf1\xa5\x08\xb3\xdc\x8a\x87\xc3\x6a\x3d\x24\xba\xec\x40\xd3\x1c\x95\x5a\x5b\xcd\xa4\xe1\xa8\x8f\xd2\x7c\x60\x16\x8d\xfd\xa0\xd6\x9f\x44\xfd\x12\x97\x46\xd6\x1c\xb7\xa5\x50\x8d\xbd\x46\x3f\x94\x26\x43\x79\x4d\x14\xd3\xec\xec\xfe\xbf\x32\x7e\x79\xdf\x25\x7c\xb7\x7f\xff\x7d\x56\x7a\xc3\x2b\xb5\x05\x8d\xb6\xd5\xf2\x7b\xc6\x38\xc9\xfb\x49\xfe\x6c\x8c\xd3\x90\x17\x92\x2b\x7f\x97\x30\x8d\xcf\x86\xb8\x1b\x48\xfd\x0d\x20\xd5\x33\x49\x12\xaa\x90\x5b\x50\xad\x4d\xc1\x10\x15\x82\x46\xd3\x56\x96\xa6\x23\xd6\x8d\x7d\x04\xc1\x89\xc2\xe1\xfd\xe5\x23\x95\xc4\x30\x26\x07\x63\xc3\x50\x35\x59\xcc\x5b\x99\xc3\xdc\xe1\x7f\xa4\xa6\x5a\x3c\x0f\xc0\x7c\x01\xb7\x77\xfd\x2e\x99\x49\xb3\x32\x5c\x99\xe7\x4b\xa8\xd9\x06\xe7\x35\x6b\x6e\x7d\x88\x87\x3b\x71\x11\x47\x5c\x69\xf8\x92\x02\x87\xf3\x25\x68\x26\xd7\x08\xa4\x2a\xf3\xf7\x99\x21\x9e\x0e\x52\x0f\x10\x1e\x2e\x3a\x7f\x1e\x6d\xf0\x91\xce\x78\xf6\x27\xab\x11\x4e\x20\xc9\x12\x38\x81\xda\x2d\xe3\x28\x8a\x04\x07\x8d\x3c\x05\xb5\x21\x98\xb7\xeb\x76\x83\x8f\x77\x17\xf0\x83\xda\xc0\xb7\x6f\xc0\xb3\xbf\xc2\xcd\xfe\x96\xa0\xfd\xca\xd1\x07\x4f\x9c\x04\x2c\xa1\xb7\xfe\xc9\x2b\x4c\x07\xe9\x34\x28\xdd\x91\x18\xfd\xed\xe2\x68\x17\x47\x74\xad\xf8\xba\x38\x08\x53\xef\xbe\x19\x7c\xeb\x53\x40\x71\xf4\x0e\x92\x2c\x01\xf6\x64\xe9\x20\xba\x7a\x7f\x0e\x26\x13\x45\xea\x75\x75\x74\x92\xe2\xd0\x33\x9a\xac\x2b\x94\xa7\x17\xa3\x41\x12\x77\x17\xb4\xe7\x30\x4e\x65\x1f\xe4\x25\xb0\xa6\x41\x59\xcc\xc7\xbb\x29\x05\x6a\x31\x72\x94\x88\x2b\x94\x7b\xa0\x05\xbc\x85\x37\xce\x07\xc7\x78\x40\x65\x52\xf7\x6c\x5c\xf4\x81\xf2\x8d\xe4\x36\x4d\xf7\x5e\x62\x7a\x53\xa8\xad\xbc\x94\x79\xa9\xb4\xd9\xeb\x35\x16\xf6\x5c\xc7\xfc\x2e\xec\x1f\xed\x3d\xac\x87\x07\x88\xb3\x93\x8a\xbd\x44\x56\xd0\x0b\x16\xb6\x5a\x58\x8b\x92\x9e\x08\xd7\x28\x0b\xd4\xab\xc0\x9e\xc1\x65\x87\x9a\x99\xc0\x4b\x2d\x23\xac\x21\x02\x8b\x5f\x2d\x75\x59\xa5\xb6\xa8\x21\x67\x06\x5d\xfb\xaa\xd6\x42\xd3\xca\xdc\xb6\xa1\xc1\x69\x13\x18\xc8\xb6\x46\x2d\x72\x30\x2d\xe7\xe2\x2b\x11\x50\x5e\x58\x55\xc1\x7d\x6b\x9d\x6d\x5c\x68\x63\x3b\x8d\xc3\x28\x30\x94\x37\x52\xe6\xde\x74\xb6\x44\x4d\xcf\xa3\x8e\x80\x9e\x72\xe3\x17\x28\xeb\x5e\x3e\x8c\xd3\x2b\xad\xe7\x0d\x3d\x7b\x10\xb9\xbd\x16\xa6\x66\xec\xeb\x38\x4c\x3e\x4a\x53\x17\xd1\x71\xd3\x1e\xe2\x16\x71\x64\x10\xe5\x54\x63\x0b\x69\xff\xa7\x96\x0e\x29\x38\x5f\x86\xe7\xbb\xc9\x56\xac\x99\x93\x5f\x73\x0d\xba\x95\xb8\x70\xff\x5d\xb5\x6e\x85\xcd\xcb\xb0\x70\xe9\x99\xb1\x19\xfc\xb2\x04\x0d\xaf\x5f\x83\xa6\xaf\xd9\x3f\xb3\x14\x66\x6f\x0e\x77\x7f\x9e\xa5\xa0\x61\xb9\x84\xd9\x69\xff\xf5\x65\x76\xee\x88\xba\x72\xd4\x23\xda\xcb\x43\x82\xbf\x0f\xc1\x70\x0a\xb3\xcb\x19\x9c\xc0\x8c\xcd\xdc\xc9\x2e\x1e\x1d\x9f\xfe\x44\xab\x5d\x1a\x86\xd5\x49\x92\x25\x27\x7e\x66\x50\x17\x44\x1a\x5d\xd4\xbe\x77\xc4\x08\x0e\x2e\x0f\x94\x8f\x5b\x1f\xb2\xbb\x0b\x90\x43\xd3\x75\x09\xbd\xd5\xc8\xef\x60\x09\xbc\xb6\xd9\x4d\xa3\x85\xb4\x7c\x9e\xbc\x32\xa7\xaf\x8a\x24\x0d\xe5\x9e\x82\x74\x26\xec\x00\x2b\x83\xd3\xe2\x1e\xd9\xf5\x7c\x34\x56\x7b\x72\x72\xd8\xc5\x4c\xe6\xa5\xd2\x26\xde\xc5\xff\x0e\x00\x4c\xa0\x70\x75\x63\x0e\x00\x00")
+
+func apidocMigrationGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocMigrationGo,
+		"apidoc/migration.go",
 	)
 }
 
-func jujugenerateapidocGoMod() (*asset, error) {
-	bytes, err := jujugenerateapidocGoModBytes()
+func apidocMigrationGo() (*asset, error) {
+	bytes, err := apidocMigrationGoBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "jujugenerateapidoc/go.mod", size: 281, mode: os.FileMode(436), modTime: time.Unix(1540908573, 0)}
+	info := bindataFileInfo{name: "apidoc/migration.go", size: 3683, mode: os.FileMode(436), modTime: time.Unix(1791999376, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _jujugenerateapidocGoSum = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\xd4\x4b\xb3\xa2\x38\x18\x06\xe0\x7d\xff\x8a\xde\x9f\x52\x12\x6e\x09\x53\xd5\x0b\x44\x54\x50\xa0\x39\x0a\x88\x3b\x08\x77\x84\x70\x13\xc4\x5f\x3f\xe5\xa9\x9a\x1a\xa7\xbb\x67\x31\x7d\x66\xc3\xf2\x7d\xbe\x97\x7c\x49\x9a\x0f\xd9\x2d\x5c\x12\x5a\x31\xc5\xad\xb8\x7d\x7c\xbe\x8e\x60\x09\x96\x60\xc1\x02\x88\x21\xe0\x00\x80\x02\x27\x08\x0b\x21\xe6\xa4\x28\xc6\x1c\xc4\x09\xfb\x35\x83\x7f\xf0\xfd\xca\xd0\xd7\xe5\x34\xae\x6f\x01\xed\x6c\x99\x20\x63\x68\x79\xd4\xa9\xa1\x35\xef\xbd\xd3\x86\x58\x5c\xd4\x61\x6a\xcc\x2a\xf8\xf6\xe5\x37\x1d\x26\xa5\xcb\x8a\x46\x4f\xce\x54\x9d\xd1\x77\xee\x96\x5c\xf1\x62\xdc\x82\xab\x2f\x25\x6c\x5d\x6e\x83\xc3\x2e\xad\x27\x49\xba\xee\xb4\xfe\x34\x64\x7d\x6e\xa5\xf4\xd7\x5c\xd0\xe4\x11\x25\x3f\xa2\x90\xe5\x21\x94\x16\x12\x8b\xb1\x24\xc1\x30\x00\x80\x7f\x6a\x5a\x81\x57\x61\x09\x1a\x99\x33\x1e\x27\xa4\x11\xbe\x6c\x8f\x43\xa3\xad\xac\xdd\x05\x5a\xc7\xbb\x10\x52\xa2\x3a\xe8\x92\xcb\x22\xfe\xa4\xf6\x52\xd1\x5b\xe5\x6d\x5c\x6d\x95\x6a\xd2\x36\xd0\x15\xbc\xb7\xba\x1e\x7c\xce\x4a\xba\x37\x5c\x5c\x20\xd3\x1f\x71\x7c\x75\x90\x73\xb6\xd1\x7f\x45\x39\x96\x5b\x20\x88\x11\x01\x58\x82\x6c\x12\x3e\x35\xc9\x65\x66\x25\x21\x72\x29\x39\x9e\x51\x41\xcf\xd7\x65\x93\x3f\xb6\xbc\x76\x2e\x7a\xe2\xaf\x7b\x29\xa2\xa3\x56\xa5\x99\xda\xa7\x9f\xd4\xfe\x87\x8a\x65\xc7\x34\x5d\x3c\x0c\xf3\x93\x83\x4b\xf0\x12\x19\xc9\x33\x77\x8d\xd0\x55\x4a\x40\x1e\xae\x4d\xcb\xb6\x76\x3b\xc3\xf7\x35\x2d\xcc\x92\x70\x77\xac\x38\x85\xbf\xf4\x54\xb7\xde\x4d\xfa\x53\xe4\x33\x0f\x2e\xe1\x12\xbe\xe4\x35\x1b\xdb\xaf\x45\xd1\xdb\x75\x56\xf3\xdd\x37\xaf\xc5\x64\x19\x2d\x85\xe0\x54\xfa\x19\x4c\x66\x8e\xf8\x39\x65\xaf\x5c\xa8\xf4\xf6\x8f\x79\x43\x7c\x1f\x7e\x1e\x90\xd7\xc3\xf1\x6d\xad\x7b\x9c\x73\x62\x0e\xb9\x35\xe9\xb1\x6f\xc7\x30\x4e\xda\xc1\xb9\x33\xb9\x9b\x05\x15\xc3\x8f\x49\x24\x77\xa6\xf6\x8f\xc0\x8e\xa6\x4d\xdc\x34\x31\x13\x34\x39\xa1\x55\x13\x0c\x2f\xff\x5a\x04\x02\x8b\x20\x86\x82\xc0\x2f\x00\x11\x60\xc2\x05\x5c\x22\x89\x1f\xcb\x1b\xd4\x85\x66\x3e\xa0\xe9\x4d\x89\x77\xd6\xd3\x6c\xc7\x91\x9b\x34\xcc\xf5\x3b\x71\xa3\xf7\xa3\xab\xd6\xfe\xbe\x52\xd5\x6a\x50\x20\xff\x79\xf0\xf5\x70\x35\xd7\x9a\x55\x96\x4b\xce\xb5\x70\x14\xb8\xfe\x74\xa8\x69\x26\x47\xb0\xdd\x8c\x54\xa1\x0f\xd3\xd9\x3a\x77\x5b\x8e\x07\xa1\x77\x7e\xed\x56\x79\xff\xc3\x3e\x41\x0c\x59\x28\x71\x68\x11\x01\x09\x0a\x22\x10\x02\x02\xc5\xa7\x25\x8c\x01\x9b\x69\x01\xb1\x57\x6d\xd8\x3e\x6e\xd4\x56\x13\x90\xf1\xef\xfa\x3a\x54\xed\xf1\x4d\x24\xae\xe3\xf7\xea\x38\x31\x29\x9e\x3e\x65\xbd\xd4\xcb\x4e\x68\x2f\xea\xec\xc1\x9c\x9c\x20\x68\x51\x37\x4c\x1b\xc1\x35\x56\x52\x69\x4b\x73\x82\x76\xa9\xbf\x31\x5d\xcb\x4a\x78\x68\x3d\xcf\x91\x5e\x83\x3a\x5d\xd2\x2e\x65\xee\xcc\x40\xe9\xb5\xff\xe9\xb9\x03\x00\x41\x71\x11\x80\x00\x72\x31\x40\x1c\x41\x1f\xd7\xd2\xa9\x85\x7c\x6f\x8c\xe9\x41\xdb\x1a\x8f\xad\x01\x2b\xdd\x1b\x73\x96\xdd\xe4\xc6\x59\x3a\xaf\x44\xc6\xb4\x1e\xfe\x9e\xce\xa2\x38\xe3\xdf\x54\x5e\x2a\xd5\xc8\x54\x6e\x11\x59\x31\xb5\xfa\xb8\xbb\xdb\xea\x10\xae\x3d\x5f\x68\x12\xef\x74\x68\x57\x44\x61\xf7\x17\xb1\x98\xfd\xd1\xe0\xab\xe7\xae\xd3\xa6\x4c\x97\x79\xcd\x90\x2c\x26\xe5\x72\x84\xcf\xbb\xf3\x97\x05\x44\x16\x43\xc4\x41\x80\x17\x08\xe3\x24\x42\x98\x07\x90\x45\x2f\x96\x42\xc5\x3c\x74\x75\xf9\x51\xcb\x81\x56\xb6\x0d\xce\x6e\xa7\xe9\xaa\xdb\xca\x05\x40\xb1\xa0\x09\x43\x42\x93\x77\x3d\xc1\x7f\x80\x17\x2b\xee\xba\x94\xfe\x6d\x7d\x0c\xfd\x86\xce\x89\x32\xcf\xe9\x6a\x13\xe2\x5e\x2d\x52\x51\x94\xd8\x7b\xa1\x88\x4e\x2f\x80\xd3\xe6\xdd\x12\xf8\xb7\xbb\xef\xa8\x53\xa1\xfe\x7b\xd0\xeb\x60\xf7\xa9\x7f\x1c\x99\xf3\x03\x2a\xbc\xe4\x90\x88\xcd\xc5\x4b\x7e\x15\x9c\x13\xfd\x0e\xd5\x6a\xee\x36\xd9\x3e\x30\xdc\x30\x85\x55\xf9\xed\xcb\x9f\x01\x00\x00\xff\xff\x88\xbe\xb8\x92\x31\x07\x00\x00")
+var _apidocNegotiateGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\x4b\x8f\xdb\x38\x12\x3e\x4b\xbf\xa2\xd6\xc0\x06\xd6\xb6\x56\xdd\xbd\x47\xf7\x7a\x81\xec\x00\x03\x24\x40\x3a\xc1\x74\x66\x2e\x86\x31\xa0\xa5\x92\xc5\xb4\x44\x7a\x48\x5a\x8e\x27\xe9\xff\x3e\x28\xb2\x28\xc9\x8f\x64\x92\x4b\x5a\x64\xbd\xf8\xd5\xe3\x2b\xef\x44\xf9\x2c\xb6\x08\x62\x27\x2b\x5d\xa6\xa9\xec\x76\xda\x38\x98\xa7\xc9\x0c\x55\xa9\x2b\xa9\xb6\xb7\x9f\xac\x56\xb3\x34\x99\x59\x6d\xdc\x2c\x4d\x93\xd9\x56\xef\x9e\xb7\x85\x54\xb7\x68\xcc\x56\x17\xfd\x7f\x6e\xeb\xce\xd1\x87\x36\x76\x96\x66\x69\x7a\x7b\x0b\x3f\x8b\x52\x54\xf8\x88\x5b\xed\xa4\x70\x52\x2b\x68\x74\x5b\x59\x70\x0d\x82\xde\xbb\x52\x77\x08\xba\x86\xda\x8b\x41\x8f\xc6\x4a\xad\x48\x51\x4d\x54\x36\xe8\x0e\x88\x0a\x04\x58\x34\x3d\x1a\xb0\xfb\x1d\xc5\x27\xd5\xd6\x1b\x62\x35\x4b\x96\x04\x29\xb3\x39\xa9\xfc\x75\xa5\xcb\x7d\x87\xca\x81\x50\x15\x94\xad\x44\xe5\xec\xd4\x44\x2f\x8c\xd4\x7b\x4b\x8a\x46\xa8\x2d\x7a\x3b\xd1\x66\x91\xba\xe3\x0e\xaf\xbc\xc3\x3a\xb3\x2f\x1d\x7c\x49\x93\x70\x07\xd6\x19\xa9\xb6\x69\x72\x7b\x0b\x4f\x3e\xcc\xdf\x62\x58\xe3\x93\xa7\x91\xba\x06\xd3\x64\x0c\xd6\x35\xc2\xf9\x70\x4f\xdf\x68\x73\x90\x0a\xb4\xa9\xd0\x14\x69\x72\x66\x78\xb5\x96\xca\xa5\xc9\xfb\x00\xa4\x85\xf0\x6f\xb5\x9e\xc4\xc9\x77\xe9\x8b\x4f\xc7\xe5\x05\x18\x2c\xb5\x39\x0d\x0f\xca\x46\x5b\x54\xb0\x39\x92\x8e\x60\xcc\x42\x84\x31\x2c\x10\x6d\x7b\xfa\xa4\xda\xe8\x0e\x7e\xf2\xa2\xef\xa4\xcf\xa1\xd3\xf1\x5b\x7c\x06\xa9\xca\x76\x6f\x65\x8f\x05\xfc\x1f\xad\x03\x69\xe1\x4f\x34\x1a\xa4\x47\xc2\x20\x48\x9f\x01\xa5\x87\x28\xa4\x82\x52\x77\x9d\x56\x9c\x83\x2b\xc1\x8f\x49\x18\x1c\x83\x87\x64\xea\xd7\xa5\x89\xf7\x48\xd8\xf8\xdb\x80\x05\x9d\x31\x92\x60\xd0\xed\x8d\x0a\x20\x70\x3e\x62\x14\xfe\xd5\x11\x03\xd2\x3b\x2b\x3e\x06\x87\xc5\x2d\x1c\x64\xdb\xc2\xde\x22\x1c\xa4\x6b\x86\x92\xbd\xa2\xc8\x79\x8e\x8a\x05\xbc\xb6\xb1\x62\xdf\xee\x3f\xed\xe1\xf5\x87\x37\xec\x35\x27\x6d\xd7\x48\x4b\xa0\xd1\xbd\xc2\x03\xda\xc1\x67\x34\x8c\x15\x6c\x8e\xb0\xd1\xae\xc9\x41\x1b\x8f\x2e\x29\x4e\x00\x06\xa5\x15\x16\x69\xbd\x57\xe5\xf4\xf9\x73\x76\x13\xdb\xcb\x97\x55\x46\x50\x11\xb2\x1b\x72\xb5\x58\xc2\x5d\x9a\xd4\xda\xc0\xef\x39\xf4\xb0\x58\x86\x56\x89\x1a\x5f\xd2\x24\x91\x35\xf4\xf0\xdf\x25\x78\x79\x3a\x48\x4a\xad\x9c\x54\x7b\x4c\x93\xe4\x25\x4d\xa2\x76\x39\x51\x67\xf0\xbc\xb4\xac\xa1\xec\x61\xb9\x84\x9e\xbc\x26\x49\xe2\x0d\x2d\xa1\x0f\x1f\x06\xc5\x33\xfd\x45\x96\x5e\x52\x32\x18\x92\xe6\xfd\x71\x79\x87\x4e\xe4\x57\xd9\x93\xac\x46\x98\xa9\xf3\x50\x94\x0d\xa7\xd9\x03\xa4\x40\xaa\x5a\x4f\x1b\xcd\x23\x34\xa7\x53\xf8\xd7\x1b\x55\xeb\xec\xcc\xf4\x3c\x83\x4e\xec\x56\xa1\xe5\xd7\x1e\x2f\x0a\x7a\x70\xb2\x58\x42\x27\x9e\x71\x7e\x2e\x94\x0d\x18\xd6\x23\x08\xe4\xa6\x08\xf6\x2d\x59\x49\x7a\x4b\x97\xd1\xd8\xaa\x2e\x1e\x45\x87\xeb\x00\xb1\xa4\x2b\x1a\xc1\xc5\x13\x0a\x53\x36\x6f\x94\xb3\xf3\xde\xe6\x50\x17\x1c\x5c\xf6\x00\x92\x60\x6c\x51\xcd\x7b\x9b\xc1\xd7\xaf\xd0\xdb\x95\x5c\xc3\x3f\x96\xa3\x90\xf7\x43\x8e\x96\x20\x76\x3b\x54\x95\xb7\x71\x97\xd1\x69\xa9\x77\xc7\x39\xa9\xdc\xdc\x2f\xd6\xb9\x57\x5e\xac\xfd\x0d\xfd\xb9\x86\x89\x19\xce\xec\x79\xa8\xb0\x84\xde\x4e\x73\x14\x05\x2e\xc7\xd0\x47\xb1\x69\xf1\x24\x53\xd3\xc9\xcf\x14\x61\x81\x50\xc3\x1e\xcd\xf1\x74\xbc\x13\x74\x39\xd8\xa1\xf8\xf9\x46\x89\x0e\x0b\xf8\x38\x76\xa7\x07\xda\xcf\x97\x52\xf7\x68\xb0\x02\x61\x90\x47\x98\xb6\x38\x30\x0c\xc7\x09\xf7\x9e\x2b\xb4\x42\xe8\xb4\xf1\xb3\xd9\x37\x26\x19\xe0\xde\xe3\xc2\x67\x05\x8a\x81\x04\xc0\xf9\xe7\xf8\x59\x47\xd9\x8c\x6c\xa3\xdb\x0a\x0d\x69\x93\x59\xb2\x60\x06\x9b\xb1\x85\x84\x85\x03\xb6\x2d\x08\xcb\x31\xf9\xc1\x43\xd1\xb6\x62\x07\xd2\x5d\x2b\xca\x73\x1c\xe7\x19\xac\xd6\x97\x54\x75\x56\x99\x93\x7a\xe3\x34\xda\x79\x96\x26\x04\xda\x58\xb9\xab\x75\xa8\xdb\x1c\xee\xf2\x50\x4a\x2c\x9a\x71\x0d\x93\xf8\x58\xc3\x83\x7d\xaa\x2b\xba\x9a\x54\x96\xff\xcc\x81\xfe\xcb\x7c\x51\x50\xbe\x8a\x27\x6f\xde\xce\xe9\xd8\x66\x69\x12\x90\x1b\xdd\x5f\x3c\x63\x88\x24\x68\x8c\xad\x74\x1a\x09\x7d\x85\x36\x62\x60\xa7\xad\xa4\xb8\x91\x14\xc9\x5f\x78\x20\x25\x66\xf3\x05\x73\x29\xf8\xb0\x73\xba\x38\xe5\xde\x05\xe7\x2d\xe7\x16\xe0\xb2\xa0\xe6\xf4\xe7\x2b\xc2\x2c\x88\x64\xff\xbe\x5f\xf3\xf8\x6b\x35\x39\xbe\x7f\x80\x56\xd3\xa8\x0c\x4a\x37\xfe\xfb\xe6\x86\x7b\x52\x98\x58\xb5\x4c\xef\x41\xb5\xf1\x9d\xdf\xea\x07\x68\xe4\xa9\x6e\x23\xa3\x6e\xc2\x8a\x03\xf4\x71\xb0\x37\xd2\xf7\x6f\xa2\x0a\x66\xce\x69\x7a\x86\xb3\x7c\x5a\x50\x7c\xe8\x63\x4a\x46\x7a\x5d\x40\xab\xf3\x93\x33\xf1\x79\x01\x8d\xe4\x33\x62\x15\xc6\xee\xdb\x04\x93\x05\xe1\x97\x6c\x32\xcf\x39\xff\x43\x54\xfe\x33\x07\x95\x4d\xc7\x88\x3f\xe4\x19\xf2\x41\x18\x8b\x21\xac\x38\x3b\x77\x74\x64\x41\x40\x27\x94\xac\x89\x3d\x74\x7d\x85\xd2\xed\x84\x8c\xc3\xd8\x88\xf4\x1e\x66\xc6\xa0\x2d\xc9\xd6\xdb\xa7\xf7\x8f\xa0\x37\x9f\xb0\x74\x34\xf3\x77\xb4\xe3\x8d\x33\x88\xaa\xc3\x82\xd3\x3f\x44\xe3\x9e\x78\xc8\x39\x2a\x67\x8e\xd0\x89\x23\x6c\x90\x56\x04\xa9\xb6\xed\x10\x1e\x71\xb7\x80\x56\x86\xf0\x07\xbb\xa1\xfd\x2f\x5f\x3d\xaf\x84\x13\xb0\x5a\x6f\x8e\x0e\x33\xb8\x60\x9c\x1c\xfc\x42\x9e\x51\x81\xf4\xc2\x80\x11\x87\x29\x75\xd1\x52\x5f\xfc\x22\x0e\xef\xd0\x5a\xb1\xc5\x94\x28\x06\x8d\xa1\x52\xf3\x57\xbf\xaa\x4e\x18\xdb\x88\xd6\xbb\xc9\xe1\x95\x11\x87\xec\x81\x6c\x12\x9d\x28\xd9\x92\xdd\x98\x1e\x25\x5b\x76\x67\x8b\x47\xed\xb0\x9e\xa3\x31\x79\x38\x9e\x95\x42\x29\xed\x42\x8e\x18\x10\x06\xd1\xce\x42\x92\xf9\x6b\x98\x01\xe7\x4f\x99\x0c\x9e\x93\x1d\x84\x9e\x44\x51\xd0\xf3\x22\x86\xa1\x71\xbe\xf9\x98\x3e\x87\x57\x2c\xca\xaf\x59\x8e\xaf\x89\x81\xac\x14\x73\x99\x07\xf2\x0b\xcb\xbf\x5c\xd9\x6c\x26\x9e\x87\xa5\xfc\x87\x9c\xdb\x4b\x2c\xaf\x82\x89\x87\x7a\x3e\x93\xaa\x17\xad\xac\x46\x4f\x34\x16\xb8\x0e\xff\xf9\xc7\x02\x0e\x82\x7e\xe3\x7c\xbf\x8c\x66\xc3\x1c\x26\xc4\x2f\x5e\x1a\xc5\xa6\x4d\xc7\x32\x3e\x8f\x67\xfc\x7d\x4a\xdc\xac\x7c\xb6\x61\xfd\xed\x02\xbd\x95\x3d\x46\x2f\x3f\xb2\x46\x57\x68\x4b\x23\x37\xa1\x73\x3d\x97\xf1\x24\x27\x25\xd7\x5c\xfd\x05\x41\x7a\xc4\xf8\xba\x93\xce\x61\xf5\x3d\x2e\x45\x1e\x56\x17\x0b\xde\xc9\xca\xc7\x0b\x1f\xff\x50\xba\x52\xb1\xe7\xf5\x3a\x92\xd1\xc5\xd2\x17\x29\x65\x9e\xc5\x3d\x3a\xae\xdc\x97\x23\x34\x64\x6a\x18\xa4\x0f\x7e\xfb\x85\xff\xc1\x9d\x57\xe5\x78\x86\x74\xd2\xe5\xf9\xba\x5c\x36\xda\xa2\x4a\x5f\xd2\xbf\x06\x00\x38\xa8\x86\xd0\xf4\x0f\x00\x00")
 
-func jujugenerateapidocGoSumBytes() ([]byte, error) {
+func apidocNegotiateGoBytes() ([]byte, error) {
 	return bindataRead(
-		_jujugenerateapidocGoSum,
-		"jujugenerateapidoc/go.sum",
+		_apidocNegotiateGo,
+		"apidoc/negotiate.go",
 	)
 }
 
-func jujugenerateapidocGoSum() (*asset, error) {
-	bytes, err := jujugenerateapidocGoSumBytes()
+func apidocNegotiateGo() (*asset, error) {
+	bytes, err := apidocNegotiateGoBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "jujugenerateapidoc/go.sum", size: 1841, mode: os.FileMode(436), modTime: time.Unix(1540908577, 0)}
+	info := bindataFileInfo{name: "apidoc/negotiate.go", size: 4084, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _jujugenerateapidocProgGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x5a\xff\x8f\xdb\xb6\x92\xff\xd9\xfa\x2b\x58\x1d\xdc\x4a\x81\x23\xa5\x77\xc0\x1d\xe0\xab\x0b\xe4\x92\xa6\x2f\xb8\xa4\x35\xba\xdb\xf7\x70\x08\x82\x3e\xae\x34\x92\x19\x4b\xa4\x4a\xd2\xfb\xe5\xf2\xf6\x7f\x3f\xcc\xf0\x8b\x24\xdb\x9b\xdd\xeb\x6b\x80\xac\x2d\x72\x38\x5f\x3f\x33\x1c\x52\x2e\x4b\x76\xb9\x03\xd6\x82\x04\xcd\x2d\xf0\x41\xd4\xaa\x62\x83\x56\xad\xe6\x3d\x13\x86\x5d\x1d\x64\xdd\x41\xcd\xb8\x61\x5c\x32\x6e\x0c\x58\x26\xa4\x55\xec\xd3\xe1\xd3\xc1\x91\x27\x65\xc9\x8c\x62\x76\xc7\x2d\xbb\x01\x56\x2b\xf9\x8d\x65\x12\xa0\x66\x56\x31\x0d\x3d\xf4\x57\xa0\xf1\x7b\xa5\xfa\x41\x74\xe0\x28\xbd\x0c\x5c\x2c\x24\x53\xba\x76\x34\x41\x13\x66\x77\xc8\xaa\x32\x45\x32\xf0\x6a\xcf\x5b\x60\x3d\x17\x32\x21\x61\x00\xac\x15\x76\x77\xb8\x2a\x2a\xd5\x97\xa8\x09\xfd\x61\x2f\xfe\xe3\xdf\x9f\xf3\x41\x18\xd0\xd7\xa0\x9f\x37\xbc\xe2\x35\x3c\xef\x84\xb1\xcf\x6b\xb0\x5c\x74\x26\x49\x44\x3f\x28\x6d\x59\x96\x2c\x52\x90\x95\xaa\x85\x6c\xcb\x4f\x46\xc9\x34\x59\xa4\x4d\x6f\xf1\xa3\x55\x25\x37\xe1\xdb\xc0\xb5\x01\xed\x1f\xac\xda\x83\x0c\xdf\xef\x06\x30\xf8\x7d\x67\xfb\xae\xb4\xd0\x0f\x1d\xb7\x80\x03\x9d\x6a\xf1\x43\xd1\xac\x86\xa6\x83\xca\xa6\x49\xb2\x70\xbe\x36\xc0\x6a\x18\x40\xd6\x20\x2b\x01\x86\x99\x9d\x3a\x74\x35\x93\xca\xb2\x2b\x60\xc3\x01\xdd\x8b\xc6\x13\x7d\xab\x8a\x5e\xd5\xac\x11\x1d\xac\x30\x04\x76\x07\x77\x61\x45\xa5\x7a\x60\x8d\x56\x7d\xa4\x36\x80\xa2\xa0\xa6\xd8\xb0\x6b\xd0\x46\x28\x59\xa0\xba\x47\xce\x02\xad\x95\x26\xf5\xce\xb9\xb1\x8c\x2e\x7c\x9c\xa2\xac\x54\xdf\x3b\xef\x3d\x42\xe8\xa2\xf1\x20\xe1\x00\xba\x17\x06\x15\x7e\x90\x44\x0f\x15\xfe\x8f\x1e\x7d\x80\xcc\x58\x1f\x87\x56\x0d\xfb\xb6\x10\xd2\x0d\x4b\xde\x83\x29\xae\xff\x15\x23\x71\x76\xa1\x03\x73\xe9\x3e\x8e\xb8\x6b\xd5\x0e\x30\x0c\x80\xb3\x88\x62\x6e\x09\x34\x11\x03\xad\xea\xb8\x6c\x0b\xa5\xdb\xf2\xb6\xb4\x4a\x75\xa6\x24\xec\x10\x70\xcd\x4c\x19\xd0\xba\x55\xc5\xf5\xb7\x69\x92\x27\x49\x73\x90\x15\xe1\x3a\xcb\xd9\xe7\x64\x21\x64\xa3\x56\x0c\xb4\x66\xeb\x4d\xcc\x84\xb7\xb2\x51\x59\x9e\x2c\x44\x43\x33\x5f\x6d\x98\x14\x1d\x52\x2f\x3a\xd5\x16\x6f\xb8\xe5\x5d\x06\x5a\xe7\xc9\xe2\x3e\x59\xd4\xdc\xf2\xc8\x01\x55\x2c\xde\x73\x6d\x76\xbc\xcb\x90\xf7\x53\xb9\x28\x53\x5c\xd8\x5a\x1d\x6c\xf1\x37\x2d\x2c\x64\xc8\xd5\xad\xed\x40\x66\x03\x97\xa2\xda\x43\x9d\xb3\xef\xd9\x8b\xc8\x62\xab\x85\xb4\x4d\x96\x2e\xeb\x72\x59\x33\x17\x6d\xc3\x02\x2d\xbb\xd9\x81\x64\x56\xdf\x09\xd9\x62\x9a\xd7\x60\x31\xe0\x12\x18\xaf\x2a\x30\x86\x65\x76\x27\x0c\x16\x1c\xa9\x74\xcf\xbb\x3c\x5d\xcd\x65\xb9\x47\xde\x75\x6f\x88\xf3\x4f\x18\xcd\x9c\xb4\xbd\xf7\x6e\x9c\xfb\x8b\x65\xcf\x5c\x24\x8b\xb7\xc1\xa9\x4a\x93\x93\xab\xa6\x45\xe7\x84\xe0\x14\xaf\x94\x6c\x44\x8b\x66\xbc\x57\x35\xac\xc7\x89\x77\x8a\xd7\x2f\xbb\xee\xe2\x4e\x5a\x7e\xbb\x4a\x16\x8b\x2d\xd6\x82\x37\xa2\x83\x35\x43\x89\x59\x83\xc5\xf0\x19\x15\x85\x02\x87\x2f\xc0\xae\x28\x57\x11\x6b\xcc\x58\x2d\x64\xbb\x62\x46\x57\xec\xc3\xc7\xab\x3b\x0b\xa4\x94\xb1\x44\x3b\xd5\x68\xb1\xd0\x60\x0f\x5a\x32\x57\x6c\x8a\x28\x87\x24\x8c\x2c\x89\xd7\x6a\x46\xf5\x4a\xf5\x3d\x48\x6b\xf2\x64\xb1\xb8\x5f\x51\xf0\x5c\xbe\x6d\xf7\x64\xe5\xe3\x09\x3e\xec\x5b\x13\x11\x33\xb3\x3d\xfb\xba\x6a\x50\xff\xc0\xef\x2c\x7a\xbc\xe6\x52\x74\xc4\xa4\x55\xc5\x4f\xca\x42\x83\x58\x5a\xb1\xb4\xe2\x12\x0b\x5b\xa7\x78\xcd\x96\xbf\xa7\x73\x66\xf7\x23\xa2\xf6\xad\xc9\x91\xeb\xb7\x0f\xf1\x84\x9b\x26\x4b\x67\xda\x31\x47\x05\x35\x5b\xd6\x51\xef\x15\xd5\xd1\x6f\x03\x78\x90\xad\x13\x34\x38\x77\xe0\xc8\x87\x17\x1f\x13\x97\x6a\x21\x47\x28\x8d\x51\x46\x48\xb5\xda\xe0\x54\xf4\x52\xf1\x32\xc0\xce\x64\x79\xf1\x4e\x18\xfb\xda\x6d\x27\x9e\x16\x49\xb1\xa2\x67\xb5\x59\x4d\x57\xd5\xbd\x90\x6e\x5d\xa4\x2f\x8a\x22\x4f\x16\x8d\xd2\xec\xb7\x15\xab\x51\x8a\xe6\xb2\x05\x56\x1b\xb2\xdc\xd2\x48\xac\x71\xc5\xcf\x57\x9f\x2e\xef\x06\xf8\xb9\xc9\xea\x02\xbf\xe4\x49\xb2\x08\xab\x09\x64\x91\x81\x2d\xde\x83\xdd\xa9\x9a\x12\x23\xf3\xb0\xea\x57\xec\x37\x24\x09\x93\x19\xae\x41\xa8\xa0\xe3\x7b\x44\x10\xef\xcd\x34\x9a\x0b\xf2\x0b\x89\x22\x5f\x04\x1a\x5a\x73\x1f\x17\xfe\x02\xe6\xd0\xd9\x2f\x2f\x74\x34\x71\xe1\x3d\x85\x81\x0f\xe2\xad\x77\xfc\xd7\x93\xf4\x44\x0e\x61\xe9\x9a\x51\x15\x24\xf2\x07\xfd\xd4\xf8\xf8\x20\x03\xe7\xe1\xc0\x66\x81\x0e\x58\x33\xff\xaf\x2e\xf0\x11\x73\x77\xf1\x57\xb7\x1d\xae\xfd\xb8\x7f\xa4\xa9\x97\xd7\x5c\x74\xfc\xaa\x83\x4b\xb5\x66\x7c\x7c\xc8\xfc\x72\x56\xa3\x10\xab\xf4\x5d\xbe\x72\xa6\x2c\x06\x3b\xe6\x8c\x56\x2d\x2a\x8f\x70\x43\x52\x17\xa7\xc5\x99\x5c\x79\x5a\xb2\xb4\xe0\x9a\x23\x86\xb0\x64\xe8\x82\xe5\x75\x3a\x65\x8c\xf2\x6d\xad\xaa\xa8\x01\x12\xbe\x56\x95\xaf\x05\x4e\x8f\xc1\xfe\xb3\x3a\x60\x23\x58\x39\x96\x5e\x8b\xf5\x39\x4d\x9a\xe2\xb5\xaa\xd8\x86\xa1\x46\x4f\x42\xf0\x9f\x03\xe0\xa6\x9f\x40\xc0\x4d\x3a\x1c\xba\xf8\xcb\x10\xf6\xfb\x2f\xa2\xbd\xf1\xc3\x6c\x43\xa8\x2b\x7e\x81\xe6\xff\x83\xf9\x26\x0e\xcf\xd6\x1f\x41\x7f\xd1\x4f\x83\xd5\x93\xae\xa7\xe1\x72\xfe\x08\xc9\x79\x1c\xb5\x7f\x26\x6c\xc5\x51\xe4\x26\x92\xee\x9d\x2b\x7d\x08\x7b\x17\xc2\x45\xe3\x1d\x3a\xa9\x6c\x71\x68\xc5\x9a\x3e\x84\xde\x67\xb3\xcf\xc0\x09\xf5\xd1\xc4\x8a\x35\xae\x0a\x7b\x23\xfc\xf4\x0a\xad\xc1\xed\xfb\x9a\x6b\x66\xfb\xa1\x7b\x73\x90\x15\x72\x09\xcd\x74\x81\x03\xef\xf9\xf0\x39\x59\xa4\x88\xf1\x77\x42\xee\x53\xbf\xf7\x5a\xf6\x6c\x2c\xdd\x04\xac\x71\xd9\x5f\x2e\xdf\xbf\x23\xb7\x89\x86\x59\xb6\x39\x05\x7f\x2a\x4b\x9e\x7a\x23\x3a\x21\xf7\x18\x97\xa6\xb7\xc5\xc5\xe0\x9a\x98\xbf\x7f\xc7\xd9\x4e\x43\xb3\x49\x77\xd6\x0e\x66\x5d\x96\xad\x42\x9c\x61\x7f\xb7\x34\xe9\xf7\x4b\xf3\x5d\xc9\xbf\xff\xfb\x8a\x59\x5f\x20\xdc\x27\xfd\xc9\x70\xc7\x09\x82\x66\x2a\x65\x28\x0a\x1d\xb1\x8a\x3d\xcb\x39\x30\xb0\x67\x71\x9f\xdb\xba\x2f\x2b\x66\x29\x57\x9e\x8d\xd6\x3a\xb1\x7d\xcc\x18\xdf\x6d\xe4\x2c\x0b\x6d\xc7\xd8\x5e\x50\x4a\x12\x07\x5a\xea\x7b\xc9\xaf\x1c\xb3\xb7\xe6\xad\xb4\xa0\x1b\x5e\x41\x66\x5d\xd6\x95\x25\xfb\xd5\xb8\x03\xd8\xa0\x04\xce\xba\x52\x44\xa7\x33\x8b\xc7\x90\x9e\xcb\x3b\x2f\xdc\xe0\xf3\xa0\x8c\x11\x57\x1d\x14\x54\x00\x5c\x41\xa2\x0d\x75\xeb\xd6\x67\x96\xe2\x9f\x2c\x7a\xec\x98\xd6\x13\x02\x87\xaa\x0b\xb0\x44\x62\xa0\xa3\x14\x31\x60\x8b\x77\x4a\xed\x0f\x43\x46\x80\x1f\xed\x74\xba\x23\xdd\xe6\xa4\x07\x49\xd3\x79\xbb\xe0\x93\xa2\x11\xb2\xf6\x1c\xd8\xf2\x9a\x29\xe9\x72\x61\xe4\xb9\x62\xd6\xb7\xbd\x57\x9f\x50\xbc\x81\x0e\xcb\x16\x6d\xee\x50\x75\x31\x73\x91\xd1\x6b\xa8\x3a\x97\xb1\xea\xea\x53\xb1\x55\x86\xc2\xfd\x60\x5b\x34\xaa\xf4\x9e\x9b\xfd\xd8\x60\x9b\x1b\x61\xab\x1d\x43\xf6\xc8\x19\x3f\x8b\xcc\x12\x8a\xb1\x43\xe5\x06\x18\x75\x8a\x3f\x82\x44\x89\x6b\x87\x65\x22\xbb\x54\x7b\x14\xe4\xba\xce\xcb\xff\xd9\xfe\x30\x47\xf6\x91\x0f\x1a\x75\x90\x78\xb8\x94\xcf\x29\x84\x24\x70\xf9\x2f\x54\x0b\xa0\xea\x62\x0d\x77\x05\xd9\x0c\x50\x4d\xb6\x5a\x94\x76\x31\x40\xe5\xb6\xdc\x85\x0d\xd3\xf8\x59\xb8\x4e\x16\xf1\x84\x24\xae\x6c\xb9\xd0\xd2\x34\x4e\x78\x9a\x88\xaf\x50\xfe\x83\xb8\x7e\x94\x25\x42\x81\x37\xd4\x5e\x85\xf2\xea\xe8\xc4\x64\xff\xef\x29\xc7\xbc\x46\xe4\x14\x51\xbb\x30\x20\x20\x62\x4c\xc2\x7c\x70\x0b\x55\xb8\xe2\x12\x6e\x6d\x96\xbb\xb2\x43\xb3\x54\xfe\xdc\x5f\xdf\xa3\x3c\xe4\x47\x8f\x9f\x1a\x1a\x21\x85\x15\x4a\x52\xa3\xe9\xbc\x8b\xe7\xf5\xbb\x01\xd2\x7c\x1a\x39\x2c\x5d\xc7\xa1\xa3\x1a\xe1\xf4\xfb\xea\x44\xd9\x3f\x20\x38\xe3\x16\x83\x89\x67\x25\x3c\x5c\xbe\xc1\xb4\xd9\x2a\x43\x64\xd9\x88\xcf\x7c\x6e\x1a\xa9\x72\xe2\x8e\x1a\x1a\x7e\xe8\xec\xfa\x61\x17\x1c\x24\xdc\x0e\xee\x7e\x01\x59\x70\xcd\x49\x9d\xe5\xa5\xd3\x66\x84\xd4\x78\x24\x3b\x6d\x4c\xce\x16\xb7\xe3\xc2\x76\xb6\x8a\x7d\x21\x15\xed\x1f\x4f\x44\xeb\xd8\xaa\xfd\x98\x86\xd3\xc4\xf3\xa5\x52\xed\xd9\x3f\xfe\xc1\xec\x17\xf2\xef\x8f\xa6\xdf\xd8\xe4\xce\x93\xcf\x1e\x65\xdf\x63\xc9\x87\x3b\x1d\x4d\x4d\x30\xb6\xd9\x04\xcf\x38\x84\x45\x1a\xdc\xed\xcf\x35\x17\x71\xf6\x38\x53\xee\x27\x00\xb5\xe7\xf1\xe3\x1b\xfc\x27\x94\xe2\xe8\x09\x8f\xa0\x34\x47\xbc\x94\x65\x0c\xaa\x3f\xd9\xd1\x6d\x17\xb3\x6a\x60\x1d\x5c\x43\x37\x03\x1d\xdd\x20\x56\x4a\x5a\x2e\x1c\x1d\xae\x6f\xc5\x35\x48\xdc\x8b\x28\x01\x0a\x07\xc1\x29\x52\xce\x81\x6f\x50\xc6\xc7\x72\xab\x4c\xce\x32\x74\xec\xeb\x00\xb5\xb0\x81\xaa\x3d\x9e\xc6\xfd\x01\xd2\xe5\x19\x1d\xcf\x07\x65\x1c\x42\x02\xc5\xe9\xa6\x74\x72\x88\x95\x8a\xce\xf3\x3e\x85\x31\xf8\xea\xea\x13\x54\x36\xf5\x68\x08\xd7\x07\xeb\x4d\xe0\xea\xdb\x8a\x64\x81\x7d\x92\x5b\x16\xb4\x4c\x16\xd1\xa2\xbf\x0a\x23\x6c\xf6\xe1\xe3\x89\x8d\x9f\x87\x7d\x7b\xbf\x72\x5d\xd3\x59\x27\xe4\xec\x4a\x29\xa7\xb4\xc7\x62\x33\x02\x11\x0d\x76\x37\x20\x23\x88\x1e\x72\x47\xe3\xf3\xf0\x3f\x8f\xfd\x81\xc9\x33\xb3\x05\xe1\x17\x2d\x75\x18\xc4\x68\x0a\x79\x80\x88\xb7\xb2\x64\x7f\x83\x6f\xae\x83\xa7\x10\x0c\xe4\xb8\x1b\xf8\x46\x03\xeb\x94\xda\x0b\xd9\xa2\x03\x0b\xf6\x93\xba\x61\x56\xf3\x6b\xd0\x06\x18\xef\x3a\xbf\xfc\x2c\x76\xcc\x74\x29\x71\xd5\xa2\xdd\x59\xf2\x0f\x61\x6b\x42\x5b\x4c\x76\xab\xb0\x57\x3b\xb7\x34\xe4\xfe\xb0\x0f\x85\x02\xef\x92\xed\xbb\x0d\xa1\xea\xeb\xaf\xe9\xe3\x3b\x5f\x57\x7e\x90\xf5\xb8\x2f\x39\x93\xdc\x4c\x32\xdd\xa8\x1a\xde\x19\x78\x70\x57\xb2\x1a\xfd\x73\x4f\x29\xe7\x80\xe7\x19\x3d\x0e\xbb\x69\xf6\xc4\x1d\x24\x9d\xb5\xe5\x34\x14\x9b\xf2\xb2\x8c\x27\xdb\x59\x42\x86\xab\xef\xf1\x78\x4a\xa3\x94\x7b\xe1\xd0\x47\xa7\x0c\xe4\x70\xb3\x13\xd5\x8e\xf5\x07\x63\x99\x86\x41\x83\xc1\x53\x09\xa7\xf3\x87\x2b\x8b\x83\x06\xa7\x19\xd4\xec\x47\x45\x3c\x7d\xe2\x4e\x8f\xd5\xe7\x77\x8d\xa9\xb4\x9c\x65\x27\xdd\xf1\x98\xc0\x08\xda\xe2\xbf\x05\x45\x60\xb3\x89\x0b\xb7\x56\xfb\x5b\x17\xac\x94\x3f\x74\xd0\x67\x7e\x57\xf0\x3c\xdc\xd1\x33\xa4\x1f\x72\x09\x13\x9b\x0d\x4b\xd3\x2f\x3a\x9c\xdc\xb3\xf4\x57\x9a\xd6\x99\x9c\xc6\x56\x73\xd8\xb7\x5b\x6e\x77\x4e\xc0\xd6\x3d\x78\x19\x61\x6a\x14\x51\x96\xec\xf2\xe7\xd7\x3f\xb3\x8a\xee\xfd\x03\x16\xc8\xda\xff\xe2\x46\xb8\x7d\x96\xed\x40\x03\x13\x0d\xbb\x01\x7a\xf9\x42\xaf\x5f\x8a\x27\x28\x88\xda\xc5\x18\x08\x19\xee\xd2\x46\x5d\xc7\xb2\x73\x12\x86\x3f\xbb\xfe\x38\xf3\x83\x43\xd0\x05\xc1\x1b\x9f\x93\x49\xda\x0c\xfb\x36\x39\xcd\x99\x3f\x27\x51\xa6\x5b\xd5\xf2\x77\x74\x88\x7b\x65\x04\x35\xab\x54\x0d\xae\xd7\x42\x95\xfc\x91\xc6\x1f\x1a\x88\x3f\x81\xcf\x14\x17\x95\xc2\x63\x56\x38\xc2\x04\xd0\x38\x45\x90\xfe\x09\x6a\x4c\xa3\x13\xdb\xcc\xa5\xc1\xa8\x44\x80\x4f\x15\x41\x3d\x46\xe8\xbb\x7e\x06\x5b\xc0\xe3\xb4\x18\x3b\x9a\x2f\x49\x1f\x71\xcb\x5d\x6f\x3b\x8a\x9d\x95\x8c\x99\x50\x5f\x38\x28\x7b\xa7\xb7\x68\x4d\xbc\xb1\x8f\x77\xe2\x8d\xbb\x4e\xf3\xaf\x09\xe2\xed\x1a\xfb\xf0\xd1\x51\xa0\x72\x08\x3b\x1e\x47\x5c\x9f\x24\x56\x6c\x2f\x64\x7d\x61\xf5\x58\x8a\x71\xc0\x04\xf4\x08\x13\x2f\xf3\x26\x72\xa3\xc0\x15\x03\x69\x85\xbd\xa3\x5a\x20\x72\x5f\x8f\xf9\xe4\xe6\x22\x0a\xc8\x8f\x7b\x1a\x1e\xae\x2a\xb2\x64\x31\x7f\x0f\xc1\x36\xac\xe7\x7b\xc8\x7a\x3e\x7c\x70\xda\x7e\x44\x44\xe7\x98\x1d\xfe\xf5\x87\xfb\xf7\x10\x5d\x78\x13\x74\x5e\xfb\x47\xbc\xe6\x34\x9e\x18\x96\xb3\x4c\xed\x29\xa7\x42\xf1\x0b\x0b\x27\xb8\x2b\x4b\x46\xf7\xd3\x9e\x19\x53\xb2\xbb\x2b\x4e\x12\x88\x56\x13\xfb\xcd\x86\x3e\x5f\x29\x69\xb5\xea\x3a\xd0\xbf\x1a\xd0\xb8\xc7\x7d\x35\x5e\x78\xbf\x35\xe3\xb4\x73\xcf\xc4\x8a\x7c\x0a\x38\x9f\xb2\xa7\xfc\xdf\xab\x1a\xba\xb3\xac\x69\xe6\xa9\x5c\xe7\xf1\xf9\x30\xd2\x7f\xc4\x52\x4f\xb6\xd5\xd0\x80\x76\x55\xc9\x31\xf1\x47\x0b\x0d\x95\xba\x06\x9d\x4d\xae\x4f\x4f\x6f\x90\x7c\xb5\x29\xcb\xe9\x9b\x2f\x0a\x36\x53\xd1\xa5\xcb\xdf\x57\x4c\xab\x0e\xd8\xf2\x9a\x65\xcb\xeb\x7c\xed\xf2\x77\x0a\x4b\x34\x99\x32\x0f\xfb\x8b\xab\x43\x5b\xbc\xe2\xe8\x3c\x93\xbd\x58\xb1\x7f\x7b\x41\x27\xb7\x00\xa1\xb3\x46\x2c\xd4\x3e\x7e\xbf\x47\x95\x2b\x7b\x8b\x46\x60\x33\x05\xb7\x16\x35\xe6\x07\xbb\x5b\x33\xfc\xab\xb4\xf8\x5f\xd0\x64\x05\xca\x5d\x3b\xe9\xe3\x8b\xa3\xdf\xc6\xe3\x95\xc3\x4b\x56\xd9\xdb\x3c\xe2\xdf\xbd\x34\x2e\x5e\xf1\x83\x01\x3a\x40\x61\x13\xe7\x5e\xff\x16\x3f\x68\xbd\x05\xdd\x63\x86\x50\xe1\x1a\xc1\xc8\x84\xb4\x49\x52\x29\x69\xe8\xa5\xfb\x1c\x43\xef\x79\xb5\x13\x12\xd8\x66\x96\x96\x8a\x5e\x36\x12\x1a\xdc\xfc\xcb\x16\xa4\x75\x23\xbf\x4a\x61\x27\x8f\x73\x38\xfa\x45\x01\x42\x31\xad\xb2\xfd\x2c\x3b\x2e\x28\x9d\xb2\x9c\x8d\xb5\xc6\x9b\x48\xa5\xe4\xc3\xfe\x63\xc8\x74\x57\x5a\x36\xb1\x08\x7d\x7e\xc0\x80\x35\x4b\xab\x38\xf6\xbc\x77\x83\xcf\x39\xea\x99\xae\x4e\x4d\xf1\xaf\x27\xd2\xb3\x84\xd1\xc2\xf8\x12\x83\xa5\x07\x29\xec\x9c\x6a\x6e\x38\x91\x4e\x55\x38\x18\xd0\x51\x72\xf0\xc7\x84\x61\x8f\x63\x81\x2a\x04\xcd\x83\x06\xdd\x72\xa8\xe8\x0e\x06\x51\x33\x81\x4e\xb2\xf0\x95\xe7\x95\xa3\x8c\xd5\x3e\xab\xc2\xe2\x9c\xbd\x3c\x60\x27\x13\x6a\xd4\xcb\xb8\x78\xe2\xe6\xaa\x40\x9e\x67\x57\xbf\x7d\x7d\x2e\x2e\x69\x7a\x96\xf8\xc2\x72\x8b\x87\x89\x67\xf4\xca\xbf\xa0\xc7\xc9\x2a\x09\x37\xd9\x64\x26\x3f\xcb\xe3\x17\x30\xea\xa0\x2b\x7a\xe5\xe0\x75\x8e\x43\x53\x5e\x93\xbd\xed\x44\x85\xad\x52\xdd\x91\x1a\x5b\xdf\xd1\x9c\x57\x65\x4b\x55\xff\x1c\xbf\x31\xae\x97\x1c\x21\xea\x7e\xb5\x30\x1b\x9d\xb2\xa5\xd9\x9f\xe0\x66\xbe\x2c\xbd\xbd\xbd\xbd\x75\xc7\x6a\x0a\xec\x18\xc1\x49\x6c\x4f\x02\xe4\xd0\x32\xc9\x94\x51\x41\x3e\x61\xe1\x22\x3c\x0a\xcc\xc6\xf6\x2d\x6c\x94\xc5\xf9\x1d\xc3\xa7\xc0\x43\x6c\xff\xc2\xcd\x36\xfe\x08\x24\x53\x03\xf8\xd3\xca\xf8\xcb\x90\xe2\x25\xfd\x56\x60\xc5\x2c\xd7\x2d\x58\x6f\xfe\x25\x6f\x73\x96\xa1\x0e\xd3\x96\x7f\xb2\x8f\xcd\x3b\x93\x33\xc6\x4c\x73\xf3\x31\x73\xa6\xb4\x78\xae\xfd\x83\xc6\xa2\xd8\x98\xe9\x8f\xc9\x1c\x8b\xde\x97\x22\xd2\x89\x27\xb0\x3a\xda\xbf\x4f\x0c\x18\x8b\xe7\x03\xa2\x7e\x04\x8b\xd2\xa6\xe8\xf4\x98\xf4\x77\xda\x9e\x5f\xb8\xc6\x3e\x15\xba\x9a\x0b\x9a\xdc\x38\x46\x38\xe3\x38\x01\xf9\x4a\x5d\xc5\x6b\xd5\x79\x71\x3c\xb7\x4a\x0a\xeb\xe1\x5f\xbe\x98\x2d\x9b\x06\x6d\x75\x3e\x50\xe7\x18\xfa\x29\xe2\xf9\xc2\x9f\x95\x69\x3b\xce\xd2\x83\xdc\x4b\x75\xe3\x76\x0c\xca\xb4\xff\x0b\x00\x00\xff\xff\xad\xfc\x04\x07\x49\x27\x00\x00")
+var _apidocPermissionGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x4f\x6f\xe4\xb6\x0f\x3d\x5b\x9f\x82\x3f\x01\x3f\xc4\x46\x5c\xe7\x9e\x62\x0e\x41\x50\xf4\xdf\x6e\x5b\x6c\xb6\xe8\x21\x08\xb0\x82\x4d\x8f\x95\xd8\xa2\x2b\xca\x09\xa6\x8b\x7c\xf7\x82\x92\x3c\x9e\x34\xd9\xb6\x68\x2e\x19\x0e\xc9\xc7\xc7\x47\x52\xc9\x6c\xda\x07\xb3\x47\x30\xb3\xed\xa8\x55\xca\x4e\x33\xf9\x00\xa5\x2a\x74\x3f\x05\xad\x0a\xcd\xe4\xd3\xef\xe0\xad\xdb\xb3\x56\x95\x52\x17\x17\x70\xd5\xb6\xc8\x0c\x23\x3e\xe2\xc8\x30\xe0\xd8\x81\x75\xf0\x0b\xfa\xc9\x32\x5b\x72\x1f\xf0\xf7\xc5\x7a\x9c\xd0\x85\xe6\x9d\x04\x35\x92\xf5\x71\xc0\xc3\x99\x47\x08\x03\xc2\xa3\x19\x17\x64\xa0\x1e\xee\x97\xfb\xe5\x8c\x61\x3e\x26\x37\x09\xbd\x51\x2d\x39\x8e\x6c\x22\xc4\x3b\xda\x5b\x07\xf2\xb3\x03\x3d\x8a\xa1\xb3\xe7\xaa\xeb\xde\x53\x87\x63\xf4\x98\xae\xfb\x6a\x12\x6b\xf5\xde\x2c\x33\xfa\x85\xd1\x8b\x97\x57\x63\xf5\x7e\x40\xd3\xc1\x8a\xea\xd1\x74\xab\xe3\x37\x6f\x03\xae\x8e\x27\x31\x56\xcf\x55\x37\x6d\x44\x8c\x18\xab\xe7\x9a\x1c\x2f\x13\x26\x8f\xb0\x5f\x26\x5c\x25\xbb\x69\x69\xc6\x7f\xd2\x2a\x06\x9d\x34\x1e\xed\x6b\x72\xc1\xd3\x38\xa6\x0e\xda\xa3\xa5\xb3\x3f\xf7\x9e\x09\xad\xbd\x47\xd7\xf5\x48\xcb\xd6\x5e\x2b\xd6\xea\xfa\xb9\xef\xd1\x1f\x5d\x24\xd6\x4a\x75\xe3\xf6\x8d\x0b\x36\x1c\x7e\x15\xf1\x2c\xc7\xb9\xbd\xcd\x3b\xc5\xfd\x68\x5d\x27\xf9\xd4\x83\x48\xcc\x75\xcc\x20\x37\x1e\x00\x25\xc0\xa2\x60\x98\x00\xc6\x23\xec\xbd\x71\x01\x3b\x30\xa7\xab\xb4\xb6\xfe\x26\x83\x1d\x68\x81\xd5\x7f\xe1\x78\xc2\x03\x3c\xb6\xe4\x3b\x06\xe3\x5e\xe0\xe6\xaa\x30\x61\x18\x28\x52\x6c\x07\x6c\x1f\x32\x1b\x1b\x18\x5a\x13\xf5\x1d\x0c\x37\xf0\x7d\x38\x63\xe8\x69\x71\x1d\x0c\xb8\x78\xcb\xc1\x8a\xfb\x20\x63\x0b\x03\xc6\x74\x33\x8e\x39\x5b\x5a\x4c\xb8\x67\x0c\x2d\x75\x08\x93\x79\x40\xae\xc1\xb8\x0e\x9e\x06\x74\xc7\xba\xb9\xa8\xe4\xf7\xe4\x61\xa2\x78\x0a\xc6\x01\x39\xac\xc1\x30\x4c\xc6\x1d\xa0\x23\x08\x04\x23\x06\x40\x1b\x06\x94\x38\x19\x6f\xdc\x33\x06\xf2\x92\xbe\xed\x00\x1c\x17\x5a\xe8\x78\x5a\xf6\x43\x52\x3d\x37\xb4\xf0\x12\xa9\x3b\xc4\x8e\xe3\x28\x24\x9f\x1c\xca\xe5\x85\x01\xa7\x46\x85\xc3\xfc\x85\xb1\x02\x07\xbf\xb4\x01\x3e\xab\xe2\xe2\x02\xb6\x19\xc3\x40\x63\x27\xf5\x10\x1e\xac\xeb\x32\x14\x04\xb3\x5f\x3f\xc6\x71\x1f\x62\xda\xd3\x40\x8c\xeb\x34\x2c\x27\x11\xb0\xab\x81\x97\x76\x90\xae\xdf\x9a\x75\xa3\x8a\x93\x72\xe9\xf1\x51\x11\x2e\x1e\xfc\x09\x81\x17\x63\xce\xd8\xd0\x93\xaf\x63\xf4\x5a\x63\xbb\xdb\x26\x9f\xea\x0b\xd0\x78\x2b\x19\xf4\x69\x1d\xea\x46\x39\x50\x62\x1b\x63\x0d\xc3\x76\x75\x4d\x3e\xa6\x17\x68\xd7\xc2\xe2\x84\x22\xd3\xe2\xdb\x55\xf0\xb8\x69\x32\xf6\x90\xf6\x24\x66\x48\xb9\xc8\xfd\xa8\x4a\xfc\x5a\x9b\xd9\x36\x66\x09\x03\x79\xfb\x07\xfa\xe6\x3b\xc3\x9b\x58\xe5\xe9\x83\x29\x9d\xa5\x57\xb3\x96\xc7\xbc\x89\x3b\xf3\xd1\xec\x2b\xdd\xa8\x22\xf1\x49\x0c\xe1\xd3\x3d\x93\xbb\xd4\x35\x4d\x36\xe0\x34\x87\x83\xfe\xa4\x9e\xe3\x45\xdd\xa4\x00\x8f\x61\xf1\x2e\x4d\xd7\x9f\xec\xc2\x93\x0d\x03\x2d\xf9\x5a\x04\xb1\x56\x27\x02\xc7\xcb\xbc\x8c\x65\x2f\xe3\xaa\xea\x46\xf5\x8b\x6b\xa1\xf4\x6f\xef\x56\x95\xeb\x95\xd5\xca\xec\xb3\x2a\x52\x6d\xf0\x27\xef\x09\x9c\x83\xbe\xd4\x70\x0e\xbe\x49\x4a\x6f\x76\x9c\x63\x26\xbf\x89\xc1\x7f\xdf\x07\x1f\x1b\x09\x03\x5a\xbf\xbd\x05\x35\x30\xf9\x20\x8b\x29\xc7\xd9\xd2\x34\x9b\x88\xf3\x2a\x9f\xfa\x7c\xcf\x9c\x5b\x7c\x55\xbb\x9c\x19\x6e\xef\xbe\xd0\xf6\xd6\x2d\x33\x5c\xee\xe2\x16\x94\xb7\x77\xe9\xeb\x1a\x46\x74\xe5\xcc\x55\xa5\x0a\xa1\x61\x6b\x98\x25\xc8\x1b\xb7\x47\x98\x59\xd2\x0a\xe6\x5b\x7b\x07\x3b\x98\x9b\x55\x42\x55\x3c\xab\x42\xe8\xe7\x6f\xb8\x64\xae\x8e\x72\xe6\xbf\xdd\xcd\x0f\x64\x5d\x29\x1b\xa2\x6b\xd0\xd5\x6b\xe1\x7e\xa2\x80\x47\xd9\x0c\x38\x0a\xc7\xa5\xdd\xdb\x47\x74\x2f\x64\x78\xdd\xbb\xa4\x97\x13\xef\x19\xde\x23\xb3\xd9\xcb\x1b\xf8\xef\x84\x90\x4a\xff\x51\x8b\x98\x9a\xe4\x90\xd2\xcd\xb7\x18\x4a\xbd\x91\xd2\x35\xcc\xe9\x1f\x10\xf9\x10\x17\xa8\x52\x45\x61\x7b\x98\x9b\x74\x16\xff\xdb\x81\xd6\x11\x6a\xc3\x3a\xdf\x41\x3f\x85\xe6\x66\xf6\xd6\x85\xbe\xd4\x50\xfe\x9f\xab\x08\x15\x73\x04\xe1\x39\x4a\x9e\x05\x7e\xab\x32\xeb\x3a\x77\x98\x85\x8f\xe8\x35\xe8\xaf\x41\x57\x95\x7a\x56\x7f\x0e\x00\x8a\x6c\xcc\xc5\x7c\x09\x00\x00")
 
-func jujugenerateapidocProgGoBytes() ([]byte, error) {
+func apidocPermissionGoBytes() ([]byte, error) {
 	return bindataRead(
-		_jujugenerateapidocProgGo,
-		"jujugenerateapidoc/prog.go",
+		_apidocPermissionGo,
+		"apidoc/permission.go",
 	)
 }
 
-func jujugenerateapidocProgGo() (*asset, error) {
-	bytes, err := jujugenerateapidocProgGoBytes()
+func apidocPermissionGo() (*asset, error) {
+	bytes, err := apidocPermissionGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/permission.go", size: 2428, mode: os.FileMode(436), modTime: time.Unix(1792000086, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocPresenceGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x4f\x8f\xa4\xb6\x13\x3d\xc3\xa7\xa8\xe1\xb0\x3f\x58\x21\xe6\xde\x23\x0e\xbf\x43\x12\x45\x51\x92\xd5\xee\x28\x39\x8c\x46\x23\x37\x2e\x83\xb7\xc1\x26\xb6\x99\x16\xca\xf6\x77\x8f\xca\xe6\x8f\xbb\x67\xf6\x90\xf4\x09\x5c\xae\xf2\xf3\x7b\xaf\x8a\x1e\x59\x73\x62\x2d\x02\x1b\x25\xd7\x4d\x9a\xca\x61\xd4\xc6\x41\x9e\x26\x99\x41\xd1\x63\xe3\xb2\x34\x4d\xb2\x56\xba\x6e\x3a\x56\x8d\x1e\xee\x8d\x6e\x47\x1c\x47\xbc\x67\xa3\x6c\xf4\x30\x32\x77\xff\xd5\x6a\xe5\xe6\x11\x6d\x96\x16\x69\x7a\x7f\x0f\x7f\xb0\x7e\x42\x0b\x5a\xc0\x8f\x12\x7b\xfe\xb3\x12\xba\xfa\x64\xd0\xa2\x6a\xb0\x4a\x1b\xad\xac\x3f\xe2\x33\xfe\x35\x49\x83\x1c\x6a\xc8\xcc\xf2\x9c\xa5\xc9\xef\xa3\x93\x5a\xb1\x9e\x96\xf5\xf2\xbc\x56\xf6\xf5\xd6\x52\x60\xd0\x4d\x46\x59\x38\x77\xe8\x3a\x34\xc0\xe0\x95\x4e\x86\x61\xb2\x0e\x8e\x08\xad\x7c\x45\x05\x42\x1b\x70\x1d\x52\xba\xa0\x74\x10\x84\xcc\x75\x08\x04\x1a\xce\xd2\x75\x14\x5f\x76\x2b\x36\x60\x09\x28\x7d\xc1\x0d\xa1\x36\x94\xbe\x22\x2b\x81\x29\xee\x73\x0c\x32\xab\x15\x9c\xbb\xb9\x82\xc7\x0e\x61\xdc\xa1\x35\xda\x70\xe4\x20\x15\x65\x4a\xa2\xc0\x83\xb7\x20\x2d\x4c\x96\x22\x1e\x84\x41\x5a\xd0\x0a\x1f\x40\xd3\xeb\x59\x5a\x04\xe9\x68\x91\x63\x23\x39\x72\xca\x3f\xce\xf0\xc5\x31\x27\x9b\x9d\x46\x31\xa9\x06\x72\x2a\x0c\x1f\x89\xe1\xe2\x9a\x9c\xdc\xc1\xa6\x4b\xf5\x38\x8f\xf8\x9b\xbf\x98\x80\x8f\xfb\xb2\x4f\x28\x20\x5f\x51\x97\xeb\x7d\xac\x33\x52\xb5\x05\xfc\x9d\x26\x52\x80\x90\x70\xa8\xa3\x2b\xe4\xae\x04\x51\x51\xbd\xe2\x81\x82\x77\x35\x28\xd9\xc3\x87\x0f\x20\xe4\xa6\x33\xdc\xd5\x90\x65\x54\x21\x09\x32\xc5\xc1\x32\x7e\xf9\xec\xcf\x4c\x93\x4b\xba\xee\xbc\xbe\x6a\x2e\x8a\xf4\xe2\x7d\x75\xbd\xfe\x2f\xe4\x17\x94\xfd\x75\xe2\xad\x54\x2d\x1c\x67\x90\xce\xb3\xdb\x33\xc3\x48\x51\x60\xbd\x56\x78\x00\xb6\x18\xc4\x75\xcc\x0b\x30\x30\x73\x0a\xfc\xeb\x41\x3a\x1c\x46\x37\x83\x36\xd0\x31\x0b\x0c\x46\x2d\x95\x43\x13\x4c\x44\x12\x5e\x99\x83\xa9\x39\xe8\xb9\xdb\x4e\x5a\x58\x5d\x5e\xc2\x11\x1b\x36\x6d\x4a\xb3\xfe\xcc\x66\x0b\xa8\x1a\xcd\x91\x2f\xda\xde\xb2\xf0\x1f\x94\x7b\x29\x3d\xf2\x1f\x3c\xf2\x43\x1d\x1c\xf2\xa7\x34\xde\x0c\xb9\x28\x1e\xa2\x70\xa4\xd4\xee\xf3\x2c\x50\xb0\xdf\x3f\xf3\x3a\x91\x29\xbc\xa9\x62\xed\xfd\x42\xf5\x8b\x54\x1c\xea\x3a\x32\xdf\x27\x67\xbe\x53\x7c\xa5\xd0\xf3\x93\xc5\x0e\x58\x1b\xaf\x84\xec\x9a\x9b\x6c\xb1\xc2\xaf\xcc\x9c\x36\x23\x58\x74\x16\x5c\xdc\x7e\x5a\x00\xbe\xa2\x99\xd7\xb4\x45\x02\xed\x8d\x10\x22\xd6\x99\xa9\x71\x20\x55\x70\x36\x81\xa7\x36\x0a\xe2\x73\x8d\x56\xfd\xcf\x01\xeb\x0d\x32\x3e\x43\xc7\x5e\x11\xb4\xf2\x43\x64\xcd\xf0\x64\xda\x12\xd8\xd6\xa9\x6f\xdb\xb4\x04\x1b\x2a\x52\x22\x95\x42\x63\xd7\xe9\xc3\x75\x33\x0d\xa8\x1c\x70\x4d\x47\x29\x44\x0e\x4e\xc3\x59\x9b\x13\xd9\x42\x4f\xce\xcf\x94\xcd\x40\x3e\x11\x87\x23\x72\x3a\x2a\xc0\xb7\xc0\x0c\xc2\x22\x92\x54\x44\x82\x34\xa0\xcf\xca\xdb\xf2\xbd\x21\x11\x13\x97\xaf\x3e\xb9\x66\xa0\x0e\x9a\xee\x9a\x79\x65\x96\x43\x0e\x35\x0c\xec\x84\xf9\xc0\xc6\xa7\xb7\x03\xe6\x99\x96\xc3\xf4\x78\x3e\x6a\xdd\x17\x69\x42\xe3\xf7\xa5\x5c\x86\x88\x61\xaa\xc5\x98\x3e\x02\x40\x08\xa2\x79\x00\xf5\x36\x39\x92\x46\x2b\x27\xd5\x84\x69\x42\x10\x68\x63\x80\xf1\x24\xa4\xc7\xfb\x1c\x83\x4d\xde\xc4\x76\xa8\xd7\x98\x7c\xb1\x9b\xdd\xf4\xe0\x31\x51\x9e\x33\x74\xe6\x25\xa0\x0f\x9f\x04\x77\x83\x7f\xa5\xcb\xe3\xd8\xee\xe1\x42\x03\xdc\xc5\x0d\xf0\xc5\x4b\xf5\xee\x85\x56\x72\xf6\xda\x2e\x26\x26\x39\x2f\xbd\x5a\xc2\xcb\x7b\xfd\x4b\x15\xa5\x80\x75\xd7\xc2\xdc\xb7\x6f\x20\xaa\xff\x2b\xad\xe6\x41\x4f\x96\xe6\xf2\xf2\x2d\x5f\x90\x3c\xb2\x36\x17\xd5\x23\x6b\x8b\xea\x27\x74\x79\x46\x48\xb3\x22\xa6\x3d\x86\xe9\x71\x46\xcc\x13\x1b\xcf\x4f\x61\xfe\x3f\x7f\x6f\xfb\x9b\xb9\x74\xa8\x6f\x3a\x63\x45\x1f\x59\xa1\x06\x36\x8e\xa8\x78\x1e\x2d\x96\xfb\x5f\x88\x70\x14\xb1\x7d\x80\xfd\x47\x70\x4a\x1f\xf1\x1b\xa3\x50\x80\x18\x62\xeb\xa9\x6b\x78\xc3\x77\x15\x0d\x5f\xa2\xc3\x82\xd9\x67\x5e\x16\xb7\x5c\xd2\x1b\xa8\x8d\x1e\x8e\x52\x61\x78\x8f\x11\x17\xe9\x25\xfd\x67\x00\x8a\x06\xc7\xa7\x57\x09\x00\x00")
+
+func apidocPresenceGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocPresenceGo,
+		"apidoc/presence.go",
+	)
+}
+
+func apidocPresenceGo() (*asset, error) {
+	bytes, err := apidocPresenceGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/presence.go", size: 2391, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocQuickstartGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\xdf\x6f\xdb\xb8\x93\x7f\xb6\xfe\x8a\x59\x1d\xd2\x95\x5a\x55\xe9\xf7\xd5\x7b\x3e\xa0\x9b\x6d\x17\xbb\xe8\xb6\xbd\xa4\xbd\x3e\xc4\x41\x41\x4b\x23\x8b\x89\x44\xaa\x24\xe5\xd4\x17\xf8\x7f\x3f\x0c\x49\x49\x74\xe2\x38\xed\x1d\xae\x41\x13\x93\x1c\x0e\x87\xc3\xf9\xf1\xe1\xd0\x1d\x2b\x6e\xd8\x1a\x81\x75\xbc\x94\x45\x14\xf1\xb6\x93\xca\x40\x12\xcd\xe2\xd5\xd6\xa0\x8e\xa3\x59\x8c\xa2\x90\x25\x17\xeb\xd3\x6b\x2d\x05\x75\x54\xad\xa1\x3f\x5a\x2a\xf7\xd7\x28\x2e\xd6\x3a\x8e\xa2\x59\xbc\xe6\xa6\xee\x57\x79\x21\xdb\x53\x25\xd7\x1d\x76\x1d\x9e\xb2\x8e\x17\xb2\xed\x98\xb1\x0c\xcc\xb6\x23\xb6\x69\x14\x9d\x9e\xc2\x7f\xf6\xbc\xb8\xd1\x86\x29\x03\xb5\x6c\x4a\x0d\xba\xa6\xe5\x3b\x25\xd7\x8a\xb5\x1a\x4c\xcd\x0c\x14\x52\x08\x2c\x0c\x18\x09\x8c\x1a\x46\xc9\xa6\x41\x45\xf3\x99\x28\xa1\x60\x4d\x03\x0c\x5a\x34\xb5\x2c\x41\x56\xc0\xa0\x62\x05\x2b\x31\x03\x29\x10\x2a\xa9\x00\x59\x51\x43\xd1\x70\x14\x06\x1a\xbe\x52\x4c\x6d\xf3\x88\x04\x09\x05\xd0\x46\xf5\x85\x81\xbb\x68\x76\x7a\x0a\xff\x38\x6e\x4e\x28\x53\x23\x08\xd6\x22\x31\xa7\xcf\x7e\x29\x2b\x1c\xd7\x56\x00\x2c\xf3\x68\xe6\x27\x39\x7d\x44\x96\xcf\x85\xe0\x5d\x87\x46\x07\x9c\x86\xcd\x8d\xe2\x59\xc2\x83\x22\xce\xc6\xe9\x97\x57\xfe\x63\xb4\xb3\x8a\xf3\x2d\xcf\x96\x0d\x1a\x83\x5b\xc5\x8d\x41\x01\xb7\xdc\xd4\xc0\x1e\x30\xb4\x7b\x1e\xe6\xee\x6d\xf8\x1d\x13\xeb\x9e\x2c\x61\x12\xb4\x19\xba\x64\x15\x0a\x9e\x59\x7a\xa6\xa1\xd7\x58\xd2\xa1\xd4\x7c\x5d\x37\x7c\x5d\xd3\x49\x95\x08\xab\x46\x16\x37\x3a\x03\xdd\x17\x35\x30\x0d\xf1\x5a\xc6\x79\x34\x1b\x17\x08\xd5\xf3\xce\x1d\xc6\x23\x7a\x7e\xa0\x8e\x81\x7c\x64\x71\x26\xcb\x91\xa1\x53\x8c\x9b\xe3\x08\x39\x6a\x68\x59\xa7\x0f\x9d\xbf\x33\xad\x6f\xe3\xf1\xd3\x5c\x3d\x68\x9b\x29\x1c\x35\x49\xf6\x63\xa4\x95\xa7\xea\x45\x61\xb8\x14\x6e\x2e\x11\xa0\xd5\x54\x4b\x93\xad\xc6\x89\xca\x2f\xf0\xab\x86\x5b\xc5\xba\x0e\x95\x35\x41\x1a\x71\x66\x99\x47\x1b\xa6\x1e\xc8\xb9\x80\xcb\xab\xe9\x40\x46\xd5\xfb\xbd\xcd\x06\xa9\x87\xcd\xce\xbc\xac\x60\x85\x4a\x10\x9e\xbf\xf9\xce\xda\xae\xc1\x0c\x3a\xa6\x58\x9b\x81\x42\xdd\x37\x06\x9e\x8f\x3e\x97\x7f\xda\x76\x98\x8e\xda\xba\xbb\x23\x77\x95\x71\x06\xa1\xd3\x5e\xf7\xd7\xbd\xfb\xc5\x3a\x1e\x67\xb0\x96\xde\x5a\xb2\x68\x97\x91\x64\x71\xb7\x35\xb5\x14\x34\xcd\x7d\x7a\xd9\xf0\x15\x4d\x88\x33\x70\x1d\xd3\x84\xdd\x7d\x1f\x57\x68\x7a\x25\x34\xb0\x40\xf1\x56\x3b\x55\x66\xbd\x88\x8b\x35\xe9\x13\x2a\xae\xb4\x21\x6f\xe3\x46\x13\x0b\xe7\x70\x3e\x1c\x70\x2d\x7e\x35\xc0\xe0\x96\x99\xa2\x46\x95\x81\x54\x20\x78\x03\x9c\xc8\xa1\x66\x1a\x84\x1c\x66\xe4\xf0\xa9\x46\xe2\x60\x95\xe2\x0e\x56\x5b\x3d\x69\x30\xec\x86\x8e\x57\xc9\xd6\xae\xa9\x8b\x1a\x5b\xa6\x81\x0b\x28\xb1\xd2\x19\x30\xed\xe5\xc5\x92\x38\xac\xb6\x70\x61\x49\xfe\xc0\x8a\x0b\x4e\x76\xa0\x73\x78\xc7\x6f\xd0\x4e\xc7\xef\x9e\xed\x30\x07\x56\x5b\xf0\x67\xa2\x33\x22\x21\x26\xde\x85\xdc\xe2\xd4\x19\xc4\x33\x60\x65\xa9\x50\x3b\x62\x68\x65\x89\x0d\x7c\xfe\xfc\xd7\x1f\x36\xc8\xf9\xf9\x85\xc2\x12\x85\xe1\xac\xd1\x93\xe4\x28\x36\x5c\x49\xd1\x92\xa7\x6f\x98\xe2\x6c\x45\x72\xfc\xfd\xf9\xef\xcf\x5f\xcf\x3e\xbc\xff\x74\xfe\xe1\xdd\xbb\x37\xe7\x19\x2d\x6f\xfb\xfe\xf9\xf0\xc7\x9b\x77\x5f\x89\x73\xe6\x3a\x3e\x5f\xbc\x39\xb7\x8b\xd8\xd6\xc7\xd7\x17\x17\x5f\x3e\x9c\xff\x91\x0d\xeb\x06\x22\xfe\x6a\x4f\xe3\xec\x35\x14\xa8\x0c\xaf\x78\xc1\x0c\x3a\x39\xec\xd4\xb3\xd7\x5f\xcf\xde\x9c\x7f\xca\x23\xb2\x49\x48\xb8\xa8\x24\x3c\xff\x4b\x54\x32\x0d\x8c\x20\xa9\xe0\xf9\x5b\xeb\x08\x34\x92\x59\x65\x93\x97\x5e\x3a\xc3\xbc\x7a\xee\xb4\x9c\xc2\xf3\xc0\x70\xee\xa2\x19\xaf\xa0\x41\x91\x54\xb9\x8b\xb2\x3a\x85\xc5\x02\x5e\x91\x49\xce\x9c\xca\xc9\x08\xa2\xd9\x2e\x9a\xb5\x30\x5f\xc0\xb3\x91\xf0\xf2\xd5\x55\x34\x23\x23\xe3\xd4\xaf\x98\x58\x23\x8c\x83\x76\x3e\xaf\xa6\x8e\x4b\x7e\x95\xff\x25\x36\xb2\x60\x74\xc2\xf0\xcb\x02\xa6\xd6\x17\x67\x71\x76\xce\xac\x85\xbd\x45\xf8\x15\x75\xae\x14\xb2\x9b\x68\x46\x52\xec\xa2\x19\xd2\x82\xcf\xbc\x11\xd0\x24\xb7\xef\x39\x40\x95\xbf\x67\x2d\x66\xd1\x6c\xf6\x5f\xa8\x34\x97\x62\x0e\x55\xee\x3f\x52\xaf\x93\x6e\x0e\xd0\x0e\x84\x3b\xab\x81\x36\xff\x48\x86\x4c\x62\x91\xc9\x13\x4f\xcc\xcf\xf1\x5b\x8f\xda\xb8\x21\x0d\x0b\x20\xc5\xe7\x4e\x8d\x89\x9f\x91\xe6\x17\x56\x8a\x84\xd4\x9d\x5a\x76\xdf\xac\x74\x93\x92\xef\x82\x75\xc3\x65\x49\x75\x5f\x33\x8a\x9b\x93\xfe\xee\x47\x2f\x9a\xfb\x2d\xf7\x9e\x4f\x22\x50\xe0\x13\x65\x32\xf5\x65\xe0\x3f\x11\xe9\x98\x0c\xe6\xc4\x36\x1f\xa2\x1d\xed\x7c\x88\xf1\x73\x70\x43\xae\x65\x47\x28\xd8\xcf\x81\xfe\xd1\x88\x0f\x80\x09\x66\xe0\xf7\x48\x1f\xce\x6d\xd4\x4b\x89\x7e\xe7\xb6\xe9\x8d\xe3\x9b\x4f\x9c\x63\x40\xf3\x8e\x4a\xc1\xe8\x4f\x39\x38\xa6\x8b\x30\x2d\xbb\x71\x81\xdd\x06\x25\x8a\x08\xd6\xf9\x4c\xad\x64\xbf\xae\x47\x7c\x61\x47\x51\x4d\x8e\x48\x61\x90\x90\x14\x78\x54\xa5\xbd\x27\x8c\x6b\xfe\x7c\x9c\x26\x5b\x73\x98\x4c\x93\xf6\x03\x3f\x59\x49\xd9\x90\x2e\x63\x72\x4f\xfc\x6e\x62\xa7\x9a\x47\xfe\x19\xd5\x93\x15\x39\xec\x76\x94\x72\x8f\xba\x91\xeb\x9f\xa0\x96\xfa\x69\xe2\x89\xfa\xb1\xac\x73\xba\x62\x1a\xe3\xf9\x8f\x52\x7b\x74\x28\x55\x3c\x1f\xa8\x77\xd1\x8c\xa9\xb5\x55\x58\x2c\x78\x13\x5b\xd7\xe9\xee\x3b\x8e\x25\x59\x40\x4c\x7f\x63\x8a\x02\x4e\xcd\x97\x6b\x49\x07\xf0\x97\x6d\x25\x76\x56\x7a\x05\x0b\xcb\xdb\x1b\x94\xee\xa4\xd0\xb8\xcf\xde\x1f\x63\xc0\x7f\xa4\x5b\x40\xfc\xcc\x0d\x3f\xba\x8c\x1b\xde\x5f\xa7\xc4\x06\x0d\x26\x9e\x3e\x83\x38\x4e\xa3\x19\x41\x87\x8e\x99\x5a\x3b\xb8\x40\x99\xdc\xc6\xb6\x6e\xf2\x4d\x3f\xc1\xca\xe0\x48\x47\x77\xb4\xcd\x0c\x3a\xe7\x19\xa7\xa7\x94\x1d\x41\x1b\x26\x4a\xa6\xca\x11\x19\x0d\xd6\x0b\x85\x6c\x7d\x2e\x1e\x31\xdf\x5a\x0e\xec\x6f\x65\xdf\x94\x50\xb3\x8d\xcd\x7f\x6d\x1e\xcd\xe8\x3e\x90\x5f\x34\xbc\xc0\x61\x21\xb2\xfe\x84\x67\x70\x0d\x5c\x98\x14\xc8\x64\x87\x80\xab\x79\x06\xfa\x9a\xa4\xe6\xfa\xc2\x94\x1f\xdd\x9a\x6e\xe2\x25\xbf\x4a\xb3\x43\xfd\xd7\x57\xe9\x6f\xa0\x39\x85\x3f\x7d\x6d\x39\x0d\xee\xad\x39\x79\xfc\x94\x0b\x06\x3e\xf0\xef\xfe\xe3\xf5\x55\x34\xdb\xa5\x91\xd3\xe0\xaa\xaf\xbc\x7f\xe9\xfc\xf7\x9e\x37\x25\xaa\x68\x56\xb5\x26\x7f\xdb\x29\x2e\x4c\x95\x3c\x5b\xf5\x15\x21\x1c\x7f\x3b\x6a\x19\x17\x4b\xb1\x14\xc3\xed\x68\x29\xe8\x2c\x48\xef\x3c\x0b\x55\x6f\x97\x1a\x76\xc8\xe1\x3f\xe0\x15\x3c\x7b\x76\x70\x83\x2f\xff\x75\x95\xd2\xd8\x2f\xfb\x83\xa9\x9d\x3c\x5b\xf5\x55\xfe\x85\xe0\xe5\x85\x15\x32\x89\xdd\x82\x76\x83\x07\xc4\x5c\x9a\x93\x6f\x4b\x11\x8f\x07\x7b\x80\x24\x25\xf1\xe3\xf4\xe0\x18\x9d\x92\xdd\x62\x92\xc2\xdd\xa3\x54\x4b\x53\x64\x80\x4a\xd1\x6e\x47\x9f\xcb\xdf\xe3\xed\x05\xb7\x89\x65\xea\x73\x1d\x67\x52\x54\x7c\x7d\x8c\xdf\xd2\x9c\x8d\xc8\xe2\xb5\xc3\x3e\xa8\xe7\xa3\x61\xdf\x49\x9d\xff\x89\x06\xc5\x26\x59\xc6\xf7\xd0\xcc\x32\x4e\x77\xd9\x51\xd6\xff\x10\x7e\x22\x90\x13\x46\xa4\x07\x1c\x27\x2c\xb4\x8c\xd3\xe3\x0c\xcf\x5e\x9f\xa1\x32\x21\xb7\x43\x0c\x3d\x04\x7a\x92\xdb\x67\x8d\x8a\xae\x94\xf3\xa3\xdc\x08\x99\x3d\xc9\xea\x23\xd3\xfa\x56\xaa\xf2\x38\xab\x01\xd6\x3d\xc1\x6e\x97\x1e\x19\xe4\x95\x35\x80\x21\xc6\x2d\x05\xe9\xa5\x91\xeb\xfc\x2d\x33\xac\x49\x50\x29\x32\x33\xb3\x3b\xc2\x82\xac\x64\x32\xa3\xfc\xcc\x19\x4d\xf2\xff\xbc\x6a\x89\x15\x2a\xc2\xb1\x22\x3f\x6b\xa4\xc6\xa3\xeb\xf9\xac\x3e\x5f\x00\x25\x22\xb2\x70\x07\xdb\xce\x6c\xff\x5b\xa9\x3c\x4e\x4b\x88\x5f\x06\x27\xdf\x32\x38\x29\x89\x61\x06\x98\xbf\xf5\x95\x07\x1c\xd0\x5c\x7a\x38\xf9\x1c\x5c\xf9\xf4\x14\x3e\xd0\xe5\x12\xe1\x96\x2b\x74\xf7\x00\x7f\x71\x69\xa4\xbc\x81\xc6\x5d\x36\xb8\x9e\xfb\x78\xf0\x08\x97\xa5\x39\xd1\x56\x9e\xb7\x8a\xb5\xf8\xf7\xc5\x87\xf7\xc9\x7d\x94\x98\x3e\x3a\x7d\xc3\x14\x50\x4a\x04\xcf\xc3\xe5\x29\x82\x83\x3e\x19\xa6\x03\x18\x7d\x98\xf2\x1e\x65\xe8\x49\x1f\xb2\xf4\x89\xef\xd1\xb8\x35\x1e\x3f\x99\x8b\x3d\x00\xaf\x62\x3a\x8d\xc4\x43\x9f\xfc\x77\x56\xdc\xac\x95\xec\x45\x99\xa4\xfe\x44\x34\xfd\x4f\x7f\xbb\x67\x3a\x31\x9d\x8c\x03\xb9\x99\xdd\xa5\xc5\x5f\x36\x9d\x3f\x66\x0e\x47\x6c\xed\xc7\x75\x40\x3b\xfb\xe8\x36\xb6\x8c\x4f\x4e\x5e\x6c\x96\x4b\xb1\x8c\x07\xf0\xe7\xad\xf1\xb0\x06\xfc\x5a\x3e\xaf\x51\x66\xf0\x49\x21\xf5\x88\x36\xcc\x20\xa0\xd0\x67\xe7\x1a\x4d\x8d\xca\x9b\x90\x4d\x3d\x53\x85\x62\xcd\x37\x28\x08\xd1\x52\x32\x02\x6e\xef\xbc\xe6\x00\x12\xc8\xe0\xb6\xe6\x45\x3d\xdc\xa9\x4b\x69\x06\x52\x9a\x6c\x81\x01\x60\x83\xf6\xe2\xe9\x2e\xeb\x2e\xd9\x7a\xd8\xbb\x9f\xda\x68\x29\x97\x73\x27\x20\xe0\x37\xf5\xcb\x90\x8b\x29\x1f\x30\x2e\x74\x32\x74\x5c\x74\x0d\x37\x76\x6e\x06\xf1\x69\x9c\x5e\xbe\xba\xca\x20\xce\xe3\x61\xef\x93\x25\x8d\x70\x7e\xaf\x88\x44\x97\xf8\xa1\x90\xc3\x85\x85\xfa\xc1\x35\xdc\xd7\x13\x3c\xa0\x71\xe2\x5b\x55\x05\xc5\x2b\x6b\xf3\x3a\x7f\x23\x0c\x37\x1c\x75\x3c\x42\xfa\xd1\x84\x8f\x63\xf7\x0a\x8c\xbd\x4b\xd1\x6d\x35\x8e\x69\xd3\x84\xa4\xbe\x70\x85\x60\xd1\xbe\x2d\x49\x90\xbc\x65\x06\x9a\xca\x4c\xdc\x16\x41\xfb\xa6\xa4\x2a\x47\x6d\x81\x1b\x01\x60\xaa\x40\xf4\x06\x98\xd8\xc2\x86\x35\x3d\x42\xc1\x04\xac\x10\x4a\xa4\xa2\x5b\x49\x00\x4b\x02\xa3\x5d\x1a\x54\x15\x2b\x30\x9f\xc0\x50\x3c\x76\xde\xed\xe2\xf0\x52\xe4\xb7\x4b\xf2\x25\x4e\xcc\xfc\xe3\xcd\xfa\x23\x33\x75\x92\xa6\xf0\x82\x34\x0d\x2f\xfc\x06\xec\xaf\x64\x5f\xf1\x0e\xbd\xee\xa9\x9e\xce\x6a\xa8\xdf\x79\xf6\x4e\xcd\x25\x16\x0d\x53\x74\xaf\xb2\xa5\x08\x5f\x12\xc3\xb6\x33\x43\x3d\xcf\x55\x70\x7e\xa5\xfa\xcd\x48\x4e\x1b\x03\x36\x70\xda\x53\xbe\x87\xce\x3f\xad\xfe\x41\x29\x7b\x9a\xb8\xbf\x7b\xbf\xcd\xbd\x8a\xd6\xb8\x4f\x06\x1f\x6d\xff\x4f\xdd\x1a\xa7\x02\x20\x14\x0d\xd3\x1a\xd6\x28\x50\x31\xe3\x2a\x45\xfb\xb5\x34\xbf\xcf\xbd\xd5\xff\x77\x77\xc7\x9f\x41\xbb\x1e\xdd\x32\xbd\x15\x05\x97\x23\xda\x95\xfa\x18\x76\xa4\xbb\xaf\x15\xd9\x95\x04\xbc\x37\xf9\x02\xc1\x52\x4c\xe3\xae\xa0\xe5\x87\x2d\x3a\x5b\x8a\x23\x8c\xad\x14\x54\x1c\xf2\xc8\x74\xfe\x28\x25\xe1\x1d\xc7\x7c\xe1\xf8\x1e\xc9\xee\x44\xcb\x6e\x19\x37\xae\xbe\x96\x7b\xd0\x9a\x1c\x9d\x40\x3f\x28\xca\x4e\x72\x61\x16\x52\xe7\xbe\xde\x76\x79\x00\x95\x5e\x65\x4f\x72\xea\x7b\x5e\x1e\xe0\x12\x22\xd1\x1f\xe1\xe2\xf1\xe3\x01\x4e\x9f\x2f\x7e\x50\x92\xce\x03\xc7\x03\x3c\x26\xb4\xf8\x03\x7c\x0a\x46\xe5\xc0\x03\x5c\x46\x30\xfc\x04\x93\xe3\xe7\x65\xd4\xf6\xf8\xe1\xd3\xcf\xbf\xd9\x2a\xcc\x57\x7f\x9e\x54\xb9\xeb\xb5\xf7\x46\x81\xb7\xa8\x0d\x6c\x1c\x1e\xa3\xd8\x74\xe2\x2b\xc9\x2b\x69\xea\x3d\xdc\xf6\xd4\x1a\xde\x3d\x0f\x54\x46\x41\xf7\x1d\x59\xf6\x90\x32\x5b\xb6\xb5\x61\x6c\x85\x70\x52\xe6\x7e\x91\x09\x12\x1e\x59\xc5\xc7\x88\x85\x77\xa1\xfc\x44\x3b\xc0\x93\xdf\xdb\x60\xb2\x67\xc1\xd4\x91\xa6\x3f\xb1\x19\x1f\x3a\x16\xde\x1d\xfc\xd3\xc4\x89\x4e\x02\x88\xe4\x20\x8e\x5c\x5d\x67\x20\x6f\xe8\xde\x77\x1f\x45\xe6\x49\x50\x90\x0a\x92\x4c\xfa\x1b\x4d\x78\xf6\xcc\x56\x6c\xe5\xea\x3a\xb5\x57\x61\xca\x7d\x94\xe9\x7c\x25\xeb\x06\x93\xe1\xaa\x97\xc1\xab\x6c\xa4\xb5\xc8\x94\x6a\xfa\x94\x35\xc7\xab\xb5\x5c\xf9\x0b\xbf\xe3\x30\x96\x34\x6c\x33\xb3\xc4\xc3\x0d\xd9\xd5\x21\x6c\x0e\xd0\x09\x0d\xe8\x34\x7a\xfc\x4e\xed\xcb\x9b\xfb\xab\x51\xcb\x55\x35\x8f\xe9\x90\x4a\x91\x27\x7a\x71\xa2\xc9\xbc\x87\xe7\x0f\xca\x23\x76\xd5\x74\xe8\x79\xc7\x0d\x2a\xd6\xd0\xe6\x2e\x69\xe0\x2a\x1d\x24\x7d\x20\xd3\xc0\xf5\x71\x38\x78\xdc\x57\xe8\xc7\xf6\x0e\xc0\xfa\x28\x75\xc5\x05\x6b\x9a\x1f\xf0\xae\x30\x64\x96\x5c\x7b\x9b\x4b\xd2\xa7\x23\x38\x97\xb9\xea\x45\xe2\x62\xb8\x97\xe6\x71\x28\x3b\x69\xf0\x30\x9c\xab\x11\x6e\x70\x4b\x97\x5d\x02\xef\xbd\x05\x9e\xe4\xc9\xd3\xdc\x21\x83\x52\x80\xd4\xd3\xc3\x1b\xc7\xa6\xbc\x87\x7f\xed\x1d\xcb\xb2\x9e\x5b\xd4\x41\x3c\xb8\x80\x46\xde\xd2\x55\x91\x69\xcc\xdc\x84\x92\xe9\xda\x3e\xeb\x74\x0d\x2b\x5c\xb2\xee\x45\x89\x4a\x17\x52\x21\x3d\x13\x09\xcf\x99\x59\x48\x3d\x8d\xd1\x7b\x8e\x7b\x20\xf5\x68\xc1\x8b\xae\xed\x94\x55\xcf\x1b\xc3\x05\xbd\x93\x4a\x88\x29\x6d\xc7\xd4\x4f\x2c\x62\xd6\x75\x0d\xbd\xad\x70\x29\x5e\xca\xaa\x42\xa5\x63\x58\xa1\xad\xc6\xc5\x94\xe6\xbf\x5a\xd2\x3d\xba\xaf\x9e\x6e\x0f\x39\x8c\xa6\xe8\xd3\x7f\x08\x0c\x68\xe3\xb0\xf0\x1d\x3a\xff\x24\xdf\xd1\xc6\x47\xe0\x7d\xee\xb6\x6b\x67\x67\x10\xbf\xa4\xf7\xbe\xaf\x71\x06\x2f\xff\x95\xfa\x1b\xad\x5d\xe1\x1c\x35\xaa\x0d\x96\xce\xae\x47\x0f\x87\x17\x0b\x22\x0f\x01\x16\x11\xec\x9d\xf2\x30\x35\x78\xfb\x3d\xa4\xa7\xe1\xfc\xb5\x37\x00\x52\x90\xa7\x1b\x54\xe8\x82\x39\xe1\x68\xba\x20\x37\x5b\x52\xf9\x0a\xc9\x02\x4a\x60\xda\x1f\xbe\xe5\xe1\x9e\x5e\xef\xad\x7f\xa8\xaa\x1e\x33\x51\x0e\xf5\x64\x88\x99\x0e\x3f\x6b\x54\x26\x6c\x6f\x45\x11\x34\xc9\x53\x86\x26\x7d\x89\x82\x1e\x84\xa6\x61\x8b\xfc\x82\xa6\x14\x86\x8b\x1e\xa7\x9e\x12\xab\xb0\xd1\x0c\x0d\xfa\x1a\x46\xc3\x83\x31\x6c\x74\x30\x0d\xbf\x17\xd8\x05\x52\x79\xcf\x0e\x3a\xc2\xfa\xb8\x45\x6d\xd3\xd8\xba\x91\x2b\x36\xae\x04\x71\xb8\x8c\xc3\x6b\x41\x5b\x0c\x9f\xa3\x59\xcc\x03\xbd\x34\xac\x5d\x95\x6c\x6a\x0b\x29\x1a\x59\x84\x6c\x85\x0c\xf8\xec\x89\xd3\xed\x29\x45\x31\x1e\x6e\xcd\x05\x82\xa9\x6d\xd4\x76\x6a\xdc\xd6\xbc\x19\x69\xa3\x59\x4c\x8e\x38\x8d\x6e\xe9\xe4\xc7\x51\x3a\xd5\x26\x90\x87\x89\x80\x91\xfb\xb2\xcb\xd8\x2c\x79\x11\x08\x5b\xf2\x3d\xe5\xf1\xc6\xe0\xd8\x61\x55\xdb\xb2\x80\xba\x66\x3a\x90\xa1\xc6\xa6\x9b\x5a\x7c\x12\x67\x16\x73\xd1\xf5\xc1\xbc\x06\x83\x5d\x36\x5c\x07\x43\x2d\x0b\x78\xb4\xec\xfb\xd0\x88\x66\x71\xcb\x83\x59\xc2\xbd\x03\xf9\x96\x5c\x5d\x63\xb8\x0d\xd9\x85\x2b\x74\x4a\x76\xa8\xcc\xa8\x83\x68\x16\xdb\xdc\x37\x51\x68\x0c\x26\x6b\xaa\xeb\x07\x4d\x13\x68\x80\x62\xd2\xd0\x8a\x66\xf1\x86\xa9\x40\x95\xff\xcd\x47\xd9\xf7\xfc\xdf\x67\xc5\x31\xd0\x6f\x32\x60\xfe\x6a\x1b\x3e\xa1\xbb\x67\x4c\xff\x7a\x69\x6f\x8e\x2c\xb8\x80\x35\x8e\xc9\x5e\xd4\x1b\xd2\xed\x66\xba\x0b\xdf\xed\xc2\xd0\xa7\x6f\xb9\x29\x6a\xd8\x50\xba\xdf\xe4\x09\x49\x6f\xeb\xee\x05\xd3\x48\x65\x9c\x79\x70\x49\x7c\x2f\x05\xc6\x7e\x88\xaa\x16\x34\xc6\x2b\xd8\xec\x3d\x3e\xc4\x9f\x54\x8f\xf1\xfe\x03\x44\xfc\x96\x35\x7a\x9c\x7a\x18\x26\xcd\x9f\x06\x44\x9b\x47\xe0\xd0\xe6\xff\x06\x86\x86\x6b\xa1\x35\xfb\xfc\xf7\x9e\x92\xc7\x21\x3c\x72\x37\x42\x24\x7e\x04\x22\x8d\x8f\x1d\xb6\xf5\x90\x0b\xbd\x3f\xd0\xc8\xee\x11\x3c\x75\xa2\xe7\x70\xa2\xe3\xec\xde\x01\x1e\x04\x51\x9b\xa7\x20\xd4\x2e\x4e\xa7\x53\xd8\xc3\x18\xee\x14\x2f\xef\x9f\x00\x55\xb0\x0e\x9d\xc0\xbe\xfa\x79\x06\x44\xf8\xe0\x04\xa8\xd3\x3e\x34\x2d\xee\x09\x4a\x03\xe9\x3d\x9b\xb8\x8c\xe1\xc5\x98\x72\xff\x96\x5c\x58\x2a\x9d\x01\xa5\x57\x5b\x70\xb9\x8a\x87\xa7\x39\x7f\x66\x36\xd3\x8b\xbe\x5d\xa1\xda\xff\x5e\x12\x25\x46\x4d\x36\x71\xcb\xb6\xc0\x85\x9d\x43\xa5\x5e\x3b\xc1\xb9\x87\xfd\x0e\x4b\x23\xc5\x9a\xfe\x5a\xfa\x81\xa7\x42\xe8\x1a\xc6\xc5\xf8\xae\xe7\xbe\x14\x13\x12\xe4\xd1\xac\x64\x86\x8d\xc5\x7a\xaa\x31\xe4\xff\x30\xa5\x6b\x3a\x05\x07\x02\xc2\xe2\xea\x43\xa7\x99\x92\xbf\x63\x9b\x94\xcc\xb0\x34\xda\x45\xff\x33\x00\xcb\x22\x82\xb2\x75\x28\x00\x00")
+
+func apidocQuickstartGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocQuickstartGo,
+		"apidoc/quickstart.go",
+	)
+}
+
+func apidocQuickstartGo() (*asset, error) {
+	bytes, err := apidocQuickstartGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/quickstart.go", size: 10357, mode: os.FileMode(436), modTime: time.Unix(1792001188, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocReleasesGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4d\x6f\x1b\x37\x10\x3d\x2f\x7f\xc5\x60\x81\xc4\x52\xb2\xa0\xe2\xf6\x54\xa3\x3a\xe4\x52\x20\x0d\xda\x83\x03\xb4\x07\x41\x68\xe8\xe5\x50\x4b\x7b\x49\x0a\x24\xd7\xaa\x60\xe8\xbf\x17\xc3\xe5\x7e\xc9\x31\xd0\x83\x61\x2c\x39\x6f\xe6\xf1\xcd\xbc\xd1\x51\xd4\x4f\xe2\x80\x20\x8e\x5a\xba\x9a\x31\x6d\x8e\xce\x47\x58\xb1\xa2\x54\x26\x96\x6c\xcd\xd8\x66\x03\xf7\xd8\xa2\x08\x78\x2f\xec\x01\xa1\x71\xad\x0c\x10\x1b\x04\x9f\xbe\x9d\x82\xdf\xbb\xc7\x0e\x7c\x1f\x44\x57\x22\x42\x23\x9e\x11\x04\x81\x95\xa8\x85\x44\x78\x46\x1f\xb4\xb3\xe0\x3c\x18\x8c\x8d\x93\x15\x28\xef\x0c\x7c\xb1\xd1\x3b\xd9\xd5\x28\xa1\x3b\x42\x74\xf0\xd0\x45\xb0\x2e\x12\x54\xdb\xba\xed\xa4\xb6\x07\xb8\x47\xe3\x9e\x51\x72\x16\xcf\x47\x5c\xf2\x09\xd1\x77\x75\x84\x17\x56\x6c\x36\xf3\x6c\x13\x4f\xa5\x7d\x88\x03\x3f\x78\xb2\xee\x64\xa9\x50\xa2\xa8\x23\x4f\xc0\xbf\x1b\xb4\x89\xf9\x4d\x8f\x71\xad\xc4\x19\x48\xd3\x25\x42\xa3\x43\x74\xfe\x5c\x81\x8e\x60\xc4\x39\x21\x53\x9a\x07\x44\x0b\x7a\x2a\x8e\xc2\xb7\x1a\x3d\x67\xc5\x8c\x51\x88\x5e\xdb\x03\x4b\xa8\xfc\xa0\x37\x59\x0a\x15\xd1\xa7\xf3\x56\x84\x08\xce\x62\x82\x5d\x93\xaf\x48\x4f\x1d\x00\xcd\x31\x9e\x41\xab\x84\xb0\x78\x9a\x71\x4f\xb8\x25\x7f\x68\x44\xe8\x5f\x3e\xd0\xe8\xa9\xc1\xf7\xc7\xe0\xec\x5d\x59\x39\xa3\x63\x4a\x59\x7e\x67\x97\x34\x02\xdf\xfa\x00\x8f\xb1\xf3\x36\x80\x00\x89\xa1\xf6\xfa\x18\x53\x4f\xd5\x34\x0f\x14\x1c\xba\xba\x01\x11\xa0\xfc\x89\xff\xc2\x3f\x91\xd6\x0f\xa8\x9c\x47\xf8\x99\xdf\xf2\x4f\x25\x67\xaa\xb3\x35\xac\x3c\x7c\x98\x77\x72\x9d\x8b\xac\xd6\x03\x9d\x17\x56\x68\x05\x9e\x0f\x2c\xb7\x5b\x28\x4b\xea\x74\xd1\xf3\x00\x65\x22\xff\x76\xf4\xda\x46\xb5\x2a\xdf\x05\x10\x56\x42\x2b\x22\xfa\xb2\x02\xcf\x27\xe9\xd7\xac\xb8\xb0\xb7\x40\x13\xbd\x77\xe1\x0a\x57\x4d\xc5\xd7\x59\x88\xcc\x78\xd6\x38\xe9\xea\xce\xa0\x8d\x70\x40\x8b\x5e\x44\x94\xa0\x9c\x07\xb1\xb0\xc5\x72\x72\x97\x43\xfb\x57\xb6\xc6\x94\x72\x34\x4b\x16\xb6\x47\x55\x57\xc2\x96\x9c\x15\x03\x76\x3e\x5b\x5f\xac\x72\x3f\xe2\x47\xac\x66\xe9\xd2\x70\x2a\x07\x1f\x28\x3e\xbf\xee\xb3\x94\x99\x62\x32\x57\x80\x80\xb1\xa7\x94\x8f\x03\x28\x8d\xad\x04\xa7\x00\x45\xdd\x64\x77\x53\xd3\x49\xfb\xde\xd9\xa0\xc9\x0b\xca\xf5\x06\x27\xf0\x41\x3f\xa3\x1d\xa7\xcf\xa9\x81\x42\xa8\x08\x79\x6a\x74\xdd\x80\xe9\x42\x84\x07\x24\x70\xbe\x05\xe7\x25\xfa\x6a\xf0\x62\xb2\x31\x87\xdf\x52\xc5\x00\xc2\x23\x81\x8d\x88\x75\x83\x12\x1e\xce\x60\x85\xc1\x34\x02\x59\xbe\x6a\xc6\x29\x8c\x01\x27\x1d\x1b\x6d\x09\x1a\x1b\x34\xb3\x7c\xb3\x58\x5a\x04\x60\xdd\x48\x84\xfc\x22\x3c\x42\x8b\x2a\x2d\x26\xca\x91\xee\x49\x24\xce\x36\x1b\x3a\xfc\x9c\x17\xa2\x74\x18\xec\x0d\xf9\xaf\x76\x5e\x0e\x39\xc2\xe0\x41\xa3\xa5\x6c\x31\xed\x1a\x68\x45\xfd\x44\x50\x31\x6c\xc9\xd9\x76\x0c\x8e\x5c\xdf\xc7\x9d\x44\x00\x9f\x4d\x30\xce\x38\x01\x3d\xd2\x42\x42\x09\x3a\x64\x91\x45\x66\x91\x70\xb5\x23\x29\x52\xff\x0e\xe2\x38\xf8\x2e\xf5\x26\x75\x7d\xfd\xaa\xe1\xab\xa1\x49\xbb\x7d\x3e\x5f\x93\xe1\xd2\xf0\xf6\xdd\xfd\x8a\xe7\xd9\xf8\x16\x99\x78\xff\xef\x2b\x9e\x59\x51\xe4\x29\xc8\x23\x49\xce\xeb\x6f\xf3\x48\xdd\x6d\xc1\x88\x27\x5c\x19\x71\xdc\x8d\xb0\xfd\x72\x15\xb0\x9c\xe4\x07\x90\x91\xc5\x2b\xc8\x66\x03\xf8\x6f\x44\x2b\xc7\x35\x45\xc3\x43\x4e\xb4\x78\xca\xb2\x68\x05\x3a\xde\x04\xb0\xba\xad\x66\x08\x94\xb4\xa5\xfa\xdf\x9b\xd1\x20\xa0\x39\x2b\x72\xc6\xbb\x2d\x90\x78\xd7\x3b\xab\x02\x4d\x6b\x7f\xbd\x3c\x25\xc5\xd2\xe6\x82\xed\x96\x2a\xa5\xef\xc2\xc3\x16\xde\xcf\xc3\xd2\xe9\xec\x07\xe2\x6e\x70\xc8\x4e\xef\x79\x36\x76\x45\x31\x17\xd6\xff\xcd\x36\x21\x94\x65\x5f\x43\x7f\xbc\x85\x5f\xa1\x45\x3b\x74\x6e\x9d\xab\xcd\x62\xc7\xb4\x1f\x6f\xc7\xc4\x43\xca\x7e\x27\xfa\xb4\x20\x69\x41\xe8\x8a\x9e\x4f\x8a\xf7\x82\x65\x6c\x4a\x4a\xf7\xff\x54\xa0\xa6\x5b\x8f\x2d\xa7\x41\xe2\x83\x8b\x28\xac\x50\x4f\x78\xa6\x98\xb1\xbb\x2f\x8a\xff\x29\x0c\x56\xa0\x86\xf2\xf4\x9c\xc5\x58\xec\x08\xb4\x87\x6d\x6e\xc8\xea\xf5\x5d\x05\x7a\x9d\x50\x3d\x0b\x33\xb1\x50\xfc\x8f\x6c\xdc\x54\xbe\x30\xb9\xfe\x38\x2a\x2f\x94\xbc\x02\x93\x58\xa4\xd2\x8b\xf9\xda\x99\x65\xed\xd7\x77\x43\xed\xdc\x89\x41\xab\x89\x82\xbe\x16\x21\x89\xf4\x7e\x7e\xbc\xd3\x7b\xf6\xbf\xb5\x51\x3c\x4f\x4a\x80\x21\x36\xf3\xa1\xa7\xec\x73\x33\x1e\xdf\xd4\x60\xfc\xdc\x3d\xee\xe7\xa9\x96\x4f\xbb\xd2\x67\x01\x4a\x52\xed\x59\x51\x5c\x58\x71\x61\x17\xf6\xdf\x00\xc5\xed\x4b\x41\x2b\x0a\x00\x00")
+
+func apidocReleasesGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocReleasesGo,
+		"apidoc/releases.go",
+	)
+}
+
+func apidocReleasesGo() (*asset, error) {
+	bytes, err := apidocReleasesGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/releases.go", size: 2603, mode: os.FileMode(436), modTime: time.Unix(1791997171, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocRenderGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\x4b\x73\xdb\x38\x12\x3e\x93\xbf\xa2\x83\xaa\xb5\xc9\x98\x86\xee\xde\xd5\xc1\x71\x5e\xde\xd8\xa9\x54\xe4\xad\x3d\x24\x53\x09\x44\x36\x45\xd8\x24\xc0\xc1\xc3\x8e\x26\xf1\x7f\x9f\x6a\x10\x94\xa8\xc8\x4e\x3c\x17\x3d\x80\xfe\xfa\xf9\x75\xa3\x7b\x51\xde\x88\x15\x82\xe8\x65\xa5\xcb\x34\x95\x5d\xaf\x8d\x83\x2c\x4d\xd8\x72\xed\xd0\xb2\x34\x61\x75\xe7\xe8\x6b\xa5\x67\x95\x2e\xe9\x57\xe3\xba\x76\xe6\xb0\xeb\x5b\xe1\x90\x0e\xac\x33\x52\xad\x82\xb0\x57\xb2\xd4\x15\xb2\x94\x10\xd2\x35\x7e\xc9\x4b\xdd\xcd\x8c\x5e\xf5\xd8\xf7\x38\x13\xbd\x2c\x75\xd7\x0b\x37\xbb\xb6\x5a\xb9\x75\x4f\x36\xf2\x34\x9d\xcd\xe0\xaa\x91\x16\x6a\xd9\x22\x34\xba\xad\x2c\xf4\x46\x76\xd2\xc9\x5b\xb4\x60\x1b\x61\xb0\x82\xe5\x1a\x5c\x83\x60\x50\x55\x68\xd0\x58\xd0\x35\x01\xaf\xfd\xb5\x1f\x02\x00\xed\x5d\xef\x5d\x01\x56\x83\x6b\x84\x23\xf1\x35\x88\xb6\x85\xde\xa0\x45\xe5\xa0\xd2\xa5\xef\x50\x39\x4b\x40\xa9\x48\x00\xac\xe8\x10\xee\xc4\x9a\x07\x37\x5e\xea\xf2\xed\xd5\xe5\x45\xb4\x62\x41\xc0\x1b\x4d\x30\x28\x75\x47\x48\x10\x16\x48\xa0\x00\x6f\xa5\x5a\x6d\x34\x10\xb6\xd4\xea\x16\x95\x93\x5a\x59\x12\x5b\xe9\x4a\x97\x27\xd0\x0b\x23\x56\x46\xf4\x8d\x05\x61\x10\x2c\xd2\x81\x1b\xe2\x59\xb6\x42\xdd\x10\xb4\x95\x0a\x2d\x08\x55\x81\x54\x15\x2a\xba\x8e\x47\x06\xc9\xfb\x5a\x9b\x4e\x38\x87\x15\x4f\x6b\xaf\xca\xd1\xcd\xcc\xe1\x37\x07\x43\x01\x72\x18\x8b\xc2\xc9\x41\xf8\x9e\x26\xb7\xc2\xc0\xd2\xd7\x10\x8a\xc9\x5f\xf8\xba\x46\x93\x26\x95\x2e\xf9\x95\x26\x99\xec\x60\xe9\xeb\x02\x48\x49\x01\x4a\xb6\x79\x9a\x18\x74\xde\xa8\x5d\x55\xd9\xd2\xd7\x7c\x11\x8c\x64\x79\x9e\xde\x8f\x89\xba\x14\xe6\xa6\xd2\x77\xea\x97\xc9\x1a\x85\x38\x9c\xc7\xc8\x08\xbd\x6c\x75\x79\x63\x61\x89\xa5\xee\x10\x6a\x54\x25\x56\x40\xd4\x89\x37\xff\x06\xed\x1a\x34\xc1\x35\x90\x16\x7a\x61\xed\x80\x74\x8d\xd1\x7e\xd5\x50\x82\xb7\x99\xdd\x66\x65\x34\xb7\x9b\x99\xe1\x7b\x9a\x92\x48\x5a\xfe\xc2\xcb\xb6\xa2\xac\x48\x75\x46\xe6\x4f\xe6\x50\x8b\xd6\x62\x9a\xd4\xda\xc0\x97\x22\x94\x01\x4e\xe6\x60\x84\x5a\xe1\x06\xb6\xe8\x5b\xe9\xb2\xf7\x54\x95\x56\xfe\x85\x2f\x75\x19\x0c\xe6\x05\xb0\xcf\x8a\xe5\x64\x29\xd9\x54\xf2\x64\xbe\xc1\xbd\x15\xf6\x83\xc1\x5a\x7e\xcb\x48\x6f\x01\x0c\x58\x0e\x3f\x7e\x3c\x7e\xff\xd9\xb1\x3c\x4d\x12\x7b\x27\x5d\xd9\x04\xb5\xa5\xb0\xb8\x65\xc9\xc1\x01\x3c\x1b\x5c\x3f\x49\x93\x24\xa1\x4a\xfd\xdf\x48\x87\xb1\x5c\xec\xeb\xd7\xaf\xe4\x10\xdd\xc5\x08\xe7\xe0\x8c\xc7\x51\xd1\xb3\xa9\x26\xf2\x09\x9e\xcd\x81\x31\xfa\xf7\x4f\xd5\xc6\xbc\x25\xf7\x14\x7b\x1d\xe1\xc1\xe5\x24\x28\xde\x66\xe1\xca\xc8\xee\xc1\x30\xef\xd3\x7d\x5b\x84\xcd\x1f\x38\x0f\x89\x4e\xc9\xda\xae\xb1\xc7\x7d\xbd\xdf\xd0\x7b\xea\xc8\xc2\xd7\x54\x8f\x29\xc7\x63\x15\x23\xd3\xaf\xd6\x7d\xd4\x05\x03\x7c\x98\x0a\xc7\xad\xbc\x41\x30\x18\x67\x8b\xa0\xbe\x07\x5d\x83\x2b\x88\xa6\xdb\xe9\x50\xfb\xb6\x05\x25\x3a\xa4\x81\x15\x7e\x54\x10\x26\x1f\x87\x53\x6a\xba\xf0\x87\x20\xd2\x6e\xb5\x61\x45\x04\x67\x4a\x2b\x64\x91\xdc\x5b\x37\x32\x07\xcf\x37\xf3\x93\xd3\xf9\x94\xe1\xb2\x06\x07\xf3\x79\x50\x4d\xf9\x88\x21\x0f\xba\xc6\x7c\x39\xfe\x9e\xc6\xde\x50\xeb\x89\x94\x8d\x06\xc2\x75\x1e\xa4\x23\xf5\x1c\x7f\x27\x55\x45\x06\x02\x01\xb7\xf6\x3f\x38\x73\x32\x31\xf3\x9c\xc1\xd1\x8e\xaf\xfc\x55\x8b\x5d\xbe\x07\x5b\xb4\xb2\xc4\x29\xf0\xd3\x1f\x4f\x44\x9e\x1a\x23\xd6\x3b\x48\xce\xf9\x53\xc1\x97\xa2\x9f\x42\x3b\xd1\x7f\xda\x43\xbe\xc3\x75\x0e\x47\xc0\x9e\xaa\xf3\xac\x11\x6a\xaa\xb4\x6c\x84\x82\x27\x62\x17\xce\xf8\xd2\x4d\xd1\x36\x9c\x7c\xe7\x9c\xdf\xb3\x3d\xf1\x73\xe5\xd0\xd4\x62\x37\x73\x72\x3c\xfc\xfe\x00\xe2\xb5\x57\xe5\x54\x98\xb8\x94\x71\xce\x73\xb6\xdf\x10\x14\xbb\x54\xd5\x38\xe2\x17\x72\xa5\x84\xf3\x06\x27\xbc\xb7\x0d\x6d\x08\x23\xfb\xed\x46\x82\xa6\x25\xb1\xbd\x43\xd7\xe8\x2a\xb4\x80\xf5\x65\x18\xd3\xec\x42\xd6\x98\xd1\xb0\xee\x2c\x7f\xa5\x9c\x74\x12\x6d\x0e\xf1\x80\x2e\x3f\xa2\xf5\xad\xb3\x2c\x3e\xaa\x04\xd6\xaa\x1d\x9e\x7b\xaf\xfe\xf4\xa2\x95\xb5\xc4\xea\xe1\x2e\x1a\xba\x23\xeb\xe0\x32\x98\xce\xb7\x6e\x67\xd3\xb6\x18\x1c\xa7\xf1\x4e\x09\xf8\x65\x07\x85\x06\xa1\x39\x48\x2d\x74\x70\xb0\xdf\x2c\x63\xd6\x86\x8b\xf0\x91\x8d\xe3\x2b\x5e\x4d\x4b\x1f\xfb\x88\x5e\x91\x2e\x08\x13\xb9\x32\x16\x5a\xb5\xe3\x1f\x28\x0f\xa3\x35\xd2\x6e\xe1\x68\x0e\xc1\xdd\x2c\xde\x8e\x0a\x8e\xe6\xc0\xf2\x11\x37\x24\x6d\x0f\xc8\x02\xf3\x46\xf8\x20\xb4\x3b\xfb\x62\x75\xdf\xd0\x7a\xf2\xbf\x8f\x17\x9b\xe2\x52\xb6\xe9\xbf\xae\x43\xe2\xc7\x5d\x29\x0c\x36\x2a\xc9\x58\xe2\x6d\xf2\x63\xee\x47\x4d\x19\xdd\x4c\xa8\x47\x39\xa0\x70\xa7\xb9\x8d\x4e\xd4\x9d\xe3\x8b\xde\x48\xe5\xea\x8c\x35\xce\xf5\xf6\x64\x36\x0b\x0b\x13\xd7\x66\x35\xfb\x17\x71\x81\xb4\x8d\x54\x3c\x55\x65\xa3\xcd\x96\x87\x0a\x24\x3d\x5b\x44\x0b\x03\xd6\x4b\x27\x96\x2d\x06\x0f\xbd\x45\x10\xb4\x4a\x85\x35\x8d\xb0\xb2\x02\x6d\x42\x64\xb5\x11\x2b\x0a\xa9\x80\x4e\xd0\xaa\xb1\x86\x6b\x2d\xd5\x38\xa8\x57\xf2\x16\x15\xd1\xd2\x59\xb8\x93\xae\x21\x6c\xb3\xee\x1b\x54\x96\xc3\x59\x23\x8c\x28\x5d\x58\x3b\x87\xcd\x84\x9a\xbc\x45\x47\x47\x05\x54\x72\x25\x9d\x2d\x46\x79\x82\xd2\x32\xe7\x69\x55\xb5\xa5\x36\x71\x93\x33\xd8\xb7\x82\x56\x1d\xd2\xbf\x51\x3e\x64\x71\x08\x91\xfa\xc4\x59\xe0\x9c\xef\xef\x2e\x3f\x3d\x5e\x97\xa2\xcf\x08\x99\x19\x30\x5e\x61\x1e\x3e\x47\xfe\x1a\xf8\x0f\xc4\x6d\x9c\x5f\x8a\x6f\xa7\x8b\xb3\xf3\x73\x7a\xd4\xb3\xf1\xf0\xdc\x5e\x04\xe7\x33\x13\x16\x90\xed\xf1\x4b\x0a\x25\x9e\x1a\x7a\x48\x0e\x8f\x0f\xb7\xbf\xbf\x1c\xe6\x3b\x2d\x60\x76\x69\x7f\x78\x7c\x98\x26\xf7\xc5\xc6\xc7\xff\x6a\xa9\x86\x90\x0a\x60\xc7\x6c\xb3\x3d\xbe\x16\xa5\xa8\xf0\xa7\xaa\x52\x11\xc4\x70\x34\x72\x6d\xa8\xc9\x2d\x1a\x1b\x5f\x57\x01\x75\x80\x46\xea\x4d\xf5\x0c\xf4\x1b\x2c\x17\x1b\x8c\x54\xee\x81\x1c\x4e\x10\xc5\x84\x8c\x19\xbb\x65\x1b\xe8\xc6\xd9\x05\x31\x4d\x61\xfb\x5b\x77\xa9\xec\x36\x0a\x03\x1a\xa3\x4d\x20\xd2\x24\x12\x72\x31\xba\xbe\xab\x75\xea\xfc\xe3\xfe\xb2\xa0\xf4\xa7\xde\x18\xe6\xde\xaf\x9d\x13\x71\x30\x93\x87\xba\x7e\x52\x6a\xa7\x6a\xb3\xe1\xe6\xa1\xe4\x16\x51\xf3\x6f\x7d\x1f\x54\x3c\x9e\xed\x51\x51\x9e\xde\xa7\x7f\x0f\x00\xa2\x67\x31\x70\x99\x0e\x00\x00")
+
+func apidocRenderGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocRenderGo,
+		"apidoc/render.go",
+	)
+}
+
+func apidocRenderGo() (*asset, error) {
+	bytes, err := apidocRenderGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/render.go", size: 3737, mode: os.FileMode(436), modTime: time.Unix(1791999385, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocRequirementsGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\xc1\x8e\xdb\x36\x14\x3c\x8b\x5f\x31\x21\x50\xd4\x46\xb4\xf4\x7d\x0b\x1f\xda\x02\x29\xda\x20\x3d\x34\x45\x2f\x8b\x05\xc2\xa5\x9e\x2c\xd6\x12\xe9\xf2\x51\xd9\x1a\x8b\xfd\xf7\xe2\x51\xb2\x56\xce\xb6\xcd\xd1\xe2\x9b\x79\x33\xc3\xa1\x4f\xd6\x1d\xed\x81\x60\x4f\xbe\x89\x4e\x29\x3f\x9c\x62\xca\xd8\xa8\x4a\xb7\x43\xd6\xaa\xd2\x9c\x93\x0f\x07\xd6\x6a\xab\xd4\x6e\x87\xf7\x3e\x34\x8c\xd8\xe2\x37\xfa\x6b\xf4\x89\x06\x0a\xd9\x28\x17\x03\x17\xd4\x6e\xb7\x3e\x78\x47\x36\x8f\x89\xde\xf5\xf6\x00\xcf\x68\x63\x82\x45\x6b\x9d\x6d\x08\xb9\xb3\xf9\x5b\x46\xa2\x83\xe7\x4c\x89\x1a\x55\xed\x76\x88\xa1\x3f\xe3\xb1\xa3\x20\x83\x13\x1a\xed\x0c\xa7\x60\x1f\x7a\x6a\x0c\xfe\xb0\xfd\x48\xe8\x62\xdf\x30\x72\x47\x05\x28\x43\x35\xac\x2c\x19\x43\x03\x1f\xe4\x04\x1c\xc7\xe4\xa8\x06\x8f\xae\x93\x43\x3d\x73\x9a\x8f\xe4\x12\x65\xd6\x46\x55\xff\xa1\x77\xbf\x0c\xdf\x08\xb7\x56\x5f\x9a\x9b\x28\x7e\xb0\xee\x48\xb2\x50\x36\x27\xb8\x38\x5b\x43\x43\x27\x2a\x51\x85\x45\x63\x3e\x9f\x48\xa2\xe3\x82\xc4\xc3\x05\x1a\x30\x32\xbd\xb2\x75\x39\x5f\xa0\x2b\x1b\x9f\xed\xd8\x67\x8d\x98\xa0\x8f\xe3\x03\xa5\x40\x99\xbe\x34\x73\xad\x6f\x0f\x3d\xad\xbd\x99\x69\x5f\x1b\xfa\x10\x1b\xea\x7f\x17\x8d\x5f\x31\x53\xd4\x14\x74\x6c\xc5\x1c\x06\x41\xbe\x36\x50\x3e\x97\xe1\x5b\x68\x67\x2d\x6b\xa1\x2d\xc0\x72\x54\xc2\x79\xbf\xe8\x2f\x76\xfc\x65\x6c\x19\x69\x31\x58\xd7\xf9\x40\x7c\xed\xef\x45\xee\x1e\xba\x0c\xdf\xc8\xaa\x4b\x51\x57\x93\x48\xe4\x62\x6a\x18\x76\x36\x42\xc1\x9d\xe5\x22\x96\x32\xca\x3a\xca\x5d\x6c\x44\x91\x15\xf8\x7c\xf7\x17\x87\x2e\x86\x9c\x62\xdf\x53\x12\x95\x8b\xb9\x7a\xae\x5b\x47\x63\xf2\x9c\xbd\xb3\x7d\x7f\x86\x0f\xc2\xd0\xc5\xc7\x12\xce\xbc\xc2\xaf\xbb\x2e\x24\x73\x45\x5d\x0c\x8d\xcf\x3e\x06\xc9\xcc\x66\xf8\xcc\x25\x78\xa1\x70\x1d\xb9\x23\x1b\x25\xbe\xae\x0c\x71\x4e\xa3\xcb\x78\x2a\x59\xca\x93\x5c\xa5\x7e\x94\x9f\xb1\x45\x7a\x99\x5f\x9a\xf3\xbf\x1d\x36\xaa\x2a\x54\x98\x9e\xbc\xaa\xa6\xfb\x9c\x7f\x15\xe8\x8f\x22\x68\xb5\x6b\x7a\x5f\xab\x90\x26\x2b\x93\x93\x4b\xed\x57\x42\xf0\xb8\x7a\xa1\xd7\xaa\xf4\xdc\x4b\x23\x0d\xdc\x6c\xf1\x66\x8f\x97\x6a\x9b\x59\xa2\x9c\x69\x83\x9f\xe5\x9f\x83\x86\x53\x3e\x17\x55\xf2\xf0\x2e\xf7\x25\x6f\x95\x0d\xbe\x5f\x89\x19\xec\x19\xf4\xb7\xeb\xc7\x86\x6a\x24\x9b\x3b\x92\x1b\xb4\x61\x2d\xae\x2e\x76\x66\x92\x1a\x1c\xe1\x33\x86\x91\x33\x1e\x08\x89\x6c\x83\x1c\x91\xa9\xef\xf1\xd8\x79\xd7\x19\x55\x4d\x51\x4c\xe1\xe0\xd3\x9f\x1c\xc3\xad\xae\xe3\xe0\x73\xd1\xa5\x3f\xa9\x67\xa5\x5e\xf8\x25\x6c\xfe\x35\x66\x21\xcb\x63\x0a\xd2\xc5\x10\xf3\x12\xdd\xc1\x7f\xa6\x70\x35\x6c\x54\x3b\x06\xf7\x0a\xbf\x19\xf8\xc0\xf8\x40\xcc\xf6\x40\x5c\x23\x31\xee\xee\x57\x37\xba\xbd\x48\x7a\x52\x95\x2c\x60\xdc\xee\x31\xd8\x23\x6d\xee\xee\xa7\x93\x1a\x3d\x85\x4d\xe2\xed\x56\x55\x92\x9c\xaf\x91\x64\x28\xd9\x70\x20\xe1\x7b\x52\x55\x81\xca\x47\xd9\x66\x7e\xa2\xbc\xd1\x2b\x21\x37\xfa\x6d\x32\x52\x95\x1a\xc9\x94\x92\x6c\x55\x55\xf9\x16\xc9\x4c\xa9\xbc\xd9\x43\x6b\x69\xe7\x4c\xf4\x76\x8f\x76\xc8\xe6\xe3\x29\xf9\x90\xdb\x8d\xc6\xe6\x1b\xde\xea\xfa\x32\x2f\xe8\xe7\x79\x29\xdf\xf9\x7b\xec\x21\x30\x55\x3d\xab\x6a\x8a\xeb\xdf\x75\xb0\xae\x67\xb7\x6c\x7e\x89\x3e\x6c\x04\xc5\x35\xf4\x77\xd0\xdb\xad\x7a\x56\xff\x0c\x00\x24\x45\x88\xcd\xde\x06\x00\x00")
+
+func apidocRequirementsGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocRequirementsGo,
+		"apidoc/requirements.go",
+	)
+}
+
+func apidocRequirementsGo() (*asset, error) {
+	bytes, err := apidocRequirementsGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/requirements.go", size: 1758, mode: os.FileMode(436), modTime: time.Unix(1791999642, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocRpcGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x51\xcf\x6b\x2a\x31\x10\x3e\x9b\xbf\x62\xd8\xd3\x7b\xf0\xd4\xcb\xe3\x1d\xde\xad\x94\x16\xb6\x50\x10\x29\xbd\x94\x82\x31\x19\x35\xda\xcd\xa4\x99\x59\x45\xc4\xff\xbd\x64\x77\x2d\xa6\x6e\x69\xa1\x39\xe4\x30\xf3\xfd\x18\xbe\x2f\x68\xb3\xd1\x4b\x04\x1d\x9c\x25\xa3\x94\xab\x02\x45\x81\x5f\x6a\x50\xa0\x37\x64\x9d\x5f\x8e\xd7\x4c\xbe\x50\xbf\x95\x1a\x8f\x61\x8a\xaf\x35\xb2\xdc\x46\x5d\x21\xac\xe8\xc5\x32\x68\x88\xed\x10\x2a\x64\x6e\xc4\x18\x18\xbd\x80\x10\xc8\x0a\x13\xed\xae\x5e\xd7\x70\x35\x29\x81\x31\x6e\x31\x02\xa5\xcf\x09\xc3\x0e\xe7\x4c\x66\x83\x02\x86\xbc\x47\x23\x8e\xfc\x48\xc9\x3e\x60\x6e\xc5\x12\x6b\x23\x70\x50\x83\x6e\x5c\x5a\xa8\x9d\x97\x7f\x7f\xa1\x79\xb3\x74\xe4\xff\xa2\xbb\x64\xe8\x6c\x31\x53\x83\x87\x24\x93\xb6\xc0\x12\x9d\x5f\x66\xd0\xe4\x91\x40\x8f\x18\xd9\x91\x07\x00\xe7\x05\x00\x32\xd0\xb6\x5d\x26\x5c\x69\x01\x3e\x15\x73\xf6\x0f\x55\x4e\xb0\x0a\xb2\x2f\x66\xef\x47\xf6\x83\xbb\x23\x13\x6e\xa2\xa3\xae\x38\x2d\x9d\x17\x8c\x0b\x6d\xf0\x70\x3c\xe1\x42\xb3\xcc\x84\x8f\x5d\x09\x1c\xc8\x33\x7e\x6c\xa1\x9d\x5e\xd4\x30\xdf\xff\xac\x86\x73\xb3\xaf\x7a\x38\x7b\xbd\x95\xdc\xc4\x48\xf1\x22\xc5\x4b\x16\x26\x5c\x9e\x69\x43\xbd\x26\x8b\xdf\xa1\x0e\x0d\x59\xec\xe1\x97\x7e\x41\x50\xe9\xf0\xd4\x6a\x3c\xf7\xc4\xde\xf2\x9d\x5f\x50\xce\x3f\x05\x01\x90\x7c\x46\x53\xbd\xbb\xef\x82\xce\xfd\x4f\x3d\x64\xec\xa3\x7a\x1b\x00\xda\xcd\x89\x55\x6a\x03\x00\x00")
+
+func apidocRpcGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocRpcGo,
+		"apidoc/rpc.go",
+	)
+}
+
+func apidocRpcGo() (*asset, error) {
+	bytes, err := apidocRpcGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/rpc.go", size: 874, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocSampleGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\xdf\x6e\xdb\xb6\x17\xbe\xb6\x9e\xe2\x54\xbf\x1b\xeb\x57\x45\x91\xf3\xbf\x01\x3c\xa0\xc0\x5a\x60\xc3\xd0\x15\x4d\xb6\x8b\x79\x46\x41\x49\x47\x36\x1d\x89\x14\x48\x5a\x8d\x91\xfa\x0d\xf6\x00\x7b\x98\xdd\xed\x4d\xf6\x24\xc3\x21\x25\x5b\x96\x9d\xa4\xed\xed\xae\x12\x91\xe7\xef\xc7\xc3\xef\xa3\x2b\x96\xde\xb1\x19\x02\xab\x78\x26\x53\xcf\xe3\x65\x25\x95\x81\xa1\x37\xf0\x51\xa4\x32\xe3\x62\x76\x9c\x30\x8d\x17\x67\x7e\x77\x69\xa1\xa5\xa0\x05\x2d\x95\xb1\x7f\x8d\xe2\x62\xa6\x7d\x2f\xf0\xbc\xe3\x63\x28\xd9\xfd\x0d\x2b\xab\x02\xbf\xc7\xca\xcc\x61\x2e\x8b\x4c\x83\x99\x23\x6d\xf0\x72\x59\x82\x40\x6d\xb8\x98\x41\x66\xf7\x65\x4e\x4e\x0c\x6a\x56\x2c\x11\x2a\x25\xb3\x65\x8a\x19\x24\x2b\x70\x51\x22\x2f\x95\x42\x9b\x7e\xd8\x31\x8c\x62\x9b\xae\x90\x62\x76\x63\x2b\xf8\x09\x45\x27\x5b\x81\x62\x66\xc3\xbb\x2f\x29\x66\xa0\xad\x19\x39\x25\x72\x29\x32\xa6\x56\x07\xb2\xfe\xca\x14\x67\xc2\xe8\x36\xef\x6e\xfc\x31\x9c\xc5\xaf\x2e\x6c\x62\x57\x0d\x28\x34\x4b\x25\x34\x30\x01\x78\xef\x96\x5c\xd0\x54\x8a\x5c\xaa\x92\x3a\x35\x12\x34\xe8\x25\x37\x2c\x29\x90\x7c\x73\xa9\xa0\xc5\x13\x98\x86\x1f\x6f\x7e\x7e\x17\xc1\x6b\xa5\xd8\x8a\x22\x65\x50\xb2\x4a\xdb\x66\x80\x81\xe6\x62\x56\x20\x60\x81\x25\x0a\x43\xee\x64\xc1\x8a\x02\x64\xb2\xc0\xd4\x50\xf9\x15\x2a\xc3\x51\x03\x53\x84\x21\x6a\x14\x26\x82\x0f\x98\x2e\x95\xe6\x35\x82\x59\x55\xa8\xad\xa7\x42\x48\x97\x06\x64\x9e\xc3\x27\x6e\xe6\x20\x96\x45\xe1\x50\xd0\x91\x97\x2f\x45\x0a\x43\x0d\xff\xbf\x49\xe7\x58\xb2\xa0\x39\x82\x61\x86\xb9\xa6\x92\x26\x0e\xc1\xe9\x66\x9f\x0b\x83\x2a\x67\x29\x3e\xac\xe1\xc1\x1b\x38\x2c\x40\x47\x7a\xeb\x17\x82\xef\x87\x50\xb2\x3b\x1c\x76\x22\x24\x52\x16\x41\x08\x71\xe0\xad\xbd\xfd\xb4\xfa\xc9\xb4\x21\x08\x56\x62\x73\x9a\x21\xb0\xd4\x50\x8b\xbd\xe0\x61\x33\x5e\x5c\x98\xbd\x32\x79\x0e\x1a\xc6\x63\x10\xbc\x80\xcf\x9f\x1b\xc3\xef\xfa\x13\xf6\xe0\x0d\xda\x86\x04\x2f\xbc\xc1\xda\x39\x46\x1f\x30\x87\x17\x63\xf0\x7d\xea\x98\x96\x5c\x01\x13\xbb\x33\xb5\x8b\x3b\x7e\xe4\x38\xc8\x30\x87\xeb\x31\x10\x20\x4d\x95\x3a\xba\x55\xbc\x7c\xaf\x30\xe7\xf7\x43\xeb\x1b\x82\xff\xbf\xe3\x0c\x73\x2e\xb8\xe1\x52\xe8\x63\x3f\x98\x7a\x83\xc1\x6e\xf8\x31\x18\xb5\x44\x17\x11\x15\x64\x58\xa0\xc1\xa1\xb3\x09\x5d\x75\xc1\xb6\xf0\x0c\xf3\xdd\xb3\x20\xe4\x5a\xc8\x1a\x88\x02\xdb\x9a\xfe\xc4\x4d\x3a\x07\x1d\xdd\xae\x2a\xa4\x26\x52\xa6\x11\x7c\x3a\x27\x64\xc2\xbf\xde\xc6\x74\xf9\xdd\x36\x01\x3b\x43\xd5\xdd\x26\x76\x88\xde\x2d\xcb\x04\xd5\xd0\x1f\xf9\x41\x6b\x2a\xec\xd2\xe3\x96\xd1\xf9\xd6\xd6\x21\x64\x6d\x37\x75\xbd\x95\xaa\x64\x86\x2a\x6b\x8c\x32\x66\xf0\xc8\xf0\x12\xad\x5d\x1b\xd4\x3f\x89\xe3\x8b\xa3\x78\x74\x14\x9f\xdc\x8e\xce\xaf\xe3\xb3\xeb\xf8\xfc\x37\x7f\xe3\x94\xac\xcc\xae\xbd\xe3\xb7\xe8\xc6\x64\x6f\x9a\xfb\x18\xd9\x7f\xf0\x56\xba\x5b\x3f\x9c\x4c\xc9\x69\x48\xc8\x05\x41\x73\x9c\x3c\x77\x33\x38\xde\xcc\xc1\xc0\x7d\x6f\x6a\x6f\x0c\x9b\x2c\xb4\xd9\x36\xc7\xe8\x96\x77\x71\x98\x4c\x3b\xf3\xf9\xa0\xa3\x1f\x0c\x96\xfa\xd9\x63\x7b\x39\x0a\xd6\x6d\x48\xc7\x03\x36\xa6\x4c\x16\x34\x68\xfd\xfb\xd6\xc9\x40\x3d\x10\xff\x54\x54\x54\x08\x95\x26\x7b\xc5\xc4\x0c\x41\x47\xef\xb7\x54\x42\x48\x0f\x64\xb2\x98\x58\x43\x9a\xbc\xaa\x57\x55\x75\xb8\xac\x2d\x46\x3a\x7a\x9d\x65\x76\x98\x59\xd1\x89\xfc\xc2\x5d\xbd\x4d\x02\xff\x0e\x57\x3e\x25\x38\x6c\xff\x25\x50\xec\xc2\x2d\x93\x85\x1d\xea\xe6\x93\xae\xe1\xda\x72\x76\x4b\xee\x5b\xd6\x06\x8d\xc4\x86\x0d\x05\xee\x91\xb6\x99\x33\x03\x78\x8f\x2a\xe5\x1a\x77\x94\x83\x90\xd7\xd7\x96\x3f\x43\xc0\xb2\x32\x2b\x4b\xdc\x2c\x21\xee\x6d\xc2\x85\x5d\xdd\xd1\x21\xf9\xe3\xbd\x51\x58\x22\xb8\xeb\xe0\xc8\x5e\x4b\x90\x22\x82\x37\x2c\x9d\x43\xed\x2a\x84\x8c\xe7\x39\xed\xe7\x4a\x96\x56\xc1\x6c\x44\x8a\xe0\x4a\xef\x4a\x24\x70\x01\x52\x14\x2b\x90\x02\xa1\x2a\x58\x8a\x07\xa8\xbc\x6d\xfd\x71\x32\x9f\x4c\x1f\xa3\xf3\xa6\x28\xfd\xcd\x84\x5e\x3f\x93\xfc\xeb\x29\x7d\x32\xfd\x4f\x93\x7a\xef\x44\x0e\x5c\x0a\x47\xeb\xb5\xbd\xdd\x3b\x60\x3d\x08\x5e\x7c\x19\xe1\xd7\x1a\xc6\xc0\xaa\x0a\x45\x36\xac\x75\x08\x39\x2b\x34\x06\x87\xb8\xbf\x67\x49\x17\x7b\x87\xdd\x63\x3f\xd8\x5f\x3c\x1a\x1d\x5a\x7d\x75\x72\x72\x7a\x7a\x79\x12\x9f\x5e\x5c\x9d\x9f\x5d\x5e\x9e\x5f\xc5\x97\x07\x9d\xf7\xed\xae\x9c\xdd\x21\xc5\xf9\xc6\xfa\xf0\x34\xbe\x3a\xb4\x33\xc2\xd3\xbd\x6c\x1d\xcd\xe2\xf9\x56\xaf\x3a\x0a\xd1\x2b\xc2\x5e\xa3\x76\x6c\x3e\x60\x85\xcc\x0c\xfd\x7b\x3f\xdc\x7d\x71\x06\x21\xf8\x7f\xff\xf5\xcf\x1f\x7f\xfe\xfe\x4b\x1c\xc7\xa3\xb7\x17\x71\xec\x37\x6c\xd7\x97\x13\x7a\x20\xd2\x69\x7f\x85\x82\xec\x43\xb3\x7b\xb1\x1e\xd6\xfd\x05\x4a\x12\xda\xb7\xe8\x46\x48\x3e\x86\x80\x75\x57\x44\x5c\xfa\xe7\x47\xf4\xe5\x28\x38\x8c\x4c\x2f\x65\xbd\xde\xe9\xb8\xa3\x76\x7d\xc7\x0e\x63\x74\x23\x58\x7f\x52\x7a\xaa\xf2\x79\x60\xc8\x5a\x26\x8b\x10\x3e\x92\x3d\xf9\x45\x4f\x68\xa9\x55\x41\xbd\x11\xdd\xc9\xb4\x65\xb1\x38\x84\x02\xc5\xb0\x2b\xaa\xc1\x8e\xf8\x3e\x25\xbc\x4d\xd4\x4d\x77\xee\xbb\xd1\xdc\x06\x8e\x01\xfd\x02\x8b\xdc\xa8\xe8\xa1\xdd\xd1\x9d\x43\xe9\xe5\x68\x22\x5a\xc0\x5b\x8b\xfa\x70\x09\x8d\xe4\xf7\x8f\xf0\x11\xbd\x77\x05\xef\x1d\x22\xfd\xbc\x68\x42\xae\x86\x16\xce\xc6\xbf\xaa\x2d\x0c\x74\xa0\x83\x75\x57\xa7\x6b\xdd\xc8\x74\xd7\xb5\x23\xd5\xa9\xac\x56\xa4\xd5\xf4\xc6\x21\x13\xab\x8b\x33\x5e\xa3\x20\x5d\x6c\x7e\x05\xad\xac\xa2\x1b\x09\x75\x23\x82\xfd\x3a\x1e\x19\x92\x9e\x04\xd5\xdd\x1f\x0d\xc1\x23\x3e\xd4\xb9\x4c\x16\xa3\x67\x5e\x5c\x6e\x0e\x64\xb2\xa0\xbe\x09\xfa\xbb\x10\x3a\xc8\x53\x3b\x04\x21\x45\x9a\xdc\xd1\x3b\xa8\xb6\xa8\xd8\xef\xf6\xed\x55\x6f\x50\x92\xc9\x62\xe4\xad\xbd\x7f\x07\x00\xe7\xb6\xcd\x81\xbd\x0f\x00\x00")
+
+func apidocSampleGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocSampleGo,
+		"apidoc/sample.go",
+	)
+}
+
+func apidocSampleGo() (*asset, error) {
+	bytes, err := apidocSampleGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/sample.go", size: 4029, mode: os.FileMode(436), modTime: time.Unix(1791996688, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocSchemaGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x5a\xdd\x93\xdb\x36\x92\x7f\xa6\xfe\x8a\x36\x77\x33\x16\x63\x86\x72\x7c\x2e\x57\x4e\xce\x6c\x95\x37\x1f\x7b\xde\xbb\x78\x5d\xf1\xf8\xf6\x41\x35\x95\xc5\x90\x4d\x09\x1e\x12\x64\x00\x48\xe3\x29\x7b\xfe\xf7\xab\x6e\x00\xfc\x12\x35\x9e\xcd\xd9\x0f\x23\x91\x8d\x46\x77\xe3\xd7\x9f\x50\x2b\xf2\x6b\xb1\x45\x10\xad\x2c\x9a\x7c\xb1\x90\x75\xdb\x68\x0b\xcb\x45\x14\x5f\xdd\x5a\x34\xf1\x22\x8a\x51\xe5\x4d\x21\xd5\x76\xf5\xc1\x34\x8a\x1e\x94\xb5\xa5\x3f\xad\xb0\x3b\xfa\xab\xb1\xac\x30\xe7\x47\xa6\xd1\xee\xaf\xd5\x52\x6d\x4d\xbc\x58\x44\xf1\x56\xda\xdd\xfe\x2a\xcb\x9b\x7a\xa5\x9b\x6d\x8b\x6d\x8b\x2b\xd1\xca\xbc\xa9\x5b\x61\x99\xa7\xbd\x6d\xdd\x4e\xdb\xa6\xbd\xde\x66\x52\xad\x50\xeb\x6d\x93\x1d\x9e\xad\xca\xda\xd2\x97\x46\x9b\x78\x91\x2c\x16\xab\x15\xbc\xcb\x77\x58\x0b\xd8\x35\x55\x61\x40\xc0\xdf\xdf\xfd\xe3\x4d\x78\x56\xa0\xc9\xb5\xbc\x92\x6a\x0b\x76\x87\x70\x23\x35\x42\xd9\xe8\x5a\x58\x68\x4a\x10\x40\xfb\x64\xc4\xe3\x1f\xaa\xba\x65\x12\xb3\xbf\x32\xc8\x6f\x87\x7c\x14\x62\x81\x05\xd8\x26\x70\x44\xf8\x5b\xc3\xab\x0d\xad\x66\x7b\x60\x01\x37\xd2\xee\x60\x64\x1c\x90\x06\xcc\xbe\x25\x13\x62\x91\x2d\x68\x45\xe0\x69\xac\xde\xe7\x16\x3e\x2d\x22\xff\x60\xfc\xcf\x19\x2c\x7c\xe3\xff\xff\x22\xd3\xac\xe3\x3f\x1b\xa6\x4f\x9b\x5a\x5a\xac\x5b\x7b\x1b\xff\x6b\x11\xfd\x8a\x65\x20\x7b\x10\x0f\x8d\xe5\x98\xc1\x85\xb4\x15\x06\xaa\x2f\x33\xb0\x44\x3e\xe1\x40\xca\xfd\x1b\x1c\x6e\xdb\x09\x83\x9f\xdd\xc9\x3c\x94\x81\x3b\xc8\x31\x8b\x1f\xf9\x78\x5a\x2b\x1b\xf5\x10\x16\x45\x4f\x3e\xe6\xf3\x56\x37\x2d\x6a\x2b\xd1\x84\x15\x00\x50\x8b\x76\xe3\x78\x5d\x7e\xed\xcf\xcc\xf3\x69\x3b\xf2\x31\x9b\x5f\xf1\xf7\xbd\xd4\x58\x04\x0e\xfc\x7f\x73\x79\x24\x90\x67\xa3\x3d\xf9\x98\xc9\x6b\x8b\xf5\x50\x0c\xfe\x1f\x04\x38\x66\x42\x06\x35\x63\x0e\xaf\x8a\x42\x92\x49\x44\x35\xd0\xeb\x34\x07\x31\x43\x3e\x66\xf8\x23\x96\x52\x31\x8d\x79\x88\x79\x8a\x9e\x7c\xc4\xe7\x8e\xfd\xf7\x67\x89\x55\xf1\x4f\xa9\xf1\x8d\xa8\x11\x34\xda\xbd\x56\x86\x9d\x51\xd1\x03\xbb\x13\x76\xe2\x54\x7b\x83\x86\xfc\x98\x56\x13\xdd\x56\x1e\x50\x05\x87\x2a\x89\x1d\x08\x55\x80\x46\xf2\x3b\x03\x37\x3b\xb4\x3b\xd4\xcc\x92\xdf\xd2\xba\x9d\x70\x7b\x74\xf2\x40\xc3\x38\xc8\xe0\xb5\x1d\x09\xe1\x5e\xba\x23\xa3\x85\xb2\xec\x19\x91\x7b\xab\xc6\x8b\x87\x05\x08\x0b\xa2\xaa\xb2\x45\xb9\x57\xf9\x58\xaf\x65\x09\x5f\x77\x91\x2d\xe3\x57\x09\x2c\x59\x41\xc7\x3a\x65\x49\x7e\xe2\xcd\xae\x9a\xa6\x4a\x28\x32\x58\xb1\x85\xf5\x39\xf8\x78\x9a\xbd\x63\x0d\x2f\xc4\x76\x59\x66\x17\x62\x9b\x64\x7f\x43\xbb\x8c\x89\x6d\x9c\x2c\x22\x12\x4c\x6c\xe1\xfc\x1c\xe2\x6f\x62\x5a\x1d\x39\x35\x20\x8e\x53\x28\x45\x65\x70\x11\xdd\x2d\xa2\x56\x90\x4d\xd6\xe7\x5e\x25\x93\xbd\x6b\x2b\x69\x97\x56\x6c\x53\x88\x53\x62\xc4\x52\x9d\x03\x13\x6e\x9e\x5e\x2e\xa2\xb2\xd1\xf0\x5b\x4a\x06\x62\x69\x84\xda\xa2\x7f\xfb\xed\xfa\x92\x77\x92\x25\xbf\xa5\xbd\xfb\x03\xe6\x37\x51\xaf\xd6\x39\x58\xbd\xc7\x45\x44\x52\xdc\xb1\xbc\x6e\xa7\x73\x88\x9d\xbc\x7e\xe3\x32\x23\x24\x30\x8d\xd7\x80\x5e\x0c\x0c\xe4\x81\xd3\xa3\x70\x1e\x39\x7b\x83\x05\xc1\x64\x80\x11\x62\x54\x70\xe0\x06\xa9\x02\x7c\x06\xf0\xa4\xc0\x2f\xc0\x05\x58\xcf\x10\x0b\xb8\xba\x85\xd7\xaa\x6c\x32\xe7\x31\x8c\x10\xd9\xef\x43\x6c\x1a\x07\x0a\x66\xfc\xfb\x5e\x54\xb2\x94\x6e\x5d\x27\x4c\x53\x82\xb4\x06\x7c\x7a\x4d\x59\x2e\xfc\x28\xea\xb6\x62\x06\x71\x2b\xb4\xa8\x4d\xf6\x93\xb2\x92\xdc\x33\xce\xe0\x9f\x3b\xd4\x08\x42\x35\x0e\xbc\xc4\x5a\x2a\x90\x24\xc8\xc5\x6d\x8b\x24\x11\xdc\x34\xfb\xaa\x80\x2d\xda\xa0\x8b\x21\xc5\x69\xc3\x14\x08\xe0\x94\xa1\xa0\xd4\x4d\x1d\x36\x36\x2e\x49\x8d\x48\x21\x17\x2a\x05\x14\xf9\x8e\xb8\x48\x33\xd6\x40\x18\xa8\x85\xba\x05\xab\x85\xac\x28\x25\x61\x85\x35\x2a\x6b\x26\x2a\x01\x65\x7f\x10\x9c\x10\xa5\x05\x2b\xae\xd1\x50\xc6\xb4\x58\x55\x64\x9c\x1a\x04\x61\x86\xe5\x92\x0a\xe2\xbc\xd1\x98\x29\xb4\x37\x8d\xbe\xce\x5e\x15\x85\x46\x63\x62\xf2\x5b\x62\x10\x7f\xd8\x7f\xd8\x1f\xbd\xf5\x8e\xb5\x24\x1b\xc0\xd7\x64\x80\x64\x02\x02\xe7\x51\xbd\xa3\x91\xa1\x08\x1c\x89\x47\x3b\x7c\xea\x70\x97\xbd\xbd\xde\xbe\x15\x76\xb7\x4c\x06\x08\x1c\xe0\x8d\x41\xb8\x4c\x18\x86\xed\xf5\x96\xbe\x11\xfa\xbd\xb6\xdd\x66\x3d\x9b\x64\x11\x6d\x75\xb3\x6f\x89\x6a\x73\x79\x2c\xc3\x27\x92\xcd\xe1\x7e\x7c\x88\x8f\xce\x41\xc9\x8a\xf7\x27\x54\xb8\xf3\xee\x1c\x6d\x44\xcb\x1f\x0c\x93\x12\x1f\x47\x4a\xeb\x49\xba\xb3\x33\xf7\xc0\x4b\x4e\x6a\x0d\x14\xe9\x5f\xf7\x8a\x3f\x62\xc5\xcf\xce\x46\x5a\x4d\x88\xd8\x3c\xc1\x00\xbc\xb1\x57\xf3\x1c\x44\xdb\xa2\x2a\x96\xfc\x35\x75\x7b\x27\x44\x70\x37\xf4\xf1\x0a\x95\xa3\x60\x46\xdf\x0e\xcd\x1c\xb8\x3e\x81\x38\x8b\xe1\xc9\x91\xd9\x3d\x59\x07\x47\x7a\x65\x3c\xb3\x0d\x11\x5f\xce\x46\x02\x33\x0a\x05\xe3\x77\xd0\x94\x84\x2f\x3c\xa0\xbe\x1d\x47\x83\xf1\xa1\x7c\x19\x6a\x66\x99\x70\xca\x3b\x3e\xe8\x90\xdf\x3f\xb9\x50\xca\xc1\xb6\x16\xd7\xb8\xbc\x97\x3c\x99\x41\xc6\x79\x8f\x0c\x6f\x0b\x66\xc8\xc6\x61\x33\x8c\x79\xfb\xec\x3b\x07\xbe\xc4\x45\x71\xe5\x51\x7c\x3f\xb4\xee\xf3\x10\xa7\x92\xb3\x3e\x8c\x00\x46\x27\x9f\x37\xca\x4a\x15\x82\x7c\x64\x76\xd4\x3b\xdc\xef\x35\xf3\xa7\xef\x30\x66\x36\xcc\xe1\x72\x82\xb5\xf0\x38\xe5\x35\xce\x45\x49\x3b\x7e\x9a\x42\xe7\x85\x4e\x4b\x6f\x28\xaf\xd8\x1c\x1c\xbd\x4e\xfc\x78\xf3\xf4\x92\xb6\x63\x56\x33\x1a\x05\x23\xa6\x83\x20\xd9\xed\x34\x0b\xd4\x39\xab\x75\x84\x9d\xa3\x8c\x8e\xd7\x81\x7a\xcc\xad\xc3\x34\xf1\x30\x93\xb4\xc6\x67\x9d\xc2\xcd\x4e\xe6\x3b\x97\x13\x88\x01\x3b\xe4\x8d\x34\x48\x09\xa2\x0f\xf9\x63\x18\xbb\xc0\x7f\x94\xb4\x68\x7d\x89\x37\x68\xec\x03\x03\x3f\x97\x69\x5d\xac\xa7\xe5\x1c\xee\xbd\x1f\x8d\x75\x71\x96\x99\x0d\x92\x0f\xf0\x29\x52\x5d\x11\x88\x9f\xbd\x84\x97\xa0\x9e\x3c\xe9\x6a\x87\x87\x7b\x5a\x64\x10\xd5\x9c\xef\x70\xe9\xb5\x88\xa2\xbd\x92\xbf\xef\x91\x4c\xda\x54\x48\x84\x54\xba\xa4\xa1\x80\xf1\x05\xd1\xd8\x9b\x9c\x52\x24\x4b\x44\x96\x9a\xa9\xb1\xc6\xc8\x4f\x21\x5e\x51\xb9\xc5\xb0\x54\xf0\x3d\x43\x93\x57\x26\x3e\xce\xf2\x17\x38\x07\xfe\xbb\xe9\x5f\x7f\xa3\xd6\x97\xb4\x2e\x72\xd2\x9d\x87\xea\x8e\xc1\x14\x45\xfd\x59\x0e\x24\xf8\x7b\x23\xfd\xf2\x94\xfc\xed\x94\xdb\x79\xc5\xe1\x1c\xfc\x87\xb3\x33\x78\x44\xc6\xda\x74\x5c\x79\xeb\xc9\xa3\xbe\xb6\xbb\x1f\xe9\xa4\xaa\x67\xfc\xf9\xb3\x37\x2e\xeb\x3a\x0e\x6f\x44\x7a\xe7\x63\xfb\x20\x74\x9c\x68\x0e\x08\xd9\x01\x8b\x5d\x75\xc3\x8e\x41\x30\x64\x74\x4a\x03\x79\xa3\x0e\xa8\x08\xf6\xa2\xaa\x6e\xa1\xc0\xbc\x12\xda\x37\xed\x6b\x5e\x52\x09\x63\x03\xc8\x7d\x45\xd7\x31\x20\xaa\x66\x6f\x81\x2a\xa1\x5a\x7c\x68\x34\x1c\x50\x1b\x6a\x33\xcd\xbe\x2c\xe5\xc7\x14\xcc\x3e\xdf\x81\x6f\x2a\xe2\xd5\xe1\xbb\xd8\xa7\x9a\xe1\xb8\x83\xaa\x9a\x55\xbe\x13\xba\x76\x04\xce\x87\xe3\xec\xf0\xac\xa7\x0e\x13\x0f\xa6\x65\x63\xd2\x6b\xef\x47\x03\x63\x2c\x5b\x07\x24\x7f\xc2\xc3\x0a\xa7\x90\x3a\x85\x2b\x61\x7c\xc1\x62\x77\x1e\x7f\x7e\x85\xcb\x34\x85\xd4\x7d\xfe\x97\xe6\x17\x52\xea\x7f\x9d\x4e\x4b\x5a\xeb\x40\x48\x9f\xc0\x33\xf9\xab\x30\xb8\x2c\xa4\x4e\x42\x6a\x0f\xd8\xfa\x2f\x61\xde\x6a\x2c\xe5\xc7\x20\x53\x3a\xd0\x23\x4e\x42\x4e\x91\x43\x3c\xfe\x8f\x30\xf6\xb5\x2a\xf0\x23\x6f\x46\x98\x3c\xc4\xc9\x4b\x90\xf0\x17\x78\x7a\x42\xa2\x8d\x7c\xf2\xed\xfa\xd2\x3b\x87\x17\x8c\xfe\x6c\xd6\xf2\x72\x1a\x48\xe9\xb9\x07\xd0\x98\xd3\x51\x57\x68\xa8\x89\x13\xee\x50\xe9\x04\xc6\xe7\x4a\x38\x10\x1d\xba\xc8\x0c\xfd\x49\xc7\x87\xef\xc2\xb9\x4c\xa4\x35\x5e\xcd\x04\x28\x9e\xf8\xa2\x93\xfc\xd7\x24\xf0\x3d\x3c\x83\xcf\x9f\xc1\x6c\x9e\x5e\x92\xfd\x1f\x1f\x1e\x0f\xf3\x7b\xdf\xa7\xf9\x18\x33\xa8\x04\x47\xed\x96\x86\xef\xe1\xf1\xd3\xc7\xc4\x4a\xc3\x5f\xe0\xf1\x7f\x3a\x36\x13\x3e\x63\xa3\x70\xf4\xba\x1b\x0e\xcd\x82\x43\x75\x3d\xcf\x71\x5e\xc9\xe0\x4d\x57\x26\x71\x71\x2f\x34\x52\x47\x8a\x9a\xbc\xc7\x36\xae\xa1\xf8\xb3\xc6\x12\x2a\xa9\xae\x0d\x48\x65\x9b\x69\x5f\x45\xeb\x86\xed\x94\xdb\xbe\xcf\x44\x66\xae\xde\x72\x44\x4b\x3b\xec\x9c\x29\x94\x27\x10\xe6\x0b\x9f\x3a\xd5\x68\x5d\xe6\x74\x58\x2a\x59\xa5\x60\x13\x7f\xfc\x5e\x31\x69\xa0\x92\xd7\xe8\x99\xa6\x70\xb5\xef\x7a\x14\x1f\x4c\xb8\xa1\xe9\x4b\x42\xd6\x95\x5b\x27\x7a\x66\xb8\x6f\x19\xaa\x30\x4e\xa3\x86\x52\x05\x2a\xd2\xfb\xf6\xb1\x0e\xdd\xa5\xb4\x21\x29\x1b\x71\x40\x03\x37\x8d\xbe\xf6\xc3\xc8\x1a\x28\x9c\x90\xb9\x39\xff\xb2\x41\x51\xe5\x38\x67\x88\xa0\x17\xc9\x71\x7f\x7e\x4c\xe1\x7e\x6b\xd1\x8c\x60\xae\xaa\x94\x55\xf0\x69\x9b\xbd\x19\x65\x29\x78\x34\x6a\xcf\x53\x68\xae\x09\x91\x6c\x93\x8d\x23\x26\xe7\x93\x25\x3c\x6a\xae\xfb\x5a\x07\xce\x5d\x3d\x3d\xb6\xd2\xd2\x2d\x48\x7c\x32\xf0\xbb\x9f\x39\xf9\x78\xed\xaf\x58\xae\x21\xfe\xd3\x6a\x80\x9d\x55\xc8\x52\xe9\x14\xcf\xbc\x03\x9d\x95\x47\x8a\x3f\xa8\xee\xe8\x8f\x50\xd6\x25\x10\x67\x51\x57\x46\x09\x57\xb3\x30\x06\x0a\x3a\x35\xe2\x68\x8e\x3a\x82\x14\xae\xf1\x76\xee\xe8\x4f\x43\x77\xb0\xb3\x6f\x16\x7c\x95\x31\x38\x91\x02\xcb\xd9\x22\xde\x93\x3c\xac\x2b\x20\x26\x6c\x98\xae\x0a\x9a\x31\xbe\x59\x0e\x9a\x00\x02\x4a\x17\x58\x4e\xf5\x01\xc4\x76\x33\xc8\xe7\x97\x70\x7e\x8f\xcd\x07\x07\x43\x0b\xe9\x0c\x8e\x0d\x33\x5d\xf9\xff\x87\x73\xf6\xdf\x52\x15\xbe\x41\xf9\xfc\x79\xf0\xbd\x5f\xf7\x5e\x5d\xab\xe6\x46\xd1\x8a\x68\xb5\x82\x57\xbd\xb7\xd1\x80\xc2\x5d\x03\xb8\x52\xe2\x46\x18\xf5\x98\xe6\x7f\x79\xa3\x0b\x2c\x5e\xba\x05\x37\x08\xc4\x00\x68\x14\x43\xee\x2b\xae\xc8\x77\xa5\xcd\x8e\x31\xdc\xb5\xbd\x36\xfb\x05\xed\xae\x29\xcc\x26\xfe\x45\x68\xb3\x13\x15\x5d\x28\xc4\x97\xa1\xdb\xff\xfc\x79\x86\xe4\x02\x3f\xda\x9e\x84\xc4\x35\x37\xd2\xe6\x3b\xef\x95\xac\x40\x4e\x89\x2f\xb6\xb2\xc6\x3f\x5d\xc8\x1a\xe3\xf5\x22\x3a\x92\x82\x1e\x45\x74\x8e\x6b\x00\xf0\x77\x2f\x31\xb9\x4f\xe4\x87\xec\x6b\x88\x0b\x61\xf1\x1b\x62\x13\xa7\x83\x9e\x7d\x5e\xf4\x63\xb9\xee\xd9\x71\xb4\x5f\x60\xbb\x5a\xc1\x45\x18\x90\xd1\xe8\x55\x40\xbe\x37\xb6\xa9\xbb\xc9\xae\xb7\x3f\x42\x2e\xd4\x63\xeb\xec\x5e\xa0\x45\x5d\x4b\x85\x60\xac\xb0\x32\xa7\xc2\xed\xa4\xcd\x3b\x4b\x31\x1e\x3e\x2d\x9c\xa1\x7a\x10\xfc\xb5\x69\xaa\xf5\xf1\x62\x2f\x33\xe5\x69\x14\x2a\xbe\x3b\x5a\xf7\x5a\xd9\x74\xfc\xf5\xbb\xc9\xf7\x6f\x5f\x4c\x1e\xfc\xc7\xb3\xc9\x83\x17\xcf\xc9\x18\xfd\xa3\xf7\x72\xcc\xf4\xbd\x9c\x70\x7d\x2f\xa7\x6c\xdf\xcb\x29\x5f\x7a\xf2\xe2\xf9\xf4\x49\x6b\xf5\x69\x2d\xa5\xb2\xb8\x45\x3d\xa3\xe5\xcf\x55\x23\x26\xfc\xf9\xd1\x8b\xe7\xa7\xb9\xa9\x7d\x7d\x35\xcb\xec\x1d\x03\xe0\xf4\x42\x0f\x90\xe3\x85\x6f\x47\xc2\x8f\x72\xba\x0f\x33\xd9\x4f\x15\xd6\xc9\xd1\xc2\x57\x5a\x8b\xdb\xa1\xf0\xef\x2a\x99\xe3\x7a\x11\x8d\x43\xc4\xe4\x3d\xd5\x99\x8e\xe3\x5c\xd0\xa0\x33\x61\x8f\x8b\x56\xab\xc9\x05\x04\x7f\x43\x03\x74\x23\x0a\x86\xb6\x32\x20\x0c\x97\xdc\x2f\x9e\x67\x7f\xd0\x21\x89\xd9\xd8\x69\x66\x98\x78\x1e\xb1\x20\x85\x1d\x35\xdf\x0f\xad\xef\xb1\x96\xcf\x9b\x13\x93\xfd\x22\xda\xf5\xbd\x7b\x4c\xfe\xc5\xcd\xd5\x07\xcc\xad\xdb\x73\xee\x46\xe9\xdf\x17\xc1\xdd\x67\x90\x14\x9c\xb3\x4e\xc9\x30\xda\x79\xb8\xdf\xa9\x9c\xe9\x77\x8b\x58\x1e\x51\x14\x7c\xdd\xe2\x98\x9b\x20\x98\xcf\x59\x41\x7f\x73\x24\xdc\x6b\x65\x51\x97\x22\xc7\x19\x2b\xb9\x28\xbf\x5a\xc1\x0f\x3b\xa1\x14\x56\x26\x05\x4a\x75\x64\x11\x43\x23\x6c\xae\x27\xb8\xdc\xcc\x85\xa2\x0b\xa2\x2b\x0c\x77\x44\xd9\x62\xca\x6c\x11\x0d\xaf\x2d\xd7\x10\xef\x15\xd3\x8a\xab\x0a\x81\x6a\x1f\x07\x97\xa5\xcb\x6c\x49\xea\x5b\xe3\xe3\xdc\x3a\xab\xe9\x97\x12\x6c\x77\x11\x38\x9b\x6b\xfd\xb8\xe5\xb7\x14\xca\xbe\x5c\xb0\xee\xfe\xca\x0c\x8a\xc2\xdf\xe8\xed\xe4\xc2\x2b\xe9\x27\x89\xc3\xf9\xe1\x64\xa0\x26\x4b\x28\xb3\x57\xaa\x51\xb7\x75\xb3\x37\xe4\x91\x0f\xb9\xed\x1a\x32\x0c\x57\x88\x5c\xbf\x63\x7d\x85\x05\xdd\xd5\x1b\xc6\x96\xe1\x6e\xa5\xd5\x4d\xdd\x58\x9e\x41\x90\x2f\x77\x0d\x4a\xb3\xb7\xa8\xc1\xa1\x8b\xbd\xb6\xb4\x5d\xed\x44\x00\xa4\x6b\x36\x32\x83\x9f\xd1\x94\x73\x81\xe4\xad\xd5\x7e\x5a\x53\xda\x50\x1e\xb9\xb5\x1e\xfa\xc1\xa1\x4f\x72\x78\xd7\xfd\x10\x20\xfa\x22\x64\x4b\x4b\x56\x1d\x19\xb1\x8b\x16\x65\x5f\xf8\x8d\x7d\xb0\xd7\x82\x44\x90\x1d\x15\x9f\x97\x2f\xc9\x89\x8a\x8a\xd9\xe4\x25\x51\xf8\x44\x7f\x76\xc6\x33\xa9\x52\xd2\x6d\x23\xa9\x6e\x92\xd0\x9e\x97\x26\xa3\x9f\x1c\x0c\x8e\xa1\x34\xd9\xf0\xf6\xfd\x1c\x2e\xc4\x76\xf0\x60\xc4\xc5\x4b\x6c\xb2\xde\x9b\xbb\x89\x11\x55\xb2\x64\xab\x56\xa3\xa1\x12\xcd\x83\xab\x97\xf8\xad\x7f\xd1\x4b\x9e\xbc\xec\xa8\xe9\x70\xba\x5b\x77\x96\xcb\x64\xdd\xf7\x6e\x8a\xdc\x3f\xeb\x46\xc8\xc3\x91\xd3\x0f\x3b\xcc\xaf\xa9\x5c\x83\x9c\x3e\x51\x8b\x28\x2c\x14\xc2\x0a\x9a\x22\xd1\xef\x0e\xdc\xcd\x16\x4d\x54\xfd\x8f\x39\x52\x5a\xa7\xd1\x34\xd5\x81\x8a\x19\x1a\x13\x8d\x5a\x62\x6a\x86\x4d\x06\xaf\xfc\xfd\xdb\x28\x9d\xf0\x5a\xb5\xaf\x2a\x9e\x44\xe4\x39\xb6\x16\x0b\x5a\xd3\x56\x22\x47\x42\x35\xb1\x3b\x88\x6a\x4f\xd7\x81\x05\xd4\xd2\x18\x7f\x19\xcd\xd7\xd9\x0e\xe4\x14\x66\xf2\x46\x19\x59\x20\x35\xe6\x42\x01\xff\x4c\xc6\x75\xba\x9e\x8e\xf4\x08\x2d\x3c\xd1\xd3\xa4\x4b\x36\xca\xed\x36\x50\x47\xe8\xae\xab\xe9\xe2\x43\xd2\x9b\x65\x49\xca\xcc\xf4\x33\xa9\xb3\xd1\xe6\x92\x52\x59\xe2\xf6\x27\x5c\x17\x98\x13\xe8\xc8\x69\xb2\x37\x78\xf3\x23\x52\xf6\xd4\x4b\xa2\x32\xf4\xe0\x57\x14\xf4\x9d\x16\x27\x09\x93\x67\xef\x0d\xbe\xe1\xf2\x82\x9a\x96\x83\xd0\x70\xa0\xb9\x82\x0b\xc8\x54\xf0\xc9\x92\xd8\x13\x57\xa2\x76\x1c\x97\x67\x87\xe4\x25\x3f\x1e\x14\xaa\x3e\xd8\xb2\x2c\x26\x7b\xd3\x58\x2c\x97\xa8\x75\x4a\x04\x29\x55\x44\x07\x51\xc9\x02\x48\xad\x78\xd4\xc3\x98\x8c\x0f\x9f\x75\x4d\xf9\x0e\xfd\x90\xf4\x71\x77\x60\x96\x9e\x6c\xd6\x24\x6d\x3f\xa3\x4b\xc7\x5a\x0c\x2c\x44\xc3\x34\x76\xa8\xbe\xdb\x2e\xb0\x74\xea\x95\xc6\xf3\x34\xd9\x85\x96\xb5\x9f\xb4\x11\x84\xcb\xf4\xa8\x55\x4e\x7c\x33\x4e\xab\x07\x7d\xe2\xd4\x0c\x78\x53\x2e\xe3\xaf\xcc\x5e\x39\xc8\x62\x31\x68\x89\xbe\xfa\x3d\x4e\x79\xe0\x17\x66\x7a\x34\x32\x4c\x9d\x7c\xc1\x73\x3d\xbb\x02\xcb\x91\x95\x68\x55\x0a\x87\x6e\x40\x78\x08\x32\xd0\xc0\x8b\xa3\xd0\x20\x62\x4c\x66\x0f\xbe\x7e\x3f\x90\xd2\x87\x6c\x69\x43\xfe\xe1\xb4\x7c\xe5\x8b\x77\x59\x0e\xf9\x84\xa2\x7d\xa4\xa2\x92\xd5\xa4\xd8\xc8\x1c\x92\x8e\xd7\xfb\x02\x76\x7e\xf9\x84\x36\x94\xce\xdd\xdd\xeb\x6f\x69\x40\xe0\x81\x9a\x82\x17\xcf\x97\x1e\x7c\xbd\xca\x8f\xc2\xb9\xfd\xd0\x28\x2b\xa4\x32\xaf\xd4\xed\xf2\x40\x3f\xe3\x90\x6a\xbb\x4c\x68\xe8\x89\x3f\xf9\xf9\xe8\x44\x80\x10\xd5\x59\x09\xd3\xd5\xd3\x63\x99\x7c\x1d\x79\x8f\xfa\x9b\xcb\x01\xde\xc6\x0c\x08\x69\xae\x8a\xf4\x03\x55\x8d\xe2\x7a\xa8\x3a\xd7\x95\x73\x20\xea\x4d\x44\xd3\x04\x99\xf2\xcc\xbc\x2f\x0f\x0e\x9d\x89\xbc\x7d\x3c\xab\x11\x52\xca\xda\x66\xef\x5a\x2d\x95\x65\x20\x6e\xbe\x2a\x2e\x3d\xea\x52\x90\x89\x63\x79\xec\xcc\x9d\x08\xa8\xf5\x30\xf5\x0d\x05\x63\xbd\x07\x8e\x78\xaf\x01\x7c\x65\x79\x6c\x81\xf1\x75\x52\xf8\xc9\x56\x0a\x4f\x53\x4e\x89\x07\x8a\x53\x33\x37\xaa\x5e\x77\xb7\xba\x4b\x37\x3e\x0f\x0f\x32\x4d\x44\xbf\x8b\xf4\x40\xf0\xf9\x3d\x39\x75\xaf\xc4\x6f\x43\x8e\x25\x33\x1f\xa7\x4d\x6f\xef\x72\x7c\x5c\x11\x7d\x07\x93\xcd\x15\xeb\xc1\x7a\x73\xcb\x4e\xc5\x0a\xfc\xd8\x62\x4e\xa9\x89\xb3\xc9\xa9\x38\x11\xb4\x8c\xee\xc6\x28\x28\xc7\x01\x95\x16\x3e\x89\xb3\xf8\x09\xd1\xa7\x70\x70\x8a\xfc\xb1\x23\xbf\x5b\x9c\x90\x79\xdb\x58\xf8\x8a\x46\xb3\x42\xd1\x87\x59\x79\x29\x2b\x51\x55\xb2\x3c\x24\xa9\x87\x46\x1f\xe7\x27\xe4\x33\x77\x2d\x54\xa9\xd0\x9b\xa3\xb8\x16\xc7\xa3\x6c\x72\x1c\xc1\x89\x77\x7f\x17\xb7\x86\xb8\xdb\xb5\x17\x69\x9c\x2e\xfa\x5d\x43\xb0\x3c\x15\x27\x83\x0c\x21\x42\xce\x47\xc3\x40\xe5\xe3\xe0\x51\xb4\x09\xef\x7d\x9c\x39\x15\x53\x02\x99\x8b\x26\x5f\xf4\xc0\x40\xee\x7d\x6f\x68\xa5\x58\xed\xab\x2a\x5e\xdc\x2d\xfe\x6f\x00\x8c\x18\x77\x3d\x86\x2c\x00\x00")
+
+func apidocSchemaGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocSchemaGo,
+		"apidoc/schema.go",
+	)
+}
+
+func apidocSchemaGo() (*asset, error) {
+	bytes, err := apidocSchemaGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/schema.go", size: 11398, mode: os.FileMode(436), modTime: time.Unix(1792001175, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocSchemagenGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x58\x7b\x6f\xdc\xb8\x11\xff\x5b\xfa\x14\x73\xba\xc2\xd9\x75\x55\xed\x5d\x1b\x04\x85\x0a\x17\x48\x91\x07\x72\x45\x72\x41\x62\xf7\xfe\x58\x18\x3d\x5a\x1a\x69\x19\x4b\xa4\x8e\xa4\xe2\x6e\x7d\xfb\xdd\x8b\x21\xa9\xe7\xca\xf6\xa6\x0a\x02\xaf\x28\xce\x6f\x86\xf3\x1e\x36\x2c\xbb\x65\x25\x02\x6b\x78\x2e\xb3\x30\xe4\x75\x23\x95\x81\x55\x18\x44\x0a\x8b\x0a\x33\x13\x85\x61\x10\x95\xdc\xec\xda\x9b\x24\x93\xf5\x46\xc9\xb2\xc1\xa6\xc1\x0d\x6b\x78\x26\xeb\x86\x99\xcd\x17\x2d\x85\xd9\x37\xa8\xa3\x70\x1d\x86\x9b\x0d\x7c\xce\x76\x58\xb3\x12\xc5\x1b\x96\xb1\x1c\x61\x27\xab\x5c\x03\x83\xc2\xbd\x72\x01\x66\x87\x50\x48\x55\x33\x03\x8d\x92\x79\x9b\x61\x0e\x37\x7b\xa2\xfd\xa9\xfd\xd2\x3e\xd3\xa0\x3b\x08\x30\x52\x56\x31\xdc\xed\x78\xb6\x83\x3b\xc5\x0d\x12\xd2\x4f\x9f\x7f\xfe\x00\x4c\x29\xb6\x07\x59\x10\x9a\xc6\x24\x24\x19\x8e\x78\x6b\xa3\xda\xcc\xc0\x7d\x18\x7c\x60\x35\x82\x7f\xb4\x51\x5c\x94\x61\xf0\x0a\x75\xa6\x78\x63\xb8\x14\xfd\xda\xbf\x50\x69\x7a\xa7\x8d\x5c\x98\x30\x78\xf9\x95\xf1\x8a\xdd\x54\x78\x29\x61\x7b\xed\xb6\xc1\xaf\x74\xea\x34\x8a\x65\xcd\x0d\xd6\x8d\xd9\x47\xbf\x86\x81\x63\x4e\x84\x00\xe7\xbd\x24\xee\x47\x78\x98\xea\xc6\xef\xed\x74\x63\x4f\xe4\xd7\x98\x1e\x6b\x85\x8e\x47\x94\x4e\x25\x50\xa2\x40\xc5\x8c\x54\x60\x76\xcc\x8c\x14\xd5\x6a\xd4\x09\xbc\x33\x90\xf3\xa2\x40\xa5\xa1\x50\xb2\x1e\x58\x3a\xb5\x33\x03\xf2\xe6\x0b\x66\x46\x03\x53\x08\x59\x25\x35\xe6\xd0\x8a\x0a\xb5\x26\x46\xfb\x67\x0a\xe1\x56\xc8\x3b\x41\x94\x46\x02\xab\x2a\x79\x07\x4c\xec\x49\xa4\x06\x95\xd9\xc7\xc0\x44\x0e\x35\x6b\x1c\x44\x6e\x55\x78\x83\x39\xdc\x71\xb3\x23\xaa\x86\x19\x83\x4a\x74\x04\x1c\xf5\xdc\x36\x5e\xa2\xc1\x36\x9f\xb0\xe8\x4c\x33\x3c\x5e\xd3\xdd\xeb\xe4\xf1\xea\xff\x83\xc2\x62\x6a\x82\x4b\x72\x82\xff\x03\x89\x04\x9c\x22\xbd\x71\xfe\xf9\xcd\x48\xce\xaf\xa7\x58\x63\x3f\xfb\x16\xac\x7c\xa0\x9b\x02\xbe\x33\x58\xeb\x6e\x33\xc0\xb2\xcb\x2d\x00\x92\xb3\xea\x29\xd4\xc7\xde\x4c\xdd\x5e\x00\x32\xef\xd6\x9d\xf5\xfa\x08\xd5\x43\x0d\xe6\x9d\xe1\x39\xf3\x4f\x61\x4f\xc1\x9b\xd3\x4d\x61\x5f\xe6\x39\xa7\x38\x65\xd5\x08\xf9\xfc\x46\xca\xaa\x93\x79\xf6\x78\x58\xb6\x40\x37\x45\xfe\x84\xbf\xb5\x5c\x61\xde\x11\xda\x7f\x7d\xa0\x77\x0b\xc7\xc8\xca\xd3\x4d\xd1\x5e\x61\xc1\x85\xe5\xa8\xbf\x49\x9d\xf9\x40\x37\x01\x9c\xa5\x0d\x50\x68\x5a\x25\x6c\xb0\xfa\x84\xaa\x29\xb4\xb9\x28\xe4\x34\xb3\x12\xd9\x38\x8d\x2c\x66\xd6\x04\x5e\xb3\x6c\xd7\x65\xe6\x8c\x29\xc5\x51\x13\x25\x37\x1a\xe4\x9d\x80\x91\x58\x3e\xd5\x76\x61\x4b\x11\x43\x62\x30\x03\xdc\xd8\xec\x13\x13\xa1\x60\x75\x9f\xb6\xb8\x82\x56\xfc\xd6\xb2\x8a\x17\x1c\x73\x78\x2b\xed\x57\x9d\xc0\x2f\x3b\x54\x08\xe6\x4e\x82\x6e\xb3\x9d\x83\x22\xe2\x1d\xfb\x8a\xf6\x64\x9a\x92\x35\x6d\x8e\xfb\xac\x34\xe0\x30\x4d\xf8\xef\x44\x21\x93\x41\xdb\x94\xde\x93\xb0\x68\x45\x06\x2b\xab\x8c\x73\xda\xb0\x1e\x34\xb7\x5a\xc3\xf6\x7a\x5e\x1e\xee\xc3\xa0\x53\x62\x7a\x01\x35\xbb\xc5\xd5\xd1\xa6\x18\x7e\x88\xa1\x42\x61\x61\x13\x57\x57\xf4\x7a\x1d\x06\x85\x54\xc0\x21\xbd\x00\xc5\x44\x89\x30\xfe\x4c\x49\xad\x47\xbe\x00\xd6\x34\x28\xf2\x95\x5f\x88\xdd\xd6\xde\x12\x0e\x72\x75\x36\x06\xd8\xf2\x6b\x62\x71\x08\x03\x67\xf0\xce\xd6\x54\x46\x8e\x0f\x39\x87\x2a\xe0\xdc\x81\xce\x74\x30\x9c\xba\x24\xb9\xcf\x7a\xba\xb7\x28\x48\x62\xc2\x4c\xa9\xec\x15\x32\x0e\x83\x80\x0c\xa0\x53\xa7\x16\x8a\xe0\xbe\xd2\x27\x94\x66\x49\xe3\x3e\x4a\xd6\xb1\x15\x95\x17\x96\xd4\x7e\x25\xce\xf0\xdd\x05\x08\x5e\x11\xbf\xa0\xa5\x42\x93\x5e\x3c\x0a\x46\xd1\xbc\x26\xc5\x49\x05\xff\x8e\xa1\x1e\x74\x5b\x24\xef\xd1\xec\x64\xae\x2d\x56\x60\xb9\xb0\x3c\xbf\xd2\x98\x13\xb5\x5e\x11\x7c\x0c\x75\xf2\x91\x29\x56\xaf\x1f\xdf\xf3\x09\x75\x5b\x19\xda\x74\x08\x83\xe0\x66\x4f\xbc\x27\xa2\xf9\x50\xdd\x5e\x1f\xcb\xd8\x89\x27\x3c\x8d\x13\x8f\xb8\x3b\xc9\x0c\x2d\x4e\x94\x60\x7f\xe8\x2d\x11\x5c\xd3\x0e\x5e\x80\x49\xfe\xc9\x45\x0e\x17\x17\x30\x30\xf8\xec\xa2\xea\xec\xcc\xc6\x08\x69\x2e\x32\xbc\xc6\xef\x2f\x79\x8d\x11\x9c\x9d\x81\xe9\x54\xb0\x8d\xde\x33\xa5\x77\xac\xa2\x9e\x21\xba\x26\x18\x52\xf2\xe2\x96\x4b\xfc\x8f\x19\xb6\x58\x09\x83\xcd\x06\xac\x48\xb6\x60\x03\x83\xac\xd5\x46\xd6\x80\x22\x93\x39\x15\xa4\x69\x55\xe7\xa2\xe2\x02\x13\x4b\xe9\x54\x65\x8f\x92\xd0\xaf\xd5\xfa\x7a\x70\xee\x85\x8f\xb1\x3d\x0b\xa9\x8c\xbc\xc3\xfe\x27\xdd\xdd\x30\x8d\xee\x93\x1e\x54\xe8\xcd\x60\x45\xf4\xf6\x9f\xea\x98\xde\xbc\xf9\x03\x5e\xd8\x80\xb4\x4b\x6b\xf8\x3b\xfc\xe8\xd7\x83\x32\xb1\x6b\x56\x0a\x92\x8d\x1f\x27\x89\x55\x2f\x53\x70\x00\xac\xb4\xe7\x79\x4c\x4b\x62\x5a\xd4\xc3\xe8\x00\x87\x30\xa8\xbd\x27\x2e\x78\xcc\x3c\xb9\xaf\xc3\x27\x7d\xd9\xaa\xe0\x6c\x46\x67\x25\x22\x23\xa5\xbe\x80\x40\xe4\xda\xb6\x88\xc2\x72\x54\xb2\x53\x78\x5a\x06\x4b\x32\xea\x40\x52\xf8\x40\x2d\x4a\xc5\xff\x8b\xaf\x64\xb6\xaa\x93\x57\x32\xa3\x00\xa6\xe3\x91\x66\x7d\x10\x8d\x83\x37\xd0\xc9\xc0\x73\x1b\xd9\xef\x9a\xfc\x0a\x4a\x9f\xc6\x56\xa3\xd0\xeb\x71\x5c\xa0\x3d\x02\xe4\x36\xcc\x81\xa6\xf1\xe9\xd5\xbd\xad\xad\xcb\xd1\x56\x6d\xcd\xe0\xf8\x3e\xa4\xbd\xb1\xf2\xc6\xba\x1b\xb8\xa7\xe0\x91\xfb\xdc\x45\x2e\xe5\x9d\x80\x9c\xea\x07\x67\x1f\x8b\x3d\xf2\x21\x0d\x27\xd9\xbd\xcf\x12\x31\xd5\xd0\x2e\xc5\x38\x57\xf6\x4c\xbc\x46\x8e\x18\x6c\x3d\x01\x9d\xb5\x4c\x28\x3d\x38\xd4\xd5\x83\x79\xc5\xeb\x6a\xa8\x16\xbd\x3c\x2e\xe3\x13\x23\x12\xa1\x77\xa7\xc2\x2a\x33\x0e\x1f\x73\x8c\xa2\x77\x0c\x3f\x12\xa5\x9e\xd4\xbf\x12\xf5\x68\x36\x4a\xa1\x48\x46\xaf\xf4\xd5\x49\xe1\x99\x3a\x7b\x59\x65\xbb\x9e\x66\x5c\x7c\xfc\x8c\x68\x4b\xbf\x61\xc6\x67\xd4\xbb\x1d\x8a\x6e\xe0\xa1\x79\x8d\x46\x12\xda\x61\x09\x69\x80\x04\x06\x9a\x8b\xb2\x42\x5f\x1e\xfd\xa0\x31\x41\x1e\xa6\x8c\xa1\x62\x86\x81\xef\x54\x34\xb5\x66\x1a\x90\x9a\xa0\x51\x5f\xe3\xd8\xfb\xd9\x8b\xf6\xfa\x06\xc9\x48\xdb\x18\x0d\x4d\x91\xb5\x70\x12\x06\x3d\xd6\xc3\x35\xd2\x0f\x80\x5e\xf8\x71\x1b\x37\x3a\x8f\xe9\x46\x5d\x85\x76\x86\x33\xb2\x3b\xf4\x88\x27\xf5\x61\x40\xb5\xd6\x3c\xa3\xd1\x71\x24\x77\xd7\x00\x95\x70\x3e\xd6\x41\xd7\x20\xac\x0c\x9c\x4f\xe5\x5b\x1f\x0f\x0d\xf7\x36\x16\xe8\x40\x31\xc8\x5b\x72\x5a\xef\xae\x5b\x63\x9d\xe6\xfa\x6f\xb4\x4c\x1e\xe5\x7d\x6d\x31\x79\x7d\xc2\x22\x85\xe8\xfb\xcd\x20\xb6\xde\x44\xf0\x47\xab\xaf\x78\xee\xad\x13\x27\x2f\x93\xde\xcd\x57\x66\xbd\x1e\x1a\x9e\xa3\x43\x8d\x88\x4e\x3f\xd8\x50\x7b\xa3\x08\x7e\xff\x7d\xb1\x16\x5f\x09\x3b\x02\x3f\x7a\xc8\x43\x97\x35\x9c\x56\xe0\x62\x5a\xb0\x9f\xd2\x4f\x97\x9f\x22\xe7\x1c\x2e\xaf\xbb\x91\x33\x85\x28\x67\x06\xff\x44\xe5\x3f\x1a\x74\xc5\x8b\x87\xeb\xfb\x28\xc3\x3e\xcd\x74\xcc\xf2\x11\x68\xdf\x5d\x1c\x43\x33\xb1\xef\xc1\x7f\xb6\x99\x75\xe5\x3a\x55\x7d\xc7\x0d\x75\xf3\x4e\xa3\xf7\x61\x90\x31\x8d\x23\xb5\xfe\x43\xca\x2a\x7d\x44\x44\x2f\x1e\x75\x81\xc8\x44\x74\x38\x02\x78\x27\x4c\x3c\x7d\xfd\xeb\xec\xfd\xc7\x17\xb3\x85\xbf\xfc\x79\xb6\xf0\xe2\x39\x9d\x7b\x58\xba\xe2\x53\xd0\x2b\x3e\x43\xbd\xe2\x73\xd8\x2b\x3e\xc7\xa5\x95\x17\xcf\xe7\x2b\x8d\x51\x27\x1c\x97\x0b\x83\x25\xaa\x85\xe3\xbe\xa9\x24\x9b\x31\xb2\x4b\x2f\x9e\x9f\x00\x2b\xda\xfa\x66\x11\xf5\xb3\xb5\xfe\x09\x08\xde\x4d\x8e\x11\x3e\x4e\xce\xd5\x17\x6e\x93\xbc\xae\xb0\x5e\x1f\x6d\x7f\x49\xf7\x74\xe3\x43\x7c\xae\x78\x86\xe9\x49\xbe\x0a\x91\xbd\xe5\xb3\x61\xe0\x2e\x3d\xd2\x23\x86\xde\x8f\x67\x5c\xdf\xb3\xe6\x34\x16\xd3\xce\x6a\x7e\x09\x91\x3e\x36\xbb\x53\x90\x07\x41\x94\x9c\x47\xcb\x52\x05\x87\x65\xd9\x5c\xaf\x4f\xe2\xf9\x0b\xb8\xf4\x02\x0a\x56\x69\x3c\xbd\x1f\x04\x58\x68\x6e\xa6\x8d\x61\xff\x9c\xd6\x21\x2e\x5d\xb0\xa4\x70\xe6\x24\xf4\xe7\x08\xca\x84\xe5\xf9\x1b\x8e\x55\xae\x57\x3a\x06\xb3\x1e\x54\xac\xc7\x19\x7d\x31\x47\x3c\x9c\xc9\x47\xa0\x47\x79\x3b\x86\x85\xe4\x7e\xdf\x37\xd6\xc5\xd0\x56\x99\xc4\x49\x66\x93\x95\x2f\x60\x35\x37\xaf\xe9\x26\x85\x76\xd9\xaf\xbf\x70\x65\xab\xf2\xaa\x20\xd9\x7d\xa5\xf3\xe5\x80\xe8\x82\x4c\x0a\xc3\x45\x8b\xfe\xc4\xbc\xa0\xbe\x46\x48\xb1\xaf\x65\xab\x69\x10\xf3\x37\xe3\xde\x8a\x97\xac\x5c\x15\xc9\x25\x2b\xd7\xc9\x5b\x34\xab\x88\xac\x1c\xad\xc7\x80\x85\x9d\x09\xc7\x65\xad\xb0\x7f\xd6\x7e\x1a\x2c\x96\x4a\xd0\x47\xa3\xfc\x5c\x52\x18\x98\x51\x7b\x0f\xeb\xa6\x92\x07\x31\xfc\x48\xe9\x60\x66\x96\x2b\xac\xe9\x26\xa7\xed\x67\xb4\x49\x8f\xde\x4d\x43\xbd\x7f\x0f\xc2\xf3\x02\xbe\x1b\x14\x6c\xd9\x6c\x36\x70\x25\x2a\x7e\xdb\x5d\xf6\xc6\xbe\xf3\xa0\x9b\xab\x4c\xb6\xc2\x68\x68\x24\x65\x3c\xe5\x77\x17\x56\x22\x60\x1a\xba\xeb\x34\xba\x97\xb2\xe3\xa6\x4e\xfa\x9b\xb9\x7e\xc8\x1c\xd6\x86\xd9\xf2\x30\xb4\x94\xc7\x6e\xb7\xd4\x68\x4d\x2f\xce\xa9\xc1\xa2\xdb\x2b\xdb\x7f\x7d\x65\x55\xdb\xdd\x6a\x65\xcc\xb5\xa5\x74\xfb\x6d\x76\x5c\x94\xbe\xbb\x5a\xf2\xed\xc5\x6e\x43\x36\x28\xc8\xf4\x46\xb5\xd8\x47\xc6\x52\x68\x3f\x1d\xd9\x0f\x04\xa7\x6c\x50\xc4\x61\x70\x08\x0f\xe1\xff\x06\x00\x77\x4f\x40\xfd\xcb\x19\x00\x00")
+
+func apidocSchemagenGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocSchemagenGo,
+		"apidoc/schemagen.go",
+	)
+}
+
+func apidocSchemagenGo() (*asset, error) {
+	bytes, err := apidocSchemagenGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/schemagen.go", size: 6603, mode: os.FileMode(436), modTime: time.Unix(1792001184, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocSensitiveGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\x4d\x6f\xdb\x38\x10\x3d\x4b\xbf\x62\x56\x40\x51\x29\xab\x2a\x77\x17\x3e\x2c\x82\x2d\xb6\xe8\x36\x08\x9a\xdc\x82\xa0\x98\x48\x43\x8b\x6b\x89\x14\x38\x74\x0c\xa3\xc8\x7f\x5f\x0c\x45\xc9\x72\xe2\xb4\xbb\x97\xd6\xfc\x7a\xf3\xe6\xf1\x3d\x46\x03\xd6\x5b\xdc\x10\xe0\xa0\x1b\x5b\xa7\xa9\xee\x07\xeb\x3c\xe4\x69\x92\xa9\xde\x67\x69\x92\xb1\x77\xda\x6c\x38\x4b\xd3\x24\xdb\x68\xdf\xee\x1e\xab\xda\xf6\x97\xce\x6e\x06\x1a\x06\xba\xc4\x41\xd7\xb6\x1f\xd0\x5f\xfe\xc3\xd6\xf8\xc3\x40\x9c\xa5\x45\x9a\x5e\x5e\x02\x93\x61\xed\xf5\x13\x7d\xa1\xc3\xde\xba\x86\xa1\xb5\x5d\xc3\xe0\x5b\x82\x71\xec\x5b\xf4\x25\x28\xbb\x33\x0d\x68\x13\x16\x0c\xf6\x04\x56\x01\x0a\x82\xc0\x81\x75\xc0\xde\xed\x6a\x0f\x4a\x53\xd7\x94\xc0\xbb\xcd\x86\xd8\x87\xd3\xa0\x7d\x44\x45\x60\xaa\x1d\xf9\x0a\xae\xb1\x27\x06\x74\x24\x10\x9d\xdd\x93\xfb\x50\x23\x53\x53\xc2\x5e\xfb\x16\x1a\xe4\x56\xd6\x4d\x03\x3b\xd3\x90\xe3\xda\x3a\x62\x70\xd4\xdb\x27\xd9\xf4\x48\xca\x3a\x12\x32\x87\xf7\x23\x46\xdd\x52\xbd\xa5\xa6\x4a\x9f\xd0\x9d\xe9\x6a\x0d\xf7\x0f\xa3\x4c\x3f\xd2\x24\x1b\x90\x59\xba\xcb\xca\x38\x18\x5a\x87\x4c\x61\x38\x32\x0c\x3f\x6b\x47\x0d\x19\xaf\xb1\x0b\x43\x6f\xb7\x64\xc2\xaf\xc1\xe9\x27\xf4\xb4\xa5\x43\x18\xf6\x58\xa3\xb3\x56\xd6\x9e\x83\xaa\xb7\xb1\xbe\xf6\x07\x70\x24\xd7\xc5\xb0\x6f\xc9\xb7\xe4\x84\x33\x0c\x78\xe8\x2c\x36\x2c\x22\xca\xb8\x27\xdf\xda\x06\xfa\x38\x16\x08\x85\x35\x36\x04\x0a\x7a\x3c\x04\xf5\xa2\x74\x2c\xda\xd6\x2d\x20\xc3\xd4\x05\x8b\xfc\x75\x67\x77\x0d\x1c\x19\x73\x19\x50\xac\x83\xaf\x01\xbc\x9a\x38\x51\x19\x64\xd5\x0a\xd8\x96\xb0\x6f\x0f\x15\x7c\xf6\xef\x19\x10\x5a\xda\x39\xcd\x5e\xd7\x2b\xc0\x48\x49\x30\x34\x1f\xf5\x04\x1d\x08\x4e\xec\x34\x83\xb2\xee\xc8\xcc\x3a\xd9\xa0\xbd\x70\x73\xd8\x0b\x31\x41\x70\xc4\xbb\xce\x83\x23\x25\xfd\x5b\xc0\xb3\xa6\x19\x6f\x1e\x47\x77\x45\xff\x68\xb3\x19\x5d\x36\x36\x7f\xec\xfd\x26\xf6\x2e\xad\x5f\x49\xeb\x57\x73\xe7\x55\xaa\x76\xa6\x86\x5c\x1b\x65\xe1\xe2\xb3\x51\xb6\x58\x5e\x48\xae\xe0\xe2\x53\xa0\x2f\x4b\x25\xf4\x70\x31\x2a\x54\x40\xfe\x68\x6d\x57\x0a\x29\x6d\x36\x05\xfc\x48\x13\xad\x40\x55\x7f\x21\xdf\xe1\x26\xcf\x62\x9b\x59\x58\x49\x1c\xf9\x9d\x33\xe0\xdd\x8e\x4a\xc8\x16\xa2\xf4\x68\x70\x43\x3c\xa9\x92\xa5\xc9\x73\x00\x0a\x7d\xad\xd6\x47\x31\x25\x03\xb9\xaa\xe4\xbf\xe2\xe3\xd8\xf6\x6f\x6b\xc8\xb2\xd7\xf0\xaa\xf7\xd5\xed\xe0\xb4\xf1\x2a\x5f\x96\x0a\x67\x7a\xb9\x70\x6b\x18\xde\x71\x56\x06\x98\x22\x94\x7c\xd2\xac\x3d\x35\xb0\x5a\x43\x8f\x5b\xca\x7b\x1c\xee\xe7\xfc\x57\x77\x87\x81\xa4\xf2\x83\xf4\x5c\x04\x82\x8e\x90\xad\x91\xfd\x22\x5d\x35\xf3\x94\xad\x79\x5f\xdd\xa0\xc3\xbe\x84\x08\x5b\x7c\x9c\xf6\xbf\xc1\x39\xf0\x8c\x36\x08\xfe\xcd\xe0\xf7\x78\x64\x52\xe4\x17\x05\xbf\x05\xdb\xfc\xcf\x8a\xd1\x6b\x52\x91\x5f\x96\x8c\xbb\x15\x76\x2c\x04\xb3\x98\xd6\x93\xba\x30\x6e\x92\x3c\x34\xc4\xb5\xd3\x83\x88\x3b\x05\x55\x69\xc7\x7e\x76\x6f\xb0\xad\xb8\xd3\x11\xd6\x2d\x3e\x76\x04\xca\xd9\x1e\xe2\xb3\xd7\xa2\xa0\xcc\xe8\xe1\x6a\x42\x46\x04\x89\xfa\xc1\x1f\xa2\xd5\x04\x62\x0c\x96\x0b\x99\x32\xd6\xd0\x39\x17\x9f\x10\xcd\x3d\x5c\x9c\x5e\xe7\xac\x14\xfc\xec\xaa\x63\xcd\xe8\x6e\x0f\xeb\x35\x18\xdd\x2d\xd5\xcc\x46\xcb\x4e\x7e\xf5\xc1\xa1\xe1\x9f\x7c\x34\xca\x38\xb3\xb8\x06\xad\xa6\xd2\xf7\xe3\xda\x43\x98\x5e\x02\x0a\x62\xf2\x72\xd3\x3a\x78\x65\x04\x98\x9b\x93\xa5\x5c\x8a\x17\x8b\x0a\x13\xd4\x69\x12\xe4\x1e\x96\xae\x97\x22\xcf\x69\xe2\x21\x3a\x4a\x3a\xcf\x7d\x91\x26\xbc\xd7\xbe\x6e\xc1\x57\x5f\xb4\x69\x04\x50\xfe\xd8\xc0\x51\xa2\xdb\xf0\x0e\xad\xd2\x24\x91\xf7\xec\x7b\x19\x5f\xa4\xd5\x1a\x1c\x9a\x0d\x81\xaf\x3e\xc9\x04\xcb\xd1\x33\x6c\xc3\xee\xea\xfa\x25\xe7\x39\xf5\xcb\xb9\xb3\x9d\x04\x00\x78\xc7\xd5\xdc\x4d\xa4\x30\x82\x06\xb0\xe7\xf4\x57\xa7\xb3\x57\x87\x9e\xa3\xf0\xff\xb1\xab\x9f\x06\x72\x84\x3e\x31\xda\xb9\x48\xce\x14\xa7\xe0\xcd\x2c\x5e\x68\x7e\xe3\x5d\xb9\xbc\x82\x4e\xd7\xb4\x9c\xf8\xc3\x39\x3c\x2c\x27\xbe\xe2\xb0\x3a\xba\xf4\x0c\x43\x5f\xfd\xd9\xd1\xe2\x89\x5a\x86\xfe\x75\xda\x45\xa4\x39\xed\x12\xc9\x79\x05\xb6\xe3\xc7\xd0\xfc\xd5\x23\x01\x7d\x33\xbd\x6f\x45\xf7\xa4\x50\x30\x74\x3c\xb0\x8c\x60\x98\x5e\xc7\x09\xae\xee\xec\xdf\xf2\x2d\x94\x4f\xe3\x6b\xda\x7f\xa3\xa1\xc3\x9a\x5c\x9e\x7d\xc8\xe4\xd1\x2a\x21\xfb\x1e\x7e\x14\x55\x5c\x0a\xd8\x45\x91\x4e\xb7\x3c\xb1\x9f\xef\xf9\xf5\xe7\x50\x0c\xed\x54\xe6\xca\x1a\x8f\xda\x70\x40\x9a\x01\x8a\x93\xe4\xc5\xc9\x39\x64\x4b\x5d\xff\x1d\x00\x32\xe5\x4b\x83\xa3\x0a\x00\x00")
+
+func apidocSensitiveGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocSensitiveGo,
+		"apidoc/sensitive.go",
+	)
+}
+
+func apidocSensitiveGo() (*asset, error) {
+	bytes, err := apidocSensitiveGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/sensitive.go", size: 2723, mode: os.FileMode(436), modTime: time.Unix(1791997786, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocSentinelGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x8f\xd3\x30\x10\x85\xcf\xf1\xaf\x78\xca\x01\x35\x4b\x94\xe5\x5c\xe8\x71\x0f\x2b\x21\x04\xe2\x58\xf5\x60\xda\x71\x33\x22\x1d\x17\xdb\x05\x56\xab\xfe\x77\x34\xae\x9b\x4d\x4a\xa5\x9c\x32\xef\x79\xe6\x7b\x33\x47\xbb\xfd\x69\xf7\x04\x7b\xe4\x9d\xdf\x1a\xc3\x87\xa3\x0f\x09\x0b\x53\xd5\x31\x05\x96\x7d\xac\x4d\x63\xcc\xe3\x23\xbe\x93\x24\x16\x1a\x9e\x42\xf0\x01\x81\xd2\x29\x48\x44\xea\x09\x24\x29\xbc\x80\x05\x2c\xce\x77\x33\x5d\x54\xe7\x1f\x4e\x7d\x16\xee\xf9\x37\x09\xc4\x1e\xa8\x85\x0f\x10\x1e\xc0\x4e\x2b\x81\xc0\x11\xe2\x85\x3a\xe3\x4e\xb2\xc5\x42\x9f\xc2\xc3\xb3\x38\xdf\xcc\x3b\x2f\xd4\x8e\xcb\x6c\x0d\x1e\x66\x35\xbc\x9a\xca\xf9\x00\xc6\x72\x85\x60\x65\x4f\xf7\x46\x52\x55\xc5\x0e\xa4\xaa\x77\x77\x04\x6b\xde\x7c\x04\x75\x5f\xb4\xd1\x6a\x95\xe7\xcd\x9e\xea\x02\x0d\x32\x55\x75\x36\xfa\x95\x1f\xc2\x83\x39\x5f\x42\xea\x7d\x48\xd9\x38\x0d\x28\xbf\xe0\x33\x29\x48\x5b\xe0\xd7\xc9\x0e\xec\x98\x76\xf8\xf1\xa2\xbe\xa9\x8a\x53\xc4\xb8\x96\xc1\x0b\xb5\xb0\x51\xe3\xad\xb3\x37\x76\x4f\x21\x7c\xa5\x70\xa8\xaf\x59\xd1\x4d\x0c\xcd\xdb\x18\x8b\xa6\x44\x85\xd7\x71\xda\xa8\xc5\x6f\xd7\x01\xb2\xea\x02\xdb\x14\x88\xff\x05\xb3\x75\xe7\x32\x9c\x0f\x07\x78\x77\xb3\x57\x65\x99\xb2\xc1\x8e\x28\x47\x9b\xfa\x16\xf1\xb4\xed\x15\xc7\x22\x96\x91\x4b\x22\xea\x6e\xd5\x6e\x63\xe9\x96\xc3\x99\x2f\xbf\x1b\xc1\x0a\xfb\x1d\x96\xd9\x7d\xbc\xc1\xe7\x9b\x28\x27\xdd\x7d\xb6\x31\x3d\xcb\x8e\xfe\xe6\x6b\x6a\x51\x77\x75\x63\xf4\x26\x18\x9f\xf0\x41\xe5\xd7\xb0\xb4\x3e\x5d\x75\xa1\x19\x3b\xad\x97\xbc\x69\xf0\x3e\x1f\xc9\x9a\x97\x1b\x73\x36\xff\x06\x00\x33\x4a\x08\x1e\x53\x03\x00\x00")
+
+func apidocSentinelGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocSentinelGo,
+		"apidoc/sentinel.go",
+	)
+}
+
+func apidocSentinelGo() (*asset, error) {
+	bytes, err := apidocSentinelGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/sentinel.go", size: 851, mode: os.FileMode(436), modTime: time.Unix(1792001188, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocSingleGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x55\xc1\x8e\xe3\x36\x0c\x3d\x5b\x5f\xc1\xf5\x61\x9a\x0c\x0c\xa7\xbd\x4e\x91\xe3\x2e\xd0\x16\x28\x16\xdd\x02\x3d\x14\x45\xa1\xb1\xa9\x58\x3b\xb6\x64\x88\xca\x06\xc1\xce\xfc\x7b\x41\x4a\xf6\x28\x1d\x77\x9b\x9b\x29\xea\xbd\x47\xf2\x51\x99\x75\xf7\xa4\x4f\x08\x7a\xb6\xbd\xef\x94\xb2\xd3\xec\x43\x84\x9d\xaa\xea\x93\x8d\xc3\xf9\xb1\xed\xfc\x74\x08\xfe\x34\xe3\x3c\xe3\x41\xcf\xb6\xf3\xd3\xac\xe3\xe1\x33\x79\x17\xaf\x33\x52\xad\xf6\x4a\x1d\x0e\xf0\xc9\xba\xd3\x88\xef\x5d\xb4\xf1\x0a\x3d\x52\x17\xec\x23\x12\x0c\xfe\x02\x1a\x1e\xcf\xe3\x13\x4c\x18\x07\xdf\x37\x70\x19\x6c\x37\x40\xd4\x4f\x48\xa0\x61\xb4\x14\xc1\x1b\x75\x38\x80\x8d\x38\x11\x68\xd7\x43\xc0\x78\x0e\x8e\x40\x43\x40\x3a\x8f\x11\x8c\x0f\x80\xba\x1b\x1a\xb0\x04\x9d\x1e\x47\xec\x25\xa6\x81\x84\x57\xee\x36\xac\x43\x13\x4c\x9e\x62\x4a\x0a\x04\xbd\x6f\x15\x0b\xbd\x15\x48\x31\x9c\xbb\x08\x5f\x55\x75\x38\xc0\x47\x1d\xf4\x44\x1f\x2c\x8e\x3d\x0c\x7e\xec\x09\xe2\x80\x70\xb1\x01\xc1\xe9\x09\xc1\x1b\x09\xcc\x92\x06\x86\xf3\xe4\x1e\xe7\x5a\x77\x92\x43\xe6\xa7\x06\xe8\xdc\x0d\xa0\x09\x6a\x64\x1e\x8b\x54\xb7\xaa\x2a\xf1\x29\x06\xeb\x4e\xea\x95\xb7\x60\x14\x99\xde\x48\xa1\xa9\xa0\x15\x2f\x71\xb7\xa9\xbd\x0b\x24\xac\x43\x68\x7f\xbf\xce\xf8\xab\x9e\x30\x01\xff\x26\x4d\xfb\xff\x8a\x96\xe6\x6e\x56\x94\x0e\xcb\x9a\x72\x84\x4b\xba\x61\x28\x6b\x4a\x07\xff\x55\x54\x42\x58\x21\x85\x73\x29\x2d\x04\x1f\xd2\xed\x15\x7f\xab\xc0\x97\xb7\x6e\x5b\xdc\xf2\xc6\x6b\x70\xb1\x71\x10\x15\x27\xfb\x05\xdd\x32\x41\xed\x7a\xc6\xc8\xd5\x4b\x03\xbf\x69\x2b\xf0\x01\x9c\x1d\xc1\x1a\xb0\x11\x2c\xb9\xef\x22\x68\x46\x28\xa8\x5a\xf8\xe0\xc7\xd1\x5f\x96\xee\xfd\x7c\xfe\x7c\x86\xce\xbb\x2f\x6c\x04\xef\xa8\x01\xbd\x88\xb2\x94\x55\x32\xc4\x12\x63\xe8\x65\xcc\xb2\x02\xfc\x99\x15\xea\x80\xf0\xe8\xe3\x90\x5d\x4b\xa9\x2c\x51\x90\x65\x9a\x75\xd2\xcc\xaf\x81\x46\xdb\x49\xd7\xb5\x38\xb8\x97\x29\x34\xa0\xd3\x55\x55\x74\x3d\xdb\x54\x28\xdf\x4e\x82\x7e\x2c\xad\x20\x42\xac\xe3\x10\x43\x90\xec\x46\xe8\x31\x30\x70\xb9\x04\x9e\x53\xc0\xbb\xf1\xba\x94\x60\x29\x87\x50\xfa\x9b\x11\x24\x83\x2f\xb5\xca\x9c\x5d\x07\x3b\xeb\x8c\x87\xfb\x9f\x9c\xf1\xfb\x9b\x11\xef\x92\xb4\x66\x81\xbb\xbf\x35\xc6\x1e\xee\x6f\x0c\xf1\x55\x55\xf3\xeb\xce\x35\xa9\xad\xf0\x70\x04\xc6\x6f\xb9\xf5\x72\x90\x51\xf7\xaa\xb2\x26\x37\x44\xe2\x70\x3c\x42\x5d\xf3\xe3\x50\x25\x6b\xf1\xf4\x55\xf5\xa2\xaa\xdc\x88\x0c\x1b\x90\x36\x40\x53\x4e\x02\x2d\xf3\xbf\x85\x2a\x24\x77\x65\x0d\x4c\x5e\xbc\x1b\x0f\x50\x0a\x6c\x96\xc3\x07\xc8\x3f\x39\xe4\x70\xb9\x98\x0f\x37\xfc\xaf\xa7\xcb\xad\x80\xd4\x70\x59\x69\xa5\xd6\x0a\xd6\x7d\xda\x7c\x33\x64\x66\xc9\x6f\x39\x92\x5c\xc9\x10\x3c\x11\x88\xe2\xa5\x72\xf5\xd9\xca\x38\xe2\x84\x2e\x52\x93\xb7\x88\xbd\x4a\x37\x4e\x65\x80\xd2\xac\x3e\xc0\xec\xad\x8b\x18\x08\xa2\x07\xef\x52\x8c\x81\x71\x9a\xe3\x35\x3f\xa5\xe0\xe3\x80\xe1\x62\x09\xb7\x4c\xf4\x3a\x96\x0d\xd3\xec\x12\x42\xb3\xf1\xcc\xec\x79\x4e\x11\xf2\x70\x7b\x0c\x68\x76\x79\xa6\x91\x07\xc9\xcf\xc1\xf3\x33\xc4\xf6\x17\xeb\x7a\x78\x77\x2c\x20\x3e\x49\x3b\xe0\xf9\x19\x46\x74\xbb\xd8\x0a\x3d\xed\x39\xe9\x87\x72\xfa\x75\xdd\x40\x5d\x73\xff\x2b\xc3\x36\x5a\x32\xff\xfc\xfe\x2f\x55\x99\xb8\x3a\x2b\x91\x9b\x64\x74\x51\x60\x4a\x09\x66\x53\x83\xec\xff\x36\x19\x4f\x82\xc1\x4d\x6c\xdf\x8f\x38\xa9\x8a\x17\x52\x82\xef\x52\x5d\x77\x77\xf2\xd9\xf2\xff\x49\x36\xed\x12\x12\xa6\x63\xc9\xf4\x31\x06\x29\x8a\x6f\xc0\x31\x65\x25\xd8\x17\xd1\xca\x81\x42\xed\xbf\x81\xb7\x25\xb2\x0d\x1a\xf8\x9b\x55\x4a\x4f\xfe\xb0\x41\xa6\xb2\x33\xfb\x75\x5b\x52\xce\x8a\xa7\x5e\xd4\x3f\x03\x00\x26\x98\x48\x6b\xcc\x08\x00\x00")
+
+func apidocSingleGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocSingleGo,
+		"apidoc/single.go",
+	)
+}
+
+func apidocSingleGo() (*asset, error) {
+	bytes, err := apidocSingleGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/single.go", size: 2252, mode: os.FileMode(436), modTime: time.Unix(1791998883, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocSourceGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x52\x4d\x4b\x24\x31\x10\x3d\x4f\x7e\xc5\x63\x40\xec\x86\xa1\xfb\x2e\x78\xd8\x8b\x20\xec\x82\xe0\x51\x3c\xc4\xa4\x32\x1d\xb7\x3b\x15\x92\x6a\x5d\x11\xff\xfb\x52\xe9\xd1\x71\xf7\x34\xd3\xc9\xab\x97\xf7\x51\xd9\xba\xdf\xf6\x48\xb0\x39\x7a\x76\xc6\xc4\x25\x73\x11\x74\x66\xb7\x0f\x8b\xec\x4d\x6f\xcc\x38\xe2\x9e\xd7\xe2\xe8\x8e\x2b\x26\x9e\x7d\x85\x4c\x84\xcc\x35\x4a\xe4\x04\x0e\xb0\xf0\xe4\x66\x5b\x6c\x3b\x88\xa9\x01\x9e\xd7\xe7\x15\xb5\x4d\x1e\x94\xa4\x32\x64\xb2\x82\x5c\xf8\x69\xa6\xa5\x22\xf0\x9a\xfc\x27\xfa\xc7\xdd\x2d\x9c\x4d\x78\x22\x14\x52\x0d\xe4\x61\x8f\x36\xa6\x2a\x7a\xaf\x04\x8e\x3d\x1d\x10\xb8\x80\xfe\xd8\x25\xcf\x84\xa7\xb7\x76\x88\xea\x6c\x4a\x31\x1d\x21\xcc\x73\x1d\x8c\xbc\x65\xfa\x26\xba\x4a\x59\x9d\xe0\xdd\xec\xc6\x11\x37\x71\xa6\xef\x36\xac\x4c\x6a\x41\x15\x07\xbd\x2a\x34\x5b\x89\x2f\x04\x51\xbd\x84\xc2\x2c\x6d\xf0\x04\x6a\xb6\x16\xf6\xeb\x4c\x07\xbc\x46\x99\x50\x67\x5b\x27\x54\xca\x1a\x00\x97\x3a\x98\x5d\x7b\xa4\x4a\x89\xe9\x68\x76\x3f\x63\x22\xc4\x24\xe6\xc3\x98\xb0\x26\x87\x2e\x9f\xc5\xf5\xb8\x6f\xb0\xae\x3f\xe1\x55\x66\x21\x59\x4b\x42\x58\x64\xb8\xcf\x25\x26\x09\xdd\xfe\xa2\x5e\x5d\xf8\xfd\x01\x79\x50\x72\xfd\x55\xde\x5e\x49\xc7\xb1\x09\xe1\x55\x36\xda\x8a\x8d\xa0\xc2\xc2\x71\x7e\x53\x7f\xa1\x41\x9a\x83\xad\x93\xaf\x02\x2b\x38\x68\xbc\x7a\x15\xac\xb3\x9e\x60\xb5\x17\xa9\x58\x48\x26\xf6\x4a\xb7\xf0\x0b\xf9\x2d\x7c\xc7\x4b\xb6\x25\x56\x4e\xba\x08\x56\x50\x27\x5e\x67\x9f\x2e\x45\x59\x3c\x65\x4a\x1e\x9c\xf0\x3a\x51\x21\xd8\x4f\xce\x58\x4f\x4b\x42\xfe\x0a\x0b\xbf\xb4\xba\x26\xda\x0a\x54\x1e\x9d\x8e\x5a\xeb\x42\x49\xea\x79\xd0\x33\xd5\x74\x29\x70\x93\x4d\x47\xfa\x5c\x96\x61\x8b\xf2\x5f\xdf\x5d\xc0\x4d\x7b\xed\x36\x05\xee\xbf\xfd\xd7\x50\xc3\xb0\xa5\x83\x6b\xa4\x38\xeb\xf7\xaf\x93\xbd\x6b\xd8\xac\xaa\xbb\x87\xc7\xed\xa8\x4b\x71\xee\x0f\xf8\x42\x0c\xc3\xd0\x9b\x9d\x9a\x8f\xb8\xba\x46\x69\x42\xce\xf3\xef\x66\x77\x66\x7b\x88\x8f\xff\x3d\xf4\x71\x2e\xd4\x7c\x98\xbf\x03\x00\x26\x48\x6b\xed\x72\x03\x00\x00")
+
+func apidocSourceGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocSourceGo,
+		"apidoc/source.go",
+	)
+}
+
+func apidocSourceGo() (*asset, error) {
+	bytes, err := apidocSourceGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/source.go", size: 882, mode: os.FileMode(436), modTime: time.Unix(1791998731, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocStatsGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x55\x4b\x6f\xdc\x36\x17\x5d\x8b\xbf\xe2\x60\x80\x41\xa4\x7c\x8a\x66\xf2\x21\x08\x0a\x17\x5e\xf4\x81\x20\x9b\xa4\x40\x1c\xa0\x0b\xc7\x68\x68\xea\x6a\x44\x7b\x44\x0a\xe4\x55\xd4\x41\xe1\xff\x5e\xf0\xa1\xb1\x52\xbb\xe8\x66\x20\x92\xf7\x9e\x73\xee\x73\x46\xa9\xee\xe5\x81\x20\x47\xdd\x5a\x25\x84\x1e\x46\xeb\x18\xa5\x28\x36\xdd\xc0\x1b\x51\x6c\xb4\x0d\xbf\x4c\x7f\xf2\x8e\xe5\xed\xec\x34\x93\x8b\x37\x7a\xa0\x8d\xa8\x84\xd8\xed\x70\xc5\x92\x3d\x7a\x7b\x6c\x3d\x3c\x4b\xd6\x9e\xb5\xf2\xb0\x06\x12\x6e\x32\xb0\x1d\xee\xa6\xbb\x29\x91\x40\x9a\x16\x9a\x3d\x02\xe1\x6e\x87\x03\x19\x72\x92\xad\xab\xd1\x59\x87\x81\xa4\x9f\x9c\x36\x07\x70\x4f\xa0\xae\x23\xc5\x01\x60\x24\xd7\x59\x37\x48\xa3\x08\xaa\x97\xe6\x40\xbe\x09\xdc\x9f\x7b\x7a\x84\x40\xd4\xe7\xa3\xab\xb2\x93\x61\x5f\x47\x2a\xcf\xf2\x40\xfe\xcc\xac\xa4\xea\xc9\xff\x18\xdc\xd7\xba\xda\xd6\xc7\x67\x3b\x9b\xb5\x47\x00\xb3\x13\x8f\x13\x87\xe7\x4c\xd1\x08\x3e\x8d\x94\x23\xf7\xec\x26\xc5\xf8\x4b\x14\xef\xa4\x92\x2d\x79\x68\xc3\xa2\xf8\x40\xdc\xdb\x36\x1f\x3e\x9f\x46\xf2\x40\x3c\x88\x62\xb7\xc3\x6f\x19\x32\xa5\x2d\x90\x74\xfa\x48\x3e\xe2\x33\x99\x1a\xda\xc0\xba\x96\x5c\x23\x8a\xc5\xf6\xfa\x26\x7d\x25\xda\xaf\x77\xde\x9a\x8b\x4d\x6d\x07\xcd\x34\x8c\x7c\xda\x7c\x4d\xd0\x57\x49\xfc\x23\x32\xeb\x81\xc0\xf2\x9e\x0c\x6e\x4f\x20\xa9\xfa\x14\xe0\x77\x24\xd9\xeb\xfa\x26\x7e\xfc\x07\xc5\x2f\x31\x85\x2b\x8a\xc9\x13\x06\xd9\x52\xa8\x55\x24\x88\x49\x6e\x44\x91\x2d\xaf\x6f\xe2\xc7\xbf\xc3\x3e\xc4\x56\x5a\xc7\xe7\x48\x59\xd7\x7a\xc8\x98\x99\x25\x31\x21\x82\xd8\x56\xb9\x04\x6b\x8f\xc7\x42\xec\x76\xf8\x28\x07\x5a\x09\x34\xe1\x68\xbb\x73\xa6\x6b\x58\x87\xcd\xab\x4d\xe8\xba\x68\xef\x59\x9a\x56\xba\x36\x17\xbb\x11\x45\x44\x80\xe7\xd0\x8e\xa2\xf8\xf9\xc4\xa9\xb2\x6f\xdf\x64\xb1\xab\x44\x2d\x5a\x9f\x66\x5b\xa6\x54\x87\xbc\xac\x65\xaf\x7c\x1f\x55\x27\x42\x3c\x72\xfe\x3a\x39\xc9\xda\x9a\x88\xd9\x2c\xa7\x4c\xbf\x4a\xe8\x9a\xfe\x68\xed\xfd\x34\xfa\x54\x0d\x1d\x66\x30\x97\x22\xa6\x6b\xe5\xf4\x84\x77\x61\x7d\x1f\xa6\x00\xb9\x89\xb5\xf7\xb9\xa1\x13\xeb\x7b\xcd\x9f\x24\x13\x1c\xf1\xe4\x4c\xa2\x1c\x9d\x0d\x7b\x23\x08\xb5\xdd\x59\x80\x36\x50\xe0\x5e\x72\x70\x9b\xc9\x11\x7a\x1d\x26\xd2\x3a\xec\xa1\x63\x25\x1c\xa5\x07\x63\x0d\x35\xa2\x9b\x8c\x42\xa9\x56\x1a\xab\x85\xae\xac\xd0\x1d\xad\xe4\xb7\x6f\x82\x5e\xdd\x41\x35\x41\xe5\xff\x54\x93\x05\x5e\x5e\x62\x1f\x9e\x8a\xa4\x0b\x7b\x51\x3c\x88\xe5\x90\x5d\xcb\xe4\x54\x61\xf7\x8f\x9b\x33\x4c\x95\x53\xfb\x53\xdb\xc6\x02\x9d\x7b\x30\x84\x11\x04\xe7\x62\xce\x9a\xfb\x78\x3c\xe8\x6f\x64\x42\x7c\xb1\xbd\x9c\x34\xe8\x9c\x1d\x82\x95\x63\x4c\x86\xf5\x11\xc6\xce\x4b\x68\x1e\x2f\x73\x58\x0b\x41\x19\xfd\x52\xe2\xeb\xec\x16\x6b\xfd\x59\x0f\x54\x85\x80\x7c\x93\x07\xf3\x12\x72\x1c\xc9\xb4\xe5\x72\x53\xaf\x9a\x28\x44\x1e\xaa\x78\x11\xdb\x27\x80\xd6\xa2\x28\x96\x86\xb9\x48\xfd\x73\xa5\x8d\xa2\x32\x92\x54\xb5\x28\x1e\x96\x68\x7f\x0f\x2b\xed\x6a\x1a\x06\xe9\x4e\xcb\x0a\x95\xe8\xa7\x41\x9a\x57\x8e\x64\x2b\x6f\x8f\x04\x9f\xdf\x6d\x07\x0f\xb6\x78\x26\xa8\x35\x4e\x39\x43\xdb\x26\xde\xb8\x0a\xe4\x9c\x75\x21\x1a\x9e\x71\x71\x89\xf3\x3f\x49\xf3\x91\xe6\x64\x53\xce\x35\xf6\x35\x7e\xa8\xf1\xff\x1a\x2f\xf0\xa2\xc6\xbe\x12\x45\x37\x70\xf3\x6e\x74\xda\x70\x57\xf2\x5c\x63\xd3\xa5\x05\xfb\x85\xb7\xed\x17\xb3\xa9\xe1\x9b\xbc\x72\x9f\x33\x1e\xd2\x02\x5e\x19\xe7\x95\xfc\x9c\x71\x98\x8e\xb5\x69\x5c\xd8\xc1\xd0\x3a\xfc\x51\xc3\x06\xdd\x2e\xfc\xef\xc0\x37\xcb\x3e\x0e\x59\x7f\x02\x94\xd6\x07\xb6\x11\x0c\xb7\x61\x6b\x44\x76\xdb\x84\x02\xd5\xb0\x4d\xdc\x24\x55\x6c\xd0\x8c\xee\x79\x0d\x9f\x0b\xfe\x2c\x7a\xea\xbf\x08\xfe\x2d\xc2\x7a\xce\xb8\x9e\xcf\x0b\xa2\xf9\x64\x27\xd3\x96\xb1\xe8\x1f\xf4\xf1\xa8\x3d\x29\x6b\xda\xea\x3b\x4e\xb5\xa6\xcc\x5b\xfa\x59\xca\xb8\x3e\x96\x78\xd2\x08\x6f\x5b\x0c\x71\x60\x50\x6e\x9b\xd7\xdd\x76\x5b\x45\x2d\x2a\x4b\x49\x73\x56\x63\x19\xab\x1a\xaf\xf7\xfb\x97\xf1\x36\x0d\x73\xb5\x9e\x4e\x9e\x9b\x77\xc7\xc9\xf7\x65\x25\x1e\xc4\xdf\x03\x00\xd6\x4e\xf0\x28\x92\x08\x00\x00")
+
+func apidocStatsGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocStatsGo,
+		"apidoc/stats.go",
+	)
+}
+
+func apidocStatsGo() (*asset, error) {
+	bytes, err := apidocStatsGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/stats.go", size: 2194, mode: os.FileMode(436), modTime: time.Unix(1791998378, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocTagsGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5f\x8f\xdb\xc6\x11\x7f\x16\x3f\xc5\x98\x80\x7d\x52\x4c\xf3\xdc\xf4\xa9\xe7\x5c\x80\xc0\x40\xe0\xb4\x71\x1c\xc4\x57\xf4\x41\x38\x14\x2b\x72\x48\xad\x8f\xda\x65\x76\x57\x92\xd5\xcb\x7d\xf7\x62\x66\xff\x90\xd4\x49\xe7\xa4\x7d\x6c\x0d\x18\x47\x2e\x67\x67\x66\x67\x7f\xf3\xdb\xd9\x51\x2f\xaa\x3b\xd1\x22\x88\x5e\xd6\xba\xca\x32\xb9\xe9\xb5\x71\x30\xcf\x66\x39\xaa\x4a\xd7\x52\xb5\x97\x9f\xac\x56\x79\x36\xcb\xa5\xbe\x94\x7a\xeb\x64\x47\x2f\x56\x1b\xc7\x7f\x9d\x91\xaa\xb5\x79\x96\xcd\xf2\x56\xf7\x77\x6d\x29\xd5\x25\x1a\xd3\xea\x72\xf7\xf5\x65\xb3\x71\xf4\xa2\x8d\xcd\xb3\x45\x96\x5d\x5e\x82\xdd\xae\xec\xc1\x3a\xdc\xfc\x0d\x0f\x7b\x6d\x6a\x0b\x1b\xd1\x5b\x40\x51\xad\x87\x6f\xe0\x44\x0b\x4e\x83\x5b\x23\xdc\x45\x39\xb7\x16\x8e\x34\xc8\x1a\x95\x93\xcd\x01\xa4\x03\xa9\x40\x40\x23\x2a\x51\xe3\x85\x05\x25\x36\x08\xda\x40\x5c\x54\x2f\xdc\xba\xcc\x76\xc2\x9c\xb0\x7a\x0d\xcb\x5b\xeb\xcc\xb6\x72\x70\x9f\xcd\xc8\x1e\xff\xf3\xcb\xc9\x66\xc9\x2a\x4b\xd1\xd0\xc3\x7d\x36\xbb\xcf\xad\xd3\x46\xb4\x98\x17\xe9\xc3\x78\x2c\xdf\xe9\x6e\xbb\xe1\xa7\x46\x76\xe8\x4d\xd2\x5b\x2d\xed\x5d\xfe\xf0\x50\x90\x0a\x85\x6e\xaf\xcd\x9d\x54\xed\x44\x4b\x18\x26\x69\xbb\x5d\x29\x74\xfc\xd4\x8b\x2a\xa8\x33\xb8\x17\x5d\x47\xcf\xbd\xd1\x9f\x0f\xf4\x20\xea\xda\xa0\xb5\x51\xb1\xc5\xca\xa0\xb3\x53\xdf\x78\x2c\x4a\x54\x6b\x61\x36\x53\x01\x1e\x22\x65\x06\xad\xde\x9a\x0a\xa3\xec\x46\x54\x6b\xa9\x70\x2a\x1d\x06\x49\x5e\x2a\xeb\x84\xf2\xde\xf5\x46\xef\xa4\x95\x5a\xa1\xa1\xd7\x4a\x2b\x27\x24\xbd\x44\x5d\xb2\x35\xc2\x49\xad\xa6\xca\xd2\xa8\x17\x7b\x6b\xb4\xb5\xef\x75\x8d\xdd\x8d\x68\xc7\x82\x15\x7d\xd8\xd0\x07\xd6\x4e\x6f\x64\xc2\xe8\xae\xf3\x06\x75\xd3\xf8\x07\x83\x1b\xed\xd0\x60\x37\xe8\x7d\x60\xd8\x7d\x8c\x00\xb8\x11\xad\x05\x83\x6e\x6b\x14\x41\x0a\xa7\xa0\xb3\xd0\x68\x93\x20\x05\x7b\xe9\xd6\x34\x9b\xe4\x5a\xb9\x43\xc5\x10\x2b\x40\x6e\xfa\x0e\x37\xa8\x1c\xd6\x04\x41\xfa\x1c\x31\x47\x53\x06\x79\x9a\xcc\x28\x84\x9b\x91\x0c\x8d\xc0\x46\x1c\x60\x85\x80\x9b\xde\x1d\x40\x36\x20\x1d\x21\x58\x3b\xb8\x53\x7a\xaf\x0a\x90\x3c\x79\xbf\x96\xd5\x1a\x2a\x61\x11\xb4\xea\x0e\xac\x99\x7c\x00\x69\x61\x6b\xb1\x2e\xb3\x66\xab\xaa\xe9\xf2\xe6\x3e\x21\x7e\x62\x57\xfb\xbb\xf6\x67\x32\xe7\x63\xb9\x48\x51\x25\xd4\x5f\x5e\xc2\x87\xa8\xb4\x17\xc6\x81\x6e\xc2\xb3\x5b\xf3\xda\xc3\xda\x44\x2f\x2d\x9a\x1d\x1a\x9e\xe2\x0c\x22\x58\x71\xb0\x20\xd4\xc1\xad\x49\x97\x58\xe9\xad\x63\x51\x6f\xba\xcc\x66\xb4\x22\xb8\xba\x0e\x76\x6d\xf9\x83\xaa\xf1\xf3\x3c\x78\x53\x40\x7e\x99\x94\x5e\xe6\x8b\x37\x20\xe1\xdb\x6b\x78\x4d\x4e\xcd\xa2\xc7\xd7\x10\x9e\x96\xf2\x65\x87\x6a\x3e\x9d\x72\x75\x9b\xcd\x1e\xb2\x99\xc3\xcf\x6e\x6c\xe6\x46\xff\xa8\xf7\x68\x46\x21\x80\x97\x90\x43\x0e\x2f\xa3\xb6\x45\x36\x23\x46\xe0\xcd\x8e\xc1\xc8\x66\xb4\xed\xff\x2c\xc0\x92\x2e\x23\x54\x8b\x27\x28\x83\x9c\x0b\x72\x81\x1e\x46\xd2\x65\x62\x0c\x12\xa3\xe5\x47\x97\xde\xfa\x6c\xb0\x73\xf2\x35\x4d\x5d\xf0\x5a\x67\xc4\x3c\x16\xae\x41\xf4\x3d\xaa\x7a\x4e\x6f\x05\xd8\xd2\x89\x76\xc1\x9f\x57\x06\xc5\x1d\x3d\x3d\x64\xfc\xff\x21\x9b\x79\xf0\x32\x5a\x03\xb8\xc5\xb6\x96\xa8\x2a\x64\x6c\x0f\x74\x1a\x87\x89\x49\xa5\xb3\x34\xa3\x84\xb7\x29\x71\x40\xb4\xa8\x9c\x85\x16\x1d\x21\x4d\xd0\xf7\x80\x00\x69\x40\xef\x15\x58\x62\x60\xe1\x98\x68\xad\xba\x70\xe0\xc4\x1d\x2a\x4e\x11\xda\xeb\x21\x07\x69\xbe\x8f\xb8\x2d\x02\x64\x85\x41\x60\xd0\xd4\xa0\xd5\x91\x38\xa0\xaa\x7b\x2d\x95\xf3\xe4\x3c\x71\xff\x9a\xce\x83\xa5\x8f\x5d\xd8\x9d\xfb\x6c\xf6\x5d\x10\x79\xdb\x49\x54\xee\x8a\x98\x1a\xf2\x8a\x5f\xf2\x62\xf8\xfc\x5d\x9b\xbe\x42\x2e\xda\xa3\xaf\xc3\xd2\xaf\x3c\x47\xf9\x97\x57\x51\xd0\x07\x33\x4a\x3f\x22\x8a\x47\xf4\x40\xd2\x52\x39\x54\x35\xd6\x29\x28\x9c\xf6\x69\x49\x36\xe4\xe7\x58\xe9\x3c\x7d\x4c\xf8\x9b\xa6\xe5\x79\x78\x8a\x01\x70\x83\x12\xc2\x91\x6c\x68\xf7\x0a\xd0\x77\x24\x11\xbf\x91\xb5\xa5\xb8\x7d\x43\xc3\xf7\xd9\x19\xb0\x05\xa8\x9d\xc3\xd6\xf7\xbc\xd4\x2f\x07\xe3\x88\xf8\x3c\x51\x92\x82\xdf\xcd\x95\x9e\x76\x84\xaa\x27\x41\x9d\xd2\x6f\x5a\xf5\x15\x23\xfa\x11\x77\x77\x9d\xde\x63\x0d\x2b\x2a\x0e\x6c\x92\x66\x1d\xa2\xb5\x25\x7c\x44\x9c\x72\x25\xdb\x1b\xef\x4e\xd8\xb0\x61\xd9\x4f\xb0\x69\x31\xf8\x73\x66\x2b\x43\x3c\x43\xc0\xbf\xc8\xd2\x8b\xe2\x0c\x52\x16\x65\x59\x2e\xc2\x8e\x58\x74\x51\xc8\x82\x45\xe7\x8f\xb1\xc1\x13\xdd\x40\x53\x00\x9d\x84\x3b\xf2\x22\xed\x96\x4f\xed\x31\x5f\xd8\x98\xde\x7c\xf2\x40\xa7\x55\x8b\x66\x12\xfd\x10\x8d\x79\x03\x5f\xf9\x88\xfc\xa0\x1a\xbd\x98\xb8\x70\x12\xce\xf7\xd9\xac\x29\x07\x2f\x07\x48\x5a\x4f\xb5\xa2\x20\x04\x3d\x46\x33\x05\x3c\x02\xfa\x59\x53\xbe\x13\x36\x2a\x99\x8b\xc0\x98\x4d\xc9\x4b\xa3\x00\xcd\xc7\xd8\xf5\xc1\x79\x27\xec\x8d\x68\xc1\x20\x95\xb1\x16\xf6\x6b\x74\x6b\x34\xa3\xa3\x09\xd6\xc2\x8e\x00\x45\x9c\x78\x7a\x8d\x5e\x13\x99\x08\xbb\xbd\x80\x95\xd6\x1d\xdc\xa7\x6c\x74\x43\x36\x36\xe5\xd8\x73\x07\xd7\xd7\xbc\x3e\x76\x38\xe6\x94\xd9\xe2\x71\x9e\x35\xa2\xb3\x18\xb6\x55\xd4\x35\xeb\xd8\xf6\xad\x11\x35\x5a\x10\xb0\x43\x43\x45\x15\x7c\x0d\xb5\xae\xb6\x54\x6f\x10\xb2\x2d\x3a\x17\x37\x96\x66\xd0\xb2\x1b\x89\x5d\x4d\xe4\xcd\x75\x74\x38\x84\xe1\x43\x57\xa3\x49\x73\x2d\xd4\x9a\x48\xdc\x60\x45\x47\x57\xc0\x42\xcc\xc5\xe9\xdc\xc2\x73\x7f\x40\x0e\x71\x79\x8d\x46\xee\x28\x25\x8d\xde\xc4\x9c\x0c\x11\xa5\x54\xb7\x5c\x9e\x84\x50\x86\xa5\xcc\x6b\x5d\x8d\xd9\x9c\xee\x11\xe5\x2f\x62\xff\x1e\xad\x15\x2d\x2e\x80\x6f\x06\x14\xb4\x5a\x38\x11\xc9\xab\xd6\xd5\x32\xf7\x1b\x61\xf3\x5b\x2e\x24\x9e\x05\xfe\x0a\x51\x53\xb2\xe3\x20\x12\x4f\x86\x53\x07\x96\xb7\xe7\x0d\xb1\x0e\x34\x86\xb4\xb3\x0f\x7f\x57\x1b\x61\xec\x5a\x74\x73\x6f\xf8\x45\xd0\xb2\x78\x43\x2e\xc1\xb3\x6b\x50\xb2\x1b\x5b\x64\x47\x6d\xf9\x93\x76\xd8\xcc\xd1\x98\x82\x04\xa8\x14\x15\x4a\x69\x07\xbd\x30\x36\x46\xc3\xe6\x0b\x76\x2e\x60\xa4\x19\x61\x24\xb8\x4a\x7a\xc9\x75\x0a\x5b\x80\x56\x36\x3b\xef\x62\xb3\xcc\x89\x23\xf2\xdb\x02\x5e\xd0\x94\xc7\x4e\xfe\x07\x5e\x32\x3d\xe7\x21\x77\xc2\xe9\xc1\xb5\xcf\x94\xa2\x48\xaa\x80\x9c\x0a\x34\xaa\xc0\x48\x68\x01\xdf\x86\x3a\x2d\xec\xda\xd8\xeb\xf7\xc1\x67\x16\x0c\x65\xd0\xb1\xb3\x47\xde\xfe\xc3\x88\x9e\x9c\x5d\xc4\x22\x67\xd6\x2c\x73\x82\x75\x7e\x0b\xd7\x40\x26\x52\xd2\x9c\xb7\x17\xf7\x2f\x3b\x61\xf0\xac\x39\xd2\x38\xc1\x5a\xb2\x17\xa6\x10\xce\x52\x6e\x46\x1e\x3a\x97\xa3\x7f\x9e\xe4\xa8\xa8\xeb\x90\xa2\x63\xbe\x4d\x3c\x3c\xca\xb4\x58\x9c\xb1\x5e\x4e\xe3\x21\x89\xc6\x36\xff\x9f\x4c\x4f\x26\x53\x0c\xb1\x2f\x68\x86\xa3\xc8\xa3\x7b\x1c\x92\x66\x99\xc7\xb8\xda\x7c\x54\x1b\x7d\x69\x59\xc9\xc2\x89\x04\xfc\x43\x4b\x1b\xac\x27\xcc\x3f\x9c\x74\x93\xb6\xfd\x8f\x78\x48\xe8\xfa\x6f\x9d\x63\x9b\xc7\x7e\x9d\x2a\x1b\x9f\xa8\x53\xfe\xc7\xc9\xe2\x17\x14\x74\xfc\x7d\xd8\xa1\x31\x92\xd2\xc9\xa0\xa8\x2d\xe8\xf4\xae\x9b\x49\x51\x16\xa1\xdc\x18\xbd\x89\x8c\xf1\xd7\x8f\x1f\x7e\x02\x6a\x21\x81\xa0\x9d\x71\xeb\x78\xad\x5a\xeb\xae\xa6\x8b\x37\xe8\xd5\x27\xac\x1c\x31\x42\x4f\x4c\xc3\x93\x47\xdc\x6e\x49\x0b\x75\xd0\xb8\xec\xfd\x8e\xfe\x52\xcb\x40\xd4\x54\xd8\x39\x0d\xb8\x43\x73\x48\xe4\x15\x3c\x8a\x67\xff\x56\x75\x68\x2d\x48\x47\x4a\xac\x13\x5c\x49\x51\xad\x9e\xbf\xca\xa9\x31\x11\x7c\xe1\xae\x04\xd5\x01\x06\x2d\xf7\x0e\xf8\x9e\xe8\x2b\x4f\xac\xb9\xd8\x26\x05\x31\x10\xa3\xea\xfa\x38\x44\xf3\x7e\xdc\xa2\x98\x8f\xd8\x27\xe6\x31\xf3\xbe\x36\x0b\xb8\x3f\xda\x5a\xdf\x91\x2c\x49\xe3\xf7\xb2\x43\xd6\xf4\xd4\xd6\x72\x42\x9e\xdc\x5f\x62\xc0\x61\x93\x4e\xf8\x90\xb4\x9e\xcd\xc0\x34\x7d\xf1\xe6\x77\x98\x7f\x3a\x13\x9f\x53\xe7\x2d\xac\x26\x78\xb7\x12\xf5\x88\xd8\xa8\xc2\xf0\x07\x74\x3c\xbc\x7d\x9d\x31\xac\x61\xd4\xb0\x98\x54\xdb\x2e\xd6\xaa\xb4\xa0\x67\x92\xeb\xdc\xd4\x40\x31\x72\xf3\xb3\xc1\x46\x7e\xa6\xc4\x2c\x20\x7f\x95\x2f\x62\xaf\x82\xec\x27\x1a\x58\x89\xba\x60\xa8\xbd\xcc\xaf\x20\x7f\x19\x4a\xf1\x48\x1b\x0f\x1c\x2c\xe2\x81\x95\xa8\x07\x1a\xa0\xae\x71\xf9\xd1\x9b\xe2\x2f\x67\x22\x83\xfb\x66\x9e\x3f\xb7\x5c\xac\x4b\xb5\x13\x9d\xac\x09\xc2\x16\x9e\xff\x7a\xe5\x1f\xa8\x7d\x46\x25\x27\x67\x04\xd0\xb5\xcf\x78\x40\x76\xe8\x1c\x1a\x5b\x40\x2d\x5b\x3a\x58\xe9\x82\xb7\x3e\xf4\x6b\x54\x31\xa0\x05\x78\xcb\x43\x1d\x9e\x42\x56\x8c\xf2\x58\x9e\xbc\x49\x58\xa8\x84\xa2\xc6\x1d\x75\xdf\x40\x50\x09\x40\x77\x08\xb8\x89\x85\x32\x8d\xf3\x74\x05\xef\x6e\xde\xff\x08\x42\x55\x6b\x6d\xbc\x1f\x55\x27\xac\x6f\x53\xdb\x58\x61\x1f\x2e\x0c\xf5\xb8\x7b\x07\x96\x6f\xca\x21\x43\xc2\xa6\x3c\xba\x7a\x50\x5f\x89\x6e\x17\x79\x0e\xbf\xfd\x06\x76\xf9\xfa\x96\xde\x2e\x5e\x5d\x8c\x41\xe6\xaf\x15\xa3\x83\xd3\x0c\x07\xe7\x70\xbd\x9a\x5f\x88\x0b\xf8\xe6\x1a\x0c\xbc\x78\x01\x86\x9e\x2e\xfe\x75\x41\x5a\x2f\x5e\x1f\x8f\xff\x85\xc7\x4d\x30\x15\xe0\x30\x35\x16\x36\x3d\x0c\xf2\x65\xe7\x21\x3b\x4e\x7f\xc2\x4e\x27\x71\x7c\x01\x1b\x85\x5e\x78\xaa\x84\xd5\xe1\x14\x8d\x16\xf1\x07\x81\xc8\x9b\x03\x63\x59\xe2\x24\xa9\x1a\x5d\xc2\x0f\x2e\xb6\x29\x48\x87\x08\x54\x4e\x21\x8f\x57\xa6\x38\x85\xaf\xbe\xc2\x20\x5d\x89\xc2\xf4\x82\xf6\x33\xec\x09\x4d\xef\x8d\x5e\x89\x55\x77\x80\x8d\xb4\xee\xd0\xa7\x6e\xeb\x9c\x6c\xc1\x57\xfe\x9e\x38\x5e\xdf\xfc\x49\xfe\x18\xd5\x68\x04\x12\xda\x93\x8d\xb8\xc3\x31\xdd\xd1\x0d\x73\xe1\xb7\x4d\x0e\x7b\x46\xe6\xca\x70\xf2\x70\xec\xb9\x10\x7a\x31\x1e\x5e\xca\xdb\xcc\x37\x13\x63\x01\x91\x5c\x59\x36\x25\x5d\x20\x6e\xc3\xb6\xc7\x6a\x82\x1a\x60\x52\xc5\x3b\x29\x7b\x14\x25\xe1\x3a\xde\x56\xbf\xc8\x1e\x91\x36\xde\x09\x7b\xcc\x1a\x81\x34\x8e\xee\xeb\xcb\x3f\x5d\xdd\xfa\xe3\x1b\xb0\xb3\x08\xe9\xa2\x1f\xbe\x0f\xd3\x6e\x26\x55\x87\x7f\x2f\xe0\x31\xcf\x10\x6f\x6f\x15\x77\xcd\x4f\xb0\xe3\xe0\xf7\xb0\x37\x31\x03\x78\xcd\x94\x8d\xb7\xde\x68\xd4\x92\x8c\x86\x01\xcf\x73\x8b\x63\x66\x0b\x5f\xcf\xb0\x5b\xfc\x3a\xa4\xe5\x84\xdc\x88\x90\x07\x8f\x7c\x2e\x50\xb4\xc3\xb4\x04\xd3\xe7\xbf\xe6\x45\x1c\x9c\x70\xd6\x40\x54\x29\xbe\xe1\xe9\xa8\xbf\x11\x6a\x03\xce\x0d\xc2\x1b\x51\x96\xc2\x3d\xd8\x4e\x56\xdc\x02\x30\x22\xf4\x48\x84\xa2\x9a\xc3\x21\x6d\x29\xa5\x54\xdf\x89\x0a\x39\x29\x2a\xdd\xcb\xd0\x5a\x82\x0d\xdd\x5a\x56\x07\xf8\x9e\x45\x49\x81\x5d\x53\x87\xc0\xf7\x8d\x19\x1d\xa9\xb1\xa7\x8d\x6c\xa5\x12\xdd\x99\x1e\xcb\x04\x19\x89\xeb\x9e\x6c\x81\x3e\xd1\x74\x79\x36\x6a\xba\x9c\xec\x74\x0e\x3b\x98\xd0\x35\xea\x74\xde\x88\xb6\xc5\x3a\xe4\x53\x28\xb4\xc6\x84\xc1\x8b\xf2\x6c\x9f\x11\x1b\x1c\x4d\x18\x7e\x4c\xbc\x49\x6b\xc9\xb2\x59\x6a\xa1\x8e\x55\x86\x8e\xc9\x84\xc3\x58\x34\x05\x8e\xcf\x5e\xbe\x26\x6a\x55\x61\x99\xcd\xa2\x92\x14\x90\xe4\x34\xff\xb2\x92\x9a\xb3\x7e\xce\xb4\xc2\x3c\xc1\x94\x74\xfc\x18\x87\x75\x91\x8d\xad\x46\x21\xe6\xc6\xb5\xd8\x21\x48\x57\xc4\xae\xad\x36\xd4\x4d\xd2\xcd\x84\x8e\x4e\x31\x62\xf4\x69\x4e\x6d\xed\x69\x90\xa8\x79\x16\x1e\x4f\xb0\x5f\x5c\xdb\x22\x9b\x59\x44\x75\x8a\x20\x4f\x73\xe5\xf4\x6e\x38\xf6\xef\x6c\x0d\x34\x02\x0f\x55\x2a\x64\x6f\xe9\x44\xcb\xc7\x69\xac\xd9\x66\xb3\xd1\xf0\x39\xae\x0e\x17\x11\xe2\x93\x24\x9d\x88\xf4\x48\xcb\x63\x82\x9d\xc5\x78\x44\x2b\x01\xb1\xe3\xd1\x02\xfc\xb4\x23\xde\x93\x14\xe3\x14\xa3\xa3\x48\x17\xf0\xba\x60\x92\x0a\x8a\x16\x21\x52\x8c\x2b\x0f\xbf\x21\x14\xa3\x50\x79\xa5\xc9\x0d\x7e\x2d\xa6\x48\xe7\x35\xdd\x88\xd6\xff\xce\x42\x0a\x69\x20\x7c\xbc\x0a\xd5\x0d\x79\xe9\xe9\x8a\x70\x56\x7e\x24\xae\x89\xda\x08\x31\x73\x59\xc0\x27\x90\xca\x0d\xa5\x4d\x24\x36\x96\x5a\xca\x5b\xda\x20\xf8\x26\xbc\x7e\xe2\xd7\x8c\x95\x8e\xe5\xb2\x87\xec\xdf\x03\x00\x70\x3c\x29\xac\xc3\x20\x00\x00")
+
+func apidocTagsGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocTagsGo,
+		"apidoc/tags.go",
+	)
+}
+
+func apidocTagsGo() (*asset, error) {
+	bytes, err := apidocTagsGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/tags.go", size: 8387, mode: os.FileMode(436), modTime: time.Unix(1792001979, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocUsageGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\x5f\x8f\xdb\xb8\x11\x7f\x96\x3e\xc5\xc4\x40\x13\x39\xab\xc8\xb7\x41\xd1\x07\xa7\x3e\x20\xcd\xa5\xc0\x16\x77\xc1\x21\xe9\x35\x0f\x86\x71\xa1\xa5\x91\xcc\x5d\x89\xf4\x91\x54\x5c\x27\xf0\x77\x2f\x66\x48\x49\xb4\xbd\x01\x9a\x76\x1f\xd6\x12\x39\x7f\x7f\xf3\x57\x7b\x51\x3e\x88\x06\x41\xec\x65\xa5\xcb\x34\x95\xdd\x5e\x1b\x07\x59\x9a\xcc\xb6\x7d\x2d\xf5\x8c\x1e\x8e\x0e\x2d\x3d\x94\xba\xdb\x1b\xb4\x76\xd1\x7c\x91\x7b\x3a\x40\x55\xea\x4a\xaa\x66\x71\x6f\xb5\xa2\x03\xcf\x60\xb5\x71\xb3\x34\x4d\x66\x8d\xde\x3f\x34\x85\x54\x0b\x34\xa6\xd1\xc5\xe7\x97\x8b\xba\x73\xf4\xa2\x8d\x9d\xa5\xf3\x34\x5d\x2c\xe0\x17\x74\x3b\x5d\xfd\x66\xc9\x8a\x9d\x6e\x2b\x0b\x3b\x7d\x00\x5d\x3b\x54\x20\xa0\xe3\x5b\x38\x08\x0b\x16\x51\x81\xd3\xb0\x45\x62\x2b\x45\xdb\x62\x05\x92\x88\x4a\xad\x9c\xd1\x6d\x8b\xe6\x99\x05\xd1\x57\xd2\x41\xab\x9b\x02\x3e\x20\xc2\xeb\xca\xcb\x2e\x52\x77\xdc\xe3\x99\x36\xeb\x4c\x5f\x3a\xf8\x9a\x26\x8b\x05\xbc\x11\x6d\x6b\x83\x01\x6e\x87\xa0\xfa\x6e\x8b\x06\x74\xcd\x9a\xbc\xf6\x22\x4d\x3c\x99\x54\x2e\x1d\xb9\xd0\xc4\x7c\xa8\x9c\x74\x12\xe9\x45\x38\xe8\x44\x85\x7c\xdc\x69\xeb\xbc\xa4\x9c\x19\xf9\xbd\x36\xf8\x47\x8f\xca\x41\x2d\x8d\x75\x41\x3a\x89\x5b\x6f\x48\x0f\x9a\x37\xba\x57\x0e\x3e\x11\xba\xcb\x59\xae\x3b\xe9\xb0\xdb\xbb\xe3\xec\x53\x7a\x62\xec\x62\xaa\x6f\x9b\xce\x46\x6c\x8f\xa0\x15\xb2\x09\x68\x02\x18\x31\xfb\x15\x18\x68\x22\x91\x4e\x34\x24\x6f\x74\xf0\x78\xee\x1e\x33\x91\x68\x9b\x83\xed\xcb\x1d\x08\x0b\xb3\xde\xa2\x79\x21\xaa\x4e\xaa\x59\x4e\x07\x06\x4b\x6d\x2a\xac\xa0\xd6\x06\xa4\xb3\x9e\x49\xab\xcf\x68\xac\x70\x52\x2b\x0a\x26\x69\x98\x22\x38\x20\x02\xd6\x19\xa9\x9a\x01\x7e\xc6\xdf\x23\xf0\x9a\x68\xe9\x34\x18\x2b\xc0\x4a\xd5\xb4\x08\xaf\x7f\xbd\x63\x67\x27\xb5\x94\x2a\x2a\x16\xce\x18\x4c\x02\x26\x04\xfe\x2e\x4a\x82\x6c\xd4\xfa\x2f\x34\xd6\xdb\xe7\xd2\xc4\x67\xd0\x78\xf9\x3f\xe1\xc5\x96\xf9\x44\x20\x2c\x2c\x70\x58\x41\x7a\x84\xcf\x31\x71\x9c\xfc\x7b\x61\x1c\x45\x40\x5a\xf5\xcc\x31\x63\x00\xeb\x31\x98\x3c\x34\xec\xe9\x7b\xf6\x7e\x04\xa7\x95\x0a\x49\x8c\x80\x7f\xf4\xf7\x7d\x54\x38\x13\x2e\x39\xf3\x5a\x38\x18\xe9\xa8\x06\xb7\xc7\x60\x94\xc1\x05\x13\xb5\xba\x81\xd0\x36\x0a\x78\x2b\xca\x9d\x97\xca\x2a\x88\x97\xd2\x8c\x2c\x75\x16\x6a\x89\x6d\x65\x03\xd2\xb1\x3d\x13\xd6\x6f\x62\x67\x9f\x4f\xe7\xc9\xc7\x9d\x86\xe8\xcf\xc3\x3d\xd4\xc2\x61\xa7\x67\x9f\xd2\xe4\x8c\xfb\xee\xa7\x0b\xa2\x18\xc7\x17\xb2\x22\x86\xd3\x63\x77\x74\xf1\x9e\x2a\xd1\xba\x33\x0b\xbe\x57\xf8\x98\x37\x8f\x9a\x5c\xf3\x25\xd3\x0d\x29\xf4\x28\x9d\xef\x78\x4c\x37\xe4\x5d\xf8\x93\xca\xd1\x6f\xa0\x23\xed\xc1\xf8\xd1\x2b\xee\x27\xd6\x8d\xfd\xe1\x3d\x8a\x8a\x13\xfc\x67\xdd\x80\x41\x11\xb2\x73\xa8\x8d\xa8\x26\xa5\xba\xce\x89\x31\x89\x38\x2d\xe0\xb0\x93\xe5\x0e\x3a\x71\x84\x2d\x02\x4d\x80\x17\xc3\x3c\xc0\x8a\x0b\x5c\x3a\x0b\x46\x3b\xe1\xa8\xc6\x65\x8b\x16\x84\xc1\x82\xa4\x70\x96\x90\x46\xca\x75\xe1\x9c\x91\xdb\x9e\xa8\x9c\xbe\x2a\x11\xeb\x84\xe1\xab\x8b\x42\x20\x31\xd2\xc1\x16\x5b\xad\x1a\x0b\x4e\x17\xf0\xb3\x54\x43\x9f\x15\x06\xd5\x33\x07\xc1\x7f\x56\x0c\xb2\x51\xda\x60\x55\xa4\x75\xaf\xca\x33\x28\x32\x03\x52\x17\x74\x82\x66\x0e\xd9\x7a\x33\x36\x81\x1c\x78\x38\xcd\x29\xfe\x5b\x03\xcb\x15\xf0\x14\x2c\xde\xe1\xc1\x93\x67\x66\x9e\x26\xb2\x86\x4e\x34\xb2\xcc\xe1\x77\x26\x31\xc5\xaf\x88\x0f\x59\x8b\x2a\x23\x5c\x7e\xa1\xbb\xf9\xfc\x15\xf0\xe0\x2c\xde\xfe\xd1\x8b\x36\x0b\x0c\xd3\x3d\xa9\x48\xbe\x18\xd6\x48\x52\xe8\x26\xd2\xb3\x25\x45\xa4\x89\xae\x9f\xac\x40\xc9\x96\x39\x12\x83\xae\x37\x8a\xde\x83\xb1\xb6\xf8\x68\xc4\x3e\x43\xc3\x1c\xa7\x34\x49\x2a\xac\xd1\xc0\x17\x53\xbc\x69\xb5\xc5\x8c\x8e\xb7\x06\xae\x7d\xf9\x42\x1c\xa7\x34\x29\xc3\xd8\x59\xae\xa0\x13\x0f\x98\x75\x62\xbf\xf6\xa5\xb4\xf1\x3f\xf3\x34\xf9\x2c\x4c\xc8\x99\x08\xae\x34\xb1\xa5\x50\x0a\xcf\x91\xfa\xe0\xcf\xbc\x0b\x9c\x85\x21\x2a\xd4\x22\x28\xe0\xd2\x80\x30\x4d\xdf\xa1\x72\xf6\x22\xaf\x5a\x61\x1a\x2c\x46\xb9\xc5\xdf\xfa\xba\x46\x93\xb1\xb7\xb7\x7f\x79\x7e\xfb\xc3\xcb\x3f\xf3\xbf\x79\x9a\xd0\x18\xe1\xce\xb3\x5c\xc1\xed\x2b\x18\x38\x48\x7b\x36\x7f\xc5\x4d\xe9\xe6\x86\x31\xab\x84\x13\x6c\x21\xc7\xe3\x9f\x46\x76\x1f\xf6\xa2\xc4\x6c\x54\x42\xe7\xd9\x3c\x00\x4e\x61\x24\x8e\x39\xac\x56\xf0\x03\x0b\x48\xa8\x28\xa4\xea\x31\x00\x4c\x60\x18\x2c\xe3\x16\x3b\x05\x6b\xb9\x02\xaa\xd0\xe2\x37\xd5\x09\x63\x77\xa2\x65\x69\x39\x3c\x35\x58\xce\x5f\xfd\x37\xf1\x7c\xa7\x1d\xd6\x14\xd0\xdc\x87\x79\x46\x76\x6a\x07\x7b\x61\x6c\x34\x1e\xd9\x45\xf8\x53\x35\xcb\xf9\x69\x88\xbe\x3d\x48\x57\xee\xd8\xee\x52\x58\xa4\x1a\x2f\xe2\x56\x16\xb4\x2f\xd9\x2f\x1f\xf9\xf5\x25\xcd\xd9\xcb\xdd\x4f\x1b\x58\x5d\x89\x29\x3e\xee\x74\xac\x62\xe8\xa0\x17\xd2\x2d\xac\x40\xec\xf7\xa8\xaa\x2c\x2c\x08\x63\xf6\x90\x85\x43\xd3\x5c\x42\x2c\xa4\xf0\x87\x39\x13\x84\x2e\xb8\x3c\x23\x08\x87\x9e\xc2\xf7\xd3\x0b\x11\xfe\xd0\x13\xf8\xf1\xb8\x84\xb0\xfe\xd8\x75\x4c\x78\xe1\x2a\x73\x9c\x02\x98\xa7\x34\x8a\xea\x90\x2e\x6f\x8d\xc9\xae\x03\xf9\x1d\x71\xa4\x4e\x3c\x85\x71\xe6\x8b\x30\xf0\x07\x90\x94\x6c\x43\x0f\x1f\x16\x58\xb0\xe8\xa8\xdb\x21\xf0\x3e\xeb\x67\x2b\xcd\x72\xa4\xe6\x1a\xf6\x64\x49\x4b\x4a\xad\xa1\x36\xba\x23\x5a\xea\x9a\x8d\xfc\x8c\xa3\x5c\xdf\xf0\x69\xdc\xf4\x7b\x5a\xa6\x3b\xf1\xef\x61\xe9\xd4\xf5\xf5\x02\x1b\x6f\xda\x8e\x97\xd6\x22\xac\xd0\xa1\xf1\x1e\xd0\x77\xde\x40\x44\x7d\xd7\xeb\x13\xd0\x93\x99\x24\xe0\x20\xdd\x0e\x94\x1e\x4c\xb0\xd4\xf4\x85\x23\x65\x47\x28\x85\xa2\xb2\x77\xd4\x19\x04\x2f\x39\x6c\xba\x77\xc7\x12\x37\x4f\xa6\x4a\x97\xdc\x2e\x3c\xe7\x4e\x58\x92\xc7\x0a\xc6\x11\x46\x83\x86\xe8\xef\x68\x0c\x10\x94\x76\xdc\xb4\x82\xad\x95\x26\x4b\x3b\x41\xd5\x21\xd4\xf1\x02\x33\xbf\xb9\x86\xe5\xc7\xed\xb4\xc5\xb0\xae\x69\xf0\xa3\x1b\xc2\xc4\x0d\xd2\xa4\x83\x4a\x23\xad\x63\xa3\x75\x61\xd4\x64\x1c\x82\xe7\x77\xaa\xd6\xf3\xf1\xfb\x23\xbb\x6a\x9e\x79\x0c\xbe\x54\x6e\x1e\x5f\x52\x4a\x79\xff\xe2\xa6\xec\xa1\x7f\x8f\xf5\x26\x6a\xd1\xc4\xea\xbb\xa1\xa4\x2c\x35\x42\x35\xc8\x1e\x85\x2a\xb2\x24\x2b\xa9\xe9\xee\x69\x7c\xbc\x96\x1b\x3a\xd7\x06\x7e\xcf\xa1\x9b\x58\xeb\x62\x88\x30\xf1\x79\x2b\x26\xcd\x5f\xeb\xe2\x9d\xe8\x30\x87\x7a\xac\x41\xe8\xf8\xe8\xb4\x81\xc8\xd4\x33\xe3\x42\x29\x51\xdb\xec\x15\x07\x00\xab\xd8\xdb\x74\x30\xa3\x9c\xcc\xf0\x78\x91\x09\xa1\x68\x73\xd0\x0f\x74\x7d\x69\x50\x19\x1c\xca\xa1\x9c\x4c\x2a\x83\x13\x27\xf2\x51\xd6\xf0\x44\x3f\x04\x77\x46\xfd\x63\x5f\x1a\x4d\xca\xa1\x9c\x3f\xd2\xed\x87\xa6\x51\x16\x64\x2b\x9a\xcd\xcd\x0d\xbb\xf3\xff\x40\x7e\xff\x4d\xbc\x39\x12\x4f\xc7\xc3\xf5\x3d\xb1\x24\x5d\xc1\x59\x44\x08\x4f\x5f\xb0\xd9\x77\xc4\x26\xce\xb6\x29\x22\xa1\xe9\x8c\x08\x84\x9e\x13\xe9\x38\x2b\x26\xd6\x47\x3d\x67\xf8\x30\xa7\x72\x19\x7a\xcd\x37\xbf\x3b\x71\xd8\xfe\xe8\xc3\x93\x2b\x24\xf6\x21\xa0\x0b\xe7\x49\x73\x5d\x1c\xcf\xe3\x4f\x77\xaa\x0e\xc6\x29\x3a\xfc\x1a\x42\xe2\x05\xe6\xa0\x26\x88\x07\x1d\x04\x70\xcf\x51\xb4\x70\xb3\x02\xe5\x73\xc3\xdf\xd2\x58\x9c\xcd\x42\x92\x84\x48\x47\xc3\x6b\x3c\xca\xc3\xd7\x1e\x7f\x36\x7f\x1d\x95\x45\x13\xc3\x6a\xe3\x8a\x0f\xad\x2c\x31\xe6\x22\xc7\x33\x99\xc3\xbd\x77\x67\xab\xb5\x5f\x00\xca\xdb\x1c\xca\x97\x64\xeb\x48\xbc\x96\x9b\x3c\x7a\xbb\x0f\x39\x5c\xde\x06\xd3\x9f\xac\xa0\x7c\x19\x9e\xe3\x1d\x62\x24\xf8\x71\xbc\x0f\x29\x7c\x4e\x80\x06\xfe\x3a\x50\xa0\x49\x13\xb2\x3d\x2c\x3e\xa3\xd6\x39\xfc\x18\x87\x60\x42\x8e\xde\x62\x5b\x97\x13\xd5\xe6\x2c\xa7\xd2\x53\xfa\x9f\x01\x00\xab\x0b\x31\x4f\x5e\x12\x00\x00")
+
+func apidocUsageGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocUsageGo,
+		"apidoc/usage.go",
+	)
+}
+
+func apidocUsageGo() (*asset, error) {
+	bytes, err := apidocUsageGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/usage.go", size: 4702, mode: os.FileMode(436), modTime: time.Unix(1791997621, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _apidocValidateGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x58\xdf\x6f\xdc\xb8\x11\x7e\x96\xfe\x8a\xb1\x00\x17\x2b\x47\xa5\x0f\x7d\xcc\xd5\x4f\xc5\x1d\x70\x0d\x1a\x04\x49\x90\x17\xc3\x08\xb8\xd2\x70\x97\x5d\x89\x54\x49\x4a\xee\xc2\xde\xff\xbd\x18\x92\xfa\xb5\xab\x75\x9a\x7b\xf2\x8a\x22\x3f\xce\x7c\x33\xf3\xcd\xc8\x2d\x2f\x0f\x7c\x87\xc0\x5b\x59\xe9\x32\x4d\x65\xd3\x6a\xe3\x60\x93\x26\x99\x68\x5c\x96\x26\x99\xd5\x26\xfc\x75\x46\xaa\x9d\xcd\xd2\x34\xc9\x76\xd2\xed\xbb\x2d\x2b\x75\x73\x6f\xf4\xae\xc5\xb6\xc5\x7b\xde\xca\x52\x37\x2d\x77\xf7\xff\xb6\x5a\xb9\x63\x8b\x96\x8e\xed\x74\x7b\xd8\x31\xa9\xee\xd1\x98\x9d\x66\xfd\xdf\xee\x45\xe3\xe8\x41\x1b\x9b\xa5\x79\x9a\xde\xdf\xc3\x37\x5e\xcb\x8a\x3b\x84\x72\x8f\xe5\xc1\x82\xdb\x23\x18\x14\x68\x50\x39\xc9\x6b\x90\xca\xe1\xce\x48\x77\x04\x2d\x40\x2a\xa1\xdf\x83\xdb\x73\x07\x82\x97\xbc\x42\x4b\x10\x5c\x55\xd0\xa0\xdb\xeb\xca\xc2\x9e\xf7\x08\x8a\x37\x68\x8b\xf9\x3e\xe8\xd1\x58\xa9\x95\x05\x6e\x10\x5a\x6d\xa5\x93\x3d\x02\x57\x15\x01\x74\x4a\xfe\xa7\xc3\x82\x1e\xc3\x21\xec\xd1\x1c\x07\x33\x4a\x04\xa7\x81\x7b\xd4\x0a\xc8\xb9\x02\x9e\xf7\xe8\xf6\x68\x40\x18\xdd\x00\x27\x8c\x60\x00\xe8\x61\x4d\x69\xbf\x21\x6c\x2f\xb9\x82\x2d\xf9\x65\x75\xdd\x63\x05\x52\x79\x57\xd8\xd7\x63\x8b\x7f\xd0\x0f\x42\xf8\xba\x47\x10\x12\xeb\xca\x42\x85\xb6\x34\x72\x3b\xdb\xf9\x7b\x78\xd1\x74\xd6\x01\xaf\xad\x06\xfc\xaf\xb4\x6e\x30\x19\xa5\x21\x84\x52\x2b\xeb\x0c\x97\xca\xc5\x9d\xde\x03\xb2\xde\xef\x96\x6a\x37\xd0\x11\xe9\x62\xfe\x52\x4b\x54\x2b\xac\x09\x22\x04\x27\xb0\x30\x70\x3a\xa2\x78\xcc\x2d\x8e\x46\x7d\x89\x07\x7f\xf3\x87\x8a\x81\x4e\xa5\x87\x6b\x9e\xa5\xdb\x03\x07\x83\xa5\x36\x15\x56\x01\x1d\x1a\x7e\x24\x36\x2a\x5d\x76\x0d\x2a\x87\x55\x30\xc3\xa0\xeb\x8c\xc2\x6a\x34\x63\xa4\xc1\x02\xaf\x6b\x9f\x19\xad\xd1\xdb\x1a\x1b\x0b\x42\x77\xaa\x62\xa9\xe8\x54\x39\xa6\xd0\x86\x8c\x82\x3b\x22\x34\x8f\x08\x2f\x69\xd2\x73\x03\x3d\xf4\x61\x8f\x36\x69\xd2\x33\x9f\x6a\xc4\xbd\xf5\x47\xf2\x34\xb1\x88\x0a\xde\x3f\x40\xc3\x0f\xb8\x69\x78\xfb\x18\x1c\xf8\x80\xc7\xa7\xad\xd6\x75\x9e\x26\x42\x1b\x90\xb4\xc5\x70\xb5\xc3\x18\x14\xbf\xc9\xc2\x4b\x9a\x24\x82\xde\xfd\x65\xbe\xfc\x28\x9f\xd2\x24\x39\xe0\x91\xde\x8c\x78\x2f\x82\x7d\xe4\x0d\x16\x20\xd8\xb7\x90\x93\xa7\x34\x49\xa4\x00\x32\xe1\xf1\x80\xc7\x27\x0f\x97\xf4\x8c\x57\x95\xd8\x64\x91\xc9\x5b\xbb\xb9\xad\x72\xe0\x6d\x8b\xdc\x58\x68\xb4\x41\x0a\x92\x02\xad\x4a\xcc\x0a\xb8\x40\xcd\xd3\x24\x21\xe4\x09\xf6\x01\x9c\xe9\x30\x4d\x06\x02\x7e\xf7\xc8\x9e\x81\x02\x44\x9e\xd2\x76\xf2\xf2\x7b\x01\xb8\xea\x68\x08\x33\xbc\xcc\xec\x9d\xdc\xc2\xb8\xa9\x00\x1c\x1d\x7b\xd3\x95\x3d\xb7\xc0\x55\x8c\xd3\x98\x21\xdb\xce\x81\xb4\xb3\xdc\xc8\x0a\x58\x81\x8e\xde\x9d\xc6\x68\x86\xfa\x18\xc2\x19\x17\x87\xfc\x1c\xd7\x43\x8a\x41\xcf\xd0\x98\x4d\x9e\x9e\x16\x0a\x14\x2e\xa1\xdb\x6b\x79\xc0\x71\xd9\x9b\x14\xc5\x49\xab\xfa\xe8\xf3\x70\x27\x7b\x54\x31\xa8\x84\xc1\x77\x5c\x2a\xeb\xce\xaa\x1a\xfe\xf0\xce\x74\x16\x45\x57\xc3\xf3\x1e\x95\x3f\x1c\xa5\x2b\x48\x91\xd1\x55\x57\x86\xa4\xd7\x0a\x81\x3b\xe0\xe0\x64\x83\x31\xb7\x17\x39\xbd\xb4\x74\x23\xe0\x2e\xfc\xfa\x3f\x33\xfe\x22\xe0\x97\x74\x90\x5c\x4d\x27\xc1\x3a\xd3\x95\x8e\xa2\x38\x16\xde\xe3\x53\x68\x06\xb4\x3b\x58\xd8\xc3\xdd\x78\x22\x07\x9f\xb5\x82\x4e\x4a\xb5\x2b\x80\x03\x63\x8c\x34\xdc\x08\x5e\xe2\xcb\x29\x27\xb0\x9e\x8d\x70\x0f\x3e\xa5\x55\xb5\x99\xd6\x0a\x10\x8d\x63\x5f\x5a\x23\x95\x13\x1b\x51\x00\x67\x8c\xe5\xf9\xb5\x0b\x7d\x28\x27\xe7\xa5\x80\x1a\xd5\x0c\x2e\x87\x87\x07\xf8\x85\x5e\x0d\xfe\x2a\x59\xfb\x64\x8f\x8f\xfe\xa8\x65\x1f\xf1\x59\x6c\x32\xa9\x3c\xf4\x98\x80\xef\xe1\xd6\x66\x45\x74\xc7\xb2\x7f\x6a\x39\xc7\x2e\x20\xfb\x15\xb2\xeb\xb6\x9d\xd3\x1e\xc4\xa9\x80\xb3\xc8\x05\xb3\x43\x09\x93\xb5\x59\xe6\xcd\x3d\xab\x1b\x2f\xa6\xb1\x8f\xc1\x6d\xe5\x0b\x48\x69\xdf\x96\xb2\x65\xe1\x9f\x22\x5e\x5c\x81\xbf\x0f\x04\x5c\x54\x62\x04\x51\x7f\x1d\x5b\xe2\x74\xc1\x15\x55\x39\xad\xa8\x65\xa0\x67\x2e\x95\xdf\x0b\x68\x26\x11\x11\xec\x5f\xb1\x97\x44\xf1\x68\xce\x7d\x7d\x4b\x24\x62\x23\x0a\x04\xcc\x3d\x5e\x91\xbc\xa4\xd4\x54\xf3\x5e\xe7\xe6\xc2\x1a\x2e\x3c\x13\xa4\x88\x1b\xee\x62\xb7\xf6\x27\xe4\xb5\x80\x80\xb8\x90\xd9\xe1\x92\x73\xa5\xfd\x8c\x22\x56\x5d\xc3\x3e\x71\xc3\x9b\x65\x8a\x67\x2d\xad\x59\x1a\x70\x46\x53\xde\xbc\x33\x5f\x87\xfe\x8c\xb6\xab\xdd\x19\xb6\xf1\x8b\x3f\x85\x7d\x7a\x33\x9d\xa7\xbe\x39\xc8\x52\x48\xdf\x85\xf4\x51\x16\x2b\x59\xcf\xaa\xce\x57\x1c\xe5\xaa\x1d\x33\x67\x90\x92\x02\x7e\x29\x7c\xd1\x2e\xd5\xd3\x5f\x94\xc7\x7c\xa2\x93\x67\x4d\x69\xb9\xd1\x5f\x45\xbb\x66\x9a\x12\xe7\xc0\x70\xcb\x46\x8d\xee\x25\x34\xd7\xb2\x2f\x7e\xd9\xfa\x75\x3b\xa5\xed\xf2\x26\x7a\x0a\x0d\xde\xd1\xe2\xca\xc5\x8f\xe3\xc8\xeb\x5f\x10\xaf\x1e\x32\xa7\xee\x2f\x05\xb8\x81\x8b\xd7\x57\x70\x9e\x63\xb8\x79\x80\x6b\x87\x96\x09\x4a\x3b\x00\x95\x33\x47\xaa\xd5\x4a\x23\x55\xbc\x83\x0a\x85\x54\x3e\x41\x9d\x9f\x47\xb3\x60\xf5\x5a\xfe\xc7\x34\xf9\x8d\xd4\x2a\x26\x8a\x2b\x20\xa3\x53\x90\xbd\x8b\xa7\xde\x0e\xf8\xac\xb5\xce\x22\x1e\xd9\x12\x17\x33\x91\xdf\xed\xdd\xe8\xb9\x01\x07\x77\x4b\x57\x03\x29\x0b\x1e\xe1\x66\x4a\x96\xc4\xc1\x3a\xcb\x42\xfa\x95\xa7\xe8\xd7\x9c\xd8\x05\x65\x7e\x82\xf6\x08\x40\x26\xde\x5a\xaa\x6b\x3f\xc1\x5a\x1a\x84\x3b\x75\x50\xfa\x59\x0d\xb4\x45\x54\xff\xc3\xfb\xb9\xc6\xa1\x9f\x35\xc9\x4d\xc1\x6b\x4b\x8b\x83\xef\x93\xeb\x6e\xee\x77\x3c\xf0\x10\x86\x54\x78\x7d\x8d\xe5\x46\xf6\x0e\xf7\x4c\x6e\xdc\x84\x5d\x7f\xc6\x09\xef\xeb\x35\x2f\x4e\x93\xa1\xe5\x64\xa8\x90\xec\x1f\xb3\xaf\x84\x68\xee\xb9\x5e\x97\x0b\xbd\xf6\x6c\x8b\x31\xfd\x63\x3f\x6b\xc6\xa9\xac\x19\x44\x24\xff\x15\xc4\x2c\xdb\x07\xd5\xdf\x34\xf1\x47\xbe\x88\xd8\xe4\xee\xf4\xdd\x02\x5a\x5d\xf5\xf6\x5c\xad\xd7\x1c\x2f\x60\xc5\xac\x02\x46\x03\xfc\xbd\xc4\x8c\x67\xff\xf4\xa3\xd4\x5f\x0e\x90\x2b\xd9\xbf\x3a\x27\xc7\x0f\x82\x1f\x74\xc1\x75\xbd\x69\xd8\x6c\xca\x9e\x4a\x65\x30\xc4\xbf\x8c\x4a\xb1\xce\xe5\x45\x4f\xbb\x24\x72\xf8\xde\x8b\x43\xd3\xdb\x0d\x61\x26\x2d\x97\xc4\xd1\x37\x67\xec\x42\xd3\xf7\x3b\x8d\xb0\xea\x38\xfb\x5c\x8e\x36\x98\x30\xe5\x3a\x0d\xdb\x23\xb8\xf3\x6f\x62\x76\x3d\x0a\x43\x8f\x1b\xa6\xa7\x0b\x4d\x29\xe0\x99\xbe\x57\x83\xce\x0f\xfd\xc8\x5d\xe9\x41\xa4\x1c\xde\x59\xf6\xe9\xb0\xfb\xc4\xdd\x7e\x93\xc3\xcd\x38\x87\x5c\x68\x53\x04\x79\x7d\x5d\x55\x25\x37\x74\xfc\x59\x2c\x86\x48\xac\x72\x4f\xba\x13\x46\x4a\x32\xb9\x00\xb7\x98\x22\x66\x66\xae\x0b\x37\x1d\x7a\x7b\xda\x9c\x0e\xfc\x04\x5d\x3d\x5b\x10\x4d\x66\x11\xce\x70\xdf\xe5\xb0\xe1\xd8\x07\x3c\x8e\xaf\x7f\xa0\x87\x17\xa7\xc5\xcc\x8c\x77\x59\xf8\xa7\x07\x64\xef\xc4\xc0\xc5\x29\x3d\xa5\xff\x1b\x00\x7f\xdd\x7c\x23\x9d\x12\x00\x00")
+
+func apidocValidateGoBytes() ([]byte, error) {
+	return bindataRead(
+		_apidocValidateGo,
+		"apidoc/validate.go",
+	)
+}
+
+func apidocValidateGo() (*asset, error) {
+	bytes, err := apidocValidateGoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "apidoc/validate.go", size: 4765, mode: os.FileMode(436), modTime: time.Unix(1791997284, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _goMod = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xcb\x31\xb2\xc2\x20\x10\x80\xe1\x3a\x9c\x82\xf2\xbd\x22\xc0\x92\x10\xf5\x38\x48\x36\xb8\xd1\x64\x57\x26\x70\x7e\x47\x2b\x67\x6c\xfe\xea\xfb\x37\x9e\xeb\x03\x75\xa6\xe3\x56\xaf\x26\xf1\x66\xd7\xba\xd6\x4f\xa2\xd0\xcc\x49\xa9\x82\xcf\x4a\x05\xf5\x9f\xea\xbe\x58\xe1\x2c\x28\x82\x36\x0a\x25\xde\x24\x1e\xba\x39\xe3\x8c\xeb\xbd\x83\xc9\x05\x7f\x82\x33\x84\x30\xf6\x2e\x05\x58\x86\x38\x2c\x97\x69\x54\x5d\x66\xb9\x67\x43\xbb\xc5\x52\x32\x9b\x06\xba\xc1\xfb\xd2\xd6\x6a\xda\x67\x2a\x98\x8e\x5f\xe5\x75\xf3\x06\x8c\x53\xff\xea\x35\x00\xf2\x82\xd0\x5a\xb1\x00\x00\x00")
+
+func goModBytes() ([]byte, error) {
+	return bindataRead(
+		_goMod,
+		"go.mod",
+	)
+}
+
+func goMod() (*asset, error) {
+	bytes, err := goModBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "jujugenerateapidoc/prog.go", size: 10057, mode: os.FileMode(436), modTime: time.Unix(1540908507, 0)}
+	info := bindataFileInfo{name: "go.mod", size: 177, mode: os.FileMode(436), modTime: time.Unix(1792000939, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -180,9 +1776,85 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"jujugenerateapidoc/access.go": jujugenerateapidocAccessGo,
+	"jujugenerateapidoc/advertised.go": jujugenerateapidocAdvertisedGo,
+	"jujugenerateapidoc/audit.go": jujugenerateapidocAuditGo,
+	"jujugenerateapidoc/cache.go": jujugenerateapidocCacheGo,
+	"jujugenerateapidoc/checkpoint.go": jujugenerateapidocCheckpointGo,
+	"jujugenerateapidoc/completeness.go": jujugenerateapidocCompletenessGo,
+	"jujugenerateapidoc/constraints.go": jujugenerateapidocConstraintsGo,
+	"jujugenerateapidoc/debugdump.go": jujugenerateapidocDebugdumpGo,
+	"jujugenerateapidoc/examples.go": jujugenerateapidocExamplesGo,
+	"jujugenerateapidoc/facades.go": jujugenerateapidocFacadesGo,
 	"jujugenerateapidoc/go.mod": jujugenerateapidocGoMod,
 	"jujugenerateapidoc/go.sum": jujugenerateapidocGoSum,
+	"jujugenerateapidoc/juju2.go": jujugenerateapidocJuju2Go,
+	"jujugenerateapidoc/juju3.go": jujugenerateapidocJuju3Go,
+	"jujugenerateapidoc/juju4.go": jujugenerateapidocJuju4Go,
+	"jujugenerateapidoc/lease.go": jujugenerateapidocLeaseGo,
+	"jujugenerateapidoc/loaderrors.go": jujugenerateapidocLoaderrorsGo,
+	"jujugenerateapidoc/operational.go": jujugenerateapidocOperationalGo,
+	"jujugenerateapidoc/ordering.go": jujugenerateapidocOrderingGo,
+	"jujugenerateapidoc/platform.go": jujugenerateapidocPlatformGo,
+	"jujugenerateapidoc/profile.go": jujugenerateapidocProfileGo,
 	"jujugenerateapidoc/prog.go": jujugenerateapidocProgGo,
+	"jujugenerateapidoc/registration.go": jujugenerateapidocRegistrationGo,
+	"jujugenerateapidoc/retry.go": jujugenerateapidocRetryGo,
+	"jujugenerateapidoc/roundtrip.go": jujugenerateapidocRoundtripGo,
+	"jujugenerateapidoc/secrets.go": jujugenerateapidocSecretsGo,
+	"jujugenerateapidoc/security.go": jujugenerateapidocSecurityGo,
+	"jujugenerateapidoc/sentinels.go": jujugenerateapidocSentinelsGo,
+	"jujugenerateapidoc/stats.go": jujugenerateapidocStatsGo,
+	"jujugenerateapidoc/stream.go": jujugenerateapidocStreamGo,
+	"jujugenerateapidoc/strict.go": jujugenerateapidocStrictGo,
+	"jujugenerateapidoc/unserializable.go": jujugenerateapidocUnserializableGo,
+	"apidoc/advertised.go": apidocAdvertisedGo,
+	"apidoc/audience.go": apidocAudienceGo,
+	"apidoc/canonical.go": apidocCanonicalGo,
+	"apidoc/conventions.go": apidocConventionsGo,
+	"apidoc/crossmodel.go": apidocCrossmodelGo,
+	"apidoc/delta.go": apidocDeltaGo,
+	"apidoc/diff.go": apidocDiffGo,
+	"apidoc/doc.go": apidocDocGo,
+	"apidoc/entitytag.go": apidocEntitytagGo,
+	"apidoc/example.go": apidocExampleGo,
+	"apidoc/families.go": apidocFamiliesGo,
+	"apidoc/fields.go": apidocFieldsGo,
+	"apidoc/filter.go": apidocFilterGo,
+	"apidoc/flat.go": apidocFlatGo,
+	"apidoc/format.go": apidocFormatGo,
+	"apidoc/html.go": apidocHtmlGo,
+	"apidoc/intersect.go": apidocIntersectGo,
+	"apidoc/legacy.go": apidocLegacyGo,
+	"apidoc/load.go": apidocLoadGo,
+	"apidoc/logintarget.go": apidocLogintargetGo,
+	"apidoc/lookup.go": apidocLookupGo,
+	"apidoc/markdown.go": apidocMarkdownGo,
+	"apidoc/matrix.go": apidocMatrixGo,
+	"apidoc/merge.go": apidocMergeGo,
+	"apidoc/messages.go": apidocMessagesGo,
+	"apidoc/methodexample.go": apidocMethodexampleGo,
+	"apidoc/migration.go": apidocMigrationGo,
+	"apidoc/negotiate.go": apidocNegotiateGo,
+	"apidoc/permission.go": apidocPermissionGo,
+	"apidoc/presence.go": apidocPresenceGo,
+	"apidoc/quickstart.go": apidocQuickstartGo,
+	"apidoc/releases.go": apidocReleasesGo,
+	"apidoc/render.go": apidocRenderGo,
+	"apidoc/requirements.go": apidocRequirementsGo,
+	"apidoc/rpc.go": apidocRpcGo,
+	"apidoc/sample.go": apidocSampleGo,
+	"apidoc/schema.go": apidocSchemaGo,
+	"apidoc/schemagen.go": apidocSchemagenGo,
+	"apidoc/sensitive.go": apidocSensitiveGo,
+	"apidoc/sentinel.go": apidocSentinelGo,
+	"apidoc/single.go": apidocSingleGo,
+	"apidoc/source.go": apidocSourceGo,
+	"apidoc/stats.go": apidocStatsGo,
+	"apidoc/tags.go": apidocTagsGo,
+	"apidoc/usage.go": apidocUsageGo,
+	"apidoc/validate.go": apidocValidateGo,
+	"go.mod": goMod,
 }
 
 // AssetDir returns the file names below a certain
@@ -225,10 +1897,88 @@ type bintree struct {
 	Children map[string]*bintree
 }
 var _bintree = &bintree{nil, map[string]*bintree{
+	"apidoc": &bintree{nil, map[string]*bintree{
+		"advertised.go": &bintree{apidocAdvertisedGo, map[string]*bintree{}},
+		"audience.go": &bintree{apidocAudienceGo, map[string]*bintree{}},
+		"canonical.go": &bintree{apidocCanonicalGo, map[string]*bintree{}},
+		"conventions.go": &bintree{apidocConventionsGo, map[string]*bintree{}},
+		"crossmodel.go": &bintree{apidocCrossmodelGo, map[string]*bintree{}},
+		"delta.go": &bintree{apidocDeltaGo, map[string]*bintree{}},
+		"diff.go": &bintree{apidocDiffGo, map[string]*bintree{}},
+		"doc.go": &bintree{apidocDocGo, map[string]*bintree{}},
+		"entitytag.go": &bintree{apidocEntitytagGo, map[string]*bintree{}},
+		"example.go": &bintree{apidocExampleGo, map[string]*bintree{}},
+		"families.go": &bintree{apidocFamiliesGo, map[string]*bintree{}},
+		"fields.go": &bintree{apidocFieldsGo, map[string]*bintree{}},
+		"filter.go": &bintree{apidocFilterGo, map[string]*bintree{}},
+		"flat.go": &bintree{apidocFlatGo, map[string]*bintree{}},
+		"format.go": &bintree{apidocFormatGo, map[string]*bintree{}},
+		"html.go": &bintree{apidocHtmlGo, map[string]*bintree{}},
+		"intersect.go": &bintree{apidocIntersectGo, map[string]*bintree{}},
+		"legacy.go": &bintree{apidocLegacyGo, map[string]*bintree{}},
+		"load.go": &bintree{apidocLoadGo, map[string]*bintree{}},
+		"logintarget.go": &bintree{apidocLogintargetGo, map[string]*bintree{}},
+		"lookup.go": &bintree{apidocLookupGo, map[string]*bintree{}},
+		"markdown.go": &bintree{apidocMarkdownGo, map[string]*bintree{}},
+		"matrix.go": &bintree{apidocMatrixGo, map[string]*bintree{}},
+		"merge.go": &bintree{apidocMergeGo, map[string]*bintree{}},
+		"messages.go": &bintree{apidocMessagesGo, map[string]*bintree{}},
+		"methodexample.go": &bintree{apidocMethodexampleGo, map[string]*bintree{}},
+		"migration.go": &bintree{apidocMigrationGo, map[string]*bintree{}},
+		"negotiate.go": &bintree{apidocNegotiateGo, map[string]*bintree{}},
+		"permission.go": &bintree{apidocPermissionGo, map[string]*bintree{}},
+		"presence.go": &bintree{apidocPresenceGo, map[string]*bintree{}},
+		"quickstart.go": &bintree{apidocQuickstartGo, map[string]*bintree{}},
+		"releases.go": &bintree{apidocReleasesGo, map[string]*bintree{}},
+		"render.go": &bintree{apidocRenderGo, map[string]*bintree{}},
+		"requirements.go": &bintree{apidocRequirementsGo, map[string]*bintree{}},
+		"rpc.go": &bintree{apidocRpcGo, map[string]*bintree{}},
+		"sample.go": &bintree{apidocSampleGo, map[string]*bintree{}},
+		"schema.go": &bintree{apidocSchemaGo, map[string]*bintree{}},
+		"schemagen.go": &bintree{apidocSchemagenGo, map[string]*bintree{}},
+		"sensitive.go": &bintree{apidocSensitiveGo, map[string]*bintree{}},
+		"sentinel.go": &bintree{apidocSentinelGo, map[string]*bintree{}},
+		"single.go": &bintree{apidocSingleGo, map[string]*bintree{}},
+		"source.go": &bintree{apidocSourceGo, map[string]*bintree{}},
+		"stats.go": &bintree{apidocStatsGo, map[string]*bintree{}},
+		"tags.go": &bintree{apidocTagsGo, map[string]*bintree{}},
+		"usage.go": &bintree{apidocUsageGo, map[string]*bintree{}},
+		"validate.go": &bintree{apidocValidateGo, map[string]*bintree{}},
+	}},
+	"go.mod": &bintree{goMod, map[string]*bintree{}},
 	"jujugenerateapidoc": &bintree{nil, map[string]*bintree{
+		"access.go": &bintree{jujugenerateapidocAccessGo, map[string]*bintree{}},
+		"advertised.go": &bintree{jujugenerateapidocAdvertisedGo, map[string]*bintree{}},
+		"audit.go": &bintree{jujugenerateapidocAuditGo, map[string]*bintree{}},
+		"cache.go": &bintree{jujugenerateapidocCacheGo, map[string]*bintree{}},
+		"checkpoint.go": &bintree{jujugenerateapidocCheckpointGo, map[string]*bintree{}},
+		"completeness.go": &bintree{jujugenerateapidocCompletenessGo, map[string]*bintree{}},
+		"constraints.go": &bintree{jujugenerateapidocConstraintsGo, map[string]*bintree{}},
+		"debugdump.go": &bintree{jujugenerateapidocDebugdumpGo, map[string]*bintree{}},
+		"examples.go": &bintree{jujugenerateapidocExamplesGo, map[string]*bintree{}},
+		"facades.go": &bintree{jujugenerateapidocFacadesGo, map[string]*bintree{}},
 		"go.mod": &bintree{jujugenerateapidocGoMod, map[string]*bintree{}},
 		"go.sum": &bintree{jujugenerateapidocGoSum, map[string]*bintree{}},
+		"juju2.go": &bintree{jujugenerateapidocJuju2Go, map[string]*bintree{}},
+		"juju3.go": &bintree{jujugenerateapidocJuju3Go, map[string]*bintree{}},
+		"juju4.go": &bintree{jujugenerateapidocJuju4Go, map[string]*bintree{}},
+		"lease.go": &bintree{jujugenerateapidocLeaseGo, map[string]*bintree{}},
+		"loaderrors.go": &bintree{jujugenerateapidocLoaderrorsGo, map[string]*bintree{}},
+		"operational.go": &bintree{jujugenerateapidocOperationalGo, map[string]*bintree{}},
+		"ordering.go": &bintree{jujugenerateapidocOrderingGo, map[string]*bintree{}},
+		"platform.go": &bintree{jujugenerateapidocPlatformGo, map[string]*bintree{}},
+		"profile.go": &bintree{jujugenerateapidocProfileGo, map[string]*bintree{}},
 		"prog.go": &bintree{jujugenerateapidocProgGo, map[string]*bintree{}},
+		"registration.go": &bintree{jujugenerateapidocRegistrationGo, map[string]*bintree{}},
+		"retry.go": &bintree{jujugenerateapidocRetryGo, map[string]*bintree{}},
+		"roundtrip.go": &bintree{jujugenerateapidocRoundtripGo, map[string]*bintree{}},
+		"secrets.go": &bintree{jujugenerateapidocSecretsGo, map[string]*bintree{}},
+		"security.go": &bintree{jujugenerateapidocSecurityGo, map[string]*bintree{}},
+		"sentinels.go": &bintree{jujugenerateapidocSentinelsGo, map[string]*bintree{}},
+		"stats.go": &bintree{jujugenerateapidocStatsGo, map[string]*bintree{}},
+		"stream.go": &bintree{jujugenerateapidocStreamGo, map[string]*bintree{}},
+		"strict.go": &bintree{jujugenerateapidocStrictGo, map[string]*bintree{}},
+		"unserializable.go": &bintree{jujugenerateapidocUnserializableGo, map[string]*bintree{}},
 	}},
 }}
 
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"github.com/juju/jujuapidoc/rpc"
+)
+
+// callMain implements the "call" subcommand, which calls
+// a single API method on a controller.
+func callMain(args []string) error {
+	fset := flag.NewFlagSet("call", flag.ExitOnError)
+	var (
+		controller = fset.String("controller", "", "address of the controller's API server (host:port)")
+		model      = fset.String("model", "", "UUID of the model to connect to (default the controller)")
+		user       = fset.String("user", "admin", "user to log in as")
+		docFile    = fset.String("doc", "", "jujuapidoc output describing the API (required)")
+		version    = fset.Int("version", 0, "facade version to use (default the newest in the doc)")
+		caCert     = fset.String("ca-cert", "", "PEM file holding the controller's CA certificate")
+		insecure   = fset.Bool("insecure", false, "don't verify the controller's certificate")
+	)
+	fset.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidoc call [flags] Facade.Method [params.json]\n")
+		fmt.Fprintf(os.Stderr, "\nThe password is read from $JUJU_PASSWORD. If params.json is omitted, no params are sent.\n\n")
+		fset.PrintDefaults()
+		os.Exit(2)
+	}
+	fset.Parse(args)
+	if fset.NArg() < 1 || fset.NArg() > 2 || *controller == "" || *docFile == "" {
+		fset.Usage()
+	}
+	parts := strings.SplitN(fset.Arg(0), ".", 2)
+	if len(parts) != 2 {
+		fset.Usage()
+	}
+	facadeName, methodName := parts[0], parts[1]
+	info, err := apidoc.ReadFile(*docFile)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	if *version == 0 {
+		vs := info.FacadeVersions()[facadeName]
+		if len(vs) == 0 {
+			return errors.Newf("facade %s not found in %s", facadeName, *docFile)
+		}
+		*version = vs[len(vs)-1]
+	}
+	f := info.Facade(facadeName, *version)
+	if f == nil {
+		return errors.Newf("facade %s(%d) not found in %s", facadeName, *version, *docFile)
+	}
+	m := f.Method(methodName)
+	if m == nil {
+		return errors.Newf("%s(%d) has no method %s", facadeName, *version, methodName)
+	}
+	defs := info.SchemaDefinitions()
+	var params json.RawMessage
+	if fset.NArg() == 2 {
+		if m.Param == nil {
+			return errors.Newf("%s.%s takes no params", facadeName, methodName)
+		}
+		data, err := ioutil.ReadFile(fset.Arg(1))
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		if err := info.Schema(m.Param).CheckJSON(defs, data); err != nil {
+			return errors.Notef(err, nil, "invalid params")
+		}
+		params = data
+	}
+	config := &tls.Config{
+		InsecureSkipVerify: *insecure,
+	}
+	if *caCert != "" {
+		data, err := ioutil.ReadFile(*caCert)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(data) {
+			return errors.Newf("no certificates found in %s", *caCert)
+		}
+	}
+	conn, err := rpc.Dial(*controller, *model, config)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	defer conn.Close()
+	adminVersion := 3
+	if vs := info.FacadeVersions()["Admin"]; len(vs) > 0 {
+		adminVersion = vs[len(vs)-1]
+	}
+	if err := conn.Login(*user, os.Getenv("JUJU_PASSWORD"), adminVersion); err != nil {
+		return errors.Notef(err, nil, "cannot log in")
+	}
+	var reqParams interface{}
+	if params != nil {
+		reqParams = params
+	}
+	resp, err := conn.Call(facadeName, *version, methodName, reqParams)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	if m.Result != nil && len(resp) > 0 {
+		// The response doesn't match the doc, which is
+		// interesting but doesn't stop us printing it.
+		if err := info.Schema(m.Result).CheckJSON(defs, resp); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: response does not match the documented result: %v\n", err)
+		}
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, resp, "", "\t"); err != nil {
+		// Print the response as is.
+		buf.Reset()
+		buf.Write(resp)
+	}
+	buf.WriteByte('\n')
+	os.Stdout.Write(buf.Bytes())
+	return nil
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// checkpoint records the stages of a run that have completed,
+// so that a run that was interrupted can carry on where
+// it left off when the -resume flag is used. It is kept
+// in the run's work directory.
+type checkpoint struct {
+	// Generator holds a hash of the doc generator source.
+	// Work done with a different generator is not reused.
+	Generator string
+
+	// ResolvedModule holds the juju module and the version
+	// that it resolved to, in module@version form.
+	ResolvedModule string `json:",omitempty"`
+
+	// JujuDir holds the directory holding the
+	// downloaded source of ResolvedModule.
+	JujuDir string `json:",omitempty"`
+
+	// Commit holds the hash of the commit that
+	// ResolvedModule was built from, if known.
+	Commit string `json:",omitempty"`
+
+	// Built records whether the doc generator has been built.
+	Built bool `json:",omitempty"`
+}
+
+const checkpointFile = "checkpoint.json"
+
+// workDir returns the work directory used for
+// generating the documentation for the given
+// version of the given juju module.
+func workDir(cacheDir, module, version string) string {
+	return filepath.Join(cacheDir, "work", url.PathEscape(module+"@"+version))
+}
+
+// startWork prepares the work directory dir and returns the
+// checkpoint for the run. If resume is true and dir holds
+// a checkpoint made with the current generator, the work
+// already done is kept; otherwise dir is cleared and the
+// doc generator source is written to it.
+func startWork(dir string, resume bool) (*checkpoint, error) {
+	gen, err := generatorHash()
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if resume {
+		cp, err := readCheckpoint(dir)
+		switch {
+		case err != nil:
+			log.Printf("cannot resume: %v", err)
+		case cp == nil:
+			log.Printf("nothing to resume in %s", dir)
+		case cp.Generator != gen:
+			log.Printf("cannot resume: work in %s was done by a different version of jujuapidoc", dir)
+		default:
+			log.Printf("resuming from %s", dir)
+			return cp, nil
+		}
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "jujumod"), 0777); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if err := restoreGenerator(dir); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	cp := &checkpoint{
+		Generator: gen,
+	}
+	if err := cp.save(dir); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return cp, nil
+}
+
+// readCheckpoint reads the checkpoint in the given work
+// directory. It returns a nil checkpoint if there is none.
+func readCheckpoint(dir string) (*checkpoint, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, checkpointFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	var cp checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, errors.Notef(err, nil, "invalid checkpoint in %s", dir)
+	}
+	return &cp, nil
+}
+
+// save writes the checkpoint to the given work directory.
+func (cp *checkpoint) save(dir string) error {
+	data, err := json.MarshalIndent(cp, "", "\t")
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	path := filepath.Join(dir, checkpointFile)
+	if err := ioutil.WriteFile(path+".tmp", data, 0666); err != nil {
+		return errors.Wrap(err)
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// generatorHash returns a hash of the embedded
+// doc generator source.
+func generatorHash() (string, error) {
+	names := AssetNames()
+	sort.Strings(names)
+	h := sha256.New()
+	for _, name := range names {
+		data, err := Asset(name)
+		if err != nil {
+			return "", errors.Wrap(err)
+		}
+		fmt.Fprintf(h, "%s %d\n", name, len(data))
+		h.Write(data)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// exists reports whether the named file exists.
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
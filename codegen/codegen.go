@@ -0,0 +1,375 @@
+// Package codegen generates client bindings for the Juju API in
+// other languages from the output of jujuapidoc, so that clients
+// can be kept in step with the API of a particular Juju version.
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+	"gopkg.in/errgo.v2/fmt/errors"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// Backend describes a code generator for a particular language.
+type Backend struct {
+	Name        string
+	Description string
+
+	// File holds the name of the file written by the
+	// backend, or the empty string if the backend
+	// chooses its own file names.
+	File string
+
+	generate func(g *gen)
+}
+
+// Backends holds all the available backends.
+var Backends = []Backend{{
+	Name:        "rust",
+	File:        "juju_api.rs",
+	Description: "Rust structs with serde annotations and a trait for each facade version.",
+	generate:    genRust,
+}, {
+	Name:        "java",
+	File:        "JujuApi.java",
+	Description: "Java records with Jackson annotations and a class of method name constants for each facade version.",
+	generate:    genJava,
+}, {
+	Name:        "kotlin",
+	File:        "JujuApi.kt",
+	Description: "Kotlin data classes for kotlinx.serialization and an object of method name constants for each facade version.",
+	generate:    genKotlin,
+}, {
+	Name:        "csharp",
+	File:        "JujuApi.cs",
+	Description: "C# classes with System.Text.Json attributes and a class of method name constants for each facade version.",
+	generate:    genCSharp,
+}, {
+	Name:        "jujulib",
+	Description: "JavaScript facade modules in the format used by the Juju dashboard's jujulib, one file per facade version.",
+	generate:    genJujulib,
+}}
+
+// BackendByName returns the backend with the given name,
+// or nil if there is none.
+func BackendByName(name string) *Backend {
+	for i := range Backends {
+		if Backends[i].Name == name {
+			return &Backends[i]
+		}
+	}
+	return nil
+}
+
+// Generate writes bindings for all the facades in info,
+// and all the types they use, to w. It returns an error
+// if the backend writes more than one file.
+func (b *Backend) Generate(w io.Writer, info *apidoc.Info) error {
+	files := b.generateFiles(info)
+	if len(files) != 1 {
+		return errors.Newf("%s backend generates %d files; write them to a directory instead", b.Name, len(files))
+	}
+	if _, err := w.Write(files[0].buf.Bytes()); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// GenerateDir is like Generate except that it writes
+// the generated files into the given directory.
+func (b *Backend) GenerateDir(dir string, info *apidoc.Info) error {
+	for _, f := range b.generateFiles(info) {
+		path := filepath.Join(dir, filepath.FromSlash(f.name))
+		if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			return errors.Wrap(err)
+		}
+		if err := ioutil.WriteFile(path, f.buf.Bytes(), 0666); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func (b *Backend) generateFiles(info *apidoc.Info) []*genFile {
+	g := newGen(info)
+	if b.File != "" {
+		g.startFile(b.File)
+	}
+	b.generate(g)
+	return g.files
+}
+
+// gen holds the state shared by all the backends
+// while generating code.
+type gen struct {
+	info *apidoc.Info
+
+	// files holds all the files generated so far;
+	// output is written to the last one.
+	files []*genFile
+
+	// types holds the named types to generate,
+	// sorted by identifier.
+	types []*jsontypes.Type
+
+	// idents maps each named type to the
+	// identifier used for it in generated code.
+	idents map[jsontypes.TypeName]string
+}
+
+func newGen(info *apidoc.Info) *gen {
+	// Restrict the types to those that are actually used.
+	info = info.Filter(func(*apidoc.FacadeInfo, *apidoc.Method) bool {
+		return true
+	})
+	info.Canonicalize()
+	g := &gen{
+		info:   info,
+		idents: make(map[jsontypes.TypeName]string),
+	}
+	if info.TypeInfo == nil {
+		return g
+	}
+	byName := make(map[string][]jsontypes.TypeName)
+	for name := range info.TypeInfo.Types {
+		byName[name.Name()] = append(byName[name.Name()], name)
+	}
+	for base, names := range byName {
+		for _, name := range names {
+			ident := exported(base)
+			if len(names) > 1 {
+				// Qualify names that would otherwise clash as
+				// their schema definitions are qualified.
+				ident = qualifiedIdent(info.DefinitionName(name))
+			}
+			g.idents[name] = ident
+		}
+	}
+	for name, t := range info.TypeInfo.Types {
+		if g.special(name) == notSpecial {
+			g.types = append(g.types, t)
+		}
+	}
+	sort.Slice(g.types, func(i, j int) bool {
+		return g.idents[g.types[i].Name] < g.idents[g.types[j].Name]
+	})
+	return g
+}
+
+// genFile holds a generated file.
+type genFile struct {
+	// name holds the slash-separated path of the file.
+	name string
+	buf  bytes.Buffer
+}
+
+// startFile starts a new file with the given name.
+// Subsequent output is written to that file.
+func (g *gen) startFile(name string) {
+	g.files = append(g.files, &genFile{
+		name: name,
+	})
+}
+
+func (g *gen) printf(f string, a ...interface{}) {
+	fmt.Fprintf(&g.files[len(g.files)-1].buf, f, a...)
+}
+
+// specialKind classifies named types that have a custom
+// JSON encoding and so are not generated as ordinary types.
+type specialKind int
+
+const (
+	notSpecial specialKind = iota
+	// timeType is time.Time, encoded as an RFC 3339 string.
+	timeType
+	// textType is a type encoded as a string by MarshalText.
+	textType
+	// jsonType is a type with a MarshalJSON method,
+	// whose encoding we can't know.
+	jsonType
+)
+
+func (g *gen) special(name jsontypes.TypeName) specialKind {
+	if name == "time#Time" {
+		return timeType
+	}
+	t := g.info.TypeInfo.Types[name]
+	switch {
+	case t == nil:
+		return jsonType
+	case t.Methods["MarshalJSON"] != nil:
+		return jsonType
+	case t.Methods["MarshalText"] != nil:
+		return textType
+	}
+	return notSpecial
+}
+
+// ident returns the identifier for the given named type.
+func (g *gen) ident(name jsontypes.TypeName) string {
+	if ident, ok := g.idents[name]; ok {
+		return ident
+	}
+	return exported(name.Name())
+}
+
+// field holds a struct field as it appears on the wire.
+type field struct {
+	// Name holds the Go name of the field.
+	Name string
+	// WireName holds the JSON name of the field.
+	WireName string
+	Type     *jsontypes.Type
+	// Optional reports whether the field may be
+	// omitted. See apidoc.Info.FieldPresence.
+	Optional bool
+	// Doc holds a description of the field's values,
+	// if anything is known about them beyond their type.
+	Doc string
+}
+
+// fields returns the wire fields of the given struct type,
+// with the fields of embedded structs promoted as
+// encoding/json does.
+func (g *gen) fields(t *jsontypes.Type) []field {
+	var fields []field
+	for _, f := range t.Fields {
+		wireName, _ := apidoc.FieldWireName(f)
+		if wireName == "" {
+			continue
+		}
+		if f.Anonymous && !strings.Contains(f.Tag, `json:"`) {
+			ft := g.info.Type(f.Type)
+			if ft.Kind == jsontypes.Ptr {
+				ft = g.info.Type(ft.Elem)
+			}
+			if ft.Kind == jsontypes.Struct {
+				fields = append(fields, g.fields(ft)...)
+				continue
+			}
+		}
+		presence, _ := g.info.FieldPresence(t.Name, f)
+		doc := ""
+		if fi := g.info.Field(t.Name, f.Name); fi != nil {
+			doc = apidoc.TagDescription(fi.TagKinds)
+		}
+		fields = append(fields, field{
+			Name:     f.Name,
+			WireName: wireName,
+			Type:     f.Type,
+			Optional: presence == apidoc.Optional,
+			Doc:      doc,
+		})
+	}
+	return fields
+}
+
+// underlying returns the definition of t,
+// following any reference to a named type.
+func (g *gen) underlying(t *jsontypes.Type) *jsontypes.Type {
+	return g.info.Type(t)
+}
+
+// isByteSlice reports whether t is a []byte,
+// which encoding/json encodes as base64.
+func (g *gen) isByteSlice(t *jsontypes.Type) bool {
+	t = g.underlying(t)
+	return t.Kind == jsontypes.Slice && g.underlying(t.Elem).Kind == jsontypes.Uint8
+}
+
+// facadeIdent returns the identifier used for
+// the given version of a facade.
+func facadeIdent(f *apidoc.FacadeInfo) string {
+	return fmt.Sprintf("%sV%d", exported(f.Name), f.Version)
+}
+
+// docLines returns the lines of a doc comment,
+// without any trailing newline.
+func docLines(doc string) []string {
+	doc = apidoc.NormalizeDoc(doc)
+	if doc == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(doc, "\n"), "\n")
+}
+
+// printDoc prints a doc comment with each line
+// preceded by the given indent and prefix.
+func (g *gen) printDoc(indent, prefix, doc string) {
+	for _, line := range docLines(doc) {
+		g.printf("%s%s", indent, strings.TrimRight(prefix+" "+line, " "))
+		g.printf("\n")
+	}
+}
+
+// exported returns name with its first letter in upper case.
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// qualifiedIdent returns an identifier made from the given
+// definition name, as returned by Info.DefinitionName, for
+// example "CharmMeta" from "charm.Meta".
+func qualifiedIdent(defName string) string {
+	var buf strings.Builder
+	for _, part := range strings.Split(defName, ".") {
+		buf.WriteString(exported(strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+				return r
+			}
+			return -1
+		}, part)))
+	}
+	return buf.String()
+}
+
+// lowerCamel returns name with its leading initialism
+// or first letter in lower case, for example "APIHostPorts"
+// becomes "apiHostPorts".
+func lowerCamel(name string) string {
+	r := []rune(name)
+	for i := range r {
+		if !unicode.IsUpper(r[i]) {
+			break
+		}
+		if i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r)
+}
+
+// snake returns the snake_case form of a CamelCase name,
+// for example "APIHostPorts" becomes "api_host_ports".
+func snake(name string) string {
+	var buf strings.Builder
+	r := []rune(name)
+	for i, c := range r {
+		if unicode.IsUpper(c) && i > 0 {
+			prev := r[i-1]
+			nextLower := i+1 < len(r) && unicode.IsLower(r[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				buf.WriteByte('_')
+			}
+		}
+		buf.WriteRune(unicode.ToLower(c))
+	}
+	return buf.String()
+}
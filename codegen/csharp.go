@@ -0,0 +1,152 @@
+package codegen
+
+import (
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+var xmlReplacer = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func genCSharp(g *gen) {
+	g.printf("// Code generated by jujuapidoc. DO NOT EDIT.\n\n")
+	g.printf("#nullable enable\n\n")
+	g.printf("using System;\n")
+	g.printf("using System.Collections.Generic;\n")
+	g.printf("using System.Text.Json;\n")
+	g.printf("using System.Text.Json.Serialization;\n\n")
+	g.printf("namespace Juju.Api\n{\n")
+	first := true
+	sep := func() {
+		if !first {
+			g.printf("\n")
+		}
+		first = false
+	}
+	for _, t := range g.types {
+		if t.Kind != jsontypes.Struct {
+			// C# has no type aliases that can be used
+			// across files; uses of the type refer to
+			// its definition.
+			continue
+		}
+		sep()
+		ident := g.ident(t.Name)
+		g.printf("\t/// <summary>%s</summary>\n", t.Name)
+		g.printf("\tpublic sealed class %s\n\t{\n", ident)
+		for i, f := range g.fields(t) {
+			if i > 0 {
+				g.printf("\n")
+			}
+			name := exported(f.Name)
+			if name == ident {
+				// Members can't have the same name
+				// as their enclosing type.
+				name += "Value"
+			}
+			ut := g.underlying(f.Type)
+			// Go encodes nil slices and maps as null.
+			nullable := f.Optional || ut.Kind == jsontypes.Slice || ut.Kind == jsontypes.Map
+			typ := g.csharpType(f.Type)
+			if nullable && typ[len(typ)-1] != '?' {
+				typ += "?"
+			}
+			if f.Doc != "" {
+				g.printf("\t\t/// <summary>%s</summary>\n", xmlEscape(f.Doc))
+			}
+			g.printf("\t\t[JsonPropertyName(%q)]\n", f.WireName)
+			if f.Optional {
+				g.printf("\t\t[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]\n")
+			}
+			init := ""
+			if !nullable && typ == "string" {
+				init = " = \"\";"
+			} else if !nullable && ut.Kind == jsontypes.Struct && g.special(f.Type.Name) == notSpecial {
+				init = " = new();"
+			}
+			g.printf("\t\tpublic %s %s { get; set; }%s\n", typ, name, init)
+		}
+		g.printf("\t}\n")
+	}
+	for i := range g.info.Facades {
+		f := &g.info.Facades[i]
+		sep()
+		if lines := docLines(f.Doc); len(lines) > 0 {
+			g.printf("\t/// <summary>\n")
+			g.printDoc("\t", "///", xmlEscape(f.Doc))
+			g.printf("\t/// </summary>\n")
+		}
+		g.printf("\tpublic static class %s\n\t{\n", facadeIdent(f))
+		g.printf("\t\tpublic const string Name = %q;\n", f.Name)
+		g.printf("\t\tpublic const int Version = %d;\n\n", f.Version)
+		g.printf("\t\tpublic static class Methods\n\t\t{\n")
+		for _, m := range f.Methods {
+			g.printf("\t\t\tpublic const string %s = %q;\n", exported(m.Name), m.Name)
+		}
+		g.printf("\t\t}\n")
+		g.printf("\t}\n")
+	}
+	g.printf("}\n")
+}
+
+// csharpType returns the C# type used for t.
+func (g *gen) csharpType(t *jsontypes.Type) string {
+	if t.Name.PkgPath() != "" {
+		switch g.special(t.Name) {
+		case timeType:
+			return "DateTimeOffset"
+		case textType:
+			return "string"
+		case jsonType:
+			return "JsonElement"
+		}
+		dt := g.underlying(t)
+		if dt.Kind == jsontypes.Struct {
+			return g.ident(t.Name)
+		}
+		t = dt
+	}
+	switch t.Kind {
+	case jsontypes.Bool:
+		return "bool"
+	case jsontypes.Int, jsontypes.Int64:
+		return "long"
+	case jsontypes.Int32:
+		return "int"
+	case jsontypes.Int16:
+		return "short"
+	case jsontypes.Int8:
+		return "sbyte"
+	case jsontypes.Uint, jsontypes.Uint64, jsontypes.Uintptr:
+		return "ulong"
+	case jsontypes.Uint32:
+		return "uint"
+	case jsontypes.Uint16:
+		return "ushort"
+	case jsontypes.Uint8:
+		return "byte"
+	case jsontypes.Float32:
+		return "float"
+	case jsontypes.Float64:
+		return "double"
+	case jsontypes.String:
+		return "string"
+	case jsontypes.Ptr:
+		return g.csharpType(t.Elem) + "?"
+	case jsontypes.Slice, jsontypes.Array:
+		if g.isByteSlice(t) {
+			// System.Text.Json encodes byte arrays
+			// as base64, as Go does.
+			return "byte[]"
+		}
+		return "List<" + g.csharpType(t.Elem) + ">"
+	case jsontypes.Map:
+		return "Dictionary<string, " + g.csharpType(t.Elem) + ">"
+	}
+	return "JsonElement"
+}
+
+// xmlEscape escapes text for inclusion in an XML doc comment.
+func xmlEscape(s string) string {
+	return xmlReplacer.Replace(s)
+}
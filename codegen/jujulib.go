@@ -0,0 +1,208 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// genJujulib generates a module for each facade version in the
+// format used by the Juju dashboard's jujulib. Each module exports
+// a class whose methods send requests with the given transport,
+// converting between JavaScript-style camelCase names and the
+// names used on the wire.
+func genJujulib(g *gen) {
+	for i := range g.info.Facades {
+		f := &g.info.Facades[i]
+		g.startFile(fmt.Sprintf("%s-v%d.js", strings.ToLower(f.Name), f.Version))
+		g.genJujulibFacade(f)
+	}
+}
+
+func (g *gen) genJujulibFacade(f *apidoc.FacadeInfo) {
+	class := facadeIdent(f)
+	g.printf("/**\n")
+	g.printf("  Juju %s version %d.\n", f.Name, f.Version)
+	if len(f.AvailableTo) > 0 {
+		g.printf("  This facade is available on:\n")
+		for _, kind := range f.AvailableTo {
+			g.printf("    %s\n", kind)
+		}
+	}
+	g.printf("\n  NOTE: this file has been generated by jujuapidoc.\n")
+	g.printf("  Do not manually edit this file.\n")
+	g.printf("*/\n\n")
+	g.printf("'use strict';\n\n")
+	g.printf("const {createAsyncHandler} = require('../transform.js');\n\n")
+	conv := &jsConverters{
+		g:    g,
+		need: make(map[string]*jsontypes.Type),
+	}
+	g.printDoc("", "//", f.Doc)
+	g.printf("class %s {\n", class)
+	g.printf("  constructor(transport, info) {\n")
+	g.printf("    this._transport = transport;\n")
+	g.printf("    this._info = info;\n")
+	g.printf("    this.version = %d;\n", f.Version)
+	g.printf("  }\n")
+	for _, m := range f.Methods {
+		g.printf("\n  /**\n")
+		g.printDoc("  ", " ", m.Doc)
+		g.printf("    @param {Object} args Arguments to be provided to Juju.\n")
+		g.printf("    @param {Function} callback Called when the response from Juju is\n")
+		g.printf("      available; it receives an error and the result.\n")
+		g.printf("    @returns {Promise} Resolved or rejected with the result of the request.\n")
+		g.printf("  */\n")
+		g.printf("  %s(args, callback) {\n", lowerCamel(m.Name))
+		g.printf("    return new Promise((resolve, reject) => {\n")
+		params := "{}"
+		if m.Param != nil {
+			params = conv.expr(m.Param, "args", true)
+		}
+		g.printf("      const req = {\n")
+		g.printf("        type: '%s',\n", f.Name)
+		g.printf("        request: '%s',\n", m.Name)
+		g.printf("        version: %d,\n", f.Version)
+		g.printf("        params: %s,\n", params)
+		g.printf("      };\n")
+		transform := "null"
+		if m.Result != nil {
+			transform = "resp => " + conv.expr(m.Result, "resp", false)
+		}
+		g.printf("      const transform = %s;\n", transform)
+		g.printf("      const handler = createAsyncHandler(callback, resolve, reject, transform);\n")
+		g.printf("      this._transport.write(req, handler);\n")
+		g.printf("    });\n")
+		g.printf("  }\n")
+	}
+	g.printf("}\n")
+	conv.define()
+	g.printf("\nmodule.exports = %s;\n", class)
+}
+
+// jsConverters generates functions that convert between
+// JavaScript objects and their wire representation.
+type jsConverters struct {
+	g *gen
+	// need holds the names of the conversion functions
+	// that are needed, mapped to the type they convert.
+	need map[string]*jsontypes.Type
+}
+
+// expr returns a JavaScript expression that converts the value
+// of the expression x, of type t, to the wire format (if toWire
+// is true) or from it (if it is false).
+func (c *jsConverters) expr(t *jsontypes.Type, x string, toWire bool) string {
+	if !c.needsConversion(t, make(map[jsontypes.TypeName]bool)) {
+		return x
+	}
+	if t.Name.PkgPath() != "" {
+		dt := c.g.underlying(t)
+		if dt.Kind != jsontypes.Struct {
+			return c.elemExpr(dt, x, toWire)
+		}
+		name := "from" + c.g.ident(t.Name)
+		if toWire {
+			name = "to" + c.g.ident(t.Name)
+		}
+		c.need[name] = dt
+		return name + "(" + x + ")"
+	}
+	return c.elemExpr(t, x, toWire)
+}
+
+// elemExpr is like expr but ignores the name of t, converting
+// the elements of pointers, slices and maps.
+func (c *jsConverters) elemExpr(t *jsontypes.Type, x string, toWire bool) string {
+	switch t.Kind {
+	case jsontypes.Ptr:
+		return c.expr(t.Elem, x, toWire)
+	case jsontypes.Slice, jsontypes.Array:
+		return fmt.Sprintf("(%s == null ? %s : %s.map(v => %s))", x, x, x, c.expr(t.Elem, "v", toWire))
+	case jsontypes.Map:
+		return fmt.Sprintf("(%s == null ? %s : Object.fromEntries(Object.entries(%s).map(([k, v]) => [k, %s])))", x, x, x, c.expr(t.Elem, "v", toWire))
+	}
+	return x
+}
+
+// needsConversion reports whether values of type t
+// need converting, which is so when they contain any
+// struct values.
+func (c *jsConverters) needsConversion(t *jsontypes.Type, seen map[jsontypes.TypeName]bool) bool {
+	if t.Name.PkgPath() != "" {
+		if c.g.special(t.Name) != notSpecial {
+			return false
+		}
+		if seen[t.Name] {
+			// Recursive types must contain a struct.
+			return true
+		}
+		seen[t.Name] = true
+		t = c.g.underlying(t)
+		if t.Kind == jsontypes.Struct {
+			return true
+		}
+	}
+	switch t.Kind {
+	case jsontypes.Ptr, jsontypes.Slice, jsontypes.Array, jsontypes.Map:
+		return !c.g.isByteSlice(t) && c.needsConversion(t.Elem, seen)
+	}
+	return false
+}
+
+// define writes all the needed conversion functions,
+// including those needed by the functions themselves.
+func (c *jsConverters) define() {
+	done := make(map[string]bool)
+	for {
+		var names []string
+		for name := range c.need {
+			if !done[name] {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			return
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			done[name] = true
+			c.defineFunc(name, c.need[name], strings.HasPrefix(name, "to"))
+		}
+	}
+}
+
+func (c *jsConverters) defineFunc(name string, t *jsontypes.Type, toWire bool) {
+	g := c.g
+	g.printf("\nfunction %s(v) {\n", name)
+	g.printf("  if (v == null) {\n")
+	g.printf("    return v;\n")
+	g.printf("  }\n")
+	g.printf("  return {\n")
+	for _, f := range g.fields(t) {
+		jsName := jsIdent(f.WireName)
+		if toWire {
+			g.printf("    '%s': %s,\n", f.WireName, c.expr(f.Type, "v."+jsName, true))
+		} else {
+			g.printf("    %s: %s,\n", jsName, c.expr(f.Type, "v['"+f.WireName+"']", false))
+		}
+	}
+	g.printf("  };\n")
+	g.printf("}\n")
+}
+
+// jsIdent returns the JavaScript name for a wire name,
+// for example "application-name" becomes "applicationName".
+func jsIdent(wireName string) string {
+	parts := strings.FieldsFunc(wireName, func(r rune) bool {
+		return r == '-' || r == '_' || r == '.'
+	})
+	for i := 1; i < len(parts); i++ {
+		parts[i] = exported(parts[i])
+	}
+	return lowerCamel(strings.Join(parts, ""))
+}
@@ -0,0 +1,251 @@
+package codegen
+
+import (
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// This file holds the Java and Kotlin backends. Both emit a data
+// type for every params and results type, and for each facade
+// version an object holding its name, version and method names.
+
+var javaKeywords = map[string]bool{
+	"abstract": true, "assert": true, "boolean": true, "break": true, "byte": true,
+	"case": true, "catch": true, "char": true, "class": true, "const": true,
+	"continue": true, "default": true, "do": true, "double": true, "else": true,
+	"enum": true, "extends": true, "final": true, "finally": true, "float": true,
+	"for": true, "goto": true, "if": true, "implements": true, "import": true,
+	"instanceof": true, "int": true, "interface": true, "long": true, "native": true,
+	"new": true, "package": true, "private": true, "protected": true, "public": true,
+	"record": true, "return": true, "short": true, "static": true, "strictfp": true,
+	"super": true, "switch": true, "synchronized": true, "this": true, "throw": true,
+	"throws": true, "transient": true, "try": true, "var": true, "void": true,
+	"volatile": true, "while": true, "yield": true,
+}
+
+var kotlinKeywords = map[string]bool{
+	"as": true, "break": true, "class": true, "continue": true, "do": true,
+	"else": true, "false": true, "for": true, "fun": true, "if": true,
+	"in": true, "interface": true, "is": true, "null": true, "object": true,
+	"package": true, "return": true, "super": true, "this": true, "throw": true,
+	"true": true, "try": true, "typealias": true, "typeof": true, "val": true,
+	"var": true, "when": true, "while": true,
+}
+
+// constName returns the name used for a method name
+// constant, for example "WATCH_API_HOST_PORTS".
+func constName(name string) string {
+	return strings.ToUpper(snake(name))
+}
+
+func genJava(g *gen) {
+	g.printf("// Code generated by jujuapidoc. DO NOT EDIT.\n\n")
+	g.printf("import com.fasterxml.jackson.annotation.JsonInclude;\n")
+	g.printf("import com.fasterxml.jackson.annotation.JsonProperty;\n")
+	g.printf("import com.fasterxml.jackson.databind.JsonNode;\n")
+	g.printf("import java.util.List;\n")
+	g.printf("import java.util.Map;\n\n")
+	g.printf("public final class JujuApi {\n")
+	g.printf("\tprivate JujuApi() {}\n")
+	for _, t := range g.types {
+		if t.Kind != jsontypes.Struct {
+			// Java has no type aliases; uses
+			// of the type refer to its definition.
+			continue
+		}
+		g.printf("\n\t/** %s */\n", t.Name)
+		g.printf("\t@JsonInclude(JsonInclude.Include.NON_NULL)\n")
+		g.printf("\tpublic record %s(", g.ident(t.Name))
+		for i, f := range g.fields(t) {
+			if i > 0 {
+				g.printf(",")
+			}
+			name := lowerCamel(f.Name)
+			if javaKeywords[name] {
+				name += "_"
+			}
+			g.printf("\n\t\t@JsonProperty(%q) %s %s", f.WireName, g.javaType(f.Type, f.Optional), name)
+		}
+		g.printf(") {}\n")
+	}
+	for i := range g.info.Facades {
+		f := &g.info.Facades[i]
+		g.printf("\n")
+		if lines := docLines(f.Doc); len(lines) > 0 {
+			g.printf("\t/**\n")
+			g.printDoc("\t", " *", f.Doc)
+			g.printf("\t */\n")
+		}
+		g.printf("\tpublic static final class %s {\n", facadeIdent(f))
+		g.printf("\t\tprivate %s() {}\n\n", facadeIdent(f))
+		g.printf("\t\tpublic static final String NAME = %q;\n", f.Name)
+		g.printf("\t\tpublic static final int VERSION = %d;\n", f.Version)
+		for _, m := range f.Methods {
+			g.printf("\n\t\t/** %s */\n", javaMethodDoc(g, m.Param, m.Result))
+			g.printf("\t\tpublic static final String %s = %q;\n", constName(m.Name), m.Name)
+		}
+		g.printf("\t}\n")
+	}
+	g.printf("}\n")
+}
+
+// javaMethodDoc returns a description of the params and
+// result types of a method.
+func javaMethodDoc(g *gen, param, result *jsontypes.Type) string {
+	p, r := "none", "none"
+	if param != nil {
+		p = g.javaType(param, true)
+	}
+	if result != nil {
+		r = g.javaType(result, true)
+	}
+	return "Params: " + p + "; result: " + r + "."
+}
+
+// javaType returns the Java type used for t. If boxed is true,
+// primitive types are boxed so that they can be null or used
+// as type parameters.
+func (g *gen) javaType(t *jsontypes.Type, boxed bool) string {
+	if t.Name.PkgPath() != "" {
+		switch g.special(t.Name) {
+		case timeType, textType:
+			return "String"
+		case jsonType:
+			return "JsonNode"
+		}
+		dt := g.underlying(t)
+		if dt.Kind == jsontypes.Struct {
+			return g.ident(t.Name)
+		}
+		t = dt
+	}
+	prim := func(p, box string) string {
+		if boxed {
+			return box
+		}
+		return p
+	}
+	switch t.Kind {
+	case jsontypes.Bool:
+		return prim("boolean", "Boolean")
+	case jsontypes.Int, jsontypes.Int64, jsontypes.Uint, jsontypes.Uint32, jsontypes.Uint64, jsontypes.Uintptr:
+		return prim("long", "Long")
+	case jsontypes.Int32, jsontypes.Uint16:
+		return prim("int", "Integer")
+	case jsontypes.Int16, jsontypes.Uint8:
+		return prim("short", "Short")
+	case jsontypes.Int8:
+		return prim("byte", "Byte")
+	case jsontypes.Float32:
+		return prim("float", "Float")
+	case jsontypes.Float64:
+		return prim("double", "Double")
+	case jsontypes.String:
+		return "String"
+	case jsontypes.Ptr:
+		return g.javaType(t.Elem, true)
+	case jsontypes.Slice, jsontypes.Array:
+		if g.isByteSlice(t) {
+			// Jackson encodes byte arrays as base64, as Go does.
+			return "byte[]"
+		}
+		return "List<" + g.javaType(t.Elem, true) + ">"
+	case jsontypes.Map:
+		return "Map<String, " + g.javaType(t.Elem, true) + ">"
+	}
+	return "JsonNode"
+}
+
+func genKotlin(g *gen) {
+	g.printf("// Code generated by jujuapidoc. DO NOT EDIT.\n\n")
+	g.printf("import kotlinx.serialization.SerialName\n")
+	g.printf("import kotlinx.serialization.Serializable\n")
+	g.printf("import kotlinx.serialization.json.JsonElement\n")
+	for _, t := range g.types {
+		g.printf("\n/** %s */\n", t.Name)
+		if t.Kind != jsontypes.Struct {
+			g.printf("typealias %s = %s\n", g.ident(t.Name), g.kotlinType(t, true))
+			continue
+		}
+		g.printf("@Serializable\n")
+		g.printf("data class %s(", g.ident(t.Name))
+		for i, f := range g.fields(t) {
+			if i > 0 {
+				g.printf(",")
+			}
+			name := lowerCamel(f.Name)
+			if kotlinKeywords[name] {
+				name = "`" + name + "`"
+			}
+			ut := g.underlying(f.Type)
+			// Go encodes nil slices and maps as null.
+			nullable := f.Optional || ut.Kind == jsontypes.Slice || ut.Kind == jsontypes.Map
+			typ := g.kotlinType(f.Type, false)
+			if nullable {
+				typ = strings.TrimSuffix(typ, "?") + "? = null"
+			}
+			g.printf("\n\t@SerialName(%q) val %s: %s", f.WireName, name, typ)
+		}
+		g.printf(",\n)\n")
+	}
+	for i := range g.info.Facades {
+		f := &g.info.Facades[i]
+		g.printf("\n")
+		if lines := docLines(f.Doc); len(lines) > 0 {
+			g.printf("/**\n")
+			g.printDoc("", " *", f.Doc)
+			g.printf(" */\n")
+		}
+		g.printf("object %s {\n", facadeIdent(f))
+		g.printf("\tconst val NAME = %q\n", f.Name)
+		g.printf("\tconst val VERSION = %d\n", f.Version)
+		for _, m := range f.Methods {
+			g.printf("\tconst val %s = %q\n", constName(m.Name), m.Name)
+		}
+		g.printf("}\n")
+	}
+}
+
+// kotlinType returns the Kotlin type used for t. If alias is
+// true, t is the definition of a named type being aliased.
+func (g *gen) kotlinType(t *jsontypes.Type, alias bool) string {
+	if t.Name.PkgPath() != "" && !alias {
+		switch g.special(t.Name) {
+		case timeType, textType:
+			return "String"
+		case jsonType:
+			return "JsonElement"
+		}
+		return g.ident(t.Name)
+	}
+	switch t.Kind {
+	case jsontypes.Bool:
+		return "Boolean"
+	case jsontypes.Int, jsontypes.Int64, jsontypes.Uint, jsontypes.Uint32, jsontypes.Uint64, jsontypes.Uintptr:
+		return "Long"
+	case jsontypes.Int32, jsontypes.Uint16:
+		return "Int"
+	case jsontypes.Int16, jsontypes.Uint8:
+		return "Short"
+	case jsontypes.Int8:
+		return "Byte"
+	case jsontypes.Float32:
+		return "Float"
+	case jsontypes.Float64:
+		return "Double"
+	case jsontypes.String:
+		return "String"
+	case jsontypes.Ptr:
+		return g.kotlinType(t.Elem, false) + "?"
+	case jsontypes.Slice, jsontypes.Array:
+		if g.isByteSlice(t) {
+			// Base64 encoded.
+			return "String"
+		}
+		return "List<" + g.kotlinType(t.Elem, false) + ">"
+	case jsontypes.Map:
+		return "Map<String, " + g.kotlinType(t.Elem, false) + ">"
+	}
+	return "JsonElement"
+}
@@ -0,0 +1,161 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+)
+
+// rustKeywords holds the Rust keywords that may clash with
+// field and method names; they're used as raw identifiers.
+var rustKeywords = map[string]bool{
+	"as": true, "async": true, "await": true, "box": true, "break": true,
+	"const": true, "continue": true, "crate": true, "dyn": true, "else": true,
+	"enum": true, "extern": true, "false": true, "fn": true, "for": true,
+	"if": true, "impl": true, "in": true, "let": true, "loop": true,
+	"match": true, "mod": true, "move": true, "mut": true, "pub": true,
+	"ref": true, "return": true, "static": true, "struct": true, "super": true,
+	"trait": true, "true": true, "type": true, "unsafe": true, "use": true,
+	"where": true, "while": true, "yield": true,
+}
+
+func rustIdent(name string) string {
+	name = snake(name)
+	if rustKeywords[name] {
+		return "r#" + name
+	}
+	return name
+}
+
+func genRust(g *gen) {
+	g.printf("// Code generated by jujuapidoc. DO NOT EDIT.\n\n")
+	g.printf("#![allow(dead_code)]\n\n")
+	g.printf("use serde::{Deserialize, Deserializer, Serialize};\n")
+	g.printf("use std::collections::HashMap;\n\n")
+	g.printf("// Go encodes nil slices and maps as null.\n")
+	g.printf("fn null_default<'de, D, T>(d: D) -> Result<T, D::Error>\n")
+	g.printf("where\n\tD: Deserializer<'de>,\n\tT: Default + Deserialize<'de>,\n{\n")
+	g.printf("\tOk(Option::<T>::deserialize(d)?.unwrap_or_default())\n}\n")
+	for _, t := range g.types {
+		g.printf("\n")
+		g.genRustType(t)
+	}
+	for i := range g.info.Facades {
+		f := &g.info.Facades[i]
+		g.printf("\n")
+		g.printDoc("", "///", f.Doc)
+		g.printf("pub trait %s {\n", facadeIdent(f))
+		g.printf("\ttype Error;\n\n")
+		g.printf("\tconst NAME: &'static str = %q;\n", f.Name)
+		g.printf("\tconst VERSION: i64 = %d;\n", f.Version)
+		for _, m := range f.Methods {
+			g.printf("\n")
+			g.printDoc("\t", "///", m.Doc)
+			g.printf("\t#[doc(alias = %q)]\n", m.Name)
+			params := ""
+			if m.Param != nil {
+				params = ", params: " + g.rustType(m.Param)
+			}
+			result := "()"
+			if m.Result != nil {
+				result = g.rustType(m.Result)
+			}
+			g.printf("\tfn %s(&self%s) -> Result<%s, Self::Error>;\n", rustIdent(m.Name), params, result)
+		}
+		g.printf("}\n")
+	}
+}
+
+func (g *gen) genRustType(t *jsontypes.Type) {
+	ident := g.ident(t.Name)
+	if t.Kind != jsontypes.Struct {
+		g.printf("/// %s\n", t.Name)
+		g.printf("pub type %s = %s;\n", ident, g.rustUnnamedType(t))
+		return
+	}
+	g.printf("/// %s\n", t.Name)
+	g.printf("#[derive(Debug, Clone, Default, PartialEq, Serialize, Deserialize)]\n")
+	g.printf("pub struct %s {\n", ident)
+	for _, f := range g.fields(t) {
+		ft := g.rustType(f.Type)
+		var attrs []string
+		attrs = append(attrs, fmt.Sprintf("rename = %q", f.WireName))
+		ut := g.underlying(f.Type)
+		switch {
+		case f.Optional && ut.Kind != jsontypes.Ptr:
+			ft = "Option<" + ft + ">"
+			fallthrough
+		case f.Optional:
+			attrs = append(attrs, "default", `skip_serializing_if = "Option::is_none"`)
+		case ut.Kind == jsontypes.Slice || ut.Kind == jsontypes.Map:
+			attrs = append(attrs, "default", `deserialize_with = "null_default"`)
+		}
+		if f.Doc != "" {
+			g.printf("\t/// %s\n", f.Doc)
+		}
+		g.printf("\t#[serde(%s)]\n", strings.Join(attrs, ", "))
+		g.printf("\tpub %s: %s,\n", rustIdent(f.Name), ft)
+	}
+	g.printf("}\n")
+}
+
+// rustType returns the Rust type used for t.
+func (g *gen) rustType(t *jsontypes.Type) string {
+	if t.Name.PkgPath() != "" {
+		switch g.special(t.Name) {
+		case timeType, textType:
+			return "String"
+		case jsonType:
+			return "serde_json::Value"
+		}
+		return g.ident(t.Name)
+	}
+	return g.rustUnnamedType(t)
+}
+
+func (g *gen) rustUnnamedType(t *jsontypes.Type) string {
+	switch t.Kind {
+	case jsontypes.Bool:
+		return "bool"
+	case jsontypes.Int, jsontypes.Int64:
+		return "i64"
+	case jsontypes.Int8:
+		return "i8"
+	case jsontypes.Int16:
+		return "i16"
+	case jsontypes.Int32:
+		return "i32"
+	case jsontypes.Uint, jsontypes.Uint64, jsontypes.Uintptr:
+		return "u64"
+	case jsontypes.Uint8:
+		return "u8"
+	case jsontypes.Uint16:
+		return "u16"
+	case jsontypes.Uint32:
+		return "u32"
+	case jsontypes.Float32:
+		return "f32"
+	case jsontypes.Float64:
+		return "f64"
+	case jsontypes.String:
+		return "String"
+	case jsontypes.Ptr:
+		elem := g.rustType(t.Elem)
+		if g.underlying(t.Elem).Kind == jsontypes.Struct {
+			// Box structs so that recursive types have a finite size.
+			elem = "Box<" + elem + ">"
+		}
+		return "Option<" + elem + ">"
+	case jsontypes.Slice, jsontypes.Array:
+		if g.isByteSlice(t) {
+			// Base64 encoded.
+			return "String"
+		}
+		return "Vec<" + g.rustType(t.Elem) + ">"
+	case jsontypes.Map:
+		// JSON object keys are always strings.
+		return "HashMap<String, " + g.rustType(t.Elem) + ">"
+	}
+	return "serde_json::Value"
+}
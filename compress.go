@@ -0,0 +1,76 @@
+package main
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// compressExts maps each format accepted by the -compress
+// flag to the file name extension used for it.
+var compressExts = map[string]string{
+	"gzip": ".gz",
+	"zstd": ".zst",
+}
+
+// compressedName returns the name of the file to write
+// for the given output file name when compressing in
+// the given format.
+func compressedName(name, format string) string {
+	ext := compressExts[format]
+	if strings.HasSuffix(name, ext) {
+		return name
+	}
+	return name + ext
+}
+
+// compressWriter returns a writer that compresses what is
+// written to it in the given format and writes the result to w.
+// The returned writer must be closed to flush the compressed data.
+// Gzip compression is done directly; zstd compression uses
+// the zstd command, which must be installed.
+func compressWriter(w io.Writer, format string) (io.WriteCloser, error) {
+	switch format {
+	case "gzip":
+		return gzip.NewWriter(w), nil
+	case "zstd":
+		cmd := exec.Command("zstd", "-q", "-c")
+		cmd.Stdout = w
+		cmd.Stderr = os.Stderr
+		stdin, err := cmd.StdinPipe()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		if err := cmd.Start(); err != nil {
+			return nil, errors.Notef(err, nil, "cannot run zstd")
+		}
+		return &cmdWriter{
+			WriteCloser: stdin,
+			cmd:         cmd,
+		}, nil
+	}
+	return nil, errors.Newf("unknown compression format %q", format)
+}
+
+// cmdWriter writes to the standard input of a command.
+type cmdWriter struct {
+	io.WriteCloser
+	cmd *exec.Cmd
+}
+
+// Close closes the command's standard input and
+// waits for it to finish.
+func (w *cmdWriter) Close() error {
+	err := w.WriteCloser.Close()
+	if err1 := w.cmd.Wait(); err == nil {
+		err = err1
+	}
+	if err != nil {
+		return errors.Notef(err, nil, "%s failed", w.cmd.Path)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Package contract derives a machine-readable contract from the
+// output of jujuapidoc and checks recorded RPC calls against it,
+// so that client libraries can verify that they conform to the
+// API of a particular Juju version.
+package contract
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// Contract holds the wire-level contract for a set of facades.
+type Contract struct {
+	Methods []Method
+
+	// ErrorCodes holds all the error codes that
+	// may be returned by any method.
+	ErrorCodes []string `json:",omitempty"`
+
+	// Definitions holds schemas for all the types
+	// referred to by the method schemas.
+	Definitions map[string]*apidoc.Schema
+}
+
+// Method holds the contract for a single RPC method.
+type Method struct {
+	Facade  string
+	Version int
+	Name    string
+	Params  *apidoc.Schema `json:",omitempty"`
+	Result  *apidoc.Schema `json:",omitempty"`
+}
+
+// Call holds a recorded RPC call. The field names match
+// those used in the RPC request and response messages.
+type Call struct {
+	Type      string          `json:"type"`
+	Version   int             `json:"version"`
+	Request   string          `json:"request"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	Response  json.RawMessage `json:"response,omitempty"`
+	Error     string          `json:"error,omitempty"`
+	ErrorCode string          `json:"error-code,omitempty"`
+}
+
+// New returns the contract implied by the given API information.
+func New(info *apidoc.Info) *Contract {
+	c := &Contract{
+		Definitions: info.SchemaDefinitions(),
+	}
+	for _, f := range info.Facades {
+		for _, m := range f.Methods {
+			c.Methods = append(c.Methods, Method{
+				Facade:  f.Name,
+				Version: f.Version,
+				Name:    m.Name,
+				Params:  info.Schema(m.Param),
+				Result:  info.Schema(m.Result),
+			})
+		}
+	}
+	for _, code := range info.ErrorCodes {
+		c.ErrorCodes = append(c.ErrorCodes, code.Code)
+	}
+	return c
+}
+
+// Method returns the contract for the given method,
+// or nil if there is none.
+func (c *Contract) Method(facade string, version int, name string) *Method {
+	for i := range c.Methods {
+		m := &c.Methods[i]
+		if m.Facade == facade && m.Version == version && m.Name == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// Check checks that the given call conforms to the contract.
+func (c *Contract) Check(call Call) error {
+	m := c.Method(call.Type, call.Version, call.Request)
+	if m == nil {
+		return errors.Newf("no method %s(%d).%s", call.Type, call.Version, call.Request)
+	}
+	if len(call.Params) > 0 {
+		if m.Params == nil {
+			return errors.Newf("method takes no parameters")
+		}
+		if err := m.Params.CheckJSON(c.Definitions, call.Params); err != nil {
+			return errors.Notef(err, nil, "invalid parameters")
+		}
+	}
+	if call.ErrorCode != "" {
+		if !c.hasErrorCode(call.ErrorCode) {
+			return errors.Newf("unknown error code %q", call.ErrorCode)
+		}
+		return nil
+	}
+	if call.Error != "" {
+		return nil
+	}
+	if len(call.Response) > 0 {
+		if m.Result == nil {
+			return errors.Newf("method has no result")
+		}
+		if err := m.Result.CheckJSON(c.Definitions, call.Response); err != nil {
+			return errors.Notef(err, nil, "invalid result")
+		}
+	}
+	return nil
+}
+
+func (c *Contract) hasErrorCode(code string) bool {
+	for _, known := range c.ErrorCodes {
+		if known == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Failure records a recorded call that did not conform to a contract.
+type Failure struct {
+	// Line holds the line number of the call in the recording.
+	Line int
+	Call Call
+	Err  error
+}
+
+// Replay reads a recording of calls, one JSON-encoded Call
+// per line, and checks each one against the contract. It returns
+// any calls that failed the check.
+func (c *Contract) Replay(r io.Reader) ([]Failure, error) {
+	var failures []Failure
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var call Call
+		if err := json.Unmarshal(scanner.Bytes(), &call); err != nil {
+			return nil, errors.Notef(err, nil, "cannot parse call at line %d", line)
+		}
+		if err := c.Check(call); err != nil {
+			failures = append(failures, Failure{
+				Line: line,
+				Call: call,
+				Err:  err,
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return failures, nil
+}
@@ -0,0 +1,42 @@
+package contract
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+)
+
+// TB is the subset of testing.TB used by CheckRecording.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+}
+
+// CheckRecording is intended to be called from the tests of a client
+// library. It reads the contract from contractFile (as written by
+// jujuapidoccontract) and replays the calls in recordingFile against it,
+// reporting an error for each call that does not conform.
+func CheckRecording(t TB, contractFile, recordingFile string) {
+	t.Helper()
+	data, err := ioutil.ReadFile(contractFile)
+	if err != nil {
+		t.Fatalf("cannot read contract: %v", err)
+	}
+	var c Contract
+	if err := json.Unmarshal(data, &c); err != nil {
+		t.Fatalf("cannot unmarshal contract: %v", err)
+	}
+	f, err := os.Open(recordingFile)
+	if err != nil {
+		t.Fatalf("cannot open recording: %v", err)
+	}
+	defer f.Close()
+	failures, err := c.Replay(f)
+	if err != nil {
+		t.Fatalf("cannot replay %s: %v", recordingFile, err)
+	}
+	for _, failure := range failures {
+		t.Errorf("%s:%d: %s(%d).%s: %v", recordingFile, failure.Line, failure.Call.Type, failure.Call.Version, failure.Call.Request, failure.Err)
+	}
+}
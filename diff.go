@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"time"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// diffMain implements the "diff" subcommand, which generates
+// the documentation for two versions of juju, typically two
+// branches of a fork, and prints the API changes between them.
+func diffMain(args []string) error {
+	fset := flag.NewFlagSet("diff", flag.ExitOnError)
+	var (
+		module     = fset.String("module", *moduleFlag, "module holding the juju source, such as a fork of "+jujuMod)
+		jsonOutput = fset.Bool("json", false, "print changes as JSON")
+		parallel   = fset.Bool("parallel", false, "generate the docs for both versions at the same time, which is faster but needs about twice the memory")
+	)
+	fset.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidoc diff [-module module] [-json] [-parallel] old-version new-version\n")
+		fmt.Fprintf(os.Stderr, "\nThe versions may be any version query understood by the go command, including branch names.\n\n")
+		fset.PrintDefaults()
+		os.Exit(2)
+	}
+	fset.Parse(args)
+	if fset.NArg() != 2 {
+		fset.Usage()
+	}
+	cacheDir, err := setupGoEnv()
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	infos, err := generateBoth(cacheDir, *module, fset.Arg(0), fset.Arg(1), *parallel)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	for i, info := range infos {
+		if n := len(info.FacadeErrors); n > 0 {
+			log.Printf("warning: %d facades could not be documented for %s@%s, so they will appear to have been removed or added", n, *module, fset.Arg(i))
+		}
+	}
+	changes := apidoc.Diff(infos[0], infos[1])
+	if *jsonOutput {
+		data, err := json.MarshalIndent(changes, "", "\t")
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		os.Stdout.Write(data)
+		return nil
+	}
+	for _, c := range changes {
+		fmt.Println(c)
+	}
+	return nil
+}
+
+// generateBoth generates the docs for the two given versions of
+// the given juju module, generating them only once if they're the
+// same. Each version needs a generator linked against it, so all
+// that the two share is the build cache. If parallel is true, both
+// generators are built before either is run, and then they run at
+// the same time.
+func generateBoth(cacheDir, module, oldVersion, newVersion string, parallel bool) ([2]*apidoc.Info, error) {
+	var infos [2]*apidoc.Info
+	versions := [2]string{oldVersion, newVersion}
+	if !parallel || oldVersion == newVersion {
+		for i, version := range versions {
+			if i > 0 && version == oldVersion {
+				infos[i] = infos[0]
+				continue
+			}
+			info, err := generate(cacheDir, module, version)
+			if err != nil {
+				return infos, errors.Notef(err, nil, "cannot generate docs for %s@%s", module, version)
+			}
+			infos[i] = info
+		}
+		return infos, nil
+	}
+	var cmds [2]*exec.Cmd
+	var outs [2]bytes.Buffer
+	for i, version := range versions {
+		cmd, err := generatorCmd(cacheDir, module, version)
+		if err != nil {
+			return infos, errors.Notef(err, nil, "cannot generate docs for %s@%s", module, version)
+		}
+		cmd.Stdout = &outs[i]
+		cmds[i] = cmd
+	}
+	var errs [2]error
+	run := func(i int) {
+		if _, err := runGenerator(cmds[i]); err != nil {
+			errs[i] = errors.Notef(err, nil, "cannot generate docs for %s@%s", module, versions[i])
+		}
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		run(0)
+	}()
+	run(1)
+	<-done
+	for i := range versions {
+		if errs[i] != nil {
+			return infos, errs[i]
+		}
+		info, err := apidoc.Parse(outs[i].Bytes())
+		if err != nil {
+			return infos, errors.Notef(err, nil, "cannot parse generated info for %s@%s", module, versions[i])
+		}
+		infos[i] = info
+	}
+	return infos, nil
+}
+
+// generate runs the doc generator for the given version
+// of the given juju module and returns its output. The
+// output may be partial; see runGenerator.
+func generate(cacheDir, module, version string) (*apidoc.Info, error) {
+	cmd, err := generatorCmd(cacheDir, module, version)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if _, err := runGenerator(cmd); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	info, err := apidoc.Parse(out.Bytes())
+	if err != nil {
+		return nil, errors.Notef(err, nil, "cannot parse generated info")
+	}
+	return info, nil
+}
+
+// runGenerator runs the doc generator command and reports
+// whether its output is partial because some facades could
+// not be documented. Those are listed in the FacadeErrors
+// field of the output.
+func runGenerator(cmd *exec.Cmd) (partial bool, err error) {
+	start := time.Now()
+	err = cmd.Run()
+	addStage("generate", start)
+	if err, ok := err.(*exec.ExitError); ok && err.ExitCode() == partialExitCode {
+		return true, nil
+	}
+	if err != nil {
+		return false, errors.Notef(err, nil, "generate info failed")
+	}
+	return false, nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// releaseVersionPat matches juju release versions, with or without
+// the "juju-" prefix used by juju's tags, capturing the major and
+// minor version numbers.
+var releaseVersionPat = regexp.MustCompile(`^(?:juju-|v)?(\d+)\.(\d+)(?:[.-]|$)`)
+
+// isLegacyVersion reports whether the given juju version predates
+// usable module metadata, so that the dependencies recorded by dep
+// must be used to build it.
+func isLegacyVersion(version string) bool {
+	m := releaseVersionPat.FindStringSubmatch(version)
+	if m == nil {
+		return false
+	}
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	// Pseudo-versions have major version 0, but
+	// there have been no juju releases with it.
+	return major == 1 || major == 2 && minor <= 4
+}
+
+// legacyBuild builds the doc generator in the work directory dir
+// against the given version of the juju module by cloning it into
+// a GOPATH in dir and running dep to vendor its dependencies, as
+// juju versions from before module support were built.
+func legacyBuild(dir string, cp *checkpoint, module, version string) error {
+	if cp.JujuDir == "" || !exists(cp.JujuDir) {
+		gopath := filepath.Join(dir, "gopath")
+		if err := os.RemoveAll(gopath); err != nil {
+			return errors.Wrap(err)
+		}
+		// The juju source must be at its canonical import path
+		// for dep to work, even when it's cloned from a fork.
+		root := filepath.Join(gopath, "src", filepath.FromSlash(jujuMod))
+		tag := version
+		if !strings.HasPrefix(tag, "juju-") {
+			tag = "juju-" + strings.TrimPrefix(tag, "v")
+		}
+		if _, err := runCmd(dir, "git", "clone", "--depth=1", "--branch="+tag, "https://"+module, root); err != nil {
+			return errors.Notef(err, nil, "cannot clone %s at %s", module, tag)
+		}
+		if _, err := runCmdEnv(root, []string{"GOPATH=" + gopath, "GO111MODULE=off"}, "dep", "ensure", "-vendor-only"); err != nil {
+			return errors.Notef(err, nil, `cannot run dep; try "go get github.com/golang/dep/cmd/dep"`)
+		}
+		commit, err := runCmd(root, "git", "rev-parse", "HEAD")
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		cp.Commit = strings.TrimSpace(commit)
+		if err := ensureGoMod(root); err != nil {
+			return errors.Wrap(err)
+		}
+		cp.ResolvedModule = module + "@" + tag
+		cp.JujuDir = root
+		cp.Built = false
+		if err := cp.save(dir); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	return buildVendored(dir, cp)
+}
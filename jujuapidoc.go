@@ -4,45 +4,265 @@
 // It depends on a custom addition to the apiserver package,
 // FacadeRegistry.ListDetails, the implementation of which
 // can be found in https://github.com/juju/juju/tree/076-apiserver-facade-list-details.
+// Juju 3.x and 4.x trees, which moved the params package and
+// changed the shape of facade factories, are also supported;
+// the doc generator is built with a build tag selecting the
+// code for the layout of the chosen version. Forks, and
+// distributions that have moved juju to a different import path,
+// can be documented by naming their module with the -module flag.
+// Old versions that only build with their vendored dependencies
+// can be built in vendor mode from a release tarball named with
+// the -vendor flag. Releases from before 2.5, which predate usable
+// module metadata, are cloned into a GOPATH and built from the
+// dependencies that dep vendors for them.
+//
+// Each run works in a directory under the cache directory that
+// records the stages it has completed, including the result for
+// each facade, so a run that was interrupted can be resumed
+// with the -resume flag.
+//
+// With -changed-only, the output holds only the facades that are
+// new or have changed since the -baseline document, with an index
+// of all the facades, for jobs that only publish the changes.
+//
+// Facades that exist only for testing, because they're implemented
+// or registered by test support code or registered only when a
+// testing feature flag such as developer-mode is enabled, are marked
+// as TestOnly; with -omit-test-only they're left out, so that
+// published docs don't describe facades that production controllers
+// don't serve.
+//
+// Each method has an example call with sample values synthesized
+// from its schemas; the -examples flag adds curated examples from a
+// JSON file mapping from Facade.Method to a list of examples, each
+// with a Title and optional Description, Params and Result.
+//
+// Each facade is tagged with its subsystems, such as "storage", and
+// its audiences, such as "client"; -tag-overrides adds or removes
+// tags, and -tag includes only the facades with the given tags. The
+// html format has an index of the tags and can filter by them.
+//
+// A facade that cannot be documented, because its doc lookup
+// fails, it panics, it takes longer than the -facade-timeout
+// flag allows, or the methods documented for it don't match
+// those that it serves, doesn't stop the run: it is recorded in the
+// FacadeErrors section of the output, and the command exits
+// with status 3 once the output has been written.
+// With -strict, the run fails instead if there are any facade
+// errors, warnings or facade factory panics, and methods without
+// doc comments are reported as warnings, so that CI jobs can
+// refuse to publish an incomplete document.
 //
 // The resulting JSON output can be processed into HTML by
 // the jujuapidochtml command.
+//
+// The call subcommand uses the JSON output to check the params
+// for a method, calls it on a controller and prints the response,
+// which can be useful when exploring the API.
+//
+// The diff subcommand generates the documentation for two versions,
+// which may be branches of a fork of juju named with its -module
+// flag, and prints the API changes between them, so that the API
+// impact of a change can be checked before it is proposed. The
+// -parallel flag generates the two versions at the same time, which
+// needs about twice the memory.
+//
+// The version subcommand prints the version and commit of
+// jujuapidoc and the hash of the doc generator embedded in it,
+// which are also recorded in the Meta section of each document.
+//
+// The self-update subcommand replaces the jujuapidoc binary with
+// the newest release found by the module proxy, built with go
+// install, for machines that would otherwise run a stale version.
 package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"gopkg.in/errgo.v2/fmt/errors"
+
+	"github.com/juju/jujuapidoc/apidoc"
 )
 
-var showCommands = flag.Bool("x", false, "show commands that are being run")
+var (
+	showCommands   = flag.Bool("x", false, "show commands that are being run")
+	securityReport = flag.String("security-report", "", "write a report of agent-accessible methods without permission checks to the named file")
+	panicReport    = flag.String("panic-report", "", "write a JSON report of facade factory panics to the named file")
+	cpuProfile     = flag.String("cpuprofile", "", "write a CPU profile of the doc generator to the named file")
+	memProfile     = flag.String("memprofile", "", "write a heap profile of the doc generator to the named file")
+	traceFile      = flag.String("trace", "", "write an execution trace of the doc generator to the named file")
+	maxProcs       = flag.Int("maxprocs", 0, "maximum number of CPUs for the doc generator to use (default all)")
+	buildP         = flag.Int("build-p", 0, "maximum number of build commands for the go command to run in parallel (default the number of CPUs)")
+	memLimit       = flag.String("memlimit", "", "soft memory limit for the go command and the doc generator, in GOMEMLIMIT format (e.g. 2GiB)")
+	outputFormat   = flag.String("format", "jujuapidoc", `comma-separated output formats: "jujuapidoc" (or "json") for the full document, "schemagen" for the format produced by Juju's schemagen tool, "flat" for a list of methods with their schemas inlined, or "html" or "markdown" for a page describing the newest version of each facade; with more than one, each is written to the -o file name with the format's extension added`)
+	audience       = flag.String("audience", "all", `facades to include: "all", or "public" to omit facades that only agents can use, and the types that only they use`)
+	omitTestOnly   = flag.Bool("omit-test-only", false, "omit the facades that exist only for testing, which production controllers don't serve, rather than marking them as TestOnly")
+	moduleFlag     = flag.String("module", jujuMod, "module holding the juju source, for documenting forks and distributions that import it from a different path")
+	vendorFlag     = flag.String("vendor", "", "build the doc generator with -mod=vendor from the gzipped release tarball at the named path or URL, for old juju versions that only build correctly with their vendored dependencies")
+	resume         = flag.Bool("resume", false, "resume an interrupted run for the same juju version, reusing the work it completed")
+	outputFile     = flag.String("o", "", "write the output to the named file instead of standard output")
+	compress       = flag.String("compress", "", `compress the output: "gzip", or "zstd" (which requires the zstd command); with -o, the file name is given the matching extension`)
+	checksum       = flag.Bool("checksum", false, "write a SHA-256 checksum file, in the format used by sha256sum, alongside each generated file (requires -o)")
+	signKey        = flag.String("sign", "", "sign each generated file with the named gpg key, writing a detached ASCII-armored signature alongside it (requires -o)")
+	changedOnly    = flag.Bool("changed-only", false, "include only the facades that are new or have changed since the -baseline document, along with an index of all the facades")
+	baselineFlag   = flag.String("baseline", "", "jujuapidoc JSON document, perhaps gzipped, to compare against for -changed-only")
+	facadeTimeout  = flag.Duration("facade-timeout", 2*time.Minute, "maximum time for the doc generator to spend on each facade, after which the facade is recorded as failed; 0 means no limit")
+	messagesFlag   = flag.String("messages", "", "JSON message catalog holding translations of the text of the html and markdown formats")
+	tagOverrides   = flag.String("tag-overrides", "", `JSON file mapping from facade names to tags to add to each version of those facades, or to remove when prefixed with "-"`)
+	tagFlag        = flag.String("tag", "", "comma-separated tags: include only the facades with at least one of them, and the types that they use")
+	examplesFlag   = flag.String("examples", "", "JSON file of curated examples of calls, mapping from Facade.Method to a list of examples, to add to each version of those methods before the synthesized ones")
+	strictFlag     = flag.Bool("strict", false, "fail if generation produces any warnings (including for missing doc comments), facade factory panics or facades that could not be documented, for CI jobs where an incomplete document should not be published")
+	statsFlag      = flag.Bool("stats", false, "print statistics on the run (facades, methods, types, bytes written, stage durations and cache hit rates) to standard error when it finishes")
+	statsJSON      = flag.String("stats-json", "", "write the statistics on the run as JSON to the named file")
+	debugDump      = flag.String("debug-dump", "", "write the raw rpcreflect data, go/types signatures and resolution decisions for each facade to a JSON file in the named directory, for diagnosing missing or wrong output; facades reused with -resume are not dumped")
+	cacheDirFlag   = flag.String("cache-dir", "", "directory for the Go build and module caches, shared between runs (default $XDG_CACHE_HOME/jujuapidoc or equivalent)")
+)
 
-//go:generate go-bindata jujugenerateapidoc
+//go:generate go-bindata -ignore=_test\.go$ jujugenerateapidoc apidoc go.mod
 
 func main() {
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "usage: jujuapidoc [juju-version]\n")
+		fmt.Fprintf(os.Stderr, "usage: jujuapidoc [flags] [juju-version]\n")
+		fmt.Fprintf(os.Stderr, "       jujuapidoc schema\n")
+		fmt.Fprintf(os.Stderr, "       jujuapidoc call [flags] Facade.Method [params.json]\n")
+		fmt.Fprintf(os.Stderr, "       jujuapidoc diff [-module module] [-json] [-parallel] old-version new-version\n")
+		fmt.Fprintf(os.Stderr, "       jujuapidoc version [-json]\n")
+		fmt.Fprintf(os.Stderr, "       jujuapidoc self-update [-check] [-version version] [-force]\n")
+		flag.PrintDefaults()
 		os.Exit(2)
 	}
 	flag.Parse()
+	if flag.Arg(0) == "schema" {
+		// Print the schema of our own output format.
+		data, err := json.MarshalIndent(apidoc.FormatSchema(), "", "\t")
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Stdout.Write(data)
+		return
+	}
+	if flag.Arg(0) == "call" {
+		if err := callMain(flag.Args()[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+	if flag.Arg(0) == "version" {
+		if err := versionMain(flag.Args()[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+	if flag.Arg(0) == "self-update" {
+		if err := checkGoCommand(); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		if err := selfUpdateMain(flag.Args()[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+	if flag.Arg(0) == "diff" {
+		if err := checkGoCommand(); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		if err := diffMain(flag.Args()[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
 	version := flag.Arg(0)
 	if version == "" {
 		version = "latest"
 	}
-	if !canUseModules() {
-		fmt.Fprintf(os.Stderr, "cannot use Go modules; use Go 1.11 or later\n")
+	formats := strings.Split(*outputFormat, ",")
+	for i, format := range formats {
+		if format == "json" {
+			formats[i] = "jujuapidoc"
+		} else if formatExts[format] == "" {
+			fmt.Fprintf(os.Stderr, "unknown output format %q\n", format)
+			os.Exit(2)
+		}
+	}
+	*outputFormat = strings.Join(formats, ",")
+	if len(formats) > 1 && *outputFile == "" {
+		fmt.Fprintf(os.Stderr, "more than one output format requires -o\n")
+		os.Exit(2)
+	}
+	if *audience != "all" && *audience != "public" {
+		fmt.Fprintf(os.Stderr, "unknown audience %q\n", *audience)
+		os.Exit(2)
+	}
+	if _, ok := compressExts[*compress]; !ok && *compress != "" {
+		fmt.Fprintf(os.Stderr, "unknown compression format %q\n", *compress)
+		os.Exit(2)
+	}
+	if *changedOnly != (*baselineFlag != "") {
+		fmt.Fprintf(os.Stderr, "-changed-only and -baseline must be used together\n")
+		os.Exit(2)
+	}
+	if (*checksum || *signKey != "") && *outputFile == "" {
+		fmt.Fprintf(os.Stderr, "-checksum and -sign require -o\n")
+		os.Exit(2)
+	}
+	if *messagesFlag != "" {
+		// Read the catalog now so that a mistake in it
+		// is found before the work of generating.
+		msgs, err := apidoc.ReadMessages(*messagesFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(2)
+		}
+		messages = msgs
+	}
+	if *examplesFlag != "" {
+		// Likewise for the curated examples.
+		examples, err := apidoc.ReadExamples(*examplesFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(2)
+		}
+		curatedExamples = examples
+	}
+	if *tagOverrides != "" {
+		overrides, err := apidoc.ReadTagOverrides(*tagOverrides)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(2)
+		}
+		tagOverrideMap = overrides
+	}
+	if *statsFlag || *statsJSON != "" {
+		runStats = &apidoc.Stats{}
+	}
+	if err := checkGoCommand(); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
 	if err := runMain(version); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
+		if err == errPartial {
+			os.Exit(partialExitCode)
+		}
 		os.Exit(1)
 	}
 }
@@ -54,74 +274,683 @@ func canUseModules() bool {
 
 const jujuMod = "github.com/juju/juju"
 
+// apidocMod holds the path of this module, which
+// provides the apidoc package to the doc generator.
+const apidocMod = "github.com/juju/jujuapidoc"
+
+// partialExitCode holds the exit status used, by jujuapidoc
+// and the doc generator alike, when the documentation was
+// written but some facades could not be documented.
+const partialExitCode = 3
+
+// errPartial is returned by runMain when the output is
+// incomplete because some facades could not be documented.
+var errPartial = errors.New("some facades could not be documented (see the FacadeErrors section of the output)")
+
+// goEnv holds environment variables to set for all
+// go commands that are run.
+var goEnv []string
+
 func runMain(version string) error {
-	dir, err := ioutil.TempDir("", "")
+	cacheDir, err := setupGoEnv()
 	if err != nil {
 		return errors.Wrap(err)
 	}
-	log.Printf("temp dir: %v", dir)
-	//defer os.RemoveAll(dir)
-	jujuModDir := filepath.Join(dir, "jujumod")
-	if err := os.Mkdir(jujuModDir, 0777); err != nil {
-		return errors.Wrap(err)
+	formats := strings.Split(*outputFormat, ",")
+	var baseline *apidoc.Info
+	if *changedOnly {
+		// Read the baseline first so that a bad
+		// one is found before the long generation.
+		baseline, err = apidoc.ReadFile(*baselineFlag)
+		if err != nil {
+			return errors.Notef(err, nil, "cannot read baseline")
+		}
 	}
-
-	if err := RestoreAssets(dir, ""); err != nil {
-		return errors.Wrap(err)
+	var artifacts []string
+	partial := false
+	if len(formats) == 1 && formats[0] == "jujuapidoc" && *audience == "all" && !*omitTestOnly && baseline == nil && curatedExamples == nil && tagOverrideMap == nil && *tagFlag == "" {
+		// There's no need to process the output, so
+		// avoid holding it all in memory.
+		cmd, err := generatorCmd(cacheDir, *moduleFlag, version)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		file, err := writeArtifact(*outputFile, func(w io.Writer) error {
+			cmd.Stdout = w
+			partial, err = runGenerator(cmd)
+			return errors.Wrap(err)
+		})
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		artifacts = append(artifacts, file)
+	} else {
+		// Generate the documentation once and
+		// render it in each of the formats.
+		info, err := generate(cacheDir, *moduleFlag, version)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		partial = len(info.FacadeErrors) > 0
+		if curatedExamples != nil {
+			if err := info.AddExamples(curatedExamples); err != nil {
+				return errors.Notef(err, nil, "cannot add examples from %s", *examplesFlag)
+			}
+		}
+		if tagOverrideMap != nil {
+			if err := info.OverrideTags(tagOverrideMap); err != nil {
+				return errors.Notef(err, nil, "cannot override tags from %s", *tagOverrides)
+			}
+		}
+		if *audience == "public" || *omitTestOnly || *tagFlag != "" {
+			keep := func(f *apidoc.FacadeInfo, m *apidoc.Method) bool {
+				if *omitTestOnly && f.TestOnly {
+					return false
+				}
+				if *tagFlag != "" && !hasAnyTag(f, strings.Split(*tagFlag, ",")) {
+					return false
+				}
+				return *audience != "public" || f.HasAudience(apidoc.AudienceClient)
+			}
+			info = info.Filter(keep)
+			if baseline != nil {
+				// Filter the baseline in the same way so that
+				// the facades left out aren't reported as removed.
+				baseline = baseline.Filter(keep)
+			}
+		}
+		if baseline != nil {
+			info = info.ChangedSince(baseline)
+		}
+		for _, format := range formats {
+			name := *outputFile
+			if len(formats) > 1 {
+				name += formatExts[format]
+			}
+			start := time.Now()
+			file, err := writeArtifact(name, func(w io.Writer) error {
+				return render(w, info, format)
+			})
+			if err != nil {
+				return errors.Wrap(err)
+			}
+			addStage("render "+format, start)
+			artifacts = append(artifacts, file)
+		}
 	}
-	generateDir := filepath.Join(dir, "jujugenerateapidoc")
+	if *outputFile != "" {
+		for _, file := range []string{*securityReport, *panicReport} {
+			if file != "" {
+				artifacts = append(artifacts, file)
+			}
+		}
+		if err := writeSidecars(artifacts); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	if err := addGeneratorStats(); err != nil {
+		return errors.Notef(err, nil, "cannot read doc generator statistics")
+	}
+	if err := writeStats(); err != nil {
+		return errors.Notef(err, nil, "cannot write statistics")
+	}
+	if partial {
+		// The output is still written, as the facades
+		// that could be documented are worth having.
+		return errPartial
+	}
+	return nil
+}
+
+// formatExts maps each output format to the extension added
+// to the -o file name for it when there's more than one format.
+var formatExts = map[string]string{
+	"jujuapidoc": ".json",
+	"schemagen":  ".schemagen.json",
+	"flat":       ".flat.json",
+	"html":       ".html",
+	"markdown":   ".md",
+}
 
-	// Resolve the version first, so that it won't change underfoot.
-	resolvedModule, err := runCmd(generateDir, "go", "list", "-m", jujuMod+"@"+version)
+// writeArtifact calls write to write the named output file, or
+// standard output if name is empty, compressing what it writes
+// as requested by the -compress flag. It returns the name
+// of the file written, which has the extension for the
+// compression format added if necessary.
+func writeArtifact(name string, write func(w io.Writer) error) (string, error) {
+	var w io.Writer = os.Stdout
+	var f *os.File
+	if name != "" {
+		if *compress != "" {
+			name = compressedName(name, *compress)
+		}
+		var err error
+		f, err = os.Create(name)
+		if err != nil {
+			return "", errors.Wrap(err)
+		}
+		w = f
+	}
+	cw := &countingWriter{w: w}
+	err := writeCompressed(cw, write)
+	if f != nil {
+		if err1 := f.Close(); err == nil {
+			err = err1
+		}
+	}
 	if err != nil {
-		return errors.Notef(err, nil, "cannot resolve version number for %q", jujuMod+"@"+version)
+		return "", errors.Wrap(err)
+	}
+	if runStats != nil {
+		outName := name
+		if outName == "" {
+			outName = "-"
+		}
+		runStats.Outputs = append(runStats.Outputs, apidoc.OutputStats{
+			Name:  outName,
+			Bytes: cw.n,
+		})
 	}
-	resolvedModule = strings.Replace(strings.TrimSpace(resolvedModule), " ", "@", -1)
+	return name, nil
+}
 
-	if _, err := runCmd(generateDir, "go", "mod", "download", resolvedModule); err != nil {
-		return errors.Wrap(err)
+// writeCompressed calls write with a writer that writes to w,
+// compressing the data as requested by the -compress flag.
+func writeCompressed(w io.Writer, write func(w io.Writer) error) error {
+	if *compress == "" {
+		return write(w)
 	}
-	jujuDir, err := runCmd(generateDir, "go", "list", "-f={{.Dir}}", "-m", resolvedModule)
+	cw, err := compressWriter(w, *compress)
 	if err != nil {
 		return errors.Wrap(err)
 	}
-	jujuDir = strings.TrimSpace(jujuDir)
-	if jujuDir == "" {
-		return errors.Newf("no source directory found for %s@%s (originally %s@%s)", resolvedModule, jujuMod, version)
+	if err := write(cw); err != nil {
+		cw.Close()
+		return errors.Wrap(err)
 	}
-	if err := copyFile(filepath.Join(jujuModDir, "Gopkg.lock"), filepath.Join(jujuDir, "Gopkg.lock")); err != nil {
+	if err := cw.Close(); err != nil {
 		return errors.Wrap(err)
 	}
-	if err := copyFile(filepath.Join(jujuModDir, "Gopkg.toml"), filepath.Join(jujuDir, "Gopkg.toml")); err != nil {
+	return nil
+}
+
+// messages holds the message catalog
+// given with the -messages flag.
+var messages apidoc.Messages
+
+// curatedExamples holds the examples read from
+// the file given with the -examples flag.
+var curatedExamples map[string][]apidoc.MethodExample
+
+// tagOverrideMap holds the tag overrides read from
+// the file given with the -tag-overrides flag.
+var tagOverrideMap map[string][]string
+
+// hasAnyTag reports whether f has any of the given tags.
+func hasAnyTag(f *apidoc.FacadeInfo, tags []string) bool {
+	for _, tag := range tags {
+		if f.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// render writes info to w in the given format.
+func render(w io.Writer, info *apidoc.Info, format string) error {
+	var data []byte
+	var err error
+	switch format {
+	case "jujuapidoc":
+		data, err = json.Marshal(info)
+	case "schemagen":
+		// Use the same indentation as schemagen so that
+		// the output can be compared directly with its output.
+		data, err = json.MarshalIndent(info.Schemagen(), "", "    ")
+	case "flat":
+		data, err = json.MarshalIndent(info.Flatten(), "", "\t")
+	case "html":
+		return apidoc.RenderHTMLMessages(w, sortedLatest(info), messages)
+	case "markdown":
+		return apidoc.RenderMarkdownMessages(w, sortedLatest(info), messages)
+	}
+	if err != nil {
 		return errors.Wrap(err)
 	}
-	if _, err := runCmd(jujuModDir, "go", "mod", "init", jujuMod); err != nil {
+	data = append(data, '\n')
+	if _, err := w.Write(data); err != nil {
 		return errors.Wrap(err)
 	}
-	if _, err := runCmd(generateDir, "gomodmerge", filepath.Join(jujuModDir, "go.mod")); err != nil {
-		return errors.Notef(err, nil, `cannot run gomodmerge; try "go get github.com/rogpeppe/gomodmerge"`)
+	return nil
+}
+
+// sortedLatest returns the newest version of each facade in
+// info, sorted by name, for rendering as a single page.
+func sortedLatest(info *apidoc.Info) *apidoc.Info {
+	info = info.Latest()
+	sort.Slice(info.Facades, func(i, j int) bool {
+		return info.Facades[i].Name < info.Facades[j].Name
+	})
+	return info
+}
+
+// setupGoEnv sets goEnv to use the shared build and module
+// caches and returns the cache directory.
+func setupGoEnv() (string, error) {
+	cacheDir, err := goCacheDir()
+	if err != nil {
+		return "", errors.Wrap(err)
+	}
+	log.Printf("cache dir: %v", cacheDir)
+	// Share the build and module caches between runs so that
+	// rebuilding the generator after a version bump only needs
+	// to fetch and compile what has changed.
+	goEnv = []string{
+		"GOCACHE=" + filepath.Join(cacheDir, "go-build"),
+		"GOMODCACHE=" + filepath.Join(cacheDir, "mod"),
+	}
+	if *buildP > 0 {
+		goEnv = append(goEnv, strings.TrimSpace(fmt.Sprintf("GOFLAGS=%s -p=%d", os.Getenv("GOFLAGS"), *buildP)))
+	}
+	if *memLimit != "" {
+		goEnv = append(goEnv, "GOMEMLIMIT="+*memLimit)
+	}
+	return cacheDir, nil
+}
+
+// generatorCmd builds the doc generator for the given version
+// of the given juju module, which may be a fork of github.com/juju/juju,
+// and returns the command to run it. The command's standard output
+// is left for the caller to set.
+func generatorCmd(cacheDir, module, version string) (*exec.Cmd, error) {
+	start := time.Now()
+	dir := workDir(cacheDir, module, version)
+	log.Printf("work dir: %v", dir)
+	cp, err := startWork(dir, *resume)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	generateDir := filepath.Join(dir, "jujugenerateapidoc")
+	// runDir holds the directory to run the generator in, which
+	// must be in a module that can load the juju packages.
+	runDir := generateDir
+	vendored := true
+	switch {
+	case *vendorFlag != "":
+		err = vendorBuild(dir, cp, module, version)
+	case isLegacyVersion(version):
+		log.Printf("juju %s predates module support; building in GOPATH mode", version)
+		err = legacyBuild(dir, cp, module, version)
+	default:
+		vendored = false
+		err = moduleBuild(dir, cp, module, version)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if vendored {
+		runDir = cp.JujuDir
+	}
+	args, err := generatorArgs()
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	args = append(args, "-checkpoint-dir="+filepath.Join(dir, "facades"))
+	args = append(args, fmt.Sprintf("-facade-timeout=%v", *facadeTimeout))
+	if runStats != nil {
+		generatorStatsFile = filepath.Join(dir, "stats.json")
+		args = append(args, "-stats="+generatorStatsFile)
+	}
+	if *strictFlag {
+		args = append(args, "-strict")
+	}
+	meta, err := json.Marshal(generationMeta(cp))
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	args = append(args, "-meta="+string(meta))
+	cmd := exec.Command(filepath.Join(generateDir, "jujugenerateapidoc"), args...)
+	cmd.Dir = runDir
+	cmd.Env = append(os.Environ(), goEnv...)
+	if vendored {
+		// The generator loads the juju packages as it runs,
+		// so those must come from the vendor directory too.
+		cmd.Env = append(cmd.Env, goFlagsEnv("-mod=vendor"))
 	}
-	if _, err := runCmd(generateDir, "go", "build"); err != nil {
-		return errors.Notef(err, nil, "cannot build doc generator program")
+	if *maxProcs > 0 {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GOMAXPROCS=%d", *maxProcs))
 	}
-	cmd := exec.Command(filepath.Join(generateDir, "jujugenerateapidoc"))
-	cmd.Dir = generateDir
 	if *showCommands {
 		printShellCommand(dir, cmd.Path, cmd.Args)
 	}
 	cmd.Stderr = os.Stderr
-	cmd.Stdout = os.Stdout
-	if err := cmd.Run(); err != nil {
-		return errors.Notef(err, nil, "generate info failed")
+	addStage("build", start)
+	return cmd, nil
+}
+
+// restoreGenerator writes the embedded doc generator source to
+// the work directory dir, along with the source of the apidoc
+// package, which goes in the jujuapidoc directory with this
+// module's go.mod file. The generator must be built against
+// that apidoc package, not a published version of it, as the
+// documents it writes must be ones that this command can read.
+func restoreGenerator(dir string) error {
+	if err := RestoreAssets(dir, "jujugenerateapidoc"); err != nil {
+		return errors.Wrap(err)
+	}
+	apidocDir := filepath.Join(dir, "jujuapidoc")
+	if err := RestoreAssets(apidocDir, "apidoc"); err != nil {
+		return errors.Wrap(err)
+	}
+	if err := RestoreAsset(apidocDir, "go.mod"); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// moduleBuild builds the doc generator in the work directory dir
+// against the given version of the juju module, which is
+// downloaded along with its dependencies from the module proxy.
+func moduleBuild(dir string, cp *checkpoint, module, version string) error {
+	jujuModDir := filepath.Join(dir, "jujumod")
+	generateDir := filepath.Join(dir, "jujugenerateapidoc")
+
+	if cp.ResolvedModule == "" {
+		// Resolve the version first, so that it won't change underfoot.
+		resolvedModule, err := runCmd(generateDir, "go", "list", "-m", module+"@"+version)
+		if err != nil {
+			return errors.Notef(err, nil, "cannot resolve version number for %q", module+"@"+version)
+		}
+		cp.ResolvedModule = strings.Replace(strings.TrimSpace(resolvedModule), " ", "@", -1)
+		if err := cp.save(dir); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	if cp.JujuDir == "" || !exists(cp.JujuDir) {
+		// Download only the juju module itself, which we need
+		// for its dependency metadata. The rest of its dependencies
+		// are fetched by "go build", which only downloads modules
+		// that provide packages actually used by the generator.
+		downloadOut, err := runCmd(generateDir, "go", "mod", "download", "-json", cp.ResolvedModule)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		var download struct {
+			Dir    string
+			Origin struct {
+				Hash string
+			}
+		}
+		if err := json.Unmarshal([]byte(downloadOut), &download); err != nil {
+			return errors.Notef(err, nil, "cannot parse go mod download output")
+		}
+		if download.Dir == "" {
+			return errors.Newf("no source directory found for %s (originally %s@%s)", cp.ResolvedModule, module, version)
+		}
+		cp.JujuDir = download.Dir
+		cp.Commit = download.Origin.Hash
+		if cp.Commit == "" {
+			cp.Commit = pseudoVersionRev(cp.ResolvedModule)
+		}
+		if err := cp.save(dir); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	// Even when the generator has been built, it needs
+	// the toolchain to load the juju packages as it runs.
+	if err := selectToolchain(cp.JujuDir); err != nil {
+		return errors.Wrap(err)
+	}
+	if !cp.Built || !exists(filepath.Join(generateDir, "jujugenerateapidoc")) {
+		// Start from the original generator source in case
+		// an interrupted build left it partly edited.
+		if err := restoreGenerator(dir); err != nil {
+			return errors.Wrap(err)
+		}
+		declaredPath, err := modulePath(cp.JujuDir)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		if declaredPath != jujuMod {
+			// The source has been moved to a different import
+			// path, so the generator must import it from there.
+			if err := rewriteJujuImports(generateDir, declaredPath); err != nil {
+				return errors.Wrap(err)
+			}
+		}
+		if err := jujuModFile(jujuModDir, cp.JujuDir); err != nil {
+			return errors.Wrap(err)
+		}
+		if _, err := runCmd(generateDir, "gomodmerge", filepath.Join(jujuModDir, "go.mod")); err != nil {
+			return errors.Notef(err, nil, `cannot run gomodmerge; try "go get github.com/rogpeppe/gomodmerge"`)
+		}
+		// Require the resolved version explicitly so that the
+		// build doesn't pick up the latest version instead. A fork
+		// usually still declares itself as github.com/juju/juju, in
+		// which case it replaces that module rather than being
+		// required directly. The apidoc package comes from
+		// the work directory; see restoreGenerator.
+		resolvedVersion := cp.ResolvedModule[strings.LastIndex(cp.ResolvedModule, "@")+1:]
+		editArgs := []string{
+			"mod", "edit",
+			"-require=" + declaredPath + "@" + resolvedVersion,
+			"-replace=" + apidocMod + "=" + filepath.Join(dir, "jujuapidoc"),
+		}
+		if module != declaredPath {
+			editArgs = append(editArgs, "-replace="+declaredPath+"="+cp.ResolvedModule)
+		}
+		if _, err := runCmd(generateDir, "go", editArgs...); err != nil {
+			return errors.Wrap(err)
+		}
+		layout, err := jujuLayout(cp.JujuDir)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		log.Printf("juju source layout: %s", layout)
+		buildArgs := []string{"build"}
+		if layout != "juju2" {
+			buildArgs = append(buildArgs, "-tags", layout)
+		}
+		if _, err := runCmd(generateDir, "go", buildArgs...); err != nil {
+			return errors.Notef(err, nil, "cannot build doc generator program")
+		}
+		cp.Built = true
+		if err := cp.save(dir); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// generationMeta returns the metadata to record in the
+// generated document for the juju source in cp. The doc
+// generator adds the rest.
+func generationMeta(cp *checkpoint) *apidoc.Meta {
+	i := strings.LastIndex(cp.ResolvedModule, "@")
+	meta := &apidoc.Meta{
+		Module:  cp.ResolvedModule[:i],
+		Version: cp.ResolvedModule[i+1:],
+		Commit:  cp.Commit,
+	}
+	meta.ToolVersion, meta.ToolCommit = toolVersion()
+	meta.GeneratorHash = cp.Generator
+	return meta
+}
+
+// pseudoVersionPat matches a module pseudo-version,
+// capturing its revision.
+var pseudoVersionPat = regexp.MustCompile(`[-.](?:0\.)?\d{14}-([0-9a-f]{12})(?:\+incompatible)?$`)
+
+// pseudoVersionRev returns the abbreviated commit hash
+// in the given module@version if the version is a
+// pseudo-version, or the empty string otherwise.
+func pseudoVersionRev(modVersion string) string {
+	m := pseudoVersionPat.FindStringSubmatch(modVersion)
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
+
+// jujuModFile writes a go.mod file describing the dependencies of
+// the juju source in jujuDir to modDir. Newer juju versions have
+// their own go.mod file; older ones used dep, so we create one
+// from its lock file.
+func jujuModFile(modDir, jujuDir string) error {
+	if _, err := os.Stat(filepath.Join(jujuDir, "go.mod")); err == nil {
+		if err := copyFile(filepath.Join(modDir, "go.mod"), filepath.Join(jujuDir, "go.mod")); err != nil {
+			return errors.Wrap(err)
+		}
+		return nil
+	}
+	if err := copyFile(filepath.Join(modDir, "Gopkg.lock"), filepath.Join(jujuDir, "Gopkg.lock")); err != nil {
+		return errors.Wrap(err)
+	}
+	if err := copyFile(filepath.Join(modDir, "Gopkg.toml"), filepath.Join(jujuDir, "Gopkg.toml")); err != nil {
+		return errors.Wrap(err)
+	}
+	if _, err := runCmd(modDir, "go", "mod", "init", jujuMod); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// modulePath returns the module path declared by the go.mod
+// file in the juju source in jujuDir. Sources without a go.mod
+// file are from before juju was moved, so they use jujuMod.
+func modulePath(jujuDir string) (string, error) {
+	data, err := ioutil.ReadFile(filepath.Join(jujuDir, "go.mod"))
+	if os.IsNotExist(err) {
+		return jujuMod, nil
+	}
+	if err != nil {
+		return "", errors.Wrap(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`), nil
+		}
+	}
+	return "", errors.Newf("no module path found in %s", filepath.Join(jujuDir, "go.mod"))
+}
+
+var jujuImportPat = regexp.MustCompile(`"` + regexp.QuoteMeta(jujuMod) + `(/[^"]*)?"`)
+
+// rewriteJujuImports changes all the references to jujuMod packages
+// in the Go source in dir, both imports and package path constants,
+// to refer to the same packages under the given module path.
+func rewriteJujuImports(dir, path string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		data = jujuImportPat.ReplaceAll(data, []byte(`"`+path+`$1"`))
+		if err := ioutil.WriteFile(file, data, 0666); err != nil {
+			return errors.Wrap(err)
+		}
 	}
 	return nil
 }
 
+// jujuLayout returns the layout of the juju source in jujuDir,
+// which determines the build tag used to build the doc generator:
+//
+//	juju2 - the params package is in apiserver/params (no tag).
+//	juju3 - the params package is in rpc/params.
+//	juju4 - as juju3, but facade factories take a model context.
+func jujuLayout(jujuDir string) (string, error) {
+	if _, err := os.Stat(filepath.Join(jujuDir, "rpc", "params")); err != nil {
+		return "juju2", nil
+	}
+	facadeDir := filepath.Join(jujuDir, "apiserver", "facade")
+	files, err := filepath.Glob(filepath.Join(facadeDir, "*.go"))
+	if err != nil {
+		return "", errors.Wrap(err)
+	}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return "", errors.Wrap(err)
+		}
+		if bytes.Contains(data, []byte("type MultiModelContext interface")) {
+			return "juju4", nil
+		}
+	}
+	return "juju3", nil
+}
+
+// goCacheDir returns the directory to use for the Go caches,
+// creating it if necessary.
+func goCacheDir() (string, error) {
+	dir := *cacheDirFlag
+	if dir == "" {
+		userDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", errors.Notef(err, nil, "cannot determine cache directory; use the -cache-dir flag")
+		}
+		dir = filepath.Join(userDir, "jujuapidoc")
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", errors.Wrap(err)
+	}
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return "", errors.Wrap(err)
+	}
+	return dir, nil
+}
+
+// generatorArgs returns the arguments to pass to the
+// doc generator program. As the generator runs in
+// the work directory, any file names are made absolute.
+func generatorArgs() ([]string, error) {
+	var args []string
+	fileFlags := []struct {
+		name  string
+		value string
+	}{
+		{"security-report", *securityReport},
+		{"panic-report", *panicReport},
+		{"cpuprofile", *cpuProfile},
+		{"memprofile", *memProfile},
+		{"trace", *traceFile},
+		{"debug-dump", *debugDump},
+	}
+	for _, f := range fileFlags {
+		if f.value == "" {
+			continue
+		}
+		path, err := filepath.Abs(f.value)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		args = append(args, "-"+f.name+"="+path)
+	}
+	return args, nil
+}
+
 func runCmd(dir string, exe string, args ...string) (string, error) {
+	return runCmdEnv(dir, nil, exe, args...)
+}
+
+// runCmdEnv is like runCmd but also sets the
+// given environment variables.
+func runCmdEnv(dir string, env []string, exe string, args ...string) (string, error) {
 	if *showCommands {
-		printShellCommand(dir, exe, args)
+		if len(env) > 0 {
+			printShellCommand(dir, "env", append(append(env[:len(env):len(env)], exe), args...))
+		} else {
+			printShellCommand(dir, exe, args)
+		}
 	}
 	c := exec.Command(exe, args...)
+	c.Env = append(append(os.Environ(), goEnv...), env...)
 	c.Stderr = os.Stderr
 	c.Dir = dir
 	var buf bytes.Buffer
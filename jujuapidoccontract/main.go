@@ -0,0 +1,75 @@
+// The jujuapidoccontract command converts JSON output from jujuapidoc
+// into a contract describing the wire format of each method,
+// and checks recordings of RPC calls against such a contract.
+//
+// A recording holds one JSON object per line, each holding
+// the type, version, request and params fields of an RPC request
+// together with the response, error and error-code fields
+// of its reply.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"github.com/juju/jujuapidoc/contract"
+)
+
+var check = flag.Bool("check", false, "check recordings against a contract")
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidoccontract api.json\n")
+		fmt.Fprintf(os.Stderr, "	jujuapidoccontract -check contract.json recording.json...\n")
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() < 1 || (*check && flag.NArg() < 2) {
+		flag.Usage()
+	}
+	if *check {
+		os.Exit(checkRecordings(flag.Arg(0), flag.Args()[1:]))
+	}
+	info, err := apidoc.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	data, err := json.MarshalIndent(contract.New(info), "", "\t")
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Stdout.Write(data)
+}
+
+func checkRecordings(contractFile string, recordings []string) int {
+	data, err := ioutil.ReadFile(contractFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var c contract.Contract
+	if err := json.Unmarshal(data, &c); err != nil {
+		log.Fatal(err)
+	}
+	status := 0
+	for _, file := range recordings {
+		f, err := os.Open(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		failures, err := c.Replay(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("%s: %v", file, err)
+		}
+		for _, failure := range failures {
+			fmt.Printf("%s:%d: %s(%d).%s: %v\n", file, failure.Line, failure.Call.Type, failure.Call.Version, failure.Call.Request, failure.Err)
+			status = 1
+		}
+	}
+	return status
+}
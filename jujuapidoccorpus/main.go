@@ -0,0 +1,101 @@
+// The jujuapidoccorpus command generates a corpus of JSON payloads
+// from the JSON output of jujuapidoc, suitable for seeding fuzz tests
+// of the Juju API server's request decoding.
+//
+// For every type used as the parameters of an RPC method, it writes
+// a representative sample payload and a set of boundary-case
+// payloads (null and empty values, long strings, extreme numbers)
+// into a directory named after the type.
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+var goFuzz = flag.Bool("gofuzz", false, "write files in the native Go fuzzing corpus format")
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidoccorpus [-gofuzz] api.json outdir\n")
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+	}
+	info, err := apidoc.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	outDir := flag.Arg(1)
+	defs := info.SchemaDefinitions()
+	defNames := info.DefinitionNames()
+	n := 0
+	for _, name := range paramTypes(info) {
+		s := &apidoc.Schema{
+			Ref: "#/definitions/" + defNames[name],
+		}
+		payloads := append([]interface{}{s.Sample(defs)}, s.Variants(defs)...)
+		dir := filepath.Join(outDir, defNames[name])
+		if err := os.MkdirAll(dir, 0777); err != nil {
+			log.Fatal(err)
+		}
+		for _, p := range payloads {
+			if err := writePayload(dir, p); err != nil {
+				log.Fatal(err)
+			}
+			n++
+		}
+	}
+	log.Printf("wrote %d payloads", n)
+}
+
+// paramTypes returns the names of all the types used
+// as method parameters, in sorted order.
+func paramTypes(info *apidoc.Info) []jsontypes.TypeName {
+	seen := make(map[jsontypes.TypeName]bool)
+	var names []jsontypes.TypeName
+	for _, f := range info.Facades {
+		for _, m := range f.Methods {
+			if m.Param == nil || seen[m.Param.Name] || m.Param.Name.PkgPath() == "" {
+				continue
+			}
+			seen[m.Param.Name] = true
+			names = append(names, m.Param.Name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// writePayload writes the JSON encoding of p to a file in dir
+// named after its content hash, so that duplicate payloads
+// are only stored once.
+func writePayload(dir string, p interface{}) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(data)
+	name := fmt.Sprintf("%x", sum[:8])
+	if *goFuzz {
+		data = []byte(fmt.Sprintf("go test fuzz v1\n[]byte(%q)\n", data))
+	} else {
+		name += ".json"
+	}
+	return ioutil.WriteFile(filepath.Join(dir, name), data, 0666)
+}
@@ -0,0 +1,68 @@
+// The jujuapidocdiff command compares two JSON documents
+// produced by jujuapidoc, for example from different Juju
+// versions, and prints the changes between them.
+//
+// With the -families flag, it instead takes a single document
+// and prints how each family of versioned params types, such
+// as AddApplicationUnits and AddApplicationUnitsV5, changed
+// from one revision to the next.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+var (
+	jsonOutput = flag.Bool("json", false, "print changes as JSON")
+	families   = flag.Bool("families", false, "print the changes between the revisions of each versioned type in a single document")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidocdiff [-json] old.json new.json\n")
+		fmt.Fprintf(os.Stderr, "       jujuapidocdiff -families [-json] doc.json\n")
+		os.Exit(2)
+	}
+	flag.Parse()
+	var changes []apidoc.Change
+	if *families {
+		if flag.NArg() != 1 {
+			flag.Usage()
+		}
+		info, err := apidoc.ReadFile(flag.Arg(0))
+		if err != nil {
+			log.Fatal(err)
+		}
+		changes = info.TypeEvolution()
+	} else {
+		if flag.NArg() != 2 {
+			flag.Usage()
+		}
+		old, err := apidoc.ReadFile(flag.Arg(0))
+		if err != nil {
+			log.Fatal(err)
+		}
+		new, err := apidoc.ReadFile(flag.Arg(1))
+		if err != nil {
+			log.Fatal(err)
+		}
+		changes = apidoc.Diff(old, new)
+	}
+	if *jsonOutput {
+		data, err := json.MarshalIndent(changes, "", "\t")
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Stdout.Write(data)
+		return
+	}
+	for _, c := range changes {
+		fmt.Println(c)
+	}
+}
@@ -0,0 +1,104 @@
+// The jujuapidocexample command prints examples of calling
+// methods described by JSON output from jujuapidoc, showing
+// the complete messages sent and received over a websocket
+// connection to the Juju API server.
+//
+// With the -script flag, it prints a shell script for each
+// method that makes the call using wscat
+// (see https://github.com/websockets/wscat).
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+var (
+	script = flag.Bool("script", false, "print a wscat shell script for each method")
+	outDir = flag.String("o", "", "write an example file for each method into the named directory")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidocexample [-script] [-o dir] api.json [Facade[.Method]...]\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+	}
+	info, err := apidoc.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var examples []apidoc.Example
+	for _, e := range info.Examples() {
+		if selected(e, flag.Args()[1:]) {
+			examples = append(examples, e)
+		}
+	}
+	if *script && *outDir == "" && len(examples) > 1 {
+		log.Fatalf("%d methods selected; use -o to write a script for each one", len(examples))
+	}
+	if *outDir != "" {
+		if err := os.MkdirAll(*outDir, 0777); err != nil {
+			log.Fatal(err)
+		}
+	}
+	for _, e := range examples {
+		text, ext := frames(e), ".txt"
+		if *script {
+			text, ext = e.Script(), ".sh"
+		}
+		if *outDir == "" {
+			fmt.Print(text)
+			continue
+		}
+		file := filepath.Join(*outDir, fmt.Sprintf("%s-v%d-%s%s", e.Facade, e.Version, e.Method, ext))
+		perm := os.FileMode(0666)
+		if *script {
+			perm = 0777
+		}
+		if err := ioutil.WriteFile(file, []byte(text), perm); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// selected reports whether the example is selected by any of
+// the given Facade or Facade.Method arguments. All examples are
+// selected if there are no arguments.
+func selected(e apidoc.Example, args []string) bool {
+	if len(args) == 0 {
+		return true
+	}
+	for _, arg := range args {
+		facade, method := arg, ""
+		if i := strings.Index(arg, "."); i >= 0 {
+			facade, method = arg[:i], arg[i+1:]
+		}
+		if facade == e.Facade && (method == "" || method == e.Method) {
+			return true
+		}
+	}
+	return false
+}
+
+// frames returns the messages in the example, with those sent
+// to the server prefixed by ">" and those received by "<".
+func frames(e apidoc.Example) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "# %s(%d).%s\n", e.Facade, e.Version, e.Method)
+	fmt.Fprintf(&buf, "> %s\n", apidoc.FrameJSON(e.Login))
+	fmt.Fprintf(&buf, "> %s\n", apidoc.FrameJSON(e.Request))
+	fmt.Fprintf(&buf, "< %s\n\n", apidoc.FrameJSON(e.Response))
+	return buf.String()
+}
@@ -0,0 +1,135 @@
+// The jujuapidocfixture command generates test fixtures from the
+// JSON output of jujuapidoc for the test suites of Juju API client
+// libraries, such as python-libjuju and js-libjuju, so that they can
+// check that they implement every documented method of the Juju
+// version that they target.
+//
+// It writes a file for each facade version, named
+// Facade/vN.json, holding the facade's methods with sample
+// params and results, and an index.json file listing the
+// facade versions and their methods along with the Juju
+// version that they were taken from:
+//
+//	index.json
+//	Uniter/v7.json
+//	Uniter/v8.json
+//	...
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+var latest = flag.Bool("latest", false, "write fixtures for the latest version of each facade only")
+
+// index holds the contents of the index.json file.
+type index struct {
+	Module  string `json:",omitempty"`
+	Version string `json:",omitempty"`
+	Facades []indexEntry
+}
+
+// indexEntry describes one facade version in the index.
+type indexEntry struct {
+	Name    string
+	Version int
+
+	// File holds the path of the facade's fixture file,
+	// relative to the index, with forward slashes.
+	File    string
+	Methods []string
+}
+
+// fixture holds the contents of the fixture
+// file for a single facade version.
+type fixture struct {
+	Facade  string
+	Version int
+	Methods []fixtureMethod
+}
+
+// fixtureMethod holds the fixture for a single method.
+// Params and Result are absent if the method has none.
+type fixtureMethod struct {
+	Name   string
+	Params interface{} `json:",omitempty"`
+	Result interface{} `json:",omitempty"`
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidocfixture [-latest] api.json outdir\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+	}
+	info, err := apidoc.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *latest {
+		info = info.Latest()
+	}
+	outDir := flag.Arg(1)
+	var idx index
+	if info.Meta != nil {
+		idx.Module, idx.Version = info.Meta.Module, info.Meta.Version
+	}
+	defs := info.SchemaDefinitions()
+	for _, f := range info.Facades {
+		fx := fixture{
+			Facade:  f.Name,
+			Version: f.Version,
+		}
+		entry := indexEntry{
+			Name:    f.Name,
+			Version: f.Version,
+			File:    fmt.Sprintf("%s/v%d.json", f.Name, f.Version),
+		}
+		for _, m := range f.Methods {
+			fm := fixtureMethod{
+				Name: m.Name,
+			}
+			if m.Param != nil {
+				fm.Params = info.Schema(m.Param).Sample(defs)
+			}
+			if m.Result != nil {
+				fm.Result = info.Schema(m.Result).Sample(defs)
+			}
+			fx.Methods = append(fx.Methods, fm)
+			entry.Methods = append(entry.Methods, m.Name)
+		}
+		if err := writeJSON(filepath.Join(outDir, filepath.FromSlash(entry.File)), fx); err != nil {
+			log.Fatal(err)
+		}
+		idx.Facades = append(idx.Facades, entry)
+	}
+	if err := writeJSON(filepath.Join(outDir, "index.json"), idx); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote fixtures for %d facade versions", len(idx.Facades))
+}
+
+// writeJSON writes the indented JSON encoding of v to the
+// named file, creating its directory if necessary.
+func writeJSON(file string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0777); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, append(data, '\n'), 0666)
+}
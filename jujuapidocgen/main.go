@@ -0,0 +1,55 @@
+// The jujuapidocgen command generates client bindings in
+// other languages from the JSON output of jujuapidoc.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"github.com/juju/jujuapidoc/codegen"
+)
+
+var (
+	lang      = flag.String("lang", "", "language to generate bindings for")
+	listLangs = flag.Bool("langs", false, "list the available languages and exit")
+	outDir    = flag.String("o", "", "write the generated files into the named directory instead of standard output")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidocgen [-o dir] -lang lang api.json\n")
+		os.Exit(2)
+	}
+	flag.Parse()
+	if *listLangs {
+		for _, b := range codegen.Backends {
+			fmt.Printf("%s\t%s\n", b.Name, b.Description)
+		}
+		return
+	}
+	if flag.NArg() != 1 || *lang == "" {
+		flag.Usage()
+	}
+	b := codegen.BackendByName(*lang)
+	if b == nil {
+		log.Fatalf("unknown language %q (see -langs)", *lang)
+	}
+	info, err := apidoc.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := apidoc.Validate(info); err != nil {
+		log.Fatal(err)
+	}
+	if *outDir != "" {
+		err = b.GenerateDir(*outDir, info)
+	} else {
+		err = b.Generate(os.Stdout, info)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -9,78 +9,34 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"html/template"
-	"io/ioutil"
 	"log"
 	"os"
 	"sort"
-	"strings"
-
-	"github.com/juju/apicompat/jsontypes"
 
 	"github.com/juju/jujuapidoc/apidoc"
 )
 
-var htmlTmpl = `
-<html>
-<head>
-<style>
-	body {
-		font-family: Ubuntu Light, sans-serif;
-		padding: 25px;
-	}
-	h2 a {
-		color: black;
-		text-decoration: none;
-	}
-	h2 a:hover {
-		text-decoration: underline;
-	}
-	h2 + p {
-		padding-left: 25px;
-	}
-	tr:nth-child(even) {
-		background-color: #f1f1f1;
-	}
-	td {
-		vertical-align: top;
-		padding: 10px;
-	}
-</style>
-<title>Juju API docs (autogenerated)</title>
-</head>
-<body>
-<h1>Juju API facades</h1>
-{{range .Facades}}
-	<h2 id="{{.Name}}"><a href="#{{.Name}}">{{.Name}}</a> v{{.Version}} <span style="font-size:80%;font-style: italic">{{.AvailableTo | join " "}}</span></h2>
-	<p>{{.Doc}}</p>
-	<table>
-		<tr>
-			<th>Name</th>
-			<th>Params</th>
-			<th>Results</th>
-			<th>Description</th>
-		</tr>
-		{{range .Methods}}
-			<tr>
-				<td>{{.Name}}</td>
-				<td>{{.Param | typeLink}}</td>
-				<td>{{.Result | typeLink}}</td>
-				<td>{{.Doc}}</td>
-			</tr>
-		{{end}}
-	</table>
-{{end}}
-</body>
-</html>
-`
+var (
+	audience = flag.String("audience", "", `show only facades for the given audience ("client", "agent" or "controller")`)
+	tag      = flag.String("tag", "", `show only facades with the given subsystem tag, such as "storage"`)
+	messages = flag.String("messages", "", "JSON message catalog holding translations of the text of the page")
+	defaults = flag.Bool("default-messages", false, "print the default message catalog, as a starting point for a translation, and exit")
+)
 
 func main() {
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "usage: jujuapidochtml api.json [role...]\n")
+		fmt.Fprintf(os.Stderr, "usage: jujuapidochtml [-audience audience] [-tag tag] [-messages catalog.json] api.json [role...]\n")
 		os.Exit(2)
 	}
 	flag.Parse()
+	if *defaults {
+		data, err := json.MarshalIndent(apidoc.DefaultMessages, "", "\t")
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Stdout.Write(append(data, '\n'))
+		return
+	}
 
 	if flag.NArg() < 1 {
 		flag.Usage()
@@ -90,12 +46,19 @@ func main() {
 		roles[role] = true
 	}
 
-	data, err := ioutil.ReadFile(flag.Arg(0))
+	var msgs apidoc.Messages
+	if *messages != "" {
+		var err error
+		msgs, err = apidoc.ReadMessages(*messages)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	info, err := apidoc.ReadFile(flag.Arg(0))
 	if err != nil {
 		log.Fatal(err)
 	}
-	var info *apidoc.Info
-	if err := json.Unmarshal(data, &info); err != nil {
+	if err := apidoc.Validate(info); err != nil {
 		log.Fatal(err)
 	}
 	sort.Slice(info.Facades, func(i, j int) bool {
@@ -111,6 +74,12 @@ func main() {
 		if seen[f.Name] {
 			continue
 		}
+		if *audience != "" && !f.HasAudience(*audience) {
+			continue
+		}
+		if *tag != "" && !f.HasTag(*tag) {
+			continue
+		}
 		if len(roles) > 0 {
 			found := false
 			for _, role := range f.AvailableTo {
@@ -128,24 +97,7 @@ func main() {
 	}
 	info.Facades = facades
 
-	t, err := template.New("").Funcs(tmplFuncs).Parse(htmlTmpl)
-	if err != nil {
+	if err := apidoc.RenderHTMLMessages(os.Stdout, info, msgs); err != nil {
 		log.Fatal(err)
 	}
-	if err := t.Execute(os.Stdout, info); err != nil {
-		log.Fatal(err)
-	}
-}
-
-var tmplFuncs = template.FuncMap{
-	"typeLink": func(t *jsontypes.Type) template.HTML {
-		if t == nil {
-			return "n/a"
-		}
-		link := fmt.Sprintf(`<a href="https://godoc.org/%s">%s</a>`, t.Name, t.Name.Name())
-		return template.HTML(link)
-	},
-	"join": func(sep string, ss []string) string {
-		return strings.Join(ss, sep)
-	},
 }
@@ -0,0 +1,57 @@
+// The jujuapidocintersect command reads several JSON documents
+// produced by jujuapidoc, typically for different Juju releases,
+// and prints a document holding only the facade versions and
+// methods that all of them have in common, so that client authors
+// can target a subset of the API that works with every one of
+// those releases. See apidoc.Intersect for details.
+//
+// The output is itself a jujuapidoc document, so it can be
+// rendered with jujuapidochtml or used for code generation with
+// jujuapidocgen.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidocintersect api.json api.json...\n")
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() < 2 {
+		flag.Usage()
+	}
+	var infos []*apidoc.Info
+	for _, file := range flag.Args() {
+		info, err := apidoc.ReadFile(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(info.FacadeErrors) > 0 {
+			log.Printf("warning: %s is incomplete; %d facades could not be documented", file, len(info.FacadeErrors))
+		}
+		infos = append(infos, info)
+	}
+	common := apidoc.Intersect(infos...)
+	n := 0
+	for _, f := range common.Facades {
+		n += len(f.Methods)
+	}
+	log.Printf("%d facade versions, %d methods in common", len(common.Facades), n)
+	data, err := json.Marshal(common)
+	if err != nil {
+		log.Fatal(err)
+	}
+	data = append(data, '\n')
+	if _, err := os.Stdout.Write(data); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,75 @@
+// The jujuapidoclint command checks JSON output from jujuapidoc
+// against Juju's API naming and structure conventions and prints
+// a report of any violations. With -sarif, the report is in SARIF
+// format, including the warnings recorded by the doc generator,
+// for uploading to code scanning tools such as GitHub's.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"github.com/juju/jujuapidoc/lint"
+)
+
+var (
+	jsonOutput = flag.Bool("json", false, "print findings as JSON")
+	sarif      = flag.Bool("sarif", false, "print findings, and the warnings recorded by the doc generator, as a SARIF log")
+	listRules  = flag.Bool("rules", false, "list the rules that are checked and exit")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidoclint [-json | -sarif] api.json\n")
+		os.Exit(2)
+	}
+	flag.Parse()
+	if *listRules {
+		for _, r := range lint.Rules {
+			fmt.Printf("%s\t%s\n", r.Name, r.Description)
+		}
+		return
+	}
+	if flag.NArg() != 1 || *jsonOutput && *sarif {
+		flag.Usage()
+	}
+	info, err := apidoc.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	findings := lint.Check(info)
+	switch {
+	case *sarif:
+		if err := lint.WriteSARIF(os.Stdout, info, findings); err != nil {
+			log.Fatal(err)
+		}
+	case *jsonOutput:
+		data, err := json.MarshalIndent(findings, "", "\t")
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Stdout.Write(data)
+	default:
+		for _, f := range findings {
+			fmt.Printf("%s: %s\n", location(f), f.Message)
+		}
+	}
+	if len(findings) > 0 {
+		os.Exit(1)
+	}
+}
+
+func location(f lint.Finding) string {
+	loc := f.Facade
+	if f.Version > 0 {
+		loc += fmt.Sprintf("(%d)", f.Version)
+	}
+	if f.Method != "" {
+		loc += "." + f.Method
+	}
+	return loc + " [" + f.Rule + "]"
+}
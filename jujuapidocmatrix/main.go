@@ -0,0 +1,62 @@
+// The jujuapidocmatrix command prints a permission matrix from the
+// JSON output of jujuapidoc, with a row for each facade method and
+// a column for each kind of entity, showing which kinds of entity
+// can call each method. It is intended for security reviews.
+//
+// Methods that agents can call but that appear not to check
+// permissions are marked, as are entity kinds whose access is
+// assumed because the facade factory panicked.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+var (
+	format   = flag.String("format", "csv", `output format: "csv" or "html"`)
+	latest   = flag.Bool("latest", false, "include the latest version of each facade only")
+	messages = flag.String("messages", "", "JSON message catalog holding translations of the text of the html format")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidocmatrix [-format csv|html] [-latest] [-messages catalog.json] api.json\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+	}
+	var msgs apidoc.Messages
+	if *messages != "" {
+		var err error
+		msgs, err = apidoc.ReadMessages(*messages)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	info, err := apidoc.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *latest {
+		info = info.Latest()
+	}
+	switch *format {
+	case "csv":
+		err = apidoc.WritePermissionMatrixCSV(os.Stdout, info)
+	case "html":
+		err = apidoc.RenderPermissionMatrixHTMLMessages(os.Stdout, info, msgs)
+	default:
+		log.Fatalf("unknown format %q", *format)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
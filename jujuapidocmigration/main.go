@@ -0,0 +1,237 @@
+// The jujuapidocmigration command reports on the facades involved
+// in model migration across a range of Juju versions, given JSON
+// documents produced by jujuapidoc for each of those versions.
+//
+// For each migration facade version, it shows the first and last
+// Juju versions that provide it. It also shows, for each pair of
+// source and target controller versions, the version of the
+// MigrationTarget facade that the source would use to talk to the
+// target, and the minimum target version that each source can
+// migrate models to. A source controller is assumed to be able to
+// use any version of MigrationTarget up to the newest it provides
+// itself.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+var jsonOutput = flag.Bool("json", false, "print the report as JSON")
+
+// targetFacade holds the name of the migration facade
+// that is provided by the target controller. All the others
+// are used within the source controller.
+const targetFacade = "MigrationTarget"
+
+type report struct {
+	Facades []facadeVersion
+	Pairs   []pair
+	// MinTarget maps each source version to the oldest target
+	// version that it can migrate models to. Sources that
+	// can't migrate to any of the target versions are omitted.
+	MinTarget map[string]string
+}
+
+// facadeVersion records the range of Juju versions that
+// provide a version of a migration facade.
+type facadeVersion struct {
+	Facade   string
+	Version  int
+	ServedBy string
+	First    string
+	Last     string
+}
+
+// pair records the version of the target facade used when
+// migrating between two controller versions, or zero if
+// there is no version in common.
+type pair struct {
+	Source  string
+	Target  string
+	Version int
+}
+
+type jujuVersion struct {
+	version string
+	info    *apidoc.Info
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidocmigration [-json] [version=]api.json...\n")
+		fmt.Fprintf(os.Stderr, "\nIf the version is omitted, the base name of the file is used.\n")
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+	}
+	var versions []jujuVersion
+	for _, arg := range flag.Args() {
+		version, path := strings.TrimSuffix(filepath.Base(arg), ".json"), arg
+		if i := strings.Index(arg, "="); i >= 0 {
+			version, path = arg[:i], arg[i+1:]
+		}
+		info, err := apidoc.ReadFile(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		versions = append(versions, jujuVersion{version, info})
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versionLess(versions[i].version, versions[j].version)
+	})
+	r := makeReport(versions)
+	if *jsonOutput {
+		data, err := json.MarshalIndent(r, "", "\t")
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Stdout.Write(data)
+		return
+	}
+	printReport(r, versions)
+}
+
+func makeReport(versions []jujuVersion) *report {
+	r := &report{
+		MinTarget: make(map[string]string),
+	}
+	index := make(map[apidocKey]int)
+	for _, v := range versions {
+		for _, f := range v.info.Facades {
+			if !f.HasTag("migration") {
+				continue
+			}
+			key := apidocKey{f.Name, f.Version}
+			if i, ok := index[key]; ok {
+				r.Facades[i].Last = v.version
+				continue
+			}
+			servedBy := "source"
+			if f.Name == targetFacade {
+				servedBy = "target"
+			}
+			index[key] = len(r.Facades)
+			r.Facades = append(r.Facades, facadeVersion{
+				Facade:   f.Name,
+				Version:  f.Version,
+				ServedBy: servedBy,
+				First:    v.version,
+				Last:     v.version,
+			})
+		}
+	}
+	sort.Slice(r.Facades, func(i, j int) bool {
+		f0, f1 := r.Facades[i], r.Facades[j]
+		if f0.Facade != f1.Facade {
+			return f0.Facade < f1.Facade
+		}
+		return f0.Version < f1.Version
+	})
+	for _, source := range versions {
+		server := source.info.FacadeVersions()[targetFacade]
+		if len(server) == 0 {
+			continue
+		}
+		var client []int
+		for v := 1; v <= server[len(server)-1]; v++ {
+			client = append(client, v)
+		}
+		for _, target := range versions {
+			best := apidoc.BestVersion(client, target.info.FacadeVersions()[targetFacade])
+			r.Pairs = append(r.Pairs, pair{
+				Source:  source.version,
+				Target:  target.version,
+				Version: best,
+			})
+			if _, ok := r.MinTarget[source.version]; !ok && best > 0 {
+				r.MinTarget[source.version] = target.version
+			}
+		}
+	}
+	return r
+}
+
+type apidocKey struct {
+	name    string
+	version int
+}
+
+func printReport(r *report, versions []jujuVersion) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "FACADE\tVERSION\tSERVED BY\tFIRST\tLAST\n")
+	for _, f := range r.Facades {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", f.Facade, f.Version, f.ServedBy, f.First, f.Last)
+	}
+	w.Flush()
+	if len(r.Pairs) == 0 {
+		return
+	}
+	fmt.Printf("\n%s versions used (rows: source, columns: target):\n", targetFacade)
+	used := make(map[[2]string]int)
+	var sources []string
+	for _, p := range r.Pairs {
+		if len(sources) == 0 || sources[len(sources)-1] != p.Source {
+			sources = append(sources, p.Source)
+		}
+		used[[2]string{p.Source, p.Target}] = p.Version
+	}
+	w = tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "SOURCE")
+	for _, t := range versions {
+		fmt.Fprintf(w, "\t%s", t.version)
+	}
+	fmt.Fprintf(w, "\tMIN TARGET\n")
+	for _, s := range sources {
+		fmt.Fprintf(w, "%s", s)
+		for _, t := range versions {
+			if v := used[[2]string{s, t.version}]; v > 0 {
+				fmt.Fprintf(w, "\t%d", v)
+			} else {
+				fmt.Fprintf(w, "\t-")
+			}
+		}
+		minTarget := r.MinTarget[s]
+		if minTarget == "" {
+			minTarget = "-"
+		}
+		fmt.Fprintf(w, "\t%s\n", minTarget)
+	}
+	w.Flush()
+}
+
+// versionLess reports whether Juju version v0 is older
+// than v1, comparing successive numeric components.
+// Components that aren't numbers are compared as strings.
+func versionLess(v0, v1 string) bool {
+	split := func(v string) []string {
+		return strings.FieldsFunc(v, func(r rune) bool {
+			return r == '.' || r == '-'
+		})
+	}
+	p0, p1 := split(v0), split(v1)
+	for i := 0; i < len(p0) && i < len(p1); i++ {
+		if p0[i] == p1[i] {
+			continue
+		}
+		n0, err0 := strconv.Atoi(p0[i])
+		n1, err1 := strconv.Atoi(p1[i])
+		if err0 == nil && err1 == nil {
+			return n0 < n1
+		}
+		return p0[i] < p1[i]
+	}
+	return len(p0) < len(p1)
+}
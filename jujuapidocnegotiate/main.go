@@ -0,0 +1,104 @@
+// The jujuapidocnegotiate command shows which facade versions a
+// client would use when talking to controllers of different Juju
+// versions, given JSON documents produced by jujuapidoc for each
+// of those versions and a manifest of the facade versions that
+// the client supports.
+//
+// The manifest is a JSON object mapping facade names to versions,
+// in the same form as the facade version table in the Juju API
+// client, for example:
+//
+//	{"Client": [1, 2], "Uniter": 7}
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+var jsonOutput = flag.Bool("json", false, "print the table as JSON")
+
+// controller holds the facade versions that a client
+// will use with one controller version.
+type controller struct {
+	Version string
+	Facades map[string]int
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidocnegotiate [-json] client-facades.json [version=]api.json...\n")
+		fmt.Fprintf(os.Stderr, "\nIf the version is omitted, the base name of the file is used.\n")
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() < 2 {
+		flag.Usage()
+	}
+	data, err := ioutil.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	client, err := apidoc.ParseClientFacades(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var controllers []controller
+	for _, arg := range flag.Args()[1:] {
+		version, path := strings.TrimSuffix(filepath.Base(arg), ".json"), arg
+		if i := strings.Index(arg, "="); i >= 0 {
+			version, path = arg[:i], arg[i+1:]
+		}
+		info, err := apidoc.ReadFile(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		controllers = append(controllers, controller{
+			Version: version,
+			Facades: info.Negotiate(client),
+		})
+	}
+	if *jsonOutput {
+		data, err := json.MarshalIndent(controllers, "", "\t")
+		if err != nil {
+			log.Fatal(err)
+		}
+		os.Stdout.Write(data)
+		return
+	}
+	names := make([]string, 0, len(client))
+	for name := range client {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "FACADE")
+	for _, c := range controllers {
+		fmt.Fprintf(w, "\t%s", c.Version)
+	}
+	fmt.Fprintf(w, "\n")
+	for _, name := range names {
+		fmt.Fprintf(w, "%s", name)
+		for _, c := range controllers {
+			if v, ok := c.Facades[name]; ok {
+				fmt.Fprintf(w, "\t%d", v)
+			} else {
+				// No version in common, so the
+				// client can't use the facade.
+				fmt.Fprintf(w, "\t-")
+			}
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	w.Flush()
+}
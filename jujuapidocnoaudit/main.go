@@ -0,0 +1,62 @@
+// The jujuapidocnoaudit command reads the JSON output of
+// jujuapidoc and prints the methods that the API server leaves out
+// of the audit log by default, one per line as Facade(version).Method,
+// so that compliance reviews can see exactly which calls leave no
+// audit trail.
+//
+// Entries in the API server's list that don't name a method in
+// the document are reported on standard error, as they may mean
+// that the list is out of date.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+var latest = flag.Bool("latest", false, "list the latest version of each facade only")
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidocnoaudit [-latest] api.json\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+	}
+	info, err := apidoc.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(info.AuditExcluded) == 0 {
+		log.Fatalf("%s records no audit-excluded methods; it may have been generated by an older jujuapidoc", flag.Arg(0))
+	}
+	if *latest {
+		info = info.Latest()
+	}
+	found := make(map[string]bool)
+	for _, f := range info.Facades {
+		for _, m := range f.Methods {
+			if m.AuditExcluded {
+				found[f.Name+"."+m.Name] = true
+				fmt.Printf("%s(%d).%s\n", f.Name, f.Version, m.Name)
+			}
+		}
+	}
+	var unknown []string
+	for _, name := range info.AuditExcluded {
+		if !found[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		log.Printf("warning: excluded methods not in the document: %s", strings.Join(unknown, ", "))
+	}
+}
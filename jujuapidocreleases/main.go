@@ -0,0 +1,72 @@
+// The jujuapidocreleases command adds to a JSON document produced
+// by jujuapidoc the range of Juju releases in which each facade
+// version and method exists, taken from the documents generated
+// for a history of releases, so that whether a method can be
+// called on a controller of a given version can be answered
+// from the document itself.
+//
+// The release documents must be given in release order, oldest
+// first. Each is named as [version=]api.json; if the version is
+// omitted, the one recorded in the document is used, or failing
+// that the base name of the file.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidocreleases api.json [version=]release.json...\n")
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() < 2 {
+		flag.Usage()
+	}
+	info, err := apidoc.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var history []apidoc.Release
+	for _, arg := range flag.Args()[1:] {
+		version, path := "", arg
+		if i := strings.Index(arg, "="); i >= 0 {
+			version, path = arg[:i], arg[i+1:]
+		}
+		rel, err := apidoc.ReadFile(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if version == "" && rel.Meta != nil {
+			version = rel.Meta.Version
+		}
+		if version == "" {
+			version = strings.TrimSuffix(filepath.Base(path), ".json")
+		}
+		if len(rel.FacadeErrors) > 0 {
+			log.Printf("warning: %s is incomplete; the ranges of %d facades may be wrong", path, len(rel.FacadeErrors))
+		}
+		history = append(history, apidoc.Release{
+			Version: version,
+			Info:    rel,
+		})
+	}
+	info.AddReleaseRanges(history)
+	data, err := json.Marshal(info)
+	if err != nil {
+		log.Fatal(err)
+	}
+	data = append(data, '\n')
+	if _, err := os.Stdout.Write(data); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,157 @@
+// The jujuapidocusage command adds to a JSON document produced by
+// jujuapidoc how often each method was called on a controller, and
+// by whom, as recorded in the controller's audit log, so that work
+// on documentation and deprecation can be prioritized by real usage.
+//
+// Each argument after the document names an audit log, which may be
+// gzip-compressed as rotated logs are, or a directory such as an
+// unpacked log bundle, in which all files with names starting with
+// "audit" are read.
+//
+// With the -report flag, it prints a table of the methods instead,
+// least called first, so that candidates for deprecation come at
+// the top.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+var (
+	maxCallers = flag.Int("callers", 3, "maximum number of callers to record for each method")
+	report     = flag.Bool("report", false, "print a table of methods by number of calls instead of the document")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidocusage [-callers n] [-report] api.json auditlog...\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+	if flag.NArg() < 2 {
+		flag.Usage()
+	}
+	info, err := apidoc.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var calls []apidoc.AuditCall
+	for _, arg := range flag.Args()[1:] {
+		files, err := auditLogFiles(arg)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, file := range files {
+			fileCalls, err := readAuditLog(file)
+			if err != nil {
+				log.Fatal(err)
+			}
+			calls = append(calls, fileCalls...)
+		}
+	}
+	if unmatched := info.AddUsage(calls, *maxCallers); len(unmatched) > 0 {
+		log.Printf("warning: %d of %d calls are to methods that the document doesn't have", len(unmatched), len(calls))
+	}
+	if *report {
+		printReport(info)
+		return
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		log.Fatal(err)
+	}
+	data = append(data, '\n')
+	if _, err := os.Stdout.Write(data); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// auditLogFiles returns the audit log files named by path:
+// path itself, or if it's a directory, the files below it
+// with names starting with "audit", in sorted order.
+func auditLogFiles(path string) ([]string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return []string{path}, nil
+	}
+	var files []string
+	err = filepath.Walk(path, func(file string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !fi.IsDir() && strings.HasPrefix(fi.Name(), "audit") {
+			files = append(files, file)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no audit logs found in %s", path)
+	}
+	return files, nil
+}
+
+func readAuditLog(file string) ([]apidoc.AuditCall, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	calls, err := apidoc.ReadAuditLog(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+	return calls, nil
+}
+
+// printReport prints the methods in info with the number of
+// calls made to each and their most frequent callers, least
+// called first.
+func printReport(info *apidoc.Info) {
+	type row struct {
+		method apidoc.MethodRef
+		usage  *apidoc.MethodUsage
+	}
+	var rows []row
+	for _, f := range info.Facades {
+		for _, m := range f.Methods {
+			rows = append(rows, row{
+				method: apidoc.MethodRef{
+					Facade:  f.Name,
+					Version: f.Version,
+					Method:  m.Name,
+				},
+				usage: m.Usage,
+			})
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].usage.Calls < rows[j].usage.Calls
+	})
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "CALLS\tMETHOD\tCALLERS\n")
+	for _, r := range rows {
+		callers := make([]string, len(r.usage.Callers))
+		for i, c := range r.usage.Callers {
+			callers[i] = fmt.Sprintf("%s (%d)", c.Caller, c.Calls)
+		}
+		fmt.Fprintf(w, "%d\t%s(%d).%s\t%s\n", r.usage.Calls, r.method.Facade, r.method.Version, r.method.Method, strings.Join(callers, ", "))
+	}
+	w.Flush()
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+	"unicode"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"golang.org/x/tools/go/packages"
+)
+
+// superuserAccess holds the name of the permission access level
+// held by controller superusers.
+const superuserAccess = "SuperuserAccess"
+
+// modelAccessLevels holds the names of the permission access
+// levels that are granted on models rather than on the
+// controller.
+var modelAccessLevels = map[string]bool{
+	"AdminAccess": true,
+	"WriteAccess": true,
+	"ReadAccess":  true,
+}
+
+// checkPrefixes holds the prefixes of the names of the functions
+// and methods that count as checking for the access levels they
+// are passed, such as HasPermission. Others, such as the ones that
+// grant access, are passed levels too.
+var checkPrefixes = []string{"Has", "Check", "Can", "Ensure", "Require", "Auth"}
+
+// tagScopes maps the names of the tag types in the names package
+// to the scope of the access checked on them.
+var tagScopes = map[string]string{
+	"ControllerTag":       apidoc.ScopeController,
+	"ModelTag":            apidoc.ScopeModel,
+	"CloudTag":            apidoc.ScopeCloud,
+	"ApplicationOfferTag": apidoc.ScopeOffer,
+}
+
+// methodAccessChecks returns the permission access levels passed to
+// calls made by the given method, or by the functions within the
+// juju module that it calls, or nil if its source can't be found.
+func methodAccessChecks(pkg *packages.Package, pt *types.TypeName, name string) *accessChecks {
+	decl, declPkg, err := methodDecl(pkg, pt, name)
+	if err != nil || decl.Body == nil {
+		return nil
+	}
+	c := &accessChecks{
+		seen: make(map[apidoc.PermissionRequirement]bool),
+	}
+	c.find(pkg, declPkg, decl, 0, make(map[*ast.FuncDecl]bool))
+	return c
+}
+
+// superuserCheck returns the check for controller superuser access
+// found by c, or nil if the method doesn't appear to need superuser
+// access. A method needs it if it, or a function within the juju
+// module that it calls, passes SuperuserAccess from the permission
+// package to a call, and no model access level is passed anywhere:
+// a method that also checks for model access usually lets model
+// admins through too.
+func (c *accessChecks) superuserCheck() *apidoc.SuperuserCheck {
+	if c == nil || c.superuser == "" || c.model {
+		return nil
+	}
+	return &apidoc.SuperuserCheck{
+		Check: c.superuser,
+	}
+}
+
+// permissions returns the access levels that c found to be checked
+// for, in the order found.
+func (c *accessChecks) permissions() []apidoc.PermissionRequirement {
+	if c == nil {
+		return nil
+	}
+	return c.requirements
+}
+
+// accessChecks records the permission access levels
+// passed to calls made by a method.
+type accessChecks struct {
+	// superuser holds the source of the first call
+	// found that is passed SuperuserAccess.
+	superuser string
+
+	// model holds whether any call is passed
+	// a model access level.
+	model bool
+
+	// requirements holds the access levels passed to the
+	// calls that check for them, and seen holds the ones
+	// recorded so far, without their checks.
+	requirements []apidoc.PermissionRequirement
+	seen         map[apidoc.PermissionRequirement]bool
+}
+
+// find records the access levels passed to calls in the body of the
+// given function, or in any function within the juju module that it
+// calls.
+func (c *accessChecks) find(pkg, declPkg *packages.Package, decl *ast.FuncDecl, depth int, visited map[*ast.FuncDecl]bool) {
+	if visited[decl] || decl.Body == nil || declPkg.TypesInfo == nil {
+		return
+	}
+	visited[decl] = true
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		for _, arg := range call.Args {
+			level := permissionLevel(declPkg, arg)
+			if level == nil {
+				continue
+			}
+			switch {
+			case level.Name() == superuserAccess:
+				if c.superuser == "" {
+					c.superuser = types.ExprString(call)
+				}
+			case modelAccessLevels[level.Name()]:
+				c.model = true
+			}
+			if isAccessCheck(funcName(call.Fun)) {
+				c.add(declPkg, call, level)
+			}
+		}
+		if depth >= maxAuthCallDepth {
+			return true
+		}
+		var id *ast.Ident
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			id = fun
+		case *ast.SelectorExpr:
+			id = fun.Sel
+		default:
+			return true
+		}
+		fn, ok := declPkg.TypesInfo.Uses[id].(*types.Func)
+		if !ok || fn.Pkg() == nil || !strings.HasPrefix(fn.Pkg().Path(), jujuPkgPrefix) {
+			return true
+		}
+		calleeDecl, calleePkg, err := findDeclPackage(pkg, fn.Pos())
+		if err != nil {
+			return true
+		}
+		if fdecl, ok := calleeDecl.(*ast.FuncDecl); ok {
+			c.find(pkg, calleePkg, fdecl, depth+1, visited)
+		}
+		return true
+	})
+}
+
+// add records that call checks for the given access level,
+// unless the same requirement has already been found.
+func (c *accessChecks) add(pkg *packages.Package, call *ast.CallExpr, level *types.Const) {
+	if level.Val().Kind() != constant.String {
+		return
+	}
+	r := apidoc.PermissionRequirement{
+		EntityKind: apidoc.PermissionEntityUser,
+		Level:      constant.StringVal(level.Val()),
+		Scope:      accessScope(pkg, call),
+	}
+	if r.Level == "" || c.seen[r] {
+		return
+	}
+	c.seen[r] = true
+	r.Check = types.ExprString(call)
+	c.requirements = append(c.requirements, r)
+}
+
+// accessScope returns the scope of the access checked by call, from
+// the type of the tag that it's passed. When the tag has the names.Tag
+// interface type, the scope is guessed from its source, such as
+// "api.modelTag", and failing that from the level itself.
+func accessScope(pkg *packages.Package, call *ast.CallExpr) string {
+	var level string
+	for _, arg := range call.Args {
+		if l := permissionLevel(pkg, arg); l != nil {
+			level = l.Name()
+			continue
+		}
+		t := pkg.TypesInfo.TypeOf(arg)
+		if p, ok := t.(*types.Pointer); ok {
+			t = p.Elem()
+		}
+		if named, ok := t.(*types.Named); ok {
+			obj := named.Obj()
+			if obj.Pkg() != nil && strings.HasPrefix(obj.Pkg().Path(), "github.com/juju/names") {
+				if scope, ok := tagScopes[obj.Name()]; ok {
+					return scope
+				}
+			}
+		}
+		src := strings.ToLower(types.ExprString(arg))
+		for _, scope := range []string{apidoc.ScopeController, apidoc.ScopeModel, apidoc.ScopeCloud, apidoc.ScopeOffer} {
+			if strings.Contains(src, scope) {
+				return scope
+			}
+		}
+	}
+	switch {
+	case modelAccessLevels[level]:
+		return apidoc.ScopeModel
+	case level == "ConsumeAccess":
+		return apidoc.ScopeOffer
+	}
+	return apidoc.ScopeController
+}
+
+// isAccessCheck reports whether the function or method
+// with the given name checks for the access levels it's
+// passed. See checkPrefixes.
+func isAccessCheck(name string) bool {
+	if name == "" {
+		return false
+	}
+	// Unexported functions, such as checkCanRead, count too.
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	name = string(r)
+	for _, prefix := range checkPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// permissionLevel returns the access level constant from the juju
+// permission package that e refers to, such as SuperuserAccess,
+// or nil if it refers to none.
+func permissionLevel(pkg *packages.Package, e ast.Expr) *types.Const {
+	var id *ast.Ident
+	switch e := e.(type) {
+	case *ast.Ident:
+		id = e
+	case *ast.SelectorExpr:
+		id = e.Sel
+	default:
+		return nil
+	}
+	obj, ok := pkg.TypesInfo.Uses[id].(*types.Const)
+	if !ok || obj.Pkg() == nil {
+		return nil
+	}
+	// The package moved from permission to core/permission
+	// in Juju 3.
+	path := obj.Pkg().Path()
+	if path != jujuPkgPrefix+"permission" && path != jujuPkgPrefix+"core/permission" {
+		return nil
+	}
+	return obj
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/juju/juju/apiserver"
+	"github.com/juju/juju/apiserver/facade"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// advertisedFacades returns the facade versions that the API server
+// lists in its Login response, given all the registered facades.
+// Like the API server, which lists only the facades that the login
+// lets the client use, it leaves out the facades that aren't served
+// on each endpoint.
+func advertisedFacades(ds []facade.Details) *apidoc.AdvertisedFacades {
+	versions := make(map[string][]int)
+	for _, d := range ds {
+		versions[d.Name] = append(versions[d.Name], d.Version)
+	}
+	names := make([]string, 0, len(versions))
+	for name := range versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var a apidoc.AdvertisedFacades
+	for _, name := range names {
+		vs := versions[name]
+		sort.Ints(vs)
+		f := apidoc.FacadeVersions{
+			Name:     name,
+			Versions: vs,
+		}
+		if apiserver.IsControllerFacade(name) {
+			a.Controller = append(a.Controller, f)
+		}
+		if apiserver.IsModelFacade(name) {
+			a.Model = append(a.Model, f)
+		}
+	}
+	return &a
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// auditExcludedMethods returns the methods, as Facade.Method, that
+// the API server leaves out of the audit log by default. They're
+// the string constants in the initializers of the package-level
+// variables in the API server package with names mentioning
+// "read only", such as readOnlyCalls, as the default is to
+// exclude the read-only methods.
+func auditExcludedMethods(pkg *packages.Package) map[string]bool {
+	excluded := make(map[string]bool)
+	if pkg.TypesInfo == nil {
+		return excluded
+	}
+	for _, f := range pkg.Syntax {
+		for _, decl := range f.Decls {
+			gdecl, ok := decl.(*ast.GenDecl)
+			if !ok || gdecl.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gdecl.Specs {
+				vspec := spec.(*ast.ValueSpec)
+				for i, id := range vspec.Names {
+					if i >= len(vspec.Values) || !strings.Contains(strings.ToLower(id.Name), "readonly") {
+						continue
+					}
+					addAuditExcluded(pkg, vspec.Values[i], excluded)
+				}
+			}
+		}
+	}
+	return excluded
+}
+
+// addAuditExcluded adds to excluded all the string constants
+// of the form Facade.Method found in e.
+func addAuditExcluded(pkg *packages.Package, e ast.Expr, excluded map[string]bool) {
+	ast.Inspect(e, func(n ast.Node) bool {
+		expr, ok := n.(ast.Expr)
+		if !ok {
+			return true
+		}
+		tv := pkg.TypesInfo.Types[expr]
+		if tv.Value == nil || tv.Value.Kind() != constant.String {
+			return true
+		}
+		s := constant.StringVal(tv.Value)
+		if parts := strings.Split(s, "."); len(parts) == 2 && isIdent(parts[0]) && isIdent(parts[1]) {
+			excluded[s] = true
+		}
+		return false
+	})
+}
+
+// isIdent reports whether s is an exported Go identifier,
+// as facade and method names are.
+func isIdent(s string) bool {
+	return s != "" && token.IsIdentifier(s) && ast.IsExported(s)
+}
+
+// sortedNames returns the keys of m in sorted order.
+func sortedNames(m map[string]bool) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sync"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"golang.org/x/tools/go/packages"
+)
+
+// packageIndex indexes the loaded packages so that packages
+// and source files don't need to be found by traversing
+// the whole dependency graph each time.
+type packageIndex struct {
+	// packages holds all the loaded packages, keyed by import path.
+	packages map[string]*packages.Package
+	// files holds all the loaded syntax trees, keyed by file name.
+	files map[string]indexedFile
+}
+
+type indexedFile struct {
+	file *ast.File
+	pkg  *packages.Package
+}
+
+var (
+	indexOnce sync.Once
+	index     *packageIndex
+)
+
+// indexPackages returns an index of pkg and all its dependencies.
+// The index is built on first use; as only one root package
+// is ever loaded, later calls return the same index.
+func indexPackages(pkg *packages.Package) *packageIndex {
+	indexOnce.Do(func() {
+		index = &packageIndex{
+			packages: make(map[string]*packages.Package),
+			files:    make(map[string]indexedFile),
+		}
+		packages.Visit([]*packages.Package{pkg}, func(pkg *packages.Package) bool {
+			index.packages[pkg.PkgPath] = pkg
+			for _, f := range pkg.Syntax {
+				if tokFile := pkg.Fset.File(f.Pos()); tokFile != nil {
+					index.files[tokFile.Name()] = indexedFile{
+						file: f,
+						pkg:  pkg,
+					}
+				}
+			}
+			return true
+		}, nil)
+	})
+	return index
+}
+
+var (
+	progTypes  = progTypeCache{newLookupCache("prog types")}
+	methodDocs = docCache{newLookupCache("method docs")}
+	decls      = declCache{newLookupCache("declarations")}
+)
+
+// lookupCache memoizes the results of lookups by key.
+// It is safe for concurrent use.
+type lookupCache struct {
+	// name names the cache in the -stats output.
+	name string
+
+	mu sync.Mutex
+	m  map[interface{}]interface{}
+	// hits and misses count the lookups, for -stats.
+	hits, misses int
+}
+
+func newLookupCache(name string) *lookupCache {
+	return &lookupCache{
+		name: name,
+		m:    make(map[interface{}]interface{}),
+	}
+}
+
+// get returns the cached result for key, calling
+// lookup to compute it if there is none.
+func (c *lookupCache) get(key interface{}, lookup func() interface{}) interface{} {
+	c.mu.Lock()
+	v, ok := c.m[key]
+	if ok {
+		c.hits++
+	} else {
+		c.misses++
+	}
+	c.mu.Unlock()
+	if !ok {
+		v = lookup()
+		c.mu.Lock()
+		c.m[key] = v
+		c.mu.Unlock()
+	}
+	return v
+}
+
+// stats returns the use made of c.
+func (c *lookupCache) stats() apidoc.CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return apidoc.CacheStats{Name: c.name, Hits: c.hits, Misses: c.misses}
+}
+
+// progTypeCache memoizes progType results.
+type progTypeCache struct {
+	*lookupCache
+}
+
+type progTypeEntry struct {
+	t   *types.TypeName
+	err error
+}
+
+// get returns the cached result for t, calling
+// lookup to compute it if there is none.
+func (c progTypeCache) get(t reflect.Type, lookup func() (*types.TypeName, error)) (*types.TypeName, error) {
+	e := c.lookupCache.get(t, func() interface{} {
+		var e progTypeEntry
+		e.t, e.err = lookup()
+		return e
+	}).(progTypeEntry)
+	return e.t, e.err
+}
+
+// docCache memoizes doc comments by the object they document.
+type docCache struct {
+	*lookupCache
+}
+
+type docEntry struct {
+	doc string
+	err error
+}
+
+// get returns the cached doc comment for obj, calling
+// lookup to compute it if there is none.
+func (c docCache) get(obj types.Object, lookup func() (string, error)) (string, error) {
+	e := c.lookupCache.get(obj, func() interface{} {
+		var e docEntry
+		e.doc, e.err = lookup()
+		return e
+	}).(docEntry)
+	return e.doc, e.err
+}
+
+// declCache memoizes the top level declarations found by
+// findDeclPackage, by the position they were looked up for.
+// Each doc comment lookup, and each of the checks made on a
+// method's source, finds a declaration, and many are found
+// more than once, as for the methods of embedded types
+// shared by many facades.
+type declCache struct {
+	*lookupCache
+}
+
+type declEntry struct {
+	decl ast.Decl
+	pkg  *packages.Package
+	err  error
+}
+
+// get returns the cached declaration for pos, calling
+// lookup to find it if there is none.
+func (c declCache) get(pos token.Pos, lookup func() (ast.Decl, *packages.Package, error)) (ast.Decl, *packages.Package, error) {
+	e := c.lookupCache.get(pos, func() interface{} {
+		var e declEntry
+		e.decl, e.pkg, e.err = lookup()
+		return e
+	}).(declEntry)
+	return e.decl, e.pkg, e.err
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/juju/apiserver/facade"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"github.com/rogpeppe/apicompat/jsontypes"
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/errgo.v1"
+)
+
+var checkpointDir = flag.String("checkpoint-dir", "", "save the result for each facade in the named directory, and reuse any results already saved there")
+
+// facadeCheckpoint holds the saved result of processing
+// a single facade.
+type facadeCheckpoint struct {
+	Facade        apidoc.FacadeInfo
+	Fields        []apidoc.FieldInfo    `json:",omitempty"`
+	Warnings      []apidoc.Warning      `json:",omitempty"`
+	FactoryPanics []apidoc.FactoryPanic `json:",omitempty"`
+}
+
+// checkpointedFacade is like processFacade except that when
+// a checkpoint directory has been specified, a previously
+// saved result for the facade is used if there is one, and
+// a successful result is saved otherwise.
+//
+// Note that the saved result refers to types in info, so
+// it is only valid for the same juju source that it was
+// generated from.
+func checkpointedFacade(pkg *packages.Package, info *jsontypes.Info, d facade.Details) facadeResult {
+	if *checkpointDir == "" {
+		return processFacade(pkg, info, d)
+	}
+	path := filepath.Join(*checkpointDir, fmt.Sprintf("%s-v%d.json", d.Name, d.Version))
+	r, ok := loadCheckpoint(path, d)
+	stateMu.Lock()
+	if ok {
+		checkpointHits++
+	} else {
+		checkpointMisses++
+	}
+	stateMu.Unlock()
+	if ok {
+		return r
+	}
+	r = processFacade(pkg, info, d)
+	if r.err != nil {
+		return r
+	}
+	if err := saveCheckpoint(path, d, r); err != nil {
+		r.err = errgo.Notef(err, "cannot save checkpoint for facade %s(%d)", d.Name, d.Version)
+	}
+	return r
+}
+
+// loadCheckpoint reads the result for d saved in the
+// given file, restoring the factory panic state that
+// processing it would have produced. It reports whether
+// a valid result was found.
+func loadCheckpoint(path string, d facade.Details) (facadeResult, bool) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return facadeResult{}, false
+	}
+	var c facadeCheckpoint
+	if err := json.Unmarshal(data, &c); err != nil || c.Facade.Name != d.Name || c.Facade.Version != d.Version {
+		// Treat a corrupt checkpoint, perhaps written by a
+		// run that was interrupted, as if it were absent.
+		return facadeResult{}, false
+	}
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	if d.Factory != nil {
+		allFacades[facadeID{d.Name, d.Version}] = true
+	}
+	for _, p := range c.FactoryPanics {
+		panicked[facadeID{d.Name, d.Version}] = true
+		factoryPanics = append(factoryPanics, p)
+	}
+	return facadeResult{
+		facade:   c.Facade,
+		fields:   c.Fields,
+		warnings: c.Warnings,
+	}, true
+}
+
+// saveCheckpoint writes the result r of processing d to the
+// given file, along with the factory panics recorded for it.
+func saveCheckpoint(path string, d facade.Details, r facadeResult) error {
+	c := facadeCheckpoint{
+		Facade:   r.facade,
+		Fields:   r.fields,
+		Warnings: r.warnings,
+	}
+	stateMu.Lock()
+	for _, p := range factoryPanics {
+		if p.Facade == d.Name && p.Version == d.Version {
+			c.FactoryPanics = append(c.FactoryPanics, p)
+		}
+	}
+	stateMu.Unlock()
+	data, err := json.Marshal(c)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	// Write to a temporary file first so that an interrupted
+	// write cannot leave a truncated checkpoint behind.
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0666); err != nil {
+		return errgo.Mask(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errgo.Mask(err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/rpc/rpcreflect"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"gopkg.in/errgo.v1"
+)
+
+// facadeID identifies a version of a facade.
+type facadeID struct {
+	name    string
+	version int
+}
+
+func (id facadeID) String() string {
+	return fmt.Sprintf("%s(%d)", id.name, id.version)
+}
+
+// checkMethods returns an error if the methods documented in f
+// aren't exactly those that rpcreflect reports for the facade with
+// the given details, each documented once, so that a gap in the
+// extraction is recorded as a facade error rather than published
+// as if the facade were complete.
+func checkMethods(d facade.Details, f *apidoc.FacadeInfo) error {
+	want := make(map[string]bool)
+	for _, name := range rpcreflect.ObjTypeOf(d.Type).MethodNames() {
+		want[name] = true
+	}
+	count := make(map[string]int)
+	for _, m := range f.Methods {
+		count[m.Name]++
+	}
+	var problems []string
+	if missing := notIn(want, count); len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("methods missing from the output: %s", strings.Join(missing, ", ")))
+	}
+	var extra, repeated []string
+	for name, n := range count {
+		switch {
+		case !want[name]:
+			extra = append(extra, name)
+		case n > 1:
+			repeated = append(repeated, name)
+		}
+	}
+	if len(extra) > 0 {
+		sort.Strings(extra)
+		problems = append(problems, fmt.Sprintf("methods not served by the facade: %s", strings.Join(extra, ", ")))
+	}
+	if len(repeated) > 0 {
+		sort.Strings(repeated)
+		problems = append(problems, fmt.Sprintf("methods documented more than once: %s", strings.Join(repeated, ", ")))
+	}
+	if len(problems) > 0 {
+		return errgo.Newf("facade %s(%d): incomplete documentation: %s", f.Name, f.Version, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkFacades returns an error unless every facade in ds has been
+// either documented exactly once or recorded in errs, and nothing
+// else has been documented. A failure here is a bug in the
+// generator, so it fails the run.
+func checkFacades(ds []facade.Details, documented []apidoc.FacadeInfo, errs []apidoc.FacadeError) error {
+	want := make(map[string]bool)
+	for _, d := range ds {
+		want[facadeID{d.Name, d.Version}.String()] = true
+	}
+	count := make(map[string]int)
+	for _, f := range documented {
+		count[facadeID{f.Name, f.Version}.String()]++
+	}
+	for _, e := range errs {
+		count[facadeID{e.Facade, e.Version}.String()]++
+	}
+	var problems []string
+	if missing := notIn(want, count); len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("facades neither documented nor recorded as failed: %s", strings.Join(missing, ", ")))
+	}
+	var extra, repeated []string
+	for id, n := range count {
+		switch {
+		case !want[id]:
+			extra = append(extra, id)
+		case n > 1:
+			repeated = append(repeated, id)
+		}
+	}
+	if len(extra) > 0 {
+		sort.Strings(extra)
+		problems = append(problems, fmt.Sprintf("facades not registered with the API server: %s", strings.Join(extra, ", ")))
+	}
+	if len(repeated) > 0 {
+		sort.Strings(repeated)
+		problems = append(problems, fmt.Sprintf("facades written more than once: %s", strings.Join(repeated, ", ")))
+	}
+	if len(problems) > 0 {
+		return errgo.Newf("output does not match the registered facades: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// notIn returns the sorted keys of want that aren't in count.
+func notIn(want map[string]bool, count map[string]int) []string {
+	var missing []string
+	for name := range want {
+		if count[name] == 0 {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
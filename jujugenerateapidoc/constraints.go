@@ -0,0 +1,522 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"github.com/rogpeppe/apicompat/jsontypes"
+	"golang.org/x/tools/go/packages"
+)
+
+// regexpMethods holds the *regexp.Regexp methods that
+// count as checking a string against the expression.
+var regexpMethods = map[string]bool{
+	"MatchString":        true,
+	"FindString":         true,
+	"FindStringIndex":    true,
+	"FindStringSubmatch": true,
+}
+
+var namesVersionPat = regexp.MustCompile(`^v[0-9]+$`)
+
+// fieldConstraints returns the constraints that the given method
+// of the facade f applies to fields of the wire types in info.
+// Only checks made directly in the body of the method are found:
+//
+//   - calls to the names package IsValid* functions, which
+//     require a valid entity name;
+//   - calls to the names package Parse*Tag functions, which
+//     require a valid entity tag, and record the kind of tag;
+//   - matches against a regular expression given as a literal
+//     or held in a package variable initialized by
+//     regexp.MustCompile;
+//   - switch statements with constant string cases and a default
+//     case that rejects the value, which require one of the
+//     values in the cases.
+//
+// Tests of a field against its zero value are also found: if the
+// value is then rejected, the field is marked as required, and
+// if a default is substituted for it, the field is marked as
+// optional.
+func fieldConstraints(pkg *packages.Package, info *jsontypes.Info, f apidoc.FacadeInfo, pt *types.TypeName, methodName string) []apidoc.FieldInfo {
+	decl, declPkg, err := methodDecl(pkg, pt, methodName)
+	if err != nil || decl.Body == nil || declPkg.TypesInfo == nil {
+		return nil
+	}
+	a := &constraintFinder{
+		tinfo: declPkg.TypesInfo,
+		isWireType: func(name jsontypes.TypeName) bool {
+			// The jsontypes.Info is shared between facades and
+			// isn't safe for concurrent use.
+			stateMu.Lock()
+			defer stateMu.Unlock()
+			return info.Types[name] != nil
+		},
+		patterns: packagePatterns(declPkg),
+	}
+	a.find(decl.Body)
+	ref := apidoc.MethodRef{
+		Facade:  f.Name,
+		Version: f.Version,
+		Method:  methodName,
+	}
+	for i := range a.fields {
+		fi := &a.fields[i]
+		for j := range fi.Constraints {
+			fi.Constraints[j].Methods = []apidoc.MethodRef{ref}
+		}
+		if fi.Presence != "" {
+			fi.PresenceReason = fmt.Sprintf("%s(%d).%s %s", f.Name, f.Version, methodName, fi.PresenceReason)
+		}
+	}
+	return a.fields
+}
+
+// constraintFinder finds the checks made on the fields of wire
+// types in a function body. See fieldConstraints.
+type constraintFinder struct {
+	tinfo      *types.Info
+	isWireType func(jsontypes.TypeName) bool
+
+	// patterns maps from each package variable
+	// holding a compiled regular expression
+	// to the expression.
+	patterns map[*types.Var]string
+
+	fields []apidoc.FieldInfo
+}
+
+func (a *constraintFinder) find(body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			a.checkCall(n)
+		case *ast.SwitchStmt:
+			a.checkSwitch(n)
+		case *ast.IfStmt:
+			a.checkZeroTest(n)
+		}
+		return true
+	})
+}
+
+// checkCall records any constraint applied by the given call.
+func (a *constraintFinder) checkCall(call *ast.CallExpr) {
+	fn := a.callee(call)
+	if fn == nil || fn.Pkg() == nil || len(call.Args) == 0 {
+		return
+	}
+	name := fn.Name()
+	switch pkgPath := fn.Pkg().Path(); {
+	case isNamesPkg(pkgPath) && fn.Type().(*types.Signature).Recv() == nil:
+		switch {
+		case strings.HasPrefix(name, "IsValid") && name != "IsValid":
+			a.add(call.Args[0], apidoc.Constraint{
+				Kind:        "name",
+				Description: "a valid " + lowerWords(strings.TrimPrefix(name, "IsValid")) + " name",
+			})
+		case name == "ParseTag":
+			a.add(call.Args[0], apidoc.Constraint{
+				Kind:        "tag",
+				Description: "a valid entity tag",
+			})
+		case strings.HasPrefix(name, "Parse") && strings.HasSuffix(name, "Tag"):
+			entity := strings.TrimSuffix(strings.TrimPrefix(name, "Parse"), "Tag")
+			a.add(call.Args[0], apidoc.Constraint{
+				Kind:        "tag",
+				Description: "a valid " + lowerWords(entity) + " tag",
+			})
+			a.addTagKind(call.Args[0], tagKind(fn.Pkg(), entity))
+		}
+	case pkgPath == "regexp" && name == "MatchString" && len(call.Args) == 2:
+		if pattern, ok := a.stringConst(call.Args[0]); ok {
+			a.addPattern(call.Args[1], pattern)
+		}
+	case pkgPath == "regexp" && regexpMethods[name] && fn.Type().(*types.Signature).Recv() != nil:
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return
+		}
+		if pattern, ok := a.regexpPattern(sel.X); ok {
+			a.addPattern(call.Args[0], pattern)
+		}
+	}
+}
+
+// checkSwitch records an enum constraint for a switch on
+// a field whose cases are all constant strings and whose
+// default case rejects the value.
+func (a *constraintFinder) checkSwitch(stmt *ast.SwitchStmt) {
+	if stmt.Tag == nil || stmt.Init != nil {
+		return
+	}
+	var values []string
+	rejects := false
+	for _, s := range stmt.Body.List {
+		clause := s.(*ast.CaseClause)
+		if clause.List == nil {
+			rejects = rejectsValue(clause.Body)
+			continue
+		}
+		for _, e := range clause.List {
+			v, ok := a.stringConst(e)
+			if !ok {
+				return
+			}
+			values = append(values, v)
+		}
+	}
+	if !rejects || len(values) == 0 {
+		return
+	}
+	sort.Strings(values)
+	a.add(stmt.Tag, apidoc.Constraint{
+		Kind:        "enum",
+		Description: "one of " + strings.Join(quoteAll(values), ", "),
+		Values:      values,
+	})
+}
+
+// checkZeroTest records the presence of any field that the
+// condition of the given if statement compares with its
+// zero value.
+func (a *constraintFinder) checkZeroTest(stmt *ast.IfStmt) {
+	var presence, reason string
+	switch {
+	case rejectsValue(stmt.Body.List):
+		presence, reason = apidoc.Required, "rejects the zero value"
+	case assigns(stmt.Body.List):
+		presence, reason = apidoc.Optional, "substitutes a default for the zero value"
+	default:
+		return
+	}
+	for _, e := range disjuncts(stmt.Cond) {
+		if x, ok := a.zeroTest(e); ok {
+			a.setPresence(x, presence, reason)
+		}
+	}
+}
+
+// zeroTest returns x if e has the form x == zero, where zero
+// is the zero value of x, or len(x) == 0.
+func (a *constraintFinder) zeroTest(e ast.Expr) (ast.Expr, bool) {
+	bin, ok := unparen(e).(*ast.BinaryExpr)
+	if !ok || bin.Op != token.EQL {
+		return nil, false
+	}
+	x, zero := bin.X, bin.Y
+	if a.isZero(x) {
+		x, zero = zero, x
+	}
+	if !a.isZero(zero) {
+		return nil, false
+	}
+	if call, ok := unparen(x).(*ast.CallExpr); ok && len(call.Args) == 1 {
+		if id, ok := unparen(call.Fun).(*ast.Ident); ok && id.Name == "len" {
+			if _, ok := a.tinfo.Uses[id].(*types.Builtin); ok {
+				return call.Args[0], true
+			}
+		}
+	}
+	return x, true
+}
+
+// isZero reports whether e is nil or a constant
+// zero number or empty string.
+func (a *constraintFinder) isZero(e ast.Expr) bool {
+	tv, ok := a.tinfo.Types[e]
+	if !ok {
+		return false
+	}
+	if tv.IsNil() {
+		return true
+	}
+	if tv.Value == nil {
+		return false
+	}
+	switch tv.Value.Kind() {
+	case constant.String:
+		return constant.StringVal(tv.Value) == ""
+	case constant.Int, constant.Float:
+		return constant.Sign(tv.Value) == 0
+	}
+	return false
+}
+
+// addTagKind records that the field that e refers to, if it's
+// a field of a wire type, holds a tag of the given kind.
+func (a *constraintFinder) addTagKind(e ast.Expr, kind string) {
+	t, field, ok := a.wireField(e)
+	if !ok {
+		return
+	}
+	fi := a.field(t, field)
+	fi.TagKinds = append(fi.TagKinds, kind)
+}
+
+// setPresence records the presence of the field that e
+// refers to, if it's a field of a wire type. A field that
+// is required anywhere in the method stays required.
+func (a *constraintFinder) setPresence(e ast.Expr, presence, reason string) {
+	t, field, ok := a.wireField(e)
+	if !ok {
+		return
+	}
+	fi := a.field(t, field)
+	if fi.Presence != apidoc.Required {
+		fi.Presence, fi.PresenceReason = presence, reason
+	}
+}
+
+func (a *constraintFinder) addPattern(e ast.Expr, pattern string) {
+	a.add(e, apidoc.Constraint{
+		Kind:        "pattern",
+		Description: "a string matching " + "`" + pattern + "`",
+		Pattern:     pattern,
+	})
+}
+
+// add records the constraint c for the field that e refers
+// to, if it's a field of a wire type.
+func (a *constraintFinder) add(e ast.Expr, c apidoc.Constraint) {
+	t, field, ok := a.wireField(e)
+	if !ok {
+		return
+	}
+	fi := a.field(t, field)
+	fi.Constraints = append(fi.Constraints, c)
+}
+
+// field returns the entry in a.fields for the given
+// field, adding one if necessary.
+func (a *constraintFinder) field(t jsontypes.TypeName, field string) *apidoc.FieldInfo {
+	for i := range a.fields {
+		if fi := &a.fields[i]; fi.Type == t && fi.Field == field {
+			return fi
+		}
+	}
+	a.fields = append(a.fields, apidoc.FieldInfo{
+		Type:  t,
+		Field: field,
+	})
+	return &a.fields[len(a.fields)-1]
+}
+
+// wireField returns the type and name of the field selected
+// by e, if e selects a field declared directly in a named
+// wire type. Conversions, as in string(args.Kind), are
+// looked through.
+func (a *constraintFinder) wireField(e ast.Expr) (jsontypes.TypeName, string, bool) {
+	for {
+		e = unparen(e)
+		call, ok := e.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 || !a.tinfo.Types[call.Fun].IsType() {
+			break
+		}
+		e = call.Args[0]
+	}
+	sel, ok := e.(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+	s := a.tinfo.Selections[sel]
+	if s == nil || s.Kind() != types.FieldVal || len(s.Index()) != 1 {
+		return "", "", false
+	}
+	recv := s.Recv()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	named, ok := recv.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return "", "", false
+	}
+	name := jsontypes.TypeName(named.Obj().Pkg().Path() + "#" + named.Obj().Name())
+	if !a.isWireType(name) {
+		return "", "", false
+	}
+	return name, sel.Sel.Name, true
+}
+
+// callee returns the function or method called by call, if known.
+func (a *constraintFinder) callee(call *ast.CallExpr) *types.Func {
+	return calledFunc(a.tinfo, call)
+}
+
+// regexpPattern returns the regular expression held by the
+// *regexp.Regexp value e, if it's a call to regexp.MustCompile
+// with a constant argument or a package variable initialized
+// by one.
+func (a *constraintFinder) regexpPattern(e ast.Expr) (string, bool) {
+	e = unparen(e)
+	if call, ok := e.(*ast.CallExpr); ok {
+		return a.compiledPattern(call)
+	}
+	var id *ast.Ident
+	switch e := e.(type) {
+	case *ast.Ident:
+		id = e
+	case *ast.SelectorExpr:
+		id = e.Sel
+	default:
+		return "", false
+	}
+	v, ok := a.tinfo.Uses[id].(*types.Var)
+	if !ok {
+		return "", false
+	}
+	pattern, ok := a.patterns[v]
+	return pattern, ok
+}
+
+// compiledPattern returns the expression compiled by
+// call, if it's a call to regexp.MustCompile with a
+// constant argument.
+func (a *constraintFinder) compiledPattern(call *ast.CallExpr) (string, bool) {
+	fn := a.callee(call)
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "regexp" || fn.Name() != "MustCompile" || len(call.Args) != 1 {
+		return "", false
+	}
+	return a.stringConst(call.Args[0])
+}
+
+// stringConst returns the value of e if it's a string constant.
+func (a *constraintFinder) stringConst(e ast.Expr) (string, bool) {
+	tv, ok := a.tinfo.Types[e]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+// packagePatterns returns the regular expressions compiled
+// into package variables of pkg by regexp.MustCompile.
+func packagePatterns(pkg *packages.Package) map[*types.Var]string {
+	patterns := make(map[*types.Var]string)
+	a := &constraintFinder{
+		tinfo: pkg.TypesInfo,
+	}
+	for _, init := range pkg.TypesInfo.InitOrder {
+		if len(init.Lhs) != 1 {
+			continue
+		}
+		if call, ok := unparen(init.Rhs).(*ast.CallExpr); ok {
+			if pattern, ok := a.compiledPattern(call); ok {
+				patterns[init.Lhs[0]] = pattern
+			}
+		}
+	}
+	return patterns
+}
+
+// rejectsValue reports whether the given statements, run
+// for a value that isn't wanted, appear to reject the value,
+// by making an error or by returning something other than nil.
+func rejectsValue(stmts []ast.Stmt) bool {
+	found := false
+	for _, s := range stmts {
+		ast.Inspect(s, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.ReturnStmt:
+				if len(n.Results) > 0 {
+					if last, ok := n.Results[len(n.Results)-1].(*ast.Ident); !ok || last.Name != "nil" {
+						found = true
+					}
+				}
+			case *ast.CallExpr:
+				name := funcName(n.Fun)
+				if strings.Contains(name, "Err") || strings.Contains(name, "Invalid") || strings.Contains(name, "NotValid") {
+					found = true
+				}
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+// assigns reports whether any of the given statements
+// assigns a value.
+func assigns(stmts []ast.Stmt) bool {
+	for _, s := range stmts {
+		if s, ok := s.(*ast.AssignStmt); ok && s.Tok == token.ASSIGN {
+			return true
+		}
+	}
+	return false
+}
+
+// disjuncts returns the operands of e if it is a chain
+// of || operations, or e itself otherwise.
+func disjuncts(e ast.Expr) []ast.Expr {
+	if bin, ok := unparen(e).(*ast.BinaryExpr); ok && bin.Op == token.LOR {
+		return append(disjuncts(bin.X), disjuncts(bin.Y)...)
+	}
+	return []ast.Expr{e}
+}
+
+// tagKind returns the kind of the tags parsed by the function
+// Parse<entity>Tag in the given names package. It's taken from
+// the <entity>TagKind constant, as names.UnitTagKind holds
+// "unit", if there is one.
+func tagKind(namesPkg *types.Package, entity string) string {
+	if c, ok := namesPkg.Scope().Lookup(entity + "TagKind").(*types.Const); ok && c.Val().Kind() == constant.String {
+		return constant.StringVal(c.Val())
+	}
+	return strings.Replace(lowerWords(entity), " ", "", -1)
+}
+
+// isNamesPkg reports whether the package with the given
+// path is a version of the juju names package, such as
+// gopkg.in/juju/names.v2 or github.com/juju/names/v4.
+func isNamesPkg(pkgPath string) bool {
+	if namesVersionPat.MatchString(path.Base(pkgPath)) {
+		pkgPath = path.Dir(pkgPath)
+	}
+	base := path.Base(pkgPath)
+	return base == "names" || strings.HasPrefix(base, "names.")
+}
+
+// lowerWords splits a mixed-caps identifier into lower
+// case words, so that "CloudCredential" becomes
+// "cloud credential".
+func lowerWords(s string) string {
+	var buf strings.Builder
+	rs := []rune(s)
+	for i, r := range rs {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(rs[i-1]) || i+1 < len(rs) && unicode.IsLower(rs[i+1])) {
+			buf.WriteByte(' ')
+		}
+		buf.WriteRune(unicode.ToLower(r))
+	}
+	return buf.String()
+}
+
+func quoteAll(ss []string) []string {
+	quoted := make([]string, len(ss))
+	for i, s := range ss {
+		quoted[i] = strconv.Quote(s)
+	}
+	return quoted
+}
+
+// unparen returns e with any enclosing parentheses removed.
+func unparen(e ast.Expr) ast.Expr {
+	for {
+		p, ok := e.(*ast.ParenExpr)
+		if !ok {
+			return e
+		}
+		e = p.X
+	}
+}
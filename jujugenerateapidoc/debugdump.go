@@ -0,0 +1,210 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/rpc/rpcreflect"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/errgo.v1"
+)
+
+var debugDumpDir = flag.String("debug-dump", "", "write the raw rpcreflect data, go/types signatures and resolution decisions for each facade to a JSON file in the named directory")
+
+// facadeDump holds what the generator saw and decided when
+// documenting a single facade, for diagnosing problems such as
+// a method missing from the output.
+type facadeDump struct {
+	Facade  string
+	Version int
+	// GoType holds the Go type of the facade as registered
+	// with the API server.
+	GoType string
+	// ProgType holds the go/types type that GoType was resolved
+	// to and where it's declared, or why it couldn't be resolved.
+	ProgType string
+	// Methods holds the methods that rpcreflect found on
+	// GoType, which are the methods served over the API.
+	Methods []methodDump `json:",omitempty"`
+	// Skipped holds the exported methods of ProgType that
+	// rpcreflect didn't treat as API methods, with the reason.
+	Skipped []skippedMethod `json:",omitempty"`
+	// Output holds the facade as documented, which may be
+	// incomplete if Error is set.
+	Output apidoc.FacadeInfo
+	Error  string `json:",omitempty"`
+}
+
+// methodDump holds the rpcreflect data for a method, its go/types
+// signature, and the steps taken to find its declaration.
+type methodDump struct {
+	Name      string
+	Params    string `json:",omitempty"`
+	Result    string `json:",omitempty"`
+	Signature string `json:",omitempty"`
+	Decisions []string
+}
+
+// skippedMethod holds a method of a facade that isn't served
+// over the API.
+type skippedMethod struct {
+	Name      string
+	Signature string
+	Reason    string
+}
+
+// writeDebugDump writes the dump for the facade with the given
+// details, whose processing produced r, to a file named after the
+// facade in the -debug-dump directory.
+func writeDebugDump(pkg *packages.Package, d facade.Details, r facadeResult) error {
+	dump := facadeDump{
+		Facade:  d.Name,
+		Version: d.Version,
+		GoType:  d.Type.String(),
+		Output:  r.facade,
+	}
+	if r.err != nil {
+		dump.Error = r.err.Error()
+	}
+	pt, err := progType(pkg, d.Type)
+	if err != nil {
+		dump.ProgType = fmt.Sprintf("not resolved: %v", err)
+	} else {
+		dump.ProgType = fmt.Sprintf("%s declared at %v", pt.Type(), pkg.Fset.Position(pt.Pos()))
+	}
+	t := rpcreflect.ObjTypeOf(d.Type)
+	served := make(map[string]bool)
+	for _, name := range t.MethodNames() {
+		served[name] = true
+		m, _ := t.Method(name)
+		md := methodDump{
+			Name:   name,
+			Params: reflectTypeString(m.Params),
+			Result: reflectTypeString(m.Result),
+		}
+		if pt != nil {
+			md.Signature, md.Decisions = methodDecisions(pkg, pt, name)
+		}
+		dump.Methods = append(dump.Methods, md)
+	}
+	if pt != nil {
+		dump.Skipped = skippedMethods(pt, served)
+	}
+	data, err := json.MarshalIndent(dump, "", "\t")
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	path := filepath.Join(*debugDumpDir, fmt.Sprintf("%s-v%d.json", d.Name, d.Version))
+	if err := ioutil.WriteFile(path, data, 0666); err != nil {
+		return errgo.Mask(err)
+	}
+	return nil
+}
+
+// methodDecisions returns the go/types signature of the given
+// method of pt and a description of each step taken to find its
+// declaration, which is where its doc comment and the analysis
+// of its body come from.
+func methodDecisions(pkg *packages.Package, pt *types.TypeName, name string) (string, []string) {
+	obj, err := methodObj(pt, name)
+	if err != nil {
+		return "", []string{fmt.Sprintf("not found in the go/types method set: %v", err)}
+	}
+	decisions := []string{fmt.Sprintf("declared at %v", pkg.Fset.Position(obj.Pos()))}
+	if fn, ok := obj.(*types.Func); ok {
+		if recv := fn.Type().(*types.Signature).Recv(); recv != nil && !sameNamed(recv.Type(), pt) {
+			decisions = append(decisions, fmt.Sprintf("promoted from %s", recv.Type()))
+		}
+	}
+	decl, declPkg, err := findDeclPackage(pkg, obj.Pos())
+	switch {
+	case err != nil:
+		decisions = append(decisions, fmt.Sprintf("declaration not found: %v", err))
+	case declPkg.TypesInfo == nil:
+		decisions = append(decisions, fmt.Sprintf("declaration (%T) parsed on demand from %s, so its body can't be analyzed", decl, declPkg.PkgPath))
+	default:
+		decisions = append(decisions, fmt.Sprintf("declaration (%T) found with type information in %s", decl, declPkg.PkgPath))
+	}
+	return types.TypeString(obj.Type(), nil), decisions
+}
+
+// skippedMethods returns the exported methods of pt, including
+// those of its pointer type, that aren't in served.
+func skippedMethods(pt *types.TypeName, served map[string]bool) []skippedMethod {
+	t := pt.Type()
+	if !types.IsInterface(t) {
+		t = types.NewPointer(t)
+	}
+	var skipped []skippedMethod
+	mset := types.NewMethodSet(t)
+	for i := 0; i < mset.Len(); i++ {
+		obj := mset.At(i).Obj()
+		if !obj.Exported() || served[obj.Name()] {
+			continue
+		}
+		sig := obj.Type().(*types.Signature)
+		skipped = append(skipped, skippedMethod{
+			Name:      obj.Name(),
+			Signature: types.TypeString(sig, nil),
+			Reason:    rpcSignatureProblem(sig),
+		})
+	}
+	sort.Slice(skipped, func(i, j int) bool {
+		return skipped[i].Name < skipped[j].Name
+	})
+	return skipped
+}
+
+// rpcSignatureProblem returns why rpcreflect wouldn't serve a
+// method with the given signature. rpcreflect serves methods that
+// take at most one argument and return at most one value and an
+// error; anything else is outside what this can explain.
+func rpcSignatureProblem(sig *types.Signature) string {
+	if sig.Params().Len() > 1 {
+		return fmt.Sprintf("takes %d arguments, but API methods take at most one", sig.Params().Len())
+	}
+	results := sig.Results()
+	switch results.Len() {
+	case 0, 1:
+	case 2:
+		if !isError(results.At(1).Type()) {
+			return "returns two values, but the second isn't an error"
+		}
+	default:
+		return fmt.Sprintf("returns %d values, but API methods return at most two", results.Len())
+	}
+	return "the signature doesn't explain why; check the argument and result types"
+}
+
+// isError reports whether t is the predeclared error type.
+func isError(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+// sameNamed reports whether t, or the type it points
+// to, is the named type pt.
+func sameNamed(t types.Type, pt *types.TypeName) bool {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return ok && named.Obj() == pt
+}
+
+// reflectTypeString returns t formatted for a dump,
+// or the empty string if t is nil.
+func reflectTypeString(t reflect.Type) string {
+	if t == nil {
+		return ""
+	}
+	return t.String()
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// docSnippet holds a fenced code block found in a doc comment.
+type docSnippet struct {
+	// Lang holds the language named after the opening fence,
+	// if any.
+	Lang string
+	Text string
+	// Line holds the line of the doc comment that
+	// the snippet starts on, counting from 1.
+	Line int
+}
+
+// docSnippets returns all the fenced (```) code blocks in doc.
+func docSnippets(doc string) []docSnippet {
+	var snippets []docSnippet
+	var cur *docSnippet
+	var lines []string
+	for i, line := range strings.Split(doc, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "```") {
+			if cur != nil {
+				lines = append(lines, line)
+			}
+			continue
+		}
+		if cur == nil {
+			cur = &docSnippet{
+				Lang: strings.ToLower(strings.TrimSpace(strings.TrimPrefix(trimmed, "```"))),
+				Line: i + 2,
+			}
+			lines = nil
+			continue
+		}
+		cur.Text = strings.Join(lines, "\n")
+		snippets = append(snippets, *cur)
+		cur = nil
+	}
+	return snippets
+}
+
+// lang returns the language of the snippet, guessing
+// JSON for unlabeled snippets that look like it.
+func (s docSnippet) lang() string {
+	if s.Lang != "" {
+		return s.Lang
+	}
+	if t := strings.TrimSpace(s.Text); strings.HasPrefix(t, "{") || strings.HasPrefix(t, "[") {
+		return "json"
+	}
+	return ""
+}
+
+// checkSnippet returns an error if the given snippet is
+// not valid. JSON snippets must be well formed; Go snippets
+// must parse either as a complete file or as a sequence of
+// statements. Snippets in other languages are not checked.
+func checkSnippet(s docSnippet) error {
+	switch s.lang() {
+	case "json":
+		var v interface{}
+		if err := json.Unmarshal([]byte(s.Text), &v); err != nil {
+			return err
+		}
+	case "go", "golang":
+		fset := token.NewFileSet()
+		if _, err := parser.ParseFile(fset, "", s.Text, 0); err == nil {
+			return nil
+		}
+		src := "package p\nfunc _() {\n" + s.Text + "\n}\n"
+		if _, err := parser.ParseFile(fset, "", src, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exampleWarnings returns a warning for each invalid
+// snippet in the doc comments of the given facade
+// and its methods.
+func exampleWarnings(f apidoc.FacadeInfo) []apidoc.Warning {
+	var warnings []apidoc.Warning
+	check := func(method, doc string) {
+		for _, s := range docSnippets(doc) {
+			if err := checkSnippet(s); err != nil {
+				warnings = append(warnings, apidoc.Warning{
+					Kind:    "invalid-example",
+					Facade:  f.Name,
+					Version: f.Version,
+					Method:  method,
+					Message: fmt.Sprintf("doc comment line %d: invalid %s example: %v", s.Line, s.lang(), err),
+				})
+			}
+		}
+	}
+	check("", f.Doc)
+	for _, m := range f.Methods {
+		check(m.Name, m.Doc)
+	}
+	return warnings
+}
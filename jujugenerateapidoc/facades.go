@@ -0,0 +1,138 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/juju/juju/apiserver/facade"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"github.com/rogpeppe/apicompat/jsontypes"
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/errgo.v1"
+)
+
+// facadeResult holds the result of processing a single facade.
+type facadeResult struct {
+	facade   apidoc.FacadeInfo
+	fields   []apidoc.FieldInfo
+	warnings []apidoc.Warning
+	err      error
+}
+
+// processFacades calls facadeInfo for each of the given facades
+// using a pool of workers, one for each available CPU, and calls
+// emit with each result in the same order as ds. Results are
+// passed to emit as soon as they and all the results before them
+// are available. If emit returns an error, processFacades stops
+// and returns it.
+func processFacades(pkg *packages.Package, info *jsontypes.Info, ds []facade.Details, emit func(facadeResult) error) error {
+	results := make([]chan facadeResult, len(ds))
+	for i := range results {
+		results[i] = make(chan facadeResult, 1)
+	}
+	indexes := make(chan int)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(indexes)
+		for i := range ds {
+			select {
+			case indexes <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
+		go func() {
+			for i := range indexes {
+				results[i] <- checkpointedFacade(pkg, info, ds[i])
+			}
+		}()
+	}
+	for i := range ds {
+		if err := emit(<-results[i]); err != nil {
+			return err
+		}
+		results[i] = nil
+	}
+	return nil
+}
+
+var facadeTimeout = flag.Duration("facade-timeout", 2*time.Minute, "maximum time to spend on each facade, after which it is recorded as failed; 0 means no limit")
+
+// processFacade calls facadeInfo for a single facade,
+// giving up on it if it takes longer than the -facade-timeout
+// flag allows, as a facade factory that blocks on state access
+// would otherwise hang the whole run. A goroutine cannot be
+// stopped, so the one left running is abandoned; anything it
+// records later is harmless, as the facade's result has
+// already been reported as an error.
+func processFacade(pkg *packages.Package, info *jsontypes.Info, d facade.Details) facadeResult {
+	if *facadeTimeout <= 0 {
+		return recoveredFacade(pkg, info, d)
+	}
+	result := make(chan facadeResult, 1)
+	go func() {
+		result <- recoveredFacade(pkg, info, d)
+	}()
+	timer := time.NewTimer(*facadeTimeout)
+	defer timer.Stop()
+	select {
+	case r := <-result:
+		return r
+	case <-timer.C:
+		return facadeResult{
+			facade: apidoc.FacadeInfo{
+				Name:    d.Name,
+				Version: d.Version,
+			},
+			err: errgo.Newf("facade %s(%d): timed out after %v", d.Name, d.Version, *facadeTimeout),
+		}
+	}
+}
+
+// recoveredFacade calls facadeInfo for a single facade, turning
+// any panic into an error so that one misbehaving facade
+// cannot take down the whole process. The stack of a panic
+// is logged rather than included in the error, as the error
+// is recorded in the output.
+func recoveredFacade(pkg *packages.Package, info *jsontypes.Info, d facade.Details) (r facadeResult) {
+	if *debugDumpDir != "" {
+		// Deferred first so that the dump also
+		// records a facade that panicked.
+		defer func() {
+			// The dump is a diagnostic aid, so failing to
+			// write it doesn't count against the facade.
+			if err := writeDebugDump(pkg, d, r); err != nil {
+				log.Printf("cannot write debug dump for facade %s(%d): %v", d.Name, d.Version, err)
+			}
+		}()
+	}
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("panic processing facade %s(%d): %v\n%s", d.Name, d.Version, err, debug.Stack())
+			r = facadeResult{
+				facade: apidoc.FacadeInfo{
+					Name:    d.Name,
+					Version: d.Version,
+				},
+				err: errgo.Newf("facade %s(%d): panic: %v", d.Name, d.Version, err),
+			}
+		}
+	}()
+	f, fields, warnings, err := facadeInfo(pkg, info, d)
+	if err != nil {
+		err = errgo.Notef(err, "facade %s(%d)", d.Name, d.Version)
+	}
+	return facadeResult{
+		facade:   f,
+		fields:   fields,
+		warnings: warnings,
+		err:      err,
+	}
+}
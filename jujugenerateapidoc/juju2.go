@@ -0,0 +1,84 @@
+//go:build !juju3 && !juju4
+// +build !juju3,!juju4
+
+package main
+
+// This file holds the code that depends on the layout of
+// Juju 2.x trees, which is used when no juju* build tag is set.
+
+import (
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/permission"
+	"github.com/juju/juju/state"
+	"gopkg.in/juju/names.v2"
+)
+
+const paramsPkg = "github.com/juju/juju/apiserver/params"
+
+// serverErrorsPkg holds the path of the package that
+// converts errors to the params.Error values sent on
+// the wire, mapping sentinel errors to error codes.
+const serverErrorsPkg = "github.com/juju/juju/apiserver/common"
+
+// callFactory calls the factory for the given facade with
+// a context that uses the given authorizer.
+func callFactory(d facade.Details, auth authorizer) error {
+	_, err := d.Factory(context{
+		auth: auth,
+	})
+	return err
+}
+
+// isPermissionError reports whether err is the error
+// returned by facade factories that deny access.
+func isPermissionError(err error) bool {
+	return err == common.ErrPerm
+}
+
+type context struct {
+	auth authorizer
+	facade.Context
+}
+
+func (c context) Auth() facade.Authorizer {
+	return c.auth
+}
+
+func (c context) ID() string {
+	return ""
+}
+
+func (c context) State() *state.State {
+	return new(state.State)
+}
+
+func (c context) Resources() facade.Resources {
+	return nil
+}
+
+func (c context) StatePool() *state.StatePool {
+	return new(state.StatePool)
+}
+
+func (c context) ControllerTag() names.ControllerTag {
+	return names.NewControllerTag("xxxx")
+}
+
+func (a authorizer) HasPermission(operation permission.Access, target names.Tag) (bool, error) {
+	a.called("HasPermission")
+	return true, nil
+}
+
+func (a authorizer) GetAuthTag() names.Tag {
+	a.called("GetAuthTag")
+	switch a.kind {
+	case kindControllerUser, kindModelUser:
+		return names.NewUserTag("bob")
+	case kindUnitAgent:
+		return names.NewUnitTag("xx/0")
+	case kindMachineAgent, kindControllerMachine:
+		return names.NewMachineTag("0")
+	}
+	panic("unknown kind")
+}
@@ -0,0 +1,70 @@
+//go:build juju4
+// +build juju4
+
+package main
+
+// This file holds the code that depends on the layout of
+// Juju trees whose facade factories take a model context,
+// as in Juju 4.x, selected by the juju4 build tag.
+
+import (
+	stdcontext "context"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/names/v5"
+)
+
+const paramsPkg = "github.com/juju/juju/rpc/params"
+
+// serverErrorsPkg holds the path of the package that
+// converts errors to the params.Error values sent on
+// the wire, mapping sentinel errors to error codes.
+const serverErrorsPkg = "github.com/juju/juju/apiserver/errors"
+
+// callFactory calls the factory for the given facade with
+// a context that uses the given authorizer.
+func callFactory(d facade.Details, auth authorizer) error {
+	_, err := d.Factory(stdcontext.Background(), context{
+		auth: auth,
+	})
+	return err
+}
+
+// isPermissionError reports whether err is the error
+// returned by facade factories that deny access.
+func isPermissionError(err error) bool {
+	return err == apiservererrors.ErrPerm
+}
+
+type context struct {
+	auth authorizer
+	facade.MultiModelContext
+}
+
+func (c context) Auth() facade.Authorizer {
+	return c.auth
+}
+
+func (c context) Resources() facade.Resources {
+	return nil
+}
+
+func (a authorizer) HasPermission(ctx stdcontext.Context, operation permission.Access, target names.Tag) error {
+	a.called("HasPermission")
+	return nil
+}
+
+func (a authorizer) GetAuthTag() names.Tag {
+	a.called("GetAuthTag")
+	switch a.kind {
+	case kindControllerUser, kindModelUser:
+		return names.NewUserTag("bob")
+	case kindUnitAgent:
+		return names.NewUnitTag("xx/0")
+	case kindMachineAgent, kindControllerMachine:
+		return names.NewMachineTag("0")
+	}
+	panic("unknown kind")
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"golang.org/x/tools/go/packages"
+)
+
+// leaseKeywords holds the words that, found in the name or package
+// path of a facade, show that it grants leases, as the leadership
+// and singular facades do.
+var leaseKeywords = []string{"leadership", "singular", "lease"}
+
+// isLeaseFacade reports whether the facade with the given name,
+// implemented in the package with the given path, grants leases.
+func isLeaseFacade(name, pkgPath string) bool {
+	text := strings.ToLower(name + " " + pkgPath)
+	for _, keyword := range leaseKeywords {
+		if strings.Contains(text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// leaseParameters returns the durations that govern the leases
+// granted by the facade with the Go type pt and the given methods:
+// the durations that the methods compare against, such as the
+// longest claim allowed, followed by any other duration constants
+// declared in the facade's package, such as the default length of
+// a lease.
+func leaseParameters(pkg *packages.Package, pt *types.TypeName, methodNames []string) []apidoc.LeaseParameter {
+	var params []apidoc.LeaseParameter
+	checked := make(map[*types.Const]bool)
+	for _, name := range methodNames {
+		decl, declPkg, err := methodDecl(pkg, pt, name)
+		if err != nil || decl.Body == nil || declPkg.TypesInfo == nil {
+			continue
+		}
+		tinfo := declPkg.TypesInfo
+		ast.Inspect(decl.Body, func(n ast.Node) bool {
+			b, ok := n.(*ast.BinaryExpr)
+			if !ok || !isOrdering(b.Op) {
+				return true
+			}
+			for _, side := range []ast.Expr{b.X, b.Y} {
+				tv := tinfo.Types[side]
+				if tv.Value == nil || !isDuration(tv.Type) {
+					continue
+				}
+				value, ok := numericString(tv.Type, tv.Value)
+				if !ok {
+					continue
+				}
+				p := apidoc.LeaseParameter{
+					Value:  value,
+					Method: name,
+					Check:  types.ExprString(b),
+				}
+				if c := usedConst(tinfo, side); c != nil && strings.HasPrefix(c.Pkg().Path()+"/", jujuPkgPrefix) {
+					checked[c] = true
+					p.Name = c.Pkg().Path() + "." + c.Name()
+					// The doc comment is an extra, so a declaration
+					// that can't be found isn't fatal.
+					p.Doc, _ = valueDocComment(pkg, c)
+				}
+				params = append(params, p)
+			}
+			return true
+		})
+	}
+	p := findPackage(pkg, pt.Pkg().Path())
+	if p == nil || p.Types == nil {
+		return params
+	}
+	scope := p.Types.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || checked[c] || !isDuration(c.Type()) {
+			continue
+		}
+		value, ok := numericString(c.Type(), c.Val())
+		if !ok {
+			continue
+		}
+		doc, _ := valueDocComment(pkg, c)
+		params = append(params, apidoc.LeaseParameter{
+			Name:  p.PkgPath + "." + name,
+			Value: value,
+			Doc:   doc,
+		})
+	}
+	return params
+}
+
+// isOrdering reports whether op orders its operands,
+// as a check against a limit does.
+func isOrdering(op token.Token) bool {
+	switch op {
+	case token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return true
+	}
+	return false
+}
+
+// usedConst returns the package-level constant that
+// e refers to, or nil if it doesn't refer to one.
+func usedConst(tinfo *types.Info, e ast.Expr) *types.Const {
+	var id *ast.Ident
+	switch e := unparen(e).(type) {
+	case *ast.Ident:
+		id = e
+	case *ast.SelectorExpr:
+		id = e.Sel
+	default:
+		return nil
+	}
+	c, ok := tinfo.Uses[id].(*types.Const)
+	if !ok || c.Pkg() == nil || c.Parent() != c.Pkg().Scope() {
+		return nil
+	}
+	return c
+}
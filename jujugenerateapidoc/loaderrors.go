@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/rpc/rpcreflect"
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/errgo.v1"
+)
+
+// packageLoadErrors returns the errors that packages.Load reported
+// for pkg and its dependencies, keyed by import path, such as for a
+// package whose files are all excluded by build tags. An error in a
+// dependency is also reported for the packages that import it, as
+// they can't be type-checked without it.
+func packageLoadErrors(pkg *packages.Package) map[string][]packages.Error {
+	errs := make(map[string][]packages.Error)
+	for path, p := range indexPackages(pkg).packages {
+		if len(p.Errors) > 0 {
+			errs[path] = p.Errors
+		}
+	}
+	return errs
+}
+
+// facadeLoadError returns an error if the package declaring the
+// facade with the given details, or any of its params or result
+// types, couldn't be loaded without errors, as the facade's
+// documentation would be incomplete or wrong.
+func facadeLoadError(d facade.Details, loadErrs map[string][]packages.Error) error {
+	if len(loadErrs) == 0 {
+		return nil
+	}
+	paths := make(map[string]bool)
+	addPath := func(t reflect.Type) {
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.PkgPath() != "" {
+			paths[t.PkgPath()] = true
+		}
+	}
+	addPath(d.Type)
+	t := rpcreflect.ObjTypeOf(d.Type)
+	for _, name := range t.MethodNames() {
+		m, _ := t.Method(name)
+		if m.Params != nil {
+			addPath(m.Params)
+		}
+		if m.Result != nil {
+			addPath(m.Result)
+		}
+	}
+	sorted := make([]string, 0, len(paths))
+	for path := range paths {
+		sorted = append(sorted, path)
+	}
+	sort.Strings(sorted)
+	for _, path := range sorted {
+		errs := loadErrs[path]
+		if len(errs) == 0 {
+			continue
+		}
+		msg := errs[0].Error()
+		if len(errs) > 1 {
+			msg += fmt.Sprintf(" (and %d more errors)", len(errs)-1)
+		}
+		return errgo.Newf("cannot load package %s: %s", path, msg)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"go/constant"
+	"go/types"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"golang.org/x/tools/go/packages"
+)
+
+// operationalKeywords maps words that can appear in the name
+// of an API server constant to the category of setting that
+// the constant holds. They're checked in order, so that a
+// name such as loginConnPause counts as being about logins.
+var operationalKeywords = []struct {
+	word     string
+	category string
+}{
+	{"ping", apidoc.OperationalKeepalive},
+	{"pong", apidoc.OperationalKeepalive},
+	{"keepalive", apidoc.OperationalKeepalive},
+	{"writewait", apidoc.OperationalKeepalive},
+	{"login", apidoc.OperationalLogin},
+	{"conn", apidoc.OperationalConnection},
+}
+
+// operationalSettings returns the numeric constants declared in the
+// API server package and the packages below it, other than the
+// facades, whose names show that they govern connections, logins
+// or keepalive pings, such as the limit on the rate of logins.
+func operationalSettings(pkg *packages.Package) []apidoc.OperationalSetting {
+	var settings []apidoc.OperationalSetting
+	for path, p := range indexPackages(pkg).packages {
+		if path != serverPkg && !strings.HasPrefix(path, serverPkg+"/") || strings.Contains(path, "/facades/") {
+			continue
+		}
+		if p.Types == nil {
+			continue
+		}
+		scope := p.Types.Scope()
+		for _, name := range scope.Names() {
+			c, ok := scope.Lookup(name).(*types.Const)
+			if !ok {
+				continue
+			}
+			category := operationalCategory(name)
+			if category == "" {
+				continue
+			}
+			value, ok := operationalValue(c)
+			if !ok {
+				continue
+			}
+			// The doc comment is an extra, so a declaration
+			// that can't be found isn't fatal.
+			doc, _ := valueDocComment(pkg, c)
+			settings = append(settings, apidoc.OperationalSetting{
+				Category: category,
+				Name:     path + "." + name,
+				Value:    value,
+				Doc:      doc,
+			})
+		}
+	}
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].Name < settings[j].Name
+	})
+	return settings
+}
+
+// operationalCategory returns the category of the setting held
+// in the constant with the given name, or the empty string if
+// it doesn't appear to be one.
+func operationalCategory(name string) string {
+	name = strings.ToLower(name)
+	for _, k := range operationalKeywords {
+		if strings.Contains(name, k.word) {
+			return k.category
+		}
+	}
+	return ""
+}
+
+// operationalValue returns the value of c formatted for
+// OperationalSetting.Value, reporting false if it isn't numeric.
+func operationalValue(c *types.Const) (string, bool) {
+	return numericString(c.Type(), c.Val())
+}
+
+// numericString returns the numeric constant v of type t formatted
+// for documentation, with durations formatted as by
+// time.Duration.String. It reports false if v isn't numeric.
+func numericString(t types.Type, v constant.Value) (string, bool) {
+	if v.Kind() != constant.Int && v.Kind() != constant.Float {
+		return "", false
+	}
+	if isDuration(t) {
+		if d, exact := constant.Int64Val(v); exact {
+			return time.Duration(d).String(), true
+		}
+	}
+	return v.ExactString(), true
+}
+
+// isDuration reports whether t is time.Duration.
+func isDuration(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Duration"
+}
@@ -0,0 +1,370 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"golang.org/x/tools/go/packages"
+)
+
+// maxOrderCallDepth holds the maximum depth of calls that will
+// be followed when looking for the code that builds the results
+// of a bulk method.
+const maxOrderCallDepth = 3
+
+// sortFuncs holds the sort package functions that
+// reorder the slice passed as their first argument.
+var sortFuncs = map[string]bool{
+	"Slice":       true,
+	"SliceStable": true,
+	"Sort":        true,
+	"Stable":      true,
+	"Strings":     true,
+}
+
+// resultOrder classifies how the results of the given method
+// correspond to its params, if it's a bulk method, one whose
+// params and result are both structs holding a slice. It looks
+// for a loop over the params that assigns each result by
+// index or appends one result for each item, following calls
+// that the params are passed to, and for code that breaks the
+// correspondence: loops that skip items without appending a
+// result, results collected from a map, or sorted results.
+func resultOrder(pkg *packages.Package, pt *types.TypeName, methodName string, params, result reflect.Type) *apidoc.ResultOrder {
+	inFields, outFields := sliceFields(params), sliceFields(result)
+	if len(inFields) == 0 || len(outFields) == 0 {
+		return nil
+	}
+	assumed := &apidoc.ResultOrder{
+		ByPosition: true,
+		Confidence: "low",
+		Reason:     "bulk method; assumed to follow the convention as the results aren't built in the analyzed source",
+	}
+	decl, declPkg, err := methodDecl(pkg, pt, methodName)
+	if err != nil || decl.Body == nil || declPkg.TypesInfo == nil {
+		return assumed
+	}
+	param := paramAt(declPkg.TypesInfo, decl, 0)
+	if param == nil {
+		return assumed
+	}
+	o := &orderFinder{
+		pkg:       pkg,
+		inFields:  inFields,
+		outFields: outFields,
+	}
+	if r := o.find(declPkg, decl, param, 0); r != nil {
+		return r
+	}
+	return assumed
+}
+
+// orderFinder holds the state used by resultOrder.
+type orderFinder struct {
+	pkg                 *packages.Package
+	inFields, outFields map[string]bool
+}
+
+// find returns the classification of the results built by decl,
+// given that param holds the params of the bulk method, or nil if
+// the results aren't built there.
+func (o *orderFinder) find(declPkg *packages.Package, decl *ast.FuncDecl, param *types.Var, depth int) *apidoc.ResultOrder {
+	tinfo := declPkg.TypesInfo
+	var (
+		deviation string
+		indexed   bool
+		appended  bool
+		mapLoop   bool
+		callees   []*ast.CallExpr
+	)
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.RangeStmt:
+			if o.isParamsSlice(tinfo, n.X, param) {
+				switch {
+				case assignsByIndex(tinfo, n):
+					indexed = true
+				case containsAppend(tinfo, n.Body):
+					appended = true
+					if skipsAppend(tinfo, n.Body.List, false) && deviation == "" {
+						deviation = "the loop over the params skips some items without appending a result"
+					}
+				}
+			} else if t := tinfo.TypeOf(n.X); t != nil && containsAppend(tinfo, n.Body) {
+				if _, ok := t.Underlying().(*types.Map); ok {
+					mapLoop = true
+				}
+			}
+		case *ast.CallExpr:
+			if fn := calledFunc(tinfo, n); fn != nil && fn.Pkg() != nil {
+				if fn.Pkg().Path() == "sort" && sortFuncs[fn.Name()] && len(n.Args) > 0 && o.isResultsSlice(tinfo, n.Args[0]) {
+					deviation = "the results are sorted"
+				}
+				if strings.HasPrefix(fn.Pkg().Path(), jujuPkgPrefix) && argIndex(tinfo, n, param) >= 0 {
+					callees = append(callees, n)
+				}
+			}
+		}
+		return true
+	})
+	switch {
+	case deviation != "":
+		return &apidoc.ResultOrder{
+			ByPosition: false,
+			Confidence: "medium",
+			Reason:     deviation,
+		}
+	case indexed:
+		return &apidoc.ResultOrder{
+			ByPosition: true,
+			Confidence: "high",
+			Reason:     "each result is assigned at the index of its item",
+		}
+	case appended:
+		return &apidoc.ResultOrder{
+			ByPosition: true,
+			Confidence: "medium",
+			Reason:     "a result is appended for each item in turn",
+		}
+	case mapLoop:
+		return &apidoc.ResultOrder{
+			ByPosition: false,
+			Confidence: "low",
+			Reason:     "the results are collected by iterating over a map, whose order is random",
+		}
+	}
+	if depth >= maxOrderCallDepth {
+		return nil
+	}
+	// The params may be handed on to a function
+	// that does the work, as with the common
+	// package helpers used by many facades.
+	for _, call := range callees {
+		fn := calledFunc(tinfo, call)
+		calleeDecl, calleePkg, err := findDeclPackage(o.pkg, fn.Pos())
+		if err != nil || calleePkg.TypesInfo == nil {
+			continue
+		}
+		fdecl, ok := calleeDecl.(*ast.FuncDecl)
+		if !ok || fdecl.Body == nil {
+			continue
+		}
+		calleeParam := paramAt(calleePkg.TypesInfo, fdecl, argIndex(tinfo, call, param))
+		if calleeParam == nil {
+			continue
+		}
+		if r := o.find(calleePkg, fdecl, calleeParam, depth+1); r != nil {
+			return r
+		}
+	}
+	return nil
+}
+
+// isParamsSlice reports whether e selects one of the
+// slice fields of the params held in param.
+func (o *orderFinder) isParamsSlice(tinfo *types.Info, e ast.Expr, param *types.Var) bool {
+	sel, ok := unparen(e).(*ast.SelectorExpr)
+	if !ok || !o.inFields[sel.Sel.Name] {
+		return false
+	}
+	x, ok := unparen(sel.X).(*ast.Ident)
+	return ok && tinfo.Uses[x] == param
+}
+
+// isResultsSlice reports whether e, perhaps converted to
+// another type as in sort.Sort(byName(results.Results)),
+// selects a field with the name of one of the slice fields
+// of the results.
+func (o *orderFinder) isResultsSlice(tinfo *types.Info, e ast.Expr) bool {
+	e = unparen(e)
+	if call, ok := e.(*ast.CallExpr); ok && len(call.Args) == 1 && tinfo.Types[call.Fun].IsType() {
+		e = unparen(call.Args[0])
+	}
+	sel, ok := e.(*ast.SelectorExpr)
+	return ok && o.outFields[sel.Sel.Name]
+}
+
+// assignsByIndex reports whether the body of the given loop
+// assigns to an element indexed by the loop's key.
+func assignsByIndex(tinfo *types.Info, loop *ast.RangeStmt) bool {
+	key, ok := loop.Key.(*ast.Ident)
+	if !ok || key.Name == "_" {
+		return false
+	}
+	keyObj := tinfo.Defs[key]
+	if keyObj == nil {
+		keyObj = tinfo.Uses[key]
+	}
+	found := false
+	ast.Inspect(loop.Body, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok {
+			for _, lhs := range assign.Lhs {
+				ast.Inspect(lhs, func(n ast.Node) bool {
+					if index, ok := n.(*ast.IndexExpr); ok {
+						if id, ok := unparen(index.Index).(*ast.Ident); ok && tinfo.Uses[id] == keyObj {
+							found = true
+						}
+					}
+					return !found
+				})
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// skipsAppend reports whether any of the given statements, the
+// body of a loop or a block within it, continues the loop without
+// a result having been appended, given whether one already has.
+// Nested loops are not examined, as a continue statement there
+// refers to the inner loop.
+func skipsAppend(tinfo *types.Info, stmts []ast.Stmt, appended bool) bool {
+	for _, s := range stmts {
+		switch s := s.(type) {
+		case *ast.BranchStmt:
+			if s.Tok == token.CONTINUE && s.Label == nil && !appended {
+				return true
+			}
+		case *ast.BlockStmt:
+			if skipsAppend(tinfo, s.List, appended) {
+				return true
+			}
+		case *ast.IfStmt:
+			if skipsAppend(tinfo, s.Body.List, appended) {
+				return true
+			}
+			if s.Else != nil && skipsAppend(tinfo, []ast.Stmt{s.Else}, appended) {
+				return true
+			}
+		case *ast.SwitchStmt:
+			for _, c := range s.Body.List {
+				if skipsAppend(tinfo, c.(*ast.CaseClause).Body, appended) {
+					return true
+				}
+			}
+		case *ast.TypeSwitchStmt:
+			for _, c := range s.Body.List {
+				if skipsAppend(tinfo, c.(*ast.CaseClause).Body, appended) {
+					return true
+				}
+			}
+		}
+		appended = appended || containsAppend(tinfo, s)
+	}
+	return false
+}
+
+// containsAppend reports whether n contains a call
+// to the append builtin.
+func containsAppend(tinfo *types.Info, n ast.Node) bool {
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			if id, ok := unparen(call.Fun).(*ast.Ident); ok && id.Name == "append" {
+				if _, ok := tinfo.Uses[id].(*types.Builtin); ok {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// calledFunc returns the function or method called by call, if known.
+func calledFunc(tinfo *types.Info, call *ast.CallExpr) *types.Func {
+	var id *ast.Ident
+	switch fun := unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return nil
+	}
+	fn, _ := tinfo.Uses[id].(*types.Func)
+	return fn
+}
+
+// argIndex returns the index of the argument to call that
+// is v, or -1 if there is none.
+func argIndex(tinfo *types.Info, call *ast.CallExpr, v *types.Var) int {
+	for i, arg := range call.Args {
+		if id, ok := unparen(arg).(*ast.Ident); ok && tinfo.Uses[id] == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// paramAt returns the i'th parameter of decl, or nil if
+// there is none or it has no name.
+func paramAt(tinfo *types.Info, decl *ast.FuncDecl, i int) *types.Var {
+	if i < 0 {
+		return nil
+	}
+	for _, field := range decl.Type.Params.List {
+		if len(field.Names) == 0 {
+			if i == 0 {
+				return nil
+			}
+			i--
+			continue
+		}
+		if i < len(field.Names) {
+			v, _ := tinfo.Defs[field.Names[i]].(*types.Var)
+			return v
+		}
+		i -= len(field.Names)
+	}
+	return nil
+}
+
+// sliceFields returns the names of the slice-typed fields of
+// the struct type t, or nil if t is not a struct.
+func sliceFields(t reflect.Type) map[string]bool {
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	var fields map[string]bool
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.Type.Kind() == reflect.Slice && f.Type.Elem().Kind() != reflect.Uint8 {
+			if fields == nil {
+				fields = make(map[string]bool)
+			}
+			fields[f.Name] = true
+		}
+	}
+	return fields
+}
+
+// resultOrderWarnings returns a warning for each method of f
+// that appears not to return its results in the same order
+// as its params.
+func resultOrderWarnings(f apidoc.FacadeInfo) []apidoc.Warning {
+	var warnings []apidoc.Warning
+	for _, m := range f.Methods {
+		if r := m.ResultOrder; r != nil && !r.ByPosition {
+			warnings = append(warnings, apidoc.Warning{
+				Kind:    "result-order",
+				Facade:  f.Name,
+				Version: f.Version,
+				Method:  m.Name,
+				Message: fmt.Sprintf("results may not be in the same order as the params: %s", r.Reason),
+			})
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juju/juju/apiserver/facade"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/errgo.v1"
+)
+
+var platforms = flag.String("platforms", "linux,windows", "comma-separated GOOS values to compare when looking for platform-conditional facades and methods")
+
+// registerFuncs holds the names of the functions used
+// to register facades by name and version.
+var registerFuncs = map[string]bool{
+	"reg":                              true,
+	"Register":                         true,
+	"RegisterStandardFacade":           true,
+	"RegisterStandardFacadeForFeature": true,
+	"RegisterFacade":                   true,
+	"MustRegister":                     true,
+}
+
+// platformWarnings returns a warning for each facade registration
+// and facade method in the juju packages used by pkg that is only
+// compiled for some of the platforms in the -platforms flag.
+// The generated documentation only reflects the platform that the
+// generator runs on, so such facades and methods may be missing
+// from it, or be present but unavailable elsewhere.
+//
+// The juju sources are inspected rather than built for each
+// platform, because the generator can only run on its host.
+func platformWarnings(pkg *packages.Package, ds []facade.Details) ([]apidoc.Warning, error) {
+	goos := strings.Split(*platforms, ",")
+	if len(goos) < 2 {
+		return nil, nil
+	}
+	// facadeTypes maps from the qualified name of each
+	// facade type to the facades that use it.
+	facadeTypes := make(map[string][]facade.Details)
+	for _, d := range ds {
+		t := d.Type
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Name() != "" {
+			key := t.PkgPath() + "." + t.Name()
+			facadeTypes[key] = append(facadeTypes[key], d)
+		}
+	}
+	var warnings []apidoc.Warning
+	seen := make(map[string]bool)
+	var visit func(p *packages.Package) error
+	visit = func(p *packages.Package) error {
+		if seen[p.PkgPath] {
+			return nil
+		}
+		seen[p.PkgPath] = true
+		if p.PkgPath != serverPkg && !strings.HasPrefix(p.PkgPath, jujuPkgPrefix) {
+			return nil
+		}
+		if len(p.GoFiles) > 0 {
+			ws, err := packagePlatformWarnings(p.PkgPath, filepath.Dir(p.GoFiles[0]), goos, facadeTypes)
+			if err != nil {
+				return errgo.Mask(err)
+			}
+			warnings = append(warnings, ws...)
+		}
+		for _, ip := range p.Imports {
+			if err := visit(ip); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := visit(pkg); err != nil {
+		return nil, errgo.Mask(err)
+	}
+	return warnings, nil
+}
+
+// packagePlatformWarnings returns the platform warnings for the
+// package with the given path in dir. See platformWarnings.
+func packagePlatformWarnings(pkgPath, dir string, goos []string, facadeTypes map[string][]facade.Details) ([]apidoc.Warning, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	var warnings []apidoc.Warning
+	fset := token.NewFileSet()
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		var on []string
+		for _, platform := range goos {
+			ctxt := build.Default
+			ctxt.GOOS = platform
+			ok, err := ctxt.MatchFile(dir, name)
+			if err != nil {
+				return nil, errgo.Mask(err)
+			}
+			if ok {
+				on = append(on, platform)
+			}
+		}
+		if len(on) == 0 || len(on) == len(goos) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+		where := fmt.Sprintf("only on %s (%s)", strings.Join(on, ", "), name)
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || !fd.Name.IsExported() {
+				continue
+			}
+			recv := receiverName(fd.Recv.List[0].Type)
+			for _, d := range facadeTypes[pkgPath+"."+recv] {
+				warnings = append(warnings, apidoc.Warning{
+					Kind:    "platform-conditional",
+					Facade:  d.Name,
+					Version: d.Version,
+					Method:  fd.Name.Name,
+					Message: fmt.Sprintf("method %s.%s is defined %s", d.Name, fd.Name.Name, where),
+				})
+			}
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) < 2 || !registerFuncs[funcName(call.Fun)] {
+				return true
+			}
+			name, version, ok := registration(call)
+			if ok {
+				warnings = append(warnings, apidoc.Warning{
+					Kind:    "platform-conditional",
+					Facade:  name,
+					Version: version,
+					Message: fmt.Sprintf("facade %s(%d) is registered %s", name, version, where),
+				})
+			}
+			return true
+		})
+	}
+	sort.SliceStable(warnings, func(i, j int) bool {
+		return warnings[i].Facade < warnings[j].Facade
+	})
+	return warnings, nil
+}
+
+// registration returns the facade name and version registered by
+// the given call, if they're given as literals in its first two
+// arguments.
+func registration(call *ast.CallExpr) (string, int, bool) {
+	nameLit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || nameLit.Kind != token.STRING {
+		return "", 0, false
+	}
+	versionLit, ok := call.Args[1].(*ast.BasicLit)
+	if !ok || versionLit.Kind != token.INT {
+		return "", 0, false
+	}
+	name, err := strconv.Unquote(nameLit.Value)
+	if err != nil {
+		return "", 0, false
+	}
+	version, err := strconv.Atoi(versionLit.Value)
+	if err != nil {
+		return "", 0, false
+	}
+	return name, version, true
+}
+
+// receiverName returns the name of the type of a method receiver.
+func receiverName(e ast.Expr) string {
+	if star, ok := e.(*ast.StarExpr); ok {
+		e = star.X
+	}
+	if id, ok := e.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// funcName returns the name of the function called by
+// a call expression with the given function, if any.
+func funcName(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+	return ""
+}
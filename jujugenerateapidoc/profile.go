@@ -0,0 +1,80 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+
+	"gopkg.in/errgo.v1"
+)
+
+var (
+	cpuProfile = flag.String("cpuprofile", "", "write a CPU profile to the named file")
+	memProfile = flag.String("memprofile", "", "write a heap profile to the named file on exit")
+	traceFile  = flag.String("trace", "", "write an execution trace to the named file")
+)
+
+// startProfiling starts any profiling requested by flags.
+// The returned function stops profiling and writes the
+// remaining profiles; it must be called before exiting.
+func startProfiling() (stop func() error, err error) {
+	var stops []func() error
+	stop = func() error {
+		var firstErr error
+		for i := len(stops) - 1; i >= 0; i-- {
+			if err := stops[i](); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		return firstErr
+	}
+	if *cpuProfile != "" {
+		f, err := os.Create(*cpuProfile)
+		if err != nil {
+			return nil, errgo.Mask(err)
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, errgo.Notef(err, "cannot start CPU profile")
+		}
+		stops = append(stops, func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		})
+	}
+	if *traceFile != "" {
+		f, err := os.Create(*traceFile)
+		if err != nil {
+			stop()
+			return nil, errgo.Mask(err)
+		}
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			stop()
+			return nil, errgo.Notef(err, "cannot start trace")
+		}
+		stops = append(stops, func() error {
+			trace.Stop()
+			return f.Close()
+		})
+	}
+	if *memProfile != "" {
+		stops = append(stops, func() error {
+			f, err := os.Create(*memProfile)
+			if err != nil {
+				return errgo.Mask(err)
+			}
+			defer f.Close()
+			// Make sure the profile reflects all
+			// the allocations made so far.
+			runtime.GC()
+			if err := pprof.WriteHeapProfile(f); err != nil {
+				return errgo.Notef(err, "cannot write heap profile")
+			}
+			return nil
+		})
+	}
+	return stop, nil
+}
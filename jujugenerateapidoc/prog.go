@@ -7,27 +7,36 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
 	"go/ast"
+	"go/constant"
 	"go/parser"
 	"go/token"
 	"go/types"
 	"html/template"
+	"io/ioutil"
 	"log"
 	"os"
+	"path"
+	"path/filepath"
 	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	// These dependencies should not be put in the
 	// go.mod file, as they should come from the
-	// selected juju version.
+	// selected juju version. Those whose location
+	// depends on the juju version are imported
+	// by the juju*.go files instead.
 	"github.com/juju/errors"
 	"github.com/juju/juju/apiserver"
-	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/facade"
-	"github.com/juju/juju/permission"
 	"github.com/juju/juju/rpc/rpcreflect"
-	"github.com/juju/juju/state"
-	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/jujuapidoc/apidoc"
 	"github.com/rogpeppe/apicompat/jsontypes"
@@ -35,96 +44,505 @@ import (
 	"gopkg.in/errgo.v1"
 )
 
+var (
+	securityReport = flag.String("security-report", "", "write a report of agent-accessible methods without permission checks to the named file")
+	panicReport    = flag.String("panic-report", "", "write a JSON report of facade factory panics to the named file")
+	strict         = flag.Bool("strict", false, "fail if there are any warnings, facade factory panics or facades that could not be documented, treating missing doc comments as warnings")
+	statsFile      = flag.String("stats", "", "write statistics on the run, as JSON-encoded apidoc.Stats, to the named file")
+	metaFlag       = flag.String("meta", "", "JSON-encoded apidoc.Meta describing the juju source, to which the generation time and Go version are added")
+)
+
+// partialExitCode holds the exit status used when the
+// documentation was written but some facades could not be
+// documented. It must match the constant of the same name
+// in jujuapidoc.
+const partialExitCode = 3
+
+// errPartial is returned by runMain when the documentation
+// is incomplete because of facade errors.
+var errPartial = errgo.New("some facades could not be documented")
+
 func main() {
-	info, err := generateInfo()
+	flag.Parse()
+	stopProfiling, err := startProfiling()
 	if err != nil {
 		log.Fatal(err)
 	}
-	data, err := json.Marshal(info)
+	err = runMain()
+	if err1 := stopProfiling(); err == nil {
+		err = err1
+	}
+	if err == errPartial {
+		log.Print(err)
+		os.Exit(partialExitCode)
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
-	os.Stdout.Write(data)
+}
+
+func runMain() error {
+	w := newInfoWriter(os.Stdout)
+	info, err := generateInfo(w)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	w.field("ErrorCodes", info.ErrorCodes, len(info.ErrorCodes))
+	w.field("SentinelErrors", info.SentinelErrors, len(info.SentinelErrors))
+	w.field("Operational", info.Operational, len(info.Operational))
+	w.field("AuditExcluded", info.AuditExcluded, len(info.AuditExcluded))
+	w.field("Warnings", info.Warnings, len(info.Warnings))
+	w.field("FactoryPanics", info.FactoryPanics, len(info.FactoryPanics))
+	w.field("Advertised", info.Advertised, 1)
+	w.field("Negotiation", info.Negotiation, len(info.Negotiation))
+	w.field("TypeFamilies", info.TypeFamilies, len(info.TypeFamilies))
+	w.field("Fields", info.Fields, len(info.Fields))
+	w.field("FacadeErrors", info.FacadeErrors, len(info.FacadeErrors))
+	if err := w.close(); err != nil {
+		return errgo.Mask(err)
+	}
+	if *securityReport != "" {
+		if err := writeSecurityReport(*securityReport, info); err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	if *panicReport != "" {
+		if err := writeJSONFile(*panicReport, info.FactoryPanics); err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	if *statsFile != "" {
+		if err := writeJSONFile(*statsFile, generationStats()); err != nil {
+			return errgo.Mask(err)
+		}
+	}
+	if len(info.Warnings) > 0 {
+		log.Printf("%d warnings (see the Warnings section of the output)", len(info.Warnings))
+	}
+	if *strict {
+		if err := checkStrict(info); err != nil {
+			return errgo.Mask(err)
+		}
+	}
 	if len(panicked) > 0 {
-		log.Printf("%d/%d facades panicked when trying to determine access (this is normal)", len(panicked), len(allFacadeNames))
+		log.Printf("%d/%d facade versions panicked when trying to determine access (this is normal)", len(panicked), len(allFacades))
 	}
+	if len(info.FacadeErrors) > 0 {
+		log.Printf("%d facades could not be documented:", len(info.FacadeErrors))
+		for _, e := range info.FacadeErrors {
+			log.Printf("\t%s", e.Message)
+		}
+		return errPartial
+	}
+	return nil
 }
 
-func generateInfo() (*apidoc.Info, error) {
-	cfg := packages.Config{
-		Mode: packages.LoadAllSyntax,
-		ParseFile: func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
-			return parser.ParseFile(fset, filename, src, parser.ParseComments)
-		},
+// generationMeta returns the metadata for the generated
+// document, starting from that in the -meta flag.
+func generationMeta() (*apidoc.Meta, error) {
+	var meta apidoc.Meta
+	if *metaFlag != "" {
+		if err := json.Unmarshal([]byte(*metaFlag), &meta); err != nil {
+			return nil, errgo.Notef(err, "invalid -meta flag")
+		}
 	}
-	serverPkg := "github.com/juju/juju/apiserver"
-	pkgs, err := packages.Load(&cfg, serverPkg)
-	if err != nil {
-		return nil, errgo.Notef(err, "cannot load %q", serverPkg)
+	meta.Generated = time.Now().UTC()
+	if epoch := os.Getenv("SOURCE_DATE_EPOCH"); epoch != "" {
+		// Allow reproducible builds; see https://reproducible-builds.org/specs/source-date-epoch/.
+		secs, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			return nil, errgo.Notef(err, "invalid $SOURCE_DATE_EPOCH")
+		}
+		meta.Generated = time.Unix(secs, 0).UTC()
 	}
-	if len(pkgs) != 1 {
-		return nil, errgo.Newf("packages.Load returned %d packages, not 1", len(pkgs))
+	meta.GoVersion = runtime.Version()
+	return &meta, nil
+}
+
+// writeJSONFile writes the JSON encoding of v to the named file.
+func writeJSONFile(file string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return errgo.Mask(err)
 	}
-	pkg := pkgs[0]
+	return ioutil.WriteFile(file, data, 0666)
+}
 
-	info := jsontypes.NewInfo()
+// generateInfo generates the API documentation. The type information
+// and the facades are written to w as they are produced; everything
+// else is returned in an apidoc.Info value with those fields left
+// empty, ready to be written by the caller.
+func generateInfo(w *infoWriter) (*apidoc.Info, error) {
 	ds := apiserver.AllFacades().ListDetails()
 	ds = append(ds, apiserver.AdminFacadeDetails()...)
+	// Sort the facades so that they are written
+	// in canonical order.
+	sort.Slice(ds, func(i, j int) bool {
+		if ds[i].Name != ds[j].Name {
+			return ds[i].Name < ds[j].Name
+		}
+		return ds[i].Version < ds[j].Version
+	})
+	start := time.Now()
+	wireTypes := make(map[reflect.Type]bool)
 	for _, d := range ds {
 		t := rpcreflect.ObjTypeOf(d.Type)
-
 		for _, name := range t.MethodNames() {
 			m, _ := t.Method(name)
 			if m.Params != nil {
-				info.TypeInfo(m.Params)
+				wireTypes[m.Params] = true
 			}
 			if m.Result != nil {
-				info.TypeInfo(m.Result)
+				wireTypes[m.Result] = true
 			}
 		}
 	}
-	apiInfo := &apidoc.Info{
+	pkg, err := loadPackages(ds, wireTypes)
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	stats.AddStage("load packages", start)
+	start = time.Now()
+	info := jsontypes.NewInfo()
+	for _, t := range sortedTypes(wireTypes) {
+		info.TypeInfo(t)
+	}
+	// Check the output as we go to catch
+	// generator regressions early.
+	typesOnly := &apidoc.Info{
 		TypeInfo: info,
 	}
+	if err := apidoc.Validate(typesOnly); err != nil {
+		return nil, errgo.Mask(err)
+	}
+	w.field("SchemaVersion", apidoc.CurrentSchemaVersion, 1)
+	meta, err := generationMeta()
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	w.field("Meta", meta, 1)
+	w.typeInfo(info)
+	stats.AddStage("type info", start)
+	start = time.Now()
+	w.startFacades()
+	apiInfo := &apidoc.Info{}
+	versions := &apidoc.Info{}
+	if *checkpointDir != "" {
+		if err := os.MkdirAll(*checkpointDir, 0777); err != nil {
+			return nil, errgo.Mask(err)
+		}
+	}
+	if *debugDumpDir != "" {
+		if err := os.MkdirAll(*debugDumpDir, 0777); err != nil {
+			return nil, errgo.Mask(err)
+		}
+	}
+	auditExcluded := auditExcludedMethods(pkg)
+	regs, err := conditionalRegistrations(pkg, ds)
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot check for conditionally registered facades")
+	}
+	// The quickstart and example params are sampled from
+	// the schemas of all the wire types, which are known by now.
+	defs := typesOnly.SchemaDefinitions()
+	apiInfo.AuditExcluded = sortedNames(auditExcluded)
+	n := 0
+	// A facade that cannot be documented is recorded in
+	// FacadeErrors and left out rather than stopping the
+	// run, so that one broken facade doesn't cost the
+	// documentation for all the others.
+	addError := func(f apidoc.FacadeInfo, err error) {
+		apiInfo.FacadeErrors = append(apiInfo.FacadeErrors, apidoc.FacadeError{
+			Facade:  f.Name,
+			Version: f.Version,
+			Message: err.Error(),
+		})
+	}
+	details := make(map[facadeID]facade.Details)
 	for _, d := range ds {
-		f := apidoc.FacadeInfo{
-			Name:        d.Name,
-			Version:     d.Version,
-			AvailableTo: availableTo(d.Name, d.Factory),
+		details[facadeID{d.Name, d.Version}] = d
+	}
+	// Packages that failed to load, often because of unusual
+	// build tags, only affect the facades that use them, so
+	// document the others as usual.
+	loadErrs := packageLoadErrors(pkg)
+	if errs := loadErrs[serverPkg]; len(errs) > 0 {
+		log.Printf("warning: %s loaded with errors; the facades that use the packages involved will be left out: %v", serverPkg, errs[0])
+	}
+	loaded := make([]facade.Details, 0, len(ds))
+	for _, d := range ds {
+		if err := facadeLoadError(d, loadErrs); err != nil {
+			addError(apidoc.FacadeInfo{Name: d.Name, Version: d.Version}, errgo.Notef(err, "facade %s(%d)", d.Name, d.Version))
+			continue
 		}
-		pt, err := progType(pkg, d.Type)
-		if err != nil {
-			return nil, errgo.Notef(err, "cannot get prog type for %v", d.Type)
+		loaded = append(loaded, d)
+	}
+	err = processFacades(pkg, info, loaded, func(r facadeResult) error {
+		if r.err != nil {
+			addError(r.facade, r.err)
+			return nil
 		}
-		tdoc, err := typeDocComment(pkg, pt)
-		if err != nil {
-			return nil, errgo.Notef(err, "cannot get doc comment for %v: %v", d.Type)
+		if err := checkMethods(details[facadeID{r.facade.Name, r.facade.Version}], &r.facade); err != nil {
+			addError(r.facade, err)
+			return nil
 		}
-		f.Doc = tdoc
-		t := rpcreflect.ObjTypeOf(d.Type)
-		for _, name := range t.MethodNames() {
-			m, _ := t.Method(name)
-			fm := apidoc.Method{
-				Name: name,
-			}
-			if m.Params != nil {
-				fm.Param = info.Ref(m.Params)
-			}
-			if m.Result != nil {
-				fm.Result = info.Ref(m.Result)
-			}
-			mdoc, err := methodDocComment(pkg, pt, name)
-			if err != nil {
-				return nil, errgo.Notef(err, "cannot get doc comment for %v.%v: %v", d.Type, name)
+		for i := range r.facade.Methods {
+			m := &r.facade.Methods[i]
+			m.AuditExcluded = auditExcluded[r.facade.Name+"."+m.Name]
+		}
+		id := facadeID{r.facade.Name, r.facade.Version}
+		if reason, ok := regs.testOnly[id]; ok {
+			r.facade.TestOnly, r.facade.TestOnlyReason = true, reason
+		}
+		if flag, ok := regs.featureFlags[id]; ok && r.facade.HasTag(secretsTag) {
+			r.facade.Requires = append(r.facade.Requires, apidoc.Requirement{
+				Kind:  apidoc.RequirementFeatureFlag,
+				Value: flag,
+			})
+		}
+		r.facade.Canonicalize()
+		r.facade.Quickstart = typesOnly.Quickstart(&r.facade, defs)
+		for i := range r.facade.Methods {
+			m := &r.facade.Methods[i]
+			if e := typesOnly.SampleExample(m, defs); e != nil {
+				m.Examples = append(m.Examples, *e)
 			}
-			fm.Doc = mdoc
-			f.Methods = append(f.Methods, fm)
 		}
-		apiInfo.Facades = append(apiInfo.Facades, f)
+		if err := typesOnly.ValidateFacade(&r.facade); err != nil {
+			addError(r.facade, errgo.Notef(err, "facade %s(%d)", r.facade.Name, r.facade.Version))
+			return nil
+		}
+		w.facade(n, r.facade)
+		n++
+		stats.Methods += len(r.facade.Methods)
+		// Only the facade names, versions and methods
+		// are needed for the negotiation table, the
+		// type families and the sentinel errors.
+		versions.Facades = append(versions.Facades, apidoc.FacadeInfo{
+			Name:    r.facade.Name,
+			Version: r.facade.Version,
+			Methods: r.facade.Methods,
+		})
+		apiInfo.Warnings = append(apiInfo.Warnings, r.warnings...)
+		if *strict {
+			apiInfo.Warnings = append(apiInfo.Warnings, undocumentedWarnings(r.facade)...)
+		}
+		apiInfo.Fields = append(apiInfo.Fields, r.fields...)
+		return nil
+	})
+	if err != nil {
+		return nil, errgo.Mask(err)
+	}
+	w.endFacades()
+	stats.AddStage("facades", start)
+	start = time.Now()
+	stats.Facades = n
+	stats.Types = len(info.Types)
+	if err := checkFacades(ds, versions.Facades, apiInfo.FacadeErrors); err != nil {
+		return nil, errgo.Mask(err)
+	}
+	// Mark the presence of all the fields that
+	// the facade code said nothing about.
+	marked := &apidoc.Info{
+		TypeInfo: info,
+		Fields:   apiInfo.Fields,
+	}
+	marked.MarkPresence()
+	apiInfo.Fields = marked.Fields
+	codes, err := errorCodes(pkg)
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot get error codes")
+	}
+	apiInfo.ErrorCodes = codes
+	apiInfo.SentinelErrors = sentinelErrors(pkg, referencedErrors(versions.Facades))
+	apiInfo.Operational = operationalSettings(pkg)
+	for _, p := range checkRoundTrips(wireTypes) {
+		apiInfo.Warnings = append(apiInfo.Warnings, apidoc.Warning{
+			Kind:    "round-trip",
+			Type:    typeName(p.Type),
+			Message: fmt.Sprintf("%v: %s", p.Type, p.Problem),
+		})
+	}
+	apiInfo.Warnings = append(apiInfo.Warnings, unserializableFields(pkg, wireTypes)...)
+	platformConditional, err := platformWarnings(pkg, ds)
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot check for platform-conditional facades")
 	}
+	apiInfo.Warnings = append(apiInfo.Warnings, platformConditional...)
+	// Facades that timed out may still be running
+	// and recording panics, so take a copy.
+	stateMu.Lock()
+	apiInfo.FactoryPanics = append([]apidoc.FactoryPanic(nil), factoryPanics...)
+	stateMu.Unlock()
+	apiInfo.Advertised = advertisedFacades(ds)
+	apiInfo.Negotiation = versions.NegotiationTable()
+	versions.TypeInfo = info
+	apiInfo.TypeFamilies = versions.FindTypeFamilies()
+	apiInfo.Canonicalize()
+	stats.AddStage("analysis", start)
 	return apiInfo, nil
 }
 
+const serverPkg = "github.com/juju/juju/apiserver"
+
+// loadPackages loads the apiserver package. Only the packages that
+// declare facade and wire types (and the params package, which
+// declares the error codes, and the server errors package, which
+// maps errors to them) are loaded with full syntax and type
+// information; other dependencies are loaded from export data and
+// their source is parsed on demand by findDeclPackage.
+func loadPackages(ds []facade.Details, wireTypes map[reflect.Type]bool) (*packages.Package, error) {
+	paths := map[string]bool{
+		serverPkg:       true,
+		paramsPkg:       true,
+		serverErrorsPkg: true,
+	}
+	addPath := func(t reflect.Type) {
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.PkgPath() != "" {
+			paths[t.PkgPath()] = true
+		}
+	}
+	for _, d := range ds {
+		addPath(d.Type)
+	}
+	for t := range wireTypes {
+		addPath(t)
+	}
+	patterns := make([]string, 0, len(paths))
+	for path := range paths {
+		patterns = append(patterns, path)
+	}
+	sort.Strings(patterns)
+	cfg := packages.Config{
+		Mode: packages.LoadSyntax,
+		Fset: token.NewFileSet(),
+		ParseFile: func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+			return parser.ParseFile(fset, filename, src, parser.ParseComments)
+		},
+	}
+	pkgs, err := packages.Load(&cfg, patterns...)
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot load %q", serverPkg)
+	}
+	for _, pkg := range pkgs {
+		if pkg.PkgPath == serverPkg {
+			return pkg, nil
+		}
+	}
+	return nil, errgo.Newf("packages.Load did not return %q", serverPkg)
+}
+
+// facadeInfo returns information on the facade with the given
+// details, along with the constraints that its methods apply
+// to fields and any warnings found when examining it.
+func facadeInfo(pkg *packages.Package, info *jsontypes.Info, d facade.Details) (apidoc.FacadeInfo, []apidoc.FieldInfo, []apidoc.Warning, error) {
+	f := apidoc.FacadeInfo{
+		Name:        d.Name,
+		Version:     d.Version,
+		AvailableTo: availableTo(d),
+	}
+	f.Audiences = apidoc.AudiencesOf(f.AvailableTo)
+	f.LoginTarget = &apidoc.LoginTarget{
+		Controller: apiserver.IsControllerFacade(d.Name),
+		Model:      apiserver.IsModelFacade(d.Name),
+	}
+	ft := d.Type
+	if ft.Kind() == reflect.Ptr {
+		ft = ft.Elem()
+	}
+	f.Tags = apidoc.FacadeTags(d.Name, ft.PkgPath(), f.Audiences)
+	pt, err := progType(pkg, d.Type)
+	if err != nil {
+		return f, nil, nil, errgo.Notef(err, "cannot get prog type for %v", d.Type)
+	}
+	tdoc, err := typeDocComment(pkg, pt)
+	if err != nil {
+		return f, nil, nil, errgo.Notef(err, "cannot get doc comment for %v", d.Type)
+	}
+	f.Doc = tdoc
+	f.Source = sourcePos(pkg, pt)
+	t := rpcreflect.ObjTypeOf(d.Type)
+	var fields []apidoc.FieldInfo
+	for _, name := range t.MethodNames() {
+		m, _ := t.Method(name)
+		fm := apidoc.Method{
+			Name: name,
+		}
+		// The jsontypes.Info is shared between facades and
+		// isn't safe for concurrent use.
+		stateMu.Lock()
+		if m.Params != nil {
+			fm.Param = info.Ref(m.Params)
+		}
+		if m.Result != nil {
+			fm.Result = info.Ref(m.Result)
+			fm.PerItemErrors = (&apidoc.Info{TypeInfo: info}).HasPerItemErrors(fm.Result)
+		}
+		stateMu.Unlock()
+		mdoc, err := methodDocComment(pkg, pt, name)
+		if err != nil {
+			return f, nil, nil, errgo.Notef(err, "cannot get doc comment for %v.%v", d.Type, name)
+		}
+		fm.Doc = mdoc
+		if obj, err := methodObj(pt, name); err == nil {
+			fm.Source = sourcePos(pkg, obj)
+		}
+		fm.Retry = retryClass(pkg, pt, name)
+		access := methodAccessChecks(pkg, pt, name)
+		fm.Superuser = access.superuserCheck()
+		fm.Permissions = access.permissions()
+		if f.HasTag(secretsTag) {
+			fm.Requires = methodRequirements(pkg, pt, name)
+		}
+		fm.ResultOrder = resultOrder(pkg, pt, name, m.Params, m.Result)
+		fm.Errors = methodErrors(pkg, pt, name)
+		stateMu.Lock()
+		fm.Invocation = (&apidoc.Info{TypeInfo: info}).Invocation(&f, &fm)
+		fm.Sensitive, fm.SensitiveReason = (&apidoc.Info{TypeInfo: info}).Sensitivity(&f, &fm)
+		stateMu.Unlock()
+		f.Methods = append(f.Methods, fm)
+		fields = append(fields, fieldConstraints(pkg, info, f, pt, name)...)
+	}
+	if isLeaseFacade(d.Name, ft.PkgPath()) {
+		f.Leases = leaseParameters(pkg, pt, t.MethodNames())
+	}
+	var warnings []apidoc.Warning
+	warnings = append(warnings, permissionWarnings(pkg, f, pt)...)
+	warnings = append(warnings, exampleWarnings(f)...)
+	warnings = append(warnings, resultOrderWarnings(f)...)
+	return f, fields, warnings, nil
+}
+
+// errorCodes returns all the error codes defined as Code* constants
+// in the params package.
+func errorCodes(pkg *packages.Package) ([]apidoc.ErrorCode, error) {
+	found := findPackage(pkg, paramsPkg)
+	if found == nil {
+		return nil, errgo.Newf("cannot find %q in imported code", paramsPkg)
+	}
+	var codes []apidoc.ErrorCode
+	scope := found.Types.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !strings.HasPrefix(name, "Code") || c.Val().Kind() != constant.String {
+			continue
+		}
+		doc, err := valueDocComment(found, c)
+		if err != nil {
+			return nil, errgo.Notef(err, "cannot get doc comment for %v", name)
+		}
+		codes = append(codes, apidoc.ErrorCode{
+			Name: name,
+			Code: constant.StringVal(c.Val()),
+			Doc:  doc,
+		})
+	}
+	return codes, nil
+}
+
 var tmplFuncs = template.FuncMap{
 	"typeLink": func(t *jsontypes.Type) template.HTML {
 		if t == nil {
@@ -135,7 +553,9 @@ var tmplFuncs = template.FuncMap{
 	},
 }
 
-func methodDocComment(pkg *packages.Package, tname *types.TypeName, methodName string) (string, error) {
+// methodObj returns the object for the given method
+// of the given type.
+func methodObj(tname *types.TypeName, methodName string) (types.Object, error) {
 	t := tname.Type()
 	if !types.IsInterface(t) {
 		// Use the pointer type to get as many methods as possible.
@@ -145,9 +565,24 @@ func methodDocComment(pkg *packages.Package, tname *types.TypeName, methodName s
 	mset := types.NewMethodSet(t)
 	sel := mset.Lookup(nil, methodName)
 	if sel == nil {
-		return "", errgo.Newf("cannot find method %v on %v", methodName, t)
+		return nil, errgo.Newf("cannot find method %v on %v", methodName, t)
+	}
+	return sel.Obj(), nil
+}
+
+func methodDocComment(pkg *packages.Package, tname *types.TypeName, methodName string) (string, error) {
+	obj, err := methodObj(tname, methodName)
+	if err != nil {
+		return "", errgo.Mask(err)
 	}
-	obj := sel.Obj()
+	// Methods promoted from embedded types are shared by many
+	// facades, so only look up each one once.
+	return methodDocs.get(obj, func() (string, error) {
+		return methodObjDocComment(pkg, obj)
+	})
+}
+
+func methodObjDocComment(pkg *packages.Package, obj types.Object) (string, error) {
 	decl, err := findDecl(pkg, obj.Pos())
 	if err != nil {
 		return "", errgo.Mask(err)
@@ -162,7 +597,7 @@ func methodDocComment(pkg *packages.Package, tname *types.TypeName, methodName s
 			it := tspec.Type.(*ast.InterfaceType)
 			for _, m := range it.Methods.List {
 				for _, id := range m.Names {
-					if id.Pos() == obj.Pos() {
+					if samePos(pkg.Fset, id.Pos(), obj.Pos()) {
 						return m.Doc.Text(), nil
 					}
 				}
@@ -170,7 +605,7 @@ func methodDocComment(pkg *packages.Package, tname *types.TypeName, methodName s
 		}
 		return "", errgo.Newf("method definition not found in type")
 	case *ast.FuncDecl:
-		if decl.Name.Pos() != obj.Pos() {
+		if !samePos(pkg.Fset, decl.Name.Pos(), obj.Pos()) {
 			return "", errgo.Newf("method definition not found (at %#v)", pkg.Fset.Position(obj.Pos()))
 		}
 		return decl.Doc.Text(), nil
@@ -179,6 +614,38 @@ func methodDocComment(pkg *packages.Package, tname *types.TypeName, methodName s
 	}
 }
 
+// valueDocComment returns the doc comment for the given
+// package-level constant or variable.
+func valueDocComment(pkg *packages.Package, obj types.Object) (string, error) {
+	decl, err := findDecl(pkg, obj.Pos())
+	if err != nil {
+		return "", errgo.Mask(err)
+	}
+	gdecl, ok := decl.(*ast.GenDecl)
+	if !ok || (gdecl.Tok != token.CONST && gdecl.Tok != token.VAR) {
+		return "", errgo.Newf("found non-value decl %#v", decl)
+	}
+	for _, spec := range gdecl.Specs {
+		vspec := spec.(*ast.ValueSpec)
+		for _, id := range vspec.Names {
+			if !samePos(pkg.Fset, id.Pos(), obj.Pos()) {
+				continue
+			}
+			if vspec.Doc != nil {
+				return vspec.Doc.Text(), nil
+			}
+			if vspec.Comment != nil {
+				return vspec.Comment.Text(), nil
+			}
+			if len(gdecl.Specs) == 1 {
+				return gdecl.Doc.Text(), nil
+			}
+			return "", nil
+		}
+	}
+	return "", errgo.Newf("cannot find value declaration")
+}
+
 func typeDocComment(pkg *packages.Package, t *types.TypeName) (string, error) {
 	decl, err := findDecl(pkg, t.Pos())
 	if err != nil {
@@ -190,7 +657,7 @@ func typeDocComment(pkg *packages.Package, t *types.TypeName) (string, error) {
 	}
 	for _, spec := range tdecl.Specs {
 		tspec := spec.(*ast.TypeSpec)
-		if tspec.Name.Pos() == t.Pos() {
+		if samePos(pkg.Fset, tspec.Name.Pos(), t.Pos()) {
 			if tspec.Doc != nil {
 				return tspec.Doc.Text(), nil
 			}
@@ -203,32 +670,102 @@ func typeDocComment(pkg *packages.Package, t *types.TypeName) (string, error) {
 // findDecl returns the top level declaration that contains the
 // given position.
 func findDecl(pkg *packages.Package, pos token.Pos) (ast.Decl, error) {
+	decl, _, err := findDeclPackage(pkg, pos)
+	return decl, err
+}
+
+// findDeclPackage is like findDecl but also returns
+// the package containing the declaration.
+//
+// If the declaration is in a package that was loaded without
+// syntax, its source file is parsed on demand; in that case the
+// returned package holds only that file and has no type information.
+//
+// Declarations are cached by position, so each is
+// only searched for once.
+func findDeclPackage(pkg *packages.Package, pos token.Pos) (ast.Decl, *packages.Package, error) {
+	return decls.get(pos, func() (ast.Decl, *packages.Package, error) {
+		return lookupDecl(pkg, pos)
+	})
+}
+
+func lookupDecl(pkg *packages.Package, pos token.Pos) (ast.Decl, *packages.Package, error) {
 	tokFile := pkg.Fset.File(pos)
 	if tokFile == nil {
-		return nil, errgo.Newf("no file found for object")
+		return nil, nil, errgo.Newf("no file found for object")
 	}
 	filename := tokFile.Name()
-	var found ast.Decl
-	packages.Visit([]*packages.Package{pkg}, func(pkg *packages.Package) bool {
-		for _, f := range pkg.Syntax {
-			if tokFile := pkg.Fset.File(f.Pos()); tokFile == nil || tokFile.Name() != filename {
-				continue
-			}
-			// We've found the file we're looking for. Now traverse all
-			// top level declarations looking for the right function declaration.
-			for _, decl := range f.Decls {
-				if decl.Pos() <= pos && pos <= decl.End() {
-					found = decl
-					return false
-				}
-			}
+	if f, ok := indexPackages(pkg).files[filename]; ok {
+		// We've found the file we're looking for. Top level
+		// declarations are in source order, so search them
+		// for the first one that ends at or after pos.
+		decls := f.file.Decls
+		i := sort.Search(len(decls), func(i int) bool {
+			return decls[i].End() >= pos
+		})
+		if i < len(decls) && decls[i].Pos() <= pos {
+			return decls[i], f.pkg, nil
 		}
+		return nil, nil, errgo.Newf("declaration not found")
+	}
+	f, err := parseOnDemand(pkg.Fset, filename)
+	if err != nil {
+		return nil, nil, errgo.Mask(err)
+	}
+	// Positions from export data are only accurate to the line,
+	// so look for the declaration by line number.
+	line := pkg.Fset.Position(pos).Line
+	i := sort.Search(len(f.Decls), func(i int) bool {
+		return pkg.Fset.Position(f.Decls[i].End()).Line >= line
+	})
+	if i < len(f.Decls) && pkg.Fset.Position(f.Decls[i].Pos()).Line <= line {
+		return f.Decls[i], &packages.Package{
+			Name:   f.Name.Name,
+			Fset:   pkg.Fset,
+			Syntax: []*ast.File{f},
+		}, nil
+	}
+	return nil, nil, errgo.Newf("declaration not found")
+}
+
+var (
+	parsedMu sync.Mutex
+	// parsed holds the files parsed by parseOnDemand,
+	// keyed by file name.
+	parsed = make(map[string]*ast.File)
+	// parsedHits and parsedMisses count the calls to
+	// parseOnDemand, for -stats.
+	parsedHits, parsedMisses int
+)
+
+// parseOnDemand parses the named file into fset, returning
+// the same result each time it is called for a given file.
+func parseOnDemand(fset *token.FileSet, filename string) (*ast.File, error) {
+	parsedMu.Lock()
+	defer parsedMu.Unlock()
+	if f := parsed[filename]; f != nil {
+		parsedHits++
+		return f, nil
+	}
+	parsedMisses++
+	f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		return nil, errgo.Notef(err, "cannot parse source for declaration")
+	}
+	parsed[filename] = f
+	return f, nil
+}
+
+// samePos reports whether p0 and p1 refer to the same place
+// in the source. Positions in packages loaded from export
+// data are only accurate to the line, so only the file name
+// and line are compared.
+func samePos(fset *token.FileSet, p0, p1 token.Pos) bool {
+	if p0 == p1 {
 		return true
-	}, nil)
-	if found == nil {
-		return nil, errgo.Newf("declaration not found")
 	}
-	return found, nil
+	pos0, pos1 := fset.Position(p0), fset.Position(p1)
+	return pos0.Filename == pos1.Filename && pos0.Line == pos1.Line
 }
 
 // progType returns the go/types type for the given reflect.Type,
@@ -237,6 +774,12 @@ func progType(pkg *packages.Package, t reflect.Type) (*types.TypeName, error) {
 	if t.Kind() == reflect.Ptr {
 		t = t.Elem()
 	}
+	return progTypes.get(t, func() (*types.TypeName, error) {
+		return lookupProgType(pkg, t)
+	})
+}
+
+func lookupProgType(pkg *packages.Package, t reflect.Type) (*types.TypeName, error) {
 	typeName := t.Name()
 	if typeName == "" {
 		return nil, errgo.Newf("type %s is not named", t)
@@ -246,14 +789,7 @@ func progType(pkg *packages.Package, t reflect.Type) (*types.TypeName, error) {
 		// TODO could return types.Basic type here if we needed to.
 		return nil, errgo.Newf("type %s not declared in package", t)
 	}
-	var found *packages.Package
-	packages.Visit([]*packages.Package{pkg}, func(pkg *packages.Package) bool {
-		if pkg.PkgPath == pkgPath {
-			found = pkg
-			return false
-		}
-		return true
-	}, nil)
+	found := findPackage(pkg, pkgPath)
 	if found == nil {
 		return nil, errgo.Newf("cannot find %q in imported code", pkgPath)
 	}
@@ -269,10 +805,35 @@ func progType(pkg *packages.Package, t reflect.Type) (*types.TypeName, error) {
 	return objTypeName, nil
 }
 
-func availableTo(facadeName string, factory facade.Factory) []string {
+// sourcePos returns where obj is declared, relative to the root
+// of the juju module, or nil if it's declared elsewhere.
+func sourcePos(pkg *packages.Package, obj types.Object) *apidoc.SourcePos {
+	if obj.Pkg() == nil || !strings.HasPrefix(obj.Pkg().Path()+"/", jujuPkgPrefix) {
+		return nil
+	}
+	pos := pkg.Fset.Position(obj.Pos())
+	if !pos.IsValid() {
+		return nil
+	}
+	// The package path below the module
+	// gives the file's directory.
+	dir := strings.TrimPrefix(obj.Pkg().Path()+"/", jujuPkgPrefix)
+	return &apidoc.SourcePos{
+		File: path.Join(dir, filepath.Base(pos.Filename)),
+		Line: pos.Line,
+	}
+}
+
+// findPackage returns the package with the given path
+// from the dependencies of pkg, or nil if it's not found.
+func findPackage(pkg *packages.Package, pkgPath string) *packages.Package {
+	return indexPackages(pkg).packages[pkgPath]
+}
+
+func availableTo(d facade.Details) []string {
 	var a []string
 	for i, kindStr := range kinds {
-		if isAvailable(facadeName, factory, entityKind(i)) {
+		if isAvailable(d, entityKind(i)) {
 			a = append(a, kindStr)
 		}
 	}
@@ -280,12 +841,31 @@ func availableTo(facadeName string, factory facade.Factory) []string {
 }
 
 var (
-	allFacadeNames = make(map[string]bool)
-	panicked       = make(map[string]bool)
+	// stateMu guards the variables below, which are
+	// updated concurrently by the facade workers.
+	// It also guards use of the shared jsontypes.Info.
+	stateMu sync.Mutex
+
+	allFacades = make(map[facadeID]bool)
+	panicked   = make(map[facadeID]bool)
+
+	// factoryAuthCalls records, for each facade version, the
+	// names of the authorizer methods called by its factory.
+	factoryAuthCalls = make(map[facadeID]map[string]bool)
+
+	// factoryPanics records all the panics recovered
+	// from facade factories.
+	factoryPanics []apidoc.FactoryPanic
+
+	// checkpointHits and checkpointMisses count the facades
+	// whose saved results were and weren't reused, for -stats.
+	checkpointHits, checkpointMisses int
 )
 
-func isAvailable(facadeName string, factory facade.Factory, kind entityKind) (ok bool) {
-	if factory == nil {
+func isAvailable(d facade.Details, kind entityKind) (ok bool) {
+	facadeName := d.Name
+	id := facadeID{d.Name, d.Version}
+	if d.Factory == nil {
 		// Admin facade only.
 		return true
 	}
@@ -295,23 +875,36 @@ func isAvailable(facadeName string, factory facade.Factory, kind entityKind) (ok
 	if kind == kindModelUser && !apiserver.IsModelFacade(facadeName) {
 		return false
 	}
-	allFacadeNames[facadeName] = true
+	stateMu.Lock()
+	allFacades[id] = true
+	if factoryAuthCalls[id] == nil {
+		factoryAuthCalls[id] = make(map[string]bool)
+	}
+	calls := factoryAuthCalls[id]
+	stateMu.Unlock()
 	defer func() {
 		err := recover()
 		if err == nil {
 			return
 		}
 		//log.Printf("panic on facade %q, role %v (%v): %s", facadeName, kind, err, debug.Callers(0, 30))
-		panicked[facadeName] = true
+		stateMu.Lock()
+		defer stateMu.Unlock()
+		panicked[id] = true
+		factoryPanics = append(factoryPanics, apidoc.FactoryPanic{
+			Facade:     facadeName,
+			Version:    d.Version,
+			EntityKind: kind.String(),
+			Message:    fmt.Sprint(err),
+		})
 		ok = true
 	}()
-	ctx := context{
-		auth: authorizer{
-			kind: kind,
-		},
+	auth := authorizer{
+		kind:  kind,
+		calls: calls,
 	}
-	_, err := factory(ctx)
-	return errors.Cause(err) != common.ErrPerm
+	err := callFactory(d, auth)
+	return !isPermissionError(errors.Cause(err))
 }
 
 type entityKind int
@@ -336,68 +929,39 @@ var kinds = []string{
 	kindModelUser:         "model-user",
 }
 
-type context struct {
-	auth authorizer
-	facade.Context
-}
-
-func (c context) Auth() facade.Authorizer {
-	return c.auth
-}
-
-func (c context) ID() string {
-	return ""
-}
-
-func (c context) State() *state.State {
-	return new(state.State)
-}
-
-func (c context) Resources() facade.Resources {
-	return nil
-}
-
-func (c context) StatePool() *state.StatePool {
-	return new(state.StatePool)
-}
-
-func (c context) ControllerTag() names.ControllerTag {
-	return names.NewControllerTag("xxxx")
-}
-
 type authorizer struct {
 	facade.Authorizer
 	kind entityKind
+
+	// calls records the names of the authorizer
+	// methods that have been called.
+	calls map[string]bool
 }
 
-func (a authorizer) AuthController() bool {
-	return a.kind == kindControllerMachine
+func (a authorizer) called(name string) {
+	if a.calls != nil {
+		stateMu.Lock()
+		a.calls[name] = true
+		stateMu.Unlock()
+	}
 }
 
-func (a authorizer) HasPermission(operation permission.Access, target names.Tag) (bool, error) {
-	return true, nil
+func (a authorizer) AuthController() bool {
+	a.called("AuthController")
+	return a.kind == kindControllerMachine
 }
 
 func (a authorizer) AuthMachineAgent() bool {
+	a.called("AuthMachineAgent")
 	return a.kind == kindMachineAgent || a.kind == kindControllerMachine
 }
 
 func (a authorizer) AuthUnitAgent() bool {
+	a.called("AuthUnitAgent")
 	return a.kind == kindUnitAgent
 }
 
 func (a authorizer) AuthClient() bool {
+	a.called("AuthClient")
 	return a.kind == kindControllerUser || a.kind == kindModelUser
 }
-
-func (a authorizer) GetAuthTag() names.Tag {
-	switch a.kind {
-	case kindControllerUser, kindModelUser:
-		return names.NewUserTag("bob")
-	case kindUnitAgent:
-		return names.NewUnitTag("xx/0")
-	case kindMachineAgent, kindControllerMachine:
-		return names.NewMachineTag("0")
-	}
-	panic("unknown kind")
-}
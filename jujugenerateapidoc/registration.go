@@ -0,0 +1,215 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/juju/juju/apiserver/facade"
+
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/errgo.v1"
+)
+
+// registrations records how facades are registered
+// conditionally. See conditionalRegistrations.
+type registrations struct {
+	// testOnly maps the facades that exist only for testing
+	// to the reason why: those implemented in a test support
+	// package, those registered from one, and those registered
+	// only when a testing feature flag, such as developer-mode,
+	// is enabled. Production controllers never serve them.
+	testOnly map[facadeID]string
+
+	// featureFlags maps the facades registered only when a
+	// feature flag is enabled to the flag, as found in the
+	// source, such as "feature.Secrets".
+	featureFlags map[facadeID]string
+}
+
+// conditionalRegistrations returns how the facades in ds, and any
+// others registered by the juju packages used by pkg, are registered
+// conditionally.
+//
+// As with platformWarnings, the juju sources are inspected, because
+// the registrations that are made depend on how the generator runs.
+func conditionalRegistrations(pkg *packages.Package, ds []facade.Details) (*registrations, error) {
+	r := &registrations{
+		testOnly:     make(map[facadeID]string),
+		featureFlags: make(map[facadeID]string),
+	}
+	for _, d := range ds {
+		t := d.Type
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if isTestSupportPackage(t.PkgPath()) {
+			r.testOnly[facadeID{d.Name, d.Version}] = fmt.Sprintf("implemented in test support package %s", t.PkgPath())
+		}
+	}
+	seen := make(map[string]bool)
+	var visit func(p *packages.Package) error
+	visit = func(p *packages.Package) error {
+		if seen[p.PkgPath] {
+			return nil
+		}
+		seen[p.PkgPath] = true
+		if p.PkgPath != serverPkg && !strings.HasPrefix(p.PkgPath, jujuPkgPrefix) {
+			return nil
+		}
+		if len(p.GoFiles) > 0 {
+			if err := r.addPackage(p.PkgPath, filepath.Dir(p.GoFiles[0])); err != nil {
+				return errgo.Mask(err)
+			}
+		}
+		for _, ip := range p.Imports {
+			if err := visit(ip); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := visit(pkg); err != nil {
+		return nil, errgo.Mask(err)
+	}
+	return r, nil
+}
+
+// addPackage records the conditional registrations made by
+// the package with the given path in dir.
+func (r *registrations) addPackage(pkgPath, dir string) error {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return errgo.Mask(err)
+	}
+	testPkg := isTestSupportPackage(pkgPath)
+	fset := token.NewFileSet()
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		src, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		// Most files can't make conditional
+		// registrations, so don't parse them.
+		if !testPkg && !bytes.Contains(src, []byte("featureflag")) && !bytes.Contains(src, []byte("ForFeature")) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), src, 0)
+		if err != nil {
+			return errgo.Mask(err)
+		}
+		// mark adds the facades registered within n to
+		// the given map, keeping the first value found.
+		mark := func(n ast.Node, m map[facadeID]string, value string) {
+			ast.Inspect(n, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok || len(call.Args) < 2 || !registerFuncs[funcName(call.Fun)] {
+					return true
+				}
+				name, version, ok := registration(call)
+				if !ok {
+					return true
+				}
+				id := facadeID{name, version}
+				if _, ok := m[id]; !ok {
+					m[id] = value
+				}
+				return true
+			})
+		}
+		if testPkg {
+			mark(f, r.testOnly, fmt.Sprintf("registered by test support package %s (%s)", pkgPath, name))
+			continue
+		}
+		flagged := func(n ast.Node, flag string) {
+			mark(n, r.featureFlags, flag)
+			if isTestFlag(flag) {
+				mark(n, r.testOnly, fmt.Sprintf("registered only when the %s feature flag is enabled (%s)", flag, name))
+			}
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.IfStmt:
+				if flag, ok := enabledFlag(n.Cond); ok {
+					flagged(n.Body, flag)
+				}
+			case *ast.CallExpr:
+				if funcName(n.Fun) == "RegisterStandardFacadeForFeature" && len(n.Args) >= 4 {
+					flagged(n, types.ExprString(n.Args[3]))
+				}
+			}
+			return true
+		})
+	}
+	return nil
+}
+
+// enabledFlag reports whether cond checks that a feature flag
+// is enabled, by calling featureflag.Enabled, and returns the
+// flag, as found in the source, if so.
+func enabledFlag(cond ast.Expr) (string, bool) {
+	var flag string
+	found := false
+	ast.Inspect(cond, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if u, ok := n.(*ast.UnaryExpr); ok && u.Op == token.NOT {
+			// Registering when a flag is disabled
+			// is the opposite of what's looked for.
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Enabled" {
+			return true
+		}
+		if x, ok := sel.X.(*ast.Ident); !ok || x.Name != "featureflag" {
+			return true
+		}
+		flag, found = types.ExprString(call.Args[0]), true
+		return false
+	})
+	return flag, found
+}
+
+// isTestFlag reports whether the feature flag with the given
+// source, such as feature.DeveloperMode or "developer-mode",
+// is only used for testing.
+func isTestFlag(flag string) bool {
+	if s, err := strconv.Unquote(flag); err == nil {
+		flag = s
+	}
+	flag = strings.ToLower(flag)
+	return strings.Contains(flag, "test") || strings.Contains(flag, "developer")
+}
+
+// isTestSupportPackage reports whether the package with the given
+// path holds support code for tests, such as juju/juju/testing or
+// juju/juju/apiserver/testing, rather than production code.
+func isTestSupportPackage(pkgPath string) bool {
+	if !strings.HasPrefix(pkgPath, jujuPkgPrefix) {
+		return false
+	}
+	for _, elem := range strings.Split(strings.TrimPrefix(pkgPath, jujuPkgPrefix), "/") {
+		if strings.HasSuffix(elem, "testing") || strings.HasSuffix(elem, "_test") || elem == "mocks" || elem == "testhelpers" {
+			return true
+		}
+	}
+	return false
+}
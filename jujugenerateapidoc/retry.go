@@ -0,0 +1,233 @@
+package main
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"golang.org/x/tools/go/packages"
+)
+
+// maxMutationCallDepth holds the maximum depth of calls that
+// will be followed when looking for state mutations.
+const maxMutationCallDepth = 4
+
+const statePkg = "github.com/juju/juju/state"
+
+// readVerbs holds the first words of method names that
+// conventionally don't change anything.
+var readVerbs = map[string]bool{
+	"Get":      true,
+	"List":     true,
+	"Read":     true,
+	"Watch":    true,
+	"Find":     true,
+	"Check":    true,
+	"Life":     true,
+	"Status":   true,
+	"Info":     true,
+	"Current":  true,
+	"Ping":     true,
+	"Show":     true,
+	"Validate": true,
+	"Is":       true,
+	"Has":      true,
+	"Can":      true,
+}
+
+// convergentVerbs holds the first words of method names that
+// conventionally move things to a given state, so calling them
+// again has no further effect.
+var convergentVerbs = map[string]bool{
+	"Set":     true,
+	"Ensure":  true,
+	"Update":  true,
+	"Remove":  true,
+	"Destroy": true,
+	"Delete":  true,
+	"Clear":   true,
+	"Unset":   true,
+	"Enable":  true,
+	"Disable": true,
+	"Finish":  true,
+	"Expose":  true,
+}
+
+// mutationPrefixes holds the first words of the names of state
+// package functions and methods that change the state, and of the
+// methods of the backend interfaces that facades change it through.
+var mutationPrefixes = []string{
+	"Add",
+	"Set",
+	"Remove",
+	"Destroy",
+	"Create",
+	"Update",
+	"Insert",
+	"Delete",
+	"Ensure",
+	"Enqueue",
+	"Run",
+	"Apply",
+	"Clear",
+	"Unset",
+	"Advance",
+	"Increment",
+}
+
+// retryClass classifies whether the given method is safe to retry,
+// based on its name and on whether it appears to call any state
+// methods that change the state. It returns nil when nothing
+// suggests either way: finding no state changes says little on
+// its own, as they're not always found.
+func retryClass(pkg *packages.Package, pt *types.TypeName, methodName string) *apidoc.RetryClass {
+	verb := firstWord(methodName)
+	decl, declPkg, err := methodDecl(pkg, pt, methodName)
+	if err != nil || decl.Body == nil {
+		// Without the source, we can only go by the name.
+		if readVerbs[verb] || convergentVerbs[verb] {
+			return &apidoc.RetryClass{
+				Safe:       true,
+				Confidence: "low",
+				Reason:     "name starts with " + verb + "; source not analyzed",
+			}
+		}
+		return nil
+	}
+	mutation := findMutation(pkg, declPkg, decl, 0, make(map[*ast.FuncDecl]bool))
+	switch {
+	case mutation == "" && readVerbs[verb]:
+		return &apidoc.RetryClass{
+			Safe:       true,
+			Confidence: "high",
+			Reason:     "read-only name and no state changes found",
+		}
+	case mutation == "" && convergentVerbs[verb]:
+		return &apidoc.RetryClass{
+			Safe:       true,
+			Confidence: "low",
+			Reason:     "name starts with " + verb + " and no state changes found",
+		}
+	case mutation == "":
+		return nil
+	case convergentVerbs[verb]:
+		return &apidoc.RetryClass{
+			Safe:       true,
+			Confidence: "medium",
+			Reason:     "changes state (calls " + mutation + ") but name starts with " + verb,
+		}
+	case readVerbs[verb]:
+		return &apidoc.RetryClass{
+			Safe:       false,
+			Confidence: "low",
+			Reason:     "read-only name but calls " + mutation,
+		}
+	}
+	return &apidoc.RetryClass{
+		Safe:       false,
+		Confidence: "medium",
+		Reason:     "changes state (calls " + mutation + ")",
+	}
+}
+
+// findMutation returns the name of a state function or method, or
+// a method of an interface declared within the juju module, that
+// changes the state and that is called by the given function or any
+// function within the juju module that it calls, or the empty string
+// if there is none.
+func findMutation(pkg, declPkg *packages.Package, decl *ast.FuncDecl, depth int, visited map[*ast.FuncDecl]bool) string {
+	if visited[decl] || decl.Body == nil || declPkg.TypesInfo == nil {
+		return ""
+	}
+	visited[decl] = true
+	found := ""
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if found != "" {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		var id *ast.Ident
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			id = fun
+		case *ast.SelectorExpr:
+			id = fun.Sel
+		default:
+			return true
+		}
+		fn, ok := declPkg.TypesInfo.Uses[id].(*types.Func)
+		if !ok || fn.Pkg() == nil || !strings.HasPrefix(fn.Pkg().Path(), jujuPkgPrefix) {
+			return true
+		}
+		if fn.Pkg().Path() == statePkg && isMutationName(fn.Name()) {
+			found = "state." + fn.Name()
+			return false
+		}
+		if recv := interfaceRecv(fn); recv != "" {
+			// Interface methods have no body to follow.
+			if isMutationName(fn.Name()) {
+				found = recv + "." + fn.Name()
+			}
+			return found == ""
+		}
+		if depth >= maxMutationCallDepth {
+			return true
+		}
+		calleeDecl, calleePkg, err := findDeclPackage(pkg, fn.Pos())
+		if err != nil {
+			return true
+		}
+		if fdecl, ok := calleeDecl.(*ast.FuncDecl); ok {
+			found = findMutation(pkg, calleePkg, fdecl, depth+1, visited)
+		}
+		return found == ""
+	})
+	return found
+}
+
+// isMutationName reports whether a state function or method, or a
+// backend interface method, with the given name changes the state.
+// Only the first word of the name counts, so that Settings, for
+// example, isn't taken for a Set method.
+func isMutationName(name string) bool {
+	verb := firstWord(name)
+	for _, prefix := range mutationPrefixes {
+		if verb == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+// interfaceRecv returns the name of the interface type that fn is a
+// method of, such as "Backend", or the empty string if fn isn't an
+// interface method.
+func interfaceRecv(fn *types.Func) string {
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() == nil {
+		return ""
+	}
+	t := sig.Recv().Type()
+	if _, ok := t.Underlying().(*types.Interface); !ok {
+		return ""
+	}
+	if named, ok := t.(*types.Named); ok {
+		return named.Obj().Name()
+	}
+	return "interface"
+}
+
+// firstWord returns the first word of a CamelCase identifier.
+func firstWord(name string) string {
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			return name[:i]
+		}
+	}
+	return name
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxFillDepth holds the maximum depth to which
+// fillValue will populate nested values.
+const maxFillDepth = 8
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// roundTripProblem describes a way in which a type fails
+// to survive encoding to JSON and back.
+type roundTripProblem struct {
+	Type    reflect.Type
+	Problem string
+}
+
+// checkRoundTrips checks that zero and populated values of all
+// the given types can be marshaled to JSON and unmarshaled
+// back without losing information.
+func checkRoundTrips(ts map[reflect.Type]bool) []roundTripProblem {
+	var problems []roundTripProblem
+	for _, t := range sortedTypes(ts) {
+		for _, p := range checkRoundTrip(t) {
+			problems = append(problems, roundTripProblem{
+				Type:    t,
+				Problem: p,
+			})
+		}
+	}
+	return problems
+}
+
+func checkRoundTrip(t reflect.Type) []string {
+	var problems []string
+	zero := reflect.New(t)
+	if p := roundTrip(zero); p != "" {
+		problems = append(problems, "zero value: "+p)
+	}
+	full := reflect.New(t)
+	fillValue(full.Elem(), 0)
+	if p := roundTrip(full); p != "" {
+		problems = append(problems, "populated value: "+p)
+	}
+	for _, path := range unexportedFields(t, "", make(map[reflect.Type]bool)) {
+		problems = append(problems, fmt.Sprintf("unexported field %s is not encoded", strings.TrimPrefix(path, ".")))
+	}
+	return problems
+}
+
+// roundTrip marshals the value pointed to by v and unmarshals it into
+// a new value of the same type, returning a description of the first
+// difference found, or the empty string if there was none.
+func roundTrip(v reflect.Value) (problem string) {
+	defer func() {
+		if err := recover(); err != nil {
+			problem = fmt.Sprintf("panic: %v", err)
+		}
+	}()
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprintf("cannot marshal: %v", err)
+	}
+	v1 := reflect.New(v.Type().Elem())
+	if err := json.Unmarshal(data, v1.Interface()); err != nil {
+		return fmt.Sprintf("cannot unmarshal: %v", err)
+	}
+	if path, ok := equalValues(v.Elem(), v1.Elem(), ""); !ok {
+		path = strings.TrimPrefix(path, ".")
+		if path == "" {
+			path = "value"
+		}
+		return fmt.Sprintf("%s changed after round trip", path)
+	}
+	return ""
+}
+
+// fillValue sets v to a value with all exported fields
+// and elements populated with non-zero values.
+func fillValue(v reflect.Value, depth int) {
+	if depth > maxFillDepth || !v.CanSet() {
+		return
+	}
+	if v.Type() == timeType {
+		v.Set(reflect.ValueOf(time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.FixedZone("X", 3600))))
+		return
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Ptr:
+		e := reflect.New(v.Type().Elem())
+		fillValue(e.Elem(), depth+1)
+		v.Set(e)
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), 1, 1)
+		fillValue(s.Index(0), depth+1)
+		v.Set(s)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fillValue(v.Index(i), depth+1)
+		}
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		k := reflect.New(v.Type().Key()).Elem()
+		fillValue(k, depth+1)
+		e := reflect.New(v.Type().Elem()).Elem()
+		fillValue(e, depth+1)
+		m.SetMapIndex(k, e)
+		v.Set(m)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			fillValue(v.Field(i), depth+1)
+		}
+	case reflect.Interface:
+		if v.NumMethod() == 0 {
+			v.Set(reflect.ValueOf("x"))
+		}
+	}
+}
+
+// equalValues reports whether a and b hold equal values,
+// treating times as equal when they represent the same instant.
+// If they're not equal, it also returns the path to the
+// first difference found.
+func equalValues(a, b reflect.Value, path string) (string, bool) {
+	if a.Type() == timeType {
+		return path, a.Interface().(time.Time).Equal(b.Interface().(time.Time))
+	}
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return path, a.IsNil() == b.IsNil()
+		}
+		if a.Kind() == reflect.Interface && a.Elem().Type() != b.Elem().Type() {
+			return path, false
+		}
+		return equalValues(a.Elem(), b.Elem(), path)
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			return path, false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if p, ok := equalValues(a.Index(i), b.Index(i), fmt.Sprintf("%s[%d]", path, i)); !ok {
+				return p, false
+			}
+		}
+		return "", true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return path, false
+		}
+		for _, k := range a.MapKeys() {
+			bv := b.MapIndex(k)
+			if !bv.IsValid() {
+				return fmt.Sprintf("%s[%v]", path, k), false
+			}
+			if p, ok := equalValues(a.MapIndex(k), bv, fmt.Sprintf("%s[%v]", path, k)); !ok {
+				return p, false
+			}
+		}
+		return "", true
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if a.Type().Field(i).PkgPath != "" {
+				// Unexported fields are reported separately.
+				continue
+			}
+			if p, ok := equalValues(a.Field(i), b.Field(i), path+"."+a.Type().Field(i).Name); !ok {
+				return p, false
+			}
+		}
+		return "", true
+	case reflect.Func, reflect.Chan:
+		return path, a.IsNil() && b.IsNil()
+	}
+	return path, a.Interface() == b.Interface()
+}
+
+// unexportedFields returns the paths to all unexported,
+// non-embedded struct fields reachable from t.
+func unexportedFields(t reflect.Type, path string, seen map[reflect.Type]bool) []string {
+	if seen[t] || t == timeType {
+		return nil
+	}
+	seen[t] = true
+	var paths []string
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		paths = unexportedFields(t.Elem(), path, seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" && !f.Anonymous {
+				paths = append(paths, path+"."+f.Name)
+				continue
+			}
+			paths = append(paths, unexportedFields(f.Type, path+"."+f.Name, seen)...)
+		}
+	}
+	return paths
+}
+
+func sortedTypes(ts map[reflect.Type]bool) []reflect.Type {
+	sorted := make([]reflect.Type, 0, len(ts))
+	for t := range ts {
+		sorted = append(sorted, t)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].String() < sorted[j].String()
+	})
+	return sorted
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"path"
+	"strings"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"golang.org/x/tools/go/packages"
+)
+
+// secretsTag holds the subsystem tag of the facades
+// whose requirements are recorded.
+const secretsTag = "secrets"
+
+// modelTypeConsts holds the names of the constants
+// that hold model types, in the state package and in
+// the core/model package.
+var modelTypeConsts = map[string]bool{
+	"ModelTypeCAAS": true,
+	"ModelTypeIAAS": true,
+	"CAAS":          true,
+	"IAAS":          true,
+}
+
+// methodRequirements returns the secret backend and model types
+// that the given method checks for in the conditions of its if and
+// switch statements, or those of the functions within the juju
+// module that it calls, in the order found.
+func methodRequirements(pkg *packages.Package, pt *types.TypeName, name string) []apidoc.Requirement {
+	decl, declPkg, err := methodDecl(pkg, pt, name)
+	if err != nil || decl.Body == nil {
+		return nil
+	}
+	var rs []apidoc.Requirement
+	seen := make(map[apidoc.Requirement]bool)
+	add := func(p *packages.Package, cond ast.Node, check string) {
+		ast.Inspect(cond, func(n ast.Node) bool {
+			e, ok := n.(ast.Expr)
+			if !ok {
+				return true
+			}
+			if r, ok := requirement(p, e); ok {
+				r.Check = check
+				if !seen[r] {
+					seen[r] = true
+					rs = append(rs, r)
+				}
+				return false
+			}
+			return true
+		})
+	}
+	var visit func(declPkg *packages.Package, decl *ast.FuncDecl, depth int, visited map[*ast.FuncDecl]bool)
+	visit = func(declPkg *packages.Package, decl *ast.FuncDecl, depth int, visited map[*ast.FuncDecl]bool) {
+		if visited[decl] || decl.Body == nil || declPkg.TypesInfo == nil {
+			return
+		}
+		visited[decl] = true
+		ast.Inspect(decl.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.IfStmt:
+				add(declPkg, n.Cond, types.ExprString(n.Cond))
+			case *ast.CaseClause:
+				for _, e := range n.List {
+					add(declPkg, e, "case "+types.ExprString(e))
+				}
+			case *ast.CallExpr:
+				if depth >= maxAuthCallDepth {
+					return true
+				}
+				var id *ast.Ident
+				switch fun := n.Fun.(type) {
+				case *ast.Ident:
+					id = fun
+				case *ast.SelectorExpr:
+					id = fun.Sel
+				default:
+					return true
+				}
+				fn, ok := declPkg.TypesInfo.Uses[id].(*types.Func)
+				if !ok || fn.Pkg() == nil || !strings.HasPrefix(fn.Pkg().Path(), jujuPkgPrefix) {
+					return true
+				}
+				calleeDecl, calleePkg, err := findDeclPackage(pkg, fn.Pos())
+				if err != nil {
+					return true
+				}
+				if fdecl, ok := calleeDecl.(*ast.FuncDecl); ok {
+					visit(calleePkg, fdecl, depth+1, visited)
+				}
+			}
+			return true
+		})
+	}
+	visit(declPkg, decl, 0, make(map[*ast.FuncDecl]bool))
+	return rs
+}
+
+// requirement returns the requirement expressed by e, if it refers to
+// the type of a secret backend, held in the BackendType constant of
+// one of the secrets/provider packages, or to a model type constant.
+func requirement(pkg *packages.Package, e ast.Expr) (apidoc.Requirement, bool) {
+	var id *ast.Ident
+	switch e := e.(type) {
+	case *ast.Ident:
+		id = e
+	case *ast.SelectorExpr:
+		id = e.Sel
+	default:
+		return apidoc.Requirement{}, false
+	}
+	obj, ok := pkg.TypesInfo.Uses[id].(*types.Const)
+	if !ok || obj.Pkg() == nil || !strings.HasPrefix(obj.Pkg().Path(), jujuPkgPrefix) {
+		return apidoc.Requirement{}, false
+	}
+	var kind string
+	switch pkgPath := obj.Pkg().Path(); {
+	case obj.Name() == "BackendType" && strings.Contains(pkgPath, "/secrets/provider/"):
+		kind = apidoc.RequirementSecretBackend
+	case modelTypeConsts[obj.Name()] && (path.Base(pkgPath) == "state" || path.Base(pkgPath) == "model"):
+		kind = apidoc.RequirementModelType
+	default:
+		return apidoc.Requirement{}, false
+	}
+	value := strings.ToLower(obj.Name())
+	if obj.Val().Kind() == constant.String {
+		value = constant.StringVal(obj.Val())
+	}
+	return apidoc.Requirement{
+		Kind:  kind,
+		Value: value,
+	}, true
+}
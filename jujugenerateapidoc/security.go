@@ -0,0 +1,207 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/errgo.v1"
+)
+
+// maxAuthCallDepth holds the maximum depth of calls that
+// will be followed when looking for a permission check.
+const maxAuthCallDepth = 4
+
+const jujuPkgPrefix = "github.com/juju/juju/"
+
+// agentKinds holds the entity kinds that the security
+// report is concerned with.
+var agentKinds = map[string]bool{
+	"machine-agent": true,
+	"unit-agent":    true,
+}
+
+// authMethodNames holds the names of authorizer methods
+// that count as a permission check.
+var authMethodNames = map[string]bool{
+	"HasPermission": true,
+	"AuthOwner":     true,
+}
+
+// authCheckWords holds the words that make a function whose
+// name starts with check, has, ensure or require count as a
+// permission check, as in checkCanRead and ensurePermission.
+var authCheckWords = map[string]bool{
+	"can":         true,
+	"auth":        true,
+	"permission":  true,
+	"permissions": true,
+	"perms":       true,
+	"access":      true,
+	"admin":       true,
+	"superuser":   true,
+}
+
+// permissionWarnings returns a warning for each method of the given
+// facade that is available to machine or unit agents but that never
+// appears to check permissions, either by calling one of the
+// authorizer methods in authMethodNames or by calling an
+// authorization function.
+func permissionWarnings(pkg *packages.Package, f apidoc.FacadeInfo, pt *types.TypeName) []apidoc.Warning {
+	var agents []string
+	for _, kind := range f.AvailableTo {
+		if agentKinds[kind] {
+			agents = append(agents, kind)
+		}
+	}
+	if len(agents) == 0 {
+		return nil
+	}
+	var factoryCalls []string
+	stateMu.Lock()
+	for name := range factoryAuthCalls[facadeID{f.Name, f.Version}] {
+		factoryCalls = append(factoryCalls, name)
+	}
+	stateMu.Unlock()
+	sort.Strings(factoryCalls)
+	var warnings []apidoc.Warning
+	for _, m := range f.Methods {
+		decl, declPkg, err := methodDecl(pkg, pt, m.Name)
+		if err != nil || decl.Body == nil {
+			// We can't analyze interface methods or methods
+			// whose source we can't find.
+			continue
+		}
+		if hasAuthCheck(pkg, declPkg, decl, 0, make(map[*ast.FuncDecl]bool)) {
+			continue
+		}
+		msg := fmt.Sprintf("available to %s but no permission check found", strings.Join(agents, ", "))
+		if len(factoryCalls) > 0 {
+			msg += fmt.Sprintf(" (factory calls %s)", strings.Join(factoryCalls, ", "))
+		}
+		warnings = append(warnings, apidoc.Warning{
+			Kind:    "no-permission-check",
+			Facade:  f.Name,
+			Version: f.Version,
+			Method:  m.Name,
+			Message: msg,
+		})
+	}
+	return warnings
+}
+
+// hasAuthCheck reports whether the body of the given function, or any
+// function within the juju module that it calls, appears to perform
+// a permission check.
+func hasAuthCheck(pkg, declPkg *packages.Package, decl *ast.FuncDecl, depth int, visited map[*ast.FuncDecl]bool) bool {
+	if visited[decl] || decl.Body == nil {
+		return false
+	}
+	visited[decl] = true
+	found := false
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		var id *ast.Ident
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			id = fun
+		case *ast.SelectorExpr:
+			id = fun.Sel
+		default:
+			return true
+		}
+		if authMethodNames[id.Name] || isAuthFuncName(id.Name) {
+			found = true
+			return false
+		}
+		if depth >= maxAuthCallDepth || declPkg.TypesInfo == nil {
+			return true
+		}
+		fn, ok := declPkg.TypesInfo.Uses[id].(*types.Func)
+		if !ok || fn.Pkg() == nil || !strings.HasPrefix(fn.Pkg().Path(), jujuPkgPrefix) {
+			return true
+		}
+		calleeDecl, calleePkg, err := findDeclPackage(pkg, fn.Pos())
+		if err != nil {
+			return true
+		}
+		if fdecl, ok := calleeDecl.(*ast.FuncDecl); ok && hasAuthCheck(pkg, calleePkg, fdecl, depth+1, visited) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// isAuthFuncName reports whether a function with the given name
+// looks like it performs authorization, such as the canRead and
+// canAccess functions used by many facades. The name is split into
+// its mixed-caps words, so that functions like Cancel and accessors
+// like AccessKey don't count.
+func isAuthFuncName(name string) bool {
+	words := strings.Fields(lowerWords(name))
+	if len(words) == 0 {
+		return false
+	}
+	switch words[0] {
+	case "authorize", "authorise":
+		return true
+	case "can":
+		return len(words) > 1
+	case "auth":
+		// Functions such as authTag return
+		// the caller's identity.
+		return len(words) > 1 && words[1] != "tag"
+	case "check", "has", "ensure", "require":
+		for _, w := range words[1:] {
+			if authCheckWords[w] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// methodDecl returns the declaration of the given method
+// and the package it's declared in.
+func methodDecl(pkg *packages.Package, tname *types.TypeName, methodName string) (*ast.FuncDecl, *packages.Package, error) {
+	obj, err := methodObj(tname, methodName)
+	if err != nil {
+		return nil, nil, errgo.Mask(err)
+	}
+	decl, declPkg, err := findDeclPackage(pkg, obj.Pos())
+	if err != nil {
+		return nil, nil, errgo.Mask(err)
+	}
+	fdecl, ok := decl.(*ast.FuncDecl)
+	if !ok {
+		return nil, nil, errgo.Newf("%s is not declared as a function", methodName)
+	}
+	return fdecl, declPkg, nil
+}
+
+// writeSecurityReport writes a report of all the no-permission-check
+// warnings in info to the named file.
+func writeSecurityReport(file string, info *apidoc.Info) error {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "Facade methods available to agents with no permission check found.\n")
+	fmt.Fprintf(&buf, "These are heuristic results and each needs manual review.\n\n")
+	for _, w := range info.Warnings {
+		if w.Kind == "no-permission-check" {
+			fmt.Fprintf(&buf, "%s(%d).%s: %s\n", w.Facade, w.Version, w.Method, w.Message)
+		}
+	}
+	return ioutil.WriteFile(file, []byte(buf.String()), 0666)
+}
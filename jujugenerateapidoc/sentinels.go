@@ -0,0 +1,281 @@
+package main
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"golang.org/x/tools/go/packages"
+)
+
+// maxErrorCallDepth holds the maximum depth of calls that will
+// be followed when looking for the sentinel errors that a
+// method can return.
+const maxErrorCallDepth = 3
+
+// errorType holds the error type.
+var errorType = types.Universe.Lookup("error").Type()
+
+// errorFuncs holds the names of the functions whose first argument
+// is the message of the error that they return, such as errors.New.
+var errorFuncs = map[string]bool{
+	"New":    true,
+	"Errorf": true,
+	"Newf":   true,
+}
+
+// methodErrors returns the qualified names of the sentinel errors
+// that the given method can return: the exported package-level
+// error variables with names beginning with Err, such as ErrPerm,
+// that its code refers to, following calls to functions in the
+// juju module. A variable that is only compared against, as in
+// err == ErrPerm or errors.Is(err, ErrPerm), doesn't count.
+func methodErrors(pkg *packages.Package, pt *types.TypeName, methodName string) []string {
+	decl, declPkg, err := methodDecl(pkg, pt, methodName)
+	if err != nil || decl.Body == nil || declPkg.TypesInfo == nil {
+		return nil
+	}
+	found := make(map[string]bool)
+	addFuncErrors(pkg, declPkg, decl, found, 0, make(map[*ast.FuncDecl]bool))
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// addFuncErrors adds the names of the sentinel errors referred
+// to by decl, or by the functions it calls, to found.
+func addFuncErrors(pkg, declPkg *packages.Package, decl *ast.FuncDecl, found map[string]bool, depth int, visited map[*ast.FuncDecl]bool) {
+	if visited[decl] || decl.Body == nil {
+		return
+	}
+	visited[decl] = true
+	tinfo := declPkg.TypesInfo
+	// compared holds the identifiers that are compared
+	// against, which test for an error rather than
+	// returning it. As ast.Inspect visits a node before
+	// its children, they're marked before they're seen.
+	compared := make(map[*ast.Ident]bool)
+	markCompared := func(es ...ast.Expr) {
+		for _, e := range es {
+			switch e := unparen(e).(type) {
+			case *ast.Ident:
+				compared[e] = true
+			case *ast.SelectorExpr:
+				compared[e.Sel] = true
+			}
+		}
+	}
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BinaryExpr:
+			if n.Op == token.EQL || n.Op == token.NEQ {
+				markCompared(n.X, n.Y)
+			}
+		case *ast.Ident:
+			if v, ok := tinfo.Uses[n].(*types.Var); ok && !compared[n] && isSentinel(v) {
+				found[sentinelName(v)] = true
+			}
+		case *ast.CallExpr:
+			if funcName(n.Fun) == "Is" && len(n.Args) == 2 {
+				// As in errors.Is(err, ErrPerm).
+				markCompared(n.Args[1])
+			}
+			if depth >= maxErrorCallDepth {
+				return true
+			}
+			fn := calledFunc(tinfo, n)
+			if fn == nil || fn.Pkg() == nil || !strings.HasPrefix(fn.Pkg().Path(), jujuPkgPrefix) {
+				return true
+			}
+			calleeDecl, calleePkg, err := findDeclPackage(pkg, fn.Pos())
+			if err != nil || calleePkg.TypesInfo == nil {
+				return true
+			}
+			if fdecl, ok := calleeDecl.(*ast.FuncDecl); ok {
+				addFuncErrors(pkg, calleePkg, fdecl, found, depth+1, visited)
+			}
+		}
+		return true
+	})
+}
+
+// isSentinel reports whether v is a sentinel error variable.
+func isSentinel(v *types.Var) bool {
+	return v.Pkg() != nil &&
+		v.Parent() == v.Pkg().Scope() &&
+		v.Exported() &&
+		strings.HasPrefix(v.Name(), "Err") &&
+		strings.HasPrefix(v.Pkg().Path()+"/", jujuPkgPrefix) &&
+		types.Implements(v.Type(), errorType.Underlying().(*types.Interface))
+}
+
+// sentinelName returns the name of v qualified by its package path.
+func sentinelName(v *types.Var) string {
+	return v.Pkg().Path() + "." + v.Name()
+}
+
+// referencedErrors returns the names of all the sentinel
+// errors that the methods of the given facades can return,
+// in sorted order.
+func referencedErrors(fs []apidoc.FacadeInfo) []string {
+	found := make(map[string]bool)
+	var names []string
+	for _, f := range fs {
+		for _, m := range f.Methods {
+			for _, name := range m.Errors {
+				if !found[name] {
+					found[name] = true
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sentinelErrors returns the entries for Info.SentinelErrors
+// for the sentinel errors with the given names, as returned
+// by methodErrors. The codes are taken from the tables in
+// the server errors package that map errors to codes.
+func sentinelErrors(pkg *packages.Package, names []string) []apidoc.SentinelError {
+	codes := sentinelCodes(pkg)
+	var errs []apidoc.SentinelError
+	for _, name := range names {
+		e := apidoc.SentinelError{
+			Name: name,
+			Code: codes[name],
+		}
+		i := strings.LastIndex(name, ".")
+		if p := findPackage(pkg, name[:i]); p != nil && p.Types != nil {
+			if v, ok := p.Types.Scope().Lookup(name[i+1:]).(*types.Var); ok {
+				// The doc comment and message are extras, so
+				// a declaration that can't be found isn't fatal.
+				e.Doc, _ = valueDocComment(pkg, v)
+				e.Message = sentinelMessage(pkg, v)
+			}
+		}
+		errs = append(errs, e)
+	}
+	return errs
+}
+
+// sentinelMessage returns the message of the error held in v if it's
+// initialized by a call to one of the errorFuncs with a literal
+// message, or the empty string otherwise.
+func sentinelMessage(pkg *packages.Package, v *types.Var) string {
+	decl, err := findDecl(pkg, v.Pos())
+	if err != nil {
+		return ""
+	}
+	gdecl, ok := decl.(*ast.GenDecl)
+	if !ok {
+		return ""
+	}
+	for _, spec := range gdecl.Specs {
+		vspec, ok := spec.(*ast.ValueSpec)
+		if !ok || len(vspec.Values) != len(vspec.Names) {
+			continue
+		}
+		for i, id := range vspec.Names {
+			if !samePos(pkg.Fset, id.Pos(), v.Pos()) {
+				continue
+			}
+			call, ok := unparen(vspec.Values[i]).(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 || !errorFuncs[funcName(call.Fun)] {
+				return ""
+			}
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return ""
+			}
+			msg, err := strconv.Unquote(lit.Value)
+			if err != nil || strings.Contains(msg, "%") {
+				// A formatted message depends on its arguments.
+				return ""
+			}
+			return msg
+		}
+	}
+	return ""
+}
+
+var (
+	sentinelCodesOnce sync.Once
+	sentinelCodeMap   map[string]string
+)
+
+// sentinelCodes returns a map from the qualified name of each
+// sentinel error to its error code, found by looking in the server
+// errors package for package-level map[error]string tables such
+// as the one used by ServerError.
+func sentinelCodes(pkg *packages.Package) map[string]string {
+	sentinelCodesOnce.Do(func() {
+		sentinelCodeMap = make(map[string]string)
+		p := findPackage(pkg, serverErrorsPkg)
+		if p == nil || p.TypesInfo == nil {
+			return
+		}
+		for _, f := range p.Syntax {
+			for _, decl := range f.Decls {
+				gdecl, ok := decl.(*ast.GenDecl)
+				if !ok || gdecl.Tok != token.VAR {
+					continue
+				}
+				for _, spec := range gdecl.Specs {
+					for _, value := range spec.(*ast.ValueSpec).Values {
+						addSentinelCodes(p.TypesInfo, value, sentinelCodeMap)
+					}
+				}
+			}
+		}
+	})
+	return sentinelCodeMap
+}
+
+// addSentinelCodes adds the entries of e to codes if it's
+// a map[error]string literal with constant values.
+func addSentinelCodes(tinfo *types.Info, e ast.Expr, codes map[string]string) {
+	lit, ok := unparen(e).(*ast.CompositeLit)
+	if !ok {
+		return
+	}
+	t := tinfo.TypeOf(lit)
+	if t == nil {
+		return
+	}
+	m, ok := t.Underlying().(*types.Map)
+	if !ok || !types.Identical(m.Key(), errorType) {
+		return
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		var id *ast.Ident
+		switch key := unparen(kv.Key).(type) {
+		case *ast.Ident:
+			id = key
+		case *ast.SelectorExpr:
+			id = key.Sel
+		default:
+			continue
+		}
+		v, ok := tinfo.Uses[id].(*types.Var)
+		tv := tinfo.Types[kv.Value]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+			continue
+		}
+		codes[sentinelName(v)] = constant.StringVal(tv.Value)
+	}
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// stats holds the statistics gathered by generateInfo,
+// written to the -stats file.
+var stats apidoc.Stats
+
+// generationStats returns the statistics for the run,
+// including the use made of the caches.
+func generationStats() *apidoc.Stats {
+	s := stats
+	parsedMu.Lock()
+	parsedStats := apidoc.CacheStats{Name: "parsed files", Hits: parsedHits, Misses: parsedMisses}
+	parsedMu.Unlock()
+	stateMu.Lock()
+	checkpointStats := apidoc.CacheStats{Name: "checkpoints", Hits: checkpointHits, Misses: checkpointMisses}
+	stateMu.Unlock()
+	s.Caches = []apidoc.CacheStats{
+		progTypes.stats(),
+		methodDocs.stats(),
+		decls.stats(),
+		parsedStats,
+		checkpointStats,
+	}
+	return &s
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// infoWriter writes the JSON encoding of an apidoc.Info value
+// a piece at a time, so that the whole document never needs
+// to be held in memory at once.
+//
+// Any error is remembered and returned by close.
+type infoWriter struct {
+	w      *bufio.Writer
+	enc    *json.Encoder
+	fields int
+	err    error
+}
+
+func newInfoWriter(w io.Writer) *infoWriter {
+	bw := bufio.NewWriter(w)
+	return &infoWriter{
+		w:   bw,
+		enc: json.NewEncoder(bw),
+	}
+}
+
+// typeInfo writes the TypeInfo field, encoding
+// each type entry separately.
+func (w *infoWriter) typeInfo(info *jsontypes.Info) {
+	names := make([]string, 0, len(info.Types))
+	for name := range info.Types {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	w.startField("TypeInfo")
+	w.writeString(`{"Types":{`)
+	for i, name := range names {
+		if i > 0 {
+			w.writeString(",")
+		}
+		w.encode(name)
+		w.writeString(":")
+		w.encode(info.Types[jsontypes.TypeName(name)])
+	}
+	w.writeString("}}")
+}
+
+// startFacades starts the Facades field. Each facade
+// should then be written with facade, and the field
+// finished with endFacades.
+func (w *infoWriter) startFacades() {
+	w.startField("Facades")
+	w.writeString("[")
+}
+
+// facade writes a single element of the Facades field.
+func (w *infoWriter) facade(i int, f apidoc.FacadeInfo) {
+	if i > 0 {
+		w.writeString(",")
+	}
+	w.encode(f)
+}
+
+func (w *infoWriter) endFacades() {
+	w.writeString("]")
+}
+
+// field writes a field of the top level object with the given
+// name and value. Following the omitempty tags on apidoc.Info,
+// nothing is written if n, the length of the value, is zero.
+func (w *infoWriter) field(name string, v interface{}, n int) {
+	if n == 0 {
+		return
+	}
+	w.startField(name)
+	w.encode(v)
+}
+
+// close finishes the top level object and flushes
+// the output.
+func (w *infoWriter) close() error {
+	if w.fields == 0 {
+		w.writeString("{")
+	}
+	w.writeString("}")
+	if w.err == nil {
+		w.err = w.w.Flush()
+	}
+	return w.err
+}
+
+func (w *infoWriter) startField(name string) {
+	if w.fields == 0 {
+		w.writeString("{")
+	} else {
+		w.writeString(",")
+	}
+	w.fields++
+	w.encode(name)
+	w.writeString(":")
+}
+
+func (w *infoWriter) writeString(s string) {
+	if w.err == nil {
+		_, w.err = w.w.WriteString(s)
+	}
+}
+
+func (w *infoWriter) encode(v interface{}) {
+	if w.err == nil {
+		w.err = w.enc.Encode(v)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"gopkg.in/errgo.v1"
+)
+
+// undocumentedWarnings returns a warning for f, and each of its
+// methods, that has no doc comment. They're only produced in
+// -strict mode, as many facades aren't fully documented.
+func undocumentedWarnings(f apidoc.FacadeInfo) []apidoc.Warning {
+	var warnings []apidoc.Warning
+	if f.Doc == "" {
+		warnings = append(warnings, apidoc.Warning{
+			Kind:    "undocumented",
+			Facade:  f.Name,
+			Version: f.Version,
+			Message: fmt.Sprintf("facade %s(%d) has no doc comment", f.Name, f.Version),
+		})
+	}
+	for _, m := range f.Methods {
+		if m.Doc != "" {
+			continue
+		}
+		warnings = append(warnings, apidoc.Warning{
+			Kind:    "undocumented",
+			Facade:  f.Name,
+			Version: f.Version,
+			Method:  m.Name,
+			Message: fmt.Sprintf("method %s(%d).%s has no doc comment", f.Name, f.Version, m.Name),
+		})
+	}
+	return warnings
+}
+
+// checkStrict returns an error if info records anything that makes
+// the documentation incomplete or doubtful, logging each problem,
+// so that -strict can stop an incomplete document from being
+// published. It's called once the output has been written so that
+// the problems can also be found there.
+func checkStrict(info *apidoc.Info) error {
+	for _, w := range info.Warnings {
+		log.Printf("warning: %s: %s", w.Kind, w.Message)
+	}
+	for _, p := range info.FactoryPanics {
+		log.Printf("facade factory panic: %s(%d) for %s: %s", p.Facade, p.Version, p.EntityKind, p.Message)
+	}
+	for _, e := range info.FacadeErrors {
+		log.Printf("facade error: %s", e.Message)
+	}
+	if len(info.Warnings)+len(info.FactoryPanics)+len(info.FacadeErrors) > 0 {
+		return errgo.Newf("strict mode: %d warnings, %d facade factory panics and %d facade errors", len(info.Warnings), len(info.FactoryPanics), len(info.FacadeErrors))
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"github.com/juju/jujuapidoc/apidoc"
+	"github.com/rogpeppe/apicompat/jsontypes"
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/errgo.v1"
+)
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// unserializableFields returns a warning for each struct field reachable
+// from the given types whose type cannot faithfully be encoded as JSON.
+// Interface-typed fields are allowed only when their doc comment
+// describes the concrete types they may hold.
+func unserializableFields(pkg *packages.Package, ts map[reflect.Type]bool) []apidoc.Warning {
+	var warnings []apidoc.Warning
+	seen := make(map[reflect.Type]bool)
+	var visit func(t reflect.Type)
+	visit = func(t reflect.Type) {
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || seen[t] || t == timeType {
+			return
+		}
+		seen[t] = true
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if (f.PkgPath != "" && !f.Anonymous) || f.Tag.Get("json") == "-" {
+				continue
+			}
+			if problem := fieldProblem(f.Type); problem != "" {
+				if f.Type.Kind() == reflect.Interface && fieldIsDocumented(pkg, t, f.Name) {
+					continue
+				}
+				warnings = append(warnings, apidoc.Warning{
+					Kind:    "unserializable-field",
+					Type:    typeName(t),
+					Field:   f.Name,
+					Message: problem,
+				})
+			}
+			visit(f.Type)
+		}
+	}
+	for _, t := range sortedTypes(ts) {
+		visit(t)
+	}
+	return warnings
+}
+
+// fieldProblem returns a description of why a field of type t can't
+// be faithfully encoded, or the empty string if it can.
+func fieldProblem(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer, reflect.Complex64, reflect.Complex128:
+		return fmt.Sprintf("%s values cannot be encoded as JSON", t.Kind())
+	case reflect.Interface:
+		return fmt.Sprintf("interface type %s does not specify the encoded type", t)
+	case reflect.Map:
+		k := t.Key()
+		switch {
+		case k.Kind() == reflect.String:
+		case reflect.PtrTo(k).Implements(textMarshalerType) || k.Implements(textMarshalerType):
+			return fmt.Sprintf("map keys of type %s are encoded as text", k)
+		case k.Kind() >= reflect.Int && k.Kind() <= reflect.Uintptr:
+			return fmt.Sprintf("map keys of type %s are encoded as decimal strings", k)
+		default:
+			return fmt.Sprintf("map keys of type %s cannot be encoded as JSON", k)
+		}
+		return fieldProblem(t.Elem())
+	}
+	return ""
+}
+
+// fieldIsDocumented reports whether the field with the given
+// name in struct type t has a doc comment.
+func fieldIsDocumented(pkg *packages.Package, t reflect.Type, fieldName string) bool {
+	pt, err := progType(pkg, t)
+	if err != nil {
+		return false
+	}
+	doc, err := fieldDocComment(pkg, pt, fieldName)
+	return err == nil && strings.TrimSpace(doc) != ""
+}
+
+// fieldDocComment returns the doc comment of the given field
+// of a struct type.
+func fieldDocComment(pkg *packages.Package, t *types.TypeName, fieldName string) (string, error) {
+	decl, err := findDecl(pkg, t.Pos())
+	if err != nil {
+		return "", errgo.Mask(err)
+	}
+	tdecl, ok := decl.(*ast.GenDecl)
+	if !ok {
+		return "", errgo.Newf("found non-type decl %#v", decl)
+	}
+	for _, spec := range tdecl.Specs {
+		tspec := spec.(*ast.TypeSpec)
+		if !samePos(pkg.Fset, tspec.Name.Pos(), t.Pos()) {
+			continue
+		}
+		st, ok := tspec.Type.(*ast.StructType)
+		if !ok {
+			return "", errgo.Newf("%s is not a struct type", t.Name())
+		}
+		for _, f := range st.Fields.List {
+			for _, id := range f.Names {
+				if id.Name != fieldName {
+					continue
+				}
+				if f.Doc != nil {
+					return f.Doc.Text(), nil
+				}
+				return f.Comment.Text(), nil
+			}
+		}
+		return "", errgo.Newf("field %s not found in %s", fieldName, t.Name())
+	}
+	return "", errgo.Newf("cannot find type declaration")
+}
+
+// typeName returns the jsontypes name for the given named type,
+// or the empty string if it is not named.
+func typeName(t reflect.Type) jsontypes.TypeName {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Name() == "" || t.PkgPath() == "" {
+		return ""
+	}
+	return jsontypes.TypeName(t.PkgPath() + "#" + t.Name())
+}
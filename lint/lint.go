@@ -0,0 +1,340 @@
+// Package lint checks the API surface described by jujuapidoc
+// output against Juju's API naming and structure conventions.
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/rogpeppe/apicompat/jsontypes"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// Finding holds a single violation of a lint rule.
+type Finding struct {
+	// Rule holds the name of the rule that was violated.
+	Rule    string
+	Facade  string
+	Version int    `json:",omitempty"`
+	Method  string `json:",omitempty"`
+	Message string
+}
+
+// Rule describes a lint rule.
+type Rule struct {
+	Name        string
+	Description string
+	check       func(info *apidoc.Info) []Finding
+}
+
+// Rules holds all the rules checked by Check.
+var Rules = []Rule{{
+	Name:        "facade-name",
+	Description: "Facade names should be exported CamelCase identifiers.",
+	check:       checkFacadeNames,
+}, {
+	Name:        "facade-versions",
+	Description: "The versions of a facade should be contiguous.",
+	check:       checkFacadeVersions,
+}, {
+	Name:        "facade-doc",
+	Description: "Facades should have a doc comment.",
+	check:       checkFacadeDocs,
+}, {
+	Name:        "method-name",
+	Description: "Method names should be exported CamelCase identifiers.",
+	check:       checkMethodNames,
+}, {
+	Name:        "method-verb",
+	Description: "Method names should start with a verb.",
+	check:       checkMethodVerbs,
+}, {
+	Name:        "method-doc",
+	Description: "Methods should have a doc comment.",
+	check:       checkMethodDocs,
+}, {
+	Name:        "bulk-results",
+	Description: "The result type of a bulk method should have a name ending in Results.",
+	check:       checkBulkResults,
+}}
+
+// Check checks info against all the rules in Rules
+// and returns any findings.
+func Check(info *apidoc.Info) []Finding {
+	var findings []Finding
+	for _, r := range Rules {
+		for _, f := range r.check(info) {
+			f.Rule = r.Name
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
+
+// RuleByName returns the rule with the given name,
+// or nil if there is none.
+func RuleByName(name string) *Rule {
+	for i := range Rules {
+		if Rules[i].Name == name {
+			return &Rules[i]
+		}
+	}
+	return nil
+}
+
+func checkFacadeNames(info *apidoc.Info) []Finding {
+	var findings []Finding
+	seen := make(map[string]bool)
+	for _, f := range info.Facades {
+		if seen[f.Name] {
+			continue
+		}
+		seen[f.Name] = true
+		if !isCamelCase(f.Name) {
+			findings = append(findings, Finding{
+				Facade:  f.Name,
+				Message: fmt.Sprintf("facade name %q is not an exported CamelCase identifier", f.Name),
+			})
+		}
+	}
+	return findings
+}
+
+func checkFacadeVersions(info *apidoc.Info) []Finding {
+	versions := make(map[string][]int)
+	for _, f := range info.Facades {
+		versions[f.Name] = append(versions[f.Name], f.Version)
+	}
+	var findings []Finding
+	for _, name := range sortedKeys(versions) {
+		vs := versions[name]
+		sort.Ints(vs)
+		for i := 1; i < len(vs); i++ {
+			switch {
+			case vs[i] == vs[i-1]:
+				findings = append(findings, Finding{
+					Facade:  name,
+					Version: vs[i],
+					Message: fmt.Sprintf("version %d is registered more than once", vs[i]),
+				})
+			case vs[i] != vs[i-1]+1:
+				findings = append(findings, Finding{
+					Facade:  name,
+					Version: vs[i],
+					Message: fmt.Sprintf("versions jump from %d to %d", vs[i-1], vs[i]),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func checkFacadeDocs(info *apidoc.Info) []Finding {
+	var findings []Finding
+	for _, f := range info.Facades {
+		if strings.TrimSpace(f.Doc) == "" {
+			findings = append(findings, Finding{
+				Facade:  f.Name,
+				Version: f.Version,
+				Message: "facade has no doc comment",
+			})
+		}
+	}
+	return findings
+}
+
+func checkMethodNames(info *apidoc.Info) []Finding {
+	return checkMethods(info, func(f apidoc.FacadeInfo, m apidoc.Method) string {
+		if !isCamelCase(m.Name) {
+			return fmt.Sprintf("method name %q is not an exported CamelCase identifier", m.Name)
+		}
+		return ""
+	})
+}
+
+func checkMethodVerbs(info *apidoc.Info) []Finding {
+	return checkMethods(info, func(f apidoc.FacadeInfo, m apidoc.Method) string {
+		if w := firstWord(m.Name); !verbs[w] {
+			return fmt.Sprintf("method name %q does not start with a known verb (%q)", m.Name, w)
+		}
+		return ""
+	})
+}
+
+func checkMethodDocs(info *apidoc.Info) []Finding {
+	return checkMethods(info, func(f apidoc.FacadeInfo, m apidoc.Method) string {
+		if strings.TrimSpace(m.Doc) == "" {
+			return "method has no doc comment"
+		}
+		return ""
+	})
+}
+
+func checkBulkResults(info *apidoc.Info) []Finding {
+	return checkMethods(info, func(f apidoc.FacadeInfo, m apidoc.Method) string {
+		if m.Result == nil || !IsBulk(info, m.Param) {
+			return ""
+		}
+		if name := m.Result.Name.Name(); !strings.HasSuffix(name, "Results") {
+			return fmt.Sprintf("bulk method returns %s, which does not end in Results", name)
+		}
+		return ""
+	})
+}
+
+// IsBulk reports whether t is the parameter type of a bulk method:
+// a struct type with a single field that holds a slice.
+func IsBulk(info *apidoc.Info, t *jsontypes.Type) bool {
+	if t == nil {
+		return false
+	}
+	dt := info.Type(t)
+	if dt.Kind != jsontypes.Struct || len(dt.Fields) != 1 {
+		return false
+	}
+	return info.Type(dt.Fields[0].Type).Kind == jsontypes.Slice
+}
+
+func checkMethods(info *apidoc.Info, check func(f apidoc.FacadeInfo, m apidoc.Method) string) []Finding {
+	var findings []Finding
+	for _, f := range info.Facades {
+		for _, m := range f.Methods {
+			if msg := check(f, m); msg != "" {
+				findings = append(findings, Finding{
+					Facade:  f.Name,
+					Version: f.Version,
+					Method:  m.Name,
+					Message: msg,
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func isCamelCase(name string) bool {
+	if name == "" || !unicode.IsUpper([]rune(name)[0]) {
+		return false
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// firstWord returns the first word of a CamelCase identifier.
+func firstWord(name string) string {
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			return name[:i]
+		}
+	}
+	return name
+}
+
+func sortedKeys(m map[string][]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// verbs holds the words accepted as the first word
+// of a method name.
+var verbs = map[string]bool{
+	"Abort":      true,
+	"Activate":   true,
+	"Add":        true,
+	"Adopt":      true,
+	"Allocate":   true,
+	"Assign":     true,
+	"Block":      true,
+	"Bootstrap":  true,
+	"Cancel":     true,
+	"Change":     true,
+	"Check":      true,
+	"Claim":      true,
+	"Clean":      true,
+	"Clear":      true,
+	"Close":      true,
+	"Collect":    true,
+	"Commit":     true,
+	"Configure":  true,
+	"Consume":    true,
+	"Create":     true,
+	"Deploy":     true,
+	"Destroy":    true,
+	"Detach":     true,
+	"Disable":    true,
+	"Discard":    true,
+	"Dump":       true,
+	"Enable":     true,
+	"Enqueue":    true,
+	"Ensure":     true,
+	"Enter":      true,
+	"Export":     true,
+	"Expose":     true,
+	"Finalize":   true,
+	"Find":       true,
+	"Finish":     true,
+	"Get":        true,
+	"Grant":      true,
+	"Import":     true,
+	"Init":       true,
+	"Invalidate": true,
+	"Kill":       true,
+	"List":       true,
+	"Login":      true,
+	"Logout":     true,
+	"Mark":       true,
+	"Merge":      true,
+	"Next":       true,
+	"Open":       true,
+	"Ping":       true,
+	"Prechecks":  true,
+	"Prepare":    true,
+	"Process":    true,
+	"Provision":  true,
+	"Prune":      true,
+	"Publish":    true,
+	"Read":       true,
+	"Reap":       true,
+	"Redirect":   true,
+	"Refresh":    true,
+	"Register":   true,
+	"Release":    true,
+	"Reload":     true,
+	"Remove":     true,
+	"Rename":     true,
+	"Report":     true,
+	"Request":    true,
+	"Reset":      true,
+	"Resolve":    true,
+	"Restore":    true,
+	"Resume":     true,
+	"Retry":      true,
+	"Revoke":     true,
+	"Run":        true,
+	"Save":       true,
+	"Scale":      true,
+	"Set":        true,
+	"Share":      true,
+	"Start":      true,
+	"Stop":       true,
+	"Suspend":    true,
+	"Sync":       true,
+	"Update":     true,
+	"Upgrade":    true,
+	"Upload":     true,
+	"Validate":   true,
+	"Wait":       true,
+	"Watch":      true,
+	"Write":      true,
+}
@@ -0,0 +1,234 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// warningRules describes the kinds of warning that the doc
+// generator records, which are reported alongside the findings
+// in SARIF output. The security rules are tagged as such, so
+// that code scanning shows them as security alerts.
+var warningRules = map[string]struct {
+	description string
+	security    bool
+}{
+	"no-permission-check":  {"Methods available to agents should check the caller's permissions.", true},
+	"round-trip":           {"Wire types should survive a JSON round trip unchanged.", false},
+	"unserializable-field": {"Wire types should not have fields that JSON cannot encode.", false},
+	"platform-conditional": {"Facades should not depend on the platform the API server was built for.", false},
+	"result-order":         {"Bulk results should be in the same order as the params.", false},
+	"invalid-example":      {"Examples in doc comments should match the method's params.", false},
+	"undocumented":         {"Facades and methods should have a doc comment.", false},
+}
+
+// warningRulePrefix is prepended to the kind of a generator
+// warning to make the id of its rule, to keep them apart
+// from the lint rules.
+const warningRulePrefix = "generator/"
+
+// WriteSARIF writes findings, along with the warnings recorded in
+// info by the doc generator, to w as a SARIF 2.1.0 log, the format
+// read by code scanning tools such as GitHub's. Results are located
+// at the declarations recorded in the Source fields of info, with
+// paths relative to the root of the juju module; those without a
+// known declaration have only a logical location, naming the
+// facade, method or type.
+func WriteSARIF(w io.Writer, info *apidoc.Info, findings []Finding) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "jujuapidoclint",
+			InformationURI: "https://github.com/juju/jujuapidoc",
+		}},
+		Results: []sarifResult{},
+	}
+	for _, r := range Rules {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+			ID:               r.Name,
+			ShortDescription: sarifMessage{Text: r.Description},
+		})
+	}
+	for _, f := range findings {
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    f.Rule,
+			Level:     "warning",
+			Message:   sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{location(info, f.Facade, f.Version, f.Method, "")},
+		})
+	}
+	kinds := make(map[string]bool)
+	for _, warning := range info.Warnings {
+		kinds[warning.Kind] = true
+		level := "warning"
+		if warningRules[warning.Kind].security {
+			level = "error"
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    warningRulePrefix + warning.Kind,
+			Level:     level,
+			Message:   sarifMessage{Text: warning.Message},
+			Locations: []sarifLocation{location(info, warning.Facade, warning.Version, warning.Method, warningTypeName(warning))},
+		})
+	}
+	var kindNames []string
+	for kind := range kinds {
+		kindNames = append(kindNames, kind)
+	}
+	sort.Strings(kindNames)
+	for _, kind := range kindNames {
+		rule := sarifRule{
+			ID:               warningRulePrefix + kind,
+			ShortDescription: sarifMessage{Text: warningRules[kind].description},
+		}
+		if rule.ShortDescription.Text == "" {
+			rule.ShortDescription.Text = fmt.Sprintf("Problems of kind %q found by the doc generator.", kind)
+		}
+		if warningRules[kind].security {
+			rule.Properties = &sarifProperties{Tags: []string{"security"}}
+		}
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+	}
+	data, err := json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}, "", "\t")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	_, err = w.Write(data)
+	return err
+}
+
+// location returns the location of a problem in the given facade
+// version (or any version if it's zero) and method, which may be
+// empty, or in the given type if there's no facade.
+func location(info *apidoc.Info, facade string, version int, method, typeName string) sarifLocation {
+	var loc sarifLocation
+	name := typeName
+	if facade != "" {
+		name = facade
+		if version > 0 {
+			name += fmt.Sprintf("(%d)", version)
+		}
+		if method != "" {
+			name += "." + method
+		}
+	}
+	if name != "" {
+		loc.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: name}}
+	}
+	if pos := sourcePos(info, facade, version, method); pos != nil {
+		loc.PhysicalLocation = &sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{
+				URI:       pos.File,
+				URIBaseID: "%SRCROOT%",
+			},
+			Region: sarifRegion{StartLine: pos.Line},
+		}
+	}
+	return loc
+}
+
+// sourcePos returns where the given method of the given facade
+// version is declared, falling back to where the facade is
+// declared, or nil if neither is known.
+func sourcePos(info *apidoc.Info, facade string, version int, method string) *apidoc.SourcePos {
+	for _, f := range info.Facades {
+		if f.Name != facade || version > 0 && f.Version != version {
+			continue
+		}
+		for _, m := range f.Methods {
+			if m.Name == method && m.Source != nil {
+				return m.Source
+			}
+		}
+		if f.Source != nil {
+			return f.Source
+		}
+	}
+	return nil
+}
+
+// warningTypeName returns the name of the type, and field
+// if any, that warning w was found in.
+func warningTypeName(w apidoc.Warning) string {
+	name := string(w.Type)
+	if name != "" && w.Field != "" {
+		name += "." + w.Field
+	}
+	return name
+}
+
+// The types below hold the parts of the SARIF 2.1.0 format
+// that are used; see https://docs.oasis-open.org/sarif/sarif/v2.1.0/.
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string           `json:"id"`
+	ShortDescription sarifMessage     `json:"shortDescription"`
+	Properties       *sarifProperties `json:"properties,omitempty"`
+}
+
+type sarifProperties struct {
+	Tags []string `json:"tags,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
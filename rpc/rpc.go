@@ -0,0 +1,123 @@
+// Package rpc implements a minimal client for the websocket-based
+// RPC protocol used by the Juju API server, sufficient for making
+// individual calls when exploring the API.
+package rpc
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// Conn holds a connection to a Juju API server.
+type Conn struct {
+	ws     *websocket
+	nextId uint64
+}
+
+// Error holds an error returned by the API server.
+type Error struct {
+	Message string
+	Code    string
+	Info    map[string]interface{}
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s (code %q)", e.Message, e.Code)
+}
+
+// Dial connects to the API server at the given address
+// (host:port). If modelUUID is non-empty, the connection is
+// to that model; otherwise it is to the controller.
+func Dial(addr, modelUUID string, config *tls.Config) (*Conn, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if config.ServerName == "" {
+		config = config.Clone()
+		config.ServerName = host
+	}
+	conn, err := tls.Dial("tcp", addr, config)
+	if err != nil {
+		return nil, errors.Notef(err, nil, "cannot connect to %s", addr)
+	}
+	path := "/api"
+	if modelUUID != "" {
+		path = "/model/" + modelUUID + "/api"
+	}
+	ws, err := handshake(conn, addr, path)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err)
+	}
+	return &Conn{
+		ws: ws,
+	}, nil
+}
+
+// Login logs in as the given user, using the given
+// version of the Admin facade.
+func (c *Conn) Login(user, password string, adminVersion int) error {
+	_, err := c.Call("Admin", adminVersion, "Login", map[string]interface{}{
+		"auth-tag":    "user-" + user,
+		"credentials": password,
+	})
+	return errors.Wrap(err)
+}
+
+// Call calls the given method and returns the raw response.
+// If the server returns an error, the error is an *Error.
+func (c *Conn) Call(facade string, version int, request string, params interface{}) (json.RawMessage, error) {
+	c.nextId++
+	req := apidoc.RequestFrame{
+		RequestId: c.nextId,
+		Type:      facade,
+		Version:   version,
+		Request:   request,
+		Params:    params,
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if err := c.ws.writeMessage(data); err != nil {
+		return nil, errors.Notef(err, nil, "cannot send request")
+	}
+	for {
+		data, err := c.ws.readMessage()
+		if err != nil {
+			return nil, errors.Notef(err, nil, "cannot read response")
+		}
+		var resp apidoc.ResponseFrame
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return nil, errors.Notef(err, nil, "invalid response %q", data)
+		}
+		if resp.RequestId != req.RequestId {
+			// Not the response we're waiting for.
+			continue
+		}
+		if resp.Error != "" {
+			return nil, &Error{
+				Message: resp.Error,
+				Code:    resp.ErrorCode,
+				Info:    resp.ErrorInfo,
+			}
+		}
+		return resp.Response, nil
+	}
+}
+
+// Close closes the connection.
+func (c *Conn) Close() error {
+	return c.ws.close()
+}
@@ -0,0 +1,196 @@
+package rpc
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// This file implements just enough of the websocket
+// protocol (RFC 6455) for a client to talk to the
+// Juju API server.
+
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// Websocket frame opcodes.
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+// maxMessageSize holds the maximum size of a message
+// that will be read from the server.
+const maxMessageSize = 64 << 20
+
+// websocket holds a client websocket connection.
+type websocket struct {
+	conn net.Conn
+	br   *bufio.Reader
+}
+
+// handshake performs the websocket opening handshake on conn,
+// requesting the given path on the given host.
+func handshake(conn net.Conn, host, path string) (*websocket, error) {
+	keyBytes := make([]byte, 16)
+	if _, err := rand.Read(keyBytes); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	key := base64.StdEncoding.EncodeToString(keyBytes)
+	req, err := http.NewRequest("GET", "http://"+host+path, nil)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	if err := req.Write(conn); err != nil {
+		return nil, errors.Notef(err, nil, "cannot send websocket handshake")
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, errors.Notef(err, nil, "cannot read websocket handshake response")
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		return nil, errors.Newf("websocket handshake failed: %s", resp.Status)
+	}
+	h := sha1.Sum([]byte(key + websocketGUID))
+	if resp.Header.Get("Sec-WebSocket-Accept") != base64.StdEncoding.EncodeToString(h[:]) {
+		return nil, errors.Newf("websocket handshake failed: invalid Sec-WebSocket-Accept header")
+	}
+	return &websocket{
+		conn: conn,
+		br:   br,
+	}, nil
+}
+
+// writeFrame writes a single masked frame, as
+// all frames sent by a client must be masked.
+func (ws *websocket) writeFrame(opcode byte, payload []byte) error {
+	hdr := []byte{0x80 | opcode}
+	n := len(payload)
+	switch {
+	case n < 126:
+		hdr = append(hdr, 0x80|byte(n))
+	case n <= 0xffff:
+		hdr = append(hdr, 0x80|126, 0, 0)
+		binary.BigEndian.PutUint16(hdr[2:], uint16(n))
+	default:
+		hdr = append(hdr, 0x80|127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(hdr[2:], uint64(n))
+	}
+	var mask [4]byte
+	if _, err := rand.Read(mask[:]); err != nil {
+		return errors.Wrap(err)
+	}
+	hdr = append(hdr, mask[:]...)
+	data := make([]byte, len(hdr)+n)
+	copy(data, hdr)
+	for i, b := range payload {
+		data[len(hdr)+i] = b ^ mask[i%4]
+	}
+	_, err := ws.conn.Write(data)
+	return errors.Wrap(err)
+}
+
+// writeMessage writes a text message.
+func (ws *websocket) writeMessage(data []byte) error {
+	return ws.writeFrame(opText, data)
+}
+
+// readMessage reads the next text or binary message,
+// answering any pings received in the meantime.
+func (ws *websocket) readMessage() ([]byte, error) {
+	var msg []byte
+	for {
+		fin, opcode, payload, err := ws.readFrame()
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		switch opcode {
+		case opPing:
+			if err := ws.writeFrame(opPong, payload); err != nil {
+				return nil, errors.Wrap(err)
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			ws.writeFrame(opClose, payload)
+			return nil, io.EOF
+		case opText, opBinary, opContinuation:
+		default:
+			return nil, errors.Newf("unexpected websocket opcode %#x", opcode)
+		}
+		msg = append(msg, payload...)
+		if len(msg) > maxMessageSize {
+			return nil, errors.Newf("websocket message too large")
+		}
+		if fin {
+			return msg, nil
+		}
+	}
+}
+
+func (ws *websocket) readFrame() (fin bool, opcode byte, payload []byte, err error) {
+	var hdr [2]byte
+	if _, err := io.ReadFull(ws.br, hdr[:]); err != nil {
+		return false, 0, nil, errors.Wrap(err)
+	}
+	fin = hdr[0]&0x80 != 0
+	opcode = hdr[0] & 0x0f
+	masked := hdr[1]&0x80 != 0
+	n := uint64(hdr[1] & 0x7f)
+	switch n {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(ws.br, ext[:]); err != nil {
+			return false, 0, nil, errors.Wrap(err)
+		}
+		n = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(ws.br, ext[:]); err != nil {
+			return false, 0, nil, errors.Wrap(err)
+		}
+		n = binary.BigEndian.Uint64(ext[:])
+	}
+	if n > maxMessageSize {
+		return false, 0, nil, errors.Newf("websocket frame too large")
+	}
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(ws.br, mask[:]); err != nil {
+			return false, 0, nil, errors.Wrap(err)
+		}
+	}
+	payload = make([]byte, n)
+	if _, err := io.ReadFull(ws.br, payload); err != nil {
+		return false, 0, nil, errors.Wrap(err)
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return fin, opcode, payload, nil
+}
+
+// close sends a close frame and closes the connection.
+func (ws *websocket) close() error {
+	ws.writeFrame(opClose, []byte{0x03, 0xe8}) // 1000: normal closure.
+	return ws.conn.Close()
+}
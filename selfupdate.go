@@ -0,0 +1,180 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// selfUpdateMain implements the "self-update" subcommand, which
+// replaces the running jujuapidoc binary with the latest release
+// found by the module proxy, as machines that generate the docs
+// are rarely touched otherwise.
+func selfUpdateMain(args []string) error {
+	fset := flag.NewFlagSet("self-update", flag.ExitOnError)
+	var (
+		check   = fset.Bool("check", false, "only report whether a newer release is available")
+		version = fset.String("version", "latest", "version to update to, as any version query understood by the go command")
+		force   = fset.Bool("force", false, "install the version even if it isn't newer than the running one, or the running one is unknown")
+	)
+	fset.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidoc self-update [-check] [-version version] [-force]\n")
+		fmt.Fprintf(os.Stderr, "\nThe new release is built with go install and replaces the running binary.\n\n")
+		fset.PrintDefaults()
+		os.Exit(2)
+	}
+	fset.Parse(args)
+	if fset.NArg() != 0 {
+		fset.Usage()
+	}
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return errors.Newf("jujuapidoc was built without module information, so it cannot update itself")
+	}
+	path, current := bi.Main.Path, bi.Main.Version
+	if current == "" || current == "(devel)" {
+		if !*force {
+			return errors.Newf("jujuapidoc was built from a source tree rather than a release, so its version is unknown; use -force to install %s@%s anyway", path, *version)
+		}
+		current = ""
+	}
+	latest, err := resolveModuleVersion(path, *version)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	if current != "" && !moduleVersionLess(current, latest) && !*force {
+		fmt.Printf("jujuapidoc %s is up to date (the newest release is %s)\n", current, latest)
+		return nil
+	}
+	if *check {
+		fmt.Printf("jujuapidoc %s is available (running %s)\n", latest, current)
+		return nil
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	exe, err = filepath.EvalSymlinks(exe)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	// Install into a directory next to the binary, so that the
+	// new one can be renamed into place without ever leaving a
+	// partly written binary behind.
+	tmpDir, err := ioutil.TempDir(filepath.Dir(exe), ".jujuapidoc-update")
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	if _, err := runCmdEnv(tmpDir, []string{"GOBIN=" + tmpDir, "GO111MODULE=on"}, "go", "install", path+"@"+latest); err != nil {
+		return errors.Notef(err, nil, "cannot build %s@%s", path, latest)
+	}
+	name := filepath.Base(path)
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	if err := os.Rename(filepath.Join(tmpDir, name), exe); err != nil {
+		return errors.Notef(err, nil, "cannot replace %s", exe)
+	}
+	log.Printf("updated %s from %s to %s", exe, current, latest)
+	return nil
+}
+
+// resolveModuleVersion asks the module proxy, through the go
+// command, for the version of the given module that the query
+// resolves to.
+func resolveModuleVersion(path, query string) (string, error) {
+	// Run outside any module so that the query isn't
+	// affected by the current directory's go.mod file.
+	out, err := runCmdEnv(os.TempDir(), []string{"GO111MODULE=on"}, "go", "list", "-m", "-json", path+"@"+query)
+	if err != nil {
+		return "", errors.Notef(err, nil, "cannot find %s@%s", path, query)
+	}
+	var m struct {
+		Version string
+	}
+	if err := json.Unmarshal([]byte(out), &m); err != nil {
+		return "", errors.Notef(err, nil, "cannot parse go list output")
+	}
+	if m.Version == "" {
+		return "", errors.Newf("no version found for %s@%s", path, query)
+	}
+	return m.Version, nil
+}
+
+// moduleVersionLess reports whether the module version a, such as
+// "v1.2.3" or a pseudo-version, is earlier than b, following the
+// semantic versioning rules used by the go command: a prerelease
+// is earlier than its release, and pseudo-versions, whose
+// prereleases start with a timestamp, sort by time.
+func moduleVersionLess(a, b string) bool {
+	a, b = strings.TrimPrefix(a, "v"), strings.TrimPrefix(b, "v")
+	// Build metadata, such as "+incompatible", doesn't count.
+	if i := strings.Index(a, "+"); i >= 0 {
+		a = a[:i]
+	}
+	if i := strings.Index(b, "+"); i >= 0 {
+		b = b[:i]
+	}
+	var apre, bpre string
+	if i := strings.Index(a, "-"); i >= 0 {
+		a, apre = a[:i], a[i+1:]
+	}
+	if i := strings.Index(b, "-"); i >= 0 {
+		b, bpre = b[:i], b[i+1:]
+	}
+	if c := compareIdents(strings.Split(a, "."), strings.Split(b, ".")); c != 0 {
+		return c < 0
+	}
+	if apre == "" || bpre == "" {
+		return apre != "" && bpre == ""
+	}
+	return compareIdents(strings.Split(apre, "."), strings.Split(bpre, ".")) < 0
+}
+
+// compareIdents compares two dot-separated version parts
+// identifier by identifier, numerically where both are numbers,
+// returning -1, 0 or 1. When one is a prefix of the other,
+// the shorter is earlier.
+func compareIdents(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] == b[i] {
+			continue
+		}
+		na, erra := strconv.Atoi(a[i])
+		nb, errb := strconv.Atoi(b[i])
+		switch {
+		case erra == nil && errb == nil:
+			if na < nb {
+				return -1
+			}
+			return 1
+		case erra == nil:
+			// Numeric identifiers sort first.
+			return -1
+		case errb == nil:
+			return 1
+		case a[i] < b[i]:
+			return -1
+		default:
+			return 1
+		}
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// writeSidecars writes the checksum and signature files requested
+// by the -checksum and -sign flags for each of the named files,
+// so that consumers of published documents can check their
+// integrity and provenance.
+func writeSidecars(files []string) error {
+	for _, file := range files {
+		if *checksum {
+			if err := writeChecksum(file); err != nil {
+				return errors.Notef(err, nil, "cannot write checksum for %s", file)
+			}
+		}
+		if *signKey != "" {
+			if err := signFile(file, *signKey); err != nil {
+				return errors.Notef(err, nil, "cannot sign %s", file)
+			}
+		}
+	}
+	return nil
+}
+
+// writeChecksum writes the SHA-256 checksum of the named file to
+// the file with ".sha256" appended to its name, in the format
+// used by sha256sum so that "sha256sum -c" can check it.
+func writeChecksum(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return errors.Wrap(err)
+	}
+	line := fmt.Sprintf("%x  %s\n", h.Sum(nil), filepath.Base(file))
+	if err := ioutil.WriteFile(file+".sha256", []byte(line), 0666); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// signFile signs the named file with the given gpg key, writing
+// a detached ASCII-armored signature to the file with ".asc"
+// appended to its name. The key is never handled directly;
+// gpg (or its agent) takes care of it.
+func signFile(file, key string) error {
+	if _, err := runCmd("", "gpg", "--batch", "--yes", "--armor", "--detach-sign", "--local-user", key, "--output", file+".asc", file); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
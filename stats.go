@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+
+	"github.com/juju/jujuapidoc/apidoc"
+)
+
+// runStats holds the statistics on the run when they have been
+// asked for with the -stats or -stats-json flags, and is nil
+// otherwise.
+var runStats *apidoc.Stats
+
+// generatorStatsFile holds the file that the doc generator
+// writes its statistics to, when runStats is non-nil.
+var generatorStatsFile string
+
+// addStage records the time taken by a stage of the run
+// that started at the given time.
+func addStage(name string, start time.Time) {
+	if runStats != nil {
+		runStats.AddStage(name, start)
+	}
+}
+
+// addGeneratorStats adds the statistics written by the doc
+// generator to runStats. Its stages come after the ones recorded
+// so far, as they ran within the most recent one.
+func addGeneratorStats() error {
+	if runStats == nil {
+		return nil
+	}
+	data, err := ioutil.ReadFile(generatorStatsFile)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	var s apidoc.Stats
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Notef(err, nil, "cannot parse doc generator statistics")
+	}
+	runStats.Facades = s.Facades
+	runStats.Methods = s.Methods
+	runStats.Types = s.Types
+	for _, st := range s.Stages {
+		st.Name = "generator " + st.Name
+		runStats.Stages = append(runStats.Stages, st)
+	}
+	runStats.Caches = append(runStats.Caches, s.Caches...)
+	return nil
+}
+
+// writeStats writes runStats as requested by the -stats
+// and -stats-json flags.
+func writeStats() error {
+	if runStats == nil {
+		return nil
+	}
+	if *statsFlag {
+		if err := runStats.WriteSummary(os.Stderr); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	if *statsJSON != "" {
+		data, err := json.MarshalIndent(runStats, "", "\t")
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		if err := ioutil.WriteFile(*statsJSON, data, 0666); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(buf []byte) (int, error) {
+	n, err := w.w.Write(buf)
+	w.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bufio"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// minSwitchingGo holds the first Go version whose go command can
+// fetch and run the toolchain that a module needs, as directed by
+// the GOTOOLCHAIN environment variable.
+const minSwitchingGo = "1.21"
+
+// checkGoCommand checks that the go command can be used at all,
+// which needs module support. The toolchain needed for a particular
+// juju version is chosen by selectToolchain once its source has
+// been fetched.
+func checkGoCommand() error {
+	local, err := localGoVersion()
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	if !canUseModules() {
+		return errors.Newf("the go command is Go %s, which cannot use Go modules; install Go %s or later, which can also fetch the Go toolchain that each juju version needs", local, minSwitchingGo)
+	}
+	return nil
+}
+
+// selectToolchain makes the go commands run from now on use a
+// toolchain recent enough for the juju source in jujuDir, as
+// required by the go and toolchain lines of its go.mod file. When
+// the local toolchain is too old, GOTOOLCHAIN is set so that the go
+// command fetches the right one if it can; otherwise the error says
+// which toolchain to install. Source without a go.mod file, as in
+// releases that predate modules, requires nothing.
+func selectToolchain(jujuDir string) error {
+	// Drop any toolchain chosen for another juju
+	// version, as the diff subcommand builds two.
+	env := goEnv[:0]
+	for _, e := range goEnv {
+		if !strings.HasPrefix(e, "GOTOOLCHAIN=") {
+			env = append(env, e)
+		}
+	}
+	goEnv = env
+	required, err := requiredGoVersion(filepath.Join(jujuDir, "go.mod"))
+	if err != nil {
+		return errors.Notef(err, nil, "cannot determine the Go version that juju requires")
+	}
+	if required == "" {
+		return nil
+	}
+	local, err := localGoVersion()
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	if !goVersionLess(local, required) {
+		return nil
+	}
+	if goVersionLess(local, minSwitchingGo) {
+		return errors.Newf("juju requires Go %s, but the go command is Go %s; install Go %s or later, or Go %s or later, which fetches the toolchain automatically", required, local, required, minSwitchingGo)
+	}
+	if os.Getenv("GOTOOLCHAIN") == "local" {
+		return errors.Newf("juju requires Go %s, but the go command is Go %s and GOTOOLCHAIN=local stops it fetching a newer one; install Go %s or later, or unset GOTOOLCHAIN", required, local, required)
+	}
+	toolchain := toolchainName(required)
+	log.Printf("juju requires Go %s; using toolchain %s instead of the local Go %s", required, toolchain, local)
+	goEnv = append(goEnv, "GOTOOLCHAIN="+toolchain)
+	// Fetch the toolchain now, so that a failure is
+	// reported as such rather than as a build failure.
+	if _, err := runCmd("", "go", "version"); err != nil {
+		goEnv = env
+		return errors.Notef(err, nil, "cannot fetch Go toolchain %s; install Go %s or later", toolchain, required)
+	}
+	return nil
+}
+
+// localGoVersion returns the version of the local go
+// command, without the "go" prefix, such as "1.22.1".
+func localGoVersion() (string, error) {
+	// Stop the go command from switching to the
+	// toolchain named by any go.mod file in the
+	// current directory.
+	out, err := runCmdEnv("", []string{"GOTOOLCHAIN=local"}, "go", "version")
+	if err != nil {
+		return "", errors.Notef(err, nil, "cannot run the go command")
+	}
+	// The output looks like "go version go1.22.1 linux/amd64",
+	// or "go version devel go1.23-abcdef ..." for a development
+	// toolchain.
+	for _, field := range strings.Fields(out) {
+		if strings.HasPrefix(field, "go1") {
+			v := strings.TrimPrefix(field, "go")
+			if i := strings.Index(v, "-"); i >= 0 {
+				v = v[:i]
+			}
+			return v, nil
+		}
+	}
+	return "", errors.Newf("unexpected output from go version: %q", out)
+}
+
+// requiredGoVersion returns the Go version required by the named
+// go.mod file: the later of its go and toolchain lines, or the
+// empty string if it has neither or there's no such file.
+func requiredGoVersion(goMod string) (string, error) {
+	f, err := os.Open(goMod)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrap(err)
+	}
+	defer f.Close()
+	var required string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		var v string
+		switch fields[0] {
+		case "go":
+			v = fields[1]
+		case "toolchain":
+			if !strings.HasPrefix(fields[1], "go") {
+				// For example "toolchain default".
+				continue
+			}
+			v = strings.TrimPrefix(fields[1], "go")
+		default:
+			continue
+		}
+		if required == "" || goVersionLess(required, v) {
+			required = v
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", errors.Wrap(err)
+	}
+	return required, nil
+}
+
+// toolchainName returns the name of the toolchain to set GOTOOLCHAIN
+// to for Go version v, which must be at least minSwitchingGo. From Go
+// 1.21, a go line such as "go 1.21" names a language version rather
+// than a release, the first release of which is 1.21.0.
+func toolchainName(v string) string {
+	if strings.Count(v, ".") == 1 && !strings.ContainsAny(v, "abcdefghijklmnopqrstuvwxyz") {
+		v += ".0"
+	}
+	return "go" + v
+}
+
+// goVersionLess reports whether Go version a is earlier than b.
+// Versions have the form 1.N, 1.N.P or 1.NrcR; missing parts
+// count as zero, and a prerelease is earlier than its release.
+func goVersionLess(a, b string) bool {
+	an, apre := parseGoVersion(a)
+	bn, bpre := parseGoVersion(b)
+	for i := 0; i < len(an) || i < len(bn); i++ {
+		var x, y int
+		if i < len(an) {
+			x = an[i]
+		}
+		if i < len(bn) {
+			y = bn[i]
+		}
+		if x != y {
+			return x < y
+		}
+	}
+	// The versions differ at most in their prerelease suffixes.
+	if apre == "" || bpre == "" {
+		return apre != "" && bpre == ""
+	}
+	return apre < bpre
+}
+
+// parseGoVersion splits a Go version into its numeric
+// parts and any prerelease suffix, such as "rc1".
+func parseGoVersion(v string) ([]int, string) {
+	var pre string
+	if i := strings.IndexAny(v, "abcdefghijklmnopqrstuvwxyz"); i >= 0 {
+		v, pre = v[:i], v[i:]
+	}
+	var parts []int
+	for _, s := range strings.Split(v, ".") {
+		n, _ := strconv.Atoi(s)
+		parts = append(parts, n)
+	}
+	return parts, pre
+}
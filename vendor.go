@@ -0,0 +1,521 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// vendorBuild builds the doc generator in the work directory dir
+// inside the juju source from the release tarball named by the
+// -vendor flag, using only the dependencies vendored with it
+// (plus those of the generator itself). Some older juju versions
+// only build correctly that way.
+func vendorBuild(dir string, cp *checkpoint, module, version string) error {
+	if cp.JujuDir == "" || !exists(cp.JujuDir) {
+		srcDir := filepath.Join(dir, "src")
+		if err := os.RemoveAll(srcDir); err != nil {
+			return errors.Wrap(err)
+		}
+		if err := extractTarball(srcDir, *vendorFlag); err != nil {
+			return errors.Notef(err, nil, "cannot extract %s", *vendorFlag)
+		}
+		root, err := vendorRoot(srcDir)
+		if err != nil {
+			return errors.Notef(err, nil, "cannot use %s", *vendorFlag)
+		}
+		// The tarball determines the source, so there's
+		// no version to resolve.
+		cp.ResolvedModule = module + "@" + version
+		cp.JujuDir = root
+		cp.Built = false
+		if err := cp.save(dir); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	if err := selectToolchain(cp.JujuDir); err != nil {
+		return errors.Wrap(err)
+	}
+	return buildVendored(dir, cp)
+}
+
+// buildVendored builds the doc generator in the work directory dir
+// as part of the juju source in cp.JujuDir, which must have a vendor
+// directory holding its dependencies, if it hasn't been built already.
+func buildVendored(dir string, cp *checkpoint) error {
+	generateDir := filepath.Join(dir, "jujugenerateapidoc")
+	if cp.Built && exists(filepath.Join(generateDir, "jujugenerateapidoc")) {
+		return nil
+	}
+	// Start from the original generator source in case
+	// an interrupted build left it partly edited.
+	if err := restoreGenerator(dir); err != nil {
+		return errors.Wrap(err)
+	}
+	declaredPath, err := modulePath(cp.JujuDir)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	layout, err := jujuLayout(cp.JujuDir)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	log.Printf("juju source layout: %s", layout)
+	// In vendor mode only packages in the main module and
+	// its vendor directory are available, so the generator
+	// must be built as part of the juju module.
+	genDir := filepath.Join(cp.JujuDir, "jujugenerateapidoc")
+	if err := os.RemoveAll(genDir); err != nil {
+		return errors.Wrap(err)
+	}
+	if err := os.Mkdir(genDir, 0777); err != nil {
+		return errors.Wrap(err)
+	}
+	files, err := filepath.Glob(filepath.Join(generateDir, "*.go"))
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	for _, file := range files {
+		if err := copyFile(filepath.Join(genDir, filepath.Base(file)), file); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	if declaredPath != jujuMod {
+		if err := rewriteJujuImports(genDir, declaredPath); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	if err := vendorGeneratorDeps(dir, cp.JujuDir, genDir, declaredPath, layout); err != nil {
+		return errors.Notef(err, nil, "cannot vendor doc generator dependencies")
+	}
+	buildArgs := []string{"build", "-mod=vendor", "-o", filepath.Join(generateDir, "jujugenerateapidoc")}
+	if layout != "juju2" {
+		buildArgs = append(buildArgs, "-tags", layout)
+	}
+	buildArgs = append(buildArgs, "./jujugenerateapidoc")
+	if _, err := runCmd(cp.JujuDir, "go", buildArgs...); err != nil {
+		return errors.Notef(err, nil, "cannot build doc generator program")
+	}
+	cp.Built = true
+	if err := cp.save(dir); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// extractTarball extracts the gzipped tar archive at src, which
+// may be a file name or an http or https URL, into the directory dst.
+// Only directories and regular files are extracted.
+func extractTarball(dst, src string) error {
+	var r io.Reader
+	if strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://") {
+		resp, err := http.Get(src)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return errors.Newf("cannot fetch %s: %s", src, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(src)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		defer f.Close()
+		r = f
+	}
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	tr := tar.NewReader(zr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		name := path.Clean(hdr.Name)
+		if name == ".." || strings.HasPrefix(name, "../") || path.IsAbs(name) {
+			return errors.Newf("invalid file name %q in tarball", hdr.Name)
+		}
+		target := filepath.Join(dst, filepath.FromSlash(name))
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0777); err != nil {
+				return errors.Wrap(err)
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0777); err != nil {
+				return errors.Wrap(err)
+			}
+			f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, hdr.FileInfo().Mode().Perm())
+			if err != nil {
+				return errors.Wrap(err)
+			}
+			_, err = io.Copy(f, tr)
+			f.Close()
+			if err != nil {
+				return errors.Wrap(err)
+			}
+		}
+	}
+}
+
+// vendorRoot returns the root of the juju source extracted into
+// srcDir, making sure that it has a vendor directory and a go.mod
+// file. Release tarballs either hold the juju source, with its
+// vendor directory, at the top level, or hold a GOPATH with juju
+// and all its dependencies, which are moved into a vendor directory.
+func vendorRoot(srcDir string) (string, error) {
+	tops, err := ioutil.ReadDir(srcDir)
+	if err != nil {
+		return "", errors.Wrap(err)
+	}
+	root := ""
+	for _, top := range tops {
+		if !top.IsDir() {
+			continue
+		}
+		dir := filepath.Join(srcDir, top.Name())
+		if exists(filepath.Join(dir, "apiserver")) {
+			if !exists(filepath.Join(dir, "vendor")) {
+				return "", errors.Newf("juju source in tarball has no vendor directory")
+			}
+			root = dir
+			break
+		}
+		gopathSrc := filepath.Join(dir, "src")
+		if exists(filepath.Join(gopathSrc, filepath.FromSlash(jujuMod), "apiserver")) {
+			if err := gopathToVendor(gopathSrc, jujuMod); err != nil {
+				return "", errors.Wrap(err)
+			}
+			root = filepath.Join(gopathSrc, filepath.FromSlash(jujuMod))
+			break
+		}
+	}
+	if root == "" {
+		return "", errors.Newf("no juju source found in tarball")
+	}
+	if err := ensureGoMod(root); err != nil {
+		return "", errors.Wrap(err)
+	}
+	return root, nil
+}
+
+// ensureGoMod writes a go.mod file for the juju source in root,
+// which must have a vendor directory, if it doesn't have one.
+func ensureGoMod(root string) error {
+	if exists(filepath.Join(root, "go.mod")) {
+		return nil
+	}
+	// Declaring a Go version before 1.14 stops the go command
+	// requiring vendor/modules.txt to list every vendored module,
+	// which a tree vendored without modules can't do.
+	goMod := "module " + jujuMod + "\n\ngo 1.12\n"
+	if err := ioutil.WriteFile(filepath.Join(root, "go.mod"), []byte(goMod), 0666); err != nil {
+		return errors.Wrap(err)
+	}
+	return nil
+}
+
+// gopathToVendor moves everything in the GOPATH source directory src
+// other than the package tree at the given import path into that
+// tree's vendor directory. Anything already vendored there takes
+// precedence, as it would have done in GOPATH mode.
+func gopathToVendor(src, importPath string) error {
+	elems := strings.Split(importPath, "/")
+	vendorDir := filepath.Join(src, filepath.FromSlash(importPath), "vendor")
+	parent := src
+	for i, elem := range elems {
+		entries, err := ioutil.ReadDir(parent)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		for _, e := range entries {
+			if e.Name() == elem {
+				continue
+			}
+			rel := append(elems[:i:i], e.Name())
+			if err := moveInto(filepath.Join(vendorDir, filepath.Join(rel...)), filepath.Join(parent, e.Name())); err != nil {
+				return errors.Wrap(err)
+			}
+		}
+		parent = filepath.Join(parent, elem)
+	}
+	return nil
+}
+
+// moveInto moves the file or directory src to dst, merging
+// directories with any already at dst. Files already at dst
+// are left alone, as are packages: no files are added to a
+// directory at dst that already holds Go files, although its
+// subdirectories are still merged.
+func moveInto(dst, src string) error {
+	dstInfo, err := os.Stat(dst)
+	if os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(dst), 0777); err != nil {
+			return errors.Wrap(err)
+		}
+		if err := os.Rename(src, dst); err != nil {
+			return errors.Wrap(err)
+		}
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	srcInfo, err := os.Stat(src)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	if !dstInfo.IsDir() || !srcInfo.IsDir() {
+		return nil
+	}
+	dstIsPackage, err := hasGoFiles(dst)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	entries, err := ioutil.ReadDir(src)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	for _, e := range entries {
+		if dstIsPackage && !e.IsDir() {
+			continue
+		}
+		if err := moveInto(filepath.Join(dst, e.Name()), filepath.Join(src, e.Name())); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// hasGoFiles reports whether the directory dir holds any Go files.
+func hasGoFiles(dir string) (bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return false, errors.Wrap(err)
+	}
+	return len(files) > 0, nil
+}
+
+// vendorGeneratorDeps adds the packages needed by the generator
+// source in genDir that aren't already vendored to the vendor
+// directory of the juju source in root. The apidoc package is copied
+// from the work directory dir (see restoreGenerator), and the other
+// missing packages, including those that apidoc imports, are resolved
+// in the generator's own module there, so that the versions it was
+// developed against are used.
+func vendorGeneratorDeps(dir, root, genDir, declaredPath, layout string) error {
+	generateDir := filepath.Join(dir, "jujugenerateapidoc")
+	apidocDir := filepath.Join(dir, "jujuapidoc", "apidoc")
+	vendorDir := filepath.Join(root, "vendor")
+	versions := make(map[string]string)
+	pkgs := make(map[string][]string)
+	apidocPkg := apidocMod + "/apidoc"
+	if dst := filepath.Join(vendorDir, filepath.FromSlash(apidocPkg)); !exists(dst) {
+		if err := copyPackage(dst, apidocDir); err != nil {
+			return errors.Wrap(err)
+		}
+		// This is the version that the go command
+		// gives a module replaced by a directory.
+		versions[apidocMod] = "v0.0.0-00010101000000-000000000000"
+		pkgs[apidocMod] = []string{apidocPkg}
+	}
+	seen := make(map[string]bool)
+	var missing []string
+	for _, srcDir := range []string{genDir, apidocDir} {
+		imports, err := externalImports(srcDir, declaredPath, layout)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		for _, p := range imports {
+			if !seen[p] && !exists(filepath.Join(vendorDir, filepath.FromSlash(p))) {
+				seen[p] = true
+				missing = append(missing, p)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		if _, err := runCmd(generateDir, "go", "mod", "edit", "-replace="+apidocMod+"="+filepath.Join(dir, "jujuapidoc")); err != nil {
+			return errors.Wrap(err)
+		}
+		if _, err := runCmd(generateDir, "go", append([]string{"get"}, missing...)...); err != nil {
+			return errors.Wrap(err)
+		}
+		out, err := runCmd(generateDir, "go", append([]string{
+			"list", "-deps",
+			"-f", `{{if not .Standard}}{{with .Module}}{{$.ImportPath}} {{$.Dir}} {{.Path}} {{.Version}}{{end}}{{end}}`,
+		}, missing...)...)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		for _, line := range strings.Split(out, "\n") {
+			fields := strings.Fields(line)
+			if len(fields) != 4 {
+				continue
+			}
+			importPath, pkgDir, modPath, modVersion := fields[0], fields[1], fields[2], fields[3]
+			dst := filepath.Join(vendorDir, filepath.FromSlash(importPath))
+			if exists(dst) {
+				continue
+			}
+			if err := copyPackage(dst, pkgDir); err != nil {
+				return errors.Wrap(err)
+			}
+			versions[modPath] = modVersion
+			pkgs[modPath] = append(pkgs[modPath], importPath)
+		}
+	}
+	added, err := addVendoredPackages(vendorDir, versions, pkgs)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	for _, modPath := range added {
+		if _, err := runCmd(root, "go", "mod", "edit", "-require="+modPath+"@"+versions[modPath]); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// externalImports returns the import paths of the non-standard
+// packages outside the juju module imported by the Go files in
+// dir that are built for the given juju layout.
+func externalImports(dir, declaredPath, layout string) ([]string, error) {
+	ctxt := build.Default
+	ctxt.BuildTags = []string{layout}
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	seen := make(map[string]bool)
+	var imports []string
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if ok, err := ctxt.MatchFile(dir, filepath.Base(file)); err != nil {
+			return nil, errors.Wrap(err)
+		} else if !ok {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.ImportsOnly)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		for _, spec := range f.Imports {
+			p, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return nil, errors.Wrap(err)
+			}
+			if seen[p] || !strings.Contains(strings.Split(p, "/")[0], ".") {
+				continue
+			}
+			if p == declaredPath || strings.HasPrefix(p, declaredPath+"/") {
+				continue
+			}
+			seen[p] = true
+			imports = append(imports, p)
+		}
+	}
+	return imports, nil
+}
+
+// copyPackage copies the files of the package in src, other
+// than its tests, to dst, as "go mod vendor" does.
+func copyPackage(dst, src string) error {
+	entries, err := ioutil.ReadDir(src)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	if err := os.MkdirAll(dst, 0777); err != nil {
+		return errors.Wrap(err)
+	}
+	for _, e := range entries {
+		if e.IsDir() || strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		if err := copyFile(filepath.Join(dst, e.Name()), filepath.Join(src, e.Name())); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// addVendoredPackages records the given packages, keyed by the
+// module that provides them, in the modules.txt file in vendorDir,
+// and returns the modules that weren't already vendored. Packages
+// from modules that were are added to their existing entries.
+func addVendoredPackages(vendorDir string, versions map[string]string, pkgs map[string][]string) ([]string, error) {
+	file := filepath.Join(vendorDir, "modules.txt")
+	data, err := ioutil.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err)
+	}
+	var lines []string
+	if len(data) > 0 {
+		lines = strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	}
+	var out []string
+	done := make(map[string]bool)
+	for i := 0; i < len(lines); i++ {
+		out = append(out, lines[i])
+		fields := strings.Fields(lines[i])
+		if len(fields) < 2 || fields[0] != "#" || pkgs[fields[1]] == nil {
+			continue
+		}
+		// Keep the module's annotations with its header.
+		for i+1 < len(lines) && strings.HasPrefix(lines[i+1], "## ") {
+			i++
+			out = append(out, lines[i])
+		}
+		out = append(out, pkgs[fields[1]]...)
+		done[fields[1]] = true
+	}
+	var added []string
+	for modPath := range pkgs {
+		if !done[modPath] {
+			added = append(added, modPath)
+		}
+	}
+	sort.Strings(added)
+	for _, modPath := range added {
+		out = append(out, "# "+modPath+" "+versions[modPath], "## explicit")
+		out = append(out, pkgs[modPath]...)
+	}
+	if err := ioutil.WriteFile(file, []byte(strings.Join(out, "\n")+"\n"), 0666); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return added, nil
+}
+
+// goFlagsEnv returns a GOFLAGS environment variable setting
+// that adds the given flag to those set in goEnv, or
+// in the environment if goEnv doesn't set any.
+func goFlagsEnv(flag string) string {
+	flags := os.Getenv("GOFLAGS")
+	for _, e := range goEnv {
+		if strings.HasPrefix(e, "GOFLAGS=") {
+			flags = strings.TrimPrefix(e, "GOFLAGS=")
+		}
+	}
+	return "GOFLAGS=" + strings.TrimSpace(flags+" "+flag)
+}
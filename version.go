@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/debug"
+
+	"gopkg.in/errgo.v2/fmt/errors"
+)
+
+// versionMain implements the "version" subcommand, which prints
+// the version of jujuapidoc and of the doc generator embedded in
+// it. The same information is recorded in the Meta section of
+// every generated document, so a document can be traced back to
+// the tool and generator that produced it.
+func versionMain(args []string) error {
+	fset := flag.NewFlagSet("version", flag.ExitOnError)
+	jsonOutput := fset.Bool("json", false, "print the version information as JSON")
+	fset.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: jujuapidoc version [-json]\n")
+		fset.PrintDefaults()
+		os.Exit(2)
+	}
+	fset.Parse(args)
+	if fset.NArg() != 0 {
+		fset.Usage()
+	}
+	gen, err := generatorHash()
+	if err != nil {
+		return errors.Notef(err, nil, "cannot hash the doc generator source")
+	}
+	var v struct {
+		Version       string
+		Commit        string `json:",omitempty"`
+		GeneratorHash string
+		GoVersion     string
+	}
+	v.Version, v.Commit = toolVersion()
+	v.GeneratorHash = gen
+	v.GoVersion = runtime.Version()
+	if *jsonOutput {
+		data, err := json.MarshalIndent(v, "", "\t")
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		fmt.Printf("%s\n", data)
+		return nil
+	}
+	fmt.Printf("jujuapidoc %s\n", v.Version)
+	if v.Commit != "" {
+		fmt.Printf("commit %s\n", v.Commit)
+	}
+	fmt.Printf("generator %s\n", v.GeneratorHash)
+	fmt.Printf("built with %s\n", v.GoVersion)
+	return nil
+}
+
+// toolVersion returns the module version of jujuapidoc, which is
+// "(devel)" when it was built from a source tree, and the commit
+// it was built from, if known. A commit with uncommitted changes
+// has "-dirty" appended.
+func toolVersion() (version, commit string) {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown", ""
+	}
+	modified := false
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			commit = s.Value
+		case "vcs.modified":
+			modified = s.Value == "true"
+		}
+	}
+	if commit != "" && modified {
+		commit += "-dirty"
+	}
+	return bi.Main.Version, commit
+}